@@ -2,6 +2,9 @@ package app
 
 import (
 	"context"
+	"fmt"
+
+	"go.uber.org/zap"
 
 	"github.com/crafty-ezhik/rocket-factory/assembly/internal/config"
 	"github.com/crafty-ezhik/rocket-factory/platform/pkg/closer"
@@ -23,7 +26,45 @@ func New(ctx context.Context) (*App, error) {
 }
 
 func (a *App) Run(ctx context.Context) error {
-	return a.runConsumer(ctx)
+	errCh := make(chan error, 4)
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	go func() {
+		if err := a.runConsumer(ctx); err != nil {
+			errCh <- fmt.Errorf("consumer error: %w", err)
+		}
+	}()
+
+	go func() {
+		if err := a.runRefundedConsumer(ctx); err != nil {
+			errCh <- fmt.Errorf("consumer error: %w", err)
+		}
+	}()
+
+	go func() {
+		if err := a.runAssemblyRequestedConsumer(ctx); err != nil {
+			errCh <- fmt.Errorf("consumer error: %w", err)
+		}
+	}()
+
+	go func() {
+		if err := a.runAssemblyWorkers(ctx); err != nil {
+			errCh <- fmt.Errorf("assembly workers error: %w", err)
+		}
+	}()
+
+	select {
+	case err := <-errCh:
+		// Триггерим cancel, чтобы остановить остальные consumer и обработчиков сборки
+		cancel()
+		return err
+	case <-ctx.Done():
+		logger.Info(ctx, "🔔 Получен сигнал завершения работы")
+	}
+
+	return nil
 }
 
 func (a *App) initDeps(ctx context.Context) error {
@@ -67,3 +108,31 @@ func (a *App) runConsumer(ctx context.Context) error {
 	}
 	return nil
 }
+
+func (a *App) runRefundedConsumer(ctx context.Context) error {
+	logger.Info(ctx, "🚀 OrderRefunded Kafka consumer запущен")
+
+	err := a.diContainer.OrderRefundedConsumerService().RunConsumer(ctx)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (a *App) runAssemblyRequestedConsumer(ctx context.Context) error {
+	logger.Info(ctx, "🚀 AssemblyRequested Kafka consumer запущен")
+
+	err := a.diContainer.AssemblyRequestedConsumerService().RunConsumer(ctx)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (a *App) runAssemblyWorkers(ctx context.Context) error {
+	logger.Info(ctx, "🚀 Обработчики заданий на сборку запущены",
+		zap.Int("workers", config.AppConfig().AssemblyWorker.Workers()),
+	)
+
+	return a.diContainer.AssemblyService().RunWorkers(ctx)
+}
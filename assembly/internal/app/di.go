@@ -3,33 +3,61 @@ package app
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/IBM/sarama"
+	"github.com/jackc/pgx/v5/pgxpool"
 
 	"github.com/crafty-ezhik/rocket-factory/assembly/internal/config"
 	kafkaConv "github.com/crafty-ezhik/rocket-factory/assembly/internal/converter/kafka"
 	"github.com/crafty-ezhik/rocket-factory/assembly/internal/converter/kafka/decoder"
+	"github.com/crafty-ezhik/rocket-factory/assembly/internal/repository"
+	"github.com/crafty-ezhik/rocket-factory/assembly/internal/repository/assembly_job"
 	"github.com/crafty-ezhik/rocket-factory/assembly/internal/service"
+	"github.com/crafty-ezhik/rocket-factory/assembly/internal/service/assembly"
+	"github.com/crafty-ezhik/rocket-factory/assembly/internal/service/cancellation"
+	"github.com/crafty-ezhik/rocket-factory/assembly/internal/service/consumer/assembly_requested_consumer"
 	"github.com/crafty-ezhik/rocket-factory/assembly/internal/service/consumer/order_consumer"
+	"github.com/crafty-ezhik/rocket-factory/assembly/internal/service/consumer/order_refunded_consumer"
 	"github.com/crafty-ezhik/rocket-factory/assembly/internal/service/producer/order_producer"
 	"github.com/crafty-ezhik/rocket-factory/platform/pkg/closer"
 	wrapperKafka "github.com/crafty-ezhik/rocket-factory/platform/pkg/kafka"
 	wrapperKafkaConsumer "github.com/crafty-ezhik/rocket-factory/platform/pkg/kafka/consumer"
+	"github.com/crafty-ezhik/rocket-factory/platform/pkg/kafka/consumer/dedup"
 	wrapperKafkaProducer "github.com/crafty-ezhik/rocket-factory/platform/pkg/kafka/producer"
 	"github.com/crafty-ezhik/rocket-factory/platform/pkg/logger"
 	kafkaMiddleware "github.com/crafty-ezhik/rocket-factory/platform/pkg/middleware/kafka"
 )
 
 type diContainer struct {
-	orderConsumerService service.ConsumerService
-	orderProducerService service.OrderProducerService
+	orderConsumerService         service.ConsumerService
+	orderRefundedConsumerService service.ConsumerService
+	assemblyRequestedService     service.ConsumerService
+	orderProducerService         service.OrderProducerService
+	assemblyService              service.AssemblyService
+	cancellationRegistry         service.CancellationRegistry
+
+	assemblyJobRepository repository.AssemblyJobRepository
+	pgConnPool            *pgxpool.Pool
 
 	consumerGroup     sarama.ConsumerGroup
 	orderPaidConsumer wrapperKafka.Consumer
 	orderPaidDecoder  kafkaConv.OrderPaidDecoder
+	dedupStore        wrapperKafkaConsumer.DedupStore
+
+	consumerGroupRefunded sarama.ConsumerGroup
+	orderRefundedConsumer wrapperKafka.Consumer
+	orderRefundedDecoder  kafkaConv.OrderRefundedDecoder
 
-	orderAssembledProducer wrapperKafka.Producer
-	syncProducer           sarama.SyncProducer
+	consumerGroupAssemblyRequested sarama.ConsumerGroup
+	assemblyRequestedConsumer      wrapperKafka.Consumer
+	assemblyRequestedDecoder       kafkaConv.AssemblyRequestedDecoder
+
+	assemblyStartedProducer        wrapperKafka.Producer
+	assemblyStageCompletedProducer wrapperKafka.Producer
+	orderAssembledProducer         wrapperKafka.Producer
+	orderAssemblyFailedProducer    wrapperKafka.Producer
+	syncProducer                   sarama.SyncProducer
 }
 
 func NewDiContainer() *diContainer {
@@ -38,14 +66,109 @@ func NewDiContainer() *diContainer {
 
 func (d *diContainer) OrderConsumerService() service.ConsumerService {
 	if d.orderConsumerService == nil {
-		d.orderConsumerService = order_consumer.NewService(d.OrderPaidConsumer(), d.OrderPaidDecoder(), d.OrderProducerService())
+		d.orderConsumerService = order_consumer.NewService(
+			d.OrderPaidConsumer(),
+			d.OrderPaidDecoder(),
+			d.AssemblyService(),
+		)
 	}
 	return d.orderConsumerService
 }
 
+func (d *diContainer) OrderRefundedConsumerService() service.ConsumerService {
+	if d.orderRefundedConsumerService == nil {
+		d.orderRefundedConsumerService = order_refunded_consumer.NewService(
+			d.OrderRefundedConsumer(),
+			d.OrderRefundedDecoder(),
+			d.AssemblyService(),
+		)
+	}
+	return d.orderRefundedConsumerService
+}
+
+func (d *diContainer) AssemblyRequestedConsumerService() service.ConsumerService {
+	if d.assemblyRequestedService == nil {
+		d.assemblyRequestedService = assembly_requested_consumer.NewService(
+			d.AssemblyRequestedConsumer(),
+			d.AssemblyRequestedDecoder(),
+			d.AssemblyService(),
+		)
+	}
+	return d.assemblyRequestedService
+}
+
+// AssemblyService - Создается сервис сборки с пулом обработчиков заданий
+func (d *diContainer) AssemblyService() service.AssemblyService {
+	if d.assemblyService == nil {
+		d.assemblyService = assembly.NewService(
+			d.AssemblyJobRepository(),
+			d.OrderProducerService(),
+			d.CancellationRegistry(),
+			assembly.WorkerPool{
+				Workers:            config.AppConfig().AssemblyWorker.Workers(),
+				PollInterval:       config.AppConfig().AssemblyWorker.PollInterval(),
+				JobLease:           config.AppConfig().AssemblyWorker.JobLease(),
+				JobMaxRuns:         config.AppConfig().AssemblyWorker.JobMaxRuns(),
+				TimeScale:          config.AppConfig().AssemblyWorker.BuildTimeScale(),
+				FaultInjectionRate: config.AppConfig().AssemblyWorker.FaultInjectionRate(),
+			},
+		)
+	}
+	return d.assemblyService
+}
+
+func (d *diContainer) AssemblyJobRepository() repository.AssemblyJobRepository {
+	if d.assemblyJobRepository == nil {
+		d.assemblyJobRepository = assembly_job.NewRepository(d.PgConnPool())
+	}
+	return d.assemblyJobRepository
+}
+
+// PgConnPool - пул соединений с Postgres, где хранятся задания на сборку
+func (d *diContainer) PgConnPool() *pgxpool.Pool {
+	if d.pgConnPool == nil {
+		ctx := context.Background()
+		pool, err := pgxpool.New(ctx, config.AppConfig().Postgres.URI())
+		if err != nil {
+			panic(fmt.Sprintf("❌ Ошибка подключения к базе данных: %v\n", err))
+		}
+
+		// Проверка соединения
+		pingCtx, pingCancel := context.WithTimeout(ctx, 5*time.Second)
+		defer pingCancel()
+
+		err = pool.Ping(pingCtx)
+		if err != nil {
+			panic(fmt.Sprintf("failed to ping Postgres: %v\n", err))
+		}
+
+		// Добавляем закрытие пула в closer
+		closer.AddNamed("Postgres connection pool", func(ctx context.Context) error {
+			pool.Close()
+			return nil
+		})
+
+		d.pgConnPool = pool
+	}
+	return d.pgConnPool
+}
+
+// CancellationRegistry - Создается реестр заказов, отмененных после оплаты
+func (d *diContainer) CancellationRegistry() service.CancellationRegistry {
+	if d.cancellationRegistry == nil {
+		d.cancellationRegistry = cancellation.NewRegistry()
+	}
+	return d.cancellationRegistry
+}
+
 func (d *diContainer) OrderProducerService() service.OrderProducerService {
 	if d.orderProducerService == nil {
-		d.orderProducerService = order_producer.NewService(d.OrderAssembledProducer())
+		d.orderProducerService = order_producer.NewService(
+			d.AssemblyStartedProducer(),
+			d.AssemblyStageCompletedProducer(),
+			d.OrderAssembledProducer(),
+			d.OrderAssemblyFailedProducer(),
+		)
 	}
 	return d.orderProducerService
 }
@@ -59,12 +182,72 @@ func (d *diContainer) OrderPaidConsumer() wrapperKafka.Consumer {
 				config.AppConfig().OrderPaidConsumer.Topic(),
 			},
 			logger.Logger(),
-			kafkaMiddleware.Logging(logger.Logger()),
+			wrapperKafkaConsumer.WithMiddlewares(
+				kafkaMiddleware.Logging(logger.Logger()),
+				wrapperKafkaConsumer.Deduplicate(
+					d.DedupStore(),
+					config.AppConfig().OrderPaidConsumer.GroupID(),
+					config.AppConfig().OrderPaidConsumer.DedupRetention(),
+					d.orderPaidEventKey,
+					logger.Logger(),
+				),
+			),
+			wrapperKafkaConsumer.WithRetry(d.ConsumerRetryPolicy(), d.SyncProducer()),
 		)
 	}
 	return d.orderPaidConsumer
 }
 
+// OrderRefundedConsumer - Создает consumer, слушающего событие order.refunded
+func (d *diContainer) OrderRefundedConsumer() wrapperKafka.Consumer {
+	if d.orderRefundedConsumer == nil {
+		d.orderRefundedConsumer = wrapperKafkaConsumer.NewConsumer(
+			d.ConsumerGroupRefunded(),
+			[]string{
+				config.AppConfig().OrderRefundedConsumer.Topic(),
+			},
+			logger.Logger(),
+			wrapperKafkaConsumer.WithMiddlewares(
+				kafkaMiddleware.Logging(logger.Logger()),
+				wrapperKafkaConsumer.Deduplicate(
+					d.DedupStore(),
+					config.AppConfig().OrderRefundedConsumer.GroupID(),
+					config.AppConfig().OrderRefundedConsumer.DedupRetention(),
+					d.orderRefundedEventKey,
+					logger.Logger(),
+				),
+			),
+			wrapperKafkaConsumer.WithRetry(d.ConsumerRetryPolicy(), d.SyncProducer()),
+		)
+	}
+	return d.orderRefundedConsumer
+}
+
+// AssemblyRequestedConsumer - Создает consumer, слушающего запросы повторной сборки
+func (d *diContainer) AssemblyRequestedConsumer() wrapperKafka.Consumer {
+	if d.assemblyRequestedConsumer == nil {
+		d.assemblyRequestedConsumer = wrapperKafkaConsumer.NewConsumer(
+			d.ConsumerGroupAssemblyRequested(),
+			[]string{
+				config.AppConfig().AssemblyRequested.Topic(),
+			},
+			logger.Logger(),
+			wrapperKafkaConsumer.WithMiddlewares(
+				kafkaMiddleware.Logging(logger.Logger()),
+				wrapperKafkaConsumer.Deduplicate(
+					d.DedupStore(),
+					config.AppConfig().AssemblyRequested.GroupID(),
+					config.AppConfig().AssemblyRequested.DedupRetention(),
+					d.assemblyRequestedEventKey,
+					logger.Logger(),
+				),
+			),
+			wrapperKafkaConsumer.WithRetry(d.ConsumerRetryPolicy(), d.SyncProducer()),
+		)
+	}
+	return d.assemblyRequestedConsumer
+}
+
 // ConsumerGroup - Создается consumer group на основе данных из конфигурации
 func (d *diContainer) ConsumerGroup() sarama.ConsumerGroup {
 	if d.consumerGroup == nil {
@@ -87,6 +270,50 @@ func (d *diContainer) ConsumerGroup() sarama.ConsumerGroup {
 	return d.consumerGroup
 }
 
+// ConsumerGroupRefunded - Создается consumer group для событий отмены заказа
+func (d *diContainer) ConsumerGroupRefunded() sarama.ConsumerGroup {
+	if d.consumerGroupRefunded == nil {
+		consumerGroup, err := sarama.NewConsumerGroup(
+			config.AppConfig().Kafka.Brokers(),
+			config.AppConfig().OrderRefundedConsumer.GroupID(),
+			config.AppConfig().OrderRefundedConsumer.Config(),
+		)
+		if err != nil {
+			panic(fmt.Sprintf("❌ Ошибка создания consumer group: %s\n", err.Error()))
+		}
+
+		// Добавляем закрытие consumerGroup
+		closer.AddNamed("Kafka refunded consumer group", func(ctx context.Context) error {
+			return d.consumerGroupRefunded.Close()
+		})
+
+		d.consumerGroupRefunded = consumerGroup
+	}
+	return d.consumerGroupRefunded
+}
+
+// ConsumerGroupAssemblyRequested - Создается consumer group для запросов повторной сборки
+func (d *diContainer) ConsumerGroupAssemblyRequested() sarama.ConsumerGroup {
+	if d.consumerGroupAssemblyRequested == nil {
+		consumerGroup, err := sarama.NewConsumerGroup(
+			config.AppConfig().Kafka.Brokers(),
+			config.AppConfig().AssemblyRequested.GroupID(),
+			config.AppConfig().AssemblyRequested.Config(),
+		)
+		if err != nil {
+			panic(fmt.Sprintf("❌ Ошибка создания consumer group: %s\n", err.Error()))
+		}
+
+		// Добавляем закрытие consumerGroup
+		closer.AddNamed("Kafka assembly requested consumer group", func(ctx context.Context) error {
+			return d.consumerGroupAssemblyRequested.Close()
+		})
+
+		d.consumerGroupAssemblyRequested = consumerGroup
+	}
+	return d.consumerGroupAssemblyRequested
+}
+
 // OrderPaidDecoder - Создается декодер для входящих событий
 func (d *diContainer) OrderPaidDecoder() kafkaConv.OrderPaidDecoder {
 	if d.orderPaidDecoder == nil {
@@ -95,6 +322,81 @@ func (d *diContainer) OrderPaidDecoder() kafkaConv.OrderPaidDecoder {
 	return d.orderPaidDecoder
 }
 
+// OrderRefundedDecoder - Создается декодер для событий отмены заказа
+func (d *diContainer) OrderRefundedDecoder() kafkaConv.OrderRefundedDecoder {
+	if d.orderRefundedDecoder == nil {
+		d.orderRefundedDecoder = decoder.NewOrderRefundedDecoder()
+	}
+	return d.orderRefundedDecoder
+}
+
+// AssemblyRequestedDecoder - Создается декодер запросов повторной сборки
+func (d *diContainer) AssemblyRequestedDecoder() kafkaConv.AssemblyRequestedDecoder {
+	if d.assemblyRequestedDecoder == nil {
+		d.assemblyRequestedDecoder = decoder.NewAssemblyRequestedDecoder()
+	}
+	return d.assemblyRequestedDecoder
+}
+
+// DedupStore - Создается хранилище ключей обработанных событий в PostgreSQL
+func (d *diContainer) DedupStore() wrapperKafkaConsumer.DedupStore {
+	if d.dedupStore == nil {
+		d.dedupStore = dedup.NewPostgresStore(d.PgConnPool(), "processed_messages")
+	}
+	return d.dedupStore
+}
+
+// orderPaidEventKey - ключ идемпотентности события "Заказ оплачен"
+func (d *diContainer) orderPaidEventKey(msg wrapperKafka.Message) (string, error) {
+	event, err := d.OrderPaidDecoder().Decode(msg.Value)
+	if err != nil {
+		return "", err
+	}
+	return event.EventUUID.String(), nil
+}
+
+// orderRefundedEventKey - ключ идемпотентности события "Заказ отменен, средства возвращены"
+func (d *diContainer) orderRefundedEventKey(msg wrapperKafka.Message) (string, error) {
+	event, err := d.OrderRefundedDecoder().Decode(msg.Value)
+	if err != nil {
+		return "", err
+	}
+	return event.EventUUID.String(), nil
+}
+
+// assemblyRequestedEventKey - ключ идемпотентности запроса повторной сборки
+func (d *diContainer) assemblyRequestedEventKey(msg wrapperKafka.Message) (string, error) {
+	event, err := d.AssemblyRequestedDecoder().Decode(msg.Value)
+	if err != nil {
+		return "", err
+	}
+	return event.EventUUID.String(), nil
+}
+
+// AssemblyStartedProducer - создает producer событий начала сборки
+func (d *diContainer) AssemblyStartedProducer() wrapperKafka.Producer {
+	if d.assemblyStartedProducer == nil {
+		d.assemblyStartedProducer = wrapperKafkaProducer.NewProducer(
+			d.SyncProducer(),
+			config.AppConfig().AssemblyProgress.StartedTopic(),
+			logger.Logger(),
+		)
+	}
+	return d.assemblyStartedProducer
+}
+
+// AssemblyStageCompletedProducer - создает producer событий завершения этапов сборки
+func (d *diContainer) AssemblyStageCompletedProducer() wrapperKafka.Producer {
+	if d.assemblyStageCompletedProducer == nil {
+		d.assemblyStageCompletedProducer = wrapperKafkaProducer.NewProducer(
+			d.SyncProducer(),
+			config.AppConfig().AssemblyProgress.StageCompletedTopic(),
+			logger.Logger(),
+		)
+	}
+	return d.assemblyStageCompletedProducer
+}
+
 // OrderAssembledProducer - создает producer который отправляет в топик, заданный в конфигурации
 func (d *diContainer) OrderAssembledProducer() wrapperKafka.Producer {
 	if d.orderAssembledProducer == nil {
@@ -107,6 +409,28 @@ func (d *diContainer) OrderAssembledProducer() wrapperKafka.Producer {
 	return d.orderAssembledProducer
 }
 
+// ConsumerRetryPolicy - Создается политика повторной обработки сообщений на основе конфигурации
+func (d *diContainer) ConsumerRetryPolicy() wrapperKafkaConsumer.RetryPolicy {
+	return wrapperKafkaConsumer.RetryPolicy{
+		Attempts:   config.AppConfig().ConsumerRetry.Attempts(),
+		Backoff:    config.AppConfig().ConsumerRetry.Backoff(),
+		MaxBackoff: config.AppConfig().ConsumerRetry.MaxBackoff(),
+		Delays:     config.AppConfig().ConsumerRetry.TopicDelays(),
+	}
+}
+
+// OrderAssemblyFailedProducer - создает producer событий ошибки сборки
+func (d *diContainer) OrderAssemblyFailedProducer() wrapperKafka.Producer {
+	if d.orderAssemblyFailedProducer == nil {
+		d.orderAssemblyFailedProducer = wrapperKafkaProducer.NewProducer(
+			d.SyncProducer(),
+			config.AppConfig().OrderAssemblyFailed.Topic(),
+			logger.Logger(),
+		)
+	}
+	return d.orderAssemblyFailedProducer
+}
+
 // SyncProducer - создает базового producer с указанными брокерами
 func (d *diContainer) SyncProducer() sarama.SyncProducer {
 	if d.syncProducer == nil {
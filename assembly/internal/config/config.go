@@ -13,8 +13,15 @@ var appConfig *config
 type config struct {
 	Logger                 LoggerConfig
 	Kafka                  KafkaConfig
+	ConsumerRetry          ConsumerRetryConfig
 	OrderAssembledProducer OrderAssembledConfig
 	OrderPaidConsumer      OrderPaidConsumerConfig
+	OrderRefundedConsumer  OrderRefundedConsumerConfig
+	OrderAssemblyFailed    OrderAssemblyFailedProducerConfig
+	AssemblyRequested      AssemblyRequestedConsumerConfig
+	AssemblyProgress       AssemblyProgressProducerConfig
+	AssemblyWorker         AssemblyWorkerConfig
+	Postgres               PostgresConfig
 }
 
 func Load(path ...string) error {
@@ -33,6 +40,11 @@ func Load(path ...string) error {
 		return err
 	}
 
+	consumerRetryConfig, err := env.NewConsumerRetryConfig()
+	if err != nil {
+		return err
+	}
+
 	orderAssembledProducerConfig, err := env.NewOrderAssembledProducerConfig()
 	if err != nil {
 		return err
@@ -43,11 +55,48 @@ func Load(path ...string) error {
 		return err
 	}
 
+	orderRefundedConsumerConfig, err := env.NewOrderRefundedConsumerConfig()
+	if err != nil {
+		return err
+	}
+
+	orderAssemblyFailedProducerConfig, err := env.NewOrderAssemblyFailedProducerConfig()
+	if err != nil {
+		return err
+	}
+
+	assemblyRequestedConsumerConfig, err := env.NewAssemblyRequestedConsumerConfig()
+	if err != nil {
+		return err
+	}
+
+	assemblyProgressProducerConfig, err := env.NewAssemblyProgressProducerConfig()
+	if err != nil {
+		return err
+	}
+
+	assemblyWorkerConfig, err := env.NewAssemblyWorkerConfig()
+	if err != nil {
+		return err
+	}
+
+	postgresConfig, err := env.NewPostgresConfig()
+	if err != nil {
+		return err
+	}
+
 	appConfig = &config{
 		Logger:                 loggerConfig,
 		Kafka:                  kafkaConfig,
+		ConsumerRetry:          consumerRetryConfig,
 		OrderAssembledProducer: orderAssembledProducerConfig,
 		OrderPaidConsumer:      orderPaidConsumerConfig,
+		OrderRefundedConsumer:  orderRefundedConsumerConfig,
+		OrderAssemblyFailed:    orderAssemblyFailedProducerConfig,
+		AssemblyRequested:      assemblyRequestedConsumerConfig,
+		AssemblyProgress:       assemblyProgressProducerConfig,
+		AssemblyWorker:         assemblyWorkerConfig,
+		Postgres:               postgresConfig,
 	}
 	return nil
 }
@@ -0,0 +1,30 @@
+package env
+
+import (
+	"github.com/caarlos0/env/v11"
+)
+
+type assemblyProgressProducerEnvConfig struct {
+	StartedTopicName        string `env:"ASSEMBLY_STARTED_TOPIC_NAME,required"`
+	StageCompletedTopicName string `env:"ASSEMBLY_STAGE_COMPLETED_TOPIC_NAME,required"`
+}
+
+type assemblyProgressProducerConfig struct {
+	raw assemblyProgressProducerEnvConfig
+}
+
+func NewAssemblyProgressProducerConfig() (*assemblyProgressProducerConfig, error) {
+	var raw assemblyProgressProducerEnvConfig
+	if err := env.Parse(&raw); err != nil {
+		return nil, err
+	}
+	return &assemblyProgressProducerConfig{raw: raw}, nil
+}
+
+func (cfg *assemblyProgressProducerConfig) StartedTopic() string {
+	return cfg.raw.StartedTopicName
+}
+
+func (cfg *assemblyProgressProducerConfig) StageCompletedTopic() string {
+	return cfg.raw.StageCompletedTopicName
+}
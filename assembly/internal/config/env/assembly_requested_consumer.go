@@ -0,0 +1,47 @@
+package env
+
+import (
+	"time"
+
+	"github.com/IBM/sarama"
+	"github.com/caarlos0/env/v11"
+)
+
+type assemblyRequestedConsumerEnvConfig struct {
+	TopicName      string        `env:"ASSEMBLY_REQUESTED_TOPIC_NAME,required"`
+	GroupID        string        `env:"ASSEMBLY_REQUESTED_CONSUMER_GROUP_ID,required"`
+	DedupRetention time.Duration `env:"ASSEMBLY_REQUESTED_DEDUP_RETENTION,required"`
+}
+
+type assemblyRequestedConsumerConfig struct {
+	raw assemblyRequestedConsumerEnvConfig
+}
+
+func NewAssemblyRequestedConsumerConfig() (*assemblyRequestedConsumerConfig, error) {
+	var raw assemblyRequestedConsumerEnvConfig
+	if err := env.Parse(&raw); err != nil {
+		return nil, err
+	}
+	return &assemblyRequestedConsumerConfig{raw: raw}, nil
+}
+
+func (cfg *assemblyRequestedConsumerConfig) Topic() string {
+	return cfg.raw.TopicName
+}
+
+func (cfg *assemblyRequestedConsumerConfig) GroupID() string {
+	return cfg.raw.GroupID
+}
+
+func (cfg *assemblyRequestedConsumerConfig) DedupRetention() time.Duration {
+	return cfg.raw.DedupRetention
+}
+
+func (cfg *assemblyRequestedConsumerConfig) Config() *sarama.Config {
+	config := sarama.NewConfig()
+	config.Version = sarama.V4_0_0_0
+	config.Consumer.Offsets.Initial = sarama.OffsetOldest
+	config.Consumer.Group.Rebalance.GroupStrategies = []sarama.BalanceStrategy{sarama.NewBalanceStrategyRoundRobin()}
+
+	return config
+}
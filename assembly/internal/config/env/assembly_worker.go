@@ -0,0 +1,52 @@
+package env
+
+import (
+	"time"
+
+	"github.com/caarlos0/env/v11"
+)
+
+type assemblyWorkerEnvConfig struct {
+	Workers            int           `env:"WORKERS_COUNT,required"`
+	PollInterval       time.Duration `env:"JOB_POLL_INTERVAL,required"`
+	JobLease           time.Duration `env:"JOB_LEASE,required"`
+	JobMaxRuns         int           `env:"JOB_MAX_RUNS,required"`
+	BuildTimeScale     float64       `env:"BUILD_TIME_SCALE,required"`
+	FaultInjectionRate float64       `env:"FAULT_INJECTION_RATE,required"`
+}
+
+type assemblyWorkerConfig struct {
+	raw assemblyWorkerEnvConfig
+}
+
+func NewAssemblyWorkerConfig() (*assemblyWorkerConfig, error) {
+	var raw assemblyWorkerEnvConfig
+	if err := env.Parse(&raw); err != nil {
+		return nil, err
+	}
+	return &assemblyWorkerConfig{raw: raw}, nil
+}
+
+func (cfg *assemblyWorkerConfig) Workers() int {
+	return cfg.raw.Workers
+}
+
+func (cfg *assemblyWorkerConfig) PollInterval() time.Duration {
+	return cfg.raw.PollInterval
+}
+
+func (cfg *assemblyWorkerConfig) JobLease() time.Duration {
+	return cfg.raw.JobLease
+}
+
+func (cfg *assemblyWorkerConfig) JobMaxRuns() int {
+	return cfg.raw.JobMaxRuns
+}
+
+func (cfg *assemblyWorkerConfig) BuildTimeScale() float64 {
+	return cfg.raw.BuildTimeScale
+}
+
+func (cfg *assemblyWorkerConfig) FaultInjectionRate() float64 {
+	return cfg.raw.FaultInjectionRate
+}
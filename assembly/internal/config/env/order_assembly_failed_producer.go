@@ -0,0 +1,25 @@
+package env
+
+import (
+	"github.com/caarlos0/env/v11"
+)
+
+type orderAssemblyFailedProducerEnvConfig struct {
+	TopicName string `env:"ORDER_ASSEMBLY_FAILED_TOPIC_NAME,required"`
+}
+
+type orderAssemblyFailedProducerConfig struct {
+	raw orderAssemblyFailedProducerEnvConfig
+}
+
+func NewOrderAssemblyFailedProducerConfig() (*orderAssemblyFailedProducerConfig, error) {
+	var raw orderAssemblyFailedProducerEnvConfig
+	if err := env.Parse(&raw); err != nil {
+		return nil, err
+	}
+	return &orderAssemblyFailedProducerConfig{raw: raw}, nil
+}
+
+func (cfg *orderAssemblyFailedProducerConfig) Topic() string {
+	return cfg.raw.TopicName
+}
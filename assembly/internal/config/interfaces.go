@@ -1,6 +1,10 @@
 package config
 
-import "github.com/IBM/sarama"
+import (
+	"time"
+
+	"github.com/IBM/sarama"
+)
 
 type LoggerConfig interface {
 	Level() string
@@ -14,6 +18,14 @@ type KafkaConfig interface {
 type OrderPaidConsumerConfig interface {
 	Topic() string
 	GroupID() string
+	DedupRetention() time.Duration
+	Config() *sarama.Config
+}
+
+type OrderRefundedConsumerConfig interface {
+	Topic() string
+	GroupID() string
+	DedupRetention() time.Duration
 	Config() *sarama.Config
 }
 
@@ -21,3 +33,41 @@ type OrderAssembledConfig interface {
 	Topic() string
 	Config() *sarama.Config
 }
+
+type OrderAssemblyFailedProducerConfig interface {
+	Topic() string
+}
+
+type AssemblyRequestedConsumerConfig interface {
+	Topic() string
+	GroupID() string
+	DedupRetention() time.Duration
+	Config() *sarama.Config
+}
+
+type ConsumerRetryConfig interface {
+	Attempts() int
+	Backoff() time.Duration
+	MaxBackoff() time.Duration
+	TopicDelays() []time.Duration
+}
+
+type AssemblyProgressProducerConfig interface {
+	StartedTopic() string
+	StageCompletedTopic() string
+}
+
+type AssemblyWorkerConfig interface {
+	Workers() int
+	PollInterval() time.Duration
+	JobLease() time.Duration
+	JobMaxRuns() int
+	BuildTimeScale() float64
+	FaultInjectionRate() float64
+}
+
+type PostgresConfig interface {
+	URI() string
+	DBName() string
+	MigrationsDir() string
+}
@@ -0,0 +1,127 @@
+// Code generated for crafty-ezhik service
+// © Crafty-ezhik 2025.
+
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockAssemblyProgressProducerConfig creates a new instance of MockAssemblyProgressProducerConfig. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockAssemblyProgressProducerConfig(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockAssemblyProgressProducerConfig {
+	mock := &MockAssemblyProgressProducerConfig{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockAssemblyProgressProducerConfig is an autogenerated mock type for the AssemblyProgressProducerConfig type
+type MockAssemblyProgressProducerConfig struct {
+	mock.Mock
+}
+
+type MockAssemblyProgressProducerConfig_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockAssemblyProgressProducerConfig) EXPECT() *MockAssemblyProgressProducerConfig_Expecter {
+	return &MockAssemblyProgressProducerConfig_Expecter{mock: &_m.Mock}
+}
+
+// StageCompletedTopic provides a mock function for the type MockAssemblyProgressProducerConfig
+func (_mock *MockAssemblyProgressProducerConfig) StageCompletedTopic() string {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for StageCompletedTopic")
+	}
+
+	var r0 string
+	if returnFunc, ok := ret.Get(0).(func() string); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	return r0
+}
+
+// MockAssemblyProgressProducerConfig_StageCompletedTopic_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StageCompletedTopic'
+type MockAssemblyProgressProducerConfig_StageCompletedTopic_Call struct {
+	*mock.Call
+}
+
+// StageCompletedTopic is a helper method to define mock.On call
+func (_e *MockAssemblyProgressProducerConfig_Expecter) StageCompletedTopic() *MockAssemblyProgressProducerConfig_StageCompletedTopic_Call {
+	return &MockAssemblyProgressProducerConfig_StageCompletedTopic_Call{Call: _e.mock.On("StageCompletedTopic")}
+}
+
+func (_c *MockAssemblyProgressProducerConfig_StageCompletedTopic_Call) Run(run func()) *MockAssemblyProgressProducerConfig_StageCompletedTopic_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockAssemblyProgressProducerConfig_StageCompletedTopic_Call) Return(s string) *MockAssemblyProgressProducerConfig_StageCompletedTopic_Call {
+	_c.Call.Return(s)
+	return _c
+}
+
+func (_c *MockAssemblyProgressProducerConfig_StageCompletedTopic_Call) RunAndReturn(run func() string) *MockAssemblyProgressProducerConfig_StageCompletedTopic_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// StartedTopic provides a mock function for the type MockAssemblyProgressProducerConfig
+func (_mock *MockAssemblyProgressProducerConfig) StartedTopic() string {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for StartedTopic")
+	}
+
+	var r0 string
+	if returnFunc, ok := ret.Get(0).(func() string); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	return r0
+}
+
+// MockAssemblyProgressProducerConfig_StartedTopic_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StartedTopic'
+type MockAssemblyProgressProducerConfig_StartedTopic_Call struct {
+	*mock.Call
+}
+
+// StartedTopic is a helper method to define mock.On call
+func (_e *MockAssemblyProgressProducerConfig_Expecter) StartedTopic() *MockAssemblyProgressProducerConfig_StartedTopic_Call {
+	return &MockAssemblyProgressProducerConfig_StartedTopic_Call{Call: _e.mock.On("StartedTopic")}
+}
+
+func (_c *MockAssemblyProgressProducerConfig_StartedTopic_Call) Run(run func()) *MockAssemblyProgressProducerConfig_StartedTopic_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockAssemblyProgressProducerConfig_StartedTopic_Call) Return(s string) *MockAssemblyProgressProducerConfig_StartedTopic_Call {
+	_c.Call.Return(s)
+	return _c
+}
+
+func (_c *MockAssemblyProgressProducerConfig_StartedTopic_Call) RunAndReturn(run func() string) *MockAssemblyProgressProducerConfig_StartedTopic_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -0,0 +1,305 @@
+// Code generated for crafty-ezhik service
+// © Crafty-ezhik 2025.
+
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"time"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockAssemblyWorkerConfig creates a new instance of MockAssemblyWorkerConfig. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockAssemblyWorkerConfig(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockAssemblyWorkerConfig {
+	mock := &MockAssemblyWorkerConfig{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockAssemblyWorkerConfig is an autogenerated mock type for the AssemblyWorkerConfig type
+type MockAssemblyWorkerConfig struct {
+	mock.Mock
+}
+
+type MockAssemblyWorkerConfig_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockAssemblyWorkerConfig) EXPECT() *MockAssemblyWorkerConfig_Expecter {
+	return &MockAssemblyWorkerConfig_Expecter{mock: &_m.Mock}
+}
+
+// BuildTimeScale provides a mock function for the type MockAssemblyWorkerConfig
+func (_mock *MockAssemblyWorkerConfig) BuildTimeScale() float64 {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for BuildTimeScale")
+	}
+
+	var r0 float64
+	if returnFunc, ok := ret.Get(0).(func() float64); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(float64)
+	}
+	return r0
+}
+
+// MockAssemblyWorkerConfig_BuildTimeScale_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BuildTimeScale'
+type MockAssemblyWorkerConfig_BuildTimeScale_Call struct {
+	*mock.Call
+}
+
+// BuildTimeScale is a helper method to define mock.On call
+func (_e *MockAssemblyWorkerConfig_Expecter) BuildTimeScale() *MockAssemblyWorkerConfig_BuildTimeScale_Call {
+	return &MockAssemblyWorkerConfig_BuildTimeScale_Call{Call: _e.mock.On("BuildTimeScale")}
+}
+
+func (_c *MockAssemblyWorkerConfig_BuildTimeScale_Call) Run(run func()) *MockAssemblyWorkerConfig_BuildTimeScale_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockAssemblyWorkerConfig_BuildTimeScale_Call) Return(f float64) *MockAssemblyWorkerConfig_BuildTimeScale_Call {
+	_c.Call.Return(f)
+	return _c
+}
+
+func (_c *MockAssemblyWorkerConfig_BuildTimeScale_Call) RunAndReturn(run func() float64) *MockAssemblyWorkerConfig_BuildTimeScale_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FaultInjectionRate provides a mock function for the type MockAssemblyWorkerConfig
+func (_mock *MockAssemblyWorkerConfig) FaultInjectionRate() float64 {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for FaultInjectionRate")
+	}
+
+	var r0 float64
+	if returnFunc, ok := ret.Get(0).(func() float64); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(float64)
+	}
+	return r0
+}
+
+// MockAssemblyWorkerConfig_FaultInjectionRate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FaultInjectionRate'
+type MockAssemblyWorkerConfig_FaultInjectionRate_Call struct {
+	*mock.Call
+}
+
+// FaultInjectionRate is a helper method to define mock.On call
+func (_e *MockAssemblyWorkerConfig_Expecter) FaultInjectionRate() *MockAssemblyWorkerConfig_FaultInjectionRate_Call {
+	return &MockAssemblyWorkerConfig_FaultInjectionRate_Call{Call: _e.mock.On("FaultInjectionRate")}
+}
+
+func (_c *MockAssemblyWorkerConfig_FaultInjectionRate_Call) Run(run func()) *MockAssemblyWorkerConfig_FaultInjectionRate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockAssemblyWorkerConfig_FaultInjectionRate_Call) Return(f float64) *MockAssemblyWorkerConfig_FaultInjectionRate_Call {
+	_c.Call.Return(f)
+	return _c
+}
+
+func (_c *MockAssemblyWorkerConfig_FaultInjectionRate_Call) RunAndReturn(run func() float64) *MockAssemblyWorkerConfig_FaultInjectionRate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// JobLease provides a mock function for the type MockAssemblyWorkerConfig
+func (_mock *MockAssemblyWorkerConfig) JobLease() time.Duration {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for JobLease")
+	}
+
+	var r0 time.Duration
+	if returnFunc, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+	return r0
+}
+
+// MockAssemblyWorkerConfig_JobLease_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'JobLease'
+type MockAssemblyWorkerConfig_JobLease_Call struct {
+	*mock.Call
+}
+
+// JobLease is a helper method to define mock.On call
+func (_e *MockAssemblyWorkerConfig_Expecter) JobLease() *MockAssemblyWorkerConfig_JobLease_Call {
+	return &MockAssemblyWorkerConfig_JobLease_Call{Call: _e.mock.On("JobLease")}
+}
+
+func (_c *MockAssemblyWorkerConfig_JobLease_Call) Run(run func()) *MockAssemblyWorkerConfig_JobLease_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockAssemblyWorkerConfig_JobLease_Call) Return(duration time.Duration) *MockAssemblyWorkerConfig_JobLease_Call {
+	_c.Call.Return(duration)
+	return _c
+}
+
+func (_c *MockAssemblyWorkerConfig_JobLease_Call) RunAndReturn(run func() time.Duration) *MockAssemblyWorkerConfig_JobLease_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// JobMaxRuns provides a mock function for the type MockAssemblyWorkerConfig
+func (_mock *MockAssemblyWorkerConfig) JobMaxRuns() int {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for JobMaxRuns")
+	}
+
+	var r0 int
+	if returnFunc, ok := ret.Get(0).(func() int); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+	return r0
+}
+
+// MockAssemblyWorkerConfig_JobMaxRuns_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'JobMaxRuns'
+type MockAssemblyWorkerConfig_JobMaxRuns_Call struct {
+	*mock.Call
+}
+
+// JobMaxRuns is a helper method to define mock.On call
+func (_e *MockAssemblyWorkerConfig_Expecter) JobMaxRuns() *MockAssemblyWorkerConfig_JobMaxRuns_Call {
+	return &MockAssemblyWorkerConfig_JobMaxRuns_Call{Call: _e.mock.On("JobMaxRuns")}
+}
+
+func (_c *MockAssemblyWorkerConfig_JobMaxRuns_Call) Run(run func()) *MockAssemblyWorkerConfig_JobMaxRuns_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockAssemblyWorkerConfig_JobMaxRuns_Call) Return(n int) *MockAssemblyWorkerConfig_JobMaxRuns_Call {
+	_c.Call.Return(n)
+	return _c
+}
+
+func (_c *MockAssemblyWorkerConfig_JobMaxRuns_Call) RunAndReturn(run func() int) *MockAssemblyWorkerConfig_JobMaxRuns_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PollInterval provides a mock function for the type MockAssemblyWorkerConfig
+func (_mock *MockAssemblyWorkerConfig) PollInterval() time.Duration {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for PollInterval")
+	}
+
+	var r0 time.Duration
+	if returnFunc, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+	return r0
+}
+
+// MockAssemblyWorkerConfig_PollInterval_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PollInterval'
+type MockAssemblyWorkerConfig_PollInterval_Call struct {
+	*mock.Call
+}
+
+// PollInterval is a helper method to define mock.On call
+func (_e *MockAssemblyWorkerConfig_Expecter) PollInterval() *MockAssemblyWorkerConfig_PollInterval_Call {
+	return &MockAssemblyWorkerConfig_PollInterval_Call{Call: _e.mock.On("PollInterval")}
+}
+
+func (_c *MockAssemblyWorkerConfig_PollInterval_Call) Run(run func()) *MockAssemblyWorkerConfig_PollInterval_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockAssemblyWorkerConfig_PollInterval_Call) Return(duration time.Duration) *MockAssemblyWorkerConfig_PollInterval_Call {
+	_c.Call.Return(duration)
+	return _c
+}
+
+func (_c *MockAssemblyWorkerConfig_PollInterval_Call) RunAndReturn(run func() time.Duration) *MockAssemblyWorkerConfig_PollInterval_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Workers provides a mock function for the type MockAssemblyWorkerConfig
+func (_mock *MockAssemblyWorkerConfig) Workers() int {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Workers")
+	}
+
+	var r0 int
+	if returnFunc, ok := ret.Get(0).(func() int); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+	return r0
+}
+
+// MockAssemblyWorkerConfig_Workers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Workers'
+type MockAssemblyWorkerConfig_Workers_Call struct {
+	*mock.Call
+}
+
+// Workers is a helper method to define mock.On call
+func (_e *MockAssemblyWorkerConfig_Expecter) Workers() *MockAssemblyWorkerConfig_Workers_Call {
+	return &MockAssemblyWorkerConfig_Workers_Call{Call: _e.mock.On("Workers")}
+}
+
+func (_c *MockAssemblyWorkerConfig_Workers_Call) Run(run func()) *MockAssemblyWorkerConfig_Workers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockAssemblyWorkerConfig_Workers_Call) Return(n int) *MockAssemblyWorkerConfig_Workers_Call {
+	_c.Call.Return(n)
+	return _c
+}
+
+func (_c *MockAssemblyWorkerConfig_Workers_Call) RunAndReturn(run func() int) *MockAssemblyWorkerConfig_Workers_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -0,0 +1,83 @@
+// Code generated for crafty-ezhik service
+// © Crafty-ezhik 2025.
+
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockOrderAssemblyFailedProducerConfig creates a new instance of MockOrderAssemblyFailedProducerConfig. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockOrderAssemblyFailedProducerConfig(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockOrderAssemblyFailedProducerConfig {
+	mock := &MockOrderAssemblyFailedProducerConfig{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockOrderAssemblyFailedProducerConfig is an autogenerated mock type for the OrderAssemblyFailedProducerConfig type
+type MockOrderAssemblyFailedProducerConfig struct {
+	mock.Mock
+}
+
+type MockOrderAssemblyFailedProducerConfig_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockOrderAssemblyFailedProducerConfig) EXPECT() *MockOrderAssemblyFailedProducerConfig_Expecter {
+	return &MockOrderAssemblyFailedProducerConfig_Expecter{mock: &_m.Mock}
+}
+
+// Topic provides a mock function for the type MockOrderAssemblyFailedProducerConfig
+func (_mock *MockOrderAssemblyFailedProducerConfig) Topic() string {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Topic")
+	}
+
+	var r0 string
+	if returnFunc, ok := ret.Get(0).(func() string); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	return r0
+}
+
+// MockOrderAssemblyFailedProducerConfig_Topic_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Topic'
+type MockOrderAssemblyFailedProducerConfig_Topic_Call struct {
+	*mock.Call
+}
+
+// Topic is a helper method to define mock.On call
+func (_e *MockOrderAssemblyFailedProducerConfig_Expecter) Topic() *MockOrderAssemblyFailedProducerConfig_Topic_Call {
+	return &MockOrderAssemblyFailedProducerConfig_Topic_Call{Call: _e.mock.On("Topic")}
+}
+
+func (_c *MockOrderAssemblyFailedProducerConfig_Topic_Call) Run(run func()) *MockOrderAssemblyFailedProducerConfig_Topic_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockOrderAssemblyFailedProducerConfig_Topic_Call) Return(s string) *MockOrderAssemblyFailedProducerConfig_Topic_Call {
+	_c.Call.Return(s)
+	return _c
+}
+
+func (_c *MockOrderAssemblyFailedProducerConfig_Topic_Call) RunAndReturn(run func() string) *MockOrderAssemblyFailedProducerConfig_Topic_Call {
+	_c.Call.Return(run)
+	return _c
+}
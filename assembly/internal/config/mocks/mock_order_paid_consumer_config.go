@@ -8,6 +8,8 @@
 package mocks
 
 import (
+	"time"
+
 	"github.com/IBM/sarama"
 	mock "github.com/stretchr/testify/mock"
 )
@@ -85,6 +87,50 @@ func (_c *MockOrderPaidConsumerConfig_Config_Call) RunAndReturn(run func() *sara
 	return _c
 }
 
+// DedupRetention provides a mock function for the type MockOrderPaidConsumerConfig
+func (_mock *MockOrderPaidConsumerConfig) DedupRetention() time.Duration {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for DedupRetention")
+	}
+
+	var r0 time.Duration
+	if returnFunc, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+	return r0
+}
+
+// MockOrderPaidConsumerConfig_DedupRetention_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DedupRetention'
+type MockOrderPaidConsumerConfig_DedupRetention_Call struct {
+	*mock.Call
+}
+
+// DedupRetention is a helper method to define mock.On call
+func (_e *MockOrderPaidConsumerConfig_Expecter) DedupRetention() *MockOrderPaidConsumerConfig_DedupRetention_Call {
+	return &MockOrderPaidConsumerConfig_DedupRetention_Call{Call: _e.mock.On("DedupRetention")}
+}
+
+func (_c *MockOrderPaidConsumerConfig_DedupRetention_Call) Run(run func()) *MockOrderPaidConsumerConfig_DedupRetention_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockOrderPaidConsumerConfig_DedupRetention_Call) Return(duration time.Duration) *MockOrderPaidConsumerConfig_DedupRetention_Call {
+	_c.Call.Return(duration)
+	return _c
+}
+
+func (_c *MockOrderPaidConsumerConfig_DedupRetention_Call) RunAndReturn(run func() time.Duration) *MockOrderPaidConsumerConfig_DedupRetention_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GroupID provides a mock function for the type MockOrderPaidConsumerConfig
 func (_mock *MockOrderPaidConsumerConfig) GroupID() string {
 	ret := _mock.Called()
@@ -0,0 +1,54 @@
+package decoder
+
+import (
+	"fmt"
+
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/crafty-ezhik/rocket-factory/assembly/internal/model"
+	eventsV1 "github.com/crafty-ezhik/rocket-factory/shared/pkg/proto/events/v1"
+)
+
+type assemblyRequestedDecoder struct{}
+
+func NewAssemblyRequestedDecoder() *assemblyRequestedDecoder {
+	return &assemblyRequestedDecoder{}
+}
+
+func (d *assemblyRequestedDecoder) Decode(data []byte) (model.AssemblyRequestedEvent, error) {
+	var pb eventsV1.AssemblyRequested
+	if err := proto.Unmarshal(data, &pb); err != nil {
+		return model.AssemblyRequestedEvent{}, fmt.Errorf("failed to unmarshal protobuf: %w", err)
+	}
+
+	var event model.AssemblyRequestedEvent
+
+	eventUUID, err := uuid.Parse(pb.EventUuid)
+	if err != nil {
+		return model.AssemblyRequestedEvent{}, fmt.Errorf("failed to parse event uuid: %w", err)
+	}
+	event.EventUUID = eventUUID
+
+	orderUUID, err := uuid.Parse(pb.OrderUuid)
+	if err != nil {
+		return model.AssemblyRequestedEvent{}, fmt.Errorf("failed to parse order uuid: %w", err)
+	}
+	event.OrderUUID = orderUUID
+
+	userUUID, err := uuid.Parse(pb.UserUuid)
+	if err != nil {
+		return model.AssemblyRequestedEvent{}, fmt.Errorf("failed to parse user uuid: %w", err)
+	}
+	event.UserUUID = userUUID
+
+	parts, err := partsToModel(pb.Parts)
+	if err != nil {
+		return model.AssemblyRequestedEvent{}, err
+	}
+	event.Parts = parts
+
+	event.Attempt = int(pb.Attempt)
+
+	return event, nil
+}
@@ -50,5 +50,37 @@ func (d *decoder) Decode(data []byte) (model.OrderPaidEvent, error) {
 
 	event.PaymentMethod = pb.PaymentMethod
 
+	parts, err := partsToModel(pb.Parts)
+	if err != nil {
+		return model.OrderPaidEvent{}, err
+	}
+	event.Parts = parts
+
 	return event, nil
 }
+
+func partsToModel(pbParts []*eventsV1.AssemblyPart) ([]model.Part, error) {
+	parts := make([]model.Part, 0, len(pbParts))
+	for _, pb := range pbParts {
+		partUUID, err := uuid.Parse(pb.PartUuid)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse part uuid: %w", err)
+		}
+
+		part := model.Part{
+			PartUUID: partUUID,
+			Quantity: pb.Quantity,
+			Category: pb.Category,
+		}
+		if d := pb.GetDimensions(); d != nil {
+			part.Dimensions = &model.Dimensions{
+				Length: d.Length,
+				Width:  d.Width,
+				Height: d.Height,
+				Weight: d.Weight,
+			}
+		}
+		parts = append(parts, part)
+	}
+	return parts, nil
+}
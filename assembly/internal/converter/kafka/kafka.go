@@ -5,3 +5,11 @@ import "github.com/crafty-ezhik/rocket-factory/assembly/internal/model"
 type OrderPaidDecoder interface {
 	Decode(data []byte) (model.OrderPaidEvent, error)
 }
+
+type OrderRefundedDecoder interface {
+	Decode(data []byte) (model.OrderRefundedEvent, error)
+}
+
+type AssemblyRequestedDecoder interface {
+	Decode(data []byte) (model.AssemblyRequestedEvent, error)
+}
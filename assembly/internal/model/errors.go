@@ -0,0 +1,8 @@
+package model
+
+import "errors"
+
+var (
+	ErrNoPendingJobs = errors.New("no pending assembly jobs")
+	ErrJobLeaseLost  = errors.New("assembly job is cancelled or claimed by another worker")
+)
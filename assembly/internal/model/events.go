@@ -1,6 +1,8 @@
 package model
 
 import (
+	"time"
+
 	"github.com/google/uuid"
 )
 
@@ -10,6 +12,43 @@ type OrderPaidEvent struct {
 	UserUUID        uuid.UUID
 	PaymentMethod   string
 	TransactionUUID uuid.UUID
+	Parts           []Part
+}
+
+// AssemblyRequestedEvent - запрос на сборку заказа: первая попытка приходит с оплатой заказа,
+// повторные OrderService запрашивает после ошибки сборки
+type AssemblyRequestedEvent struct {
+	EventUUID uuid.UUID
+	OrderUUID uuid.UUID
+	UserUUID  uuid.UUID
+	Attempt   int
+	Parts     []Part
+}
+
+type OrderRefundedEvent struct {
+	EventUUID uuid.UUID
+	OrderUUID uuid.UUID
+	UserUUID  uuid.UUID
+}
+
+type AssemblyStartedEvent struct {
+	EventUUID          uuid.UUID
+	OrderUUID          uuid.UUID
+	UserUUID           uuid.UUID
+	StagesTotal        int
+	EstimatedBuildTime time.Duration
+	StartedAt          time.Time
+}
+
+type AssemblyStageCompletedEvent struct {
+	EventUUID   uuid.UUID
+	OrderUUID   uuid.UUID
+	UserUUID    uuid.UUID
+	Stage       StageName
+	StageIndex  int
+	StagesTotal int
+	Duration    time.Duration
+	CompletedAt time.Time
 }
 
 type OrderAssembledEvent struct {
@@ -17,4 +56,15 @@ type OrderAssembledEvent struct {
 	OrderUUID    uuid.UUID
 	UserUUID     uuid.UUID
 	BuildTimeSec int
+	AssembledAt  time.Time
+}
+
+type AssemblyFailedEvent struct {
+	EventUUID uuid.UUID
+	OrderUUID uuid.UUID
+	UserUUID  uuid.UUID
+	Stage     StageName
+	Reason    FailureReason
+	Attempt   int
+	FailedAt  time.Time
 }
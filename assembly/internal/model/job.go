@@ -0,0 +1,89 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type JobStatus string
+
+const (
+	JobStatusPENDING   JobStatus = "PENDING"
+	JobStatusRUNNING   JobStatus = "RUNNING"
+	JobStatusCOMPLETED JobStatus = "COMPLETED"
+	JobStatusCANCELLED JobStatus = "CANCELLED"
+	JobStatusFAILED    JobStatus = "FAILED"
+)
+
+func (s JobStatus) String() string {
+	return string(s)
+}
+
+// FailureReason - причина ошибки сборки
+type FailureReason string
+
+const (
+	// FailureReasonPARTDEFECT - на этапе обнаружен брак детали
+	FailureReasonPARTDEFECT FailureReason = "PART_DEFECT"
+	// FailureReasonRETRIESEXHAUSTED - задание слишком много раз прерывалось сбоями обработчиков
+	FailureReasonRETRIESEXHAUSTED FailureReason = "RETRIES_EXHAUSTED"
+)
+
+func (r FailureReason) String() string {
+	return string(r)
+}
+
+type StageName string
+
+const (
+	StageINSPECTION StageName = "INSPECTION"
+	StageHULL       StageName = "HULL"
+	StageWINGS      StageName = "WINGS"
+	StageENGINE     StageName = "ENGINE"
+	StagePORTHOLES  StageName = "PORTHOLES"
+	StageFUEL       StageName = "FUEL"
+	StageTESTING    StageName = "TESTING"
+)
+
+func (s StageName) String() string {
+	return string(s)
+}
+
+// Stage - этап сборки и его длительность
+type Stage struct {
+	Name     StageName
+	Duration time.Duration
+}
+
+// AssemblyJob - задание на сборку заказа.
+//
+//	CurrentStage - индекс этапа, с которого продолжается сборка: все этапы до него завершены.
+//	LeaseUUID меняется при каждом захвате задания обработчиком, Runs считает эти захваты.
+//	Attempt - номер попытки сборки заказа: после ошибки OrderService может запросить повторную сборку
+type AssemblyJob struct {
+	UUID          uuid.UUID
+	OrderUUID     uuid.UUID
+	UserUUID      uuid.UUID
+	Status        JobStatus
+	Stages        []Stage
+	CurrentStage  int
+	Attempt       int
+	Runs          int
+	LeaseUUID     uuid.UUID
+	BuildTime     time.Duration
+	FailureReason FailureReason
+	StartedAt     *time.Time
+	CompletedAt   *time.Time
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
+
+// EstimatedBuildTime - суммарная длительность всех этапов
+func (j AssemblyJob) EstimatedBuildTime() time.Duration {
+	var total time.Duration
+	for _, stage := range j.Stages {
+		total += stage.Duration
+	}
+	return total
+}
@@ -0,0 +1,27 @@
+package model
+
+import "github.com/google/uuid"
+
+// Part - деталь заказа, из которой собирается корабль
+type Part struct {
+	PartUUID   uuid.UUID
+	Quantity   int64
+	Category   string
+	Dimensions *Dimensions
+}
+
+// Dimensions - габариты детали: длина, ширина и высота в см, вес в кг
+type Dimensions struct {
+	Length float64
+	Width  float64
+	Height float64
+	Weight float64
+}
+
+// Категории деталей, значения из inventory.v1.Category
+const (
+	CategoryEngine   = "ENGINE"
+	CategoryFuel     = "FUEL"
+	CategoryPorthole = "PORTHOLE"
+	CategoryWing     = "WING"
+)
@@ -0,0 +1,40 @@
+package assembly_job
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+
+	"github.com/crafty-ezhik/rocket-factory/assembly/internal/model"
+)
+
+// Cancel - отменяет незавершенное задание. Если событие "Заказ оплачен" еще не обработано,
+// создается отмененное задание, и последующий Create его не перезапишет.
+// Задание с ошибкой тоже отменяется, чтобы запоздавший запрос повторной сборки его не возобновил
+func (r *repository) Cancel(ctx context.Context, orderUUID, userUUID uuid.UUID) error {
+	query, args, err := squirrel.Insert(assemblyJobsTable).
+		Columns(fieldJobUUID, fieldOrderUUID, fieldUserUUID, fieldStatus).
+		Values(uuid.New(), orderUUID, userUUID, model.JobStatusCANCELLED.String()).
+		Suffix(fmt.Sprintf(
+			"ON CONFLICT (%s) DO UPDATE SET %s = EXCLUDED.%s, %s = NULL, %s = NULL, %s = now() WHERE %s.%s IN (?, ?, ?)",
+			fieldOrderUUID,
+			fieldStatus, fieldStatus,
+			fieldLeaseUUID,
+			fieldLockedUntil,
+			fieldUpdatedAt,
+			assemblyJobsTable, fieldStatus,
+		), model.JobStatusPENDING.String(), model.JobStatusRUNNING.String(), model.JobStatusFAILED.String()).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("build assembly job cancel: %w", err)
+	}
+
+	_, err = r.pool.Exec(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("cancel assembly job: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,69 @@
+package assembly_job
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	serviceModel "github.com/crafty-ezhik/rocket-factory/assembly/internal/model"
+	"github.com/crafty-ezhik/rocket-factory/assembly/internal/repository/converter"
+	repoModel "github.com/crafty-ezhik/rocket-factory/assembly/internal/repository/model"
+)
+
+// Claim - захватывает задание под новой арендой. SKIP LOCKED не дает двум обработчикам
+// взять одно задание, а истекшая аренда возвращает в работу задания упавших обработчиков
+func (r *repository) Claim(ctx context.Context, lease time.Duration) (serviceModel.AssemblyJob, error) {
+	query, args, err := claimQuery(lease)
+	if err != nil {
+		return serviceModel.AssemblyJob{}, fmt.Errorf("build assembly job claim: %w", err)
+	}
+
+	rows, err := r.pool.Query(ctx, query, args...)
+	if err != nil {
+		return serviceModel.AssemblyJob{}, fmt.Errorf("claim assembly job: %w", err)
+	}
+
+	job, err := pgx.CollectExactlyOneRow(rows, pgx.RowToStructByName[repoModel.AssemblyJob])
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return serviceModel.AssemblyJob{}, serviceModel.ErrNoPendingJobs
+		}
+		return serviceModel.AssemblyJob{}, fmt.Errorf("collect assembly job: %w", err)
+	}
+
+	return converter.AssemblyJobToServiceModel(job), nil
+}
+
+// claimQuery - запрос захвата самого старого доступного задания с арендой на lease
+func claimQuery(lease time.Duration) (string, []any, error) {
+	next := squirrel.Select(fieldJobUUID).
+		From(assemblyJobsTable).
+		Where(squirrel.Or{
+			squirrel.Eq{fieldStatus: serviceModel.JobStatusPENDING.String()},
+			squirrel.And{
+				squirrel.Eq{fieldStatus: serviceModel.JobStatusRUNNING.String()},
+				squirrel.Expr(fieldLockedUntil + " < now()"),
+			},
+		}).
+		OrderBy(fieldCreatedAt).
+		Limit(1).
+		Suffix("FOR UPDATE SKIP LOCKED")
+
+	return squirrel.Update(assemblyJobsTable).
+		Set(fieldStatus, serviceModel.JobStatusRUNNING.String()).
+		Set(fieldRuns, squirrel.Expr(fieldRuns+" + 1")).
+		Set(fieldLeaseUUID, uuid.New()).
+		Set(fieldLockedUntil, squirrel.Expr("now() + make_interval(secs => ?)", lease.Seconds())).
+		Set(fieldStartedAt, squirrel.Expr(fmt.Sprintf("COALESCE(%s, now())", fieldStartedAt))).
+		Set(fieldUpdatedAt, squirrel.Expr("now()")).
+		Where(squirrel.Expr(fieldJobUUID+" = (?)", next)).
+		Suffix("RETURNING " + strings.Join(jobColumns, ", ")).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+}
@@ -0,0 +1,39 @@
+package assembly_job
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClaimQuery(t *testing.T) {
+	query, args, err := claimQuery(90 * time.Second)
+	require.NoError(t, err)
+
+	// Берется самое старое ожидающее задание или задание с истекшей арендой, занятые другими пропускаются
+	assert.Contains(t, query, "WHERE (status = $4 OR (status = $5 AND locked_until < now())) ORDER BY created_at LIMIT 1 FOR UPDATE SKIP LOCKED")
+
+	// Каждый захват считается в runs и выдает новую аренду, время начала сборки сохраняется с первого захвата
+	assert.Contains(t, query, "status = $1, runs = runs + 1, lease_uuid = $2, locked_until = now() + make_interval(secs => $3)")
+	assert.Contains(t, query, "started_at = COALESCE(started_at, now())")
+	assert.Contains(t, query, "RETURNING job_uuid, order_uuid")
+
+	require.Len(t, args, 5)
+	assert.Equal(t, "RUNNING", args[0])
+	assert.IsType(t, uuid.UUID{}, args[1])
+	assert.InDelta(t, 90, args[2], 0)
+	assert.Equal(t, "PENDING", args[3])
+	assert.Equal(t, "RUNNING", args[4])
+}
+
+func TestClaimQueryNewLease(t *testing.T) {
+	_, first, err := claimQuery(time.Minute)
+	require.NoError(t, err)
+	_, second, err := claimQuery(time.Minute)
+	require.NoError(t, err)
+
+	assert.NotEqual(t, first[1], second[1])
+}
@@ -0,0 +1,66 @@
+package assembly_job
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/Masterminds/squirrel"
+
+	"github.com/crafty-ezhik/rocket-factory/assembly/internal/model"
+	"github.com/crafty-ezhik/rocket-factory/assembly/internal/repository/converter"
+)
+
+// Create - сохраняет задание. Повторное событие по той же попытке или отмена, пришедшая раньше него,
+// оставляют существующую запись без изменений. Задание с ошибкой начинается заново, только если
+// пришла следующая попытка сборки
+func (r *repository) Create(ctx context.Context, job model.AssemblyJob) (bool, error) {
+	reset := []string{
+		fieldJobUUID, fieldStatus, fieldStages, fieldAttempt,
+	}
+	cleared := []string{
+		fieldLeaseUUID, fieldLockedUntil, fieldFailureReason, fieldStartedAt, fieldCompletedAt,
+	}
+
+	set := make([]string, 0, len(reset)+len(cleared)+4)
+	for _, field := range reset {
+		set = append(set, fmt.Sprintf("%s = EXCLUDED.%s", field, field))
+	}
+	for _, field := range cleared {
+		set = append(set, field+" = NULL")
+	}
+	set = append(set,
+		fieldCurrentStage+" = 0",
+		fieldRuns+" = 0",
+		fieldBuildTimeMs+" = 0",
+		fieldUpdatedAt+" = now()",
+	)
+
+	query, args, err := squirrel.Insert(assemblyJobsTable).
+		Columns(fieldJobUUID, fieldOrderUUID, fieldUserUUID, fieldStatus, fieldStages, fieldAttempt).
+		Values(
+			job.UUID,
+			job.OrderUUID,
+			job.UserUUID,
+			model.JobStatusPENDING.String(),
+			converter.StagesToRepoModel(job.Stages),
+			job.Attempt,
+		).
+		Suffix(fmt.Sprintf("ON CONFLICT (%s) DO UPDATE SET %s WHERE %s.%s = ? AND %s.%s < EXCLUDED.%s",
+			fieldOrderUUID,
+			strings.Join(set, ", "),
+			assemblyJobsTable, fieldStatus,
+			assemblyJobsTable, fieldAttempt, fieldAttempt,
+		), model.JobStatusFAILED.String()).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return false, fmt.Errorf("build assembly job insert: %w", err)
+	}
+
+	tag, err := r.pool.Exec(ctx, query, args...)
+	if err != nil {
+		return false, fmt.Errorf("insert assembly job: %w", err)
+	}
+	return tag.RowsAffected() == 1, nil
+}
@@ -0,0 +1,39 @@
+package assembly_job
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Masterminds/squirrel"
+
+	"github.com/crafty-ezhik/rocket-factory/assembly/internal/model"
+)
+
+func (r *repository) Fail(ctx context.Context, job model.AssemblyJob) error {
+	query, args, err := squirrel.Update(assemblyJobsTable).
+		Set(fieldStatus, model.JobStatusFAILED.String()).
+		Set(fieldCurrentStage, job.CurrentStage).
+		Set(fieldFailureReason, job.FailureReason.String()).
+		Set(fieldLeaseUUID, nil).
+		Set(fieldLockedUntil, nil).
+		Set(fieldUpdatedAt, squirrel.Expr("now()")).
+		Where(squirrel.Eq{
+			fieldJobUUID:   job.UUID,
+			fieldLeaseUUID: job.LeaseUUID,
+			fieldStatus:    model.JobStatusRUNNING.String(),
+		}).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("build assembly job fail: %w", err)
+	}
+
+	tag, err := r.pool.Exec(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("fail assembly job: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return model.ErrJobLeaseLost
+	}
+	return nil
+}
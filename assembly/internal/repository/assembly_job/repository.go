@@ -0,0 +1,58 @@
+package assembly_job
+
+import (
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	def "github.com/crafty-ezhik/rocket-factory/assembly/internal/repository"
+)
+
+var _ def.AssemblyJobRepository = (*repository)(nil)
+
+const (
+	assemblyJobsTable = "assembly_jobs"
+
+	fieldJobUUID       = "job_uuid"
+	fieldOrderUUID     = "order_uuid"
+	fieldUserUUID      = "user_uuid"
+	fieldStatus        = "status"
+	fieldStages        = "stages"
+	fieldCurrentStage  = "current_stage"
+	fieldAttempt       = "attempt"
+	fieldRuns          = "runs"
+	fieldLeaseUUID     = "lease_uuid"
+	fieldLockedUntil   = "locked_until"
+	fieldBuildTimeMs   = "build_time_ms"
+	fieldFailureReason = "failure_reason"
+	fieldStartedAt     = "started_at"
+	fieldCompletedAt   = "completed_at"
+	fieldCreatedAt     = "created_at"
+	fieldUpdatedAt     = "updated_at"
+)
+
+// jobColumns - колонки в порядке полей repoModel.AssemblyJob
+var jobColumns = []string{
+	fieldJobUUID,
+	fieldOrderUUID,
+	fieldUserUUID,
+	fieldStatus,
+	fieldStages,
+	fieldCurrentStage,
+	fieldAttempt,
+	fieldRuns,
+	fieldLeaseUUID,
+	fieldLockedUntil,
+	fieldBuildTimeMs,
+	fieldFailureReason,
+	fieldStartedAt,
+	fieldCompletedAt,
+	fieldCreatedAt,
+	fieldUpdatedAt,
+}
+
+type repository struct {
+	pool *pgxpool.Pool
+}
+
+func NewRepository(pool *pgxpool.Pool) *repository {
+	return &repository{pool: pool}
+}
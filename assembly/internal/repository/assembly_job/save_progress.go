@@ -0,0 +1,38 @@
+package assembly_job
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+
+	"github.com/crafty-ezhik/rocket-factory/assembly/internal/model"
+)
+
+// SaveProgress - сохраняет текущий этап и продлевает аренду, если задание все еще принадлежит обработчику
+func (r *repository) SaveProgress(ctx context.Context, job model.AssemblyJob, lockedUntil time.Time) error {
+	query, args, err := squirrel.Update(assemblyJobsTable).
+		Set(fieldCurrentStage, job.CurrentStage).
+		Set(fieldLockedUntil, lockedUntil).
+		Set(fieldUpdatedAt, squirrel.Expr("now()")).
+		Where(squirrel.Eq{
+			fieldJobUUID:   job.UUID,
+			fieldLeaseUUID: job.LeaseUUID,
+			fieldStatus:    model.JobStatusRUNNING.String(),
+		}).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("build assembly job progress update: %w", err)
+	}
+
+	tag, err := r.pool.Exec(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("update assembly job progress: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return model.ErrJobLeaseLost
+	}
+	return nil
+}
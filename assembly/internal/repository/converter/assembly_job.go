@@ -0,0 +1,62 @@
+package converter
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	serviceModel "github.com/crafty-ezhik/rocket-factory/assembly/internal/model"
+	repoModel "github.com/crafty-ezhik/rocket-factory/assembly/internal/repository/model"
+)
+
+func AssemblyJobToServiceModel(job repoModel.AssemblyJob) serviceModel.AssemblyJob {
+	var leaseUUID uuid.UUID
+	if job.LeaseUUID != nil {
+		leaseUUID = *job.LeaseUUID
+	}
+
+	var failureReason serviceModel.FailureReason
+	if job.FailureReason != nil {
+		failureReason = serviceModel.FailureReason(*job.FailureReason)
+	}
+
+	return serviceModel.AssemblyJob{
+		UUID:          job.UUID,
+		OrderUUID:     job.OrderUUID,
+		UserUUID:      job.UserUUID,
+		Status:        serviceModel.JobStatus(job.Status),
+		Stages:        StagesToServiceModel(job.Stages),
+		CurrentStage:  job.CurrentStage,
+		Attempt:       job.Attempt,
+		Runs:          job.Runs,
+		LeaseUUID:     leaseUUID,
+		BuildTime:     time.Duration(job.BuildTimeMs) * time.Millisecond,
+		FailureReason: failureReason,
+		StartedAt:     job.StartedAt,
+		CompletedAt:   job.CompletedAt,
+		CreatedAt:     job.CreatedAt,
+		UpdatedAt:     job.UpdatedAt,
+	}
+}
+
+func StagesToServiceModel(stages []repoModel.Stage) []serviceModel.Stage {
+	res := make([]serviceModel.Stage, 0, len(stages))
+	for _, stage := range stages {
+		res = append(res, serviceModel.Stage{
+			Name:     serviceModel.StageName(stage.Name),
+			Duration: time.Duration(stage.DurationMs) * time.Millisecond,
+		})
+	}
+	return res
+}
+
+func StagesToRepoModel(stages []serviceModel.Stage) []repoModel.Stage {
+	res := make([]repoModel.Stage, 0, len(stages))
+	for _, stage := range stages {
+		res = append(res, repoModel.Stage{
+			Name:       stage.Name.String(),
+			DurationMs: stage.Duration.Milliseconds(),
+		})
+	}
+	return res
+}
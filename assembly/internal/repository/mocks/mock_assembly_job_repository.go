@@ -0,0 +1,416 @@
+// Code generated for crafty-ezhik service
+// © Crafty-ezhik 2025.
+
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"context"
+	"time"
+
+	"github.com/crafty-ezhik/rocket-factory/assembly/internal/model"
+	"github.com/google/uuid"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockAssemblyJobRepository creates a new instance of MockAssemblyJobRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockAssemblyJobRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockAssemblyJobRepository {
+	mock := &MockAssemblyJobRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockAssemblyJobRepository is an autogenerated mock type for the AssemblyJobRepository type
+type MockAssemblyJobRepository struct {
+	mock.Mock
+}
+
+type MockAssemblyJobRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockAssemblyJobRepository) EXPECT() *MockAssemblyJobRepository_Expecter {
+	return &MockAssemblyJobRepository_Expecter{mock: &_m.Mock}
+}
+
+// Cancel provides a mock function for the type MockAssemblyJobRepository
+func (_mock *MockAssemblyJobRepository) Cancel(ctx context.Context, orderUUID uuid.UUID, userUUID uuid.UUID) error {
+	ret := _mock.Called(ctx, orderUUID, userUUID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Cancel")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r0 = returnFunc(ctx, orderUUID, userUUID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockAssemblyJobRepository_Cancel_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Cancel'
+type MockAssemblyJobRepository_Cancel_Call struct {
+	*mock.Call
+}
+
+// Cancel is a helper method to define mock.On call
+//   - ctx context.Context
+//   - orderUUID uuid.UUID
+//   - userUUID uuid.UUID
+func (_e *MockAssemblyJobRepository_Expecter) Cancel(ctx interface{}, orderUUID interface{}, userUUID interface{}) *MockAssemblyJobRepository_Cancel_Call {
+	return &MockAssemblyJobRepository_Cancel_Call{Call: _e.mock.On("Cancel", ctx, orderUUID, userUUID)}
+}
+
+func (_c *MockAssemblyJobRepository_Cancel_Call) Run(run func(ctx context.Context, orderUUID uuid.UUID, userUUID uuid.UUID)) *MockAssemblyJobRepository_Cancel_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 uuid.UUID
+		if args[2] != nil {
+			arg2 = args[2].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAssemblyJobRepository_Cancel_Call) Return(err error) *MockAssemblyJobRepository_Cancel_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockAssemblyJobRepository_Cancel_Call) RunAndReturn(run func(ctx context.Context, orderUUID uuid.UUID, userUUID uuid.UUID) error) *MockAssemblyJobRepository_Cancel_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Claim provides a mock function for the type MockAssemblyJobRepository
+func (_mock *MockAssemblyJobRepository) Claim(ctx context.Context, lease time.Duration) (model.AssemblyJob, error) {
+	ret := _mock.Called(ctx, lease)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Claim")
+	}
+
+	var r0 model.AssemblyJob
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, time.Duration) (model.AssemblyJob, error)); ok {
+		return returnFunc(ctx, lease)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, time.Duration) model.AssemblyJob); ok {
+		r0 = returnFunc(ctx, lease)
+	} else {
+		r0 = ret.Get(0).(model.AssemblyJob)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, time.Duration) error); ok {
+		r1 = returnFunc(ctx, lease)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockAssemblyJobRepository_Claim_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Claim'
+type MockAssemblyJobRepository_Claim_Call struct {
+	*mock.Call
+}
+
+// Claim is a helper method to define mock.On call
+//   - ctx context.Context
+//   - lease time.Duration
+func (_e *MockAssemblyJobRepository_Expecter) Claim(ctx interface{}, lease interface{}) *MockAssemblyJobRepository_Claim_Call {
+	return &MockAssemblyJobRepository_Claim_Call{Call: _e.mock.On("Claim", ctx, lease)}
+}
+
+func (_c *MockAssemblyJobRepository_Claim_Call) Run(run func(ctx context.Context, lease time.Duration)) *MockAssemblyJobRepository_Claim_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 time.Duration
+		if args[1] != nil {
+			arg1 = args[1].(time.Duration)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAssemblyJobRepository_Claim_Call) Return(assemblyJob model.AssemblyJob, err error) *MockAssemblyJobRepository_Claim_Call {
+	_c.Call.Return(assemblyJob, err)
+	return _c
+}
+
+func (_c *MockAssemblyJobRepository_Claim_Call) RunAndReturn(run func(ctx context.Context, lease time.Duration) (model.AssemblyJob, error)) *MockAssemblyJobRepository_Claim_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Complete provides a mock function for the type MockAssemblyJobRepository
+func (_mock *MockAssemblyJobRepository) Complete(ctx context.Context, job model.AssemblyJob) error {
+	ret := _mock.Called(ctx, job)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Complete")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, model.AssemblyJob) error); ok {
+		r0 = returnFunc(ctx, job)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockAssemblyJobRepository_Complete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Complete'
+type MockAssemblyJobRepository_Complete_Call struct {
+	*mock.Call
+}
+
+// Complete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - job model.AssemblyJob
+func (_e *MockAssemblyJobRepository_Expecter) Complete(ctx interface{}, job interface{}) *MockAssemblyJobRepository_Complete_Call {
+	return &MockAssemblyJobRepository_Complete_Call{Call: _e.mock.On("Complete", ctx, job)}
+}
+
+func (_c *MockAssemblyJobRepository_Complete_Call) Run(run func(ctx context.Context, job model.AssemblyJob)) *MockAssemblyJobRepository_Complete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 model.AssemblyJob
+		if args[1] != nil {
+			arg1 = args[1].(model.AssemblyJob)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAssemblyJobRepository_Complete_Call) Return(err error) *MockAssemblyJobRepository_Complete_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockAssemblyJobRepository_Complete_Call) RunAndReturn(run func(ctx context.Context, job model.AssemblyJob) error) *MockAssemblyJobRepository_Complete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Create provides a mock function for the type MockAssemblyJobRepository
+func (_mock *MockAssemblyJobRepository) Create(ctx context.Context, job model.AssemblyJob) (bool, error) {
+	ret := _mock.Called(ctx, job)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 bool
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, model.AssemblyJob) (bool, error)); ok {
+		return returnFunc(ctx, job)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, model.AssemblyJob) bool); ok {
+		r0 = returnFunc(ctx, job)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, model.AssemblyJob) error); ok {
+		r1 = returnFunc(ctx, job)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockAssemblyJobRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockAssemblyJobRepository_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - job model.AssemblyJob
+func (_e *MockAssemblyJobRepository_Expecter) Create(ctx interface{}, job interface{}) *MockAssemblyJobRepository_Create_Call {
+	return &MockAssemblyJobRepository_Create_Call{Call: _e.mock.On("Create", ctx, job)}
+}
+
+func (_c *MockAssemblyJobRepository_Create_Call) Run(run func(ctx context.Context, job model.AssemblyJob)) *MockAssemblyJobRepository_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 model.AssemblyJob
+		if args[1] != nil {
+			arg1 = args[1].(model.AssemblyJob)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAssemblyJobRepository_Create_Call) Return(b bool, err error) *MockAssemblyJobRepository_Create_Call {
+	_c.Call.Return(b, err)
+	return _c
+}
+
+func (_c *MockAssemblyJobRepository_Create_Call) RunAndReturn(run func(ctx context.Context, job model.AssemblyJob) (bool, error)) *MockAssemblyJobRepository_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Fail provides a mock function for the type MockAssemblyJobRepository
+func (_mock *MockAssemblyJobRepository) Fail(ctx context.Context, job model.AssemblyJob) error {
+	ret := _mock.Called(ctx, job)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Fail")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, model.AssemblyJob) error); ok {
+		r0 = returnFunc(ctx, job)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockAssemblyJobRepository_Fail_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Fail'
+type MockAssemblyJobRepository_Fail_Call struct {
+	*mock.Call
+}
+
+// Fail is a helper method to define mock.On call
+//   - ctx context.Context
+//   - job model.AssemblyJob
+func (_e *MockAssemblyJobRepository_Expecter) Fail(ctx interface{}, job interface{}) *MockAssemblyJobRepository_Fail_Call {
+	return &MockAssemblyJobRepository_Fail_Call{Call: _e.mock.On("Fail", ctx, job)}
+}
+
+func (_c *MockAssemblyJobRepository_Fail_Call) Run(run func(ctx context.Context, job model.AssemblyJob)) *MockAssemblyJobRepository_Fail_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 model.AssemblyJob
+		if args[1] != nil {
+			arg1 = args[1].(model.AssemblyJob)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAssemblyJobRepository_Fail_Call) Return(err error) *MockAssemblyJobRepository_Fail_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockAssemblyJobRepository_Fail_Call) RunAndReturn(run func(ctx context.Context, job model.AssemblyJob) error) *MockAssemblyJobRepository_Fail_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SaveProgress provides a mock function for the type MockAssemblyJobRepository
+func (_mock *MockAssemblyJobRepository) SaveProgress(ctx context.Context, job model.AssemblyJob, lockedUntil time.Time) error {
+	ret := _mock.Called(ctx, job, lockedUntil)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveProgress")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, model.AssemblyJob, time.Time) error); ok {
+		r0 = returnFunc(ctx, job, lockedUntil)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockAssemblyJobRepository_SaveProgress_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveProgress'
+type MockAssemblyJobRepository_SaveProgress_Call struct {
+	*mock.Call
+}
+
+// SaveProgress is a helper method to define mock.On call
+//   - ctx context.Context
+//   - job model.AssemblyJob
+//   - lockedUntil time.Time
+func (_e *MockAssemblyJobRepository_Expecter) SaveProgress(ctx interface{}, job interface{}, lockedUntil interface{}) *MockAssemblyJobRepository_SaveProgress_Call {
+	return &MockAssemblyJobRepository_SaveProgress_Call{Call: _e.mock.On("SaveProgress", ctx, job, lockedUntil)}
+}
+
+func (_c *MockAssemblyJobRepository_SaveProgress_Call) Run(run func(ctx context.Context, job model.AssemblyJob, lockedUntil time.Time)) *MockAssemblyJobRepository_SaveProgress_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 model.AssemblyJob
+		if args[1] != nil {
+			arg1 = args[1].(model.AssemblyJob)
+		}
+		var arg2 time.Time
+		if args[2] != nil {
+			arg2 = args[2].(time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAssemblyJobRepository_SaveProgress_Call) Return(err error) *MockAssemblyJobRepository_SaveProgress_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockAssemblyJobRepository_SaveProgress_Call) RunAndReturn(run func(ctx context.Context, job model.AssemblyJob, lockedUntil time.Time) error) *MockAssemblyJobRepository_SaveProgress_Call {
+	_c.Call.Return(run)
+	return _c
+}
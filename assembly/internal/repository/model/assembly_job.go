@@ -0,0 +1,32 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type AssemblyJob struct {
+	UUID          uuid.UUID  `db:"job_uuid"`
+	OrderUUID     uuid.UUID  `db:"order_uuid"`
+	UserUUID      uuid.UUID  `db:"user_uuid"`
+	Status        string     `db:"status"`
+	Stages        []Stage    `db:"stages"`
+	CurrentStage  int        `db:"current_stage"`
+	Attempt       int        `db:"attempt"`
+	Runs          int        `db:"runs"`
+	LeaseUUID     *uuid.UUID `db:"lease_uuid"`
+	LockedUntil   *time.Time `db:"locked_until"`
+	BuildTimeMs   int64      `db:"build_time_ms"`
+	FailureReason *string    `db:"failure_reason"`
+	StartedAt     *time.Time `db:"started_at"`
+	CompletedAt   *time.Time `db:"completed_at"`
+	CreatedAt     time.Time  `db:"created_at"`
+	UpdatedAt     time.Time  `db:"updated_at"`
+}
+
+// Stage - этап сборки в колонке stages (JSONB)
+type Stage struct {
+	Name       string `json:"name"`
+	DurationMs int64  `json:"duration_ms"`
+}
@@ -0,0 +1,29 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/crafty-ezhik/rocket-factory/assembly/internal/model"
+)
+
+// AssemblyJobRepository - задания на сборку заказов
+type AssemblyJobRepository interface {
+	// Create - сохраняет задание. Задание с ошибкой заменяется, если job.Attempt больше его попытки.
+	// Возвращает false, если задание на заказ уже есть или заказ отменен
+	Create(ctx context.Context, job model.AssemblyJob) (bool, error)
+	// Claim - захватывает самое старое ожидающее задание или задание, аренда которого истекла.
+	// Если таких нет, возвращает model.ErrNoPendingJobs
+	Claim(ctx context.Context, lease time.Duration) (model.AssemblyJob, error)
+	// SaveProgress - сохраняет индекс текущего этапа и продлевает аренду до lockedUntil.
+	// Если задание отменено или захвачено другим обработчиком, возвращает model.ErrJobLeaseLost
+	SaveProgress(ctx context.Context, job model.AssemblyJob, lockedUntil time.Time) error
+	// Complete - завершает задание с фактическим временем сборки
+	Complete(ctx context.Context, job model.AssemblyJob) error
+	// Fail - завершает задание с ошибкой job.FailureReason
+	Fail(ctx context.Context, job model.AssemblyJob) error
+	// Cancel - отменяет сборку заказа. Если задания еще нет, сохраняет отмену, чтобы сборка не началась
+	Cancel(ctx context.Context, orderUUID, userUUID uuid.UUID) error
+}
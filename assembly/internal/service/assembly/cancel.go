@@ -0,0 +1,15 @@
+package assembly
+
+import (
+	"context"
+
+	"github.com/crafty-ezhik/rocket-factory/assembly/internal/model"
+)
+
+func (s *service) Cancel(ctx context.Context, event model.OrderRefundedEvent) error {
+	// Сразу останавливаем сборку, если она идет в этом процессе. Обработчики других
+	// экземпляров узнают об отмене при сохранении прогресса следующего этапа
+	s.cancellationRegistry.Cancel(event.OrderUUID)
+
+	return s.jobRepo.Cancel(ctx, event.OrderUUID, event.UserUUID)
+}
@@ -0,0 +1,47 @@
+package assembly
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/crafty-ezhik/rocket-factory/assembly/internal/model"
+	"github.com/crafty-ezhik/rocket-factory/platform/pkg/logger"
+)
+
+func (s *service) Enqueue(ctx context.Context, event model.AssemblyRequestedEvent) error {
+	job := model.AssemblyJob{
+		UUID:      uuid.New(),
+		OrderUUID: event.OrderUUID,
+		UserUUID:  event.UserUUID,
+		Stages:    planStages(event.Parts, s.pool.TimeScale),
+		Attempt:   max(event.Attempt, 1),
+	}
+
+	created, err := s.jobRepo.Create(ctx, job)
+	if err != nil {
+		return err
+	}
+	if !created {
+		logger.Info(ctx, "Задание на сборку уже существует или заказ отменен",
+			zap.String("order_uuid", event.OrderUUID.String()),
+			zap.Int("attempt", job.Attempt),
+		)
+		return nil
+	}
+
+	logger.Info(ctx, "Заказ поставлен в очередь на сборку",
+		zap.String("order_uuid", event.OrderUUID.String()),
+		zap.String("job_uuid", job.UUID.String()),
+		zap.Int("attempt", job.Attempt),
+		zap.Int("stages", len(job.Stages)),
+		zap.Duration("estimated_build_time", job.EstimatedBuildTime()),
+	)
+
+	select {
+	case s.wakeup <- struct{}{}:
+	default:
+	}
+	return nil
+}
@@ -0,0 +1,137 @@
+package assembly
+
+import (
+	"errors"
+
+	"github.com/stretchr/testify/mock"
+
+	"github.com/crafty-ezhik/rocket-factory/assembly/internal/model"
+)
+
+func (s *ServiceSuite) expectStarted(job model.AssemblyJob) {
+	s.producer.EXPECT().ProduceAssemblyStarted(s.ctx, mock.MatchedBy(func(e model.AssemblyStartedEvent) bool {
+		return e.EventUUID == eventUUID(job, "started") && e.OrderUUID == job.OrderUUID && e.StagesTotal == len(job.Stages)
+	})).Return(nil).Once()
+}
+
+func (s *ServiceSuite) expectFailed(job model.AssemblyJob, stage model.StageName, reason model.FailureReason) {
+	s.producer.EXPECT().ProduceAssemblyFailed(s.ctx, mock.MatchedBy(func(e model.AssemblyFailedEvent) bool {
+		return e.EventUUID == eventUUID(job, "failed") &&
+			e.OrderUUID == job.OrderUUID &&
+			e.UserUUID == job.UserUUID &&
+			e.Stage == stage &&
+			e.Reason == reason &&
+			e.Attempt == job.Attempt
+	})).Return(nil).Once()
+}
+
+func failedJob(reason model.FailureReason, stage int) func(model.AssemblyJob) bool {
+	return func(j model.AssemblyJob) bool {
+		return j.FailureReason == reason && j.CurrentStage == stage
+	}
+}
+
+func (s *ServiceSuite) TestPartDefect() {
+	s.service.pool.FaultInjectionRate = 0.5
+	s.randomValue = 0.2
+	job := s.newJob()
+
+	s.expectStarted(job)
+	s.repo.EXPECT().SaveProgress(s.ctx, mock.Anything, mock.Anything).Return(nil).Once()
+	s.expectFailed(job, model.StageINSPECTION, model.FailureReasonPARTDEFECT)
+	s.repo.EXPECT().Fail(s.ctx, mock.MatchedBy(failedJob(model.FailureReasonPARTDEFECT, 0))).Return(nil).Once()
+
+	s.Require().NoError(s.service.run(s.ctx, job))
+}
+
+func (s *ServiceSuite) TestPartDefectOnResumedStage() {
+	s.service.pool.FaultInjectionRate = 1
+	s.randomValue = 0
+	job := s.newJob()
+	job.CurrentStage = 1
+
+	s.repo.EXPECT().SaveProgress(s.ctx, mock.Anything, mock.Anything).Return(nil).Once()
+	s.expectFailed(job, model.StageHULL, model.FailureReasonPARTDEFECT)
+	s.repo.EXPECT().Fail(s.ctx, mock.MatchedBy(failedJob(model.FailureReasonPARTDEFECT, 1))).Return(nil).Once()
+
+	s.Require().NoError(s.service.run(s.ctx, job))
+}
+
+func (s *ServiceSuite) TestNoDefectWhenRateIsZero() {
+	s.randomValue = 0
+	job := s.newJob()
+
+	s.expectStarted(job)
+	s.repo.EXPECT().SaveProgress(s.ctx, mock.Anything, mock.Anything).Return(nil).Twice()
+	s.producer.EXPECT().ProduceAssemblyStageCompleted(s.ctx, mock.Anything).Return(nil).Twice()
+	s.producer.EXPECT().ProduceOrderAssembled(s.ctx, mock.Anything).Return(nil).Once()
+	s.repo.EXPECT().Complete(s.ctx, mock.Anything).Return(nil).Once()
+
+	s.Require().NoError(s.service.run(s.ctx, job))
+}
+
+func (s *ServiceSuite) TestRetriesExhausted() {
+	job := s.newJob()
+	job.Runs = s.service.pool.JobMaxRuns + 1
+	job.CurrentStage = 1
+
+	// Этапы не выполняются и события о начале сборки не отправляются
+	s.expectFailed(job, model.StageHULL, model.FailureReasonRETRIESEXHAUSTED)
+	s.repo.EXPECT().Fail(s.ctx, mock.MatchedBy(failedJob(model.FailureReasonRETRIESEXHAUSTED, 1))).Return(nil).Once()
+
+	s.Require().NoError(s.service.run(s.ctx, job))
+}
+
+func (s *ServiceSuite) TestRetriesNotExhaustedOnLastRun() {
+	job := s.newJob()
+	job.Runs = s.service.pool.JobMaxRuns
+	job.CurrentStage = len(job.Stages)
+
+	s.producer.EXPECT().ProduceOrderAssembled(s.ctx, mock.Anything).Return(nil).Once()
+	s.repo.EXPECT().Complete(s.ctx, mock.Anything).Return(nil).Once()
+
+	s.Require().NoError(s.service.run(s.ctx, job))
+}
+
+func (s *ServiceSuite) TestFailLeaseLost() {
+	job := s.newJob()
+	job.Runs = s.service.pool.JobMaxRuns + 1
+
+	// Задание уже отменено или передано другому обработчику: событие отправлено, ошибки нет
+	s.expectFailed(job, model.StageINSPECTION, model.FailureReasonRETRIESEXHAUSTED)
+	s.repo.EXPECT().Fail(s.ctx, mock.Anything).Return(model.ErrJobLeaseLost).Once()
+
+	s.Require().NoError(s.service.run(s.ctx, job))
+}
+
+func (s *ServiceSuite) TestFailRepositoryError() {
+	repoErr := errors.New("database unavailable")
+	job := s.newJob()
+	job.Runs = s.service.pool.JobMaxRuns + 1
+
+	s.expectFailed(job, model.StageINSPECTION, model.FailureReasonRETRIESEXHAUSTED)
+	s.repo.EXPECT().Fail(s.ctx, mock.Anything).Return(repoErr).Once()
+
+	s.ErrorIs(s.service.run(s.ctx, job), repoErr)
+}
+
+func (s *ServiceSuite) TestFailProduceError() {
+	produceErr := errors.New("kafka unavailable")
+	job := s.newJob()
+	job.Runs = s.service.pool.JobMaxRuns + 1
+
+	// Без события задание не завершается, чтобы повторить отправку при следующем захвате
+	s.producer.EXPECT().ProduceAssemblyFailed(s.ctx, mock.Anything).Return(produceErr).Once()
+
+	s.ErrorIs(s.service.run(s.ctx, job), produceErr)
+}
+
+func (s *ServiceSuite) TestFailStageOutOfRange() {
+	job := s.newJob()
+	job.Stages = nil
+
+	s.expectFailed(job, model.StageINSPECTION, model.FailureReasonPARTDEFECT)
+	s.repo.EXPECT().Fail(s.ctx, mock.Anything).Return(nil).Once()
+
+	s.Require().NoError(s.service.fail(s.ctx, job, model.FailureReasonPARTDEFECT))
+}
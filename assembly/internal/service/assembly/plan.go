@@ -0,0 +1,116 @@
+package assembly
+
+import (
+	"time"
+
+	"github.com/crafty-ezhik/rocket-factory/assembly/internal/model"
+)
+
+// categoryStages - этапы монтажа деталей в порядке сборки и базовое время монтажа одной детали
+var categoryStages = []struct {
+	category string
+	stage    model.StageName
+	perUnit  time.Duration
+}{
+	{category: model.CategoryWing, stage: model.StageWINGS, perUnit: 2 * time.Second},
+	{category: model.CategoryEngine, stage: model.StageENGINE, perUnit: 4 * time.Second},
+	{category: model.CategoryPorthole, stage: model.StagePORTHOLES, perUnit: time.Second},
+	{category: model.CategoryFuel, stage: model.StageFUEL, perUnit: 1500 * time.Millisecond},
+}
+
+const (
+	// Детали без известной категории монтируются на этапе сборки корпуса
+	hullPerUnit = time.Second
+
+	inspectionBase    = time.Second
+	inspectionPerUnit = 250 * time.Millisecond
+
+	testingBase     = 2 * time.Second
+	testingPerStage = 500 * time.Millisecond
+
+	// Надбавки за габариты одной детали: за каждый килограмм веса и за каждый кубометр объема
+	perKilogram   = 10 * time.Millisecond
+	perCubicMeter = time.Second
+
+	stagePrecision = 100 * time.Millisecond
+)
+
+// defaultStages - план сборки, если в событии нет деталей (например, от старой версии OrderService)
+var defaultStages = []model.Stage{
+	{Name: model.StageINSPECTION, Duration: 2 * time.Second},
+	{Name: model.StageHULL, Duration: 6 * time.Second},
+	{Name: model.StageTESTING, Duration: 2 * time.Second},
+}
+
+// planStages - составляет этапы сборки: приемка деталей, монтаж по категориям и испытания.
+// Время монтажа растет с количеством, весом и объемом деталей
+func planStages(parts []model.Part, scale float64) []model.Stage {
+	if scale <= 0 {
+		scale = 1
+	}
+
+	if len(parts) == 0 {
+		return scaleStages(defaultStages, scale)
+	}
+
+	var units int64
+	mounting := make(map[model.StageName]time.Duration, len(categoryStages)+1)
+	for _, part := range parts {
+		quantity := max(part.Quantity, 1)
+		units += quantity
+
+		stage, perUnit := model.StageHULL, hullPerUnit
+		for _, cs := range categoryStages {
+			if cs.category == part.Category {
+				stage, perUnit = cs.stage, cs.perUnit
+				break
+			}
+		}
+
+		mounting[stage] += time.Duration(quantity) * (perUnit + dimensionsDuration(part.Dimensions))
+	}
+
+	stages := []model.Stage{{
+		Name:     model.StageINSPECTION,
+		Duration: inspectionBase + time.Duration(units)*inspectionPerUnit,
+	}}
+
+	if d, ok := mounting[model.StageHULL]; ok {
+		stages = append(stages, model.Stage{Name: model.StageHULL, Duration: d})
+	}
+	for _, cs := range categoryStages {
+		if d, ok := mounting[cs.stage]; ok {
+			stages = append(stages, model.Stage{Name: cs.stage, Duration: d})
+		}
+	}
+
+	stages = append(stages, model.Stage{
+		Name:     model.StageTESTING,
+		Duration: testingBase + time.Duration(len(mounting))*testingPerStage,
+	})
+
+	return scaleStages(stages, scale)
+}
+
+// dimensionsDuration - надбавка ко времени монтажа одной детали за ее вес и объем
+func dimensionsDuration(d *model.Dimensions) time.Duration {
+	if d == nil {
+		return 0
+	}
+
+	// Габариты в сантиметрах, переводим объем в кубометры
+	volume := d.Length * d.Width * d.Height / 1e6
+
+	return time.Duration(d.Weight*float64(perKilogram) + volume*float64(perCubicMeter))
+}
+
+func scaleStages(stages []model.Stage, scale float64) []model.Stage {
+	scaled := make([]model.Stage, len(stages))
+	for i, stage := range stages {
+		scaled[i] = model.Stage{
+			Name:     stage.Name,
+			Duration: time.Duration(float64(stage.Duration) * scale).Round(stagePrecision),
+		}
+	}
+	return scaled
+}
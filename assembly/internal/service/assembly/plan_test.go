@@ -0,0 +1,111 @@
+package assembly
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/crafty-ezhik/rocket-factory/assembly/internal/model"
+)
+
+func TestPlanStages(t *testing.T) {
+	tests := []struct {
+		name  string
+		parts []model.Part
+		scale float64
+		want  []model.Stage
+	}{
+		{
+			name:  "без деталей используется план по умолчанию",
+			scale: 1,
+			want:  defaultStages,
+		},
+		{
+			name:  "неположительный множитель не меняет длительность",
+			scale: -1,
+			want:  defaultStages,
+		},
+		{
+			name:  "множитель ускоряет план по умолчанию",
+			scale: 0.1,
+			want: []model.Stage{
+				{Name: model.StageINSPECTION, Duration: 200 * time.Millisecond},
+				{Name: model.StageHULL, Duration: 600 * time.Millisecond},
+				{Name: model.StageTESTING, Duration: 200 * time.Millisecond},
+			},
+		},
+		{
+			name:  "длительность округляется до 100 мс",
+			scale: 0.33,
+			want: []model.Stage{
+				{Name: model.StageINSPECTION, Duration: 700 * time.Millisecond},
+				{Name: model.StageHULL, Duration: 2 * time.Second},
+				{Name: model.StageTESTING, Duration: 700 * time.Millisecond},
+			},
+		},
+		{
+			name: "этапы монтажа идут в порядке сборки, а не в порядке деталей",
+			parts: []model.Part{
+				{Category: model.CategoryFuel, Quantity: 1},
+				{Category: model.CategoryEngine, Quantity: 2},
+				{Category: model.CategoryWing, Quantity: 1},
+			},
+			scale: 1,
+			want: []model.Stage{
+				{Name: model.StageINSPECTION, Duration: 2 * time.Second},
+				{Name: model.StageWINGS, Duration: 2 * time.Second},
+				{Name: model.StageENGINE, Duration: 8 * time.Second},
+				{Name: model.StageFUEL, Duration: 1500 * time.Millisecond},
+				{Name: model.StageTESTING, Duration: 3500 * time.Millisecond},
+			},
+		},
+		{
+			name: "детали неизвестной категории монтируются на этапе корпуса",
+			parts: []model.Part{
+				{Category: "UNKNOWN", Quantity: 0},
+				{Category: model.CategoryPorthole, Quantity: 1},
+			},
+			scale: 1,
+			want: []model.Stage{
+				{Name: model.StageINSPECTION, Duration: 1500 * time.Millisecond},
+				{Name: model.StageHULL, Duration: time.Second},
+				{Name: model.StagePORTHOLES, Duration: time.Second},
+				{Name: model.StageTESTING, Duration: 3 * time.Second},
+			},
+		},
+		{
+			name: "детали одной категории монтируются на одном этапе",
+			parts: []model.Part{
+				{Category: model.CategoryPorthole, Quantity: 1},
+				{Category: model.CategoryPorthole, Quantity: 3},
+			},
+			scale: 1,
+			want: []model.Stage{
+				{Name: model.StageINSPECTION, Duration: 2 * time.Second},
+				{Name: model.StagePORTHOLES, Duration: 4 * time.Second},
+				{Name: model.StageTESTING, Duration: 2500 * time.Millisecond},
+			},
+		},
+		{
+			name: "вес и объем увеличивают время монтажа каждой детали",
+			parts: []model.Part{{
+				Category:   model.CategoryWing,
+				Quantity:   2,
+				Dimensions: &model.Dimensions{Length: 100, Width: 100, Height: 100, Weight: 50},
+			}},
+			scale: 1,
+			want: []model.Stage{
+				{Name: model.StageINSPECTION, Duration: 1500 * time.Millisecond},
+				{Name: model.StageWINGS, Duration: 7 * time.Second},
+				{Name: model.StageTESTING, Duration: 2500 * time.Millisecond},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, planStages(tt.parts, tt.scale))
+		})
+	}
+}
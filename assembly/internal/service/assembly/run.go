@@ -0,0 +1,178 @@
+package assembly
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/crafty-ezhik/rocket-factory/assembly/internal/model"
+	"github.com/crafty-ezhik/rocket-factory/platform/pkg/logger"
+)
+
+// run - выполняет этапы задания, начиная с CurrentStage.
+//
+//	Идентификаторы событий выводятся из задания, поэтому при повторном выполнении после сбоя
+//	события получают те же идентификаторы и отбрасываются дедупликацией получателей
+func (s *service) run(ctx context.Context, job model.AssemblyJob) error {
+	fields := []zap.Field{
+		zap.String("order_uuid", job.OrderUUID.String()),
+		zap.String("job_uuid", job.UUID.String()),
+	}
+
+	assemblyCtx, done := s.cancellationRegistry.Track(ctx, job.OrderUUID)
+	defer done()
+
+	// Задание, которое слишком часто прерывалось падением обработчиков, больше не берем в работу
+	if s.pool.JobMaxRuns > 0 && job.Runs > s.pool.JobMaxRuns {
+		return s.fail(ctx, job, model.FailureReasonRETRIESEXHAUSTED)
+	}
+
+	startedAt := job.CreatedAt
+	if job.StartedAt != nil {
+		startedAt = *job.StartedAt
+	}
+
+	if job.CurrentStage == 0 {
+		logger.Info(ctx, "Сборка заказа начата", fields...)
+
+		err := s.producerService.ProduceAssemblyStarted(ctx, model.AssemblyStartedEvent{
+			EventUUID:          eventUUID(job, "started"),
+			OrderUUID:          job.OrderUUID,
+			UserUUID:           job.UserUUID,
+			StagesTotal:        len(job.Stages),
+			EstimatedBuildTime: job.EstimatedBuildTime(),
+			StartedAt:          startedAt,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	for i := job.CurrentStage; i < len(job.Stages); i++ {
+		stage := job.Stages[i]
+
+		// Продлеваем аренду на время этапа и заодно проверяем, что задание не отменено
+		job.CurrentStage = i
+		err := s.jobRepo.SaveProgress(ctx, job, time.Now().Add(stage.Duration+s.pool.JobLease))
+		if err != nil {
+			if errors.Is(err, model.ErrJobLeaseLost) {
+				logger.Info(ctx, "Сборка остановлена: заказ отменен или задание передано другому обработчику", fields...)
+				return nil
+			}
+			return err
+		}
+
+		stageStartedAt := time.Now()
+		select {
+		case <-assemblyCtx.Done():
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			logger.Info(ctx, "Сборка остановлена: заказ отменен", fields...)
+			return nil
+		case <-time.After(stage.Duration):
+		}
+
+		if s.partDefect() {
+			return s.fail(ctx, job, model.FailureReasonPARTDEFECT)
+		}
+
+		err = s.producerService.ProduceAssemblyStageCompleted(ctx, model.AssemblyStageCompletedEvent{
+			EventUUID:   eventUUID(job, fmt.Sprintf("stage:%d", i)),
+			OrderUUID:   job.OrderUUID,
+			UserUUID:    job.UserUUID,
+			Stage:       stage.Name,
+			StageIndex:  i + 1,
+			StagesTotal: len(job.Stages),
+			Duration:    time.Since(stageStartedAt),
+			CompletedAt: time.Now(),
+		})
+		if err != nil {
+			return err
+		}
+
+		logger.Info(ctx, "Этап сборки завершен", append(fields, zap.String("stage", stage.Name.String()))...)
+	}
+
+	// Заказ мог быть отменен в последний момент, поэтому проверяем еще раз
+	if s.cancellationRegistry.IsCancelled(job.OrderUUID) {
+		logger.Info(ctx, "Сборка остановлена: заказ отменен", fields...)
+		return nil
+	}
+
+	assembledAt := time.Now()
+	job.BuildTime = assembledAt.Sub(startedAt)
+
+	// Событие отправляется до завершения задания: если обработчик упадет между ними,
+	// задание выполнится повторно и отправит событие с тем же идентификатором
+	err := s.producerService.ProduceOrderAssembled(ctx, model.OrderAssembledEvent{
+		EventUUID:    eventUUID(job, "assembled"),
+		OrderUUID:    job.OrderUUID,
+		UserUUID:     job.UserUUID,
+		BuildTimeSec: int(job.BuildTime.Round(time.Second).Seconds()),
+		AssembledAt:  assembledAt,
+	})
+	if err != nil {
+		return err
+	}
+
+	err = s.jobRepo.Complete(ctx, job)
+	if err != nil && !errors.Is(err, model.ErrJobLeaseLost) {
+		return err
+	}
+
+	logger.Info(ctx, "✅ Заказ успешно собран!", append(fields, zap.Duration("build_time", job.BuildTime))...)
+	return nil
+}
+
+// fail - завершает задание ошибкой на текущем этапе и сообщает об этом OrderService.
+//
+//	Как и в случае успеха, событие отправляется до сохранения статуса
+func (s *service) fail(ctx context.Context, job model.AssemblyJob, reason model.FailureReason) error {
+	stage := model.StageINSPECTION
+	if job.CurrentStage < len(job.Stages) {
+		stage = job.Stages[job.CurrentStage].Name
+	}
+
+	err := s.producerService.ProduceAssemblyFailed(ctx, model.AssemblyFailedEvent{
+		EventUUID: eventUUID(job, "failed"),
+		OrderUUID: job.OrderUUID,
+		UserUUID:  job.UserUUID,
+		Stage:     stage,
+		Reason:    reason,
+		Attempt:   job.Attempt,
+		FailedAt:  time.Now(),
+	})
+	if err != nil {
+		return err
+	}
+
+	job.FailureReason = reason
+	err = s.jobRepo.Fail(ctx, job)
+	if err != nil && !errors.Is(err, model.ErrJobLeaseLost) {
+		return err
+	}
+
+	logger.Warn(ctx, "❌ Сборка заказа завершилась ошибкой",
+		zap.String("order_uuid", job.OrderUUID.String()),
+		zap.String("job_uuid", job.UUID.String()),
+		zap.String("stage", stage.String()),
+		zap.String("reason", reason.String()),
+		zap.Int("attempt", job.Attempt),
+	)
+	return nil
+}
+
+// partDefect - имитирует брак детали с вероятностью FaultInjectionRate
+func (s *service) partDefect() bool {
+	return s.pool.FaultInjectionRate > 0 && s.random() < s.pool.FaultInjectionRate
+}
+
+// eventUUID - детерминированный идентификатор события задания
+func eventUUID(job model.AssemblyJob, name string) uuid.UUID {
+	return uuid.NewSHA1(job.UUID, []byte(name))
+}
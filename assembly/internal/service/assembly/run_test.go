@@ -0,0 +1,162 @@
+package assembly
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+
+	"github.com/crafty-ezhik/rocket-factory/assembly/internal/model"
+)
+
+func progressAt(stage int) func(model.AssemblyJob) bool {
+	return func(j model.AssemblyJob) bool {
+		return j.CurrentStage == stage
+	}
+}
+
+func (s *ServiceSuite) expectStageCompleted(job model.AssemblyJob, stage int) {
+	s.repo.EXPECT().SaveProgress(s.ctx, mock.MatchedBy(progressAt(stage)), mock.Anything).Return(nil).Once()
+	s.producer.EXPECT().ProduceAssemblyStageCompleted(s.ctx, mock.MatchedBy(func(e model.AssemblyStageCompletedEvent) bool {
+		return e.EventUUID == eventUUID(job, fmt.Sprintf("stage:%d", stage)) &&
+			e.Stage == job.Stages[stage].Name &&
+			e.StageIndex == stage+1 &&
+			e.StagesTotal == len(job.Stages)
+	})).Return(nil).Once()
+}
+
+func (s *ServiceSuite) expectAssembled(job model.AssemblyJob) {
+	s.producer.EXPECT().ProduceOrderAssembled(s.ctx, mock.MatchedBy(func(e model.OrderAssembledEvent) bool {
+		return e.EventUUID == eventUUID(job, "assembled") && e.OrderUUID == job.OrderUUID
+	})).Return(nil).Once()
+	s.repo.EXPECT().Complete(s.ctx, mock.MatchedBy(progressAt(len(job.Stages)-1))).Return(nil).Once()
+}
+
+func (s *ServiceSuite) TestRunAllStages() {
+	job := s.newJob()
+
+	s.expectStarted(job)
+	s.expectStageCompleted(job, 0)
+	s.expectStageCompleted(job, 1)
+	s.expectAssembled(job)
+
+	s.Require().NoError(s.service.run(s.ctx, job))
+}
+
+func (s *ServiceSuite) TestRunLeaseExtendsPastStage() {
+	job := s.newJob()
+	job.Stages = job.Stages[:1]
+	job.Stages[0].Duration = 10 * time.Millisecond
+
+	s.expectStarted(job)
+	s.repo.EXPECT().SaveProgress(s.ctx, mock.Anything, mock.MatchedBy(func(lockedUntil time.Time) bool {
+		return lockedUntil.After(time.Now().Add(s.service.pool.JobLease))
+	})).Return(nil).Once()
+	s.producer.EXPECT().ProduceAssemblyStageCompleted(s.ctx, mock.Anything).Return(nil).Once()
+	s.producer.EXPECT().ProduceOrderAssembled(s.ctx, mock.Anything).Return(nil).Once()
+	s.repo.EXPECT().Complete(s.ctx, mock.Anything).Return(nil).Once()
+
+	s.Require().NoError(s.service.run(s.ctx, job))
+}
+
+func (s *ServiceSuite) TestRunResumesFromCurrentStage() {
+	job := s.newJob()
+	job.CurrentStage = 1
+	job.Runs = 2
+
+	// Событие о начале сборки и завершенные этапы повторно не отправляются
+	s.expectStageCompleted(job, 1)
+	s.expectAssembled(job)
+
+	s.Require().NoError(s.service.run(s.ctx, job))
+}
+
+func (s *ServiceSuite) TestRunResumesAfterLastStage() {
+	job := s.newJob()
+	job.CurrentStage = len(job.Stages)
+
+	// Все этапы пройдены, но задание не успели завершить
+	s.producer.EXPECT().ProduceOrderAssembled(s.ctx, mock.Anything).Return(nil).Once()
+	s.repo.EXPECT().Complete(s.ctx, mock.Anything).Return(nil).Once()
+
+	s.Require().NoError(s.service.run(s.ctx, job))
+}
+
+func (s *ServiceSuite) TestRunCancelledDuringStage() {
+	job := s.newJob()
+	job.Stages[0].Duration = time.Hour
+
+	s.expectStarted(job)
+	s.repo.EXPECT().SaveProgress(s.ctx, mock.Anything, mock.Anything).
+		Run(func(context.Context, model.AssemblyJob, time.Time) { s.registry.Cancel(job.OrderUUID) }).
+		Return(nil).Once()
+
+	s.Require().NoError(s.service.run(s.ctx, job))
+}
+
+func (s *ServiceSuite) TestRunCancelledBeforeAssembled() {
+	job := s.newJob()
+	job.CurrentStage = len(job.Stages)
+	s.registry.Cancel(job.OrderUUID)
+
+	// Событие о сборке не отправляется, задание завершит отмена
+	s.Require().NoError(s.service.run(s.ctx, job))
+}
+
+func (s *ServiceSuite) TestRunStoppedWithContext() {
+	ctx, cancel := context.WithCancel(s.ctx)
+	defer cancel()
+	s.ctx = ctx
+
+	job := s.newJob()
+	job.Stages[0].Duration = time.Hour
+
+	s.expectStarted(job)
+	s.repo.EXPECT().SaveProgress(ctx, mock.Anything, mock.Anything).
+		Run(func(context.Context, model.AssemblyJob, time.Time) { cancel() }).
+		Return(nil).Once()
+
+	// Остановка сервиса не отменяет заказ: задание подхватят после истечения аренды
+	s.ErrorIs(s.service.run(ctx, job), context.Canceled)
+}
+
+func (s *ServiceSuite) TestRunLeaseLost() {
+	job := s.newJob()
+
+	s.expectStarted(job)
+	s.expectStageCompleted(job, 0)
+	s.repo.EXPECT().SaveProgress(s.ctx, mock.MatchedBy(progressAt(1)), mock.Anything).Return(model.ErrJobLeaseLost).Once()
+
+	s.Require().NoError(s.service.run(s.ctx, job))
+}
+
+func (s *ServiceSuite) TestRunSaveProgressError() {
+	repoErr := errors.New("database unavailable")
+	job := s.newJob()
+
+	s.expectStarted(job)
+	s.repo.EXPECT().SaveProgress(s.ctx, mock.Anything, mock.Anything).Return(repoErr).Once()
+
+	s.ErrorIs(s.service.run(s.ctx, job), repoErr)
+}
+
+func (s *ServiceSuite) TestRunCompleteLeaseLost() {
+	job := s.newJob()
+	job.CurrentStage = len(job.Stages)
+
+	s.producer.EXPECT().ProduceOrderAssembled(s.ctx, mock.Anything).Return(nil).Once()
+	s.repo.EXPECT().Complete(s.ctx, mock.Anything).Return(model.ErrJobLeaseLost).Once()
+
+	s.Require().NoError(s.service.run(s.ctx, job))
+}
+
+func (s *ServiceSuite) TestRunProduceError() {
+	produceErr := errors.New("kafka unavailable")
+	job := s.newJob()
+
+	s.producer.EXPECT().ProduceAssemblyStarted(s.ctx, mock.Anything).Return(produceErr).Once()
+
+	s.ErrorIs(s.service.run(s.ctx, job), produceErr)
+}
@@ -0,0 +1,56 @@
+package assembly
+
+import (
+	"math/rand/v2"
+	"time"
+
+	"github.com/crafty-ezhik/rocket-factory/assembly/internal/repository"
+	def "github.com/crafty-ezhik/rocket-factory/assembly/internal/service"
+)
+
+var _ def.AssemblyService = (*service)(nil)
+
+// WorkerPool - настройки пула обработчиков заданий.
+//
+//	JobLease - на сколько задание закрепляется за обработчиком сверх длительности текущего этапа:
+//	если обработчик упал, по истечении аренды задание подхватит другой.
+//	JobMaxRuns - сколько раз задание можно взять в работу, прежде чем сборка завершится ошибкой.
+//	TimeScale - множитель длительности этапов, например 0.1 ускоряет сборку в 10 раз.
+//	FaultInjectionRate - вероятность брака детали на каждом этапе, от 0 до 1
+type WorkerPool struct {
+	Workers            int
+	PollInterval       time.Duration
+	JobLease           time.Duration
+	JobMaxRuns         int
+	TimeScale          float64
+	FaultInjectionRate float64
+}
+
+type service struct {
+	jobRepo              repository.AssemblyJobRepository
+	producerService      def.OrderProducerService
+	cancellationRegistry def.CancellationRegistry
+	pool                 WorkerPool
+
+	// random - источник случайных чисел от 0 до 1 для имитации брака деталей
+	random func() float64
+
+	// wakeup - будит свободного обработчика, когда появилось новое задание, не дожидаясь опроса
+	wakeup chan struct{}
+}
+
+func NewService(
+	jobRepo repository.AssemblyJobRepository,
+	producerService def.OrderProducerService,
+	cancellationRegistry def.CancellationRegistry,
+	pool WorkerPool,
+) *service {
+	return &service{
+		jobRepo:              jobRepo,
+		producerService:      producerService,
+		cancellationRegistry: cancellationRegistry,
+		pool:                 pool,
+		random:               rand.Float64, //nolint:gosec
+		wakeup:               make(chan struct{}, 1),
+	}
+}
@@ -0,0 +1,69 @@
+package assembly
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/crafty-ezhik/rocket-factory/assembly/internal/model"
+	repoMock "github.com/crafty-ezhik/rocket-factory/assembly/internal/repository/mocks"
+	def "github.com/crafty-ezhik/rocket-factory/assembly/internal/service"
+	"github.com/crafty-ezhik/rocket-factory/assembly/internal/service/cancellation"
+	serviceMock "github.com/crafty-ezhik/rocket-factory/assembly/internal/service/mocks"
+	"github.com/crafty-ezhik/rocket-factory/platform/pkg/logger"
+)
+
+type ServiceSuite struct {
+	suite.Suite
+	ctx      context.Context //nolint:containedctx
+	repo     *repoMock.MockAssemblyJobRepository
+	producer *serviceMock.MockOrderProducerService
+	registry def.CancellationRegistry
+	service  *service
+
+	// randomValue - значение, которое возвращает источник случайных чисел сервиса
+	randomValue float64
+}
+
+func (s *ServiceSuite) SetupSuite() {
+	logger.SetNopLogger()
+}
+
+func (s *ServiceSuite) SetupTest() {
+	s.ctx = context.Background()
+	s.randomValue = 1
+
+	s.repo = repoMock.NewMockAssemblyJobRepository(s.T())
+	s.producer = serviceMock.NewMockOrderProducerService(s.T())
+	s.registry = cancellation.NewRegistry()
+	s.service = NewService(s.repo, s.producer, s.registry, WorkerPool{
+		JobLease:   time.Minute,
+		JobMaxRuns: 3,
+	})
+	s.service.random = func() float64 { return s.randomValue }
+}
+
+func TestServiceIntegration(t *testing.T) {
+	suite.Run(t, new(ServiceSuite))
+}
+
+// newJob - задание из двух мгновенных этапов, взятое в работу впервые
+func (s *ServiceSuite) newJob() model.AssemblyJob {
+	return model.AssemblyJob{
+		UUID:      uuid.New(),
+		OrderUUID: uuid.New(),
+		UserUUID:  uuid.New(),
+		Status:    model.JobStatusRUNNING,
+		Stages: []model.Stage{
+			{Name: model.StageINSPECTION},
+			{Name: model.StageHULL},
+		},
+		Attempt:   1,
+		Runs:      1,
+		LeaseUUID: uuid.New(),
+		CreatedAt: time.Now(),
+	}
+}
@@ -0,0 +1,63 @@
+package assembly
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/crafty-ezhik/rocket-factory/assembly/internal/model"
+	"github.com/crafty-ezhik/rocket-factory/platform/pkg/logger"
+)
+
+func (s *service) RunWorkers(ctx context.Context) error {
+	var wg sync.WaitGroup
+	for i := 0; i < s.pool.Workers; i++ {
+		wg.Add(1)
+		go func(id int) {
+			defer wg.Done()
+			s.work(ctx, id)
+		}(i)
+	}
+
+	wg.Wait()
+	return nil
+}
+
+// work - цикл обработчика: берет задания, пока они есть, затем ждет нового задания или опроса
+func (s *service) work(ctx context.Context, id int) {
+	ticker := time.NewTicker(s.pool.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		err := s.processNext(ctx)
+		if err == nil {
+			continue
+		}
+
+		if ctx.Err() != nil {
+			return
+		}
+		if !errors.Is(err, model.ErrNoPendingJobs) {
+			logger.Error(ctx, "Ошибка обработки задания на сборку", zap.Int("worker", id), zap.Error(err))
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-s.wakeup:
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *service) processNext(ctx context.Context) error {
+	job, err := s.jobRepo.Claim(ctx, s.pool.JobLease)
+	if err != nil {
+		return err
+	}
+
+	return s.run(ctx, job)
+}
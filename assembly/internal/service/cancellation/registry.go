@@ -0,0 +1,73 @@
+package cancellation
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	def "github.com/crafty-ezhik/rocket-factory/assembly/internal/service"
+)
+
+var _ def.CancellationRegistry = (*registry)(nil)
+
+// retention - сколько помнить отмененный заказ: OrderPaid может прийти позже OrderRefunded
+// после ретраев, но не позже времени хранения ключей дедупликации
+const retention = 24 * time.Hour
+
+// registry - реестр отмененных заказов в памяти процесса
+type registry struct {
+	mu        sync.Mutex
+	cancelled map[uuid.UUID]time.Time
+	running   map[uuid.UUID]context.CancelFunc
+}
+
+func NewRegistry() *registry {
+	return &registry{
+		cancelled: make(map[uuid.UUID]time.Time),
+		running:   make(map[uuid.UUID]context.CancelFunc),
+	}
+}
+
+func (r *registry) Track(ctx context.Context, orderUUID uuid.UUID) (context.Context, func()) {
+	trackCtx, cancel := context.WithCancel(ctx)
+
+	r.mu.Lock()
+	r.running[orderUUID] = cancel
+	r.mu.Unlock()
+
+	return trackCtx, func() {
+		r.mu.Lock()
+		delete(r.running, orderUUID)
+		r.mu.Unlock()
+
+		cancel()
+	}
+}
+
+func (r *registry) Cancel(orderUUID uuid.UUID) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	now := time.Now()
+	r.cancelled[orderUUID] = now
+
+	if cancel, ok := r.running[orderUUID]; ok {
+		cancel()
+	}
+
+	for id, cancelledAt := range r.cancelled {
+		if now.Sub(cancelledAt) > retention {
+			delete(r.cancelled, id)
+		}
+	}
+}
+
+func (r *registry) IsCancelled(orderUUID uuid.UUID) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	_, ok := r.cancelled[orderUUID]
+	return ok
+}
@@ -0,0 +1,44 @@
+package assembly_requested_consumer
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	kafkaConv "github.com/crafty-ezhik/rocket-factory/assembly/internal/converter/kafka"
+	def "github.com/crafty-ezhik/rocket-factory/assembly/internal/service"
+	"github.com/crafty-ezhik/rocket-factory/platform/pkg/kafka"
+	"github.com/crafty-ezhik/rocket-factory/platform/pkg/logger"
+)
+
+var _ def.ConsumerService = (*service)(nil)
+
+type service struct {
+	assemblyRequestedConsumer kafka.Consumer
+	assemblyRequestedDecoder  kafkaConv.AssemblyRequestedDecoder
+	assemblyService           def.AssemblyService
+}
+
+func NewService(
+	assemblyRequestedConsumer kafka.Consumer,
+	assemblyRequestedDecoder kafkaConv.AssemblyRequestedDecoder,
+	assemblyService def.AssemblyService,
+) *service {
+	return &service{
+		assemblyRequestedConsumer: assemblyRequestedConsumer,
+		assemblyRequestedDecoder:  assemblyRequestedDecoder,
+		assemblyService:           assemblyService,
+	}
+}
+
+func (s *service) RunConsumer(ctx context.Context) error {
+	logger.Info(ctx, "Starting assemblyRequestedConsumer service")
+
+	err := s.assemblyRequestedConsumer.Consume(ctx, s.AssemblyRequestedHandler)
+	if err != nil {
+		logger.Error(ctx, "Consume from order.assembly-requested topic error", zap.Error(err))
+		return err
+	}
+
+	return nil
+}
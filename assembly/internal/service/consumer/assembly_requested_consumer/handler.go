@@ -0,0 +1,33 @@
+package assembly_requested_consumer
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"github.com/crafty-ezhik/rocket-factory/platform/pkg/kafka"
+	"github.com/crafty-ezhik/rocket-factory/platform/pkg/logger"
+)
+
+// AssemblyRequestedHandler - ставит в очередь повторную сборку заказа после ошибки предыдущей попытки
+func (s *service) AssemblyRequestedHandler(ctx context.Context, msg kafka.Message) error {
+	event, err := s.assemblyRequestedDecoder.Decode(msg.Value)
+	if err != nil {
+		logger.Error(ctx, "Failed to decode AssemblyRequested event", zap.Error(err))
+		return err
+	}
+
+	logger.Info(ctx, "Получен запрос на повторную сборку заказа",
+		zap.String("order_uuid", event.OrderUUID.String()),
+		zap.Int("attempt", event.Attempt),
+		zap.Int("parts", len(event.Parts)),
+	)
+
+	err = s.assemblyService.Enqueue(ctx, event)
+	if err != nil {
+		logger.Error(ctx, "Failed to enqueue assembly job", zap.Error(err))
+		return err
+	}
+
+	return nil
+}
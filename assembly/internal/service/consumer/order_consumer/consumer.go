@@ -14,20 +14,20 @@ import (
 var _ def.ConsumerService = (*service)(nil)
 
 type service struct {
-	orderPaidConsumer     kafka.Consumer
-	orderPaidDecoder      kafkaConv.OrderPaidDecoder
-	orderAssembledService def.OrderProducerService
+	orderPaidConsumer kafka.Consumer
+	orderPaidDecoder  kafkaConv.OrderPaidDecoder
+	assemblyService   def.AssemblyService
 }
 
 func NewService(
 	orderPaidConsumer kafka.Consumer,
 	orderPaidDecoder kafkaConv.OrderPaidDecoder,
-	orderAssembledService def.OrderProducerService,
+	assemblyService def.AssemblyService,
 ) *service {
 	return &service{
-		orderPaidConsumer:     orderPaidConsumer,
-		orderPaidDecoder:      orderPaidDecoder,
-		orderAssembledService: orderAssembledService,
+		orderPaidConsumer: orderPaidConsumer,
+		orderPaidDecoder:  orderPaidDecoder,
+		assemblyService:   assemblyService,
 	}
 }
 
@@ -2,7 +2,6 @@ package order_consumer
 
 import (
 	"context"
-	"time"
 
 	"go.uber.org/zap"
 
@@ -11,6 +10,8 @@ import (
 	"github.com/crafty-ezhik/rocket-factory/platform/pkg/logger"
 )
 
+// OrderPaidHandler - ставит заказ в очередь на сборку и сразу возвращает управление,
+// чтобы сборка не блокировала чтение партиции и не повторялась при ребалансировке
 func (s *service) OrderPaidHandler(ctx context.Context, msg kafka.Message) error {
 	event, err := s.orderPaidDecoder.Decode(msg.Value)
 	if err != nil {
@@ -21,24 +22,19 @@ func (s *service) OrderPaidHandler(ctx context.Context, msg kafka.Message) error
 	logger.Info(ctx, "Получен запрос на сборку заказа",
 		zap.String("order_uuid", event.OrderUUID.String()),
 		zap.String("user_uuid", event.UserUUID.String()),
+		zap.Int("parts", len(event.Parts)),
 	)
-	// Задержка перед отправкой обратного сообщения
-	select {
-	case <-ctx.Done():
-		return ctx.Err()
-	case <-time.After(time.Second * 10):
-		logger.Info(ctx, "✅ Заказ успешно собран!")
-	}
 
-	// Отправляем сообщение о завершении сборки
-	err = s.orderAssembledService.ProduceOrderAssembled(ctx, model.OrderAssembledEvent{
-		EventUUID:    event.EventUUID,
-		OrderUUID:    event.OrderUUID,
-		UserUUID:     event.UserUUID,
-		BuildTimeSec: 10,
+	// Оплата запускает первую попытку сборки, повторные приходят от OrderService отдельным событием
+	err = s.assemblyService.Enqueue(ctx, model.AssemblyRequestedEvent{
+		EventUUID: event.EventUUID,
+		OrderUUID: event.OrderUUID,
+		UserUUID:  event.UserUUID,
+		Attempt:   1,
+		Parts:     event.Parts,
 	})
 	if err != nil {
-		logger.Error(ctx, "Failed to produce order assembled event", zap.Error(err))
+		logger.Error(ctx, "Failed to enqueue assembly job", zap.Error(err))
 		return err
 	}
 
@@ -0,0 +1,44 @@
+package order_refunded_consumer
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	kafkaConv "github.com/crafty-ezhik/rocket-factory/assembly/internal/converter/kafka"
+	def "github.com/crafty-ezhik/rocket-factory/assembly/internal/service"
+	"github.com/crafty-ezhik/rocket-factory/platform/pkg/kafka"
+	"github.com/crafty-ezhik/rocket-factory/platform/pkg/logger"
+)
+
+var _ def.ConsumerService = (*service)(nil)
+
+type service struct {
+	orderRefundedConsumer kafka.Consumer
+	orderRefundedDecoder  kafkaConv.OrderRefundedDecoder
+	assemblyService       def.AssemblyService
+}
+
+func NewService(
+	orderRefundedConsumer kafka.Consumer,
+	orderRefundedDecoder kafkaConv.OrderRefundedDecoder,
+	assemblyService def.AssemblyService,
+) *service {
+	return &service{
+		orderRefundedConsumer: orderRefundedConsumer,
+		orderRefundedDecoder:  orderRefundedDecoder,
+		assemblyService:       assemblyService,
+	}
+}
+
+func (s *service) RunConsumer(ctx context.Context) error {
+	logger.Info(ctx, "Starting orderRefundedConsumer service")
+
+	err := s.orderRefundedConsumer.Consume(ctx, s.OrderRefundedHandler)
+	if err != nil {
+		logger.Error(ctx, "Consume from order.refunded topic error", zap.Error(err))
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,32 @@
+package order_refunded_consumer
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"github.com/crafty-ezhik/rocket-factory/platform/pkg/kafka"
+	"github.com/crafty-ezhik/rocket-factory/platform/pkg/logger"
+)
+
+func (s *service) OrderRefundedHandler(ctx context.Context, msg kafka.Message) error {
+	event, err := s.orderRefundedDecoder.Decode(msg.Value)
+	if err != nil {
+		logger.Error(ctx, "Failed to decode OrderRefunded event", zap.Error(err))
+		return err
+	}
+
+	// Останавливаем сборку, если она идет, и не начинаем ее, если OrderPaid еще не обработан
+	err = s.assemblyService.Cancel(ctx, event)
+	if err != nil {
+		logger.Error(ctx, "Failed to cancel assembly", zap.Error(err))
+		return err
+	}
+
+	logger.Info(ctx, "Сборка заказа отменена",
+		zap.String("order_uuid", event.OrderUUID.String()),
+		zap.String("user_uuid", event.UserUUID.String()),
+	)
+
+	return nil
+}
@@ -0,0 +1,207 @@
+// Code generated for crafty-ezhik service
+// © Crafty-ezhik 2025.
+
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/crafty-ezhik/rocket-factory/assembly/internal/model"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockAssemblyService creates a new instance of MockAssemblyService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockAssemblyService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockAssemblyService {
+	mock := &MockAssemblyService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockAssemblyService is an autogenerated mock type for the AssemblyService type
+type MockAssemblyService struct {
+	mock.Mock
+}
+
+type MockAssemblyService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockAssemblyService) EXPECT() *MockAssemblyService_Expecter {
+	return &MockAssemblyService_Expecter{mock: &_m.Mock}
+}
+
+// Cancel provides a mock function for the type MockAssemblyService
+func (_mock *MockAssemblyService) Cancel(ctx context.Context, event model.OrderRefundedEvent) error {
+	ret := _mock.Called(ctx, event)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Cancel")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, model.OrderRefundedEvent) error); ok {
+		r0 = returnFunc(ctx, event)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockAssemblyService_Cancel_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Cancel'
+type MockAssemblyService_Cancel_Call struct {
+	*mock.Call
+}
+
+// Cancel is a helper method to define mock.On call
+//   - ctx context.Context
+//   - event model.OrderRefundedEvent
+func (_e *MockAssemblyService_Expecter) Cancel(ctx interface{}, event interface{}) *MockAssemblyService_Cancel_Call {
+	return &MockAssemblyService_Cancel_Call{Call: _e.mock.On("Cancel", ctx, event)}
+}
+
+func (_c *MockAssemblyService_Cancel_Call) Run(run func(ctx context.Context, event model.OrderRefundedEvent)) *MockAssemblyService_Cancel_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 model.OrderRefundedEvent
+		if args[1] != nil {
+			arg1 = args[1].(model.OrderRefundedEvent)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAssemblyService_Cancel_Call) Return(err error) *MockAssemblyService_Cancel_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockAssemblyService_Cancel_Call) RunAndReturn(run func(ctx context.Context, event model.OrderRefundedEvent) error) *MockAssemblyService_Cancel_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Enqueue provides a mock function for the type MockAssemblyService
+func (_mock *MockAssemblyService) Enqueue(ctx context.Context, event model.AssemblyRequestedEvent) error {
+	ret := _mock.Called(ctx, event)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Enqueue")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, model.AssemblyRequestedEvent) error); ok {
+		r0 = returnFunc(ctx, event)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockAssemblyService_Enqueue_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Enqueue'
+type MockAssemblyService_Enqueue_Call struct {
+	*mock.Call
+}
+
+// Enqueue is a helper method to define mock.On call
+//   - ctx context.Context
+//   - event model.AssemblyRequestedEvent
+func (_e *MockAssemblyService_Expecter) Enqueue(ctx interface{}, event interface{}) *MockAssemblyService_Enqueue_Call {
+	return &MockAssemblyService_Enqueue_Call{Call: _e.mock.On("Enqueue", ctx, event)}
+}
+
+func (_c *MockAssemblyService_Enqueue_Call) Run(run func(ctx context.Context, event model.AssemblyRequestedEvent)) *MockAssemblyService_Enqueue_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 model.AssemblyRequestedEvent
+		if args[1] != nil {
+			arg1 = args[1].(model.AssemblyRequestedEvent)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAssemblyService_Enqueue_Call) Return(err error) *MockAssemblyService_Enqueue_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockAssemblyService_Enqueue_Call) RunAndReturn(run func(ctx context.Context, event model.AssemblyRequestedEvent) error) *MockAssemblyService_Enqueue_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RunWorkers provides a mock function for the type MockAssemblyService
+func (_mock *MockAssemblyService) RunWorkers(ctx context.Context) error {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RunWorkers")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockAssemblyService_RunWorkers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RunWorkers'
+type MockAssemblyService_RunWorkers_Call struct {
+	*mock.Call
+}
+
+// RunWorkers is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockAssemblyService_Expecter) RunWorkers(ctx interface{}) *MockAssemblyService_RunWorkers_Call {
+	return &MockAssemblyService_RunWorkers_Call{Call: _e.mock.On("RunWorkers", ctx)}
+}
+
+func (_c *MockAssemblyService_RunWorkers_Call) Run(run func(ctx context.Context)) *MockAssemblyService_RunWorkers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAssemblyService_RunWorkers_Call) Return(err error) *MockAssemblyService_RunWorkers_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockAssemblyService_RunWorkers_Call) RunAndReturn(run func(ctx context.Context) error) *MockAssemblyService_RunWorkers_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -41,6 +41,177 @@ func (_m *MockOrderProducerService) EXPECT() *MockOrderProducerService_Expecter
 	return &MockOrderProducerService_Expecter{mock: &_m.Mock}
 }
 
+// ProduceAssemblyFailed provides a mock function for the type MockOrderProducerService
+func (_mock *MockOrderProducerService) ProduceAssemblyFailed(ctx context.Context, event model.AssemblyFailedEvent) error {
+	ret := _mock.Called(ctx, event)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ProduceAssemblyFailed")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, model.AssemblyFailedEvent) error); ok {
+		r0 = returnFunc(ctx, event)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockOrderProducerService_ProduceAssemblyFailed_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ProduceAssemblyFailed'
+type MockOrderProducerService_ProduceAssemblyFailed_Call struct {
+	*mock.Call
+}
+
+// ProduceAssemblyFailed is a helper method to define mock.On call
+//   - ctx context.Context
+//   - event model.AssemblyFailedEvent
+func (_e *MockOrderProducerService_Expecter) ProduceAssemblyFailed(ctx interface{}, event interface{}) *MockOrderProducerService_ProduceAssemblyFailed_Call {
+	return &MockOrderProducerService_ProduceAssemblyFailed_Call{Call: _e.mock.On("ProduceAssemblyFailed", ctx, event)}
+}
+
+func (_c *MockOrderProducerService_ProduceAssemblyFailed_Call) Run(run func(ctx context.Context, event model.AssemblyFailedEvent)) *MockOrderProducerService_ProduceAssemblyFailed_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 model.AssemblyFailedEvent
+		if args[1] != nil {
+			arg1 = args[1].(model.AssemblyFailedEvent)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockOrderProducerService_ProduceAssemblyFailed_Call) Return(err error) *MockOrderProducerService_ProduceAssemblyFailed_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockOrderProducerService_ProduceAssemblyFailed_Call) RunAndReturn(run func(ctx context.Context, event model.AssemblyFailedEvent) error) *MockOrderProducerService_ProduceAssemblyFailed_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ProduceAssemblyStageCompleted provides a mock function for the type MockOrderProducerService
+func (_mock *MockOrderProducerService) ProduceAssemblyStageCompleted(ctx context.Context, event model.AssemblyStageCompletedEvent) error {
+	ret := _mock.Called(ctx, event)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ProduceAssemblyStageCompleted")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, model.AssemblyStageCompletedEvent) error); ok {
+		r0 = returnFunc(ctx, event)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockOrderProducerService_ProduceAssemblyStageCompleted_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ProduceAssemblyStageCompleted'
+type MockOrderProducerService_ProduceAssemblyStageCompleted_Call struct {
+	*mock.Call
+}
+
+// ProduceAssemblyStageCompleted is a helper method to define mock.On call
+//   - ctx context.Context
+//   - event model.AssemblyStageCompletedEvent
+func (_e *MockOrderProducerService_Expecter) ProduceAssemblyStageCompleted(ctx interface{}, event interface{}) *MockOrderProducerService_ProduceAssemblyStageCompleted_Call {
+	return &MockOrderProducerService_ProduceAssemblyStageCompleted_Call{Call: _e.mock.On("ProduceAssemblyStageCompleted", ctx, event)}
+}
+
+func (_c *MockOrderProducerService_ProduceAssemblyStageCompleted_Call) Run(run func(ctx context.Context, event model.AssemblyStageCompletedEvent)) *MockOrderProducerService_ProduceAssemblyStageCompleted_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 model.AssemblyStageCompletedEvent
+		if args[1] != nil {
+			arg1 = args[1].(model.AssemblyStageCompletedEvent)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockOrderProducerService_ProduceAssemblyStageCompleted_Call) Return(err error) *MockOrderProducerService_ProduceAssemblyStageCompleted_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockOrderProducerService_ProduceAssemblyStageCompleted_Call) RunAndReturn(run func(ctx context.Context, event model.AssemblyStageCompletedEvent) error) *MockOrderProducerService_ProduceAssemblyStageCompleted_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ProduceAssemblyStarted provides a mock function for the type MockOrderProducerService
+func (_mock *MockOrderProducerService) ProduceAssemblyStarted(ctx context.Context, event model.AssemblyStartedEvent) error {
+	ret := _mock.Called(ctx, event)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ProduceAssemblyStarted")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, model.AssemblyStartedEvent) error); ok {
+		r0 = returnFunc(ctx, event)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockOrderProducerService_ProduceAssemblyStarted_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ProduceAssemblyStarted'
+type MockOrderProducerService_ProduceAssemblyStarted_Call struct {
+	*mock.Call
+}
+
+// ProduceAssemblyStarted is a helper method to define mock.On call
+//   - ctx context.Context
+//   - event model.AssemblyStartedEvent
+func (_e *MockOrderProducerService_Expecter) ProduceAssemblyStarted(ctx interface{}, event interface{}) *MockOrderProducerService_ProduceAssemblyStarted_Call {
+	return &MockOrderProducerService_ProduceAssemblyStarted_Call{Call: _e.mock.On("ProduceAssemblyStarted", ctx, event)}
+}
+
+func (_c *MockOrderProducerService_ProduceAssemblyStarted_Call) Run(run func(ctx context.Context, event model.AssemblyStartedEvent)) *MockOrderProducerService_ProduceAssemblyStarted_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 model.AssemblyStartedEvent
+		if args[1] != nil {
+			arg1 = args[1].(model.AssemblyStartedEvent)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockOrderProducerService_ProduceAssemblyStarted_Call) Return(err error) *MockOrderProducerService_ProduceAssemblyStarted_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockOrderProducerService_ProduceAssemblyStarted_Call) RunAndReturn(run func(ctx context.Context, event model.AssemblyStartedEvent) error) *MockOrderProducerService_ProduceAssemblyStarted_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ProduceOrderAssembled provides a mock function for the type MockOrderProducerService
 func (_mock *MockOrderProducerService) ProduceOrderAssembled(ctx context.Context, event model.OrderAssembledEvent) error {
 	ret := _mock.Called(ctx, event)
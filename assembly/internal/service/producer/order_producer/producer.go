@@ -2,22 +2,68 @@ package order_producer
 
 import (
 	"context"
+	"time"
 
 	"go.uber.org/zap"
 	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/crafty-ezhik/rocket-factory/assembly/internal/model"
+	def "github.com/crafty-ezhik/rocket-factory/assembly/internal/service"
 	"github.com/crafty-ezhik/rocket-factory/platform/pkg/kafka"
 	"github.com/crafty-ezhik/rocket-factory/platform/pkg/logger"
 	eventsV1 "github.com/crafty-ezhik/rocket-factory/shared/pkg/proto/events/v1"
 )
 
+var _ def.OrderProducerService = (*service)(nil)
+
 type service struct {
-	orderAssembledProducer kafka.Producer
+	assemblyStartedProducer        kafka.Producer
+	assemblyStageCompletedProducer kafka.Producer
+	orderAssembledProducer         kafka.Producer
+	orderAssemblyFailedProducer    kafka.Producer
+}
+
+func NewService(
+	assemblyStartedProducer kafka.Producer,
+	assemblyStageCompletedProducer kafka.Producer,
+	orderAssembledProducer kafka.Producer,
+	orderAssemblyFailedProducer kafka.Producer,
+) *service {
+	return &service{
+		assemblyStartedProducer:        assemblyStartedProducer,
+		assemblyStageCompletedProducer: assemblyStageCompletedProducer,
+		orderAssembledProducer:         orderAssembledProducer,
+		orderAssemblyFailedProducer:    orderAssemblyFailedProducer,
+	}
+}
+
+func (p *service) ProduceAssemblyStarted(ctx context.Context, event model.AssemblyStartedEvent) error {
+	msg := &eventsV1.AssemblyStarted{
+		EventUuid:             event.EventUUID.String(),
+		OrderUuid:             event.OrderUUID.String(),
+		UserUuid:              event.UserUUID.String(),
+		StagesTotal:           int32(event.StagesTotal), //nolint:gosec
+		EstimatedBuildTimeSec: int64(event.EstimatedBuildTime.Round(time.Second).Seconds()),
+		StartedAt:             timestamppb.New(event.StartedAt),
+	}
+
+	return p.send(ctx, p.assemblyStartedProducer, event.OrderUUID.String(), msg)
 }
 
-func NewService(orderAssembledProducer kafka.Producer) *service {
-	return &service{orderAssembledProducer: orderAssembledProducer}
+func (p *service) ProduceAssemblyStageCompleted(ctx context.Context, event model.AssemblyStageCompletedEvent) error {
+	msg := &eventsV1.AssemblyStageCompleted{
+		EventUuid:   event.EventUUID.String(),
+		OrderUuid:   event.OrderUUID.String(),
+		UserUuid:    event.UserUUID.String(),
+		Stage:       event.Stage.String(),
+		StageIndex:  int32(event.StageIndex),  //nolint:gosec
+		StagesTotal: int32(event.StagesTotal), //nolint:gosec
+		DurationSec: int64(event.Duration.Round(time.Second).Seconds()),
+		CompletedAt: timestamppb.New(event.CompletedAt),
+	}
+
+	return p.send(ctx, p.assemblyStageCompletedProducer, event.OrderUUID.String(), msg)
 }
 
 func (p *service) ProduceOrderAssembled(ctx context.Context, event model.OrderAssembledEvent) error {
@@ -26,19 +72,39 @@ func (p *service) ProduceOrderAssembled(ctx context.Context, event model.OrderAs
 		OrderUuid:    event.OrderUUID.String(),
 		UserUuid:     event.UserUUID.String(),
 		BuildTimeSec: int64(event.BuildTimeSec),
+		AssembledAt:  timestamppb.New(event.AssembledAt),
+	}
+
+	return p.send(ctx, p.orderAssembledProducer, event.OrderUUID.String(), msg)
+}
+
+func (p *service) ProduceAssemblyFailed(ctx context.Context, event model.AssemblyFailedEvent) error {
+	msg := &eventsV1.ShipAssemblyFailed{
+		EventUuid: event.EventUUID.String(),
+		OrderUuid: event.OrderUUID.String(),
+		UserUuid:  event.UserUUID.String(),
+		Stage:     event.Stage.String(),
+		Reason:    event.Reason.String(),
+		Attempt:   int32(event.Attempt), //nolint:gosec
+		FailedAt:  timestamppb.New(event.FailedAt),
 	}
 
+	return p.send(ctx, p.orderAssemblyFailedProducer, event.OrderUUID.String(), msg)
+}
+
+// send - отправляет событие с ключом заказа, чтобы события одного заказа попадали в одну партицию по порядку
+func (p *service) send(ctx context.Context, producer kafka.Producer, key string, msg proto.Message) error {
 	// Преобразуем структуру в слайс байт для передачи в kafka
 	payload, err := proto.Marshal(msg)
 	if err != nil {
-		logger.Error(ctx, "Failed to marshal order assembled payload", zap.Error(err))
+		logger.Error(ctx, "Failed to marshal event payload", zap.Error(err))
 		return err
 	}
 
 	// Отправляем сообщение в топик
-	err = p.orderAssembledProducer.Send(ctx, []byte(event.OrderUUID.String()), payload)
+	err = producer.Send(ctx, []byte(key), payload)
 	if err != nil {
-		logger.Error(ctx, "Failed to publish OrderAssembled", zap.Error(err))
+		logger.Error(ctx, "Failed to publish event", zap.Error(err))
 		return err
 	}
 
@@ -3,13 +3,38 @@ package service
 import (
 	"context"
 
+	"github.com/google/uuid"
+
 	"github.com/crafty-ezhik/rocket-factory/assembly/internal/model"
 )
 
 type OrderProducerService interface {
+	ProduceAssemblyStarted(ctx context.Context, event model.AssemblyStartedEvent) error
+	ProduceAssemblyStageCompleted(ctx context.Context, event model.AssemblyStageCompletedEvent) error
 	ProduceOrderAssembled(ctx context.Context, event model.OrderAssembledEvent) error
+	ProduceAssemblyFailed(ctx context.Context, event model.AssemblyFailedEvent) error
+}
+
+// AssemblyService - сборка заказов пулом обработчиков, независимым от чтения Kafka
+type AssemblyService interface {
+	// Enqueue - ставит заказ в очередь на сборку. Повторный запрос той же попытки игнорируется
+	Enqueue(ctx context.Context, event model.AssemblyRequestedEvent) error
+	// Cancel - отменяет сборку заказа, в том числе еще не начатую
+	Cancel(ctx context.Context, event model.OrderRefundedEvent) error
+	// RunWorkers - запускает обработчиков заданий и блокируется до отмены контекста
+	RunWorkers(ctx context.Context) error
 }
 
 type ConsumerService interface {
 	RunConsumer(ctx context.Context) error
 }
+
+// CancellationRegistry - реестр заказов, отмененных после оплаты
+type CancellationRegistry interface {
+	// Track - регистрирует сборку заказа и возвращает контекст, который отменяется при отмене заказа
+	Track(ctx context.Context, orderUUID uuid.UUID) (context.Context, func())
+	// Cancel - помечает заказ отмененным и останавливает его сборку
+	Cancel(orderUUID uuid.UUID)
+	// IsCancelled - сообщает, был ли заказ отменен
+	IsCancelled(orderUUID uuid.UUID) bool
+}
@@ -0,0 +1,3 @@
+-- удаляем задания на сборку
+DROP INDEX IF EXISTS idx_assembly_jobs_claim;
+DROP TABLE IF EXISTS assembly_jobs;
@@ -0,0 +1,22 @@
+-- +goose Up
+
+-- создаем задания на сборку. Один заказ собирается не больше одного раза
+CREATE TABLE assembly_jobs (
+    job_uuid UUID PRIMARY KEY,
+    order_uuid UUID NOT NULL UNIQUE,
+    user_uuid UUID NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'PENDING',
+    stages JSONB NOT NULL DEFAULT '[]',
+    current_stage INTEGER NOT NULL DEFAULT 0,
+    lease_uuid UUID,
+    locked_until TIMESTAMP WITH TIME ZONE,
+    build_time_ms BIGINT NOT NULL DEFAULT 0,
+    started_at TIMESTAMP WITH TIME ZONE,
+    completed_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now()
+);
+
+-- создаем индекс для выборки заданий обработчиками
+CREATE INDEX IF NOT EXISTS idx_assembly_jobs_claim ON assembly_jobs (created_at)
+    WHERE status IN ('PENDING', 'RUNNING');
@@ -0,0 +1,5 @@
+-- удаляем поля ошибки сборки
+ALTER TABLE assembly_jobs
+    DROP COLUMN IF EXISTS failure_reason,
+    DROP COLUMN IF EXISTS runs,
+    DROP COLUMN IF EXISTS attempt;
@@ -0,0 +1,7 @@
+-- +goose Up
+
+-- добавляем номер попытки сборки заказа, счетчик запусков задания и причину ошибки сборки
+ALTER TABLE assembly_jobs
+    ADD COLUMN attempt INTEGER NOT NULL DEFAULT 1,
+    ADD COLUMN runs INTEGER NOT NULL DEFAULT 0,
+    ADD COLUMN failure_reason VARCHAR(30);
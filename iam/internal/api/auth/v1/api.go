@@ -1,6 +1,8 @@
 package v1
 
 import (
+	"net/netip"
+
 	"github.com/crafty-ezhik/rocket-factory/iam/internal/service"
 	authV1 "github.com/crafty-ezhik/rocket-factory/shared/pkg/proto/auth/v1"
 )
@@ -8,9 +10,17 @@ import (
 type api struct {
 	authV1.UnimplementedAuthServiceServer
 
-	service service.AuthService
+	service      service.AuthService
+	tokenService service.TokenService
+
+	trustedProxies []netip.Prefix
 }
 
-func NewAuthAPI(service service.AuthService) *api {
-	return &api{service: service}
+// NewAuthAPI - API аутентификации. trustedProxies - прокси, которым доверяется x-forwarded-for
+func NewAuthAPI(service service.AuthService, tokenService service.TokenService, trustedProxies []netip.Prefix) *api {
+	return &api{
+		service:        service,
+		tokenService:   tokenService,
+		trustedProxies: trustedProxies,
+	}
 }
@@ -0,0 +1,84 @@
+package v1
+
+import (
+	"context"
+	"net"
+	"net/netip"
+	"strings"
+
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+// forwardedForMetadataKey - цепочка адресов клиента и прокси, которую проставляют gRPC-gateway и прокси
+const forwardedForMetadataKey = "x-forwarded-for"
+
+// clientIP - IP-адрес клиента.
+//
+//	x-forwarded-for учитывается, только если соединение пришло от доверенного прокси: иначе клиент
+//	подставит в заголовок любой адрес и обойдет блокировку по IP. Левые значения цепочки тоже может
+//	подставить клиент, поэтому цепочка читается справа и берется первый адрес не из trustedProxies
+func clientIP(ctx context.Context, trustedProxies []netip.Prefix) string {
+	peerIP, ok := peerAddr(ctx)
+	if !ok {
+		return ""
+	}
+	if !isTrustedProxy(peerIP, trustedProxies) {
+		return peerIP.String()
+	}
+
+	md, _ := metadata.FromIncomingContext(ctx)
+	hops := forwardedHops(md.Get(forwardedForMetadataKey))
+
+	ip := peerIP
+	for i := len(hops) - 1; i >= 0; i-- {
+		hop, err := netip.ParseAddr(hops[i])
+		if err != nil {
+			// Дальше цепочке верить нельзя, клиентом считается последний разобранный адрес
+			break
+		}
+		ip = hop.Unmap()
+		if !isTrustedProxy(ip, trustedProxies) {
+			break
+		}
+	}
+	return ip.String()
+}
+
+func peerAddr(ctx context.Context) (netip.Addr, bool) {
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return netip.Addr{}, false
+	}
+
+	host, _, err := net.SplitHostPort(p.Addr.String())
+	if err != nil {
+		host = p.Addr.String()
+	}
+
+	ip, err := netip.ParseAddr(host)
+	if err != nil {
+		return netip.Addr{}, false
+	}
+	return ip.Unmap(), true
+}
+
+// forwardedHops - адреса из всех значений x-forwarded-for в порядке добавления
+func forwardedHops(values []string) []string {
+	var hops []string
+	for _, value := range values {
+		for hop := range strings.SplitSeq(value, ",") {
+			hops = append(hops, strings.TrimSpace(hop))
+		}
+	}
+	return hops
+}
+
+func isTrustedProxy(ip netip.Addr, trustedProxies []netip.Prefix) bool {
+	for _, prefix := range trustedProxies {
+		if prefix.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
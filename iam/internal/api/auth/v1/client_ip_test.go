@@ -0,0 +1,105 @@
+package v1
+
+import (
+	"context"
+	"net"
+
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+
+	"github.com/crafty-ezhik/rocket-factory/iam/internal/model"
+	authV1 "github.com/crafty-ezhik/rocket-factory/shared/pkg/proto/auth/v1"
+)
+
+// clientCtx - входящий контекст соединения с адреса peerIP и с цепочкой x-forwarded-for
+func clientCtx(peerIP string, forwardedFor ...string) context.Context {
+	ctx := peer.NewContext(context.Background(), &peer.Peer{
+		Addr: &net.TCPAddr{IP: net.ParseIP(peerIP), Port: 41000},
+	})
+	if len(forwardedFor) > 0 {
+		ctx = metadata.NewIncomingContext(ctx, metadata.MD{forwardedForMetadataKey: forwardedFor})
+	}
+	return ctx
+}
+
+func (s *ApiSuite) TestClientIP() {
+	tests := []struct {
+		name         string
+		peerIP       string
+		forwardedFor []string
+		expected     string
+	}{
+		{
+			name:     "direct connection",
+			peerIP:   "203.0.113.7",
+			expected: "203.0.113.7",
+		},
+		{
+			name:         "forwarded for is ignored from untrusted peer",
+			peerIP:       "203.0.113.7",
+			forwardedFor: []string{"198.51.100.1"},
+			expected:     "203.0.113.7",
+		},
+		{
+			name:         "trusted proxy forwards client address",
+			peerIP:       "10.0.0.5",
+			forwardedFor: []string{"198.51.100.1"},
+			expected:     "198.51.100.1",
+		},
+		{
+			name:         "spoofed left-most hop is skipped",
+			peerIP:       "10.0.0.5",
+			forwardedFor: []string{"1.2.3.4, 198.51.100.1"},
+			expected:     "198.51.100.1",
+		},
+		{
+			name:         "chain of trusted proxies",
+			peerIP:       "10.0.0.5",
+			forwardedFor: []string{"198.51.100.1, 10.0.0.9", "10.0.0.8"},
+			expected:     "198.51.100.1",
+		},
+		{
+			name:         "all hops trusted",
+			peerIP:       "10.0.0.5",
+			forwardedFor: []string{"10.1.0.1, 10.0.0.9"},
+			expected:     "10.1.0.1",
+		},
+		{
+			name:         "malformed hop stops the chain",
+			peerIP:       "10.0.0.5",
+			forwardedFor: []string{"198.51.100.1, garbage, 10.0.0.9"},
+			expected:     "10.0.0.9",
+		},
+		{
+			name:     "trusted proxy without forwarded for",
+			peerIP:   "10.0.0.5",
+			expected: "10.0.0.5",
+		},
+		{
+			name:         "ipv4-mapped peer",
+			peerIP:       "::ffff:10.0.0.5",
+			forwardedFor: []string{"198.51.100.1"},
+			expected:     "198.51.100.1",
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			s.Equal(tt.expected, clientIP(clientCtx(tt.peerIP, tt.forwardedFor...), s.trustedProxies))
+		})
+	}
+}
+
+func (s *ApiSuite) TestClientIPWithoutPeer() {
+	s.Empty(clientIP(context.Background(), s.trustedProxies))
+}
+
+func (s *ApiSuite) TestLoginUsesClientIP() {
+	ctx := clientCtx("203.0.113.7", "198.51.100.1")
+
+	s.authService.EXPECT().Login(ctx, "login", "password", "203.0.113.7").
+		Return(model.LoginResult{}, model.ErrInvalidCredentials).Once()
+
+	_, err := s.api.Login(ctx, &authV1.LoginRequest{Login: "login", Password: "password"})
+	s.Require().ErrorIs(err, model.ErrInvalidCredentials)
+}
@@ -0,0 +1,19 @@
+package v1
+
+import (
+	"context"
+
+	"github.com/crafty-ezhik/rocket-factory/iam/internal/converter"
+	authV1 "github.com/crafty-ezhik/rocket-factory/shared/pkg/proto/auth/v1"
+)
+
+func (a *api) GetSigningKeys(ctx context.Context, _ *authV1.GetSigningKeysRequest) (*authV1.GetSigningKeysResponse, error) {
+	keys, err := a.tokenService.SigningKeys(ctx)
+	if err != nil {
+		return &authV1.GetSigningKeysResponse{}, err
+	}
+
+	return &authV1.GetSigningKeysResponse{
+		Keys: converter.SigningKeysToProto(keys),
+	}, nil
+}
@@ -0,0 +1,26 @@
+package v1
+
+import (
+	"context"
+
+	"github.com/crafty-ezhik/rocket-factory/iam/internal/converter"
+	"github.com/crafty-ezhik/rocket-factory/iam/internal/interceptor"
+	authV1 "github.com/crafty-ezhik/rocket-factory/shared/pkg/proto/auth/v1"
+)
+
+func (a *api) ListSessions(ctx context.Context, req *authV1.ListSessionsRequest) (*authV1.ListSessionsResponse, error) {
+	userUUID, err := interceptor.TargetUserUUID(ctx, req.UserUuid)
+	if err != nil {
+		return &authV1.ListSessionsResponse{}, err
+	}
+
+	sessions, err := a.service.ListSessions(ctx, userUUID)
+	if err != nil {
+		return &authV1.ListSessionsResponse{}, err
+	}
+
+	caller, _ := interceptor.SessionFromContext(ctx)
+	return &authV1.ListSessionsResponse{
+		Sessions: converter.SessionSummariesToProto(sessions, caller.Session.UUID),
+	}, nil
+}
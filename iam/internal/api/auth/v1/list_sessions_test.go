@@ -0,0 +1,110 @@
+package v1
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/crafty-ezhik/rocket-factory/iam/internal/model"
+	authV1 "github.com/crafty-ezhik/rocket-factory/shared/pkg/proto/auth/v1"
+)
+
+func (s *ApiSuite) TestListSessionsSuccess() {
+	createdAt := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	otherSession := model.Session{
+		UUID:      uuid.MustParse("00000000-0000-0000-0000-000000000033"),
+		CreatedAt: createdAt,
+		ExpiresAt: createdAt.Add(time.Hour),
+	}
+
+	tests := []struct {
+		name         string
+		ctx          context.Context
+		req          *authV1.ListSessionsRequest
+		userUUID     uuid.UUID
+		sessions     []model.Session
+		expectedLen  int
+		currentIndex int
+	}{
+		{
+			name:         "own sessions without user_uuid",
+			ctx:          s.customerCtx,
+			req:          &authV1.ListSessionsRequest{},
+			userUUID:     s.customer.User.UUID,
+			sessions:     []model.Session{s.customer.Session, otherSession},
+			expectedLen:  2,
+			currentIndex: 0,
+		},
+		{
+			name:         "own sessions with own user_uuid",
+			ctx:          s.customerCtx,
+			req:          &authV1.ListSessionsRequest{UserUuid: s.customer.User.UUID.String()},
+			userUUID:     s.customer.User.UUID,
+			sessions:     []model.Session{s.customer.Session},
+			expectedLen:  1,
+			currentIndex: 0,
+		},
+		{
+			name:         "admin lists sessions of another user",
+			ctx:          s.adminCtx,
+			req:          &authV1.ListSessionsRequest{UserUuid: s.otherUserUUID.String()},
+			userUUID:     s.otherUserUUID,
+			sessions:     []model.Session{otherSession},
+			expectedLen:  1,
+			currentIndex: -1,
+		},
+	}
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			s.authService.On("ListSessions", tt.ctx, tt.userUUID).
+				Return(tt.sessions, nil).
+				Once()
+
+			res, err := s.api.ListSessions(tt.ctx, tt.req)
+			s.Require().NoError(err)
+			s.Require().Len(res.GetSessions(), tt.expectedLen)
+
+			for i, summary := range res.GetSessions() {
+				// UUID сессии - учетные данные, наружу уходит только непрозрачный идентификатор
+				s.Require().Equal(tt.sessions[i].PublicID(), summary.GetId())
+				s.Require().NotContains(summary.GetId(), tt.sessions[i].UUID.String())
+				s.Require().Equal(i == tt.currentIndex, summary.GetCurrent())
+			}
+		})
+	}
+}
+
+func (s *ApiSuite) TestListSessionsFailure() {
+	tests := []struct {
+		name        string
+		ctx         context.Context
+		req         *authV1.ListSessionsRequest
+		expectedErr error
+	}{
+		{
+			name:        "no session in context",
+			ctx:         context.Background(),
+			req:         &authV1.ListSessionsRequest{},
+			expectedErr: model.ErrUnauthenticated,
+		},
+		{
+			name:        "customer lists sessions of another user",
+			ctx:         s.customerCtx,
+			req:         &authV1.ListSessionsRequest{UserUuid: s.otherUserUUID.String()},
+			expectedErr: model.ErrUserAccessDenied,
+		},
+		{
+			name:        "invalid user uuid",
+			ctx:         s.customerCtx,
+			req:         &authV1.ListSessionsRequest{UserUuid: "not-a-uuid"},
+			expectedErr: model.ErrInvalidUserUUID,
+		},
+	}
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			_, err := s.api.ListSessions(tt.ctx, tt.req)
+			s.Require().ErrorIs(err, tt.expectedErr)
+		})
+	}
+}
@@ -3,6 +3,7 @@ package v1
 import (
 	"context"
 
+	"github.com/crafty-ezhik/rocket-factory/iam/internal/converter"
 	"github.com/crafty-ezhik/rocket-factory/iam/internal/model"
 	authV1 "github.com/crafty-ezhik/rocket-factory/shared/pkg/proto/auth/v1"
 )
@@ -16,12 +17,10 @@ func (a *api) Login(ctx context.Context, req *authV1.LoginRequest) (*authV1.Logi
 		return &authV1.LoginResponse{}, model.ErrInvalidCredentials
 	}
 
-	sessionUUID, err := a.service.Login(ctx, req.Login, req.Password)
+	result, err := a.service.Login(ctx, req.Login, req.Password, clientIP(ctx, a.trustedProxies))
 	if err != nil {
 		return &authV1.LoginResponse{}, err
 	}
 
-	return &authV1.LoginResponse{
-		SessionUuid: sessionUUID.String(),
-	}, nil
+	return converter.LoginResultToProto(result), nil
 }
@@ -0,0 +1,28 @@
+package v1
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/crafty-ezhik/rocket-factory/iam/internal/model"
+	authV1 "github.com/crafty-ezhik/rocket-factory/shared/pkg/proto/auth/v1"
+)
+
+func (a *api) Logout(ctx context.Context, req *authV1.LogoutRequest) (*authV1.LogoutResponse, error) {
+	if req.SessionUuid == "" {
+		return &authV1.LogoutResponse{}, model.ErrSessionUUIDIsMissing
+	}
+
+	sessionUUID, err := uuid.Parse(req.SessionUuid)
+	if err != nil {
+		return &authV1.LogoutResponse{}, model.ErrInvalidSessionUUID
+	}
+
+	err = a.service.Logout(ctx, sessionUUID)
+	if err != nil {
+		return &authV1.LogoutResponse{}, err
+	}
+
+	return &authV1.LogoutResponse{}, nil
+}
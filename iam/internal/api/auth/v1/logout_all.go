@@ -0,0 +1,24 @@
+package v1
+
+import (
+	"context"
+
+	"github.com/crafty-ezhik/rocket-factory/iam/internal/interceptor"
+	authV1 "github.com/crafty-ezhik/rocket-factory/shared/pkg/proto/auth/v1"
+)
+
+func (a *api) LogoutAll(ctx context.Context, req *authV1.LogoutAllRequest) (*authV1.LogoutAllResponse, error) {
+	userUUID, err := interceptor.TargetUserUUID(ctx, req.UserUuid)
+	if err != nil {
+		return &authV1.LogoutAllResponse{}, err
+	}
+
+	revoked, err := a.service.LogoutAll(ctx, userUUID)
+	if err != nil {
+		return &authV1.LogoutAllResponse{}, err
+	}
+
+	return &authV1.LogoutAllResponse{
+		RevokedCount: int32(revoked), //nolint:gosec
+	}, nil
+}
@@ -0,0 +1,71 @@
+package v1
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/crafty-ezhik/rocket-factory/iam/internal/model"
+	authV1 "github.com/crafty-ezhik/rocket-factory/shared/pkg/proto/auth/v1"
+)
+
+func (s *ApiSuite) TestLogoutAllSuccess() {
+	tests := []struct {
+		name     string
+		ctx      context.Context
+		req      *authV1.LogoutAllRequest
+		userUUID uuid.UUID
+	}{
+		{
+			name:     "own sessions",
+			ctx:      s.customerCtx,
+			req:      &authV1.LogoutAllRequest{},
+			userUUID: s.customer.User.UUID,
+		},
+		{
+			name:     "admin logs out another user",
+			ctx:      s.adminCtx,
+			req:      &authV1.LogoutAllRequest{UserUuid: s.otherUserUUID.String()},
+			userUUID: s.otherUserUUID,
+		},
+	}
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			s.authService.On("LogoutAll", tt.ctx, tt.userUUID).
+				Return(2, nil).
+				Once()
+
+			res, err := s.api.LogoutAll(tt.ctx, tt.req)
+			s.Require().NoError(err)
+			s.Require().Equal(int32(2), res.GetRevokedCount())
+		})
+	}
+}
+
+func (s *ApiSuite) TestLogoutAllFailure() {
+	tests := []struct {
+		name        string
+		ctx         context.Context
+		req         *authV1.LogoutAllRequest
+		expectedErr error
+	}{
+		{
+			name:        "no session in context",
+			ctx:         context.Background(),
+			req:         &authV1.LogoutAllRequest{UserUuid: s.customer.User.UUID.String()},
+			expectedErr: model.ErrUnauthenticated,
+		},
+		{
+			name:        "customer logs out another user",
+			ctx:         s.customerCtx,
+			req:         &authV1.LogoutAllRequest{UserUuid: s.otherUserUUID.String()},
+			expectedErr: model.ErrUserAccessDenied,
+		},
+	}
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			_, err := s.api.LogoutAll(tt.ctx, tt.req)
+			s.Require().ErrorIs(err, tt.expectedErr)
+		})
+	}
+}
@@ -0,0 +1,31 @@
+package v1
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/crafty-ezhik/rocket-factory/iam/internal/converter"
+	"github.com/crafty-ezhik/rocket-factory/iam/internal/model"
+	authV1 "github.com/crafty-ezhik/rocket-factory/shared/pkg/proto/auth/v1"
+)
+
+func (a *api) RefreshSession(ctx context.Context, req *authV1.RefreshSessionRequest) (*authV1.RefreshSessionResponse, error) {
+	if req.SessionUuid == "" {
+		return &authV1.RefreshSessionResponse{}, model.ErrSessionUUIDIsMissing
+	}
+
+	sessionUUID, err := uuid.Parse(req.SessionUuid)
+	if err != nil {
+		return &authV1.RefreshSessionResponse{}, model.ErrInvalidSessionUUID
+	}
+
+	session, err := a.service.RefreshSession(ctx, sessionUUID)
+	if err != nil {
+		return &authV1.RefreshSessionResponse{}, err
+	}
+
+	return &authV1.RefreshSessionResponse{
+		Session: converter.SessionToProto(session),
+	}, nil
+}
@@ -0,0 +1,61 @@
+package v1
+
+import (
+	"context"
+	"net/netip"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/crafty-ezhik/rocket-factory/iam/internal/interceptor"
+	"github.com/crafty-ezhik/rocket-factory/iam/internal/model"
+	"github.com/crafty-ezhik/rocket-factory/iam/internal/service/mocks"
+)
+
+type ApiSuite struct {
+	suite.Suite
+	// customerCtx и adminCtx - контексты с сессией, которую кладет AuthInterceptor
+	customerCtx   context.Context
+	adminCtx      context.Context
+	customer      model.WhoamiResponse
+	admin         model.WhoamiResponse
+	otherUserUUID uuid.UUID
+	authService   *mocks.MockAuthService
+	tokenService  *mocks.MockTokenService
+	// trustedProxies - подсеть прокси перед IAM, которым доверяется x-forwarded-for
+	trustedProxies []netip.Prefix
+	api            *api
+}
+
+func (s *ApiSuite) SetupSuite() {
+	s.customer = model.WhoamiResponse{
+		Session: model.Session{UUID: uuid.MustParse("00000000-0000-0000-0000-000000000011")},
+		User: model.User{
+			UUID:  uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			Roles: []string{model.RoleCustomer},
+		},
+	}
+	s.admin = model.WhoamiResponse{
+		Session: model.Session{UUID: uuid.MustParse("00000000-0000-0000-0000-000000000022")},
+		User: model.User{
+			UUID:        uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+			Roles:       []string{model.RoleAdmin},
+			Permissions: []string{model.PermissionUsersManage},
+		},
+	}
+	s.otherUserUUID = uuid.MustParse("00000000-0000-0000-0000-000000000003")
+
+	s.customerCtx = interceptor.ContextWithSession(context.Background(), s.customer)
+	s.adminCtx = interceptor.ContextWithSession(context.Background(), s.admin)
+
+	s.authService = mocks.NewMockAuthService(s.T())
+	s.tokenService = mocks.NewMockTokenService(s.T())
+	s.trustedProxies = []netip.Prefix{netip.MustParsePrefix("10.0.0.0/8")}
+	s.api = NewAuthAPI(s.authService, s.tokenService, s.trustedProxies)
+}
+func (s *ApiSuite) TearDownSuite() {}
+
+func TestApiIntegration(t *testing.T) {
+	suite.Run(t, new(ApiSuite))
+}
@@ -0,0 +1,21 @@
+package v1
+
+import (
+	"context"
+
+	"github.com/crafty-ezhik/rocket-factory/iam/internal/model"
+	authV1 "github.com/crafty-ezhik/rocket-factory/shared/pkg/proto/auth/v1"
+)
+
+func (a *api) UnlockAccount(ctx context.Context, req *authV1.UnlockAccountRequest) (*authV1.UnlockAccountResponse, error) {
+	if req.Login == "" {
+		return &authV1.UnlockAccountResponse{}, model.ErrLoginIsMissing
+	}
+
+	err := a.service.UnlockAccount(ctx, req.Login)
+	if err != nil {
+		return &authV1.UnlockAccountResponse{}, err
+	}
+
+	return &authV1.UnlockAccountResponse{}, nil
+}
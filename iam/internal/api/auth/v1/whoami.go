@@ -25,5 +25,5 @@ func (a *api) Whoami(ctx context.Context, req *authV1.WhoamiRequest) (*authV1.Wh
 		return &authV1.WhoamiResponse{}, err
 	}
 
-	return converter.WhoamiResponseToProto(response, sessionUUID.String()), nil
+	return converter.WhoamiResponseToProto(response), nil
 }
@@ -0,0 +1,115 @@
+package v1
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/crafty-ezhik/rocket-factory/iam/internal/model"
+	userV1 "github.com/crafty-ezhik/rocket-factory/shared/pkg/proto/user/v1"
+)
+
+// userCall - вызов метода, изменяющего учетную запись userUUID
+type userCall struct {
+	name string
+	call func(ctx context.Context, userUUID uuid.UUID) error
+	// mockService - ожидание вызова сервиса, если доступ разрешен
+	mockService func(ctx context.Context, userUUID uuid.UUID)
+}
+
+func (s *ApiSuite) userCalls() []userCall {
+	method := model.NotificationMethod{ProviderName: "telegram", Target: "12345"}
+
+	return []userCall{
+		{
+			name: "UpdateUser",
+			call: func(ctx context.Context, userUUID uuid.UUID) error {
+				_, err := s.api.UpdateUser(ctx, &userV1.UpdateUserRequest{UserUuid: userUUID.String(), Email: "new@example.com"})
+				return err
+			},
+			mockService: func(ctx context.Context, userUUID uuid.UUID) {
+				s.userService.On("UpdateEmail", ctx, userUUID, "new@example.com").
+					Return(model.User{UUID: userUUID}, nil).
+					Once()
+			},
+		},
+		{
+			name: "UpdatePreferences",
+			call: func(ctx context.Context, userUUID uuid.UUID) error {
+				_, err := s.api.UpdatePreferences(ctx, &userV1.UpdatePreferencesRequest{UserUuid: userUUID.String(), Locale: "en", Timezone: "UTC"})
+				return err
+			},
+			mockService: func(ctx context.Context, userUUID uuid.UUID) {
+				s.userService.On("UpdatePreferences", ctx, userUUID, "en", "UTC").
+					Return(model.User{UUID: userUUID}, nil).
+					Once()
+			},
+		},
+		{
+			name: "DeleteUser",
+			call: func(ctx context.Context, userUUID uuid.UUID) error {
+				_, err := s.api.DeleteUser(ctx, &userV1.DeleteUserRequest{UserUuid: userUUID.String()})
+				return err
+			},
+			mockService: func(ctx context.Context, userUUID uuid.UUID) {
+				s.userService.On("Delete", ctx, userUUID).Return(nil).Once()
+			},
+		},
+		{
+			name: "AddNotificationMethod",
+			call: func(ctx context.Context, userUUID uuid.UUID) error {
+				_, err := s.api.AddNotificationMethod(ctx, &userV1.AddNotificationMethodRequest{
+					UserUuid:     userUUID.String(),
+					ProviderName: method.ProviderName,
+					Target:       method.Target,
+				})
+				return err
+			},
+			mockService: func(ctx context.Context, userUUID uuid.UUID) {
+				s.userService.On("AddNotificationMethod", ctx, userUUID, method).Return(nil).Once()
+			},
+		},
+		{
+			name: "RemoveNotificationMethod",
+			call: func(ctx context.Context, userUUID uuid.UUID) error {
+				_, err := s.api.RemoveNotificationMethod(ctx, &userV1.RemoveNotificationMethodRequest{
+					UserUuid:     userUUID.String(),
+					ProviderName: method.ProviderName,
+					Target:       method.Target,
+				})
+				return err
+			},
+			mockService: func(ctx context.Context, userUUID uuid.UUID) {
+				s.userService.On("RemoveNotificationMethod", ctx, userUUID, method).Return(nil).Once()
+			},
+		},
+	}
+}
+
+func (s *ApiSuite) TestUserAccessAllowed() {
+	for _, tt := range s.userCalls() {
+		s.Run(tt.name+" own account", func() {
+			tt.mockService(s.customerCtx, s.customerUUID)
+			s.Require().NoError(tt.call(s.customerCtx, s.customerUUID))
+		})
+
+		s.Run(tt.name+" admin on another account", func() {
+			tt.mockService(s.adminCtx, s.otherUserUUID)
+			s.Require().NoError(tt.call(s.adminCtx, s.otherUserUUID))
+		})
+	}
+}
+
+func (s *ApiSuite) TestUserAccessDenied() {
+	for _, tt := range s.userCalls() {
+		s.Run(tt.name+" another account", func() {
+			err := tt.call(s.customerCtx, s.otherUserUUID)
+			s.Require().ErrorIs(err, model.ErrUserAccessDenied)
+		})
+
+		s.Run(tt.name+" without session", func() {
+			err := tt.call(context.Background(), s.customerUUID)
+			s.Require().ErrorIs(err, model.ErrUnauthenticated)
+		})
+	}
+}
@@ -0,0 +1,36 @@
+package v1
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/crafty-ezhik/rocket-factory/iam/internal/interceptor"
+	"github.com/crafty-ezhik/rocket-factory/iam/internal/model"
+	userV1 "github.com/crafty-ezhik/rocket-factory/shared/pkg/proto/user/v1"
+)
+
+func (a *api) ChangePassword(ctx context.Context, req *userV1.ChangePasswordRequest) (*userV1.ChangePasswordResponse, error) {
+	userUUID, err := interceptor.TargetUserUUID(ctx, req.UserUuid)
+	if err != nil {
+		return &userV1.ChangePasswordResponse{}, err
+	}
+
+	// Если текущая сессия не передана, завершаются все сессии пользователя
+	keepSessionUUID := uuid.Nil
+	if req.SessionUuid != "" {
+		keepSessionUUID, err = uuid.Parse(req.SessionUuid)
+		if err != nil {
+			return &userV1.ChangePasswordResponse{}, model.ErrInvalidSessionUUID
+		}
+	}
+
+	revoked, err := a.service.ChangePassword(ctx, userUUID, req.OldPassword, req.NewPassword, keepSessionUUID)
+	if err != nil {
+		return &userV1.ChangePasswordResponse{}, err
+	}
+
+	return &userV1.ChangePasswordResponse{
+		RevokedSessions: int32(revoked), //nolint:gosec
+	}, nil
+}
@@ -0,0 +1,21 @@
+package v1
+
+import (
+	"context"
+
+	"github.com/crafty-ezhik/rocket-factory/iam/internal/interceptor"
+	userV1 "github.com/crafty-ezhik/rocket-factory/shared/pkg/proto/user/v1"
+)
+
+func (a *api) DeleteUser(ctx context.Context, req *userV1.DeleteUserRequest) (*userV1.DeleteUserResponse, error) {
+	userUUID, err := interceptor.TargetUserUUID(ctx, req.UserUuid)
+	if err != nil {
+		return &userV1.DeleteUserResponse{}, err
+	}
+
+	if err = a.service.Delete(ctx, userUUID); err != nil {
+		return &userV1.DeleteUserResponse{}, err
+	}
+
+	return &userV1.DeleteUserResponse{}, nil
+}
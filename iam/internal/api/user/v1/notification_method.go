@@ -0,0 +1,43 @@
+package v1
+
+import (
+	"context"
+
+	"github.com/crafty-ezhik/rocket-factory/iam/internal/interceptor"
+	"github.com/crafty-ezhik/rocket-factory/iam/internal/model"
+	userV1 "github.com/crafty-ezhik/rocket-factory/shared/pkg/proto/user/v1"
+)
+
+func (a *api) AddNotificationMethod(ctx context.Context, req *userV1.AddNotificationMethodRequest) (*userV1.AddNotificationMethodResponse, error) {
+	userUUID, err := interceptor.TargetUserUUID(ctx, req.UserUuid)
+	if err != nil {
+		return &userV1.AddNotificationMethodResponse{}, err
+	}
+
+	err = a.service.AddNotificationMethod(ctx, userUUID, model.NotificationMethod{
+		ProviderName: req.ProviderName,
+		Target:       req.Target,
+	})
+	if err != nil {
+		return &userV1.AddNotificationMethodResponse{}, err
+	}
+
+	return &userV1.AddNotificationMethodResponse{}, nil
+}
+
+func (a *api) RemoveNotificationMethod(ctx context.Context, req *userV1.RemoveNotificationMethodRequest) (*userV1.RemoveNotificationMethodResponse, error) {
+	userUUID, err := interceptor.TargetUserUUID(ctx, req.UserUuid)
+	if err != nil {
+		return &userV1.RemoveNotificationMethodResponse{}, err
+	}
+
+	err = a.service.RemoveNotificationMethod(ctx, userUUID, model.NotificationMethod{
+		ProviderName: req.ProviderName,
+		Target:       req.Target,
+	})
+	if err != nil {
+		return &userV1.RemoveNotificationMethodResponse{}, err
+	}
+
+	return &userV1.RemoveNotificationMethodResponse{}, nil
+}
@@ -0,0 +1,26 @@
+package v1
+
+import (
+	"context"
+
+	userV1 "github.com/crafty-ezhik/rocket-factory/shared/pkg/proto/user/v1"
+)
+
+func (a *api) RequestPasswordReset(ctx context.Context, req *userV1.RequestPasswordResetRequest) (*userV1.RequestPasswordResetResponse, error) {
+	if err := a.service.RequestPasswordReset(ctx, req.Email); err != nil {
+		return &userV1.RequestPasswordResetResponse{}, err
+	}
+
+	return &userV1.RequestPasswordResetResponse{}, nil
+}
+
+func (a *api) ConfirmPasswordReset(ctx context.Context, req *userV1.ConfirmPasswordResetRequest) (*userV1.ConfirmPasswordResetResponse, error) {
+	revoked, err := a.service.ConfirmPasswordReset(ctx, req.Token, req.NewPassword)
+	if err != nil {
+		return &userV1.ConfirmPasswordResetResponse{}, err
+	}
+
+	return &userV1.ConfirmPasswordResetResponse{
+		RevokedSessions: int32(revoked), //nolint:gosec
+	}, nil
+}
@@ -0,0 +1,48 @@
+package v1
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/crafty-ezhik/rocket-factory/iam/internal/interceptor"
+	"github.com/crafty-ezhik/rocket-factory/iam/internal/model"
+	"github.com/crafty-ezhik/rocket-factory/iam/internal/service/mocks"
+)
+
+type ApiSuite struct {
+	suite.Suite
+	// customerCtx и adminCtx - контексты с сессией, которую кладет AuthInterceptor
+	customerCtx   context.Context
+	adminCtx      context.Context
+	customerUUID  uuid.UUID
+	otherUserUUID uuid.UUID
+	userService   *mocks.MockUserService
+	api           *api
+}
+
+func (s *ApiSuite) SetupSuite() {
+	s.customerUUID = uuid.MustParse("00000000-0000-0000-0000-000000000001")
+	s.otherUserUUID = uuid.MustParse("00000000-0000-0000-0000-000000000003")
+
+	s.customerCtx = interceptor.ContextWithSession(context.Background(), model.WhoamiResponse{
+		User: model.User{UUID: s.customerUUID, Roles: []string{model.RoleCustomer}},
+	})
+	s.adminCtx = interceptor.ContextWithSession(context.Background(), model.WhoamiResponse{
+		User: model.User{
+			UUID:        uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+			Roles:       []string{model.RoleAdmin},
+			Permissions: []string{model.PermissionUsersManage},
+		},
+	})
+
+	s.userService = mocks.NewMockUserService(s.T())
+	s.api = NewUserAPI(s.userService)
+}
+func (s *ApiSuite) TearDownSuite() {}
+
+func TestApiIntegration(t *testing.T) {
+	suite.Run(t, new(ApiSuite))
+}
@@ -0,0 +1,25 @@
+package v1
+
+import (
+	"context"
+
+	"github.com/crafty-ezhik/rocket-factory/iam/internal/converter"
+	"github.com/crafty-ezhik/rocket-factory/iam/internal/interceptor"
+	userV1 "github.com/crafty-ezhik/rocket-factory/shared/pkg/proto/user/v1"
+)
+
+func (a *api) UpdatePreferences(ctx context.Context, req *userV1.UpdatePreferencesRequest) (*userV1.UpdatePreferencesResponse, error) {
+	userUUID, err := interceptor.TargetUserUUID(ctx, req.UserUuid)
+	if err != nil {
+		return &userV1.UpdatePreferencesResponse{}, err
+	}
+
+	user, err := a.service.UpdatePreferences(ctx, userUUID, req.Locale, req.Timezone)
+	if err != nil {
+		return &userV1.UpdatePreferencesResponse{}, err
+	}
+
+	return &userV1.UpdatePreferencesResponse{
+		User: converter.UserToProto(user),
+	}, nil
+}
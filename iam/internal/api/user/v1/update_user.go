@@ -0,0 +1,25 @@
+package v1
+
+import (
+	"context"
+
+	"github.com/crafty-ezhik/rocket-factory/iam/internal/converter"
+	"github.com/crafty-ezhik/rocket-factory/iam/internal/interceptor"
+	userV1 "github.com/crafty-ezhik/rocket-factory/shared/pkg/proto/user/v1"
+)
+
+func (a *api) UpdateUser(ctx context.Context, req *userV1.UpdateUserRequest) (*userV1.UpdateUserResponse, error) {
+	userUUID, err := interceptor.TargetUserUUID(ctx, req.UserUuid)
+	if err != nil {
+		return &userV1.UpdateUserResponse{}, err
+	}
+
+	user, err := a.service.UpdateEmail(ctx, userUUID, req.Email)
+	if err != nil {
+		return &userV1.UpdateUserResponse{}, err
+	}
+
+	return &userV1.UpdateUserResponse{
+		User: converter.UserToProto(user),
+	}, nil
+}
@@ -0,0 +1,16 @@
+package v1
+
+import (
+	"context"
+
+	userV1 "github.com/crafty-ezhik/rocket-factory/shared/pkg/proto/user/v1"
+)
+
+func (a *api) VerifyEmail(ctx context.Context, req *userV1.VerifyEmailRequest) (*userV1.VerifyEmailResponse, error) {
+	userUUID, err := a.service.VerifyEmail(ctx, req.Token)
+	if err != nil {
+		return &userV1.VerifyEmailResponse{}, err
+	}
+
+	return &userV1.VerifyEmailResponse{UserUuid: userUUID.String()}, nil
+}
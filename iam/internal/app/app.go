@@ -14,6 +14,7 @@ import (
 	"github.com/crafty-ezhik/rocket-factory/iam/internal/interceptor"
 	"github.com/crafty-ezhik/rocket-factory/platform/pkg/closer"
 	"github.com/crafty-ezhik/rocket-factory/platform/pkg/grpc/health"
+	sharedIns "github.com/crafty-ezhik/rocket-factory/platform/pkg/grpc/interceptors"
 	"github.com/crafty-ezhik/rocket-factory/platform/pkg/logger"
 	authV1 "github.com/crafty-ezhik/rocket-factory/shared/pkg/proto/auth/v1"
 	userV1 "github.com/crafty-ezhik/rocket-factory/shared/pkg/proto/user/v1"
@@ -36,7 +37,23 @@ func New(ctx context.Context) (*App, error) {
 }
 
 func (a *App) Run(ctx context.Context) error {
-	return a.runGRPCServer(ctx)
+	errCh := make(chan error)
+
+	go func() {
+		errCh <- a.runGRPCServer(ctx)
+	}()
+
+	// Запускаем ротацию ключей подписи токенов доступа
+	go func() {
+		errCh <- a.runKeyRotation(ctx)
+	}()
+
+	select {
+	case err := <-errCh:
+		return err
+	case <-ctx.Done():
+		return nil
+	}
 }
 
 func (a *App) initDeps(ctx context.Context) error {
@@ -97,6 +114,25 @@ func (a *App) initGRPCServer(ctx context.Context) error {
 		grpc.Creds(insecure.NewCredentials()),
 		grpc.ChainUnaryInterceptor(
 			interceptor.LoggerInterceptor(),
+			sharedIns.UnaryErrorInterceptor(),
+			interceptor.ValidatorInterceptor(),
+			interceptor.AuthInterceptor(
+				a.diContainer.AuthService(ctx),
+				a.diContainer.TokenVerifier(ctx),
+				authV1.AuthService_ListSessions_FullMethodName,
+				authV1.AuthService_LogoutAll_FullMethodName,
+				authV1.AuthService_UnlockAccount_FullMethodName,
+				// Изменять учетную запись может только ее владелец или пользователь с разрешением users:manage
+				userV1.UserService_UpdateUser_FullMethodName,
+				userV1.UserService_UpdatePreferences_FullMethodName,
+				userV1.UserService_ChangePassword_FullMethodName,
+				userV1.UserService_AddNotificationMethod_FullMethodName,
+				userV1.UserService_RemoveNotificationMethod_FullMethodName,
+				userV1.UserService_DeleteUser_FullMethodName,
+			),
+			interceptor.AdminInterceptor(
+				authV1.AuthService_UnlockAccount_FullMethodName,
+			),
 		),
 	)
 
@@ -124,3 +160,14 @@ func (a *App) runGRPCServer(ctx context.Context) error {
 	}
 	return nil
 }
+
+func (a *App) runKeyRotation(ctx context.Context) error {
+	logger.Info(ctx, "🚀 Signing key rotation запущена")
+
+	err := a.diContainer.TokenService(ctx).RunKeyRotation(ctx)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -2,9 +2,11 @@ package app
 
 import (
 	"context"
+	"crypto/ed25519"
 	"fmt"
 	"time"
 
+	"github.com/IBM/sarama"
 	"github.com/gomodule/redigo/redis"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"go.uber.org/zap"
@@ -13,17 +15,27 @@ import (
 	userAPIV1 "github.com/crafty-ezhik/rocket-factory/iam/internal/api/user/v1"
 	"github.com/crafty-ezhik/rocket-factory/iam/internal/config"
 	"github.com/crafty-ezhik/rocket-factory/iam/internal/repository"
+	loginAttempt "github.com/crafty-ezhik/rocket-factory/iam/internal/repository/login_attempt"
 	"github.com/crafty-ezhik/rocket-factory/iam/internal/repository/session"
+	signingKeyRepo "github.com/crafty-ezhik/rocket-factory/iam/internal/repository/signing_key"
 	userRepo "github.com/crafty-ezhik/rocket-factory/iam/internal/repository/user"
+	userTokenRepo "github.com/crafty-ezhik/rocket-factory/iam/internal/repository/user_token"
 	"github.com/crafty-ezhik/rocket-factory/iam/internal/service"
 	"github.com/crafty-ezhik/rocket-factory/iam/internal/service/auth"
+	userProducer "github.com/crafty-ezhik/rocket-factory/iam/internal/service/producer/user_producer"
+	"github.com/crafty-ezhik/rocket-factory/iam/internal/service/token"
 	"github.com/crafty-ezhik/rocket-factory/iam/internal/service/user"
 	"github.com/crafty-ezhik/rocket-factory/platform/pkg/cache"
 	redisWrap "github.com/crafty-ezhik/rocket-factory/platform/pkg/cache/redis"
 	"github.com/crafty-ezhik/rocket-factory/platform/pkg/closer"
 	"github.com/crafty-ezhik/rocket-factory/platform/pkg/hasher"
+	"github.com/crafty-ezhik/rocket-factory/platform/pkg/hasher/argon2id"
 	"github.com/crafty-ezhik/rocket-factory/platform/pkg/hasher/bcrypt"
+	"github.com/crafty-ezhik/rocket-factory/platform/pkg/hasher/multi"
+	wrapperKafka "github.com/crafty-ezhik/rocket-factory/platform/pkg/kafka"
+	wrapperKafkaProducer "github.com/crafty-ezhik/rocket-factory/platform/pkg/kafka/producer"
 	"github.com/crafty-ezhik/rocket-factory/platform/pkg/logger"
+	platformToken "github.com/crafty-ezhik/rocket-factory/platform/pkg/token"
 	authV1 "github.com/crafty-ezhik/rocket-factory/shared/pkg/proto/auth/v1"
 	userV1 "github.com/crafty-ezhik/rocket-factory/shared/pkg/proto/user/v1"
 )
@@ -33,8 +45,16 @@ type diContainer struct {
 	userV1API         userV1.UserServiceServer
 	authService       service.AuthService
 	userService       service.UserService
+	tokenService      service.TokenService
+	tokenVerifier     *platformToken.Verifier
 	sessionRepository repository.SessionRepository
 	userRepository    repository.UserRepository
+	signingKeyRepo    repository.SigningKeyRepository
+	loginAttemptRepo  repository.LoginAttemptRepository
+	userTokenRepo     repository.UserTokenRepository
+	userProducer      service.UserProducerService
+	userTokenProducer wrapperKafka.Producer
+	syncProducer      sarama.SyncProducer
 	redisClient       cache.RedisClient
 	hasher            hasher.PasswordHasher
 	pgConnPool        *pgxpool.Pool
@@ -47,7 +67,11 @@ func NewDIContainer() *diContainer {
 
 func (d *diContainer) AuthV1API(ctx context.Context) authV1.AuthServiceServer {
 	if d.authV1API == nil {
-		d.authV1API = authAPIV1.NewAuthAPI(d.AuthService(ctx))
+		d.authV1API = authAPIV1.NewAuthAPI(
+			d.AuthService(ctx),
+			d.TokenService(ctx),
+			config.AppConfig().LoginProtection.TrustedProxies(),
+		)
 	}
 	return d.authV1API
 }
@@ -61,18 +85,96 @@ func (d *diContainer) UserV1API(ctx context.Context) userV1.UserServiceServer {
 
 func (d *diContainer) AuthService(ctx context.Context) service.AuthService {
 	if d.authService == nil {
-		d.authService = auth.NewService(d.UserRepository(ctx), d.SessionRepository(ctx), d.Hasher(ctx))
+		d.authService = auth.NewService(
+			d.UserRepository(ctx),
+			d.SessionRepository(ctx),
+			d.Hasher(ctx),
+			d.TokenService(ctx),
+			d.LoginAttemptRepository(ctx),
+			auth.LoginProtection{
+				MaxFailedAttempts:      config.AppConfig().LoginProtection.MaxFailedAttempts(),
+				MaxFailedAttemptsPerIP: config.AppConfig().LoginProtection.MaxFailedAttemptsPerIP(),
+				FailedAttemptsWindow:   config.AppConfig().LoginProtection.FailedAttemptsWindow(),
+				LockoutDuration:        config.AppConfig().LoginProtection.LockoutDuration(),
+				BaseDelay:              config.AppConfig().LoginProtection.BaseDelay(),
+				MaxDelay:               config.AppConfig().LoginProtection.MaxDelay(),
+			},
+			config.AppConfig().Session.TTL(),
+			config.AppConfig().Session.SlidingExpiration(),
+			config.AppConfig().UserToken.EmailVerificationRequired(),
+		)
 	}
 	return d.authService
 }
 
 func (d *diContainer) UserService(ctx context.Context) service.UserService {
 	if d.userService == nil {
-		d.userService = user.NewService(d.UserRepository(ctx), d.Hasher(ctx))
+		d.userService = user.NewService(
+			d.UserRepository(ctx),
+			d.SessionRepository(ctx),
+			d.Hasher(ctx),
+			hasher.DefaultPasswordPolicy(),
+			d.UserTokenRepository(ctx),
+			d.UserProducerService(),
+			user.TokenTTL{
+				EmailVerification: config.AppConfig().UserToken.EmailVerificationTTL(),
+				PasswordReset:     config.AppConfig().UserToken.PasswordResetTTL(),
+			},
+		)
 	}
 	return d.userService
 }
 
+func (d *diContainer) TokenService(ctx context.Context) service.TokenService {
+	if d.tokenService == nil {
+		d.tokenService = token.NewService(
+			d.SigningKeyRepository(ctx),
+			config.AppConfig().Token.TTL(),
+			config.AppConfig().Token.KeyRotationInterval(),
+			config.AppConfig().Token.Issuer(),
+		)
+	}
+	return d.tokenService
+}
+
+const (
+	// signingKeysRefreshInterval - как часто перечитывать ключи подписи для проверки токенов
+	signingKeysRefreshInterval = 5 * time.Minute
+	// signingKeysMinRefreshInterval - как часто можно перечитывать ключи при встрече неизвестного kid
+	signingKeysMinRefreshInterval = 10 * time.Second
+)
+
+// TokenVerifier - проверка токенов доступа, с которыми вызывают IAM другие сервисы
+func (d *diContainer) TokenVerifier(ctx context.Context) *platformToken.Verifier {
+	if d.tokenVerifier == nil {
+		fetch := func(ctx context.Context) (map[string]ed25519.PublicKey, error) {
+			keys, err := d.TokenService(ctx).SigningKeys(ctx)
+			if err != nil {
+				return nil, err
+			}
+
+			publicKeys := make(map[string]ed25519.PublicKey, len(keys))
+			for _, key := range keys {
+				publicKeys[key.KID] = key.PublicKey
+			}
+			return publicKeys, nil
+		}
+
+		d.tokenVerifier = platformToken.NewVerifier(
+			platformToken.NewCachedKeySet(fetch, signingKeysRefreshInterval, signingKeysMinRefreshInterval),
+			config.AppConfig().Token.Issuer(),
+		)
+	}
+	return d.tokenVerifier
+}
+
+func (d *diContainer) UserProducerService() service.UserProducerService {
+	if d.userProducer == nil {
+		d.userProducer = userProducer.NewService(d.UserTokenIssuedProducer())
+	}
+	return d.userProducer
+}
+
 func (d *diContainer) SessionRepository(ctx context.Context) repository.SessionRepository {
 	if d.sessionRepository == nil {
 		d.sessionRepository = session.NewRepository(d.RedisClient(ctx))
@@ -80,6 +182,13 @@ func (d *diContainer) SessionRepository(ctx context.Context) repository.SessionR
 	return d.sessionRepository
 }
 
+func (d *diContainer) LoginAttemptRepository(ctx context.Context) repository.LoginAttemptRepository {
+	if d.loginAttemptRepo == nil {
+		d.loginAttemptRepo = loginAttempt.NewRepository(d.RedisClient(ctx))
+	}
+	return d.loginAttemptRepo
+}
+
 func (d *diContainer) UserRepository(ctx context.Context) repository.UserRepository {
 	if d.userRepository == nil {
 		d.userRepository = userRepo.NewRepository(d.PgConn(ctx))
@@ -87,6 +196,20 @@ func (d *diContainer) UserRepository(ctx context.Context) repository.UserReposit
 	return d.userRepository
 }
 
+func (d *diContainer) UserTokenRepository(ctx context.Context) repository.UserTokenRepository {
+	if d.userTokenRepo == nil {
+		d.userTokenRepo = userTokenRepo.NewRepository(d.PgConn(ctx))
+	}
+	return d.userTokenRepo
+}
+
+func (d *diContainer) SigningKeyRepository(ctx context.Context) repository.SigningKeyRepository {
+	if d.signingKeyRepo == nil {
+		d.signingKeyRepo = signingKeyRepo.NewRepository(d.PgConn(ctx))
+	}
+	return d.signingKeyRepo
+}
+
 func (d *diContainer) RedisClient(ctx context.Context) cache.RedisClient {
 	if d.redisClient == nil {
 		d.redisClient = redisWrap.NewClient(
@@ -100,7 +223,11 @@ func (d *diContainer) RedisClient(ctx context.Context) cache.RedisClient {
 
 func (d *diContainer) Hasher(_ context.Context) hasher.PasswordHasher {
 	if d.hasher == nil {
-		d.hasher = bcrypt.NewBcryptPasswordHasher(10)
+		// Новые хеши - argon2id, bcrypt-хеши существующих пользователей пересчитываются при входе
+		d.hasher = multi.NewPasswordHasher(
+			argon2id.NewArgon2idPasswordHasher(argon2id.DefaultParams()),
+			bcrypt.NewBcryptPasswordHasher(10),
+		)
 	}
 	return d.hasher
 }
@@ -155,3 +282,34 @@ func (d *diContainer) RedisConn(ctx context.Context) *redis.Pool {
 	}
 	return d.redisConn
 }
+
+// UserTokenIssuedProducer - создает producer который отправляет в топик, заданный в конфигурации
+func (d *diContainer) UserTokenIssuedProducer() wrapperKafka.Producer {
+	if d.userTokenProducer == nil {
+		d.userTokenProducer = wrapperKafkaProducer.NewProducer(
+			d.SyncProducer(),
+			config.AppConfig().UserTokenProducer.Topic(),
+			logger.Logger(),
+		)
+	}
+	return d.userTokenProducer
+}
+
+// SyncProducer - создает базового producer с указанными брокерами
+func (d *diContainer) SyncProducer() sarama.SyncProducer {
+	if d.syncProducer == nil {
+		p, err := sarama.NewSyncProducer(
+			config.AppConfig().Kafka.Brokers(),
+			config.AppConfig().UserTokenProducer.Config(),
+		)
+		if err != nil {
+			panic(fmt.Sprintf("❌ Ошибка создания sync producer: %s\n", err.Error()))
+		}
+
+		// Добавляем закрытие producer
+		closer.AddNamed("Kafka sync producer", func(ctx context.Context) error { return p.Close() })
+
+		d.syncProducer = p
+	}
+	return d.syncProducer
+}
@@ -11,11 +11,16 @@ import (
 var appConfig *config
 
 type config struct {
-	IamGRPC  GRPCConfig
-	Postgres PostgresConfig
-	Redis    RedisConfig
-	Logger   LoggerConfig
-	Session  SessionConfig
+	IamGRPC           GRPCConfig
+	Postgres          PostgresConfig
+	Redis             RedisConfig
+	Logger            LoggerConfig
+	Session           SessionConfig
+	Token             TokenConfig
+	LoginProtection   LoginProtectionConfig
+	UserToken         UserTokenConfig
+	Kafka             KafkaConfig
+	UserTokenProducer UserTokenProducerConfig
 }
 
 func Load(path ...string) error {
@@ -41,17 +46,42 @@ func Load(path ...string) error {
 	if err != nil {
 		return err
 	}
+	tokenConfig, err := env.NewTokenConfig()
+	if err != nil {
+		return err
+	}
+	loginProtectionConfig, err := env.NewLoginProtectionConfig()
+	if err != nil {
+		return err
+	}
+	userTokenConfig, err := env.NewUserTokenConfig()
+	if err != nil {
+		return err
+	}
+	kafkaConfig, err := env.NewKafkaConfig()
+	if err != nil {
+		return err
+	}
+	userTokenProducerConfig, err := env.NewUserTokenProducerConfig()
+	if err != nil {
+		return err
+	}
 	loggerConfig, err := env.NewLoggerConfig()
 	if err != nil {
 		return err
 	}
 
 	appConfig = &config{
-		IamGRPC:  iamGRPCConfig,
-		Postgres: postgresConfig,
-		Redis:    redisConfig,
-		Session:  sessionConfig,
-		Logger:   loggerConfig,
+		IamGRPC:           iamGRPCConfig,
+		Postgres:          postgresConfig,
+		Redis:             redisConfig,
+		Session:           sessionConfig,
+		Token:             tokenConfig,
+		LoginProtection:   loginProtectionConfig,
+		UserToken:         userTokenConfig,
+		Kafka:             kafkaConfig,
+		UserTokenProducer: userTokenProducerConfig,
+		Logger:            loggerConfig,
 	}
 	return nil
 }
@@ -0,0 +1,94 @@
+package env
+
+import (
+	"fmt"
+	"net/netip"
+	"strings"
+	"time"
+
+	"github.com/caarlos0/env/v11"
+)
+
+type loginProtectionEnvConfig struct {
+	MaxFailedAttempts      int64         `env:"LOGIN_MAX_FAILED_ATTEMPTS,required"`
+	MaxFailedAttemptsPerIP int64         `env:"LOGIN_MAX_FAILED_ATTEMPTS_PER_IP,required"`
+	FailedAttemptsWindow   time.Duration `env:"LOGIN_FAILED_ATTEMPTS_WINDOW,required"`
+	LockoutDuration        time.Duration `env:"LOGIN_LOCKOUT_DURATION,required"`
+	BaseDelay              time.Duration `env:"LOGIN_BASE_DELAY,required"`
+	MaxDelay               time.Duration `env:"LOGIN_MAX_DELAY,required"`
+	TrustedProxies         []string      `env:"LOGIN_TRUSTED_PROXIES"`
+}
+
+type loginProtectionConfig struct {
+	raw            loginProtectionEnvConfig
+	trustedProxies []netip.Prefix
+}
+
+func NewLoginProtectionConfig() (*loginProtectionConfig, error) {
+	var raw loginProtectionEnvConfig
+	if err := env.Parse(&raw); err != nil {
+		return nil, err
+	}
+
+	trustedProxies := make([]netip.Prefix, 0, len(raw.TrustedProxies))
+	for _, value := range raw.TrustedProxies {
+		prefix, err := parseProxyPrefix(strings.TrimSpace(value))
+		if err != nil {
+			return nil, fmt.Errorf("LOGIN_TRUSTED_PROXIES: %w", err)
+		}
+		trustedProxies = append(trustedProxies, prefix)
+	}
+
+	return &loginProtectionConfig{raw: raw, trustedProxies: trustedProxies}, nil
+}
+
+// parseProxyPrefix - подсеть в нотации CIDR или одиночный адрес
+func parseProxyPrefix(value string) (netip.Prefix, error) {
+	if strings.Contains(value, "/") {
+		prefix, err := netip.ParsePrefix(value)
+		if err != nil {
+			return netip.Prefix{}, err
+		}
+		return prefix.Masked(), nil
+	}
+
+	addr, err := netip.ParseAddr(value)
+	if err != nil {
+		return netip.Prefix{}, err
+	}
+	return netip.PrefixFrom(addr, addr.BitLen()), nil
+}
+
+// MaxFailedAttempts - порог неудачных попыток, после которого логин временно блокируется
+func (cfg *loginProtectionConfig) MaxFailedAttempts() int64 {
+	return cfg.raw.MaxFailedAttempts
+}
+
+// MaxFailedAttemptsPerIP - порог неудачных попыток, после которого временно блокируется IP-адрес
+func (cfg *loginProtectionConfig) MaxFailedAttemptsPerIP() int64 {
+	return cfg.raw.MaxFailedAttemptsPerIP
+}
+
+// FailedAttemptsWindow - окно, в котором считаются неудачные попытки
+func (cfg *loginProtectionConfig) FailedAttemptsWindow() time.Duration {
+	return cfg.raw.FailedAttemptsWindow
+}
+
+func (cfg *loginProtectionConfig) LockoutDuration() time.Duration {
+	return cfg.raw.LockoutDuration
+}
+
+// BaseDelay - начальная задержка проверки пароля, удваивается с каждой неудачной попыткой
+func (cfg *loginProtectionConfig) BaseDelay() time.Duration {
+	return cfg.raw.BaseDelay
+}
+
+func (cfg *loginProtectionConfig) MaxDelay() time.Duration {
+	return cfg.raw.MaxDelay
+}
+
+// TrustedProxies - адреса прокси, которым доверяется заголовок x-forwarded-for.
+// Пустой список - x-forwarded-for игнорируется, IP клиента берется из соединения
+func (cfg *loginProtectionConfig) TrustedProxies() []netip.Prefix {
+	return cfg.trustedProxies
+}
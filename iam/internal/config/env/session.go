@@ -7,7 +7,8 @@ import (
 )
 
 type sessionEnvConfig struct {
-	TTL time.Duration
+	TTL               time.Duration `env:"SESSION_TTL,required"`
+	SlidingExpiration bool          `env:"SESSION_SLIDING_EXPIRATION,required"`
 }
 
 type sessionConfig struct {
@@ -25,3 +26,8 @@ func NewSessionConfig() (*sessionConfig, error) {
 func (cfg *sessionConfig) TTL() time.Duration {
 	return cfg.raw.TTL
 }
+
+// SlidingExpiration - продлевать ли сессию при каждом обращении к Whoami
+func (cfg *sessionConfig) SlidingExpiration() bool {
+	return cfg.raw.SlidingExpiration
+}
@@ -0,0 +1,40 @@
+package env
+
+import (
+	"time"
+
+	"github.com/caarlos0/env/v11"
+)
+
+type tokenEnvConfig struct {
+	TTL                 time.Duration `env:"TOKEN_TTL,required"`
+	Issuer              string        `env:"TOKEN_ISSUER,required"`
+	KeyRotationInterval time.Duration `env:"TOKEN_KEY_ROTATION_INTERVAL,required"`
+}
+
+type tokenConfig struct {
+	raw tokenEnvConfig
+}
+
+func NewTokenConfig() (*tokenConfig, error) {
+	var raw tokenEnvConfig
+	if err := env.Parse(&raw); err != nil {
+		return nil, err
+	}
+	return &tokenConfig{raw: raw}, nil
+}
+
+// TTL - время жизни токена доступа
+func (cfg *tokenConfig) TTL() time.Duration {
+	return cfg.raw.TTL
+}
+
+// Issuer - значение claim iss, которое проверяют сервисы
+func (cfg *tokenConfig) Issuer() string {
+	return cfg.raw.Issuer
+}
+
+// KeyRotationInterval - как часто заменять ключ подписи
+func (cfg *tokenConfig) KeyRotationInterval() time.Duration {
+	return cfg.raw.KeyRotationInterval
+}
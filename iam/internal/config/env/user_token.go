@@ -0,0 +1,40 @@
+package env
+
+import (
+	"time"
+
+	"github.com/caarlos0/env/v11"
+)
+
+type userTokenEnvConfig struct {
+	EmailVerificationTTL      time.Duration `env:"EMAIL_VERIFICATION_TOKEN_TTL,required"`
+	EmailVerificationRequired bool          `env:"EMAIL_VERIFICATION_REQUIRED,required"`
+	PasswordResetTTL          time.Duration `env:"PASSWORD_RESET_TOKEN_TTL,required"`
+}
+
+type userTokenConfig struct {
+	raw userTokenEnvConfig
+}
+
+func NewUserTokenConfig() (*userTokenConfig, error) {
+	var raw userTokenEnvConfig
+	if err := env.Parse(&raw); err != nil {
+		return nil, err
+	}
+	return &userTokenConfig{raw: raw}, nil
+}
+
+// EmailVerificationTTL - время жизни токена подтверждения email
+func (cfg *userTokenConfig) EmailVerificationTTL() time.Duration {
+	return cfg.raw.EmailVerificationTTL
+}
+
+// EmailVerificationRequired - запрещать ли вход пользователям с неподтвержденным email
+func (cfg *userTokenConfig) EmailVerificationRequired() bool {
+	return cfg.raw.EmailVerificationRequired
+}
+
+// PasswordResetTTL - время жизни токена сброса пароля
+func (cfg *userTokenConfig) PasswordResetTTL() time.Duration {
+	return cfg.raw.PasswordResetTTL
+}
@@ -0,0 +1,34 @@
+package env
+
+import (
+	"github.com/IBM/sarama"
+	"github.com/caarlos0/env/v11"
+)
+
+type userTokenProducerEnvConfig struct {
+	TopicName string `env:"USER_TOKEN_ISSUED_TOPIC_NAME,required"`
+}
+
+type userTokenProducerConfig struct {
+	raw userTokenProducerEnvConfig
+}
+
+func NewUserTokenProducerConfig() (*userTokenProducerConfig, error) {
+	var raw userTokenProducerEnvConfig
+	if err := env.Parse(&raw); err != nil {
+		return nil, err
+	}
+	return &userTokenProducerConfig{raw: raw}, nil
+}
+
+func (cfg *userTokenProducerConfig) Topic() string {
+	return cfg.raw.TopicName
+}
+
+func (cfg *userTokenProducerConfig) Config() *sarama.Config {
+	config := sarama.NewConfig()
+	config.Version = sarama.V4_0_0_0
+	config.Producer.Return.Successes = true
+
+	return config
+}
@@ -1,6 +1,11 @@
 package config
 
-import "time"
+import (
+	"net/netip"
+	"time"
+
+	"github.com/IBM/sarama"
+)
 
 type PostgresConfig interface {
 	DBName() string
@@ -29,4 +34,36 @@ type RedisConfig interface {
 
 type SessionConfig interface {
 	TTL() time.Duration
+	SlidingExpiration() bool
+}
+
+type TokenConfig interface {
+	TTL() time.Duration
+	Issuer() string
+	KeyRotationInterval() time.Duration
+}
+
+type LoginProtectionConfig interface {
+	MaxFailedAttempts() int64
+	MaxFailedAttemptsPerIP() int64
+	FailedAttemptsWindow() time.Duration
+	LockoutDuration() time.Duration
+	BaseDelay() time.Duration
+	MaxDelay() time.Duration
+	TrustedProxies() []netip.Prefix
+}
+
+type UserTokenConfig interface {
+	EmailVerificationTTL() time.Duration
+	EmailVerificationRequired() bool
+	PasswordResetTTL() time.Duration
+}
+
+type KafkaConfig interface {
+	Brokers() []string
+}
+
+type UserTokenProducerConfig interface {
+	Topic() string
+	Config() *sarama.Config
 }
@@ -0,0 +1,85 @@
+// Code generated for crafty-ezhik service
+// © Crafty-ezhik 2025.
+
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockKafkaConfig creates a new instance of MockKafkaConfig. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockKafkaConfig(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockKafkaConfig {
+	mock := &MockKafkaConfig{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockKafkaConfig is an autogenerated mock type for the KafkaConfig type
+type MockKafkaConfig struct {
+	mock.Mock
+}
+
+type MockKafkaConfig_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockKafkaConfig) EXPECT() *MockKafkaConfig_Expecter {
+	return &MockKafkaConfig_Expecter{mock: &_m.Mock}
+}
+
+// Brokers provides a mock function for the type MockKafkaConfig
+func (_mock *MockKafkaConfig) Brokers() []string {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Brokers")
+	}
+
+	var r0 []string
+	if returnFunc, ok := ret.Get(0).(func() []string); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+	return r0
+}
+
+// MockKafkaConfig_Brokers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Brokers'
+type MockKafkaConfig_Brokers_Call struct {
+	*mock.Call
+}
+
+// Brokers is a helper method to define mock.On call
+func (_e *MockKafkaConfig_Expecter) Brokers() *MockKafkaConfig_Brokers_Call {
+	return &MockKafkaConfig_Brokers_Call{Call: _e.mock.On("Brokers")}
+}
+
+func (_c *MockKafkaConfig_Brokers_Call) Run(run func()) *MockKafkaConfig_Brokers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockKafkaConfig_Brokers_Call) Return(strings []string) *MockKafkaConfig_Brokers_Call {
+	_c.Call.Return(strings)
+	return _c
+}
+
+func (_c *MockKafkaConfig_Brokers_Call) RunAndReturn(run func() []string) *MockKafkaConfig_Brokers_Call {
+	_c.Call.Return(run)
+	return _c
+}
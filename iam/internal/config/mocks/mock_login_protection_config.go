@@ -0,0 +1,352 @@
+// Code generated for crafty-ezhik service
+// © Crafty-ezhik 2025.
+
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"net/netip"
+	"time"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockLoginProtectionConfig creates a new instance of MockLoginProtectionConfig. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockLoginProtectionConfig(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockLoginProtectionConfig {
+	mock := &MockLoginProtectionConfig{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockLoginProtectionConfig is an autogenerated mock type for the LoginProtectionConfig type
+type MockLoginProtectionConfig struct {
+	mock.Mock
+}
+
+type MockLoginProtectionConfig_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockLoginProtectionConfig) EXPECT() *MockLoginProtectionConfig_Expecter {
+	return &MockLoginProtectionConfig_Expecter{mock: &_m.Mock}
+}
+
+// BaseDelay provides a mock function for the type MockLoginProtectionConfig
+func (_mock *MockLoginProtectionConfig) BaseDelay() time.Duration {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for BaseDelay")
+	}
+
+	var r0 time.Duration
+	if returnFunc, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+	return r0
+}
+
+// MockLoginProtectionConfig_BaseDelay_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BaseDelay'
+type MockLoginProtectionConfig_BaseDelay_Call struct {
+	*mock.Call
+}
+
+// BaseDelay is a helper method to define mock.On call
+func (_e *MockLoginProtectionConfig_Expecter) BaseDelay() *MockLoginProtectionConfig_BaseDelay_Call {
+	return &MockLoginProtectionConfig_BaseDelay_Call{Call: _e.mock.On("BaseDelay")}
+}
+
+func (_c *MockLoginProtectionConfig_BaseDelay_Call) Run(run func()) *MockLoginProtectionConfig_BaseDelay_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockLoginProtectionConfig_BaseDelay_Call) Return(duration time.Duration) *MockLoginProtectionConfig_BaseDelay_Call {
+	_c.Call.Return(duration)
+	return _c
+}
+
+func (_c *MockLoginProtectionConfig_BaseDelay_Call) RunAndReturn(run func() time.Duration) *MockLoginProtectionConfig_BaseDelay_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FailedAttemptsWindow provides a mock function for the type MockLoginProtectionConfig
+func (_mock *MockLoginProtectionConfig) FailedAttemptsWindow() time.Duration {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for FailedAttemptsWindow")
+	}
+
+	var r0 time.Duration
+	if returnFunc, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+	return r0
+}
+
+// MockLoginProtectionConfig_FailedAttemptsWindow_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FailedAttemptsWindow'
+type MockLoginProtectionConfig_FailedAttemptsWindow_Call struct {
+	*mock.Call
+}
+
+// FailedAttemptsWindow is a helper method to define mock.On call
+func (_e *MockLoginProtectionConfig_Expecter) FailedAttemptsWindow() *MockLoginProtectionConfig_FailedAttemptsWindow_Call {
+	return &MockLoginProtectionConfig_FailedAttemptsWindow_Call{Call: _e.mock.On("FailedAttemptsWindow")}
+}
+
+func (_c *MockLoginProtectionConfig_FailedAttemptsWindow_Call) Run(run func()) *MockLoginProtectionConfig_FailedAttemptsWindow_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockLoginProtectionConfig_FailedAttemptsWindow_Call) Return(duration time.Duration) *MockLoginProtectionConfig_FailedAttemptsWindow_Call {
+	_c.Call.Return(duration)
+	return _c
+}
+
+func (_c *MockLoginProtectionConfig_FailedAttemptsWindow_Call) RunAndReturn(run func() time.Duration) *MockLoginProtectionConfig_FailedAttemptsWindow_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// LockoutDuration provides a mock function for the type MockLoginProtectionConfig
+func (_mock *MockLoginProtectionConfig) LockoutDuration() time.Duration {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for LockoutDuration")
+	}
+
+	var r0 time.Duration
+	if returnFunc, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+	return r0
+}
+
+// MockLoginProtectionConfig_LockoutDuration_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LockoutDuration'
+type MockLoginProtectionConfig_LockoutDuration_Call struct {
+	*mock.Call
+}
+
+// LockoutDuration is a helper method to define mock.On call
+func (_e *MockLoginProtectionConfig_Expecter) LockoutDuration() *MockLoginProtectionConfig_LockoutDuration_Call {
+	return &MockLoginProtectionConfig_LockoutDuration_Call{Call: _e.mock.On("LockoutDuration")}
+}
+
+func (_c *MockLoginProtectionConfig_LockoutDuration_Call) Run(run func()) *MockLoginProtectionConfig_LockoutDuration_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockLoginProtectionConfig_LockoutDuration_Call) Return(duration time.Duration) *MockLoginProtectionConfig_LockoutDuration_Call {
+	_c.Call.Return(duration)
+	return _c
+}
+
+func (_c *MockLoginProtectionConfig_LockoutDuration_Call) RunAndReturn(run func() time.Duration) *MockLoginProtectionConfig_LockoutDuration_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MaxDelay provides a mock function for the type MockLoginProtectionConfig
+func (_mock *MockLoginProtectionConfig) MaxDelay() time.Duration {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for MaxDelay")
+	}
+
+	var r0 time.Duration
+	if returnFunc, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+	return r0
+}
+
+// MockLoginProtectionConfig_MaxDelay_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MaxDelay'
+type MockLoginProtectionConfig_MaxDelay_Call struct {
+	*mock.Call
+}
+
+// MaxDelay is a helper method to define mock.On call
+func (_e *MockLoginProtectionConfig_Expecter) MaxDelay() *MockLoginProtectionConfig_MaxDelay_Call {
+	return &MockLoginProtectionConfig_MaxDelay_Call{Call: _e.mock.On("MaxDelay")}
+}
+
+func (_c *MockLoginProtectionConfig_MaxDelay_Call) Run(run func()) *MockLoginProtectionConfig_MaxDelay_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockLoginProtectionConfig_MaxDelay_Call) Return(duration time.Duration) *MockLoginProtectionConfig_MaxDelay_Call {
+	_c.Call.Return(duration)
+	return _c
+}
+
+func (_c *MockLoginProtectionConfig_MaxDelay_Call) RunAndReturn(run func() time.Duration) *MockLoginProtectionConfig_MaxDelay_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MaxFailedAttempts provides a mock function for the type MockLoginProtectionConfig
+func (_mock *MockLoginProtectionConfig) MaxFailedAttempts() int64 {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for MaxFailedAttempts")
+	}
+
+	var r0 int64
+	if returnFunc, ok := ret.Get(0).(func() int64); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+	return r0
+}
+
+// MockLoginProtectionConfig_MaxFailedAttempts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MaxFailedAttempts'
+type MockLoginProtectionConfig_MaxFailedAttempts_Call struct {
+	*mock.Call
+}
+
+// MaxFailedAttempts is a helper method to define mock.On call
+func (_e *MockLoginProtectionConfig_Expecter) MaxFailedAttempts() *MockLoginProtectionConfig_MaxFailedAttempts_Call {
+	return &MockLoginProtectionConfig_MaxFailedAttempts_Call{Call: _e.mock.On("MaxFailedAttempts")}
+}
+
+func (_c *MockLoginProtectionConfig_MaxFailedAttempts_Call) Run(run func()) *MockLoginProtectionConfig_MaxFailedAttempts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockLoginProtectionConfig_MaxFailedAttempts_Call) Return(n int64) *MockLoginProtectionConfig_MaxFailedAttempts_Call {
+	_c.Call.Return(n)
+	return _c
+}
+
+func (_c *MockLoginProtectionConfig_MaxFailedAttempts_Call) RunAndReturn(run func() int64) *MockLoginProtectionConfig_MaxFailedAttempts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MaxFailedAttemptsPerIP provides a mock function for the type MockLoginProtectionConfig
+func (_mock *MockLoginProtectionConfig) MaxFailedAttemptsPerIP() int64 {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for MaxFailedAttemptsPerIP")
+	}
+
+	var r0 int64
+	if returnFunc, ok := ret.Get(0).(func() int64); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+	return r0
+}
+
+// MockLoginProtectionConfig_MaxFailedAttemptsPerIP_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MaxFailedAttemptsPerIP'
+type MockLoginProtectionConfig_MaxFailedAttemptsPerIP_Call struct {
+	*mock.Call
+}
+
+// MaxFailedAttemptsPerIP is a helper method to define mock.On call
+func (_e *MockLoginProtectionConfig_Expecter) MaxFailedAttemptsPerIP() *MockLoginProtectionConfig_MaxFailedAttemptsPerIP_Call {
+	return &MockLoginProtectionConfig_MaxFailedAttemptsPerIP_Call{Call: _e.mock.On("MaxFailedAttemptsPerIP")}
+}
+
+func (_c *MockLoginProtectionConfig_MaxFailedAttemptsPerIP_Call) Run(run func()) *MockLoginProtectionConfig_MaxFailedAttemptsPerIP_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockLoginProtectionConfig_MaxFailedAttemptsPerIP_Call) Return(n int64) *MockLoginProtectionConfig_MaxFailedAttemptsPerIP_Call {
+	_c.Call.Return(n)
+	return _c
+}
+
+func (_c *MockLoginProtectionConfig_MaxFailedAttemptsPerIP_Call) RunAndReturn(run func() int64) *MockLoginProtectionConfig_MaxFailedAttemptsPerIP_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// TrustedProxies provides a mock function for the type MockLoginProtectionConfig
+func (_mock *MockLoginProtectionConfig) TrustedProxies() []netip.Prefix {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for TrustedProxies")
+	}
+
+	var r0 []netip.Prefix
+	if returnFunc, ok := ret.Get(0).(func() []netip.Prefix); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]netip.Prefix)
+		}
+	}
+	return r0
+}
+
+// MockLoginProtectionConfig_TrustedProxies_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TrustedProxies'
+type MockLoginProtectionConfig_TrustedProxies_Call struct {
+	*mock.Call
+}
+
+// TrustedProxies is a helper method to define mock.On call
+func (_e *MockLoginProtectionConfig_Expecter) TrustedProxies() *MockLoginProtectionConfig_TrustedProxies_Call {
+	return &MockLoginProtectionConfig_TrustedProxies_Call{Call: _e.mock.On("TrustedProxies")}
+}
+
+func (_c *MockLoginProtectionConfig_TrustedProxies_Call) Run(run func()) *MockLoginProtectionConfig_TrustedProxies_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockLoginProtectionConfig_TrustedProxies_Call) Return(prefixs []netip.Prefix) *MockLoginProtectionConfig_TrustedProxies_Call {
+	_c.Call.Return(prefixs)
+	return _c
+}
+
+func (_c *MockLoginProtectionConfig_TrustedProxies_Call) RunAndReturn(run func() []netip.Prefix) *MockLoginProtectionConfig_TrustedProxies_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -40,6 +40,50 @@ func (_m *MockSessionConfig) EXPECT() *MockSessionConfig_Expecter {
 	return &MockSessionConfig_Expecter{mock: &_m.Mock}
 }
 
+// SlidingExpiration provides a mock function for the type MockSessionConfig
+func (_mock *MockSessionConfig) SlidingExpiration() bool {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for SlidingExpiration")
+	}
+
+	var r0 bool
+	if returnFunc, ok := ret.Get(0).(func() bool); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	return r0
+}
+
+// MockSessionConfig_SlidingExpiration_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SlidingExpiration'
+type MockSessionConfig_SlidingExpiration_Call struct {
+	*mock.Call
+}
+
+// SlidingExpiration is a helper method to define mock.On call
+func (_e *MockSessionConfig_Expecter) SlidingExpiration() *MockSessionConfig_SlidingExpiration_Call {
+	return &MockSessionConfig_SlidingExpiration_Call{Call: _e.mock.On("SlidingExpiration")}
+}
+
+func (_c *MockSessionConfig_SlidingExpiration_Call) Run(run func()) *MockSessionConfig_SlidingExpiration_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockSessionConfig_SlidingExpiration_Call) Return(b bool) *MockSessionConfig_SlidingExpiration_Call {
+	_c.Call.Return(b)
+	return _c
+}
+
+func (_c *MockSessionConfig_SlidingExpiration_Call) RunAndReturn(run func() bool) *MockSessionConfig_SlidingExpiration_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // TTL provides a mock function for the type MockSessionConfig
 func (_mock *MockSessionConfig) TTL() time.Duration {
 	ret := _mock.Called()
@@ -0,0 +1,173 @@
+// Code generated for crafty-ezhik service
+// © Crafty-ezhik 2025.
+
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"time"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockTokenConfig creates a new instance of MockTokenConfig. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockTokenConfig(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockTokenConfig {
+	mock := &MockTokenConfig{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockTokenConfig is an autogenerated mock type for the TokenConfig type
+type MockTokenConfig struct {
+	mock.Mock
+}
+
+type MockTokenConfig_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockTokenConfig) EXPECT() *MockTokenConfig_Expecter {
+	return &MockTokenConfig_Expecter{mock: &_m.Mock}
+}
+
+// Issuer provides a mock function for the type MockTokenConfig
+func (_mock *MockTokenConfig) Issuer() string {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Issuer")
+	}
+
+	var r0 string
+	if returnFunc, ok := ret.Get(0).(func() string); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	return r0
+}
+
+// MockTokenConfig_Issuer_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Issuer'
+type MockTokenConfig_Issuer_Call struct {
+	*mock.Call
+}
+
+// Issuer is a helper method to define mock.On call
+func (_e *MockTokenConfig_Expecter) Issuer() *MockTokenConfig_Issuer_Call {
+	return &MockTokenConfig_Issuer_Call{Call: _e.mock.On("Issuer")}
+}
+
+func (_c *MockTokenConfig_Issuer_Call) Run(run func()) *MockTokenConfig_Issuer_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockTokenConfig_Issuer_Call) Return(s string) *MockTokenConfig_Issuer_Call {
+	_c.Call.Return(s)
+	return _c
+}
+
+func (_c *MockTokenConfig_Issuer_Call) RunAndReturn(run func() string) *MockTokenConfig_Issuer_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// KeyRotationInterval provides a mock function for the type MockTokenConfig
+func (_mock *MockTokenConfig) KeyRotationInterval() time.Duration {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for KeyRotationInterval")
+	}
+
+	var r0 time.Duration
+	if returnFunc, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+	return r0
+}
+
+// MockTokenConfig_KeyRotationInterval_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'KeyRotationInterval'
+type MockTokenConfig_KeyRotationInterval_Call struct {
+	*mock.Call
+}
+
+// KeyRotationInterval is a helper method to define mock.On call
+func (_e *MockTokenConfig_Expecter) KeyRotationInterval() *MockTokenConfig_KeyRotationInterval_Call {
+	return &MockTokenConfig_KeyRotationInterval_Call{Call: _e.mock.On("KeyRotationInterval")}
+}
+
+func (_c *MockTokenConfig_KeyRotationInterval_Call) Run(run func()) *MockTokenConfig_KeyRotationInterval_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockTokenConfig_KeyRotationInterval_Call) Return(duration time.Duration) *MockTokenConfig_KeyRotationInterval_Call {
+	_c.Call.Return(duration)
+	return _c
+}
+
+func (_c *MockTokenConfig_KeyRotationInterval_Call) RunAndReturn(run func() time.Duration) *MockTokenConfig_KeyRotationInterval_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// TTL provides a mock function for the type MockTokenConfig
+func (_mock *MockTokenConfig) TTL() time.Duration {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for TTL")
+	}
+
+	var r0 time.Duration
+	if returnFunc, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+	return r0
+}
+
+// MockTokenConfig_TTL_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TTL'
+type MockTokenConfig_TTL_Call struct {
+	*mock.Call
+}
+
+// TTL is a helper method to define mock.On call
+func (_e *MockTokenConfig_Expecter) TTL() *MockTokenConfig_TTL_Call {
+	return &MockTokenConfig_TTL_Call{Call: _e.mock.On("TTL")}
+}
+
+func (_c *MockTokenConfig_TTL_Call) Run(run func()) *MockTokenConfig_TTL_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockTokenConfig_TTL_Call) Return(duration time.Duration) *MockTokenConfig_TTL_Call {
+	_c.Call.Return(duration)
+	return _c
+}
+
+func (_c *MockTokenConfig_TTL_Call) RunAndReturn(run func() time.Duration) *MockTokenConfig_TTL_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -0,0 +1,173 @@
+// Code generated for crafty-ezhik service
+// © Crafty-ezhik 2025.
+
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"time"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockUserTokenConfig creates a new instance of MockUserTokenConfig. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockUserTokenConfig(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockUserTokenConfig {
+	mock := &MockUserTokenConfig{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockUserTokenConfig is an autogenerated mock type for the UserTokenConfig type
+type MockUserTokenConfig struct {
+	mock.Mock
+}
+
+type MockUserTokenConfig_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockUserTokenConfig) EXPECT() *MockUserTokenConfig_Expecter {
+	return &MockUserTokenConfig_Expecter{mock: &_m.Mock}
+}
+
+// EmailVerificationRequired provides a mock function for the type MockUserTokenConfig
+func (_mock *MockUserTokenConfig) EmailVerificationRequired() bool {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for EmailVerificationRequired")
+	}
+
+	var r0 bool
+	if returnFunc, ok := ret.Get(0).(func() bool); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	return r0
+}
+
+// MockUserTokenConfig_EmailVerificationRequired_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'EmailVerificationRequired'
+type MockUserTokenConfig_EmailVerificationRequired_Call struct {
+	*mock.Call
+}
+
+// EmailVerificationRequired is a helper method to define mock.On call
+func (_e *MockUserTokenConfig_Expecter) EmailVerificationRequired() *MockUserTokenConfig_EmailVerificationRequired_Call {
+	return &MockUserTokenConfig_EmailVerificationRequired_Call{Call: _e.mock.On("EmailVerificationRequired")}
+}
+
+func (_c *MockUserTokenConfig_EmailVerificationRequired_Call) Run(run func()) *MockUserTokenConfig_EmailVerificationRequired_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockUserTokenConfig_EmailVerificationRequired_Call) Return(b bool) *MockUserTokenConfig_EmailVerificationRequired_Call {
+	_c.Call.Return(b)
+	return _c
+}
+
+func (_c *MockUserTokenConfig_EmailVerificationRequired_Call) RunAndReturn(run func() bool) *MockUserTokenConfig_EmailVerificationRequired_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// EmailVerificationTTL provides a mock function for the type MockUserTokenConfig
+func (_mock *MockUserTokenConfig) EmailVerificationTTL() time.Duration {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for EmailVerificationTTL")
+	}
+
+	var r0 time.Duration
+	if returnFunc, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+	return r0
+}
+
+// MockUserTokenConfig_EmailVerificationTTL_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'EmailVerificationTTL'
+type MockUserTokenConfig_EmailVerificationTTL_Call struct {
+	*mock.Call
+}
+
+// EmailVerificationTTL is a helper method to define mock.On call
+func (_e *MockUserTokenConfig_Expecter) EmailVerificationTTL() *MockUserTokenConfig_EmailVerificationTTL_Call {
+	return &MockUserTokenConfig_EmailVerificationTTL_Call{Call: _e.mock.On("EmailVerificationTTL")}
+}
+
+func (_c *MockUserTokenConfig_EmailVerificationTTL_Call) Run(run func()) *MockUserTokenConfig_EmailVerificationTTL_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockUserTokenConfig_EmailVerificationTTL_Call) Return(duration time.Duration) *MockUserTokenConfig_EmailVerificationTTL_Call {
+	_c.Call.Return(duration)
+	return _c
+}
+
+func (_c *MockUserTokenConfig_EmailVerificationTTL_Call) RunAndReturn(run func() time.Duration) *MockUserTokenConfig_EmailVerificationTTL_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PasswordResetTTL provides a mock function for the type MockUserTokenConfig
+func (_mock *MockUserTokenConfig) PasswordResetTTL() time.Duration {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for PasswordResetTTL")
+	}
+
+	var r0 time.Duration
+	if returnFunc, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+	return r0
+}
+
+// MockUserTokenConfig_PasswordResetTTL_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PasswordResetTTL'
+type MockUserTokenConfig_PasswordResetTTL_Call struct {
+	*mock.Call
+}
+
+// PasswordResetTTL is a helper method to define mock.On call
+func (_e *MockUserTokenConfig_Expecter) PasswordResetTTL() *MockUserTokenConfig_PasswordResetTTL_Call {
+	return &MockUserTokenConfig_PasswordResetTTL_Call{Call: _e.mock.On("PasswordResetTTL")}
+}
+
+func (_c *MockUserTokenConfig_PasswordResetTTL_Call) Run(run func()) *MockUserTokenConfig_PasswordResetTTL_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockUserTokenConfig_PasswordResetTTL_Call) Return(duration time.Duration) *MockUserTokenConfig_PasswordResetTTL_Call {
+	_c.Call.Return(duration)
+	return _c
+}
+
+func (_c *MockUserTokenConfig_PasswordResetTTL_Call) RunAndReturn(run func() time.Duration) *MockUserTokenConfig_PasswordResetTTL_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -1,30 +1,81 @@
 package converter
 
 import (
+	"github.com/google/uuid"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/crafty-ezhik/rocket-factory/iam/internal/model"
+	"github.com/crafty-ezhik/rocket-factory/platform/pkg/token"
 	authV1 "github.com/crafty-ezhik/rocket-factory/shared/pkg/proto/auth/v1"
 	commonV1 "github.com/crafty-ezhik/rocket-factory/shared/pkg/proto/common/v1"
 )
 
-func WhoamiResponseToProto(data model.WhoamiResponse, sessionUUID string) *authV1.WhoamiResponse {
+func LoginResultToProto(data model.LoginResult) *authV1.LoginResponse {
+	return &authV1.LoginResponse{
+		SessionUuid:          data.SessionUUID.String(),
+		AccessToken:          data.AccessToken.Value,
+		AccessTokenExpiresAt: timestamppb.New(data.AccessToken.ExpiresAt),
+	}
+}
+
+func WhoamiResponseToProto(data model.WhoamiResponse) *authV1.WhoamiResponse {
 	return &authV1.WhoamiResponse{
-		Session: SessionToProto(data.Session, sessionUUID),
+		Session: SessionToProto(data.Session),
 		User:    UserToProto(data.User),
 	}
 }
 
-func SessionToProto(data model.Session, sessionUUID string) *commonV1.Session {
+func SessionToProto(data model.Session) *commonV1.Session {
 	var updatedAt *timestamppb.Timestamp
 	if data.UpdatedAt != nil {
 		updatedAt = timestamppb.New(*data.UpdatedAt)
 	}
 
 	return &commonV1.Session{
-		Uuid:      sessionUUID,
+		Uuid:      data.UUID.String(),
 		CreatedAt: timestamppb.New(data.CreatedAt),
 		UpdatedAt: updatedAt,
 		ExpiresAt: timestamppb.New(data.ExpiresAt),
 	}
 }
+
+// SessionSummariesToProto - сессии без UUID: наружу отдаются только непрозрачные идентификаторы.
+// current отмечает сессию, из которой пришел запрос
+func SessionSummariesToProto(sessions []model.Session, currentSessionUUID uuid.UUID) []*authV1.SessionSummary {
+	out := make([]*authV1.SessionSummary, len(sessions))
+	for i, session := range sessions {
+		var updatedAt *timestamppb.Timestamp
+		if session.UpdatedAt != nil {
+			updatedAt = timestamppb.New(*session.UpdatedAt)
+		}
+
+		out[i] = &authV1.SessionSummary{
+			Id:        session.PublicID(),
+			CreatedAt: timestamppb.New(session.CreatedAt),
+			UpdatedAt: updatedAt,
+			ExpiresAt: timestamppb.New(session.ExpiresAt),
+			Current:   session.UUID == currentSessionUUID,
+		}
+	}
+	return out
+}
+
+// SigningKeysToProto - публикует только открытые части ключей
+func SigningKeysToProto(keys []model.SigningKey) []*authV1.SigningKey {
+	out := make([]*authV1.SigningKey, len(keys))
+	for i, key := range keys {
+		var expiresAt *timestamppb.Timestamp
+		if key.VerifiableUntil != nil {
+			expiresAt = timestamppb.New(*key.VerifiableUntil)
+		}
+
+		out[i] = &authV1.SigningKey{
+			Kid:       key.KID,
+			Algorithm: token.Algorithm,
+			PublicKey: key.PublicKey,
+			CreatedAt: timestamppb.New(key.CreatedAt),
+			ExpiresAt: expiresAt,
+		}
+	}
+	return out
+}
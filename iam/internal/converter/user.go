@@ -22,6 +22,8 @@ func userInfoToModel(data *commonV1.UserInfo) serviceModel.UserInfo {
 		Login:               data.Login,
 		Email:               data.Email,
 		NotificationMethods: notificationMethodsToModel(data.NotificationMethod),
+		Locale:              data.Locale,
+		Timezone:            data.Timezone,
 	}
 }
 
@@ -45,10 +47,14 @@ func UserToProto(user serviceModel.User) *commonV1.User {
 	}
 
 	return &commonV1.User{
-		Uuid:      user.UUID.String(),
-		Info:      userInfoToProto(user.Info),
-		CreatedAt: timestamppb.New(user.CreatedAt),
-		UpdatedAt: updatedAt,
+		Uuid:        user.UUID.String(),
+		Info:        userInfoToProto(user.Info),
+		CreatedAt:   timestamppb.New(user.CreatedAt),
+		UpdatedAt:   updatedAt,
+		Roles:       user.Roles,
+		Permissions: user.Permissions,
+
+		EmailVerified: user.IsEmailVerified(),
 	}
 }
 
@@ -57,6 +63,8 @@ func userInfoToProto(info serviceModel.UserInfo) *commonV1.UserInfo {
 		Login:              info.Login,
 		Email:              info.Email,
 		NotificationMethod: notificationMethodsToProto(info.NotificationMethods),
+		Locale:             info.Locale,
+		Timezone:           info.Timezone,
 	}
 }
 
@@ -0,0 +1,38 @@
+package interceptor
+
+import (
+	"context"
+	"slices"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/crafty-ezhik/rocket-factory/iam/internal/model"
+)
+
+// AdminInterceptor пропускает вызовы методов adminMethods только с сессией администратора.
+// Сессию в контекст кладет AuthInterceptor, поэтому adminMethods должны входить и в его список
+func AdminInterceptor(adminMethods ...string) grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req any,
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (any, error) {
+		if !slices.Contains(adminMethods, info.FullMethod) {
+			return handler(ctx, req)
+		}
+
+		whoami, ok := SessionFromContext(ctx)
+		if !ok {
+			return nil, status.Error(codes.Unauthenticated, "authentication required")
+		}
+
+		if !slices.Contains(whoami.User.Roles, model.RoleAdmin) {
+			return nil, status.Error(codes.PermissionDenied, "admin role is required")
+		}
+
+		return handler(ctx, req)
+	}
+}
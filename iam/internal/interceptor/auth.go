@@ -0,0 +1,117 @@
+package interceptor
+
+import (
+	"context"
+	"slices"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/crafty-ezhik/rocket-factory/iam/internal/model"
+	"github.com/crafty-ezhik/rocket-factory/iam/internal/service"
+	grpcAuth "github.com/crafty-ezhik/rocket-factory/platform/pkg/middleware/grpc"
+	"github.com/crafty-ezhik/rocket-factory/platform/pkg/token"
+)
+
+type sessionContextKey struct{}
+
+// AuthInterceptor аутентифицирует вызовы методов methods и кладет сессию вызывающего в контекст.
+// Принимается токен доступа из authorization или UUID сессии из session-uuid, как их пересылают остальные сервисы.
+// Сессия из токена все равно проверяется через Whoami, чтобы завершенная сессия сразу теряла доступ
+func AuthInterceptor(authService service.AuthService, verifier *token.Verifier, methods ...string) grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req any,
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (any, error) {
+		if !slices.Contains(methods, info.FullMethod) {
+			return handler(ctx, req)
+		}
+
+		sessionUUID, err := callerSessionUUID(ctx, verifier)
+		if err != nil {
+			return nil, err
+		}
+
+		whoami, err := authService.Whoami(ctx, sessionUUID)
+		if err != nil {
+			return nil, status.Error(codes.Unauthenticated, "invalid session")
+		}
+
+		return handler(context.WithValue(ctx, sessionContextKey{}, whoami), req)
+	}
+}
+
+// callerSessionUUID - UUID сессии вызывающего из metadata
+func callerSessionUUID(ctx context.Context, verifier *token.Verifier) (uuid.UUID, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+
+	if values := md.Get(grpcAuth.AuthorizationMetadataKey); len(values) > 0 {
+		accessToken, ok := grpcAuth.ParseBearer(values[0])
+		if !ok {
+			return uuid.Nil, status.Error(codes.Unauthenticated, "malformed authorization metadata")
+		}
+
+		claims, err := verifier.Verify(ctx, accessToken)
+		if err != nil {
+			return uuid.Nil, status.Error(codes.Unauthenticated, "invalid access token")
+		}
+
+		sessionUUID, err := uuid.Parse(claims.SessionUUID)
+		if err != nil {
+			return uuid.Nil, status.Error(codes.Unauthenticated, "invalid access token")
+		}
+		return sessionUUID, nil
+	}
+
+	values := md.Get(grpcAuth.SessionUUIDMetadataKey)
+	if len(values) == 0 {
+		return uuid.Nil, status.Error(codes.Unauthenticated, "missing session-uuid in metadata")
+	}
+
+	sessionUUID, err := uuid.Parse(values[0])
+	if err != nil {
+		return uuid.Nil, status.Error(codes.Unauthenticated, "invalid session-uuid")
+	}
+	return sessionUUID, nil
+}
+
+// SessionFromContext - сессия и пользователь, аутентифицированные AuthInterceptor
+func SessionFromContext(ctx context.Context) (model.WhoamiResponse, bool) {
+	whoami, ok := ctx.Value(sessionContextKey{}).(model.WhoamiResponse)
+	return whoami, ok
+}
+
+// ContextWithSession - кладет сессию вызывающего в контекст, как это делает AuthInterceptor
+func ContextWithSession(ctx context.Context, whoami model.WhoamiResponse) context.Context {
+	return context.WithValue(ctx, sessionContextKey{}, whoami)
+}
+
+// TargetUserUUID - пользователь, над которым выполняется действие.
+//
+//	Пустой rawUserUUID означает самого вызывающего. Чужая учетная запись доступна только
+//	с разрешением users:manage, иначе возвращается ErrUserAccessDenied
+func TargetUserUUID(ctx context.Context, rawUserUUID string) (uuid.UUID, error) {
+	whoami, ok := SessionFromContext(ctx)
+	if !ok {
+		return uuid.Nil, model.ErrUnauthenticated
+	}
+
+	if rawUserUUID == "" {
+		return whoami.User.UUID, nil
+	}
+
+	userUUID, err := uuid.Parse(rawUserUUID)
+	if err != nil {
+		return uuid.Nil, model.ErrInvalidUserUUID
+	}
+
+	if !whoami.CanAccessUser(userUUID) {
+		return uuid.Nil, model.ErrUserAccessDenied
+	}
+	return userUUID, nil
+}
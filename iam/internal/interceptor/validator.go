@@ -0,0 +1,27 @@
+package interceptor
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ValidatorInterceptor проверяет запросы по правилам protoc-gen-validate из proto.
+// Запросы без правил валидации пропускаются как есть
+func ValidatorInterceptor() grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req any,
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (any, error) {
+		if v, ok := req.(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return nil, status.Errorf(codes.InvalidArgument, "validation error: %v", err)
+			}
+		}
+		return handler(ctx, req)
+	}
+}
@@ -1,19 +1,40 @@
 package model
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"time"
 
 	"github.com/google/uuid"
 )
 
 type Session struct {
+	UUID      uuid.UUID
 	UserUUID  uuid.UUID
 	CreatedAt time.Time
 	UpdatedAt *time.Time
 	ExpiresAt time.Time
 }
 
+// IsExpired - истекло ли время жизни сессии к моменту now
+func (s Session) IsExpired(now time.Time) bool {
+	return !now.Before(s.ExpiresAt)
+}
+
+// PublicID - непрозрачный идентификатор сессии для списка сессий.
+//
+//	UUID сессии является учетными данными, поэтому наружу отдается только его хеш
+func (s Session) PublicID() string {
+	sum := sha256.Sum256([]byte(s.UUID.String()))
+	return hex.EncodeToString(sum[:16])
+}
+
 type WhoamiResponse struct {
 	Session Session
 	User    User
 }
+
+// CanAccessUser - может ли владелец сессии работать с учетной записью userUUID: своей или любой при разрешении users:manage
+func (w WhoamiResponse) CanAccessUser(userUUID uuid.UUID) bool {
+	return w.User.UUID == userUUID || w.User.HasPermission(PermissionUsersManage)
+}
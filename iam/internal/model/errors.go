@@ -11,10 +11,32 @@ var (
 	ErrSessionUUIDIsMissing = sharedErr.NewBusinessError(sharedErr.BadRequestErrCode, errors.New("session UUID is missing"))
 	ErrUserInfoIsMissing    = sharedErr.NewBusinessError(sharedErr.BadRequestErrCode, errors.New("user info is missing"))
 	ErrInvalidSessionUUID   = sharedErr.NewBusinessError(sharedErr.BadRequestErrCode, errors.New("invalid session UUID"))
+	ErrSessionNotFound      = sharedErr.NewBusinessError(sharedErr.UnauthorizedErrCode, errors.New("session not found"))
+	ErrSessionExpired       = sharedErr.NewBusinessError(sharedErr.UnauthorizedErrCode, errors.New("session expired"))
+	ErrUnauthenticated      = sharedErr.NewBusinessError(sharedErr.UnauthorizedErrCode, errors.New("authentication required"))
+	ErrUserAccessDenied     = sharedErr.NewBusinessError(sharedErr.ForbiddenErrCode, errors.New("access to another user is denied"))
+	ErrUserUUIDIsMissing    = sharedErr.NewBusinessError(sharedErr.BadRequestErrCode, errors.New("user UUID is missing"))
 	ErrInvalidUserUUID      = sharedErr.NewBusinessError(sharedErr.BadRequestErrCode, errors.New("invalid user UUID"))
 	ErrInvalidEmail         = sharedErr.NewBusinessError(sharedErr.BadRequestErrCode, errors.New("invalid email"))
 	ErrPasswordIsRequired   = sharedErr.NewBusinessError(sharedErr.BadRequestErrCode, errors.New("password is required"))
 	ErrUserNotFound         = sharedErr.NewBusinessError(sharedErr.NotFoundErrCode, errors.New("user not found"))
 	ErrUserAlreadyExist     = sharedErr.NewBusinessError(sharedErr.NotFoundErrCode, errors.New("user already exists"))
-	ErrWeakPassword         = sharedErr.NewBusinessError(sharedErr.BadRequestErrCode, errors.New("password must be at least 8 characters"))
+	ErrSigningKeyNotFound   = sharedErr.NewBusinessError(sharedErr.NotFoundErrCode, errors.New("signing key not found"))
+	ErrTooManyLoginAttempts = sharedErr.NewBusinessError(sharedErr.TooManyRequestsErrCode, errors.New("too many failed login attempts, try again later"))
+	ErrLoginIsMissing       = sharedErr.NewBusinessError(sharedErr.BadRequestErrCode, errors.New("login is missing"))
+	ErrEmailAlreadyInUse    = sharedErr.NewBusinessError(sharedErr.FailedPreconditionErrCode, errors.New("email already in use"))
+	ErrWrongPassword        = sharedErr.NewBusinessError(sharedErr.UnauthorizedErrCode, errors.New("wrong password"))
+	ErrInvalidToken         = sharedErr.NewBusinessError(sharedErr.BadRequestErrCode, errors.New("invalid or expired token"))
+	ErrEmailNotVerified     = sharedErr.NewBusinessError(sharedErr.ForbiddenErrCode, errors.New("email is not verified"))
+	ErrSamePassword         = sharedErr.NewBusinessError(sharedErr.BadRequestErrCode, errors.New("new password must differ from the old one"))
+	ErrUnsupportedLocale    = sharedErr.NewBusinessError(sharedErr.BadRequestErrCode, errors.New("unsupported locale"))
+	ErrInvalidTimezone      = sharedErr.NewBusinessError(sharedErr.BadRequestErrCode, errors.New("invalid timezone"))
+
+	ErrNotificationMethodAlreadyExist = sharedErr.NewBusinessError(sharedErr.FailedPreconditionErrCode, errors.New("notification method already exists"))
+	ErrNotificationMethodNotFound     = sharedErr.NewBusinessError(sharedErr.NotFoundErrCode, errors.New("notification method not found"))
 )
+
+// NewWeakPasswordError - пароль не прошел политику паролей, reason - конкретное нарушение
+func NewWeakPasswordError(reason error) error {
+	return sharedErr.NewBusinessError(sharedErr.BadRequestErrCode, reason)
+}
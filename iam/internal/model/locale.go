@@ -0,0 +1,55 @@
+package model
+
+import (
+	"strings"
+	"time"
+)
+
+// Языки, на которых notification умеет отправлять уведомления
+const (
+	LocaleRU = "ru"
+	LocaleEN = "en"
+
+	DefaultLocale   = LocaleRU
+	DefaultTimezone = "UTC"
+)
+
+var supportedLocales = map[string]struct{}{
+	LocaleRU: {},
+	LocaleEN: {},
+}
+
+// NormalizeLocale - приводит язык к виду, в котором он хранится: "en-US" и "EN_us" становятся "en".
+// Пустой язык заменяется языком по умолчанию
+func NormalizeLocale(locale string) (string, error) {
+	locale = strings.ToLower(strings.TrimSpace(locale))
+	if locale == "" {
+		return DefaultLocale, nil
+	}
+
+	if i := strings.IndexAny(locale, "-_"); i > 0 {
+		locale = locale[:i]
+	}
+
+	if _, ok := supportedLocales[locale]; !ok {
+		return "", ErrUnsupportedLocale
+	}
+	return locale, nil
+}
+
+// NormalizeTimezone - проверяет, что часовой пояс есть в базе IANA. Пустой пояс заменяется UTC
+func NormalizeTimezone(timezone string) (string, error) {
+	timezone = strings.TrimSpace(timezone)
+	if timezone == "" {
+		return DefaultTimezone, nil
+	}
+
+	// time.LoadLocation принимает "Local", но хранить пояс сервера бессмысленно
+	if timezone == "Local" {
+		return "", ErrInvalidTimezone
+	}
+	if _, err := time.LoadLocation(timezone); err != nil {
+		return "", ErrInvalidTimezone
+	}
+	return timezone, nil
+}
@@ -0,0 +1,11 @@
+package model
+
+// LoginSubject - субъект счетчика неудачных попыток входа для логина
+func LoginSubject(login string) string {
+	return "login:" + login
+}
+
+// IPSubject - субъект счетчика неудачных попыток входа для IP-адреса клиента
+func IPSubject(ip string) string {
+	return "ip:" + ip
+}
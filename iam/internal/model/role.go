@@ -0,0 +1,14 @@
+package model
+
+// Роли пользователей. Набор разрешений каждой роли хранится в таблице role_permissions
+const (
+	RoleCustomer = "customer"
+	RoleOperator = "operator"
+	RoleAdmin    = "admin"
+)
+
+// DefaultRole - роль, которая выдается пользователю при регистрации
+const DefaultRole = RoleCustomer
+
+// PermissionUsersManage - разрешение работать с чужими учетными записями и сессиями
+const PermissionUsersManage = "users:manage"
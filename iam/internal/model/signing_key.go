@@ -0,0 +1,31 @@
+package model
+
+import (
+	"crypto/ed25519"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// SigningKey - ключ подписи токенов доступа
+type SigningKey struct {
+	KID        string
+	PrivateKey ed25519.PrivateKey
+	PublicKey  ed25519.PublicKey
+	CreatedAt  time.Time
+	// RotatedAt - время вывода ключа из использования. Не задано для текущего ключа
+	RotatedAt *time.Time
+	// VerifiableUntil - момент, после которого все подписанные ключом токены истекли. Не задано для текущего ключа
+	VerifiableUntil *time.Time
+}
+
+// AccessToken - подписанный токен доступа
+type AccessToken struct {
+	Value     string
+	ExpiresAt time.Time
+}
+
+type LoginResult struct {
+	SessionUUID uuid.UUID
+	AccessToken AccessToken
+}
@@ -1,16 +1,31 @@
 package model
 
 import (
+	"slices"
 	"time"
 
 	"github.com/google/uuid"
 )
 
 type User struct {
-	UUID      uuid.UUID
-	Info      UserInfo
-	CreatedAt time.Time
-	UpdatedAt *time.Time
+	UUID        uuid.UUID
+	Info        UserInfo
+	Roles       []string
+	Permissions []string
+	CreatedAt   time.Time
+	UpdatedAt   *time.Time
+
+	EmailVerifiedAt *time.Time
+}
+
+// IsEmailVerified - подтвердил ли пользователь свой email
+func (u User) IsEmailVerified() bool {
+	return u.EmailVerifiedAt != nil
+}
+
+// HasPermission - выдано ли разрешение ролям пользователя
+func (u User) HasPermission(permission string) bool {
+	return slices.Contains(u.Permissions, permission)
 }
 
 type UserRegistrationInfo struct {
@@ -23,6 +38,8 @@ type UserInfo struct {
 	Email               string
 	PasswordHash        string
 	NotificationMethods []NotificationMethod
+	Locale              string
+	Timezone            string
 }
 
 type NotificationMethod struct {
@@ -0,0 +1,39 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// TokenPurpose - назначение одноразового токена пользователя
+type TokenPurpose string
+
+const (
+	TokenPurposeEmailVerification TokenPurpose = "EMAIL_VERIFICATION"
+	TokenPurposePasswordReset     TokenPurpose = "PASSWORD_RESET"
+)
+
+func (p TokenPurpose) String() string {
+	return string(p)
+}
+
+// UserToken - одноразовый токен пользователя. Хранится только хеш токена
+type UserToken struct {
+	Hash      string
+	UserUUID  uuid.UUID
+	Purpose   TokenPurpose
+	CreatedAt time.Time
+	ExpiresAt time.Time
+}
+
+// UserTokenIssuedEvent - событие о выпуске токена, который нужно доставить пользователю на email
+type UserTokenIssuedEvent struct {
+	EventUUID uuid.UUID
+	UserUUID  uuid.UUID
+	Login     string
+	Email     string
+	Purpose   TokenPurpose
+	Token     string
+	ExpiresAt time.Time
+}
@@ -9,7 +9,12 @@ import (
 	repoModel "github.com/crafty-ezhik/rocket-factory/iam/internal/repository/model"
 )
 
-func SessionToServiceModel(data repoModel.Session) serviceModel.Session {
+func SessionToServiceModel(sessionUUID uuid.UUID, data repoModel.Session) (serviceModel.Session, error) {
+	userUUID, err := uuid.Parse(data.UserUUID)
+	if err != nil {
+		return serviceModel.Session{}, serviceModel.ErrSessionNotFound
+	}
+
 	var updatedAt *time.Time
 	if data.UpdatedAt != nil {
 		tmp := time.Unix(*data.UpdatedAt, 0)
@@ -17,9 +22,10 @@ func SessionToServiceModel(data repoModel.Session) serviceModel.Session {
 	}
 
 	return serviceModel.Session{
-		UserUUID:  uuid.MustParse(data.UserUUID),
+		UUID:      sessionUUID,
+		UserUUID:  userUUID,
 		CreatedAt: time.Unix(data.CreatedAt, 0),
 		UpdatedAt: updatedAt,
 		ExpiresAt: time.Unix(data.ExpiresAt, 0),
-	}
+	}, nil
 }
@@ -0,0 +1,26 @@
+package converter
+
+import (
+	"crypto/ed25519"
+
+	serviceModel "github.com/crafty-ezhik/rocket-factory/iam/internal/model"
+	repoModel "github.com/crafty-ezhik/rocket-factory/iam/internal/repository/model"
+)
+
+func SigningKeyToServiceModel(key repoModel.SigningKey) serviceModel.SigningKey {
+	return serviceModel.SigningKey{
+		KID:        key.KID,
+		PrivateKey: ed25519.PrivateKey(key.PrivateKey),
+		PublicKey:  ed25519.PublicKey(key.PublicKey),
+		CreatedAt:  key.CreatedAt,
+		RotatedAt:  key.RotatedAt,
+	}
+}
+
+func SigningKeysToServiceModel(keys []repoModel.SigningKey) []serviceModel.SigningKey {
+	out := make([]serviceModel.SigningKey, len(keys))
+	for i, key := range keys {
+		out[i] = SigningKeyToServiceModel(key)
+	}
+	return out
+}
@@ -13,9 +13,15 @@ func UserToServiceModel(user repoModel.User) serviceModel.User {
 			Email:               user.Info.Email,
 			PasswordHash:        user.Info.PasswordHash,
 			NotificationMethods: notificationMethodsToModel(user.Info.NotificationMethods),
+			Locale:              user.Info.Locale,
+			Timezone:            user.Info.Timezone,
 		},
-		CreatedAt: user.CreatedAt,
-		UpdatedAt: user.UpdatedAt,
+		Roles:       user.Roles,
+		Permissions: user.Permissions,
+		CreatedAt:   user.CreatedAt,
+		UpdatedAt:   user.UpdatedAt,
+
+		EmailVerifiedAt: user.EmailVerifiedAt,
 	}
 }
 
@@ -25,6 +31,8 @@ func UserRegInfoToRepoModel(data serviceModel.UserRegistrationInfo) repoModel.Us
 			Login:               data.Info.Login,
 			Email:               data.Info.Email,
 			NotificationMethods: notificationMethodsToRepo(data.Info.NotificationMethods),
+			Locale:              data.Info.Locale,
+			Timezone:            data.Info.Timezone,
 		},
 	}
 }
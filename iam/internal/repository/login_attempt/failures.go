@@ -0,0 +1,54 @@
+package login_attempt
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/gomodule/redigo/redis"
+)
+
+// Failures - возвращает число неудачных попыток входа в текущем окне
+func (r *repository) Failures(ctx context.Context, subject string) (int64, error) {
+	value, err := r.redis.Get(ctx, failuresKeyPrefix+subject)
+	if err != nil {
+		if errors.Is(err, redis.ErrNil) {
+			return 0, nil
+		}
+		return 0, fmt.Errorf("failed to get login failures: %w", err)
+	}
+
+	failures, err := strconv.ParseInt(string(value), 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("failed to parse login failures: %w", err)
+	}
+	return failures, nil
+}
+
+// RegisterFailure - учитывает неудачную попытку входа. Окно отсчитывается от первой неудачной попытки
+func (r *repository) RegisterFailure(ctx context.Context, subject string, window time.Duration) (int64, error) {
+	key := failuresKeyPrefix + subject
+
+	failures, err := r.redis.Incr(ctx, key)
+	if err != nil {
+		return 0, fmt.Errorf("failed to increment login failures: %w", err)
+	}
+
+	if failures == 1 {
+		err = r.redis.Expire(ctx, key, window)
+		if err != nil {
+			return 0, fmt.Errorf("failed to set login failures ttl: %w", err)
+		}
+	}
+	return failures, nil
+}
+
+func (r *repository) ResetFailures(ctx context.Context, subject string) error {
+	err := r.redis.Del(ctx, failuresKeyPrefix+subject)
+	if err != nil {
+		return fmt.Errorf("failed to reset login failures: %w", err)
+	}
+	return nil
+}
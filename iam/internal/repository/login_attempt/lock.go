@@ -0,0 +1,47 @@
+package login_attempt
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/gomodule/redigo/redis"
+)
+
+// Lock - запрещает вход до момента until. Redis сам снимет блокировку по истечении TTL
+func (r *repository) Lock(ctx context.Context, subject string, until time.Time) error {
+	err := r.redis.SetWithTTL(ctx, lockKeyPrefix+subject, until.Unix(), time.Until(until))
+	if err != nil {
+		return fmt.Errorf("failed to set login lock: %w", err)
+	}
+	return nil
+}
+
+// LockedUntil - возвращает момент снятия блокировки или нулевое время, если блокировки нет
+func (r *repository) LockedUntil(ctx context.Context, subject string) (time.Time, error) {
+	value, err := r.redis.Get(ctx, lockKeyPrefix+subject)
+	if err != nil {
+		if errors.Is(err, redis.ErrNil) {
+			return time.Time{}, nil
+		}
+		return time.Time{}, fmt.Errorf("failed to get login lock: %w", err)
+	}
+
+	until, err := strconv.ParseInt(string(value), 10, 64)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("failed to parse login lock: %w", err)
+	}
+	return time.Unix(until, 0), nil
+}
+
+// Unlock - снимает блокировку и сбрасывает счетчик неудачных попыток
+func (r *repository) Unlock(ctx context.Context, subject string) error {
+	err := r.redis.Del(ctx, lockKeyPrefix+subject)
+	if err != nil {
+		return fmt.Errorf("failed to delete login lock: %w", err)
+	}
+
+	return r.ResetFailures(ctx, subject)
+}
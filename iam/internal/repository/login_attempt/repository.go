@@ -0,0 +1,21 @@
+package login_attempt
+
+import (
+	def "github.com/crafty-ezhik/rocket-factory/iam/internal/repository"
+	"github.com/crafty-ezhik/rocket-factory/platform/pkg/cache"
+)
+
+var _ def.LoginAttemptRepository = (*repository)(nil)
+
+const (
+	failuresKeyPrefix = "iam:login_failures:"
+	lockKeyPrefix     = "iam:login_lock:"
+)
+
+type repository struct {
+	redis cache.RedisClient
+}
+
+func NewRepository(redis cache.RedisClient) *repository {
+	return &repository{redis: redis}
+}
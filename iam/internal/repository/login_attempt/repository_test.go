@@ -0,0 +1,125 @@
+package login_attempt
+
+import (
+	"errors"
+	"time"
+
+	"github.com/gomodule/redigo/redis"
+	"github.com/stretchr/testify/mock"
+)
+
+const testSubject = "login:engineer"
+
+func (s *RepositorySuite) TestFailures() {
+	tests := []struct {
+		name        string
+		value       []byte
+		err         error
+		expected    int64
+		expectedErr bool
+	}{
+		{name: "no failures", err: redis.ErrNil, expected: 0},
+		{name: "stored counter", value: []byte("4"), expected: 4},
+		{name: "corrupted counter", value: []byte("four"), expectedErr: true},
+		{name: "redis error", err: errors.New("connection refused"), expectedErr: true},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			s.SetupTest()
+			s.redis.EXPECT().Get(s.ctx, failuresKeyPrefix+testSubject).Return(tt.value, tt.err).Once()
+
+			failures, err := s.repository.Failures(s.ctx, testSubject)
+			if tt.expectedErr {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+			s.Equal(tt.expected, failures)
+		})
+	}
+}
+
+func (s *RepositorySuite) TestRegisterFailureStartsWindow() {
+	key := failuresKeyPrefix + testSubject
+
+	s.redis.EXPECT().Incr(s.ctx, key).Return(1, nil).Once()
+	s.redis.EXPECT().Expire(s.ctx, key, 15*time.Minute).Return(nil).Once()
+
+	failures, err := s.repository.RegisterFailure(s.ctx, testSubject, 15*time.Minute)
+	s.Require().NoError(err)
+	s.Equal(int64(1), failures)
+}
+
+func (s *RepositorySuite) TestRegisterFailureKeepsWindow() {
+	// Окно отсчитывается от первой попытки: следующие попытки не продлевают TTL
+	s.redis.EXPECT().Incr(s.ctx, failuresKeyPrefix+testSubject).Return(3, nil).Once()
+
+	failures, err := s.repository.RegisterFailure(s.ctx, testSubject, 15*time.Minute)
+	s.Require().NoError(err)
+	s.Equal(int64(3), failures)
+	s.redis.AssertNotCalled(s.T(), "Expire", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (s *RepositorySuite) TestRegisterFailureExpireError() {
+	s.redis.EXPECT().Incr(s.ctx, failuresKeyPrefix+testSubject).Return(1, nil).Once()
+	s.redis.EXPECT().Expire(s.ctx, failuresKeyPrefix+testSubject, time.Minute).Return(errors.New("timeout")).Once()
+
+	_, err := s.repository.RegisterFailure(s.ctx, testSubject, time.Minute)
+	s.Require().Error(err)
+}
+
+func (s *RepositorySuite) TestLock() {
+	until := time.Now().Add(15 * time.Minute)
+
+	s.redis.EXPECT().SetWithTTL(s.ctx, lockKeyPrefix+testSubject, until.Unix(), mock.MatchedBy(func(ttl time.Duration) bool {
+		return ttl > 14*time.Minute && ttl <= 15*time.Minute
+	})).Return(nil).Once()
+
+	s.Require().NoError(s.repository.Lock(s.ctx, testSubject, until))
+}
+
+func (s *RepositorySuite) TestLockedUntil() {
+	until := time.Unix(1_900_000_000, 0)
+
+	tests := []struct {
+		name        string
+		value       []byte
+		err         error
+		expected    time.Time
+		expectedErr bool
+	}{
+		{name: "not locked", err: redis.ErrNil},
+		{name: "locked", value: []byte("1900000000"), expected: until},
+		{name: "corrupted lock", value: []byte("soon"), expectedErr: true},
+		{name: "redis error", err: errors.New("connection refused"), expectedErr: true},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			s.SetupTest()
+			s.redis.EXPECT().Get(s.ctx, lockKeyPrefix+testSubject).Return(tt.value, tt.err).Once()
+
+			lockedUntil, err := s.repository.LockedUntil(s.ctx, testSubject)
+			if tt.expectedErr {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+			s.True(tt.expected.Equal(lockedUntil))
+		})
+	}
+}
+
+func (s *RepositorySuite) TestUnlockResetsFailures() {
+	s.redis.EXPECT().Del(s.ctx, lockKeyPrefix+testSubject).Return(nil).Once()
+	s.redis.EXPECT().Del(s.ctx, failuresKeyPrefix+testSubject).Return(nil).Once()
+
+	s.Require().NoError(s.repository.Unlock(s.ctx, testSubject))
+}
+
+func (s *RepositorySuite) TestUnlockError() {
+	s.redis.EXPECT().Del(s.ctx, lockKeyPrefix+testSubject).Return(errors.New("timeout")).Once()
+
+	s.Require().Error(s.repository.Unlock(s.ctx, testSubject))
+}
@@ -0,0 +1,27 @@
+package login_attempt
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/crafty-ezhik/rocket-factory/platform/pkg/cache/mocks"
+)
+
+type RepositorySuite struct {
+	suite.Suite
+	ctx        context.Context //nolint:containedctx
+	redis      *mocks.MockRedisClient
+	repository *repository
+}
+
+func (s *RepositorySuite) SetupTest() {
+	s.ctx = context.Background()
+	s.redis = mocks.NewMockRedisClient(s.T())
+	s.repository = NewRepository(s.redis)
+}
+
+func TestRepositoryIntegration(t *testing.T) {
+	suite.Run(t, new(RepositorySuite))
+}
@@ -0,0 +1,423 @@
+// Code generated for crafty-ezhik service
+// © Crafty-ezhik 2025.
+
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"context"
+	"time"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockLoginAttemptRepository creates a new instance of MockLoginAttemptRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockLoginAttemptRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockLoginAttemptRepository {
+	mock := &MockLoginAttemptRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockLoginAttemptRepository is an autogenerated mock type for the LoginAttemptRepository type
+type MockLoginAttemptRepository struct {
+	mock.Mock
+}
+
+type MockLoginAttemptRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockLoginAttemptRepository) EXPECT() *MockLoginAttemptRepository_Expecter {
+	return &MockLoginAttemptRepository_Expecter{mock: &_m.Mock}
+}
+
+// Failures provides a mock function for the type MockLoginAttemptRepository
+func (_mock *MockLoginAttemptRepository) Failures(ctx context.Context, subject string) (int64, error) {
+	ret := _mock.Called(ctx, subject)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Failures")
+	}
+
+	var r0 int64
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (int64, error)); ok {
+		return returnFunc(ctx, subject)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) int64); ok {
+		r0 = returnFunc(ctx, subject)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, subject)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockLoginAttemptRepository_Failures_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Failures'
+type MockLoginAttemptRepository_Failures_Call struct {
+	*mock.Call
+}
+
+// Failures is a helper method to define mock.On call
+//   - ctx context.Context
+//   - subject string
+func (_e *MockLoginAttemptRepository_Expecter) Failures(ctx interface{}, subject interface{}) *MockLoginAttemptRepository_Failures_Call {
+	return &MockLoginAttemptRepository_Failures_Call{Call: _e.mock.On("Failures", ctx, subject)}
+}
+
+func (_c *MockLoginAttemptRepository_Failures_Call) Run(run func(ctx context.Context, subject string)) *MockLoginAttemptRepository_Failures_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockLoginAttemptRepository_Failures_Call) Return(n int64, err error) *MockLoginAttemptRepository_Failures_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *MockLoginAttemptRepository_Failures_Call) RunAndReturn(run func(ctx context.Context, subject string) (int64, error)) *MockLoginAttemptRepository_Failures_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Lock provides a mock function for the type MockLoginAttemptRepository
+func (_mock *MockLoginAttemptRepository) Lock(ctx context.Context, subject string, until time.Time) error {
+	ret := _mock.Called(ctx, subject, until)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Lock")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, time.Time) error); ok {
+		r0 = returnFunc(ctx, subject, until)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockLoginAttemptRepository_Lock_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Lock'
+type MockLoginAttemptRepository_Lock_Call struct {
+	*mock.Call
+}
+
+// Lock is a helper method to define mock.On call
+//   - ctx context.Context
+//   - subject string
+//   - until time.Time
+func (_e *MockLoginAttemptRepository_Expecter) Lock(ctx interface{}, subject interface{}, until interface{}) *MockLoginAttemptRepository_Lock_Call {
+	return &MockLoginAttemptRepository_Lock_Call{Call: _e.mock.On("Lock", ctx, subject, until)}
+}
+
+func (_c *MockLoginAttemptRepository_Lock_Call) Run(run func(ctx context.Context, subject string, until time.Time)) *MockLoginAttemptRepository_Lock_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 time.Time
+		if args[2] != nil {
+			arg2 = args[2].(time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockLoginAttemptRepository_Lock_Call) Return(err error) *MockLoginAttemptRepository_Lock_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockLoginAttemptRepository_Lock_Call) RunAndReturn(run func(ctx context.Context, subject string, until time.Time) error) *MockLoginAttemptRepository_Lock_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// LockedUntil provides a mock function for the type MockLoginAttemptRepository
+func (_mock *MockLoginAttemptRepository) LockedUntil(ctx context.Context, subject string) (time.Time, error) {
+	ret := _mock.Called(ctx, subject)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LockedUntil")
+	}
+
+	var r0 time.Time
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (time.Time, error)); ok {
+		return returnFunc(ctx, subject)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) time.Time); ok {
+		r0 = returnFunc(ctx, subject)
+	} else {
+		r0 = ret.Get(0).(time.Time)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, subject)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockLoginAttemptRepository_LockedUntil_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LockedUntil'
+type MockLoginAttemptRepository_LockedUntil_Call struct {
+	*mock.Call
+}
+
+// LockedUntil is a helper method to define mock.On call
+//   - ctx context.Context
+//   - subject string
+func (_e *MockLoginAttemptRepository_Expecter) LockedUntil(ctx interface{}, subject interface{}) *MockLoginAttemptRepository_LockedUntil_Call {
+	return &MockLoginAttemptRepository_LockedUntil_Call{Call: _e.mock.On("LockedUntil", ctx, subject)}
+}
+
+func (_c *MockLoginAttemptRepository_LockedUntil_Call) Run(run func(ctx context.Context, subject string)) *MockLoginAttemptRepository_LockedUntil_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockLoginAttemptRepository_LockedUntil_Call) Return(time1 time.Time, err error) *MockLoginAttemptRepository_LockedUntil_Call {
+	_c.Call.Return(time1, err)
+	return _c
+}
+
+func (_c *MockLoginAttemptRepository_LockedUntil_Call) RunAndReturn(run func(ctx context.Context, subject string) (time.Time, error)) *MockLoginAttemptRepository_LockedUntil_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RegisterFailure provides a mock function for the type MockLoginAttemptRepository
+func (_mock *MockLoginAttemptRepository) RegisterFailure(ctx context.Context, subject string, window time.Duration) (int64, error) {
+	ret := _mock.Called(ctx, subject, window)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RegisterFailure")
+	}
+
+	var r0 int64
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, time.Duration) (int64, error)); ok {
+		return returnFunc(ctx, subject, window)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, time.Duration) int64); ok {
+		r0 = returnFunc(ctx, subject, window)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, time.Duration) error); ok {
+		r1 = returnFunc(ctx, subject, window)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockLoginAttemptRepository_RegisterFailure_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RegisterFailure'
+type MockLoginAttemptRepository_RegisterFailure_Call struct {
+	*mock.Call
+}
+
+// RegisterFailure is a helper method to define mock.On call
+//   - ctx context.Context
+//   - subject string
+//   - window time.Duration
+func (_e *MockLoginAttemptRepository_Expecter) RegisterFailure(ctx interface{}, subject interface{}, window interface{}) *MockLoginAttemptRepository_RegisterFailure_Call {
+	return &MockLoginAttemptRepository_RegisterFailure_Call{Call: _e.mock.On("RegisterFailure", ctx, subject, window)}
+}
+
+func (_c *MockLoginAttemptRepository_RegisterFailure_Call) Run(run func(ctx context.Context, subject string, window time.Duration)) *MockLoginAttemptRepository_RegisterFailure_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 time.Duration
+		if args[2] != nil {
+			arg2 = args[2].(time.Duration)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockLoginAttemptRepository_RegisterFailure_Call) Return(n int64, err error) *MockLoginAttemptRepository_RegisterFailure_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *MockLoginAttemptRepository_RegisterFailure_Call) RunAndReturn(run func(ctx context.Context, subject string, window time.Duration) (int64, error)) *MockLoginAttemptRepository_RegisterFailure_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ResetFailures provides a mock function for the type MockLoginAttemptRepository
+func (_mock *MockLoginAttemptRepository) ResetFailures(ctx context.Context, subject string) error {
+	ret := _mock.Called(ctx, subject)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ResetFailures")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = returnFunc(ctx, subject)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockLoginAttemptRepository_ResetFailures_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ResetFailures'
+type MockLoginAttemptRepository_ResetFailures_Call struct {
+	*mock.Call
+}
+
+// ResetFailures is a helper method to define mock.On call
+//   - ctx context.Context
+//   - subject string
+func (_e *MockLoginAttemptRepository_Expecter) ResetFailures(ctx interface{}, subject interface{}) *MockLoginAttemptRepository_ResetFailures_Call {
+	return &MockLoginAttemptRepository_ResetFailures_Call{Call: _e.mock.On("ResetFailures", ctx, subject)}
+}
+
+func (_c *MockLoginAttemptRepository_ResetFailures_Call) Run(run func(ctx context.Context, subject string)) *MockLoginAttemptRepository_ResetFailures_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockLoginAttemptRepository_ResetFailures_Call) Return(err error) *MockLoginAttemptRepository_ResetFailures_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockLoginAttemptRepository_ResetFailures_Call) RunAndReturn(run func(ctx context.Context, subject string) error) *MockLoginAttemptRepository_ResetFailures_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Unlock provides a mock function for the type MockLoginAttemptRepository
+func (_mock *MockLoginAttemptRepository) Unlock(ctx context.Context, subject string) error {
+	ret := _mock.Called(ctx, subject)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Unlock")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = returnFunc(ctx, subject)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockLoginAttemptRepository_Unlock_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Unlock'
+type MockLoginAttemptRepository_Unlock_Call struct {
+	*mock.Call
+}
+
+// Unlock is a helper method to define mock.On call
+//   - ctx context.Context
+//   - subject string
+func (_e *MockLoginAttemptRepository_Expecter) Unlock(ctx interface{}, subject interface{}) *MockLoginAttemptRepository_Unlock_Call {
+	return &MockLoginAttemptRepository_Unlock_Call{Call: _e.mock.On("Unlock", ctx, subject)}
+}
+
+func (_c *MockLoginAttemptRepository_Unlock_Call) Run(run func(ctx context.Context, subject string)) *MockLoginAttemptRepository_Unlock_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockLoginAttemptRepository_Unlock_Call) Return(err error) *MockLoginAttemptRepository_Unlock_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockLoginAttemptRepository_Unlock_Call) RunAndReturn(run func(ctx context.Context, subject string) error) *MockLoginAttemptRepository_Unlock_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -9,6 +9,7 @@ package mocks
 
 import (
 	"context"
+	"time"
 
 	"github.com/crafty-ezhik/rocket-factory/iam/internal/model"
 	"github.com/google/uuid"
@@ -43,16 +44,16 @@ func (_m *MockSessionRepository) EXPECT() *MockSessionRepository_Expecter {
 }
 
 // AddToUserSet provides a mock function for the type MockSessionRepository
-func (_mock *MockSessionRepository) AddToUserSet(ctx context.Context, userUUID uuid.UUID, sessionUUID uuid.UUID) error {
-	ret := _mock.Called(ctx, userUUID, sessionUUID)
+func (_mock *MockSessionRepository) AddToUserSet(ctx context.Context, userUUID uuid.UUID, sessionUUID uuid.UUID, ttl time.Duration) error {
+	ret := _mock.Called(ctx, userUUID, sessionUUID, ttl)
 
 	if len(ret) == 0 {
 		panic("no return value specified for AddToUserSet")
 	}
 
 	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
-		r0 = returnFunc(ctx, userUUID, sessionUUID)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, time.Duration) error); ok {
+		r0 = returnFunc(ctx, userUUID, sessionUUID, ttl)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -68,11 +69,12 @@ type MockSessionRepository_AddToUserSet_Call struct {
 //   - ctx context.Context
 //   - userUUID uuid.UUID
 //   - sessionUUID uuid.UUID
-func (_e *MockSessionRepository_Expecter) AddToUserSet(ctx interface{}, userUUID interface{}, sessionUUID interface{}) *MockSessionRepository_AddToUserSet_Call {
-	return &MockSessionRepository_AddToUserSet_Call{Call: _e.mock.On("AddToUserSet", ctx, userUUID, sessionUUID)}
+//   - ttl time.Duration
+func (_e *MockSessionRepository_Expecter) AddToUserSet(ctx interface{}, userUUID interface{}, sessionUUID interface{}, ttl interface{}) *MockSessionRepository_AddToUserSet_Call {
+	return &MockSessionRepository_AddToUserSet_Call{Call: _e.mock.On("AddToUserSet", ctx, userUUID, sessionUUID, ttl)}
 }
 
-func (_c *MockSessionRepository_AddToUserSet_Call) Run(run func(ctx context.Context, userUUID uuid.UUID, sessionUUID uuid.UUID)) *MockSessionRepository_AddToUserSet_Call {
+func (_c *MockSessionRepository_AddToUserSet_Call) Run(run func(ctx context.Context, userUUID uuid.UUID, sessionUUID uuid.UUID, ttl time.Duration)) *MockSessionRepository_AddToUserSet_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -86,10 +88,15 @@ func (_c *MockSessionRepository_AddToUserSet_Call) Run(run func(ctx context.Cont
 		if args[2] != nil {
 			arg2 = args[2].(uuid.UUID)
 		}
+		var arg3 time.Duration
+		if args[3] != nil {
+			arg3 = args[3].(time.Duration)
+		}
 		run(
 			arg0,
 			arg1,
 			arg2,
+			arg3,
 		)
 	})
 	return _c
@@ -100,14 +107,14 @@ func (_c *MockSessionRepository_AddToUserSet_Call) Return(err error) *MockSessio
 	return _c
 }
 
-func (_c *MockSessionRepository_AddToUserSet_Call) RunAndReturn(run func(ctx context.Context, userUUID uuid.UUID, sessionUUID uuid.UUID) error) *MockSessionRepository_AddToUserSet_Call {
+func (_c *MockSessionRepository_AddToUserSet_Call) RunAndReturn(run func(ctx context.Context, userUUID uuid.UUID, sessionUUID uuid.UUID, ttl time.Duration) error) *MockSessionRepository_AddToUserSet_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
 // Create provides a mock function for the type MockSessionRepository
-func (_mock *MockSessionRepository) Create(ctx context.Context, userUUID uuid.UUID) (uuid.UUID, error) {
-	ret := _mock.Called(ctx, userUUID)
+func (_mock *MockSessionRepository) Create(ctx context.Context, userUUID uuid.UUID, ttl time.Duration) (uuid.UUID, error) {
+	ret := _mock.Called(ctx, userUUID, ttl)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Create")
@@ -115,18 +122,18 @@ func (_mock *MockSessionRepository) Create(ctx context.Context, userUUID uuid.UU
 
 	var r0 uuid.UUID
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (uuid.UUID, error)); ok {
-		return returnFunc(ctx, userUUID)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, time.Duration) (uuid.UUID, error)); ok {
+		return returnFunc(ctx, userUUID, ttl)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) uuid.UUID); ok {
-		r0 = returnFunc(ctx, userUUID)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, time.Duration) uuid.UUID); ok {
+		r0 = returnFunc(ctx, userUUID, ttl)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(uuid.UUID)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
-		r1 = returnFunc(ctx, userUUID)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, time.Duration) error); ok {
+		r1 = returnFunc(ctx, userUUID, ttl)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -141,11 +148,12 @@ type MockSessionRepository_Create_Call struct {
 // Create is a helper method to define mock.On call
 //   - ctx context.Context
 //   - userUUID uuid.UUID
-func (_e *MockSessionRepository_Expecter) Create(ctx interface{}, userUUID interface{}) *MockSessionRepository_Create_Call {
-	return &MockSessionRepository_Create_Call{Call: _e.mock.On("Create", ctx, userUUID)}
+//   - ttl time.Duration
+func (_e *MockSessionRepository_Expecter) Create(ctx interface{}, userUUID interface{}, ttl interface{}) *MockSessionRepository_Create_Call {
+	return &MockSessionRepository_Create_Call{Call: _e.mock.On("Create", ctx, userUUID, ttl)}
 }
 
-func (_c *MockSessionRepository_Create_Call) Run(run func(ctx context.Context, userUUID uuid.UUID)) *MockSessionRepository_Create_Call {
+func (_c *MockSessionRepository_Create_Call) Run(run func(ctx context.Context, userUUID uuid.UUID, ttl time.Duration)) *MockSessionRepository_Create_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -155,9 +163,14 @@ func (_c *MockSessionRepository_Create_Call) Run(run func(ctx context.Context, u
 		if args[1] != nil {
 			arg1 = args[1].(uuid.UUID)
 		}
+		var arg2 time.Duration
+		if args[2] != nil {
+			arg2 = args[2].(time.Duration)
+		}
 		run(
 			arg0,
 			arg1,
+			arg2,
 		)
 	})
 	return _c
@@ -168,7 +181,70 @@ func (_c *MockSessionRepository_Create_Call) Return(uUID uuid.UUID, err error) *
 	return _c
 }
 
-func (_c *MockSessionRepository_Create_Call) RunAndReturn(run func(ctx context.Context, userUUID uuid.UUID) (uuid.UUID, error)) *MockSessionRepository_Create_Call {
+func (_c *MockSessionRepository_Create_Call) RunAndReturn(run func(ctx context.Context, userUUID uuid.UUID, ttl time.Duration) (uuid.UUID, error)) *MockSessionRepository_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function for the type MockSessionRepository
+func (_mock *MockSessionRepository) Delete(ctx context.Context, userUUID uuid.UUID, sessionUUID uuid.UUID) error {
+	ret := _mock.Called(ctx, userUUID, sessionUUID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r0 = returnFunc(ctx, userUUID, sessionUUID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockSessionRepository_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type MockSessionRepository_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userUUID uuid.UUID
+//   - sessionUUID uuid.UUID
+func (_e *MockSessionRepository_Expecter) Delete(ctx interface{}, userUUID interface{}, sessionUUID interface{}) *MockSessionRepository_Delete_Call {
+	return &MockSessionRepository_Delete_Call{Call: _e.mock.On("Delete", ctx, userUUID, sessionUUID)}
+}
+
+func (_c *MockSessionRepository_Delete_Call) Run(run func(ctx context.Context, userUUID uuid.UUID, sessionUUID uuid.UUID)) *MockSessionRepository_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 uuid.UUID
+		if args[2] != nil {
+			arg2 = args[2].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSessionRepository_Delete_Call) Return(err error) *MockSessionRepository_Delete_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockSessionRepository_Delete_Call) RunAndReturn(run func(ctx context.Context, userUUID uuid.UUID, sessionUUID uuid.UUID) error) *MockSessionRepository_Delete_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -238,3 +314,140 @@ func (_c *MockSessionRepository_Get_Call) RunAndReturn(run func(ctx context.Cont
 	_c.Call.Return(run)
 	return _c
 }
+
+// ListByUser provides a mock function for the type MockSessionRepository
+func (_mock *MockSessionRepository) ListByUser(ctx context.Context, userUUID uuid.UUID) ([]model.Session, error) {
+	ret := _mock.Called(ctx, userUUID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListByUser")
+	}
+
+	var r0 []model.Session
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]model.Session, error)); ok {
+		return returnFunc(ctx, userUUID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) []model.Session); ok {
+		r0 = returnFunc(ctx, userUUID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.Session)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, userUUID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSessionRepository_ListByUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListByUser'
+type MockSessionRepository_ListByUser_Call struct {
+	*mock.Call
+}
+
+// ListByUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userUUID uuid.UUID
+func (_e *MockSessionRepository_Expecter) ListByUser(ctx interface{}, userUUID interface{}) *MockSessionRepository_ListByUser_Call {
+	return &MockSessionRepository_ListByUser_Call{Call: _e.mock.On("ListByUser", ctx, userUUID)}
+}
+
+func (_c *MockSessionRepository_ListByUser_Call) Run(run func(ctx context.Context, userUUID uuid.UUID)) *MockSessionRepository_ListByUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSessionRepository_ListByUser_Call) Return(sessions []model.Session, err error) *MockSessionRepository_ListByUser_Call {
+	_c.Call.Return(sessions, err)
+	return _c
+}
+
+func (_c *MockSessionRepository_ListByUser_Call) RunAndReturn(run func(ctx context.Context, userUUID uuid.UUID) ([]model.Session, error)) *MockSessionRepository_ListByUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Refresh provides a mock function for the type MockSessionRepository
+func (_mock *MockSessionRepository) Refresh(ctx context.Context, userUUID uuid.UUID, sessionUUID uuid.UUID, expiresAt time.Time) error {
+	ret := _mock.Called(ctx, userUUID, sessionUUID, expiresAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Refresh")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, time.Time) error); ok {
+		r0 = returnFunc(ctx, userUUID, sessionUUID, expiresAt)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockSessionRepository_Refresh_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Refresh'
+type MockSessionRepository_Refresh_Call struct {
+	*mock.Call
+}
+
+// Refresh is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userUUID uuid.UUID
+//   - sessionUUID uuid.UUID
+//   - expiresAt time.Time
+func (_e *MockSessionRepository_Expecter) Refresh(ctx interface{}, userUUID interface{}, sessionUUID interface{}, expiresAt interface{}) *MockSessionRepository_Refresh_Call {
+	return &MockSessionRepository_Refresh_Call{Call: _e.mock.On("Refresh", ctx, userUUID, sessionUUID, expiresAt)}
+}
+
+func (_c *MockSessionRepository_Refresh_Call) Run(run func(ctx context.Context, userUUID uuid.UUID, sessionUUID uuid.UUID, expiresAt time.Time)) *MockSessionRepository_Refresh_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 uuid.UUID
+		if args[2] != nil {
+			arg2 = args[2].(uuid.UUID)
+		}
+		var arg3 time.Time
+		if args[3] != nil {
+			arg3 = args[3].(time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSessionRepository_Refresh_Call) Return(err error) *MockSessionRepository_Refresh_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockSessionRepository_Refresh_Call) RunAndReturn(run func(ctx context.Context, userUUID uuid.UUID, sessionUUID uuid.UUID, expiresAt time.Time) error) *MockSessionRepository_Refresh_Call {
+	_c.Call.Return(run)
+	return _c
+}
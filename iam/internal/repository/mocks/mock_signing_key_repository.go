@@ -0,0 +1,228 @@
+// Code generated for crafty-ezhik service
+// © Crafty-ezhik 2025.
+
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"context"
+	"time"
+
+	"github.com/crafty-ezhik/rocket-factory/iam/internal/model"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockSigningKeyRepository creates a new instance of MockSigningKeyRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockSigningKeyRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockSigningKeyRepository {
+	mock := &MockSigningKeyRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockSigningKeyRepository is an autogenerated mock type for the SigningKeyRepository type
+type MockSigningKeyRepository struct {
+	mock.Mock
+}
+
+type MockSigningKeyRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockSigningKeyRepository) EXPECT() *MockSigningKeyRepository_Expecter {
+	return &MockSigningKeyRepository_Expecter{mock: &_m.Mock}
+}
+
+// GetActive provides a mock function for the type MockSigningKeyRepository
+func (_mock *MockSigningKeyRepository) GetActive(ctx context.Context) (model.SigningKey, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetActive")
+	}
+
+	var r0 model.SigningKey
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) (model.SigningKey, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) model.SigningKey); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		r0 = ret.Get(0).(model.SigningKey)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSigningKeyRepository_GetActive_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetActive'
+type MockSigningKeyRepository_GetActive_Call struct {
+	*mock.Call
+}
+
+// GetActive is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockSigningKeyRepository_Expecter) GetActive(ctx interface{}) *MockSigningKeyRepository_GetActive_Call {
+	return &MockSigningKeyRepository_GetActive_Call{Call: _e.mock.On("GetActive", ctx)}
+}
+
+func (_c *MockSigningKeyRepository_GetActive_Call) Run(run func(ctx context.Context)) *MockSigningKeyRepository_GetActive_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSigningKeyRepository_GetActive_Call) Return(signingKey model.SigningKey, err error) *MockSigningKeyRepository_GetActive_Call {
+	_c.Call.Return(signingKey, err)
+	return _c
+}
+
+func (_c *MockSigningKeyRepository_GetActive_Call) RunAndReturn(run func(ctx context.Context) (model.SigningKey, error)) *MockSigningKeyRepository_GetActive_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListVerifiable provides a mock function for the type MockSigningKeyRepository
+func (_mock *MockSigningKeyRepository) ListVerifiable(ctx context.Context, rotatedAfter time.Time) ([]model.SigningKey, error) {
+	ret := _mock.Called(ctx, rotatedAfter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListVerifiable")
+	}
+
+	var r0 []model.SigningKey
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, time.Time) ([]model.SigningKey, error)); ok {
+		return returnFunc(ctx, rotatedAfter)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, time.Time) []model.SigningKey); ok {
+		r0 = returnFunc(ctx, rotatedAfter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.SigningKey)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, time.Time) error); ok {
+		r1 = returnFunc(ctx, rotatedAfter)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSigningKeyRepository_ListVerifiable_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListVerifiable'
+type MockSigningKeyRepository_ListVerifiable_Call struct {
+	*mock.Call
+}
+
+// ListVerifiable is a helper method to define mock.On call
+//   - ctx context.Context
+//   - rotatedAfter time.Time
+func (_e *MockSigningKeyRepository_Expecter) ListVerifiable(ctx interface{}, rotatedAfter interface{}) *MockSigningKeyRepository_ListVerifiable_Call {
+	return &MockSigningKeyRepository_ListVerifiable_Call{Call: _e.mock.On("ListVerifiable", ctx, rotatedAfter)}
+}
+
+func (_c *MockSigningKeyRepository_ListVerifiable_Call) Run(run func(ctx context.Context, rotatedAfter time.Time)) *MockSigningKeyRepository_ListVerifiable_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 time.Time
+		if args[1] != nil {
+			arg1 = args[1].(time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSigningKeyRepository_ListVerifiable_Call) Return(signingKeys []model.SigningKey, err error) *MockSigningKeyRepository_ListVerifiable_Call {
+	_c.Call.Return(signingKeys, err)
+	return _c
+}
+
+func (_c *MockSigningKeyRepository_ListVerifiable_Call) RunAndReturn(run func(ctx context.Context, rotatedAfter time.Time) ([]model.SigningKey, error)) *MockSigningKeyRepository_ListVerifiable_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Rotate provides a mock function for the type MockSigningKeyRepository
+func (_mock *MockSigningKeyRepository) Rotate(ctx context.Context, key model.SigningKey) error {
+	ret := _mock.Called(ctx, key)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Rotate")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, model.SigningKey) error); ok {
+		r0 = returnFunc(ctx, key)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockSigningKeyRepository_Rotate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Rotate'
+type MockSigningKeyRepository_Rotate_Call struct {
+	*mock.Call
+}
+
+// Rotate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - key model.SigningKey
+func (_e *MockSigningKeyRepository_Expecter) Rotate(ctx interface{}, key interface{}) *MockSigningKeyRepository_Rotate_Call {
+	return &MockSigningKeyRepository_Rotate_Call{Call: _e.mock.On("Rotate", ctx, key)}
+}
+
+func (_c *MockSigningKeyRepository_Rotate_Call) Run(run func(ctx context.Context, key model.SigningKey)) *MockSigningKeyRepository_Rotate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 model.SigningKey
+		if args[1] != nil {
+			arg1 = args[1].(model.SigningKey)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSigningKeyRepository_Rotate_Call) Return(err error) *MockSigningKeyRepository_Rotate_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockSigningKeyRepository_Rotate_Call) RunAndReturn(run func(ctx context.Context, key model.SigningKey) error) *MockSigningKeyRepository_Rotate_Call {
+	_c.Call.Return(run)
+	return _c
+}
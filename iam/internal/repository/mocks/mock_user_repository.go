@@ -42,6 +42,69 @@ func (_m *MockUserRepository) EXPECT() *MockUserRepository_Expecter {
 	return &MockUserRepository_Expecter{mock: &_m.Mock}
 }
 
+// AddNotificationMethod provides a mock function for the type MockUserRepository
+func (_mock *MockUserRepository) AddNotificationMethod(ctx context.Context, userUUID uuid.UUID, method model.NotificationMethod) error {
+	ret := _mock.Called(ctx, userUUID, method)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddNotificationMethod")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, model.NotificationMethod) error); ok {
+		r0 = returnFunc(ctx, userUUID, method)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockUserRepository_AddNotificationMethod_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddNotificationMethod'
+type MockUserRepository_AddNotificationMethod_Call struct {
+	*mock.Call
+}
+
+// AddNotificationMethod is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userUUID uuid.UUID
+//   - method model.NotificationMethod
+func (_e *MockUserRepository_Expecter) AddNotificationMethod(ctx interface{}, userUUID interface{}, method interface{}) *MockUserRepository_AddNotificationMethod_Call {
+	return &MockUserRepository_AddNotificationMethod_Call{Call: _e.mock.On("AddNotificationMethod", ctx, userUUID, method)}
+}
+
+func (_c *MockUserRepository_AddNotificationMethod_Call) Run(run func(ctx context.Context, userUUID uuid.UUID, method model.NotificationMethod)) *MockUserRepository_AddNotificationMethod_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 model.NotificationMethod
+		if args[2] != nil {
+			arg2 = args[2].(model.NotificationMethod)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockUserRepository_AddNotificationMethod_Call) Return(err error) *MockUserRepository_AddNotificationMethod_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockUserRepository_AddNotificationMethod_Call) RunAndReturn(run func(ctx context.Context, userUUID uuid.UUID, method model.NotificationMethod) error) *MockUserRepository_AddNotificationMethod_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Create provides a mock function for the type MockUserRepository
 func (_mock *MockUserRepository) Create(ctx context.Context, info model.UserRegistrationInfo, hashedPassword string) (uuid.UUID, error) {
 	ret := _mock.Called(ctx, info, hashedPassword)
@@ -247,3 +310,384 @@ func (_c *MockUserRepository_Get_Call) RunAndReturn(run func(ctx context.Context
 	_c.Call.Return(run)
 	return _c
 }
+
+// GetByEmail provides a mock function for the type MockUserRepository
+func (_mock *MockUserRepository) GetByEmail(ctx context.Context, email string) (model.User, error) {
+	ret := _mock.Called(ctx, email)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByEmail")
+	}
+
+	var r0 model.User
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (model.User, error)); ok {
+		return returnFunc(ctx, email)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) model.User); ok {
+		r0 = returnFunc(ctx, email)
+	} else {
+		r0 = ret.Get(0).(model.User)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, email)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockUserRepository_GetByEmail_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByEmail'
+type MockUserRepository_GetByEmail_Call struct {
+	*mock.Call
+}
+
+// GetByEmail is a helper method to define mock.On call
+//   - ctx context.Context
+//   - email string
+func (_e *MockUserRepository_Expecter) GetByEmail(ctx interface{}, email interface{}) *MockUserRepository_GetByEmail_Call {
+	return &MockUserRepository_GetByEmail_Call{Call: _e.mock.On("GetByEmail", ctx, email)}
+}
+
+func (_c *MockUserRepository_GetByEmail_Call) Run(run func(ctx context.Context, email string)) *MockUserRepository_GetByEmail_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockUserRepository_GetByEmail_Call) Return(user model.User, err error) *MockUserRepository_GetByEmail_Call {
+	_c.Call.Return(user, err)
+	return _c
+}
+
+func (_c *MockUserRepository_GetByEmail_Call) RunAndReturn(run func(ctx context.Context, email string) (model.User, error)) *MockUserRepository_GetByEmail_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoveNotificationMethod provides a mock function for the type MockUserRepository
+func (_mock *MockUserRepository) RemoveNotificationMethod(ctx context.Context, userUUID uuid.UUID, method model.NotificationMethod) error {
+	ret := _mock.Called(ctx, userUUID, method)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveNotificationMethod")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, model.NotificationMethod) error); ok {
+		r0 = returnFunc(ctx, userUUID, method)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockUserRepository_RemoveNotificationMethod_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveNotificationMethod'
+type MockUserRepository_RemoveNotificationMethod_Call struct {
+	*mock.Call
+}
+
+// RemoveNotificationMethod is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userUUID uuid.UUID
+//   - method model.NotificationMethod
+func (_e *MockUserRepository_Expecter) RemoveNotificationMethod(ctx interface{}, userUUID interface{}, method interface{}) *MockUserRepository_RemoveNotificationMethod_Call {
+	return &MockUserRepository_RemoveNotificationMethod_Call{Call: _e.mock.On("RemoveNotificationMethod", ctx, userUUID, method)}
+}
+
+func (_c *MockUserRepository_RemoveNotificationMethod_Call) Run(run func(ctx context.Context, userUUID uuid.UUID, method model.NotificationMethod)) *MockUserRepository_RemoveNotificationMethod_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 model.NotificationMethod
+		if args[2] != nil {
+			arg2 = args[2].(model.NotificationMethod)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockUserRepository_RemoveNotificationMethod_Call) Return(err error) *MockUserRepository_RemoveNotificationMethod_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockUserRepository_RemoveNotificationMethod_Call) RunAndReturn(run func(ctx context.Context, userUUID uuid.UUID, method model.NotificationMethod) error) *MockUserRepository_RemoveNotificationMethod_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SoftDelete provides a mock function for the type MockUserRepository
+func (_mock *MockUserRepository) SoftDelete(ctx context.Context, userUUID uuid.UUID) error {
+	ret := _mock.Called(ctx, userUUID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SoftDelete")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = returnFunc(ctx, userUUID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockUserRepository_SoftDelete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SoftDelete'
+type MockUserRepository_SoftDelete_Call struct {
+	*mock.Call
+}
+
+// SoftDelete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userUUID uuid.UUID
+func (_e *MockUserRepository_Expecter) SoftDelete(ctx interface{}, userUUID interface{}) *MockUserRepository_SoftDelete_Call {
+	return &MockUserRepository_SoftDelete_Call{Call: _e.mock.On("SoftDelete", ctx, userUUID)}
+}
+
+func (_c *MockUserRepository_SoftDelete_Call) Run(run func(ctx context.Context, userUUID uuid.UUID)) *MockUserRepository_SoftDelete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockUserRepository_SoftDelete_Call) Return(err error) *MockUserRepository_SoftDelete_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockUserRepository_SoftDelete_Call) RunAndReturn(run func(ctx context.Context, userUUID uuid.UUID) error) *MockUserRepository_SoftDelete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateEmail provides a mock function for the type MockUserRepository
+func (_mock *MockUserRepository) UpdateEmail(ctx context.Context, userUUID uuid.UUID, email string) error {
+	ret := _mock.Called(ctx, userUUID, email)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateEmail")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) error); ok {
+		r0 = returnFunc(ctx, userUUID, email)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockUserRepository_UpdateEmail_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateEmail'
+type MockUserRepository_UpdateEmail_Call struct {
+	*mock.Call
+}
+
+// UpdateEmail is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userUUID uuid.UUID
+//   - email string
+func (_e *MockUserRepository_Expecter) UpdateEmail(ctx interface{}, userUUID interface{}, email interface{}) *MockUserRepository_UpdateEmail_Call {
+	return &MockUserRepository_UpdateEmail_Call{Call: _e.mock.On("UpdateEmail", ctx, userUUID, email)}
+}
+
+func (_c *MockUserRepository_UpdateEmail_Call) Run(run func(ctx context.Context, userUUID uuid.UUID, email string)) *MockUserRepository_UpdateEmail_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockUserRepository_UpdateEmail_Call) Return(err error) *MockUserRepository_UpdateEmail_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockUserRepository_UpdateEmail_Call) RunAndReturn(run func(ctx context.Context, userUUID uuid.UUID, email string) error) *MockUserRepository_UpdateEmail_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdatePasswordHash provides a mock function for the type MockUserRepository
+func (_mock *MockUserRepository) UpdatePasswordHash(ctx context.Context, userUUID uuid.UUID, hashedPassword string) error {
+	ret := _mock.Called(ctx, userUUID, hashedPassword)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdatePasswordHash")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) error); ok {
+		r0 = returnFunc(ctx, userUUID, hashedPassword)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockUserRepository_UpdatePasswordHash_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdatePasswordHash'
+type MockUserRepository_UpdatePasswordHash_Call struct {
+	*mock.Call
+}
+
+// UpdatePasswordHash is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userUUID uuid.UUID
+//   - hashedPassword string
+func (_e *MockUserRepository_Expecter) UpdatePasswordHash(ctx interface{}, userUUID interface{}, hashedPassword interface{}) *MockUserRepository_UpdatePasswordHash_Call {
+	return &MockUserRepository_UpdatePasswordHash_Call{Call: _e.mock.On("UpdatePasswordHash", ctx, userUUID, hashedPassword)}
+}
+
+func (_c *MockUserRepository_UpdatePasswordHash_Call) Run(run func(ctx context.Context, userUUID uuid.UUID, hashedPassword string)) *MockUserRepository_UpdatePasswordHash_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockUserRepository_UpdatePasswordHash_Call) Return(err error) *MockUserRepository_UpdatePasswordHash_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockUserRepository_UpdatePasswordHash_Call) RunAndReturn(run func(ctx context.Context, userUUID uuid.UUID, hashedPassword string) error) *MockUserRepository_UpdatePasswordHash_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdatePreferences provides a mock function for the type MockUserRepository
+func (_mock *MockUserRepository) UpdatePreferences(ctx context.Context, userUUID uuid.UUID, locale string, timezone string) error {
+	ret := _mock.Called(ctx, userUUID, locale, timezone)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdatePreferences")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, string) error); ok {
+		r0 = returnFunc(ctx, userUUID, locale, timezone)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockUserRepository_UpdatePreferences_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdatePreferences'
+type MockUserRepository_UpdatePreferences_Call struct {
+	*mock.Call
+}
+
+// UpdatePreferences is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userUUID uuid.UUID
+//   - locale string
+//   - timezone string
+func (_e *MockUserRepository_Expecter) UpdatePreferences(ctx interface{}, userUUID interface{}, locale interface{}, timezone interface{}) *MockUserRepository_UpdatePreferences_Call {
+	return &MockUserRepository_UpdatePreferences_Call{Call: _e.mock.On("UpdatePreferences", ctx, userUUID, locale, timezone)}
+}
+
+func (_c *MockUserRepository_UpdatePreferences_Call) Run(run func(ctx context.Context, userUUID uuid.UUID, locale string, timezone string)) *MockUserRepository_UpdatePreferences_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockUserRepository_UpdatePreferences_Call) Return(err error) *MockUserRepository_UpdatePreferences_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockUserRepository_UpdatePreferences_Call) RunAndReturn(run func(ctx context.Context, userUUID uuid.UUID, locale string, timezone string) error) *MockUserRepository_UpdatePreferences_Call {
+	_c.Call.Return(run)
+	return _c
+}
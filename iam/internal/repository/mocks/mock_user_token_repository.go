@@ -0,0 +1,242 @@
+// Code generated for crafty-ezhik service
+// © Crafty-ezhik 2025.
+
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/crafty-ezhik/rocket-factory/iam/internal/model"
+	"github.com/google/uuid"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockUserTokenRepository creates a new instance of MockUserTokenRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockUserTokenRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockUserTokenRepository {
+	mock := &MockUserTokenRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockUserTokenRepository is an autogenerated mock type for the UserTokenRepository type
+type MockUserTokenRepository struct {
+	mock.Mock
+}
+
+type MockUserTokenRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockUserTokenRepository) EXPECT() *MockUserTokenRepository_Expecter {
+	return &MockUserTokenRepository_Expecter{mock: &_m.Mock}
+}
+
+// ConsumeEmailVerification provides a mock function for the type MockUserTokenRepository
+func (_mock *MockUserTokenRepository) ConsumeEmailVerification(ctx context.Context, tokenHash string) (uuid.UUID, error) {
+	ret := _mock.Called(ctx, tokenHash)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ConsumeEmailVerification")
+	}
+
+	var r0 uuid.UUID
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (uuid.UUID, error)); ok {
+		return returnFunc(ctx, tokenHash)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) uuid.UUID); ok {
+		r0 = returnFunc(ctx, tokenHash)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(uuid.UUID)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, tokenHash)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockUserTokenRepository_ConsumeEmailVerification_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ConsumeEmailVerification'
+type MockUserTokenRepository_ConsumeEmailVerification_Call struct {
+	*mock.Call
+}
+
+// ConsumeEmailVerification is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tokenHash string
+func (_e *MockUserTokenRepository_Expecter) ConsumeEmailVerification(ctx interface{}, tokenHash interface{}) *MockUserTokenRepository_ConsumeEmailVerification_Call {
+	return &MockUserTokenRepository_ConsumeEmailVerification_Call{Call: _e.mock.On("ConsumeEmailVerification", ctx, tokenHash)}
+}
+
+func (_c *MockUserTokenRepository_ConsumeEmailVerification_Call) Run(run func(ctx context.Context, tokenHash string)) *MockUserTokenRepository_ConsumeEmailVerification_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockUserTokenRepository_ConsumeEmailVerification_Call) Return(uUID uuid.UUID, err error) *MockUserTokenRepository_ConsumeEmailVerification_Call {
+	_c.Call.Return(uUID, err)
+	return _c
+}
+
+func (_c *MockUserTokenRepository_ConsumeEmailVerification_Call) RunAndReturn(run func(ctx context.Context, tokenHash string) (uuid.UUID, error)) *MockUserTokenRepository_ConsumeEmailVerification_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ConsumePasswordReset provides a mock function for the type MockUserTokenRepository
+func (_mock *MockUserTokenRepository) ConsumePasswordReset(ctx context.Context, tokenHash string, hashedPassword string) (uuid.UUID, error) {
+	ret := _mock.Called(ctx, tokenHash, hashedPassword)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ConsumePasswordReset")
+	}
+
+	var r0 uuid.UUID
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) (uuid.UUID, error)); ok {
+		return returnFunc(ctx, tokenHash, hashedPassword)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) uuid.UUID); ok {
+		r0 = returnFunc(ctx, tokenHash, hashedPassword)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(uuid.UUID)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = returnFunc(ctx, tokenHash, hashedPassword)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockUserTokenRepository_ConsumePasswordReset_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ConsumePasswordReset'
+type MockUserTokenRepository_ConsumePasswordReset_Call struct {
+	*mock.Call
+}
+
+// ConsumePasswordReset is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tokenHash string
+//   - hashedPassword string
+func (_e *MockUserTokenRepository_Expecter) ConsumePasswordReset(ctx interface{}, tokenHash interface{}, hashedPassword interface{}) *MockUserTokenRepository_ConsumePasswordReset_Call {
+	return &MockUserTokenRepository_ConsumePasswordReset_Call{Call: _e.mock.On("ConsumePasswordReset", ctx, tokenHash, hashedPassword)}
+}
+
+func (_c *MockUserTokenRepository_ConsumePasswordReset_Call) Run(run func(ctx context.Context, tokenHash string, hashedPassword string)) *MockUserTokenRepository_ConsumePasswordReset_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockUserTokenRepository_ConsumePasswordReset_Call) Return(uUID uuid.UUID, err error) *MockUserTokenRepository_ConsumePasswordReset_Call {
+	_c.Call.Return(uUID, err)
+	return _c
+}
+
+func (_c *MockUserTokenRepository_ConsumePasswordReset_Call) RunAndReturn(run func(ctx context.Context, tokenHash string, hashedPassword string) (uuid.UUID, error)) *MockUserTokenRepository_ConsumePasswordReset_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Create provides a mock function for the type MockUserTokenRepository
+func (_mock *MockUserTokenRepository) Create(ctx context.Context, token model.UserToken) error {
+	ret := _mock.Called(ctx, token)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, model.UserToken) error); ok {
+		r0 = returnFunc(ctx, token)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockUserTokenRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockUserTokenRepository_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - token model.UserToken
+func (_e *MockUserTokenRepository_Expecter) Create(ctx interface{}, token interface{}) *MockUserTokenRepository_Create_Call {
+	return &MockUserTokenRepository_Create_Call{Call: _e.mock.On("Create", ctx, token)}
+}
+
+func (_c *MockUserTokenRepository_Create_Call) Run(run func(ctx context.Context, token model.UserToken)) *MockUserTokenRepository_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 model.UserToken
+		if args[1] != nil {
+			arg1 = args[1].(model.UserToken)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockUserTokenRepository_Create_Call) Return(err error) *MockUserTokenRepository_Create_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockUserTokenRepository_Create_Call) RunAndReturn(run func(ctx context.Context, token model.UserToken) error) *MockUserTokenRepository_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -0,0 +1,11 @@
+package model
+
+import "time"
+
+type SigningKey struct {
+	KID        string     `db:"kid"`
+	PrivateKey []byte     `db:"private_key"`
+	PublicKey  []byte     `db:"public_key"`
+	CreatedAt  time.Time  `db:"created_at"`
+	RotatedAt  *time.Time `db:"rotated_at"`
+}
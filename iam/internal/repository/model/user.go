@@ -7,10 +7,14 @@ import (
 )
 
 type User struct {
-	UUID      uuid.UUID
-	Info      UserInfo
-	CreatedAt time.Time
-	UpdatedAt *time.Time
+	UUID        uuid.UUID
+	Info        UserInfo
+	Roles       []string
+	Permissions []string
+	CreatedAt   time.Time
+	UpdatedAt   *time.Time
+
+	EmailVerifiedAt *time.Time
 }
 
 type UserRegistrationInfo struct {
@@ -22,6 +26,8 @@ type UserInfo struct {
 	Email               string
 	PasswordHash        string
 	NotificationMethods []NotificationMethod
+	Locale              string
+	Timezone            string
 }
 
 type NotificationMethod struct {
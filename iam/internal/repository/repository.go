@@ -2,6 +2,7 @@ package repository
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 
@@ -12,10 +13,45 @@ type UserRepository interface {
 	Get(ctx context.Context, userUUID uuid.UUID) (model.User, error)
 	Create(ctx context.Context, info model.UserRegistrationInfo, hashedPassword string) (uuid.UUID, error)
 	Exist(ctx context.Context, login string) (model.User, error)
+	GetByEmail(ctx context.Context, email string) (model.User, error)
+	UpdatePasswordHash(ctx context.Context, userUUID uuid.UUID, hashedPassword string) error
+	UpdateEmail(ctx context.Context, userUUID uuid.UUID, email string) error
+	UpdatePreferences(ctx context.Context, userUUID uuid.UUID, locale, timezone string) error
+	AddNotificationMethod(ctx context.Context, userUUID uuid.UUID, method model.NotificationMethod) error
+	RemoveNotificationMethod(ctx context.Context, userUUID uuid.UUID, method model.NotificationMethod) error
+	SoftDelete(ctx context.Context, userUUID uuid.UUID) error
+}
+
+// UserTokenRepository - одноразовые токены подтверждения email и сброса пароля.
+// Токены ищутся по хешу, см. model.UserToken
+type UserTokenRepository interface {
+	Create(ctx context.Context, token model.UserToken) error
+	ConsumeEmailVerification(ctx context.Context, tokenHash string) (uuid.UUID, error)
+	ConsumePasswordReset(ctx context.Context, tokenHash, hashedPassword string) (uuid.UUID, error)
 }
 
 type SessionRepository interface {
 	Get(ctx context.Context, sessionUUID uuid.UUID) (model.Session, error)
-	Create(ctx context.Context, userUUID uuid.UUID) (uuid.UUID, error)
-	AddToUserSet(ctx context.Context, userUUID, sessionUUID uuid.UUID) error
+	Create(ctx context.Context, userUUID uuid.UUID, ttl time.Duration) (uuid.UUID, error)
+	AddToUserSet(ctx context.Context, userUUID, sessionUUID uuid.UUID, ttl time.Duration) error
+	Delete(ctx context.Context, userUUID, sessionUUID uuid.UUID) error
+	ListByUser(ctx context.Context, userUUID uuid.UUID) ([]model.Session, error)
+	Refresh(ctx context.Context, userUUID, sessionUUID uuid.UUID, expiresAt time.Time) error
+}
+
+type SigningKeyRepository interface {
+	GetActive(ctx context.Context) (model.SigningKey, error)
+	ListVerifiable(ctx context.Context, rotatedAfter time.Time) ([]model.SigningKey, error)
+	Rotate(ctx context.Context, key model.SigningKey) error
+}
+
+// LoginAttemptRepository - счетчики неудачных попыток входа и блокировки.
+// subject - логин или IP-адрес, см. model.LoginSubject и model.IPSubject
+type LoginAttemptRepository interface {
+	Failures(ctx context.Context, subject string) (int64, error)
+	RegisterFailure(ctx context.Context, subject string, window time.Duration) (int64, error)
+	ResetFailures(ctx context.Context, subject string) error
+	Lock(ctx context.Context, subject string, until time.Time) error
+	LockedUntil(ctx context.Context, subject string) (time.Time, error)
+	Unlock(ctx context.Context, subject string) error
 }
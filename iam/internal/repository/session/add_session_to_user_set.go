@@ -3,14 +3,22 @@ package session
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/google/uuid"
 )
 
-func (r *repository) AddToUserSet(ctx context.Context, userUUID, sessionUUID uuid.UUID) error {
+// AddToUserSet - добавляет сессию в множество сессий пользователя.
+// Множество живет не меньше самой новой сессии пользователя
+func (r *repository) AddToUserSet(ctx context.Context, userUUID, sessionUUID uuid.UUID, ttl time.Duration) error {
 	err := r.redis.SAdd(ctx, userUUID.String(), sessionUUID.String())
 	if err != nil {
 		return fmt.Errorf("failed to add to user set: %w", err)
 	}
+
+	err = r.redis.Expire(ctx, userUUID.String(), ttl)
+	if err != nil {
+		return fmt.Errorf("failed to set user set ttl: %w", err)
+	}
 	return nil
 }
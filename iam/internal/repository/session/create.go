@@ -8,26 +8,15 @@ import (
 	"github.com/google/uuid"
 )
 
-const ttl = time.Hour * 24
-
-const (
-	fieldUserUUID  = "user_uuid"
-	fieldCreatedAt = "created_at"
-	fieldUpdatedAt = "updated_at"
-	fieldExpiresAt = "expires_at"
-)
-
-func (r *repository) Create(ctx context.Context, userUUID uuid.UUID) (uuid.UUID, error) {
+func (r *repository) Create(ctx context.Context, userUUID uuid.UUID, ttl time.Duration) (uuid.UUID, error) {
 	sessionUUID := uuid.New()
-	createdAt := time.Now().Unix()
-	expiresAt := time.Now().Add(ttl).Unix()
-	updatedAt := time.Now().Unix()
+	now := time.Now()
 
 	fields := map[string]any{
 		fieldUserUUID:  userUUID.String(),
-		fieldCreatedAt: createdAt,
-		fieldUpdatedAt: updatedAt,
-		fieldExpiresAt: expiresAt,
+		fieldCreatedAt: now.Unix(),
+		fieldUpdatedAt: now.Unix(),
+		fieldExpiresAt: now.Add(ttl).Unix(),
 	}
 
 	err := r.redis.HashSet(ctx, sessionUUID.String(), fields)
@@ -35,7 +24,13 @@ func (r *repository) Create(ctx context.Context, userUUID uuid.UUID) (uuid.UUID,
 		return uuid.Nil, fmt.Errorf("failed to set hash set: %w", err)
 	}
 
-	err = r.AddToUserSet(ctx, userUUID, sessionUUID)
+	// Redis сам удалит сессию по истечении времени жизни
+	err = r.redis.Expire(ctx, sessionUUID.String(), ttl)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("failed to set session ttl: %w", err)
+	}
+
+	err = r.AddToUserSet(ctx, userUUID, sessionUUID, ttl)
 	if err != nil {
 		return uuid.Nil, err
 	}
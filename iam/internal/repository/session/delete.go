@@ -0,0 +1,22 @@
+package session
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+)
+
+// Delete - удаляет сессию и убирает ее из множества сессий пользователя
+func (r *repository) Delete(ctx context.Context, userUUID, sessionUUID uuid.UUID) error {
+	err := r.redis.Del(ctx, sessionUUID.String())
+	if err != nil {
+		return fmt.Errorf("failed to delete session: %w", err)
+	}
+
+	err = r.redis.SRem(ctx, userUUID.String(), sessionUUID.String())
+	if err != nil {
+		return fmt.Errorf("failed to remove from user set: %w", err)
+	}
+	return nil
+}
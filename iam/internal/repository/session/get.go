@@ -18,11 +18,16 @@ func (r *repository) Get(ctx context.Context, sessionUUID uuid.UUID) (serviceMod
 		return serviceModel.Session{}, fmt.Errorf("failed to get session data: %w", err)
 	}
 
+	// Ключ удален по TTL или при выходе из сессии
+	if len(sessionData) == 0 {
+		return serviceModel.Session{}, serviceModel.ErrSessionNotFound
+	}
+
 	var session repoModel.Session
 	err = redis.ScanStruct(sessionData, &session)
 	if err != nil {
 		return serviceModel.Session{}, fmt.Errorf("failed to scan hash fields to struct: %w", err)
 	}
 
-	return converter.SessionToServiceModel(session), nil
+	return converter.SessionToServiceModel(sessionUUID, session)
 }
@@ -0,0 +1,41 @@
+package session
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	serviceModel "github.com/crafty-ezhik/rocket-factory/iam/internal/model"
+)
+
+// ListByUser - возвращает сессии пользователя. Сессии, удаленные по TTL, убираются из множества
+func (r *repository) ListByUser(ctx context.Context, userUUID uuid.UUID) ([]serviceModel.Session, error) {
+	members, err := r.redis.SMembers(ctx, userUUID.String())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user sessions: %w", err)
+	}
+
+	sessions := make([]serviceModel.Session, 0, len(members))
+	for _, member := range members {
+		sessionUUID, err := uuid.Parse(member)
+		if err != nil {
+			continue
+		}
+
+		session, err := r.Get(ctx, sessionUUID)
+		if err != nil {
+			if errors.Is(err, serviceModel.ErrSessionNotFound) {
+				if err = r.redis.SRem(ctx, userUUID.String(), member); err != nil {
+					return nil, fmt.Errorf("failed to remove from user set: %w", err)
+				}
+				continue
+			}
+			return nil, err
+		}
+
+		sessions = append(sessions, session)
+	}
+	return sessions, nil
+}
@@ -0,0 +1,36 @@
+package session
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Refresh - продлевает сессию до expiresAt
+func (r *repository) Refresh(ctx context.Context, userUUID, sessionUUID uuid.UUID, expiresAt time.Time) error {
+	now := time.Now()
+	ttl := expiresAt.Sub(now)
+
+	fields := map[string]any{
+		fieldUpdatedAt: now.Unix(),
+		fieldExpiresAt: expiresAt.Unix(),
+	}
+
+	err := r.redis.HashSet(ctx, sessionUUID.String(), fields)
+	if err != nil {
+		return fmt.Errorf("failed to set hash set: %w", err)
+	}
+
+	err = r.redis.Expire(ctx, sessionUUID.String(), ttl)
+	if err != nil {
+		return fmt.Errorf("failed to set session ttl: %w", err)
+	}
+
+	err = r.redis.Expire(ctx, userUUID.String(), ttl)
+	if err != nil {
+		return fmt.Errorf("failed to set user set ttl: %w", err)
+	}
+	return nil
+}
@@ -1,6 +1,18 @@
 package session
 
-import "github.com/crafty-ezhik/rocket-factory/platform/pkg/cache"
+import (
+	def "github.com/crafty-ezhik/rocket-factory/iam/internal/repository"
+	"github.com/crafty-ezhik/rocket-factory/platform/pkg/cache"
+)
+
+var _ def.SessionRepository = (*repository)(nil)
+
+const (
+	fieldUserUUID  = "user_uuid"
+	fieldCreatedAt = "created_at"
+	fieldUpdatedAt = "updated_at"
+	fieldExpiresAt = "expires_at"
+)
 
 type repository struct {
 	redis cache.RedisClient
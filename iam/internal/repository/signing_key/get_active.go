@@ -0,0 +1,41 @@
+package signing_key
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+
+	serviceModel "github.com/crafty-ezhik/rocket-factory/iam/internal/model"
+	"github.com/crafty-ezhik/rocket-factory/iam/internal/repository/converter"
+	repoModel "github.com/crafty-ezhik/rocket-factory/iam/internal/repository/model"
+)
+
+// GetActive - возвращает текущий ключ подписи
+func (r *repository) GetActive(ctx context.Context) (serviceModel.SigningKey, error) {
+	query, args, err := squirrel.Select(selectColumns()...).
+		From(signingKeysTable).
+		Where(squirrel.Eq{signingKeyFieldRotatedAt: nil}).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return serviceModel.SigningKey{}, fmt.Errorf("build signing key select: %w", err)
+	}
+
+	rows, err := r.pool.Query(ctx, query, args...)
+	if err != nil {
+		return serviceModel.SigningKey{}, fmt.Errorf("query active signing key: %w", err)
+	}
+
+	key, err := pgx.CollectExactlyOneRow(rows, pgx.RowToStructByName[repoModel.SigningKey])
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return serviceModel.SigningKey{}, serviceModel.ErrSigningKeyNotFound
+		}
+		return serviceModel.SigningKey{}, fmt.Errorf("collect active signing key: %w", err)
+	}
+
+	return converter.SigningKeyToServiceModel(key), nil
+}
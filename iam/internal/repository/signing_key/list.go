@@ -0,0 +1,43 @@
+package signing_key
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+
+	serviceModel "github.com/crafty-ezhik/rocket-factory/iam/internal/model"
+	"github.com/crafty-ezhik/rocket-factory/iam/internal/repository/converter"
+	repoModel "github.com/crafty-ezhik/rocket-factory/iam/internal/repository/model"
+)
+
+// ListVerifiable - возвращает текущий ключ и ключи, выведенные из использования позже rotatedAfter.
+// Ими еще могут быть подписаны не истекшие токены
+func (r *repository) ListVerifiable(ctx context.Context, rotatedAfter time.Time) ([]serviceModel.SigningKey, error) {
+	query, args, err := squirrel.Select(selectColumns()...).
+		From(signingKeysTable).
+		Where(squirrel.Or{
+			squirrel.Eq{signingKeyFieldRotatedAt: nil},
+			squirrel.Gt{signingKeyFieldRotatedAt: rotatedAfter},
+		}).
+		OrderBy(signingKeyFieldCreatedAt + " DESC").
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("build signing keys select: %w", err)
+	}
+
+	rows, err := r.pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("query signing keys: %w", err)
+	}
+
+	keys, err := pgx.CollectRows(rows, pgx.RowToStructByName[repoModel.SigningKey])
+	if err != nil {
+		return nil, fmt.Errorf("collect signing keys: %w", err)
+	}
+
+	return converter.SigningKeysToServiceModel(keys), nil
+}
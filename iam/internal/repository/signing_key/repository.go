@@ -0,0 +1,36 @@
+package signing_key
+
+import (
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	def "github.com/crafty-ezhik/rocket-factory/iam/internal/repository"
+)
+
+var _ def.SigningKeyRepository = (*repository)(nil)
+
+const (
+	signingKeysTable          = "signing_keys"
+	signingKeyFieldKID        = "kid"
+	signingKeyFieldPrivateKey = "private_key"
+	signingKeyFieldPublicKey  = "public_key"
+	signingKeyFieldCreatedAt  = "created_at"
+	signingKeyFieldRotatedAt  = "rotated_at"
+)
+
+type repository struct {
+	pool *pgxpool.Pool
+}
+
+func NewRepository(pool *pgxpool.Pool) *repository {
+	return &repository{pool: pool}
+}
+
+func selectColumns() []string {
+	return []string{
+		signingKeyFieldKID,
+		signingKeyFieldPrivateKey,
+		signingKeyFieldPublicKey,
+		signingKeyFieldCreatedAt,
+		signingKeyFieldRotatedAt,
+	}
+}
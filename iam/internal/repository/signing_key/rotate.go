@@ -0,0 +1,58 @@
+package signing_key
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+
+	"github.com/crafty-ezhik/rocket-factory/iam/internal/model"
+)
+
+// Rotate - выводит текущий ключ из использования и сохраняет новый текущий ключ.
+// Если ключ одновременно ротирует другой экземпляр IAM, новый ключ не сохраняется
+func (r *repository) Rotate(ctx context.Context, key model.SigningKey) error {
+	err := pgx.BeginFunc(ctx, r.pool, func(tx pgx.Tx) error {
+		retireStmt, args, err := squirrel.Update(signingKeysTable).
+			Set(signingKeyFieldRotatedAt, key.CreatedAt).
+			Where(squirrel.Eq{signingKeyFieldRotatedAt: nil}).
+			PlaceholderFormat(squirrel.Dollar).
+			ToSql()
+		if err != nil {
+			return fmt.Errorf("build signing key retire: %w", err)
+		}
+
+		_, err = tx.Exec(ctx, retireStmt, args...)
+		if err != nil {
+			return fmt.Errorf("retire signing key: %w", err)
+		}
+
+		insertStmt, args, err := squirrel.Insert(signingKeysTable).
+			Columns(signingKeyFieldKID, signingKeyFieldPrivateKey, signingKeyFieldPublicKey, signingKeyFieldCreatedAt).
+			Values(key.KID, []byte(key.PrivateKey), []byte(key.PublicKey), key.CreatedAt).
+			PlaceholderFormat(squirrel.Dollar).
+			ToSql()
+		if err != nil {
+			return fmt.Errorf("build signing key insert: %w", err)
+		}
+
+		_, err = tx.Exec(ctx, insertStmt, args...)
+		if err != nil {
+			return fmt.Errorf("insert signing key: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+			return nil
+		}
+		return err
+	}
+
+	return nil
+}
@@ -46,6 +46,16 @@ func (r *repository) Create(ctx context.Context, info model.UserRegistrationInfo
 			return fmt.Errorf("insert notification method: %w", err)
 		}
 
+		rolesStmt, args, err := userRolesInsertBuilder(userUUID, model.DefaultRole).ToSql()
+		if err != nil {
+			return fmt.Errorf("build user roles insert: %w", err)
+		}
+
+		_, err = tx.Exec(ctx, rolesStmt, args...)
+		if err != nil {
+			return fmt.Errorf("insert user roles: %w", err)
+		}
+
 		return nil
 	})
 	if err != nil {
@@ -57,8 +67,8 @@ func (r *repository) Create(ctx context.Context, info model.UserRegistrationInfo
 
 func usersInsertBuilder(info repoModel.UserRegistrationInfo, hashedPassword string) squirrel.InsertBuilder {
 	return squirrel.Insert(usersTable).
-		Columns(userFieldLogin, userFieldPassword, userFieldEmail).
-		Values(info.Info.Login, hashedPassword, info.Info.Email).
+		Columns(userFieldLogin, userFieldPassword, userFieldEmail, userFieldLocale, userFieldTimezone).
+		Values(info.Info.Login, hashedPassword, info.Info.Email, info.Info.Locale, info.Info.Timezone).
 		Suffix(fmt.Sprintf("RETURNING %s", userFieldUserUUID)).
 		PlaceholderFormat(squirrel.Dollar)
 }
@@ -73,3 +83,14 @@ func notificationInsertBuilder(userUUID uuid.UUID, methods []repoModel.Notificat
 	}
 	return builder
 }
+
+func userRolesInsertBuilder(userUUID uuid.UUID, roles ...string) squirrel.InsertBuilder {
+	builder := squirrel.Insert(userRolesTable).
+		Columns(userRolesFieldUserUUID, userRolesFieldRoleName).
+		PlaceholderFormat(squirrel.Dollar)
+
+	for _, role := range roles {
+		builder = builder.Values(userUUID, role)
+	}
+	return builder
+}
@@ -0,0 +1,49 @@
+package user
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/crafty-ezhik/rocket-factory/iam/internal/model"
+)
+
+// SoftDelete - помечает пользователя удаленным и удаляет его каналы уведомлений.
+// Логин и email освобождаются для повторной регистрации
+func (r *repository) SoftDelete(ctx context.Context, userUUID uuid.UUID) error {
+	return pgx.BeginFunc(ctx, r.pool, func(tx pgx.Tx) error {
+		userStmt, args, err := squirrel.Update(usersTable).
+			Set(userFieldDeletedAt, squirrel.Expr("now()")).
+			Set(userFieldUpdatedAt, squirrel.Expr("now()")).
+			Where(squirrel.Eq{userFieldUserUUID: userUUID, userFieldDeletedAt: nil}).
+			PlaceholderFormat(squirrel.Dollar).
+			ToSql()
+		if err != nil {
+			return fmt.Errorf("build user soft delete: %w", err)
+		}
+
+		tag, err := tx.Exec(ctx, userStmt, args...)
+		if err != nil {
+			return fmt.Errorf("soft delete user: %w", err)
+		}
+		if tag.RowsAffected() == 0 {
+			return model.ErrUserNotFound
+		}
+
+		methodsStmt, args, err := squirrel.Delete(notificationMethodsTable).
+			Where(squirrel.Eq{notificationMethodsFieldUserUUID: userUUID}).
+			PlaceholderFormat(squirrel.Dollar).
+			ToSql()
+		if err != nil {
+			return fmt.Errorf("build notification delete: %w", err)
+		}
+
+		if _, err = tx.Exec(ctx, methodsStmt, args...); err != nil {
+			return fmt.Errorf("delete notification methods: %w", err)
+		}
+		return nil
+	})
+}
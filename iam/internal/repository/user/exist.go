@@ -20,7 +20,7 @@ func (r *repository) Exist(ctx context.Context, login string) (model.User, error
 	}
 
 	var user repoModel.User
-	err = r.pool.QueryRow(ctx, query, args...).Scan(&user.UUID, &user.Info.PasswordHash)
+	err = r.pool.QueryRow(ctx, query, args...).Scan(&user.UUID, &user.Info.PasswordHash, &user.Info.Email, &user.EmailVerifiedAt)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			return model.User{}, model.ErrUserNotFound
@@ -32,9 +32,9 @@ func (r *repository) Exist(ctx context.Context, login string) (model.User, error
 }
 
 func buildSelectUserExistQuery(login string) squirrel.SelectBuilder {
-	builder := squirrel.Select(userFieldUserUUID, userFieldPassword).
+	builder := squirrel.Select(userFieldUserUUID, userFieldPassword, userFieldEmail, userFieldEmailVerifiedAt).
 		From(usersTable).
-		Where(squirrel.Eq{userFieldLogin: login}).
+		Where(squirrel.Eq{userFieldLogin: login, userFieldDeletedAt: nil}).
 		PlaceholderFormat(squirrel.Dollar)
 
 	return builder
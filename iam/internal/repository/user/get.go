@@ -31,6 +31,9 @@ func (r *repository) Get(ctx context.Context, userUUID uuid.UUID) (serviceModel.
 			&user.Info.Email,
 			&user.CreatedAt,
 			&user.UpdatedAt,
+			&user.EmailVerifiedAt,
+			&user.Info.Locale,
+			&user.Info.Timezone,
 		)
 		if err != nil {
 			if errors.Is(err, pgx.ErrNoRows) {
@@ -57,6 +60,17 @@ func (r *repository) Get(ctx context.Context, userUUID uuid.UUID) (serviceModel.
 
 		user.Info.NotificationMethods = methods
 
+		// Получаем роли и выданные им разрешения
+		user.Roles, err = collectStrings(ctx, tx, buildSelectRolesQuery(userUUID))
+		if err != nil {
+			return fmt.Errorf("query user roles: %w", err)
+		}
+
+		user.Permissions, err = collectStrings(ctx, tx, buildSelectPermissionsQuery(userUUID))
+		if err != nil {
+			return fmt.Errorf("query user permissions: %w", err)
+		}
+
 		return nil
 	})
 	if err != nil {
@@ -74,9 +88,12 @@ func buildSelectUserQuery(userUUID uuid.UUID) squirrel.SelectBuilder {
 		userFieldEmail,
 		userFieldCreatedAt,
 		userFieldUpdatedAt,
+		userFieldEmailVerifiedAt,
+		userFieldLocale,
+		userFieldTimezone,
 	).
 		From(usersTable).
-		Where(squirrel.Eq{userFieldUserUUID: userUUID}).
+		Where(squirrel.Eq{userFieldUserUUID: userUUID, userFieldDeletedAt: nil}).
 		PlaceholderFormat(squirrel.Dollar)
 
 	return builder
@@ -92,3 +109,43 @@ func buildSelectMethodsQuery(userUUID uuid.UUID) squirrel.SelectBuilder {
 
 	return builder
 }
+
+func buildSelectRolesQuery(userUUID uuid.UUID) squirrel.SelectBuilder {
+	builder := squirrel.Select(userRolesFieldRoleName).
+		From(userRolesTable).
+		Where(squirrel.Eq{userRolesFieldUserUUID: userUUID}).
+		OrderBy(userRolesFieldRoleName).
+		PlaceholderFormat(squirrel.Dollar)
+
+	return builder
+}
+
+func buildSelectPermissionsQuery(userUUID uuid.UUID) squirrel.SelectBuilder {
+	userRoles := squirrel.Select(userRolesFieldRoleName).
+		From(userRolesTable).
+		Where(squirrel.Eq{userRolesFieldUserUUID: userUUID})
+
+	builder := squirrel.Select(rolePermissionsFieldPermissionName).
+		Distinct().
+		From(rolePermissionsTable).
+		Where(squirrel.Expr(rolePermissionsFieldRoleName+" IN (?)", userRoles)).
+		OrderBy(rolePermissionsFieldPermissionName).
+		PlaceholderFormat(squirrel.Dollar)
+
+	return builder
+}
+
+// collectStrings - выполняет запрос, возвращающий одну текстовую колонку
+func collectStrings(ctx context.Context, tx pgx.Tx, builder squirrel.SelectBuilder) ([]string, error) {
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := tx.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	return pgx.CollectRows(rows, pgx.RowTo[string])
+}
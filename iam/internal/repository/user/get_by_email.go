@@ -0,0 +1,37 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/crafty-ezhik/rocket-factory/iam/internal/model"
+	"github.com/crafty-ezhik/rocket-factory/iam/internal/repository/converter"
+	repoModel "github.com/crafty-ezhik/rocket-factory/iam/internal/repository/model"
+)
+
+// GetByEmail - возвращает основные данные неудаленного пользователя по email, без каналов уведомлений и ролей
+func (r *repository) GetByEmail(ctx context.Context, email string) (model.User, error) {
+	query, args, err := squirrel.Select(userFieldUserUUID, userFieldLogin, userFieldEmail, userFieldEmailVerifiedAt, userFieldLocale, userFieldTimezone).
+		From(usersTable).
+		Where(squirrel.Eq{userFieldEmail: email, userFieldDeletedAt: nil}).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return model.User{}, fmt.Errorf("build user by email select: %w", err)
+	}
+
+	var user repoModel.User
+	err = r.pool.QueryRow(ctx, query, args...).Scan(&user.UUID, &user.Info.Login, &user.Info.Email, &user.EmailVerifiedAt, &user.Info.Locale, &user.Info.Timezone)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return model.User{}, model.ErrUserNotFound
+		}
+		return model.User{}, fmt.Errorf("query user by email: %w", err)
+	}
+
+	return converter.UserToServiceModel(user), nil
+}
@@ -0,0 +1,113 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+
+	"github.com/crafty-ezhik/rocket-factory/iam/internal/model"
+	repoModel "github.com/crafty-ezhik/rocket-factory/iam/internal/repository/model"
+)
+
+func (r *repository) AddNotificationMethod(ctx context.Context, userUUID uuid.UUID, method model.NotificationMethod) error {
+	return pgx.BeginFunc(ctx, r.pool, func(tx pgx.Tx) error {
+		// Блокируем пользователя, чтобы он не был удален параллельно
+		if err := lockActiveUser(ctx, tx, userUUID); err != nil {
+			return err
+		}
+
+		stmt, args, err := notificationInsertBuilder(userUUID, []repoModel.NotificationMethod{{
+			ProviderName: method.ProviderName,
+			Target:       method.Target,
+		}}).ToSql()
+		if err != nil {
+			return fmt.Errorf("build notification insert: %w", err)
+		}
+
+		_, err = tx.Exec(ctx, stmt, args...)
+		if err != nil {
+			var pgErr *pgconn.PgError
+			if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+				return model.ErrNotificationMethodAlreadyExist
+			}
+			return fmt.Errorf("insert notification method: %w", err)
+		}
+
+		return touchUser(ctx, tx, userUUID)
+	})
+}
+
+func (r *repository) RemoveNotificationMethod(ctx context.Context, userUUID uuid.UUID, method model.NotificationMethod) error {
+	return pgx.BeginFunc(ctx, r.pool, func(tx pgx.Tx) error {
+		if err := lockActiveUser(ctx, tx, userUUID); err != nil {
+			return err
+		}
+
+		stmt, args, err := squirrel.Delete(notificationMethodsTable).
+			Where(squirrel.Eq{
+				notificationMethodsFieldUserUUID:     userUUID,
+				notificationMethodsFieldProviderName: method.ProviderName,
+				notificationMethodsFieldTarget:       method.Target,
+			}).
+			PlaceholderFormat(squirrel.Dollar).
+			ToSql()
+		if err != nil {
+			return fmt.Errorf("build notification delete: %w", err)
+		}
+
+		tag, err := tx.Exec(ctx, stmt, args...)
+		if err != nil {
+			return fmt.Errorf("delete notification method: %w", err)
+		}
+		if tag.RowsAffected() == 0 {
+			return model.ErrNotificationMethodNotFound
+		}
+
+		return touchUser(ctx, tx, userUUID)
+	})
+}
+
+// lockActiveUser - блокирует строку неудаленного пользователя до конца транзакции
+func lockActiveUser(ctx context.Context, tx pgx.Tx, userUUID uuid.UUID) error {
+	query, args, err := squirrel.Select(userFieldUserUUID).
+		From(usersTable).
+		Where(squirrel.Eq{userFieldUserUUID: userUUID, userFieldDeletedAt: nil}).
+		Suffix("FOR UPDATE").
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("build user lock: %w", err)
+	}
+
+	var lockedUUID uuid.UUID
+	err = tx.QueryRow(ctx, query, args...).Scan(&lockedUUID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return model.ErrUserNotFound
+		}
+		return fmt.Errorf("lock user: %w", err)
+	}
+	return nil
+}
+
+// touchUser - обновляет время изменения пользователя
+func touchUser(ctx context.Context, tx pgx.Tx, userUUID uuid.UUID) error {
+	query, args, err := squirrel.Update(usersTable).
+		Set(userFieldUpdatedAt, squirrel.Expr("now()")).
+		Where(squirrel.Eq{userFieldUserUUID: userUUID}).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("build user touch: %w", err)
+	}
+
+	if _, err = tx.Exec(ctx, query, args...); err != nil {
+		return fmt.Errorf("touch user: %w", err)
+	}
+	return nil
+}
@@ -16,11 +16,29 @@ const (
 	userFieldPassword  = "password"
 	userFieldCreatedAt = "created_at"
 	userFieldUpdatedAt = "updated_at"
+	userFieldDeletedAt = "deleted_at"
+
+	userFieldEmailVerifiedAt = "email_verified_at"
+	userFieldLocale          = "locale"
+	userFieldTimezone        = "timezone"
 
 	notificationMethodsTable             = "notification_methods"
 	notificationMethodsFieldUserUUID     = "user_uuid"
 	notificationMethodsFieldProviderName = "provider_name"
 	notificationMethodsFieldTarget       = "target"
+
+	userRolesTable         = "user_roles"
+	userRolesFieldUserUUID = "user_uuid"
+	userRolesFieldRoleName = "role_name"
+
+	rolePermissionsTable               = "role_permissions"
+	rolePermissionsFieldRoleName       = "role_name"
+	rolePermissionsFieldPermissionName = "permission_name"
+
+	userTokensTable        = "user_tokens"
+	userTokenFieldUserUUID = "user_uuid"
+	userTokenFieldPurpose  = "purpose"
+	userTokenFieldUsedAt   = "used_at"
 )
 
 type repository struct {
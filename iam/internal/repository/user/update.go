@@ -0,0 +1,62 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+
+	"github.com/crafty-ezhik/rocket-factory/iam/internal/model"
+)
+
+// UpdateEmail - меняет email и сбрасывает его подтверждение.
+// Неиспользованные токены подтверждения старого email гасятся в той же транзакции,
+// иначе письмо на старый адрес подтвердило бы новый
+func (r *repository) UpdateEmail(ctx context.Context, userUUID uuid.UUID, email string) error {
+	userStmt, args, err := squirrel.Update(usersTable).
+		Set(userFieldEmail, email).
+		Set(userFieldEmailVerifiedAt, nil).
+		Set(userFieldUpdatedAt, squirrel.Expr("now()")).
+		Where(squirrel.Eq{userFieldUserUUID: userUUID, userFieldDeletedAt: nil}).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("build email update: %w", err)
+	}
+
+	tokensStmt, tokensArgs, err := squirrel.Update(userTokensTable).
+		Set(userTokenFieldUsedAt, squirrel.Expr("now()")).
+		Where(squirrel.Eq{
+			userTokenFieldUserUUID: userUUID,
+			userTokenFieldPurpose:  model.TokenPurposeEmailVerification.String(),
+			userTokenFieldUsedAt:   nil,
+		}).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("build email verification tokens revoke: %w", err)
+	}
+
+	return pgx.BeginFunc(ctx, r.pool, func(tx pgx.Tx) error {
+		tag, err := tx.Exec(ctx, userStmt, args...)
+		if err != nil {
+			var pgErr *pgconn.PgError
+			if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+				return model.ErrEmailAlreadyInUse
+			}
+			return fmt.Errorf("update email: %w", err)
+		}
+		if tag.RowsAffected() == 0 {
+			return model.ErrUserNotFound
+		}
+
+		if _, err = tx.Exec(ctx, tokensStmt, tokensArgs...); err != nil {
+			return fmt.Errorf("revoke email verification tokens: %w", err)
+		}
+		return nil
+	})
+}
@@ -0,0 +1,32 @@
+package user
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+
+	"github.com/crafty-ezhik/rocket-factory/iam/internal/model"
+)
+
+func (r *repository) UpdatePasswordHash(ctx context.Context, userUUID uuid.UUID, hashedPassword string) error {
+	query, args, err := squirrel.Update(usersTable).
+		Set(userFieldPassword, hashedPassword).
+		Set(userFieldUpdatedAt, squirrel.Expr("now()")).
+		Where(squirrel.Eq{userFieldUserUUID: userUUID, userFieldDeletedAt: nil}).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("build password update: %w", err)
+	}
+
+	tag, err := r.pool.Exec(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("update password: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return model.ErrUserNotFound
+	}
+	return nil
+}
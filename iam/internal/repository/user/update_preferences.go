@@ -0,0 +1,33 @@
+package user
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+
+	"github.com/crafty-ezhik/rocket-factory/iam/internal/model"
+)
+
+func (r *repository) UpdatePreferences(ctx context.Context, userUUID uuid.UUID, locale, timezone string) error {
+	query, args, err := squirrel.Update(usersTable).
+		Set(userFieldLocale, locale).
+		Set(userFieldTimezone, timezone).
+		Set(userFieldUpdatedAt, squirrel.Expr("now()")).
+		Where(squirrel.Eq{userFieldUserUUID: userUUID, userFieldDeletedAt: nil}).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("build preferences update: %w", err)
+	}
+
+	tag, err := r.pool.Exec(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("update preferences: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return model.ErrUserNotFound
+	}
+	return nil
+}
@@ -0,0 +1,104 @@
+package user_token
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/crafty-ezhik/rocket-factory/iam/internal/model"
+)
+
+// ConsumeEmailVerification - гасит токен подтверждения email и отмечает email пользователя подтвержденным
+func (r *repository) ConsumeEmailVerification(ctx context.Context, tokenHash string) (uuid.UUID, error) {
+	var userUUID uuid.UUID
+
+	err := pgx.BeginFunc(ctx, r.pool, func(tx pgx.Tx) error {
+		var err error
+		userUUID, err = consume(ctx, tx, tokenHash, model.TokenPurposeEmailVerification)
+		if err != nil {
+			return err
+		}
+
+		return updateActiveUser(ctx, tx, userUUID, squirrel.Update(usersTable).
+			Set(userFieldEmailVerifiedAt, squirrel.Expr("now()")))
+	})
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	return userUUID, nil
+}
+
+// ConsumePasswordReset - гасит токен сброса пароля и сохраняет новый хеш пароля
+func (r *repository) ConsumePasswordReset(ctx context.Context, tokenHash, hashedPassword string) (uuid.UUID, error) {
+	var userUUID uuid.UUID
+
+	err := pgx.BeginFunc(ctx, r.pool, func(tx pgx.Tx) error {
+		var err error
+		userUUID, err = consume(ctx, tx, tokenHash, model.TokenPurposePasswordReset)
+		if err != nil {
+			return err
+		}
+
+		return updateActiveUser(ctx, tx, userUUID, squirrel.Update(usersTable).
+			Set(userFieldPassword, hashedPassword))
+	})
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	return userUUID, nil
+}
+
+// consume - помечает токен использованным, если он не истек и еще не использован
+func consume(ctx context.Context, tx pgx.Tx, tokenHash string, purpose model.TokenPurpose) (uuid.UUID, error) {
+	query, args, err := squirrel.Update(userTokensTable).
+		Set(userTokenFieldUsedAt, squirrel.Expr("now()")).
+		Where(squirrel.Eq{
+			userTokenFieldTokenHash: tokenHash,
+			userTokenFieldPurpose:   purpose.String(),
+			userTokenFieldUsedAt:    nil,
+		}).
+		Where(squirrel.Expr(userTokenFieldExpiresAt + " > now()")).
+		Suffix(fmt.Sprintf("RETURNING %s", userTokenFieldUserUUID)).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("build user token consume: %w", err)
+	}
+
+	var userUUID uuid.UUID
+	err = tx.QueryRow(ctx, query, args...).Scan(&userUUID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return uuid.Nil, model.ErrInvalidToken
+		}
+		return uuid.Nil, fmt.Errorf("consume user token: %w", err)
+	}
+	return userUUID, nil
+}
+
+// updateActiveUser - применяет изменение к неудаленному пользователю
+func updateActiveUser(ctx context.Context, tx pgx.Tx, userUUID uuid.UUID, builder squirrel.UpdateBuilder) error {
+	query, args, err := builder.
+		Set(userFieldUpdatedAt, squirrel.Expr("now()")).
+		Where(squirrel.Eq{userFieldUserUUID: userUUID, userFieldDeletedAt: nil}).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("build user update: %w", err)
+	}
+
+	tag, err := tx.Exec(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("update user: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return model.ErrInvalidToken
+	}
+	return nil
+}
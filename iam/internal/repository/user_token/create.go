@@ -0,0 +1,51 @@
+package user_token
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/crafty-ezhik/rocket-factory/iam/internal/model"
+)
+
+// Create - сохраняет токен. Ранее выпущенные токены пользователя с тем же назначением перестают действовать
+func (r *repository) Create(ctx context.Context, token model.UserToken) error {
+	return pgx.BeginFunc(ctx, r.pool, func(tx pgx.Tx) error {
+		deleteStmt, args, err := squirrel.Delete(userTokensTable).
+			Where(squirrel.Eq{
+				userTokenFieldUserUUID: token.UserUUID,
+				userTokenFieldPurpose:  token.Purpose.String(),
+			}).
+			PlaceholderFormat(squirrel.Dollar).
+			ToSql()
+		if err != nil {
+			return fmt.Errorf("build user tokens delete: %w", err)
+		}
+
+		if _, err = tx.Exec(ctx, deleteStmt, args...); err != nil {
+			return fmt.Errorf("delete previous user tokens: %w", err)
+		}
+
+		insertStmt, args, err := squirrel.Insert(userTokensTable).
+			Columns(
+				userTokenFieldTokenHash,
+				userTokenFieldUserUUID,
+				userTokenFieldPurpose,
+				userTokenFieldCreatedAt,
+				userTokenFieldExpiresAt,
+			).
+			Values(token.Hash, token.UserUUID, token.Purpose.String(), token.CreatedAt, token.ExpiresAt).
+			PlaceholderFormat(squirrel.Dollar).
+			ToSql()
+		if err != nil {
+			return fmt.Errorf("build user token insert: %w", err)
+		}
+
+		if _, err = tx.Exec(ctx, insertStmt, args...); err != nil {
+			return fmt.Errorf("insert user token: %w", err)
+		}
+		return nil
+	})
+}
@@ -0,0 +1,34 @@
+package user_token
+
+import (
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	def "github.com/crafty-ezhik/rocket-factory/iam/internal/repository"
+)
+
+var _ def.UserTokenRepository = (*repository)(nil)
+
+const (
+	userTokensTable         = "user_tokens"
+	userTokenFieldTokenHash = "token_hash"
+	userTokenFieldUserUUID  = "user_uuid"
+	userTokenFieldPurpose   = "purpose"
+	userTokenFieldCreatedAt = "created_at"
+	userTokenFieldExpiresAt = "expires_at"
+	userTokenFieldUsedAt    = "used_at"
+
+	usersTable               = "users"
+	userFieldUserUUID        = "user_uuid"
+	userFieldPassword        = "password"
+	userFieldUpdatedAt       = "updated_at"
+	userFieldDeletedAt       = "deleted_at"
+	userFieldEmailVerifiedAt = "email_verified_at"
+)
+
+type repository struct {
+	pool *pgxpool.Pool
+}
+
+func NewRepository(pool *pgxpool.Pool) *repository {
+	return &repository{pool: pool}
+}
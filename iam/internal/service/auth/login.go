@@ -2,27 +2,72 @@ package auth
 
 import (
 	"context"
-
-	"github.com/google/uuid"
+	"errors"
 
 	"github.com/crafty-ezhik/rocket-factory/iam/internal/model"
 )
 
-func (s *service) Login(ctx context.Context, login, password string) (uuid.UUID, error) {
+func (s *service) Login(ctx context.Context, login, password, clientIP string) (model.LoginResult, error) {
+	// Заблокированный логин или IP-адрес не доходит до проверки пароля
+	err := s.checkLoginLock(ctx, loginSubjects(login, clientIP))
+	if err != nil {
+		return model.LoginResult{}, err
+	}
+
+	err = s.delayLogin(ctx, model.LoginSubject(login))
+	if err != nil {
+		return model.LoginResult{}, err
+	}
+
 	user, err := s.userRepo.Exist(ctx, login)
 	if err != nil {
-		return uuid.Nil, err
+		// Попытки по несуществующим логинам тоже считаются, иначе перебор логинов ничем не ограничен
+		if errors.Is(err, model.ErrUserNotFound) {
+			if ferr := s.registerLoginFailure(ctx, login, clientIP); ferr != nil {
+				return model.LoginResult{}, ferr
+			}
+		}
+		return model.LoginResult{}, err
 	}
 
 	err = s.hasher.Verify(user.Info.PasswordHash, password)
 	if err != nil {
-		return uuid.Nil, model.ErrInvalidCredentials
+		if ferr := s.registerLoginFailure(ctx, login, clientIP); ferr != nil {
+			return model.LoginResult{}, ferr
+		}
+		return model.LoginResult{}, model.ErrInvalidCredentials
+	}
+
+	err = s.loginAttemptRepo.ResetFailures(ctx, model.LoginSubject(login))
+	if err != nil {
+		return model.LoginResult{}, err
+	}
+
+	// Пароль верный, поэтому можно сообщить о неподтвержденном email, не раскрывая его постороннему
+	if s.emailVerificationRequired && !user.IsEmailVerified() {
+		return model.LoginResult{}, model.ErrEmailNotVerified
+	}
+
+	s.rehashPassword(ctx, user, password)
+
+	sessionUUID, err := s.sessionRepo.Create(ctx, user.UUID, s.sessionTTL)
+	if err != nil {
+		return model.LoginResult{}, err
+	}
+
+	// Роли и разрешения попадают в токен доступа, поэтому загружаем пользователя целиком
+	user, err = s.userRepo.Get(ctx, user.UUID)
+	if err != nil {
+		return model.LoginResult{}, err
 	}
 
-	sessionUUID, err := s.sessionRepo.Create(ctx, user.UUID)
+	accessToken, err := s.tokenService.Issue(ctx, user, sessionUUID)
 	if err != nil {
-		return uuid.Nil, err
+		return model.LoginResult{}, err
 	}
 
-	return sessionUUID, nil
+	return model.LoginResult{
+		SessionUUID: sessionUUID,
+		AccessToken: accessToken,
+	}, nil
 }
@@ -0,0 +1,118 @@
+package auth
+
+import (
+	"context"
+	"time"
+
+	"github.com/crafty-ezhik/rocket-factory/iam/internal/model"
+)
+
+// LoginProtection - параметры защиты входа от перебора паролей
+type LoginProtection struct {
+	// MaxFailedAttempts - после стольких неудачных попыток в окне логин блокируется
+	MaxFailedAttempts int64
+	// MaxFailedAttemptsPerIP - после стольких неудачных попыток в окне блокируется IP-адрес
+	MaxFailedAttemptsPerIP int64
+	// FailedAttemptsWindow - окно, в котором считаются неудачные попытки
+	FailedAttemptsWindow time.Duration
+	// LockoutDuration - длительность временной блокировки
+	LockoutDuration time.Duration
+	// BaseDelay - задержка перед проверкой пароля после первой неудачной попытки, удваивается с каждой следующей
+	BaseDelay time.Duration
+	// MaxDelay - верхняя граница задержки
+	MaxDelay time.Duration
+}
+
+// loginSubjects - субъекты счетчиков для попытки входа. IP может быть неизвестен
+func loginSubjects(login, clientIP string) []string {
+	subjects := []string{model.LoginSubject(login)}
+	if clientIP != "" {
+		subjects = append(subjects, model.IPSubject(clientIP))
+	}
+	return subjects
+}
+
+// checkLoginLock - возвращает ErrTooManyLoginAttempts, если заблокирован логин или IP-адрес
+func (s *service) checkLoginLock(ctx context.Context, subjects []string) error {
+	now := time.Now()
+	for _, subject := range subjects {
+		lockedUntil, err := s.loginAttemptRepo.LockedUntil(ctx, subject)
+		if err != nil {
+			return err
+		}
+		if now.Before(lockedUntil) {
+			return model.ErrTooManyLoginAttempts
+		}
+	}
+	return nil
+}
+
+// delayLogin - замедляет проверку пароля пропорционально числу недавних неудачных попыток
+func (s *service) delayLogin(ctx context.Context, subject string) error {
+	failures, err := s.loginAttemptRepo.Failures(ctx, subject)
+	if err != nil {
+		return err
+	}
+
+	delay := s.loginProtection.delay(failures)
+	if delay <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// registerLoginFailure - учитывает неудачную попытку и блокирует логин или IP-адрес при превышении порога
+func (s *service) registerLoginFailure(ctx context.Context, login, clientIP string) error {
+	thresholds := map[string]int64{model.LoginSubject(login): s.loginProtection.MaxFailedAttempts}
+	if clientIP != "" {
+		thresholds[model.IPSubject(clientIP)] = s.loginProtection.MaxFailedAttemptsPerIP
+	}
+
+	for subject, threshold := range thresholds {
+		failures, err := s.loginAttemptRepo.RegisterFailure(ctx, subject, s.loginProtection.FailedAttemptsWindow)
+		if err != nil {
+			return err
+		}
+
+		if failures < threshold {
+			continue
+		}
+
+		err = s.loginAttemptRepo.Lock(ctx, subject, time.Now().Add(s.loginProtection.LockoutDuration))
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// delay - BaseDelay * 2^(failures-1), но не больше MaxDelay
+func (p LoginProtection) delay(failures int64) time.Duration {
+	if failures <= 0 || p.BaseDelay <= 0 {
+		return 0
+	}
+
+	delay := p.BaseDelay
+	for i := int64(1); i < failures && delay < p.MaxDelay; i++ {
+		delay *= 2
+	}
+	return min(delay, p.MaxDelay)
+}
+
+func (s *service) UnlockAccount(ctx context.Context, login string) error {
+	_, err := s.userRepo.Exist(ctx, login)
+	if err != nil {
+		return err
+	}
+
+	return s.loginAttemptRepo.Unlock(ctx, model.LoginSubject(login))
+}
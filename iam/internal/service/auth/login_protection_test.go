@@ -0,0 +1,208 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/crafty-ezhik/rocket-factory/iam/internal/model"
+	bcryptHasher "github.com/crafty-ezhik/rocket-factory/platform/pkg/hasher/bcrypt"
+)
+
+const (
+	testLogin    = "engineer"
+	testPassword = "correct-horse-battery"
+	testClientIP = "198.51.100.1"
+)
+
+func (s *ServiceSuite) testUser() model.User {
+	hash, err := bcryptHasher.NewBcryptPasswordHasher(testBcryptCost).Hash(testPassword)
+	s.Require().NoError(err)
+
+	return model.User{
+		UUID: uuid.New(),
+		Info: model.UserInfo{Login: testLogin, PasswordHash: hash},
+	}
+}
+
+// expectNotLocked - ни логин, ни IP-адрес не заблокированы, неудачных попыток нет
+func (s *ServiceSuite) expectNotLocked() {
+	s.loginAttemptRepo.EXPECT().LockedUntil(s.ctx, model.LoginSubject(testLogin)).Return(time.Time{}, nil).Once()
+	s.loginAttemptRepo.EXPECT().LockedUntil(s.ctx, model.IPSubject(testClientIP)).Return(time.Time{}, nil).Once()
+	s.loginAttemptRepo.EXPECT().Failures(s.ctx, model.LoginSubject(testLogin)).Return(0, nil).Once()
+}
+
+func (s *ServiceSuite) TestLoginLockedSubject() {
+	tests := []struct {
+		name    string
+		subject string
+	}{
+		{name: "locked login", subject: model.LoginSubject(testLogin)},
+		{name: "locked ip", subject: model.IPSubject(testClientIP)},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			s.SetupTest()
+
+			s.loginAttemptRepo.EXPECT().LockedUntil(s.ctx, mock.Anything).RunAndReturn(
+				func(_ context.Context, subject string) (time.Time, error) {
+					if subject == tt.subject {
+						return time.Now().Add(time.Minute), nil
+					}
+					return time.Time{}, nil
+				},
+			)
+
+			_, err := s.service.Login(s.ctx, testLogin, testPassword, testClientIP)
+			s.Require().ErrorIs(err, model.ErrTooManyLoginAttempts)
+
+			// Заблокированный вход не доходит до проверки пароля и не увеличивает счетчики
+			s.userRepo.AssertNotCalled(s.T(), "Exist", mock.Anything, mock.Anything)
+			s.loginAttemptRepo.AssertNotCalled(s.T(), "RegisterFailure", mock.Anything, mock.Anything, mock.Anything)
+		})
+	}
+}
+
+func (s *ServiceSuite) TestLoginExpiredLockIsIgnored() {
+	user := s.testUser()
+
+	s.loginAttemptRepo.EXPECT().LockedUntil(s.ctx, model.LoginSubject(testLogin)).Return(time.Now().Add(-time.Second), nil).Once()
+	s.loginAttemptRepo.EXPECT().LockedUntil(s.ctx, model.IPSubject(testClientIP)).Return(time.Time{}, nil).Once()
+	s.loginAttemptRepo.EXPECT().Failures(s.ctx, model.LoginSubject(testLogin)).Return(0, nil).Once()
+	s.userRepo.EXPECT().Exist(s.ctx, testLogin).Return(user, nil).Once()
+	s.loginAttemptRepo.EXPECT().ResetFailures(s.ctx, model.LoginSubject(testLogin)).Return(nil).Once()
+	s.sessionRepo.EXPECT().Create(s.ctx, user.UUID, time.Hour).Return(uuid.New(), nil).Once()
+	s.userRepo.EXPECT().Get(s.ctx, user.UUID).Return(user, nil).Once()
+	s.tokenService.EXPECT().Issue(s.ctx, user, mock.Anything).Return(model.AccessToken{}, nil).Once()
+
+	_, err := s.service.Login(s.ctx, testLogin, testPassword, testClientIP)
+	s.Require().NoError(err)
+}
+
+func (s *ServiceSuite) TestLoginWrongPasswordRegistersFailure() {
+	user := s.testUser()
+
+	s.expectNotLocked()
+	s.userRepo.EXPECT().Exist(s.ctx, testLogin).Return(user, nil).Once()
+	s.loginAttemptRepo.EXPECT().RegisterFailure(s.ctx, model.LoginSubject(testLogin), testLoginProtection.FailedAttemptsWindow).Return(1, nil).Once()
+	s.loginAttemptRepo.EXPECT().RegisterFailure(s.ctx, model.IPSubject(testClientIP), testLoginProtection.FailedAttemptsWindow).Return(1, nil).Once()
+
+	_, err := s.service.Login(s.ctx, testLogin, "wrong-password", testClientIP)
+	s.Require().ErrorIs(err, model.ErrInvalidCredentials)
+	s.loginAttemptRepo.AssertNotCalled(s.T(), "Lock", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (s *ServiceSuite) TestLoginUnknownUserRegistersFailure() {
+	s.expectNotLocked()
+	s.userRepo.EXPECT().Exist(s.ctx, testLogin).Return(model.User{}, model.ErrUserNotFound).Once()
+	s.loginAttemptRepo.EXPECT().RegisterFailure(s.ctx, model.LoginSubject(testLogin), testLoginProtection.FailedAttemptsWindow).Return(1, nil).Once()
+	s.loginAttemptRepo.EXPECT().RegisterFailure(s.ctx, model.IPSubject(testClientIP), testLoginProtection.FailedAttemptsWindow).Return(1, nil).Once()
+
+	_, err := s.service.Login(s.ctx, testLogin, testPassword, testClientIP)
+	s.Require().ErrorIs(err, model.ErrUserNotFound)
+}
+
+func (s *ServiceSuite) TestLoginThresholdLocksSubject() {
+	user := s.testUser()
+
+	s.expectNotLocked()
+	s.userRepo.EXPECT().Exist(s.ctx, testLogin).Return(user, nil).Once()
+	// Порог по логину достигнут, по IP - нет: блокируется только логин
+	s.loginAttemptRepo.EXPECT().RegisterFailure(s.ctx, model.LoginSubject(testLogin), testLoginProtection.FailedAttemptsWindow).
+		Return(testLoginProtection.MaxFailedAttempts, nil).Once()
+	s.loginAttemptRepo.EXPECT().RegisterFailure(s.ctx, model.IPSubject(testClientIP), testLoginProtection.FailedAttemptsWindow).
+		Return(testLoginProtection.MaxFailedAttempts, nil).Once()
+	s.loginAttemptRepo.EXPECT().Lock(s.ctx, model.LoginSubject(testLogin), mock.MatchedBy(func(until time.Time) bool {
+		return until.After(time.Now().Add(testLoginProtection.LockoutDuration - time.Minute))
+	})).Return(nil).Once()
+
+	_, err := s.service.Login(s.ctx, testLogin, "wrong-password", testClientIP)
+	s.Require().ErrorIs(err, model.ErrInvalidCredentials)
+}
+
+func (s *ServiceSuite) TestLoginWithoutClientIP() {
+	s.loginAttemptRepo.EXPECT().LockedUntil(s.ctx, model.LoginSubject(testLogin)).Return(time.Time{}, nil).Once()
+	s.loginAttemptRepo.EXPECT().Failures(s.ctx, model.LoginSubject(testLogin)).Return(0, nil).Once()
+	s.userRepo.EXPECT().Exist(s.ctx, testLogin).Return(model.User{}, model.ErrUserNotFound).Once()
+	s.loginAttemptRepo.EXPECT().RegisterFailure(s.ctx, model.LoginSubject(testLogin), testLoginProtection.FailedAttemptsWindow).Return(1, nil).Once()
+
+	_, err := s.service.Login(s.ctx, testLogin, testPassword, "")
+	s.Require().ErrorIs(err, model.ErrUserNotFound)
+}
+
+func (s *ServiceSuite) TestLoginSuccessResetsFailures() {
+	user := s.testUser()
+	sessionUUID := uuid.New()
+
+	s.expectNotLocked()
+	s.userRepo.EXPECT().Exist(s.ctx, testLogin).Return(user, nil).Once()
+	s.loginAttemptRepo.EXPECT().ResetFailures(s.ctx, model.LoginSubject(testLogin)).Return(nil).Once()
+	s.sessionRepo.EXPECT().Create(s.ctx, user.UUID, time.Hour).Return(sessionUUID, nil).Once()
+	s.userRepo.EXPECT().Get(s.ctx, user.UUID).Return(user, nil).Once()
+	s.tokenService.EXPECT().Issue(s.ctx, user, sessionUUID).Return(model.AccessToken{}, nil).Once()
+
+	result, err := s.service.Login(s.ctx, testLogin, testPassword, testClientIP)
+	s.Require().NoError(err)
+	s.Equal(sessionUUID, result.SessionUUID)
+}
+
+func (s *ServiceSuite) TestLoginRepositoryError() {
+	repoErr := errors.New("redis is down")
+	s.loginAttemptRepo.EXPECT().LockedUntil(s.ctx, model.LoginSubject(testLogin)).Return(time.Time{}, repoErr).Once()
+
+	_, err := s.service.Login(s.ctx, testLogin, testPassword, testClientIP)
+	s.Require().ErrorIs(err, repoErr)
+}
+
+func (s *ServiceSuite) TestDelayLoginRespectsContext() {
+	s.service.loginProtection.BaseDelay = time.Hour
+	s.service.loginProtection.MaxDelay = time.Hour
+
+	ctx, cancel := context.WithCancel(s.ctx)
+	cancel()
+
+	s.loginAttemptRepo.EXPECT().Failures(ctx, model.LoginSubject(testLogin)).Return(1, nil).Once()
+
+	err := s.service.delayLogin(ctx, model.LoginSubject(testLogin))
+	s.Require().ErrorIs(err, context.Canceled)
+}
+
+func (s *ServiceSuite) TestLoginProtectionDelay() {
+	protection := LoginProtection{BaseDelay: 100 * time.Millisecond, MaxDelay: time.Second}
+
+	tests := []struct {
+		failures int64
+		expected time.Duration
+	}{
+		{failures: 0, expected: 0},
+		{failures: -1, expected: 0},
+		{failures: 1, expected: 100 * time.Millisecond},
+		{failures: 2, expected: 200 * time.Millisecond},
+		{failures: 4, expected: 800 * time.Millisecond},
+		{failures: 5, expected: time.Second},
+		{failures: 1000, expected: time.Second},
+	}
+
+	for _, tt := range tests {
+		s.Equal(tt.expected, protection.delay(tt.failures), "failures=%d", tt.failures)
+	}
+
+	s.Zero(LoginProtection{MaxDelay: time.Second}.delay(3))
+}
+
+func (s *ServiceSuite) TestUnlockAccount() {
+	s.userRepo.EXPECT().Exist(s.ctx, testLogin).Return(model.User{}, nil).Once()
+	s.loginAttemptRepo.EXPECT().Unlock(s.ctx, model.LoginSubject(testLogin)).Return(nil).Once()
+
+	s.Require().NoError(s.service.UnlockAccount(s.ctx, testLogin))
+}
+
+func (s *ServiceSuite) TestUnlockAccountUnknownUser() {
+	s.userRepo.EXPECT().Exist(s.ctx, testLogin).Return(model.User{}, model.ErrUserNotFound).Once()
+
+	s.Require().ErrorIs(s.service.UnlockAccount(s.ctx, testLogin), model.ErrUserNotFound)
+}
@@ -0,0 +1,32 @@
+package auth
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"github.com/crafty-ezhik/rocket-factory/iam/internal/model"
+	"github.com/crafty-ezhik/rocket-factory/platform/pkg/logger"
+)
+
+// rehashPassword - пересчитывает хеш пароля текущим алгоритмом и параметрами после успешного входа.
+// Ошибка не мешает входу: хеш пересчитается при следующем входе
+func (s *service) rehashPassword(ctx context.Context, user model.User, password string) {
+	if !s.hasher.NeedsRehash(user.Info.PasswordHash) {
+		return
+	}
+
+	hashedPassword, err := s.hasher.Hash(password)
+	if err != nil {
+		logger.Error(ctx, "Failed to rehash password", zap.String("user_uuid", user.UUID.String()), zap.Error(err))
+		return
+	}
+
+	err = s.userRepo.UpdatePasswordHash(ctx, user.UUID, hashedPassword)
+	if err != nil {
+		logger.Error(ctx, "Failed to store rehashed password", zap.String("user_uuid", user.UUID.String()), zap.Error(err))
+		return
+	}
+
+	logger.Info(ctx, "Password rehashed", zap.String("user_uuid", user.UUID.String()))
+}
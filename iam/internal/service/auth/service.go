@@ -1,20 +1,55 @@
 package auth
 
 import (
+	"time"
+
 	"github.com/crafty-ezhik/rocket-factory/iam/internal/repository"
+	def "github.com/crafty-ezhik/rocket-factory/iam/internal/service"
 	"github.com/crafty-ezhik/rocket-factory/platform/pkg/hasher"
 )
 
+var _ def.AuthService = (*service)(nil)
+
 type service struct {
 	userRepo    repository.UserRepository
 	sessionRepo repository.SessionRepository
 	hasher      hasher.PasswordHasher
+
+	loginAttemptRepo repository.LoginAttemptRepository
+	loginProtection  LoginProtection
+
+	tokenService def.TokenService
+
+	sessionTTL        time.Duration
+	slidingExpiration bool
+
+	emailVerificationRequired bool
 }
 
-func NewService(userRepo repository.UserRepository, sessionRepo repository.SessionRepository, hasher hasher.PasswordHasher) *service {
+// NewService - создает сервис аутентификации.
+// При slidingExpiration каждое обращение к Whoami продлевает сессию на sessionTTL.
+// При emailVerificationRequired пользователи с неподтвержденным email не могут войти
+func NewService(
+	userRepo repository.UserRepository,
+	sessionRepo repository.SessionRepository,
+	hasher hasher.PasswordHasher,
+	tokenService def.TokenService,
+	loginAttemptRepo repository.LoginAttemptRepository,
+	loginProtection LoginProtection,
+	sessionTTL time.Duration,
+	slidingExpiration bool,
+	emailVerificationRequired bool,
+) *service {
 	return &service{
-		userRepo:    userRepo,
-		sessionRepo: sessionRepo,
-		hasher:      hasher,
+		userRepo:          userRepo,
+		sessionRepo:       sessionRepo,
+		hasher:            hasher,
+		tokenService:      tokenService,
+		loginAttemptRepo:  loginAttemptRepo,
+		loginProtection:   loginProtection,
+		sessionTTL:        sessionTTL,
+		slidingExpiration: slidingExpiration,
+
+		emailVerificationRequired: emailVerificationRequired,
 	}
 }
@@ -0,0 +1,89 @@
+package auth
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/crafty-ezhik/rocket-factory/iam/internal/model"
+)
+
+// getActiveSession - возвращает сессию, если она существует и не истекла. Истекшая сессия удаляется
+func (s *service) getActiveSession(ctx context.Context, sessionUUID uuid.UUID) (model.Session, error) {
+	session, err := s.sessionRepo.Get(ctx, sessionUUID)
+	if err != nil {
+		return model.Session{}, err
+	}
+
+	if session.IsExpired(time.Now()) {
+		if err = s.sessionRepo.Delete(ctx, session.UserUUID, sessionUUID); err != nil {
+			return model.Session{}, err
+		}
+		return model.Session{}, model.ErrSessionExpired
+	}
+	return session, nil
+}
+
+// refresh - продлевает сессию на время жизни сессии от текущего момента
+func (s *service) refresh(ctx context.Context, session model.Session) (model.Session, error) {
+	now := time.Now()
+	expiresAt := now.Add(s.sessionTTL)
+
+	err := s.sessionRepo.Refresh(ctx, session.UserUUID, session.UUID, expiresAt)
+	if err != nil {
+		return model.Session{}, err
+	}
+
+	session.UpdatedAt = &now
+	session.ExpiresAt = expiresAt
+	return session, nil
+}
+
+func (s *service) Logout(ctx context.Context, sessionUUID uuid.UUID) error {
+	session, err := s.sessionRepo.Get(ctx, sessionUUID)
+	if err != nil {
+		return err
+	}
+
+	return s.sessionRepo.Delete(ctx, session.UserUUID, sessionUUID)
+}
+
+func (s *service) LogoutAll(ctx context.Context, userUUID uuid.UUID) (int, error) {
+	sessions, err := s.sessionRepo.ListByUser(ctx, userUUID)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, session := range sessions {
+		if err = s.sessionRepo.Delete(ctx, userUUID, session.UUID); err != nil {
+			return 0, err
+		}
+	}
+	return len(sessions), nil
+}
+
+func (s *service) ListSessions(ctx context.Context, userUUID uuid.UUID) ([]model.Session, error) {
+	sessions, err := s.sessionRepo.ListByUser(ctx, userUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	active := make([]model.Session, 0, len(sessions))
+	for _, session := range sessions {
+		if !session.IsExpired(now) {
+			active = append(active, session)
+		}
+	}
+	return active, nil
+}
+
+func (s *service) RefreshSession(ctx context.Context, sessionUUID uuid.UUID) (model.Session, error) {
+	session, err := s.getActiveSession(ctx, sessionUUID)
+	if err != nil {
+		return model.Session{}, err
+	}
+
+	return s.refresh(ctx, session)
+}
@@ -0,0 +1,61 @@
+package auth
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	repoMock "github.com/crafty-ezhik/rocket-factory/iam/internal/repository/mocks"
+	serviceMock "github.com/crafty-ezhik/rocket-factory/iam/internal/service/mocks"
+	bcryptHasher "github.com/crafty-ezhik/rocket-factory/platform/pkg/hasher/bcrypt"
+	"github.com/crafty-ezhik/rocket-factory/platform/pkg/logger"
+)
+
+// testBcryptCost - минимальная стоимость bcrypt, чтобы тесты не тратили время на хеширование
+const testBcryptCost = 4
+
+// testLoginProtection - пороги защиты входа без задержки, чтобы тесты не ждали
+var testLoginProtection = LoginProtection{
+	MaxFailedAttempts:      3,
+	MaxFailedAttemptsPerIP: 10,
+	FailedAttemptsWindow:   15 * time.Minute,
+	LockoutDuration:        15 * time.Minute,
+}
+
+type ServiceSuite struct {
+	suite.Suite
+	ctx              context.Context //nolint:containedctx
+	userRepo         *repoMock.MockUserRepository
+	sessionRepo      *repoMock.MockSessionRepository
+	loginAttemptRepo *repoMock.MockLoginAttemptRepository
+	tokenService     *serviceMock.MockTokenService
+	service          *service
+}
+
+func (s *ServiceSuite) SetupTest() {
+	s.ctx = context.Background()
+
+	logger.SetNopLogger()
+
+	s.userRepo = repoMock.NewMockUserRepository(s.T())
+	s.sessionRepo = repoMock.NewMockSessionRepository(s.T())
+	s.loginAttemptRepo = repoMock.NewMockLoginAttemptRepository(s.T())
+	s.tokenService = serviceMock.NewMockTokenService(s.T())
+	s.service = NewService(
+		s.userRepo,
+		s.sessionRepo,
+		bcryptHasher.NewBcryptPasswordHasher(testBcryptCost),
+		s.tokenService,
+		s.loginAttemptRepo,
+		testLoginProtection,
+		time.Hour,
+		false,
+		false,
+	)
+}
+
+func TestServiceIntegration(t *testing.T) {
+	suite.Run(t, new(ServiceSuite))
+}
@@ -9,11 +9,19 @@ import (
 )
 
 func (s *service) Whoami(ctx context.Context, sessionUUID uuid.UUID) (model.WhoamiResponse, error) {
-	sessionInfo, err := s.sessionRepo.Get(ctx, sessionUUID)
+	sessionInfo, err := s.getActiveSession(ctx, sessionUUID)
 	if err != nil {
 		return model.WhoamiResponse{}, err
 	}
 
+	// В режиме скользящего времени жизни активная сессия продлевается при каждом обращении
+	if s.slidingExpiration {
+		sessionInfo, err = s.refresh(ctx, sessionInfo)
+		if err != nil {
+			return model.WhoamiResponse{}, err
+		}
+	}
+
 	user, err := s.userRepo.Get(ctx, sessionInfo.UserUUID)
 	if err != nil {
 		return model.WhoamiResponse{}, err
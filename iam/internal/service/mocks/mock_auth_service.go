@@ -42,28 +42,94 @@ func (_m *MockAuthService) EXPECT() *MockAuthService_Expecter {
 	return &MockAuthService_Expecter{mock: &_m.Mock}
 }
 
-// Login provides a mock function for the type MockAuthService
-func (_mock *MockAuthService) Login(ctx context.Context, login string, password string) (uuid.UUID, error) {
-	ret := _mock.Called(ctx, login, password)
+// ListSessions provides a mock function for the type MockAuthService
+func (_mock *MockAuthService) ListSessions(ctx context.Context, userUUID uuid.UUID) ([]model.Session, error) {
+	ret := _mock.Called(ctx, userUUID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Login")
+		panic("no return value specified for ListSessions")
 	}
 
-	var r0 uuid.UUID
+	var r0 []model.Session
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) (uuid.UUID, error)); ok {
-		return returnFunc(ctx, login, password)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]model.Session, error)); ok {
+		return returnFunc(ctx, userUUID)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) uuid.UUID); ok {
-		r0 = returnFunc(ctx, login, password)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) []model.Session); ok {
+		r0 = returnFunc(ctx, userUUID)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(uuid.UUID)
+			r0 = ret.Get(0).([]model.Session)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
-		r1 = returnFunc(ctx, login, password)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, userUUID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockAuthService_ListSessions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListSessions'
+type MockAuthService_ListSessions_Call struct {
+	*mock.Call
+}
+
+// ListSessions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userUUID uuid.UUID
+func (_e *MockAuthService_Expecter) ListSessions(ctx interface{}, userUUID interface{}) *MockAuthService_ListSessions_Call {
+	return &MockAuthService_ListSessions_Call{Call: _e.mock.On("ListSessions", ctx, userUUID)}
+}
+
+func (_c *MockAuthService_ListSessions_Call) Run(run func(ctx context.Context, userUUID uuid.UUID)) *MockAuthService_ListSessions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAuthService_ListSessions_Call) Return(sessions []model.Session, err error) *MockAuthService_ListSessions_Call {
+	_c.Call.Return(sessions, err)
+	return _c
+}
+
+func (_c *MockAuthService_ListSessions_Call) RunAndReturn(run func(ctx context.Context, userUUID uuid.UUID) ([]model.Session, error)) *MockAuthService_ListSessions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Login provides a mock function for the type MockAuthService
+func (_mock *MockAuthService) Login(ctx context.Context, login string, password string, clientIP string) (model.LoginResult, error) {
+	ret := _mock.Called(ctx, login, password, clientIP)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Login")
+	}
+
+	var r0 model.LoginResult
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, string) (model.LoginResult, error)); ok {
+		return returnFunc(ctx, login, password, clientIP)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, string) model.LoginResult); ok {
+		r0 = returnFunc(ctx, login, password, clientIP)
+	} else {
+		r0 = ret.Get(0).(model.LoginResult)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string, string) error); ok {
+		r1 = returnFunc(ctx, login, password, clientIP)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -79,11 +145,12 @@ type MockAuthService_Login_Call struct {
 //   - ctx context.Context
 //   - login string
 //   - password string
-func (_e *MockAuthService_Expecter) Login(ctx interface{}, login interface{}, password interface{}) *MockAuthService_Login_Call {
-	return &MockAuthService_Login_Call{Call: _e.mock.On("Login", ctx, login, password)}
+//   - clientIP string
+func (_e *MockAuthService_Expecter) Login(ctx interface{}, login interface{}, password interface{}, clientIP interface{}) *MockAuthService_Login_Call {
+	return &MockAuthService_Login_Call{Call: _e.mock.On("Login", ctx, login, password, clientIP)}
 }
 
-func (_c *MockAuthService_Login_Call) Run(run func(ctx context.Context, login string, password string)) *MockAuthService_Login_Call {
+func (_c *MockAuthService_Login_Call) Run(run func(ctx context.Context, login string, password string, clientIP string)) *MockAuthService_Login_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -97,21 +164,272 @@ func (_c *MockAuthService_Login_Call) Run(run func(ctx context.Context, login st
 		if args[2] != nil {
 			arg2 = args[2].(string)
 		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
+		}
 		run(
 			arg0,
 			arg1,
 			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAuthService_Login_Call) Return(loginResult model.LoginResult, err error) *MockAuthService_Login_Call {
+	_c.Call.Return(loginResult, err)
+	return _c
+}
+
+func (_c *MockAuthService_Login_Call) RunAndReturn(run func(ctx context.Context, login string, password string, clientIP string) (model.LoginResult, error)) *MockAuthService_Login_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Logout provides a mock function for the type MockAuthService
+func (_mock *MockAuthService) Logout(ctx context.Context, sessionUUID uuid.UUID) error {
+	ret := _mock.Called(ctx, sessionUUID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Logout")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = returnFunc(ctx, sessionUUID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockAuthService_Logout_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Logout'
+type MockAuthService_Logout_Call struct {
+	*mock.Call
+}
+
+// Logout is a helper method to define mock.On call
+//   - ctx context.Context
+//   - sessionUUID uuid.UUID
+func (_e *MockAuthService_Expecter) Logout(ctx interface{}, sessionUUID interface{}) *MockAuthService_Logout_Call {
+	return &MockAuthService_Logout_Call{Call: _e.mock.On("Logout", ctx, sessionUUID)}
+}
+
+func (_c *MockAuthService_Logout_Call) Run(run func(ctx context.Context, sessionUUID uuid.UUID)) *MockAuthService_Logout_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAuthService_Logout_Call) Return(err error) *MockAuthService_Logout_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockAuthService_Logout_Call) RunAndReturn(run func(ctx context.Context, sessionUUID uuid.UUID) error) *MockAuthService_Logout_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// LogoutAll provides a mock function for the type MockAuthService
+func (_mock *MockAuthService) LogoutAll(ctx context.Context, userUUID uuid.UUID) (int, error) {
+	ret := _mock.Called(ctx, userUUID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LogoutAll")
+	}
+
+	var r0 int
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (int, error)); ok {
+		return returnFunc(ctx, userUUID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) int); ok {
+		r0 = returnFunc(ctx, userUUID)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, userUUID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockAuthService_LogoutAll_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LogoutAll'
+type MockAuthService_LogoutAll_Call struct {
+	*mock.Call
+}
+
+// LogoutAll is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userUUID uuid.UUID
+func (_e *MockAuthService_Expecter) LogoutAll(ctx interface{}, userUUID interface{}) *MockAuthService_LogoutAll_Call {
+	return &MockAuthService_LogoutAll_Call{Call: _e.mock.On("LogoutAll", ctx, userUUID)}
+}
+
+func (_c *MockAuthService_LogoutAll_Call) Run(run func(ctx context.Context, userUUID uuid.UUID)) *MockAuthService_LogoutAll_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAuthService_LogoutAll_Call) Return(n int, err error) *MockAuthService_LogoutAll_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *MockAuthService_LogoutAll_Call) RunAndReturn(run func(ctx context.Context, userUUID uuid.UUID) (int, error)) *MockAuthService_LogoutAll_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RefreshSession provides a mock function for the type MockAuthService
+func (_mock *MockAuthService) RefreshSession(ctx context.Context, sessionUUID uuid.UUID) (model.Session, error) {
+	ret := _mock.Called(ctx, sessionUUID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RefreshSession")
+	}
+
+	var r0 model.Session
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (model.Session, error)); ok {
+		return returnFunc(ctx, sessionUUID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) model.Session); ok {
+		r0 = returnFunc(ctx, sessionUUID)
+	} else {
+		r0 = ret.Get(0).(model.Session)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, sessionUUID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockAuthService_RefreshSession_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RefreshSession'
+type MockAuthService_RefreshSession_Call struct {
+	*mock.Call
+}
+
+// RefreshSession is a helper method to define mock.On call
+//   - ctx context.Context
+//   - sessionUUID uuid.UUID
+func (_e *MockAuthService_Expecter) RefreshSession(ctx interface{}, sessionUUID interface{}) *MockAuthService_RefreshSession_Call {
+	return &MockAuthService_RefreshSession_Call{Call: _e.mock.On("RefreshSession", ctx, sessionUUID)}
+}
+
+func (_c *MockAuthService_RefreshSession_Call) Run(run func(ctx context.Context, sessionUUID uuid.UUID)) *MockAuthService_RefreshSession_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAuthService_RefreshSession_Call) Return(session model.Session, err error) *MockAuthService_RefreshSession_Call {
+	_c.Call.Return(session, err)
+	return _c
+}
+
+func (_c *MockAuthService_RefreshSession_Call) RunAndReturn(run func(ctx context.Context, sessionUUID uuid.UUID) (model.Session, error)) *MockAuthService_RefreshSession_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UnlockAccount provides a mock function for the type MockAuthService
+func (_mock *MockAuthService) UnlockAccount(ctx context.Context, login string) error {
+	ret := _mock.Called(ctx, login)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UnlockAccount")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = returnFunc(ctx, login)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockAuthService_UnlockAccount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UnlockAccount'
+type MockAuthService_UnlockAccount_Call struct {
+	*mock.Call
+}
+
+// UnlockAccount is a helper method to define mock.On call
+//   - ctx context.Context
+//   - login string
+func (_e *MockAuthService_Expecter) UnlockAccount(ctx interface{}, login interface{}) *MockAuthService_UnlockAccount_Call {
+	return &MockAuthService_UnlockAccount_Call{Call: _e.mock.On("UnlockAccount", ctx, login)}
+}
+
+func (_c *MockAuthService_UnlockAccount_Call) Run(run func(ctx context.Context, login string)) *MockAuthService_UnlockAccount_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
 		)
 	})
 	return _c
 }
 
-func (_c *MockAuthService_Login_Call) Return(uUID uuid.UUID, err error) *MockAuthService_Login_Call {
-	_c.Call.Return(uUID, err)
+func (_c *MockAuthService_UnlockAccount_Call) Return(err error) *MockAuthService_UnlockAccount_Call {
+	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockAuthService_Login_Call) RunAndReturn(run func(ctx context.Context, login string, password string) (uuid.UUID, error)) *MockAuthService_Login_Call {
+func (_c *MockAuthService_UnlockAccount_Call) RunAndReturn(run func(ctx context.Context, login string) error) *MockAuthService_UnlockAccount_Call {
 	_c.Call.Return(run)
 	return _c
 }
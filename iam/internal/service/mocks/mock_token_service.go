@@ -0,0 +1,228 @@
+// Code generated for crafty-ezhik service
+// © Crafty-ezhik 2025.
+
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/crafty-ezhik/rocket-factory/iam/internal/model"
+	"github.com/google/uuid"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockTokenService creates a new instance of MockTokenService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockTokenService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockTokenService {
+	mock := &MockTokenService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockTokenService is an autogenerated mock type for the TokenService type
+type MockTokenService struct {
+	mock.Mock
+}
+
+type MockTokenService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockTokenService) EXPECT() *MockTokenService_Expecter {
+	return &MockTokenService_Expecter{mock: &_m.Mock}
+}
+
+// Issue provides a mock function for the type MockTokenService
+func (_mock *MockTokenService) Issue(ctx context.Context, user model.User, sessionUUID uuid.UUID) (model.AccessToken, error) {
+	ret := _mock.Called(ctx, user, sessionUUID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Issue")
+	}
+
+	var r0 model.AccessToken
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, model.User, uuid.UUID) (model.AccessToken, error)); ok {
+		return returnFunc(ctx, user, sessionUUID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, model.User, uuid.UUID) model.AccessToken); ok {
+		r0 = returnFunc(ctx, user, sessionUUID)
+	} else {
+		r0 = ret.Get(0).(model.AccessToken)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, model.User, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, user, sessionUUID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockTokenService_Issue_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Issue'
+type MockTokenService_Issue_Call struct {
+	*mock.Call
+}
+
+// Issue is a helper method to define mock.On call
+//   - ctx context.Context
+//   - user model.User
+//   - sessionUUID uuid.UUID
+func (_e *MockTokenService_Expecter) Issue(ctx interface{}, user interface{}, sessionUUID interface{}) *MockTokenService_Issue_Call {
+	return &MockTokenService_Issue_Call{Call: _e.mock.On("Issue", ctx, user, sessionUUID)}
+}
+
+func (_c *MockTokenService_Issue_Call) Run(run func(ctx context.Context, user model.User, sessionUUID uuid.UUID)) *MockTokenService_Issue_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 model.User
+		if args[1] != nil {
+			arg1 = args[1].(model.User)
+		}
+		var arg2 uuid.UUID
+		if args[2] != nil {
+			arg2 = args[2].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockTokenService_Issue_Call) Return(accessToken model.AccessToken, err error) *MockTokenService_Issue_Call {
+	_c.Call.Return(accessToken, err)
+	return _c
+}
+
+func (_c *MockTokenService_Issue_Call) RunAndReturn(run func(ctx context.Context, user model.User, sessionUUID uuid.UUID) (model.AccessToken, error)) *MockTokenService_Issue_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RunKeyRotation provides a mock function for the type MockTokenService
+func (_mock *MockTokenService) RunKeyRotation(ctx context.Context) error {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RunKeyRotation")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockTokenService_RunKeyRotation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RunKeyRotation'
+type MockTokenService_RunKeyRotation_Call struct {
+	*mock.Call
+}
+
+// RunKeyRotation is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockTokenService_Expecter) RunKeyRotation(ctx interface{}) *MockTokenService_RunKeyRotation_Call {
+	return &MockTokenService_RunKeyRotation_Call{Call: _e.mock.On("RunKeyRotation", ctx)}
+}
+
+func (_c *MockTokenService_RunKeyRotation_Call) Run(run func(ctx context.Context)) *MockTokenService_RunKeyRotation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockTokenService_RunKeyRotation_Call) Return(err error) *MockTokenService_RunKeyRotation_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockTokenService_RunKeyRotation_Call) RunAndReturn(run func(ctx context.Context) error) *MockTokenService_RunKeyRotation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SigningKeys provides a mock function for the type MockTokenService
+func (_mock *MockTokenService) SigningKeys(ctx context.Context) ([]model.SigningKey, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SigningKeys")
+	}
+
+	var r0 []model.SigningKey
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) ([]model.SigningKey, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) []model.SigningKey); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.SigningKey)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockTokenService_SigningKeys_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SigningKeys'
+type MockTokenService_SigningKeys_Call struct {
+	*mock.Call
+}
+
+// SigningKeys is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockTokenService_Expecter) SigningKeys(ctx interface{}) *MockTokenService_SigningKeys_Call {
+	return &MockTokenService_SigningKeys_Call{Call: _e.mock.On("SigningKeys", ctx)}
+}
+
+func (_c *MockTokenService_SigningKeys_Call) Run(run func(ctx context.Context)) *MockTokenService_SigningKeys_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockTokenService_SigningKeys_Call) Return(signingKeys []model.SigningKey, err error) *MockTokenService_SigningKeys_Call {
+	_c.Call.Return(signingKeys, err)
+	return _c
+}
+
+func (_c *MockTokenService_SigningKeys_Call) RunAndReturn(run func(ctx context.Context) ([]model.SigningKey, error)) *MockTokenService_SigningKeys_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -0,0 +1,99 @@
+// Code generated for crafty-ezhik service
+// © Crafty-ezhik 2025.
+
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/crafty-ezhik/rocket-factory/iam/internal/model"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockUserProducerService creates a new instance of MockUserProducerService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockUserProducerService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockUserProducerService {
+	mock := &MockUserProducerService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockUserProducerService is an autogenerated mock type for the UserProducerService type
+type MockUserProducerService struct {
+	mock.Mock
+}
+
+type MockUserProducerService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockUserProducerService) EXPECT() *MockUserProducerService_Expecter {
+	return &MockUserProducerService_Expecter{mock: &_m.Mock}
+}
+
+// ProduceUserTokenIssued provides a mock function for the type MockUserProducerService
+func (_mock *MockUserProducerService) ProduceUserTokenIssued(ctx context.Context, event model.UserTokenIssuedEvent) error {
+	ret := _mock.Called(ctx, event)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ProduceUserTokenIssued")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, model.UserTokenIssuedEvent) error); ok {
+		r0 = returnFunc(ctx, event)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockUserProducerService_ProduceUserTokenIssued_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ProduceUserTokenIssued'
+type MockUserProducerService_ProduceUserTokenIssued_Call struct {
+	*mock.Call
+}
+
+// ProduceUserTokenIssued is a helper method to define mock.On call
+//   - ctx context.Context
+//   - event model.UserTokenIssuedEvent
+func (_e *MockUserProducerService_Expecter) ProduceUserTokenIssued(ctx interface{}, event interface{}) *MockUserProducerService_ProduceUserTokenIssued_Call {
+	return &MockUserProducerService_ProduceUserTokenIssued_Call{Call: _e.mock.On("ProduceUserTokenIssued", ctx, event)}
+}
+
+func (_c *MockUserProducerService_ProduceUserTokenIssued_Call) Run(run func(ctx context.Context, event model.UserTokenIssuedEvent)) *MockUserProducerService_ProduceUserTokenIssued_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 model.UserTokenIssuedEvent
+		if args[1] != nil {
+			arg1 = args[1].(model.UserTokenIssuedEvent)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockUserProducerService_ProduceUserTokenIssued_Call) Return(err error) *MockUserProducerService_ProduceUserTokenIssued_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockUserProducerService_ProduceUserTokenIssued_Call) RunAndReturn(run func(ctx context.Context, event model.UserTokenIssuedEvent) error) *MockUserProducerService_ProduceUserTokenIssued_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -42,6 +42,282 @@ func (_m *MockUserService) EXPECT() *MockUserService_Expecter {
 	return &MockUserService_Expecter{mock: &_m.Mock}
 }
 
+// AddNotificationMethod provides a mock function for the type MockUserService
+func (_mock *MockUserService) AddNotificationMethod(ctx context.Context, userUUID uuid.UUID, method model.NotificationMethod) error {
+	ret := _mock.Called(ctx, userUUID, method)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddNotificationMethod")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, model.NotificationMethod) error); ok {
+		r0 = returnFunc(ctx, userUUID, method)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockUserService_AddNotificationMethod_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddNotificationMethod'
+type MockUserService_AddNotificationMethod_Call struct {
+	*mock.Call
+}
+
+// AddNotificationMethod is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userUUID uuid.UUID
+//   - method model.NotificationMethod
+func (_e *MockUserService_Expecter) AddNotificationMethod(ctx interface{}, userUUID interface{}, method interface{}) *MockUserService_AddNotificationMethod_Call {
+	return &MockUserService_AddNotificationMethod_Call{Call: _e.mock.On("AddNotificationMethod", ctx, userUUID, method)}
+}
+
+func (_c *MockUserService_AddNotificationMethod_Call) Run(run func(ctx context.Context, userUUID uuid.UUID, method model.NotificationMethod)) *MockUserService_AddNotificationMethod_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 model.NotificationMethod
+		if args[2] != nil {
+			arg2 = args[2].(model.NotificationMethod)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockUserService_AddNotificationMethod_Call) Return(err error) *MockUserService_AddNotificationMethod_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockUserService_AddNotificationMethod_Call) RunAndReturn(run func(ctx context.Context, userUUID uuid.UUID, method model.NotificationMethod) error) *MockUserService_AddNotificationMethod_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ChangePassword provides a mock function for the type MockUserService
+func (_mock *MockUserService) ChangePassword(ctx context.Context, userUUID uuid.UUID, oldPassword string, newPassword string, keepSessionUUID uuid.UUID) (int, error) {
+	ret := _mock.Called(ctx, userUUID, oldPassword, newPassword, keepSessionUUID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ChangePassword")
+	}
+
+	var r0 int
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, string, uuid.UUID) (int, error)); ok {
+		return returnFunc(ctx, userUUID, oldPassword, newPassword, keepSessionUUID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, string, uuid.UUID) int); ok {
+		r0 = returnFunc(ctx, userUUID, oldPassword, newPassword, keepSessionUUID)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, string, string, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, userUUID, oldPassword, newPassword, keepSessionUUID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockUserService_ChangePassword_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ChangePassword'
+type MockUserService_ChangePassword_Call struct {
+	*mock.Call
+}
+
+// ChangePassword is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userUUID uuid.UUID
+//   - oldPassword string
+//   - newPassword string
+//   - keepSessionUUID uuid.UUID
+func (_e *MockUserService_Expecter) ChangePassword(ctx interface{}, userUUID interface{}, oldPassword interface{}, newPassword interface{}, keepSessionUUID interface{}) *MockUserService_ChangePassword_Call {
+	return &MockUserService_ChangePassword_Call{Call: _e.mock.On("ChangePassword", ctx, userUUID, oldPassword, newPassword, keepSessionUUID)}
+}
+
+func (_c *MockUserService_ChangePassword_Call) Run(run func(ctx context.Context, userUUID uuid.UUID, oldPassword string, newPassword string, keepSessionUUID uuid.UUID)) *MockUserService_ChangePassword_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
+		}
+		var arg4 uuid.UUID
+		if args[4] != nil {
+			arg4 = args[4].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+			arg4,
+		)
+	})
+	return _c
+}
+
+func (_c *MockUserService_ChangePassword_Call) Return(n int, err error) *MockUserService_ChangePassword_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *MockUserService_ChangePassword_Call) RunAndReturn(run func(ctx context.Context, userUUID uuid.UUID, oldPassword string, newPassword string, keepSessionUUID uuid.UUID) (int, error)) *MockUserService_ChangePassword_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ConfirmPasswordReset provides a mock function for the type MockUserService
+func (_mock *MockUserService) ConfirmPasswordReset(ctx context.Context, token string, newPassword string) (int, error) {
+	ret := _mock.Called(ctx, token, newPassword)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ConfirmPasswordReset")
+	}
+
+	var r0 int
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) (int, error)); ok {
+		return returnFunc(ctx, token, newPassword)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) int); ok {
+		r0 = returnFunc(ctx, token, newPassword)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = returnFunc(ctx, token, newPassword)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockUserService_ConfirmPasswordReset_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ConfirmPasswordReset'
+type MockUserService_ConfirmPasswordReset_Call struct {
+	*mock.Call
+}
+
+// ConfirmPasswordReset is a helper method to define mock.On call
+//   - ctx context.Context
+//   - token string
+//   - newPassword string
+func (_e *MockUserService_Expecter) ConfirmPasswordReset(ctx interface{}, token interface{}, newPassword interface{}) *MockUserService_ConfirmPasswordReset_Call {
+	return &MockUserService_ConfirmPasswordReset_Call{Call: _e.mock.On("ConfirmPasswordReset", ctx, token, newPassword)}
+}
+
+func (_c *MockUserService_ConfirmPasswordReset_Call) Run(run func(ctx context.Context, token string, newPassword string)) *MockUserService_ConfirmPasswordReset_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockUserService_ConfirmPasswordReset_Call) Return(n int, err error) *MockUserService_ConfirmPasswordReset_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *MockUserService_ConfirmPasswordReset_Call) RunAndReturn(run func(ctx context.Context, token string, newPassword string) (int, error)) *MockUserService_ConfirmPasswordReset_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function for the type MockUserService
+func (_mock *MockUserService) Delete(ctx context.Context, userUUID uuid.UUID) error {
+	ret := _mock.Called(ctx, userUUID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = returnFunc(ctx, userUUID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockUserService_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type MockUserService_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userUUID uuid.UUID
+func (_e *MockUserService_Expecter) Delete(ctx interface{}, userUUID interface{}) *MockUserService_Delete_Call {
+	return &MockUserService_Delete_Call{Call: _e.mock.On("Delete", ctx, userUUID)}
+}
+
+func (_c *MockUserService_Delete_Call) Run(run func(ctx context.Context, userUUID uuid.UUID)) *MockUserService_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockUserService_Delete_Call) Return(err error) *MockUserService_Delete_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockUserService_Delete_Call) RunAndReturn(run func(ctx context.Context, userUUID uuid.UUID) error) *MockUserService_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Get provides a mock function for the type MockUserService
 func (_mock *MockUserService) Get(ctx context.Context, userUUID uuid.UUID) (model.User, error) {
 	ret := _mock.Called(ctx, userUUID)
@@ -175,3 +451,341 @@ func (_c *MockUserService_Register_Call) RunAndReturn(run func(ctx context.Conte
 	_c.Call.Return(run)
 	return _c
 }
+
+// RemoveNotificationMethod provides a mock function for the type MockUserService
+func (_mock *MockUserService) RemoveNotificationMethod(ctx context.Context, userUUID uuid.UUID, method model.NotificationMethod) error {
+	ret := _mock.Called(ctx, userUUID, method)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveNotificationMethod")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, model.NotificationMethod) error); ok {
+		r0 = returnFunc(ctx, userUUID, method)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockUserService_RemoveNotificationMethod_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveNotificationMethod'
+type MockUserService_RemoveNotificationMethod_Call struct {
+	*mock.Call
+}
+
+// RemoveNotificationMethod is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userUUID uuid.UUID
+//   - method model.NotificationMethod
+func (_e *MockUserService_Expecter) RemoveNotificationMethod(ctx interface{}, userUUID interface{}, method interface{}) *MockUserService_RemoveNotificationMethod_Call {
+	return &MockUserService_RemoveNotificationMethod_Call{Call: _e.mock.On("RemoveNotificationMethod", ctx, userUUID, method)}
+}
+
+func (_c *MockUserService_RemoveNotificationMethod_Call) Run(run func(ctx context.Context, userUUID uuid.UUID, method model.NotificationMethod)) *MockUserService_RemoveNotificationMethod_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 model.NotificationMethod
+		if args[2] != nil {
+			arg2 = args[2].(model.NotificationMethod)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockUserService_RemoveNotificationMethod_Call) Return(err error) *MockUserService_RemoveNotificationMethod_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockUserService_RemoveNotificationMethod_Call) RunAndReturn(run func(ctx context.Context, userUUID uuid.UUID, method model.NotificationMethod) error) *MockUserService_RemoveNotificationMethod_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RequestPasswordReset provides a mock function for the type MockUserService
+func (_mock *MockUserService) RequestPasswordReset(ctx context.Context, email string) error {
+	ret := _mock.Called(ctx, email)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RequestPasswordReset")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = returnFunc(ctx, email)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockUserService_RequestPasswordReset_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RequestPasswordReset'
+type MockUserService_RequestPasswordReset_Call struct {
+	*mock.Call
+}
+
+// RequestPasswordReset is a helper method to define mock.On call
+//   - ctx context.Context
+//   - email string
+func (_e *MockUserService_Expecter) RequestPasswordReset(ctx interface{}, email interface{}) *MockUserService_RequestPasswordReset_Call {
+	return &MockUserService_RequestPasswordReset_Call{Call: _e.mock.On("RequestPasswordReset", ctx, email)}
+}
+
+func (_c *MockUserService_RequestPasswordReset_Call) Run(run func(ctx context.Context, email string)) *MockUserService_RequestPasswordReset_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockUserService_RequestPasswordReset_Call) Return(err error) *MockUserService_RequestPasswordReset_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockUserService_RequestPasswordReset_Call) RunAndReturn(run func(ctx context.Context, email string) error) *MockUserService_RequestPasswordReset_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateEmail provides a mock function for the type MockUserService
+func (_mock *MockUserService) UpdateEmail(ctx context.Context, userUUID uuid.UUID, email string) (model.User, error) {
+	ret := _mock.Called(ctx, userUUID, email)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateEmail")
+	}
+
+	var r0 model.User
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) (model.User, error)); ok {
+		return returnFunc(ctx, userUUID, email)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) model.User); ok {
+		r0 = returnFunc(ctx, userUUID, email)
+	} else {
+		r0 = ret.Get(0).(model.User)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, string) error); ok {
+		r1 = returnFunc(ctx, userUUID, email)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockUserService_UpdateEmail_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateEmail'
+type MockUserService_UpdateEmail_Call struct {
+	*mock.Call
+}
+
+// UpdateEmail is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userUUID uuid.UUID
+//   - email string
+func (_e *MockUserService_Expecter) UpdateEmail(ctx interface{}, userUUID interface{}, email interface{}) *MockUserService_UpdateEmail_Call {
+	return &MockUserService_UpdateEmail_Call{Call: _e.mock.On("UpdateEmail", ctx, userUUID, email)}
+}
+
+func (_c *MockUserService_UpdateEmail_Call) Run(run func(ctx context.Context, userUUID uuid.UUID, email string)) *MockUserService_UpdateEmail_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockUserService_UpdateEmail_Call) Return(user model.User, err error) *MockUserService_UpdateEmail_Call {
+	_c.Call.Return(user, err)
+	return _c
+}
+
+func (_c *MockUserService_UpdateEmail_Call) RunAndReturn(run func(ctx context.Context, userUUID uuid.UUID, email string) (model.User, error)) *MockUserService_UpdateEmail_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdatePreferences provides a mock function for the type MockUserService
+func (_mock *MockUserService) UpdatePreferences(ctx context.Context, userUUID uuid.UUID, locale string, timezone string) (model.User, error) {
+	ret := _mock.Called(ctx, userUUID, locale, timezone)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdatePreferences")
+	}
+
+	var r0 model.User
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, string) (model.User, error)); ok {
+		return returnFunc(ctx, userUUID, locale, timezone)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, string) model.User); ok {
+		r0 = returnFunc(ctx, userUUID, locale, timezone)
+	} else {
+		r0 = ret.Get(0).(model.User)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, string, string) error); ok {
+		r1 = returnFunc(ctx, userUUID, locale, timezone)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockUserService_UpdatePreferences_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdatePreferences'
+type MockUserService_UpdatePreferences_Call struct {
+	*mock.Call
+}
+
+// UpdatePreferences is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userUUID uuid.UUID
+//   - locale string
+//   - timezone string
+func (_e *MockUserService_Expecter) UpdatePreferences(ctx interface{}, userUUID interface{}, locale interface{}, timezone interface{}) *MockUserService_UpdatePreferences_Call {
+	return &MockUserService_UpdatePreferences_Call{Call: _e.mock.On("UpdatePreferences", ctx, userUUID, locale, timezone)}
+}
+
+func (_c *MockUserService_UpdatePreferences_Call) Run(run func(ctx context.Context, userUUID uuid.UUID, locale string, timezone string)) *MockUserService_UpdatePreferences_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockUserService_UpdatePreferences_Call) Return(user model.User, err error) *MockUserService_UpdatePreferences_Call {
+	_c.Call.Return(user, err)
+	return _c
+}
+
+func (_c *MockUserService_UpdatePreferences_Call) RunAndReturn(run func(ctx context.Context, userUUID uuid.UUID, locale string, timezone string) (model.User, error)) *MockUserService_UpdatePreferences_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// VerifyEmail provides a mock function for the type MockUserService
+func (_mock *MockUserService) VerifyEmail(ctx context.Context, token string) (uuid.UUID, error) {
+	ret := _mock.Called(ctx, token)
+
+	if len(ret) == 0 {
+		panic("no return value specified for VerifyEmail")
+	}
+
+	var r0 uuid.UUID
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (uuid.UUID, error)); ok {
+		return returnFunc(ctx, token)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) uuid.UUID); ok {
+		r0 = returnFunc(ctx, token)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(uuid.UUID)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, token)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockUserService_VerifyEmail_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'VerifyEmail'
+type MockUserService_VerifyEmail_Call struct {
+	*mock.Call
+}
+
+// VerifyEmail is a helper method to define mock.On call
+//   - ctx context.Context
+//   - token string
+func (_e *MockUserService_Expecter) VerifyEmail(ctx interface{}, token interface{}) *MockUserService_VerifyEmail_Call {
+	return &MockUserService_VerifyEmail_Call{Call: _e.mock.On("VerifyEmail", ctx, token)}
+}
+
+func (_c *MockUserService_VerifyEmail_Call) Run(run func(ctx context.Context, token string)) *MockUserService_VerifyEmail_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockUserService_VerifyEmail_Call) Return(uUID uuid.UUID, err error) *MockUserService_VerifyEmail_Call {
+	_c.Call.Return(uUID, err)
+	return _c
+}
+
+func (_c *MockUserService_VerifyEmail_Call) RunAndReturn(run func(ctx context.Context, token string) (uuid.UUID, error)) *MockUserService_VerifyEmail_Call {
+	_c.Call.Return(run)
+	return _c
+}
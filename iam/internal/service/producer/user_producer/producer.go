@@ -0,0 +1,53 @@
+package user_producer
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/crafty-ezhik/rocket-factory/iam/internal/model"
+	def "github.com/crafty-ezhik/rocket-factory/iam/internal/service"
+	"github.com/crafty-ezhik/rocket-factory/platform/pkg/kafka"
+	"github.com/crafty-ezhik/rocket-factory/platform/pkg/logger"
+	eventsV1 "github.com/crafty-ezhik/rocket-factory/shared/pkg/proto/events/v1"
+)
+
+var _ def.UserProducerService = (*service)(nil)
+
+type service struct {
+	userTokenIssuedProducer kafka.Producer
+}
+
+func NewService(userTokenIssuedProducer kafka.Producer) *service {
+	return &service{userTokenIssuedProducer: userTokenIssuedProducer}
+}
+
+func (p *service) ProduceUserTokenIssued(ctx context.Context, event model.UserTokenIssuedEvent) error {
+	msg := &eventsV1.UserTokenIssued{
+		EventUuid: event.EventUUID.String(),
+		UserUuid:  event.UserUUID.String(),
+		Login:     event.Login,
+		Email:     event.Email,
+		Purpose:   event.Purpose.String(),
+		Token:     event.Token,
+		ExpiresAt: timestamppb.New(event.ExpiresAt),
+	}
+
+	// Преобразуем структуру в слайс байт для передачи в kafka
+	payload, err := proto.Marshal(msg)
+	if err != nil {
+		logger.Error(ctx, "Failed to marshal user token issued payload", zap.Error(err))
+		return err
+	}
+
+	// Ключ - пользователь, чтобы токены одного пользователя доставлялись по порядку
+	err = p.userTokenIssuedProducer.Send(ctx, []byte(event.UserUUID.String()), payload)
+	if err != nil {
+		logger.Error(ctx, "Failed to publish UserTokenIssued", zap.Error(err))
+		return err
+	}
+
+	return nil
+}
@@ -9,11 +9,35 @@ import (
 )
 
 type AuthService interface {
-	Login(ctx context.Context, login, password string) (uuid.UUID, error)
+	Login(ctx context.Context, login, password, clientIP string) (model.LoginResult, error)
 	Whoami(ctx context.Context, sessionUUID uuid.UUID) (model.WhoamiResponse, error)
+	Logout(ctx context.Context, sessionUUID uuid.UUID) error
+	LogoutAll(ctx context.Context, userUUID uuid.UUID) (int, error)
+	ListSessions(ctx context.Context, userUUID uuid.UUID) ([]model.Session, error)
+	RefreshSession(ctx context.Context, sessionUUID uuid.UUID) (model.Session, error)
+	UnlockAccount(ctx context.Context, login string) error
 }
 
 type UserService interface {
 	Register(ctx context.Context, userInfo model.UserRegistrationInfo) (uuid.UUID, error)
 	Get(ctx context.Context, userUUID uuid.UUID) (model.User, error)
+	UpdateEmail(ctx context.Context, userUUID uuid.UUID, email string) (model.User, error)
+	UpdatePreferences(ctx context.Context, userUUID uuid.UUID, locale, timezone string) (model.User, error)
+	ChangePassword(ctx context.Context, userUUID uuid.UUID, oldPassword, newPassword string, keepSessionUUID uuid.UUID) (int, error)
+	AddNotificationMethod(ctx context.Context, userUUID uuid.UUID, method model.NotificationMethod) error
+	RemoveNotificationMethod(ctx context.Context, userUUID uuid.UUID, method model.NotificationMethod) error
+	Delete(ctx context.Context, userUUID uuid.UUID) error
+	VerifyEmail(ctx context.Context, token string) (uuid.UUID, error)
+	RequestPasswordReset(ctx context.Context, email string) error
+	ConfirmPasswordReset(ctx context.Context, token, newPassword string) (int, error)
+}
+
+type TokenService interface {
+	Issue(ctx context.Context, user model.User, sessionUUID uuid.UUID) (model.AccessToken, error)
+	SigningKeys(ctx context.Context) ([]model.SigningKey, error)
+	RunKeyRotation(ctx context.Context) error
+}
+
+type UserProducerService interface {
+	ProduceUserTokenIssued(ctx context.Context, event model.UserTokenIssuedEvent) error
 }
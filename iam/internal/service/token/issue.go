@@ -0,0 +1,56 @@
+package token
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/crafty-ezhik/rocket-factory/iam/internal/model"
+	"github.com/crafty-ezhik/rocket-factory/platform/pkg/token"
+)
+
+func (s *service) Issue(ctx context.Context, user model.User, sessionUUID uuid.UUID) (model.AccessToken, error) {
+	key, err := s.activeKey(ctx)
+	if err != nil {
+		return model.AccessToken{}, err
+	}
+
+	now := time.Now()
+	expiresAt := now.Add(s.tokenTTL)
+
+	value, err := token.NewSigner(key.KID, key.PrivateKey).Sign(token.Claims{
+		Issuer:      s.issuer,
+		Subject:     user.UUID.String(),
+		SessionUUID: sessionUUID.String(),
+		Roles:       user.Roles,
+		Permissions: user.Permissions,
+		IssuedAt:    now.Unix(),
+		ExpiresAt:   expiresAt.Unix(),
+	})
+	if err != nil {
+		return model.AccessToken{}, err
+	}
+
+	return model.AccessToken{
+		Value:     value,
+		ExpiresAt: expiresAt,
+	}, nil
+}
+
+// activeKey - возвращает текущий ключ подписи, создавая его при первом запуске
+func (s *service) activeKey(ctx context.Context) (model.SigningKey, error) {
+	key, err := s.keyRepo.GetActive(ctx)
+	if err == nil {
+		return key, nil
+	}
+	if !errors.Is(err, model.ErrSigningKeyNotFound) {
+		return model.SigningKey{}, err
+	}
+
+	if err = s.rotate(ctx); err != nil {
+		return model.SigningKey{}, err
+	}
+	return s.keyRepo.GetActive(ctx)
+}
@@ -0,0 +1,24 @@
+package token
+
+import (
+	"context"
+	"time"
+
+	"github.com/crafty-ezhik/rocket-factory/iam/internal/model"
+)
+
+// SigningKeys - возвращает ключи, которыми могут быть подписаны еще не истекшие токены
+func (s *service) SigningKeys(ctx context.Context) ([]model.SigningKey, error) {
+	keys, err := s.keyRepo.ListVerifiable(ctx, time.Now().Add(-s.tokenTTL))
+	if err != nil {
+		return nil, err
+	}
+
+	for i, key := range keys {
+		if key.RotatedAt != nil {
+			verifiableUntil := key.RotatedAt.Add(s.tokenTTL)
+			keys[i].VerifiableUntil = &verifiableUntil
+		}
+	}
+	return keys, nil
+}
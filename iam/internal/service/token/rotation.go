@@ -0,0 +1,69 @@
+package token
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/crafty-ezhik/rocket-factory/iam/internal/model"
+	"github.com/crafty-ezhik/rocket-factory/platform/pkg/logger"
+)
+
+// RunKeyRotation - периодически заменяет устаревший ключ подписи, пока не будет отменен контекст
+func (s *service) RunKeyRotation(ctx context.Context) error {
+	logger.Info(ctx, "Starting signing key rotation")
+
+	// Проверяем ключ чаще интервала ротации, чтобы не пропустить момент замены на долгом интервале
+	ticker := time.NewTicker(min(s.rotationInterval, time.Hour))
+	defer ticker.Stop()
+
+	for {
+		if err := s.rotateIfDue(ctx); err != nil {
+			logger.Error(ctx, "Signing key rotation failed", zap.Error(err))
+		}
+
+		select {
+		case <-ctx.Done():
+			logger.Info(ctx, "Signing key rotation stopped")
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// rotateIfDue - заменяет ключ, если текущего нет или он старше интервала ротации
+func (s *service) rotateIfDue(ctx context.Context) error {
+	key, err := s.keyRepo.GetActive(ctx)
+	if err != nil && !errors.Is(err, model.ErrSigningKeyNotFound) {
+		return err
+	}
+	if err == nil && time.Since(key.CreatedAt) < s.rotationInterval {
+		return nil
+	}
+
+	if err = s.rotate(ctx); err != nil {
+		return err
+	}
+	logger.Info(ctx, "Signing key rotated")
+	return nil
+}
+
+// rotate - генерирует новый ключ Ed25519 и делает его текущим
+func (s *service) rotate(ctx context.Context) error {
+	publicKey, privateKey, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		return err
+	}
+
+	return s.keyRepo.Rotate(ctx, model.SigningKey{
+		KID:        uuid.NewString(),
+		PrivateKey: privateKey,
+		PublicKey:  publicKey,
+		CreatedAt:  time.Now(),
+	})
+}
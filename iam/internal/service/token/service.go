@@ -0,0 +1,34 @@
+package token
+
+import (
+	"time"
+
+	"github.com/crafty-ezhik/rocket-factory/iam/internal/repository"
+	def "github.com/crafty-ezhik/rocket-factory/iam/internal/service"
+)
+
+var _ def.TokenService = (*service)(nil)
+
+type service struct {
+	keyRepo repository.SigningKeyRepository
+
+	tokenTTL         time.Duration
+	rotationInterval time.Duration
+	issuer           string
+}
+
+// NewService - создает сервис токенов доступа.
+// Ключ подписи заменяется новым раз в rotationInterval, старые ключи публикуются еще tokenTTL
+func NewService(
+	keyRepo repository.SigningKeyRepository,
+	tokenTTL time.Duration,
+	rotationInterval time.Duration,
+	issuer string,
+) *service {
+	return &service{
+		keyRepo:          keyRepo,
+		tokenTTL:         tokenTTL,
+		rotationInterval: rotationInterval,
+		issuer:           issuer,
+	}
+}
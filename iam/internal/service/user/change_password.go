@@ -0,0 +1,67 @@
+package user
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/crafty-ezhik/rocket-factory/iam/internal/model"
+)
+
+// ChangePassword - меняет пароль после проверки текущего и завершает все сессии пользователя,
+// кроме keepSessionUUID. Возвращает количество завершенных сессий
+func (s *service) ChangePassword(
+	ctx context.Context,
+	userUUID uuid.UUID,
+	oldPassword, newPassword string,
+	keepSessionUUID uuid.UUID,
+) (int, error) {
+	user, err := s.userRepo.Get(ctx, userUUID)
+	if err != nil {
+		return 0, err
+	}
+
+	if err = s.hasher.Verify(user.Info.PasswordHash, oldPassword); err != nil {
+		return 0, model.ErrWrongPassword
+	}
+
+	if oldPassword == newPassword {
+		return 0, model.ErrSamePassword
+	}
+
+	if err = s.passwordPolicy.Validate(newPassword); err != nil {
+		return 0, model.NewWeakPasswordError(err)
+	}
+
+	hashedPassword, err := s.hasher.Hash(newPassword)
+	if err != nil {
+		return 0, err
+	}
+
+	if err = s.userRepo.UpdatePasswordHash(ctx, userUUID, hashedPassword); err != nil {
+		return 0, err
+	}
+
+	return s.revokeSessions(ctx, userUUID, keepSessionUUID)
+}
+
+// revokeSessions - завершает все сессии пользователя, кроме keepSessionUUID
+func (s *service) revokeSessions(ctx context.Context, userUUID, keepSessionUUID uuid.UUID) (int, error) {
+	sessions, err := s.sessionRepo.ListByUser(ctx, userUUID)
+	if err != nil {
+		return 0, err
+	}
+
+	revoked := 0
+	for _, session := range sessions {
+		if session.UUID == keepSessionUUID {
+			continue
+		}
+
+		if err = s.sessionRepo.Delete(ctx, userUUID, session.UUID); err != nil {
+			return revoked, err
+		}
+		revoked++
+	}
+	return revoked, nil
+}
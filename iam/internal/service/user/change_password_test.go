@@ -0,0 +1,74 @@
+package user
+
+import (
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/crafty-ezhik/rocket-factory/iam/internal/model"
+	"github.com/crafty-ezhik/rocket-factory/platform/pkg/hasher"
+)
+
+func (s *ServiceSuite) TestChangePasswordSuccess() {
+	userUUID := uuid.MustParse("00000000-0000-0000-0000-000000000001")
+	currentSessionUUID := uuid.MustParse("00000000-0000-0000-0000-000000000011")
+	otherSessionUUID := uuid.MustParse("00000000-0000-0000-0000-000000000012")
+
+	hash, err := s.service.hasher.Hash("Old-passw0rd")
+	s.Require().NoError(err)
+
+	s.userRepo.On("Get", s.ctx, userUUID).
+		Return(model.User{UUID: userUUID, Info: model.UserInfo{PasswordHash: hash}}, nil).
+		Once()
+	s.userRepo.On("UpdatePasswordHash", s.ctx, userUUID, mock.AnythingOfType("string")).Return(nil).Once()
+	s.sessionRepo.On("ListByUser", s.ctx, userUUID).
+		Return([]model.Session{{UUID: currentSessionUUID}, {UUID: otherSessionUUID}}, nil).
+		Once()
+	// Текущая сессия остается активной
+	s.sessionRepo.On("Delete", s.ctx, userUUID, otherSessionUUID).Return(nil).Once()
+
+	revoked, err := s.service.ChangePassword(s.ctx, userUUID, "Old-passw0rd", "New-passw0rd", currentSessionUUID)
+	s.Require().NoError(err)
+	s.Require().Equal(1, revoked)
+}
+
+func (s *ServiceSuite) TestChangePasswordFailure() {
+	userUUID := uuid.MustParse("00000000-0000-0000-0000-000000000001")
+
+	hash, err := s.service.hasher.Hash("Old-passw0rd")
+	s.Require().NoError(err)
+	user := model.User{UUID: userUUID, Info: model.UserInfo{PasswordHash: hash}}
+
+	tests := []struct {
+		name        string
+		oldPassword string
+		newPassword string
+		expectedErr error
+	}{
+		{
+			name:        "wrong old password",
+			oldPassword: "Wrong-passw0rd",
+			newPassword: "New-passw0rd",
+			expectedErr: model.ErrWrongPassword,
+		},
+		{
+			name:        "same password",
+			oldPassword: "Old-passw0rd",
+			newPassword: "Old-passw0rd",
+			expectedErr: model.ErrSamePassword,
+		},
+		{
+			name:        "weak password",
+			oldPassword: "Old-passw0rd",
+			newPassword: "short",
+			expectedErr: hasher.ErrWeakPassword,
+		},
+	}
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			s.userRepo.On("Get", s.ctx, userUUID).Return(user, nil).Once()
+
+			_, err := s.service.ChangePassword(s.ctx, userUUID, tt.oldPassword, tt.newPassword, uuid.Nil)
+			s.Require().ErrorIs(err, tt.expectedErr)
+		})
+	}
+}
@@ -0,0 +1,17 @@
+package user
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// Delete - мягко удаляет пользователя и завершает все его сессии
+func (s *service) Delete(ctx context.Context, userUUID uuid.UUID) error {
+	if err := s.userRepo.SoftDelete(ctx, userUUID); err != nil {
+		return err
+	}
+
+	_, err := s.revokeSessions(ctx, userUUID, uuid.Nil)
+	return err
+}
@@ -0,0 +1,61 @@
+package user
+
+import (
+	"errors"
+
+	"github.com/google/uuid"
+
+	"github.com/crafty-ezhik/rocket-factory/iam/internal/model"
+)
+
+func (s *ServiceSuite) TestDeleteSuccess() {
+	userUUID := uuid.MustParse("00000000-0000-0000-0000-000000000001")
+	sessions := []model.Session{
+		{UUID: uuid.MustParse("00000000-0000-0000-0000-000000000011"), UserUUID: userUUID},
+		{UUID: uuid.MustParse("00000000-0000-0000-0000-000000000012"), UserUUID: userUUID},
+	}
+
+	s.userRepo.On("SoftDelete", s.ctx, userUUID).Return(nil).Once()
+	s.sessionRepo.On("ListByUser", s.ctx, userUUID).Return(sessions, nil).Once()
+	for _, session := range sessions {
+		s.sessionRepo.On("Delete", s.ctx, userUUID, session.UUID).Return(nil).Once()
+	}
+
+	err := s.service.Delete(s.ctx, userUUID)
+	s.Require().NoError(err)
+}
+
+func (s *ServiceSuite) TestDeleteFailure() {
+	userUUID := uuid.MustParse("00000000-0000-0000-0000-000000000001")
+	redisErr := errors.New("redis error")
+
+	tests := []struct {
+		name        string
+		setupMock   func()
+		expectedErr error
+	}{
+		{
+			name: "user not found",
+			setupMock: func() {
+				s.userRepo.On("SoftDelete", s.ctx, userUUID).Return(model.ErrUserNotFound).Once()
+			},
+			expectedErr: model.ErrUserNotFound,
+		},
+		{
+			name: "sessions not revoked",
+			setupMock: func() {
+				s.userRepo.On("SoftDelete", s.ctx, userUUID).Return(nil).Once()
+				s.sessionRepo.On("ListByUser", s.ctx, userUUID).Return(nil, redisErr).Once()
+			},
+			expectedErr: redisErr,
+		},
+	}
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			tt.setupMock()
+
+			err := s.service.Delete(s.ctx, userUUID)
+			s.Require().ErrorIs(err, tt.expectedErr)
+		})
+	}
+}
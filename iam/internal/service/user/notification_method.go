@@ -0,0 +1,17 @@
+package user
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/crafty-ezhik/rocket-factory/iam/internal/model"
+)
+
+func (s *service) AddNotificationMethod(ctx context.Context, userUUID uuid.UUID, method model.NotificationMethod) error {
+	return s.userRepo.AddNotificationMethod(ctx, userUUID, method)
+}
+
+func (s *service) RemoveNotificationMethod(ctx context.Context, userUUID uuid.UUID, method model.NotificationMethod) error {
+	return s.userRepo.RemoveNotificationMethod(ctx, userUUID, method)
+}
@@ -0,0 +1,39 @@
+package user
+
+import (
+	"github.com/google/uuid"
+
+	"github.com/crafty-ezhik/rocket-factory/iam/internal/model"
+)
+
+func (s *ServiceSuite) TestAddNotificationMethod() {
+	userUUID := uuid.MustParse("00000000-0000-0000-0000-000000000001")
+	method := model.NotificationMethod{ProviderName: "telegram", Target: "12345"}
+
+	tests := []struct {
+		name        string
+		repoErr     error
+		expectedErr error
+	}{
+		{name: "success"},
+		{name: "already exists", repoErr: model.ErrNotificationMethodAlreadyExist, expectedErr: model.ErrNotificationMethodAlreadyExist},
+	}
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			s.userRepo.On("AddNotificationMethod", s.ctx, userUUID, method).Return(tt.repoErr).Once()
+
+			err := s.service.AddNotificationMethod(s.ctx, userUUID, method)
+			s.Require().ErrorIs(err, tt.expectedErr)
+		})
+	}
+}
+
+func (s *ServiceSuite) TestRemoveNotificationMethod() {
+	userUUID := uuid.MustParse("00000000-0000-0000-0000-000000000001")
+	method := model.NotificationMethod{ProviderName: "telegram", Target: "12345"}
+
+	s.userRepo.On("RemoveNotificationMethod", s.ctx, userUUID, method).Return(nil).Once()
+
+	err := s.service.RemoveNotificationMethod(s.ctx, userUUID, method)
+	s.Require().NoError(err)
+}
@@ -0,0 +1,46 @@
+package user
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+
+	"github.com/crafty-ezhik/rocket-factory/iam/internal/model"
+	"github.com/crafty-ezhik/rocket-factory/platform/pkg/logger"
+)
+
+// RequestPasswordReset - выпускает токен сброса пароля. Если пользователя с таким email нет,
+// ошибка не возвращается, чтобы по ответу нельзя было перебирать зарегистрированные адреса
+func (s *service) RequestPasswordReset(ctx context.Context, email string) error {
+	user, err := s.userRepo.GetByEmail(ctx, email)
+	if err != nil {
+		if errors.Is(err, model.ErrUserNotFound) {
+			logger.Info(ctx, "Password reset requested for unknown email")
+			return nil
+		}
+		return err
+	}
+
+	return s.issueToken(ctx, user, model.TokenPurposePasswordReset)
+}
+
+// ConfirmPasswordReset - устанавливает новый пароль по токену сброса и завершает все сессии пользователя.
+// Возвращает количество завершенных сессий
+func (s *service) ConfirmPasswordReset(ctx context.Context, token, newPassword string) (int, error) {
+	if err := s.passwordPolicy.Validate(newPassword); err != nil {
+		return 0, model.NewWeakPasswordError(err)
+	}
+
+	hashedPassword, err := s.hasher.Hash(newPassword)
+	if err != nil {
+		return 0, err
+	}
+
+	userUUID, err := s.userTokenRepo.ConsumePasswordReset(ctx, hashToken(token), hashedPassword)
+	if err != nil {
+		return 0, err
+	}
+
+	return s.revokeSessions(ctx, userUUID, uuid.Nil)
+}
@@ -2,13 +2,13 @@ package user
 
 import (
 	"context"
-	"errors"
 	"net/mail"
 
 	"github.com/google/uuid"
+	"go.uber.org/zap"
 
 	"github.com/crafty-ezhik/rocket-factory/iam/internal/model"
-	"github.com/crafty-ezhik/rocket-factory/platform/pkg/hasher"
+	"github.com/crafty-ezhik/rocket-factory/platform/pkg/logger"
 )
 
 func (s *service) Register(ctx context.Context, userInfo model.UserRegistrationInfo) (uuid.UUID, error) {
@@ -16,11 +16,24 @@ func (s *service) Register(ctx context.Context, userInfo model.UserRegistrationI
 		return uuid.Nil, model.ErrInvalidEmail
 	}
 
+	locale, err := model.NormalizeLocale(userInfo.Info.Locale)
+	if err != nil {
+		return uuid.Nil, err
+	}
+	userInfo.Info.Locale = locale
+
+	timezone, err := model.NormalizeTimezone(userInfo.Info.Timezone)
+	if err != nil {
+		return uuid.Nil, err
+	}
+	userInfo.Info.Timezone = timezone
+
+	if err = s.passwordPolicy.Validate(userInfo.Password); err != nil {
+		return uuid.Nil, model.NewWeakPasswordError(err)
+	}
+
 	hashedPassword, err := s.hasher.Hash(userInfo.Password)
 	if err != nil {
-		if errors.Is(err, hasher.ErrWeakPassword) {
-			return uuid.Nil, model.ErrWeakPassword
-		}
 		return uuid.Nil, err
 	}
 
@@ -28,5 +41,13 @@ func (s *service) Register(ctx context.Context, userInfo model.UserRegistrationI
 	if err != nil {
 		return uuid.Nil, err
 	}
+
+	// Пользователь уже создан, поэтому ошибка отправки токена не отменяет регистрацию.
+	// Новый токен выпускается при повторном указании email через UpdateUser
+	user := model.User{UUID: userUUID, Info: userInfo.Info}
+	if err = s.issueToken(ctx, user, model.TokenPurposeEmailVerification); err != nil {
+		logger.Error(ctx, "Failed to issue email verification token", zap.String("user_uuid", userUUID.String()), zap.Error(err))
+	}
+
 	return userUUID, nil
 }
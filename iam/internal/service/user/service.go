@@ -9,13 +9,32 @@ import (
 var _ def.UserService = (*service)(nil)
 
 type service struct {
-	userRepo repository.UserRepository
-	hasher   hasher.PasswordHasher
+	userRepo       repository.UserRepository
+	sessionRepo    repository.SessionRepository
+	hasher         hasher.PasswordHasher
+	passwordPolicy hasher.PasswordPolicy
+
+	userTokenRepo repository.UserTokenRepository
+	userProducer  def.UserProducerService
+	tokenTTL      TokenTTL
 }
 
-func NewService(userRepo repository.UserRepository, hasher hasher.PasswordHasher) *service {
+func NewService(
+	userRepo repository.UserRepository,
+	sessionRepo repository.SessionRepository,
+	hasher hasher.PasswordHasher,
+	passwordPolicy hasher.PasswordPolicy,
+	userTokenRepo repository.UserTokenRepository,
+	userProducer def.UserProducerService,
+	tokenTTL TokenTTL,
+) *service {
 	return &service{
-		userRepo: userRepo,
-		hasher:   hasher,
+		userRepo:       userRepo,
+		sessionRepo:    sessionRepo,
+		hasher:         hasher,
+		passwordPolicy: passwordPolicy,
+		userTokenRepo:  userTokenRepo,
+		userProducer:   userProducer,
+		tokenTTL:       tokenTTL,
 	}
 }
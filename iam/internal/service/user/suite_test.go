@@ -0,0 +1,52 @@
+package user
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	repoMock "github.com/crafty-ezhik/rocket-factory/iam/internal/repository/mocks"
+	serviceMock "github.com/crafty-ezhik/rocket-factory/iam/internal/service/mocks"
+	"github.com/crafty-ezhik/rocket-factory/platform/pkg/hasher"
+	bcryptHasher "github.com/crafty-ezhik/rocket-factory/platform/pkg/hasher/bcrypt"
+	"github.com/crafty-ezhik/rocket-factory/platform/pkg/logger"
+)
+
+// testBcryptCost - минимальная стоимость bcrypt, чтобы тесты не тратили время на хеширование
+const testBcryptCost = 4
+
+type ServiceSuite struct {
+	suite.Suite
+	ctx           context.Context //nolint:containedctx
+	userRepo      *repoMock.MockUserRepository
+	sessionRepo   *repoMock.MockSessionRepository
+	userTokenRepo *repoMock.MockUserTokenRepository
+	userProducer  *serviceMock.MockUserProducerService
+	service       *service
+}
+
+func (s *ServiceSuite) SetupTest() {
+	s.ctx = context.Background()
+
+	logger.SetNopLogger()
+
+	s.userRepo = repoMock.NewMockUserRepository(s.T())
+	s.sessionRepo = repoMock.NewMockSessionRepository(s.T())
+	s.userTokenRepo = repoMock.NewMockUserTokenRepository(s.T())
+	s.userProducer = serviceMock.NewMockUserProducerService(s.T())
+	s.service = NewService(
+		s.userRepo,
+		s.sessionRepo,
+		bcryptHasher.NewBcryptPasswordHasher(testBcryptCost),
+		hasher.DefaultPasswordPolicy(),
+		s.userTokenRepo,
+		s.userProducer,
+		TokenTTL{EmailVerification: time.Hour, PasswordReset: time.Hour},
+	)
+}
+
+func TestServiceIntegration(t *testing.T) {
+	suite.Run(t, new(ServiceSuite))
+}
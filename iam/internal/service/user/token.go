@@ -0,0 +1,78 @@
+package user
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/crafty-ezhik/rocket-factory/iam/internal/model"
+)
+
+// tokenSize - размер одноразового токена в байтах до кодирования
+const tokenSize = 32
+
+// TokenTTL - время жизни одноразовых токенов пользователя
+type TokenTTL struct {
+	EmailVerification time.Duration
+	PasswordReset     time.Duration
+}
+
+func (t TokenTTL) forPurpose(purpose model.TokenPurpose) time.Duration {
+	if purpose == model.TokenPurposePasswordReset {
+		return t.PasswordReset
+	}
+	return t.EmailVerification
+}
+
+// issueToken - выпускает одноразовый токен, сохраняет его хеш и публикует событие для доставки токена на email
+func (s *service) issueToken(ctx context.Context, user model.User, purpose model.TokenPurpose) error {
+	token, err := generateToken()
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	expiresAt := now.Add(s.tokenTTL.forPurpose(purpose))
+
+	err = s.userTokenRepo.Create(ctx, model.UserToken{
+		Hash:      hashToken(token),
+		UserUUID:  user.UUID,
+		Purpose:   purpose,
+		CreatedAt: now,
+		ExpiresAt: expiresAt,
+	})
+	if err != nil {
+		return err
+	}
+
+	return s.userProducer.ProduceUserTokenIssued(ctx, model.UserTokenIssuedEvent{
+		EventUUID: uuid.New(),
+		UserUUID:  user.UUID,
+		Login:     user.Info.Login,
+		Email:     user.Info.Email,
+		Purpose:   purpose,
+		Token:     token,
+		ExpiresAt: expiresAt,
+	})
+}
+
+// generateToken - случайный токен в base64url без паддинга
+func generateToken() (string, error) {
+	buf := make([]byte, tokenSize)
+	if _, err := rand.Read(buf); err != nil {
+		return "", fmt.Errorf("generate token: %w", err)
+	}
+	return base64.RawURLEncoding.EncodeToString(buf), nil
+}
+
+// hashToken - хеш токена для хранения. Токен случайный и длинный, поэтому соль и медленный хеш не нужны
+func hashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,43 @@
+package user
+
+import (
+	"context"
+	"net/mail"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/crafty-ezhik/rocket-factory/iam/internal/model"
+	"github.com/crafty-ezhik/rocket-factory/platform/pkg/logger"
+)
+
+// UpdateEmail - меняет email пользователя. Новый email не подтвержден, на него отправляется новый токен.
+// Повторное указание текущего email ничего не меняет и только переотправляет токен, если email еще не подтвержден
+func (s *service) UpdateEmail(ctx context.Context, userUUID uuid.UUID, email string) (model.User, error) {
+	if _, err := mail.ParseAddress(email); err != nil {
+		return model.User{}, model.ErrInvalidEmail
+	}
+
+	user, err := s.userRepo.Get(ctx, userUUID)
+	if err != nil {
+		return model.User{}, err
+	}
+
+	if user.Info.Email != email {
+		if err = s.userRepo.UpdateEmail(ctx, userUUID, email); err != nil {
+			return model.User{}, err
+		}
+
+		user, err = s.userRepo.Get(ctx, userUUID)
+		if err != nil {
+			return model.User{}, err
+		}
+	}
+
+	if !user.IsEmailVerified() {
+		if err = s.issueToken(ctx, user, model.TokenPurposeEmailVerification); err != nil {
+			logger.Error(ctx, "Failed to issue email verification token", zap.String("user_uuid", userUUID.String()), zap.Error(err))
+		}
+	}
+	return user, nil
+}
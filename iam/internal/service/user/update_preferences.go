@@ -0,0 +1,27 @@
+package user
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/crafty-ezhik/rocket-factory/iam/internal/model"
+)
+
+func (s *service) UpdatePreferences(ctx context.Context, userUUID uuid.UUID, locale, timezone string) (model.User, error) {
+	locale, err := model.NormalizeLocale(locale)
+	if err != nil {
+		return model.User{}, err
+	}
+
+	timezone, err = model.NormalizeTimezone(timezone)
+	if err != nil {
+		return model.User{}, err
+	}
+
+	if err = s.userRepo.UpdatePreferences(ctx, userUUID, locale, timezone); err != nil {
+		return model.User{}, err
+	}
+
+	return s.userRepo.Get(ctx, userUUID)
+}
@@ -0,0 +1,146 @@
+package user
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/crafty-ezhik/rocket-factory/iam/internal/model"
+)
+
+func (s *ServiceSuite) TestUpdateEmailSuccess() {
+	userUUID := uuid.MustParse("00000000-0000-0000-0000-000000000001")
+	verifiedAt := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	verifiedUser := model.User{
+		UUID:            userUUID,
+		Info:            model.UserInfo{Login: "user", Email: "old@example.com"},
+		EmailVerifiedAt: &verifiedAt,
+	}
+	unverifiedUser := model.User{
+		UUID: userUUID,
+		Info: model.UserInfo{Login: "user", Email: "old@example.com"},
+	}
+	changedUser := model.User{
+		UUID: userUUID,
+		Info: model.UserInfo{Login: "user", Email: "new@example.com"},
+	}
+
+	tests := []struct {
+		name         string
+		email        string
+		setupMock    func()
+		expectedUser model.User
+	}{
+		{
+			name:  "new email resets verification and issues token",
+			email: "new@example.com",
+			setupMock: func() {
+				s.userRepo.On("Get", s.ctx, userUUID).Return(verifiedUser, nil).Once()
+				s.userRepo.On("UpdateEmail", s.ctx, userUUID, "new@example.com").Return(nil).Once()
+				s.userRepo.On("Get", s.ctx, userUUID).Return(changedUser, nil).Once()
+				s.expectVerificationToken(userUUID, "new@example.com")
+			},
+			expectedUser: changedUser,
+		},
+		{
+			name:  "same verified email is not changed",
+			email: "old@example.com",
+			setupMock: func() {
+				s.userRepo.On("Get", s.ctx, userUUID).Return(verifiedUser, nil).Once()
+			},
+			expectedUser: verifiedUser,
+		},
+		{
+			name:  "same unverified email reissues token",
+			email: "old@example.com",
+			setupMock: func() {
+				s.userRepo.On("Get", s.ctx, userUUID).Return(unverifiedUser, nil).Once()
+				s.expectVerificationToken(userUUID, "old@example.com")
+			},
+			expectedUser: unverifiedUser,
+		},
+	}
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			tt.setupMock()
+
+			user, err := s.service.UpdateEmail(s.ctx, userUUID, tt.email)
+			s.Require().NoError(err)
+			s.Require().Equal(tt.expectedUser, user)
+		})
+	}
+}
+
+func (s *ServiceSuite) TestUpdateEmailFailure() {
+	userUUID := uuid.MustParse("00000000-0000-0000-0000-000000000001")
+	currentUser := model.User{
+		UUID: userUUID,
+		Info: model.UserInfo{Login: "user", Email: "old@example.com"},
+	}
+	dbErr := errors.New("db error")
+
+	tests := []struct {
+		name        string
+		email       string
+		setupMock   func()
+		expectedErr error
+	}{
+		{
+			name:        "invalid email",
+			email:       "not-an-email",
+			setupMock:   func() {},
+			expectedErr: model.ErrInvalidEmail,
+		},
+		{
+			name:  "user not found",
+			email: "new@example.com",
+			setupMock: func() {
+				s.userRepo.On("Get", s.ctx, userUUID).Return(model.User{}, model.ErrUserNotFound).Once()
+			},
+			expectedErr: model.ErrUserNotFound,
+		},
+		{
+			name:  "email already in use",
+			email: "new@example.com",
+			setupMock: func() {
+				s.userRepo.On("Get", s.ctx, userUUID).Return(currentUser, nil).Once()
+				s.userRepo.On("UpdateEmail", s.ctx, userUUID, "new@example.com").Return(model.ErrEmailAlreadyInUse).Once()
+			},
+			expectedErr: model.ErrEmailAlreadyInUse,
+		},
+		{
+			name:  "db error",
+			email: "new@example.com",
+			setupMock: func() {
+				s.userRepo.On("Get", s.ctx, userUUID).Return(currentUser, nil).Once()
+				s.userRepo.On("UpdateEmail", s.ctx, userUUID, "new@example.com").Return(dbErr).Once()
+			},
+			expectedErr: dbErr,
+		},
+	}
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			tt.setupMock()
+
+			_, err := s.service.UpdateEmail(s.ctx, userUUID, tt.email)
+			s.Require().ErrorIs(err, tt.expectedErr)
+		})
+	}
+}
+
+// expectVerificationToken - ожидает выпуск токена подтверждения и событие с ним для email
+func (s *ServiceSuite) expectVerificationToken(userUUID uuid.UUID, email string) {
+	s.userTokenRepo.On("Create", s.ctx, mock.MatchedBy(func(token model.UserToken) bool {
+		return token.UserUUID == userUUID && token.Purpose == model.TokenPurposeEmailVerification
+	})).Return(nil).Once()
+
+	s.userProducer.On("ProduceUserTokenIssued", s.ctx, mock.MatchedBy(func(event model.UserTokenIssuedEvent) bool {
+		return event.UserUUID == userUUID &&
+			event.Email == email &&
+			event.Purpose == model.TokenPurposeEmailVerification &&
+			event.Token != ""
+	})).Return(nil).Once()
+}
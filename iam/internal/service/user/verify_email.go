@@ -0,0 +1,11 @@
+package user
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+func (s *service) VerifyEmail(ctx context.Context, token string) (uuid.UUID, error) {
+	return s.userTokenRepo.ConsumeEmailVerification(ctx, hashToken(token))
+}
@@ -0,0 +1,2 @@
+-- Удаляем разрешение вместе с выдачей ролям
+delete from permissions where name = 'reservations:manage';
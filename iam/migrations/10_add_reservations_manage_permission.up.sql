@@ -0,0 +1,7 @@
+-- Разрешение снимать и подтверждать резервы деталей любых пользователей в inventory
+insert into permissions (name, description) values
+    ('reservations:manage', 'Управление резервами деталей всех пользователей');
+
+insert into role_permissions (role_name, permission_name) values
+    ('operator', 'reservations:manage'),
+    ('admin', 'reservations:manage');
@@ -0,0 +1,8 @@
+-- Удаляем индекс
+drop index if exists idx_iam_user_roles_role_name;
+
+-- Удаляем таблицы ролей и разрешений
+drop table if exists user_roles;
+drop table if exists role_permissions;
+drop table if exists permissions;
+drop table if exists roles;
@@ -0,0 +1,65 @@
+-- Создаем таблицу ролей
+create table roles (
+    name varchar(50) primary key ,
+    description text not null default ''
+);
+
+-- Создаем таблицу разрешений
+create table permissions (
+    name varchar(100) primary key ,
+    description text not null default ''
+);
+
+-- Создаем таблицу выдачи разрешений ролям
+create table role_permissions (
+    role_name varchar(50) not null references roles(name) on delete cascade ,
+    permission_name varchar(100) not null references permissions(name) on delete cascade ,
+    primary key (role_name, permission_name)
+);
+
+-- Создаем таблицу ролей пользователей
+create table user_roles (
+    user_uuid uuid not null references users(user_uuid) on delete cascade ,
+    role_name varchar(50) not null references roles(name) on delete cascade ,
+    primary key (user_uuid, role_name)
+);
+
+-- Базовые роли
+insert into roles (name, description) values
+    ('customer', 'Покупатель: создает и оплачивает свои заказы'),
+    ('operator', 'Оператор: поддержка и работа со складом'),
+    ('admin', 'Администратор: полный доступ');
+
+-- Разрешения
+insert into permissions (name, description) values
+    ('parts:read', 'Просмотр деталей'),
+    ('parts:write', 'Изменение каталога деталей'),
+    ('reservations:write', 'Резервирование деталей под заказ'),
+    ('orders:read', 'Просмотр заказов'),
+    ('orders:write', 'Создание, оплата и отмена заказов'),
+    ('orders:read_all', 'Просмотр заказов всех пользователей');
+
+-- Выдаем разрешения ролям
+insert into role_permissions (role_name, permission_name) values
+    ('customer', 'parts:read'),
+    ('customer', 'reservations:write'),
+    ('customer', 'orders:read'),
+    ('customer', 'orders:write'),
+    ('operator', 'parts:read'),
+    ('operator', 'parts:write'),
+    ('operator', 'reservations:write'),
+    ('operator', 'orders:read'),
+    ('operator', 'orders:read_all'),
+    ('admin', 'parts:read'),
+    ('admin', 'parts:write'),
+    ('admin', 'reservations:write'),
+    ('admin', 'orders:read'),
+    ('admin', 'orders:write'),
+    ('admin', 'orders:read_all');
+
+-- Существующие пользователи получают роль покупателя
+insert into user_roles (user_uuid, role_name)
+select user_uuid, 'customer' from users;
+
+-- Создаем индекс для выборки пользователей по роли
+create index if not exists idx_iam_user_roles_role_name on user_roles (role_name);
@@ -0,0 +1,5 @@
+-- Удаляем индекс
+drop index if exists idx_iam_signing_keys_active;
+
+-- Удаляем таблицу ключей подписи
+drop table if exists signing_keys;
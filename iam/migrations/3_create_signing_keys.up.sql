@@ -0,0 +1,11 @@
+-- Создаем таблицу ключей подписи токенов доступа
+create table signing_keys (
+    kid varchar(64) primary key ,
+    private_key bytea not null ,
+    public_key bytea not null ,
+    created_at timestamp with time zone not null default now(),
+    rotated_at timestamp with time zone
+);
+
+-- Текущим может быть только один ключ: у него не задано время ротации
+create unique index if not exists idx_iam_signing_keys_active on signing_keys ((true)) where rotated_at is null;
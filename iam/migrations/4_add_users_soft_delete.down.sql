@@ -0,0 +1,12 @@
+-- Удаляем индексы
+drop index if exists idx_iam_notification_methods_unique;
+drop index if exists idx_iam_users_email_active;
+drop index if exists idx_iam_users_login_active;
+
+-- Возвращаем уникальность логина и email. Удаленные пользователи удаляются окончательно
+delete from users where deleted_at is not null;
+alter table users add constraint users_login_key unique (login);
+alter table users add constraint users_email_key unique (email);
+
+-- Удаляем признак удаления пользователя
+alter table users drop column if exists deleted_at;
@@ -0,0 +1,17 @@
+-- Добавляем признак удаления пользователя
+alter table users add column deleted_at timestamp with time zone;
+
+-- Логин и email удаленного пользователя можно занять снова
+alter table users drop constraint if exists users_login_key;
+alter table users drop constraint if exists users_email_key;
+create unique index if not exists idx_iam_users_login_active on users (login) where deleted_at is null;
+create unique index if not exists idx_iam_users_email_active on users (email) where deleted_at is null;
+
+-- Канал уведомлений не должен дублироваться у одного пользователя
+delete from notification_methods a
+    using notification_methods b
+    where a.id > b.id
+      and a.user_uuid = b.user_uuid
+      and a.provider_name = b.provider_name
+      and a.target = b.target;
+create unique index if not exists idx_iam_notification_methods_unique on notification_methods (user_uuid, provider_name, target);
@@ -0,0 +1,8 @@
+-- Удаляем индекс
+drop index if exists idx_iam_user_tokens_user_purpose;
+
+-- Удаляем таблицу одноразовых токенов
+drop table if exists user_tokens;
+
+-- Удаляем время подтверждения email
+alter table users drop column if exists email_verified_at;
@@ -0,0 +1,17 @@
+-- Добавляем время подтверждения email. Существующие пользователи считаются подтвержденными
+alter table users add column email_verified_at timestamp with time zone;
+update users set email_verified_at = created_at;
+
+-- Создаем таблицу одноразовых токенов: подтверждение email и сброс пароля.
+-- Хранится только хеш токена, сам токен отправляется пользователю
+create table user_tokens (
+    token_hash varchar(64) primary key ,
+    user_uuid UUID not null references users(user_uuid) on delete cascade ,
+    purpose varchar(32) not null ,
+    created_at timestamp with time zone not null default now(),
+    expires_at timestamp with time zone not null ,
+    used_at timestamp with time zone
+);
+
+-- Создаем индекс для поиска токенов пользователя по назначению
+create index if not exists idx_iam_user_tokens_user_purpose on user_tokens (user_uuid, purpose);
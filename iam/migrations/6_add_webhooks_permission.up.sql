@@ -0,0 +1,7 @@
+-- Разрешение на просмотр журнала доставки webhook-уведомлений в notification
+insert into permissions (name, description) values
+    ('webhooks:read', 'Просмотр журнала доставки webhook-уведомлений');
+
+insert into role_permissions (role_name, permission_name) values
+    ('operator', 'webhooks:read'),
+    ('admin', 'webhooks:read');
@@ -0,0 +1,3 @@
+-- Удаляем настройки уведомлений пользователя
+alter table users drop column if exists timezone;
+alter table users drop column if exists locale;
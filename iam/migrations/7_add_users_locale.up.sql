@@ -0,0 +1,3 @@
+-- Язык и часовой пояс, в которых пользователь получает уведомления
+alter table users add column locale varchar(16) not null default 'ru';
+alter table users add column timezone varchar(64) not null default 'UTC';
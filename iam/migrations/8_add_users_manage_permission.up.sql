@@ -0,0 +1,6 @@
+-- Разрешение работать с чужими учетными записями и сессиями в IAM
+insert into permissions (name, description) values
+    ('users:manage', 'Управление учетными записями и сессиями других пользователей');
+
+insert into role_permissions (role_name, permission_name) values
+    ('admin', 'users:manage');
@@ -0,0 +1,6 @@
+-- Разрешение на выдачу секретов подписи webhook-уведомлений интеграторам
+insert into permissions (name, description) values
+    ('webhooks:manage', 'Выдача секретов подписи webhook-уведомлений');
+
+insert into role_permissions (role_name, permission_name) values
+    ('admin', 'webhooks:manage');
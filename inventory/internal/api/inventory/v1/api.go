@@ -8,11 +8,13 @@ import (
 type api struct {
 	inventoryV1.UnimplementedInventoryServiceServer
 
-	inventoryService service.InventoryService
+	inventoryService   service.InventoryService
+	reservationService service.ReservationService
 }
 
-func NewAPI(inventoryService service.InventoryService) *api {
+func NewAPI(inventoryService service.InventoryService, reservationService service.ReservationService) *api {
 	return &api{
-		inventoryService: inventoryService,
+		inventoryService:   inventoryService,
+		reservationService: reservationService,
 	}
 }
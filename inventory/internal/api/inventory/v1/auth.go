@@ -0,0 +1,28 @@
+package v1
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/crafty-ezhik/rocket-factory/inventory/internal/model"
+	grpcMiddleware "github.com/crafty-ezhik/rocket-factory/platform/pkg/middleware/grpc"
+)
+
+// reservationCaller - пользователь запроса из контекста, куда его кладет AuthInterceptor
+func reservationCaller(ctx context.Context) (model.ReservationCaller, error) {
+	user, ok := grpcMiddleware.GetUserFromContext(ctx)
+	if !ok || user == nil {
+		return model.ReservationCaller{}, model.ErrUnauthenticated
+	}
+
+	userUUID, err := uuid.Parse(user.GetUuid())
+	if err != nil {
+		return model.ReservationCaller{}, model.ErrUnauthenticated
+	}
+
+	return model.ReservationCaller{
+		UserUUID:  userUUID,
+		ManageAll: grpcMiddleware.HasPermission(user, model.PermissionReservationsManage),
+	}, nil
+}
@@ -0,0 +1,29 @@
+package v1
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/crafty-ezhik/rocket-factory/inventory/internal/model"
+	inventoryV1 "github.com/crafty-ezhik/rocket-factory/shared/pkg/proto/inventory/v1"
+)
+
+func (a *api) CommitReservation(ctx context.Context, req *inventoryV1.CommitReservationRequest) (*inventoryV1.CommitReservationResponse, error) {
+	caller, err := reservationCaller(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	orderUUID, err := uuid.Parse(req.GetOrderUuid())
+	if err != nil {
+		return nil, model.ErrInvalidUUID
+	}
+
+	err = a.reservationService.Commit(ctx, caller, orderUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &inventoryV1.CommitReservationResponse{}, nil
+}
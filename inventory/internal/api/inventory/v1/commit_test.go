@@ -0,0 +1,67 @@
+package v1
+
+import (
+	"errors"
+
+	"github.com/google/uuid"
+
+	"github.com/crafty-ezhik/rocket-factory/inventory/internal/model"
+	inventoryV1 "github.com/crafty-ezhik/rocket-factory/shared/pkg/proto/inventory/v1"
+)
+
+func (s *ApiSuite) TestCommitReservation() {
+	orderUUID := uuid.New()
+
+	tests := []struct {
+		name           string
+		orderUUID      string
+		expectedErrMsg string
+		setupMock      func()
+	}{
+		{
+			name:      "success",
+			orderUUID: orderUUID.String(),
+			setupMock: func() {
+				s.reservationService.On("Commit", s.ctx, s.caller, orderUUID).Return(nil).Once()
+			},
+		},
+		{
+			name:           "invalid order uuid",
+			orderUUID:      "invalid",
+			expectedErrMsg: "invalid UUID",
+			setupMock:      func() {},
+		},
+		{
+			name:           "not found",
+			orderUUID:      orderUUID.String(),
+			expectedErrMsg: "reservation not found",
+			setupMock: func() {
+				s.reservationService.On("Commit", s.ctx, s.caller, orderUUID).Return(model.ErrReservationNotFound).Once()
+			},
+		},
+		{
+			name:           "service internal error",
+			orderUUID:      orderUUID.String(),
+			expectedErrMsg: "something went wrong",
+			setupMock: func() {
+				s.reservationService.On("Commit", s.ctx, s.caller, orderUUID).Return(errors.New("something went wrong")).Once()
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			tt.setupMock()
+
+			res, err := s.api.CommitReservation(s.ctx, &inventoryV1.CommitReservationRequest{OrderUuid: tt.orderUUID})
+
+			if tt.expectedErrMsg == "" {
+				s.Require().NoError(err)
+				s.Require().NotNil(res)
+				return
+			}
+			s.Require().Nil(res)
+			s.Require().ErrorContains(err, tt.expectedErrMsg)
+		})
+	}
+}
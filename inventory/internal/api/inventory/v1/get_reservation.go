@@ -0,0 +1,30 @@
+package v1
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/crafty-ezhik/rocket-factory/inventory/internal/converter"
+	"github.com/crafty-ezhik/rocket-factory/inventory/internal/model"
+	inventoryV1 "github.com/crafty-ezhik/rocket-factory/shared/pkg/proto/inventory/v1"
+)
+
+func (a *api) GetReservation(ctx context.Context, req *inventoryV1.GetReservationRequest) (*inventoryV1.GetReservationResponse, error) {
+	caller, err := reservationCaller(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	orderUUID, err := uuid.Parse(req.GetOrderUuid())
+	if err != nil {
+		return nil, model.ErrInvalidUUID
+	}
+
+	reservation, err := a.reservationService.Get(ctx, caller, orderUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	return converter.ReservationToProto(reservation), nil
+}
@@ -0,0 +1,95 @@
+package v1
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/crafty-ezhik/rocket-factory/inventory/internal/model"
+	inventoryV1 "github.com/crafty-ezhik/rocket-factory/shared/pkg/proto/inventory/v1"
+)
+
+func (s *ApiSuite) TestGetReservation() {
+	orderUUID := uuid.New()
+	expiresAt := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name           string
+		orderUUID      string
+		expectedRes    *inventoryV1.GetReservationResponse
+		expectedErrMsg string
+		setupMock      func()
+	}{
+		{
+			name:      "active",
+			orderUUID: orderUUID.String(),
+			expectedRes: &inventoryV1.GetReservationResponse{
+				Status:    inventoryV1.ReservationStatus_ACTIVE,
+				ExpiresAt: timestamppb.New(expiresAt),
+			},
+			setupMock: func() {
+				s.reservationService.On("Get", s.ctx, s.caller, orderUUID).
+					Return(model.Reservation{OrderUUID: orderUUID, Status: model.ReservationStatusActive, ExpiresAt: expiresAt}, nil).
+					Once()
+			},
+		},
+		{
+			name:      "released",
+			orderUUID: orderUUID.String(),
+			expectedRes: &inventoryV1.GetReservationResponse{
+				Status:    inventoryV1.ReservationStatus_RELEASED,
+				ExpiresAt: timestamppb.New(expiresAt),
+			},
+			setupMock: func() {
+				s.reservationService.On("Get", s.ctx, s.caller, orderUUID).
+					Return(model.Reservation{OrderUUID: orderUUID, Status: model.ReservationStatusReleased, ExpiresAt: expiresAt}, nil).
+					Once()
+			},
+		},
+		{
+			name:           "invalid order uuid",
+			orderUUID:      "invalid",
+			expectedErrMsg: "invalid UUID",
+			setupMock:      func() {},
+		},
+		{
+			name:           "not found",
+			orderUUID:      orderUUID.String(),
+			expectedErrMsg: "reservation not found",
+			setupMock: func() {
+				s.reservationService.On("Get", s.ctx, s.caller, orderUUID).
+					Return(model.Reservation{}, model.ErrReservationNotFound).
+					Once()
+			},
+		},
+		{
+			name:           "service internal error",
+			orderUUID:      orderUUID.String(),
+			expectedErrMsg: "something went wrong",
+			setupMock: func() {
+				s.reservationService.On("Get", s.ctx, s.caller, orderUUID).
+					Return(model.Reservation{}, errors.New("something went wrong")).
+					Once()
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			tt.setupMock()
+
+			res, err := s.api.GetReservation(s.ctx, &inventoryV1.GetReservationRequest{OrderUuid: tt.orderUUID})
+
+			if tt.expectedErrMsg == "" {
+				s.Require().NoError(err)
+				s.Require().Equal(tt.expectedRes.GetStatus(), res.GetStatus())
+				s.Require().True(tt.expectedRes.GetExpiresAt().AsTime().Equal(res.GetExpiresAt().AsTime()))
+				return
+			}
+			s.Require().Nil(res)
+			s.Require().ErrorContains(err, tt.expectedErrMsg)
+		})
+	}
+}
@@ -0,0 +1,103 @@
+package v1
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+
+	"github.com/crafty-ezhik/rocket-factory/inventory/internal/model"
+	grpcMiddleware "github.com/crafty-ezhik/rocket-factory/platform/pkg/middleware/grpc"
+	commonV1 "github.com/crafty-ezhik/rocket-factory/shared/pkg/proto/common/v1"
+	inventoryV1 "github.com/crafty-ezhik/rocket-factory/shared/pkg/proto/inventory/v1"
+)
+
+func (s *ApiSuite) TestReleaseReservation() {
+	orderUUID := uuid.New()
+
+	tests := []struct {
+		name           string
+		orderUUID      string
+		expectedErrMsg string
+		setupMock      func()
+	}{
+		{
+			name:      "success",
+			orderUUID: orderUUID.String(),
+			setupMock: func() {
+				s.reservationService.On("Release", s.ctx, s.caller, orderUUID).Return(nil).Once()
+			},
+		},
+		{
+			name:           "invalid order uuid",
+			orderUUID:      "invalid",
+			expectedErrMsg: "invalid UUID",
+			setupMock:      func() {},
+		},
+		{
+			name:           "already committed",
+			orderUUID:      orderUUID.String(),
+			expectedErrMsg: "reservation is already committed",
+			setupMock: func() {
+				s.reservationService.On("Release", s.ctx, s.caller, orderUUID).Return(model.ErrReservationCommitted).Once()
+			},
+		},
+		{
+			name:           "service internal error",
+			orderUUID:      orderUUID.String(),
+			expectedErrMsg: "something went wrong",
+			setupMock: func() {
+				s.reservationService.On("Release", s.ctx, s.caller, orderUUID).Return(errors.New("something went wrong")).Once()
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			tt.setupMock()
+
+			res, err := s.api.ReleaseReservation(s.ctx, &inventoryV1.ReleaseReservationRequest{OrderUuid: tt.orderUUID})
+
+			if tt.expectedErrMsg == "" {
+				s.Require().NoError(err)
+				s.Require().NotNil(res)
+				return
+			}
+			s.Require().Nil(res)
+			s.Require().ErrorContains(err, tt.expectedErrMsg)
+		})
+	}
+}
+
+func (s *ApiSuite) TestReservationMethodsRequireUser() {
+	orderUUID := uuid.New().String()
+	ctx := context.Background()
+
+	_, err := s.api.ReleaseReservation(ctx, &inventoryV1.ReleaseReservationRequest{OrderUuid: orderUUID})
+	s.Require().ErrorIs(err, model.ErrUnauthenticated)
+
+	_, err = s.api.CommitReservation(ctx, &inventoryV1.CommitReservationRequest{OrderUuid: orderUUID})
+	s.Require().ErrorIs(err, model.ErrUnauthenticated)
+
+	_, err = s.api.ReserveParts(ctx, &inventoryV1.ReservePartsRequest{
+		OrderUuid: orderUUID,
+		Items:     []*inventoryV1.ReservationItem{{PartUuid: uuid.New().String(), Quantity: 1}},
+	})
+	s.Require().ErrorIs(err, model.ErrUnauthenticated)
+}
+
+func (s *ApiSuite) TestReservationManagerCaller() {
+	orderUUID := uuid.New()
+	managerUUID := uuid.New()
+	ctx := context.WithValue(context.Background(), grpcMiddleware.GetUserContextKey(), &commonV1.User{
+		Uuid:        managerUUID.String(),
+		Permissions: []string{"reservations:write", model.PermissionReservationsManage},
+	})
+
+	s.reservationService.On("Release", ctx, model.ReservationCaller{UserUUID: managerUUID, ManageAll: true}, orderUUID).
+		Return(nil).
+		Once()
+
+	_, err := s.api.ReleaseReservation(ctx, &inventoryV1.ReleaseReservationRequest{OrderUuid: orderUUID.String()})
+	s.Require().NoError(err)
+}
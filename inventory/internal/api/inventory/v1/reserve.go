@@ -0,0 +1,38 @@
+package v1
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/crafty-ezhik/rocket-factory/inventory/internal/converter"
+	"github.com/crafty-ezhik/rocket-factory/inventory/internal/model"
+	inventoryV1 "github.com/crafty-ezhik/rocket-factory/shared/pkg/proto/inventory/v1"
+)
+
+func (a *api) ReserveParts(ctx context.Context, req *inventoryV1.ReservePartsRequest) (*inventoryV1.ReservePartsResponse, error) {
+	caller, err := reservationCaller(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	orderUUID, err := uuid.Parse(req.GetOrderUuid())
+	if err != nil {
+		return nil, model.ErrInvalidUUID
+	}
+
+	items, err := converter.ReservationItemsToServiceModel(req.GetItems())
+	if err != nil {
+		return nil, err
+	}
+
+	reservation, err := a.reservationService.Reserve(ctx, caller, orderUUID, items)
+	if err != nil {
+		return nil, err
+	}
+
+	return &inventoryV1.ReservePartsResponse{
+		ExpiresAt: timestamppb.New(reservation.ExpiresAt),
+	}, nil
+}
@@ -0,0 +1,111 @@
+package v1
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/crafty-ezhik/rocket-factory/inventory/internal/model"
+	inventoryV1 "github.com/crafty-ezhik/rocket-factory/shared/pkg/proto/inventory/v1"
+)
+
+func (s *ApiSuite) TestReservePartsSuccess() {
+	orderUUID := uuid.New()
+	partUUID := uuid.New()
+	expiresAt := time.Now().Add(15 * time.Minute)
+
+	items := []model.ReservationItem{{PartUUID: partUUID, Quantity: 2}}
+
+	s.reservationService.On("Reserve", s.ctx, s.caller, orderUUID, items).
+		Return(model.Reservation{
+			OrderUUID: orderUUID,
+			Items:     items,
+			Status:    model.ReservationStatusActive,
+			ExpiresAt: expiresAt,
+		}, nil).
+		Once()
+
+	res, err := s.api.ReserveParts(s.ctx, &inventoryV1.ReservePartsRequest{
+		OrderUuid: orderUUID.String(),
+		Items: []*inventoryV1.ReservationItem{
+			{PartUuid: partUUID.String(), Quantity: 2},
+		},
+	})
+
+	s.Require().NoError(err)
+	s.Require().Equal(timestamppb.New(expiresAt), res.GetExpiresAt())
+}
+
+func (s *ApiSuite) TestReservePartsFailure() {
+	orderUUID := uuid.New()
+	partUUID := uuid.New()
+	items := []model.ReservationItem{{PartUUID: partUUID, Quantity: 2}}
+
+	tests := []struct {
+		name           string
+		req            *inventoryV1.ReservePartsRequest
+		expectedErrMsg string
+		setupMock      func()
+	}{
+		{
+			name: "invalid order uuid",
+			req: &inventoryV1.ReservePartsRequest{
+				OrderUuid: "invalid",
+				Items:     []*inventoryV1.ReservationItem{{PartUuid: partUUID.String(), Quantity: 2}},
+			},
+			expectedErrMsg: "invalid UUID",
+			setupMock:      func() {},
+		},
+		{
+			name: "invalid part uuid",
+			req: &inventoryV1.ReservePartsRequest{
+				OrderUuid: orderUUID.String(),
+				Items:     []*inventoryV1.ReservationItem{{PartUuid: "invalid", Quantity: 2}},
+			},
+			expectedErrMsg: "invalid UUID",
+			setupMock:      func() {},
+		},
+		{
+			name: "out of stock",
+			req: &inventoryV1.ReservePartsRequest{
+				OrderUuid: orderUUID.String(),
+				Items:     []*inventoryV1.ReservationItem{{PartUuid: partUUID.String(), Quantity: 2}},
+			},
+			expectedErrMsg: "parts out of stock: " + partUUID.String() + " (requested 2, available 1)",
+			setupMock: func() {
+				s.reservationService.On("Reserve", s.ctx, s.caller, orderUUID, items).
+					Return(model.Reservation{}, model.NewPartsOutOfStockError([]model.StockShortage{
+						{PartUUID: partUUID, Requested: 2, Available: 1},
+					})).
+					Once()
+			},
+		},
+		{
+			name: "service internal error",
+			req: &inventoryV1.ReservePartsRequest{
+				OrderUuid: orderUUID.String(),
+				Items:     []*inventoryV1.ReservationItem{{PartUuid: partUUID.String(), Quantity: 2}},
+			},
+			expectedErrMsg: "something went wrong",
+			setupMock: func() {
+				s.reservationService.On("Reserve", s.ctx, s.caller, orderUUID, items).
+					Return(model.Reservation{}, errors.New("something went wrong")).
+					Once()
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			tt.setupMock()
+
+			res, err := s.api.ReserveParts(s.ctx, tt.req)
+
+			s.Require().Nil(res)
+			s.Require().Error(err)
+			s.Require().Contains(err.Error(), tt.expectedErrMsg)
+		})
+	}
+}
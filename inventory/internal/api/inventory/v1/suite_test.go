@@ -4,24 +4,36 @@ import (
 	"context"
 	"testing"
 
+	"github.com/google/uuid"
 	"github.com/stretchr/testify/suite"
 
+	"github.com/crafty-ezhik/rocket-factory/inventory/internal/model"
 	"github.com/crafty-ezhik/rocket-factory/inventory/internal/service/mocks"
+	grpcMiddleware "github.com/crafty-ezhik/rocket-factory/platform/pkg/middleware/grpc"
+	commonV1 "github.com/crafty-ezhik/rocket-factory/shared/pkg/proto/common/v1"
 )
 
 type ApiSuite struct {
 	suite.Suite
-	ctx              context.Context //nolint:containedctx
-	inventoryService *mocks.MockInventoryService
+	// ctx - контекст с покупателем, которого кладет AuthInterceptor
+	ctx                context.Context //nolint:containedctx
+	caller             model.ReservationCaller
+	inventoryService   *mocks.MockInventoryService
+	reservationService *mocks.MockReservationService
 
 	api *api
 }
 
 func (s *ApiSuite) SetupTest() {
-	s.ctx = context.Background()
+	s.caller = model.ReservationCaller{UserUUID: uuid.New()}
+	s.ctx = context.WithValue(context.Background(), grpcMiddleware.GetUserContextKey(), &commonV1.User{
+		Uuid:        s.caller.UserUUID.String(),
+		Permissions: []string{"reservations:write"},
+	})
 	s.inventoryService = mocks.NewMockInventoryService(s.T())
+	s.reservationService = mocks.NewMockReservationService(s.T())
 
-	s.api = NewAPI(s.inventoryService)
+	s.api = NewAPI(s.inventoryService, s.reservationService)
 }
 
 func (s *ApiSuite) TearDownTest() {}
@@ -54,6 +54,11 @@ func (a *App) Run(ctx context.Context) error {
 		errCh <- a.runGRPCServer(ctx)
 	}()
 
+	// Запускаем отмену истекших резервов
+	go func() {
+		errCh <- a.runReservationSweeper(ctx)
+	}()
+
 	select {
 	case err := <-errCh:
 		return err
@@ -117,7 +122,7 @@ func (a *App) initListener(_ context.Context) error {
 }
 
 func (a *App) initGRPCServer(ctx context.Context) error {
-	authInterceptor := grpcMidlleware.NewAuthInterceptor(a.diContainer.IAMClient(ctx))
+	authInterceptor := grpcMidlleware.NewAuthInterceptor(a.diContainer.IAMClient(ctx), a.diContainer.TokenVerifier(ctx), accessPolicy)
 
 	a.grpcServer = grpc.NewServer(
 		grpc.Creds(insecure.NewCredentials()),
@@ -210,6 +215,17 @@ func (a *App) runGRPCServer(ctx context.Context) error {
 	return nil
 }
 
+func (a *App) runReservationSweeper(ctx context.Context) error {
+	logger.Info(ctx, "🚀 Reservation sweeper запущен")
+
+	err := a.diContainer.ReservationService(ctx).RunSweeper(ctx)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func (a *App) runHTTPGateway(ctx context.Context) error {
 	logger.Info(ctx, fmt.Sprintf("🌐 HTTP server with gRPC-Gateway and Swagger UI listening on %s\n", config.AppConfig().InventoryHTTP.Address()))
 	err := a.httpServer.ListenAndServe()
@@ -16,22 +16,28 @@ import (
 	"github.com/crafty-ezhik/rocket-factory/inventory/internal/config"
 	"github.com/crafty-ezhik/rocket-factory/inventory/internal/repository"
 	inventoryRepository "github.com/crafty-ezhik/rocket-factory/inventory/internal/repository/part"
+	reservationRepository "github.com/crafty-ezhik/rocket-factory/inventory/internal/repository/reservation"
 	"github.com/crafty-ezhik/rocket-factory/inventory/internal/service"
 	inventoryService "github.com/crafty-ezhik/rocket-factory/inventory/internal/service/part"
+	reservationService "github.com/crafty-ezhik/rocket-factory/inventory/internal/service/reservation"
 	"github.com/crafty-ezhik/rocket-factory/platform/pkg/closer"
 	"github.com/crafty-ezhik/rocket-factory/platform/pkg/logger"
 	middlewareGRPC "github.com/crafty-ezhik/rocket-factory/platform/pkg/middleware/grpc"
+	"github.com/crafty-ezhik/rocket-factory/platform/pkg/token"
 	auth_v1 "github.com/crafty-ezhik/rocket-factory/shared/pkg/proto/auth/v1"
 	inventoryV1 "github.com/crafty-ezhik/rocket-factory/shared/pkg/proto/inventory/v1"
 )
 
 type diContainer struct {
-	inventoryV1API      inventoryV1.InventoryServiceServer
-	inventoryService    service.InventoryService
-	inventoryRepository repository.InventoryRepository
-	mongoDBClient       *mongo.Client
-	mongoDBHandle       *mongo.Database
-	iamClient           middlewareGRPC.IAMClient
+	inventoryV1API        inventoryV1.InventoryServiceServer
+	inventoryService      service.InventoryService
+	reservationService    service.ReservationService
+	inventoryRepository   repository.InventoryRepository
+	reservationRepository repository.ReservationRepository
+	mongoDBClient         *mongo.Client
+	mongoDBHandle         *mongo.Database
+	iamClient             middlewareGRPC.IAMClient
+	tokenVerifier         *token.Verifier
 }
 
 // NewDIContainer - возвращает пустой diContainer
@@ -42,7 +48,7 @@ func NewDIContainer() *diContainer {
 // InventoryV1API - создает экземпляр api хендлеров
 func (d *diContainer) InventoryV1API(ctx context.Context) inventoryV1.InventoryServiceServer {
 	if d.inventoryV1API == nil {
-		d.inventoryV1API = inventoryV1API.NewAPI(d.PartService(ctx))
+		d.inventoryV1API = inventoryV1API.NewAPI(d.PartService(ctx), d.ReservationService(ctx))
 	}
 	return d.inventoryV1API
 }
@@ -55,6 +61,19 @@ func (d *diContainer) PartService(ctx context.Context) service.InventoryService
 	return d.inventoryService
 }
 
+// ReservationService - создает экземпляр сервиса резервирования деталей
+func (d *diContainer) ReservationService(ctx context.Context) service.ReservationService {
+	if d.reservationService == nil {
+		d.reservationService = reservationService.NewService(
+			d.ReservationRepository(ctx),
+			config.AppConfig().Reservation.TTL(),
+			config.AppConfig().Reservation.SweepInterval(),
+			config.AppConfig().Reservation.SweepBatchSize(),
+		)
+	}
+	return d.reservationService
+}
+
 // PartRepository - создает экземпляр репозитория
 func (d *diContainer) PartRepository(ctx context.Context) repository.InventoryRepository {
 	if d.inventoryRepository == nil {
@@ -66,6 +85,14 @@ func (d *diContainer) PartRepository(ctx context.Context) repository.InventoryRe
 	return d.inventoryRepository
 }
 
+// ReservationRepository - создает экземпляр репозитория резервов
+func (d *diContainer) ReservationRepository(ctx context.Context) repository.ReservationRepository {
+	if d.reservationRepository == nil {
+		d.reservationRepository = reservationRepository.NewRepository(ctx, d.MongoDBHandle(ctx))
+	}
+	return d.reservationRepository
+}
+
 // MongoDBClient - создает клиента MongoDB и добавляет функцию закрытия в closer
 func (d *diContainer) MongoDBClient(ctx context.Context) *mongo.Client {
 	if d.mongoDBClient == nil {
@@ -106,6 +133,14 @@ func (d *diContainer) IAMClient(ctx context.Context) middlewareGRPC.IAMClient {
 	return d.iamClient
 }
 
+// TokenVerifier - проверяет токены доступа IAM по его публичным ключам
+func (d *diContainer) TokenVerifier(ctx context.Context) *token.Verifier {
+	if d.tokenVerifier == nil {
+		d.tokenVerifier = middlewareGRPC.NewTokenVerifier(d.IAMClient(ctx), config.AppConfig().IamGRPC.TokenIssuer())
+	}
+	return d.tokenVerifier
+}
+
 func (d *diContainer) IAMConn(_ context.Context) *grpc.ClientConn {
 	conn, err := grpc.NewClient(
 		config.AppConfig().IamGRPC.Address(),
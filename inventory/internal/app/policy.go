@@ -0,0 +1,24 @@
+package app
+
+import (
+	grpcMidlleware "github.com/crafty-ezhik/rocket-factory/platform/pkg/middleware/grpc"
+	inventoryV1 "github.com/crafty-ezhik/rocket-factory/shared/pkg/proto/inventory/v1"
+)
+
+// Разрешения IAM, которые проверяются для методов inventory
+const (
+	permissionPartsRead         = "parts:read"
+	permissionReservationsWrite = "reservations:write"
+)
+
+// accessPolicy - разрешения, необходимые для вызова методов InventoryService. Методы без правила запрещены.
+// Резервы создает order от имени покупателя, поэтому разрешение на них есть и у покупателя,
+// но получить, снять или подтвердить резерв можно только свой (чужие - с разрешением reservations:manage)
+var accessPolicy = grpcMidlleware.Policy{
+	inventoryV1.InventoryService_GetPart_FullMethodName:            permissionPartsRead,
+	inventoryV1.InventoryService_ListParts_FullMethodName:          permissionPartsRead,
+	inventoryV1.InventoryService_ReserveParts_FullMethodName:       permissionReservationsWrite,
+	inventoryV1.InventoryService_ReleaseReservation_FullMethodName: permissionReservationsWrite,
+	inventoryV1.InventoryService_CommitReservation_FullMethodName:  permissionReservationsWrite,
+	inventoryV1.InventoryService_GetReservation_FullMethodName:     permissionReservationsWrite,
+}
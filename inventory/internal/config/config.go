@@ -16,6 +16,7 @@ type config struct {
 	InventoryHTTP InventoryHTTPConfig
 	Mongo         MongoConfig
 	IamGRPC       IAMConfig
+	Reservation   ReservationConfig
 }
 
 func Load(path ...string) error {
@@ -48,12 +49,19 @@ func Load(path ...string) error {
 	if err != nil {
 		return err
 	}
+
+	reservationCfg, err := env.NewReservationConfig()
+	if err != nil {
+		return err
+	}
+
 	appConfig = &config{
 		Logger:        loggerCfg,
 		InventoryGRPC: inventoryGRPCCfg,
 		InventoryHTTP: inventoryHTTPCfg,
 		Mongo:         mongoCfg,
 		IamGRPC:       iamGRPCCfg,
+		Reservation:   reservationCfg,
 	}
 	return nil
 }
@@ -9,6 +9,8 @@ import (
 type iamGRPCEnvConfig struct {
 	Host string `env:"IAM_GRPC_HOST,required"`
 	Port string `env:"IAM_GRPC_PORT,required"`
+	// TokenIssuer - издатель, которого ожидаем в токенах доступа
+	TokenIssuer string `env:"IAM_TOKEN_ISSUER,required"`
 }
 
 type iamGRPCConfig struct {
@@ -26,3 +28,7 @@ func NewIAMGRPCConfig() (*iamGRPCConfig, error) {
 func (cfg *iamGRPCConfig) Address() string {
 	return net.JoinHostPort(cfg.raw.Host, cfg.raw.Port)
 }
+
+func (cfg *iamGRPCConfig) TokenIssuer() string {
+	return cfg.raw.TokenIssuer
+}
@@ -1,5 +1,7 @@
 package config
 
+import "time"
+
 type LoggerConfig interface {
 	Level() string
 	AsJSON() bool
@@ -20,4 +22,11 @@ type MongoConfig interface {
 
 type IAMConfig interface {
 	Address() string
+	TokenIssuer() string
+}
+
+type ReservationConfig interface {
+	TTL() time.Duration
+	SweepInterval() time.Duration
+	SweepBatchSize() int64
 }
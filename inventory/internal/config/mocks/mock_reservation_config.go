@@ -0,0 +1,173 @@
+// Code generated for crafty-ezhik service
+// © Crafty-ezhik 2025.
+
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"time"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockReservationConfig creates a new instance of MockReservationConfig. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockReservationConfig(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockReservationConfig {
+	mock := &MockReservationConfig{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockReservationConfig is an autogenerated mock type for the ReservationConfig type
+type MockReservationConfig struct {
+	mock.Mock
+}
+
+type MockReservationConfig_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockReservationConfig) EXPECT() *MockReservationConfig_Expecter {
+	return &MockReservationConfig_Expecter{mock: &_m.Mock}
+}
+
+// SweepBatchSize provides a mock function for the type MockReservationConfig
+func (_mock *MockReservationConfig) SweepBatchSize() int64 {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for SweepBatchSize")
+	}
+
+	var r0 int64
+	if returnFunc, ok := ret.Get(0).(func() int64); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+	return r0
+}
+
+// MockReservationConfig_SweepBatchSize_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SweepBatchSize'
+type MockReservationConfig_SweepBatchSize_Call struct {
+	*mock.Call
+}
+
+// SweepBatchSize is a helper method to define mock.On call
+func (_e *MockReservationConfig_Expecter) SweepBatchSize() *MockReservationConfig_SweepBatchSize_Call {
+	return &MockReservationConfig_SweepBatchSize_Call{Call: _e.mock.On("SweepBatchSize")}
+}
+
+func (_c *MockReservationConfig_SweepBatchSize_Call) Run(run func()) *MockReservationConfig_SweepBatchSize_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockReservationConfig_SweepBatchSize_Call) Return(n int64) *MockReservationConfig_SweepBatchSize_Call {
+	_c.Call.Return(n)
+	return _c
+}
+
+func (_c *MockReservationConfig_SweepBatchSize_Call) RunAndReturn(run func() int64) *MockReservationConfig_SweepBatchSize_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SweepInterval provides a mock function for the type MockReservationConfig
+func (_mock *MockReservationConfig) SweepInterval() time.Duration {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for SweepInterval")
+	}
+
+	var r0 time.Duration
+	if returnFunc, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+	return r0
+}
+
+// MockReservationConfig_SweepInterval_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SweepInterval'
+type MockReservationConfig_SweepInterval_Call struct {
+	*mock.Call
+}
+
+// SweepInterval is a helper method to define mock.On call
+func (_e *MockReservationConfig_Expecter) SweepInterval() *MockReservationConfig_SweepInterval_Call {
+	return &MockReservationConfig_SweepInterval_Call{Call: _e.mock.On("SweepInterval")}
+}
+
+func (_c *MockReservationConfig_SweepInterval_Call) Run(run func()) *MockReservationConfig_SweepInterval_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockReservationConfig_SweepInterval_Call) Return(duration time.Duration) *MockReservationConfig_SweepInterval_Call {
+	_c.Call.Return(duration)
+	return _c
+}
+
+func (_c *MockReservationConfig_SweepInterval_Call) RunAndReturn(run func() time.Duration) *MockReservationConfig_SweepInterval_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// TTL provides a mock function for the type MockReservationConfig
+func (_mock *MockReservationConfig) TTL() time.Duration {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for TTL")
+	}
+
+	var r0 time.Duration
+	if returnFunc, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+	return r0
+}
+
+// MockReservationConfig_TTL_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TTL'
+type MockReservationConfig_TTL_Call struct {
+	*mock.Call
+}
+
+// TTL is a helper method to define mock.On call
+func (_e *MockReservationConfig_Expecter) TTL() *MockReservationConfig_TTL_Call {
+	return &MockReservationConfig_TTL_Call{Call: _e.mock.On("TTL")}
+}
+
+func (_c *MockReservationConfig_TTL_Call) Run(run func()) *MockReservationConfig_TTL_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockReservationConfig_TTL_Call) Return(duration time.Duration) *MockReservationConfig_TTL_Call {
+	_c.Call.Return(duration)
+	return _c
+}
+
+func (_c *MockReservationConfig_TTL_Call) RunAndReturn(run func() time.Duration) *MockReservationConfig_TTL_Call {
+	_c.Call.Return(run)
+	return _c
+}
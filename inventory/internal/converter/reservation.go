@@ -0,0 +1,33 @@
+package converter
+
+import (
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	serviceModel "github.com/crafty-ezhik/rocket-factory/inventory/internal/model"
+	inventoryV1 "github.com/crafty-ezhik/rocket-factory/shared/pkg/proto/inventory/v1"
+)
+
+// ReservationItemsToServiceModel - конвертация []*inventoryV1.ReservationItem в []serviceModel.ReservationItem
+func ReservationItemsToServiceModel(items []*inventoryV1.ReservationItem) ([]serviceModel.ReservationItem, error) {
+	result := make([]serviceModel.ReservationItem, len(items))
+	for i, item := range items {
+		partUUID, err := uuid.Parse(item.GetPartUuid())
+		if err != nil {
+			return nil, serviceModel.ErrInvalidUUID
+		}
+		result[i] = serviceModel.ReservationItem{
+			PartUUID: partUUID,
+			Quantity: item.GetQuantity(),
+		}
+	}
+	return result, nil
+}
+
+// ReservationToProto - конвертация serviceModel.Reservation в *inventoryV1.GetReservationResponse
+func ReservationToProto(reservation serviceModel.Reservation) *inventoryV1.GetReservationResponse {
+	return &inventoryV1.GetReservationResponse{
+		Status:    inventoryV1.ReservationStatus(inventoryV1.ReservationStatus_value[string(reservation.Status)]),
+		ExpiresAt: timestamppb.New(reservation.ExpiresAt),
+	}
+}
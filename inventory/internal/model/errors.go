@@ -2,6 +2,8 @@ package model
 
 import (
 	"errors"
+	"fmt"
+	"strings"
 
 	sharedErr "github.com/crafty-ezhik/rocket-factory/platform/pkg/grpc/errors"
 )
@@ -9,4 +11,26 @@ import (
 var (
 	ErrPartNotFound = sharedErr.NewBusinessError(sharedErr.NotFoundErrCode, errors.New("part not found"))
 	ErrInvalidUUID  = sharedErr.NewBusinessError(sharedErr.BadRequestErrCode, errors.New("invalid UUID"))
+
+	ErrPartsOutOfStock          = errors.New("parts out of stock")
+	ErrEmptyReservation         = sharedErr.NewBusinessError(sharedErr.BadRequestErrCode, errors.New("reservation must contain at least one part"))
+	ErrInvalidReservationAmount = sharedErr.NewBusinessError(sharedErr.BadRequestErrCode, errors.New("reservation quantity must be positive"))
+	ErrReservationNotFound      = sharedErr.NewBusinessError(sharedErr.NotFoundErrCode, errors.New("reservation not found"))
+	ErrReservationCommitted     = sharedErr.NewBusinessError(sharedErr.FailedPreconditionErrCode, errors.New("reservation is already committed"))
+	ErrReservationReleased      = sharedErr.NewBusinessError(sharedErr.FailedPreconditionErrCode, errors.New("reservation is already released"))
+	ErrReservationAccessDenied  = sharedErr.NewBusinessError(sharedErr.ForbiddenErrCode, errors.New("reservation belongs to another user"))
+	ErrUnauthenticated          = sharedErr.NewBusinessError(sharedErr.UnauthorizedErrCode, errors.New("unauthenticated"))
 )
+
+// NewPartsOutOfStockError - возвращает ошибку со списком деталей, которых не хватает на складе
+func NewPartsOutOfStockError(shortages []StockShortage) error {
+	parts := make([]string, len(shortages))
+	for i, shortage := range shortages {
+		parts[i] = fmt.Sprintf("%s (requested %d, available %d)", shortage.PartUUID, shortage.Requested, shortage.Available)
+	}
+
+	return sharedErr.NewBusinessError(
+		sharedErr.FailedPreconditionErrCode,
+		fmt.Errorf("%w: %s", ErrPartsOutOfStock, strings.Join(parts, ", ")),
+	)
+}
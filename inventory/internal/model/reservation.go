@@ -0,0 +1,53 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type ReservationStatus string
+
+const (
+	ReservationStatusActive    ReservationStatus = "ACTIVE"
+	ReservationStatusCommitted ReservationStatus = "COMMITTED"
+	ReservationStatusReleased  ReservationStatus = "RELEASED"
+)
+
+type Reservation struct {
+	OrderUUID uuid.UUID
+	// UserUUID - владелец резерва, пользователь, от имени которого order создал заказ
+	UserUUID  uuid.UUID
+	Items     []ReservationItem
+	Status    ReservationStatus
+	ExpiresAt time.Time
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+type ReservationItem struct {
+	PartUUID uuid.UUID
+	Quantity int64
+}
+
+// PermissionReservationsManage - разрешение IAM на работу с резервами любых пользователей
+const PermissionReservationsManage = "reservations:manage"
+
+// ReservationCaller - пользователь, от имени которого вызван метод резервов.
+// Резерв доступен своему владельцу, а с ManageAll - любой резерв
+type ReservationCaller struct {
+	UserUUID  uuid.UUID
+	ManageAll bool
+}
+
+// CanAccess - может ли пользователь снимать и подтверждать резерв
+func (c ReservationCaller) CanAccess(reservation Reservation) bool {
+	return c.ManageAll || reservation.UserUUID == c.UserUUID
+}
+
+// StockShortage - деталь, которой не хватает на складе для резервирования
+type StockShortage struct {
+	PartUUID  uuid.UUID
+	Requested int64
+	Available int64
+}
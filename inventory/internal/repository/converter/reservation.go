@@ -0,0 +1,48 @@
+package converter
+
+import (
+	serviceModel "github.com/crafty-ezhik/rocket-factory/inventory/internal/model"
+	repoModel "github.com/crafty-ezhik/rocket-factory/inventory/internal/repository/model"
+)
+
+// ReservationToServiceModel - преобразует модель резерва репозитория в сервисную модель
+func ReservationToServiceModel(reservation repoModel.Reservation) serviceModel.Reservation {
+	items := make([]serviceModel.ReservationItem, len(reservation.Items))
+	for i, item := range reservation.Items {
+		items[i] = serviceModel.ReservationItem{
+			PartUUID: item.PartUUID,
+			Quantity: item.Quantity,
+		}
+	}
+
+	return serviceModel.Reservation{
+		OrderUUID: reservation.OrderUUID,
+		UserUUID:  reservation.UserUUID,
+		Items:     items,
+		Status:    serviceModel.ReservationStatus(reservation.Status),
+		ExpiresAt: reservation.ExpiresAt,
+		CreatedAt: reservation.CreatedAt,
+		UpdatedAt: reservation.UpdatedAt,
+	}
+}
+
+// ReservationToRepoModel - преобразует сервисную модель резерва в модель репозитория
+func ReservationToRepoModel(reservation serviceModel.Reservation) repoModel.Reservation {
+	items := make([]repoModel.ReservationItem, len(reservation.Items))
+	for i, item := range reservation.Items {
+		items[i] = repoModel.ReservationItem{
+			PartUUID: item.PartUUID,
+			Quantity: item.Quantity,
+		}
+	}
+
+	return repoModel.Reservation{
+		OrderUUID: reservation.OrderUUID,
+		UserUUID:  reservation.UserUUID,
+		Items:     items,
+		Status:    string(reservation.Status),
+		ExpiresAt: reservation.ExpiresAt,
+		CreatedAt: reservation.CreatedAt,
+		UpdatedAt: reservation.UpdatedAt,
+	}
+}
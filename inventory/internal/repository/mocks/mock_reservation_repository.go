@@ -0,0 +1,364 @@
+// Code generated for crafty-ezhik service
+// © Crafty-ezhik 2025.
+
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"context"
+	"time"
+
+	"github.com/crafty-ezhik/rocket-factory/inventory/internal/model"
+	"github.com/google/uuid"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockReservationRepository creates a new instance of MockReservationRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockReservationRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockReservationRepository {
+	mock := &MockReservationRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockReservationRepository is an autogenerated mock type for the ReservationRepository type
+type MockReservationRepository struct {
+	mock.Mock
+}
+
+type MockReservationRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockReservationRepository) EXPECT() *MockReservationRepository_Expecter {
+	return &MockReservationRepository_Expecter{mock: &_m.Mock}
+}
+
+// Commit provides a mock function for the type MockReservationRepository
+func (_mock *MockReservationRepository) Commit(ctx context.Context, orderUUID uuid.UUID) error {
+	ret := _mock.Called(ctx, orderUUID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Commit")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = returnFunc(ctx, orderUUID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockReservationRepository_Commit_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Commit'
+type MockReservationRepository_Commit_Call struct {
+	*mock.Call
+}
+
+// Commit is a helper method to define mock.On call
+//   - ctx context.Context
+//   - orderUUID uuid.UUID
+func (_e *MockReservationRepository_Expecter) Commit(ctx interface{}, orderUUID interface{}) *MockReservationRepository_Commit_Call {
+	return &MockReservationRepository_Commit_Call{Call: _e.mock.On("Commit", ctx, orderUUID)}
+}
+
+func (_c *MockReservationRepository_Commit_Call) Run(run func(ctx context.Context, orderUUID uuid.UUID)) *MockReservationRepository_Commit_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockReservationRepository_Commit_Call) Return(err error) *MockReservationRepository_Commit_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockReservationRepository_Commit_Call) RunAndReturn(run func(ctx context.Context, orderUUID uuid.UUID) error) *MockReservationRepository_Commit_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Get provides a mock function for the type MockReservationRepository
+func (_mock *MockReservationRepository) Get(ctx context.Context, orderUUID uuid.UUID) (model.Reservation, error) {
+	ret := _mock.Called(ctx, orderUUID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 model.Reservation
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (model.Reservation, error)); ok {
+		return returnFunc(ctx, orderUUID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) model.Reservation); ok {
+		r0 = returnFunc(ctx, orderUUID)
+	} else {
+		r0 = ret.Get(0).(model.Reservation)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, orderUUID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockReservationRepository_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
+type MockReservationRepository_Get_Call struct {
+	*mock.Call
+}
+
+// Get is a helper method to define mock.On call
+//   - ctx context.Context
+//   - orderUUID uuid.UUID
+func (_e *MockReservationRepository_Expecter) Get(ctx interface{}, orderUUID interface{}) *MockReservationRepository_Get_Call {
+	return &MockReservationRepository_Get_Call{Call: _e.mock.On("Get", ctx, orderUUID)}
+}
+
+func (_c *MockReservationRepository_Get_Call) Run(run func(ctx context.Context, orderUUID uuid.UUID)) *MockReservationRepository_Get_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockReservationRepository_Get_Call) Return(reservation model.Reservation, err error) *MockReservationRepository_Get_Call {
+	_c.Call.Return(reservation, err)
+	return _c
+}
+
+func (_c *MockReservationRepository_Get_Call) RunAndReturn(run func(ctx context.Context, orderUUID uuid.UUID) (model.Reservation, error)) *MockReservationRepository_Get_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListExpired provides a mock function for the type MockReservationRepository
+func (_mock *MockReservationRepository) ListExpired(ctx context.Context, now time.Time, limit int64) ([]uuid.UUID, error) {
+	ret := _mock.Called(ctx, now, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListExpired")
+	}
+
+	var r0 []uuid.UUID
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, time.Time, int64) ([]uuid.UUID, error)); ok {
+		return returnFunc(ctx, now, limit)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, time.Time, int64) []uuid.UUID); ok {
+		r0 = returnFunc(ctx, now, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]uuid.UUID)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, time.Time, int64) error); ok {
+		r1 = returnFunc(ctx, now, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockReservationRepository_ListExpired_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListExpired'
+type MockReservationRepository_ListExpired_Call struct {
+	*mock.Call
+}
+
+// ListExpired is a helper method to define mock.On call
+//   - ctx context.Context
+//   - now time.Time
+//   - limit int64
+func (_e *MockReservationRepository_Expecter) ListExpired(ctx interface{}, now interface{}, limit interface{}) *MockReservationRepository_ListExpired_Call {
+	return &MockReservationRepository_ListExpired_Call{Call: _e.mock.On("ListExpired", ctx, now, limit)}
+}
+
+func (_c *MockReservationRepository_ListExpired_Call) Run(run func(ctx context.Context, now time.Time, limit int64)) *MockReservationRepository_ListExpired_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 time.Time
+		if args[1] != nil {
+			arg1 = args[1].(time.Time)
+		}
+		var arg2 int64
+		if args[2] != nil {
+			arg2 = args[2].(int64)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockReservationRepository_ListExpired_Call) Return(uUIDs []uuid.UUID, err error) *MockReservationRepository_ListExpired_Call {
+	_c.Call.Return(uUIDs, err)
+	return _c
+}
+
+func (_c *MockReservationRepository_ListExpired_Call) RunAndReturn(run func(ctx context.Context, now time.Time, limit int64) ([]uuid.UUID, error)) *MockReservationRepository_ListExpired_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Release provides a mock function for the type MockReservationRepository
+func (_mock *MockReservationRepository) Release(ctx context.Context, orderUUID uuid.UUID) error {
+	ret := _mock.Called(ctx, orderUUID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Release")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = returnFunc(ctx, orderUUID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockReservationRepository_Release_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Release'
+type MockReservationRepository_Release_Call struct {
+	*mock.Call
+}
+
+// Release is a helper method to define mock.On call
+//   - ctx context.Context
+//   - orderUUID uuid.UUID
+func (_e *MockReservationRepository_Expecter) Release(ctx interface{}, orderUUID interface{}) *MockReservationRepository_Release_Call {
+	return &MockReservationRepository_Release_Call{Call: _e.mock.On("Release", ctx, orderUUID)}
+}
+
+func (_c *MockReservationRepository_Release_Call) Run(run func(ctx context.Context, orderUUID uuid.UUID)) *MockReservationRepository_Release_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockReservationRepository_Release_Call) Return(err error) *MockReservationRepository_Release_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockReservationRepository_Release_Call) RunAndReturn(run func(ctx context.Context, orderUUID uuid.UUID) error) *MockReservationRepository_Release_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Reserve provides a mock function for the type MockReservationRepository
+func (_mock *MockReservationRepository) Reserve(ctx context.Context, reservation model.Reservation) (model.Reservation, error) {
+	ret := _mock.Called(ctx, reservation)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Reserve")
+	}
+
+	var r0 model.Reservation
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, model.Reservation) (model.Reservation, error)); ok {
+		return returnFunc(ctx, reservation)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, model.Reservation) model.Reservation); ok {
+		r0 = returnFunc(ctx, reservation)
+	} else {
+		r0 = ret.Get(0).(model.Reservation)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, model.Reservation) error); ok {
+		r1 = returnFunc(ctx, reservation)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockReservationRepository_Reserve_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Reserve'
+type MockReservationRepository_Reserve_Call struct {
+	*mock.Call
+}
+
+// Reserve is a helper method to define mock.On call
+//   - ctx context.Context
+//   - reservation model.Reservation
+func (_e *MockReservationRepository_Expecter) Reserve(ctx interface{}, reservation interface{}) *MockReservationRepository_Reserve_Call {
+	return &MockReservationRepository_Reserve_Call{Call: _e.mock.On("Reserve", ctx, reservation)}
+}
+
+func (_c *MockReservationRepository_Reserve_Call) Run(run func(ctx context.Context, reservation model.Reservation)) *MockReservationRepository_Reserve_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 model.Reservation
+		if args[1] != nil {
+			arg1 = args[1].(model.Reservation)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockReservationRepository_Reserve_Call) Return(reservation1 model.Reservation, err error) *MockReservationRepository_Reserve_Call {
+	_c.Call.Return(reservation1, err)
+	return _c
+}
+
+func (_c *MockReservationRepository_Reserve_Call) RunAndReturn(run func(ctx context.Context, reservation model.Reservation) (model.Reservation, error)) *MockReservationRepository_Reserve_Call {
+	_c.Call.Return(run)
+	return _c
+}
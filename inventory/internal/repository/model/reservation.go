@@ -0,0 +1,24 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type Reservation struct {
+	ID        primitive.ObjectID `bson:"_id,omitempty"`
+	OrderUUID uuid.UUID          `bson:"order_uuid"`
+	UserUUID  uuid.UUID          `bson:"user_uuid"`
+	Items     []ReservationItem  `bson:"items"`
+	Status    string             `bson:"status"`
+	ExpiresAt time.Time          `bson:"expires_at"`
+	CreatedAt time.Time          `bson:"created_at"`
+	UpdatedAt time.Time          `bson:"updated_at"`
+}
+
+type ReservationItem struct {
+	PartUUID uuid.UUID `bson:"part_uuid"`
+	Quantity int64     `bson:"quantity"`
+}
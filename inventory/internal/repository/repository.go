@@ -2,6 +2,7 @@ package repository
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 
@@ -13,3 +14,11 @@ type InventoryRepository interface {
 	List(ctx context.Context, filters serviceModel.PartsFilter) ([]serviceModel.Part, error)
 	Init()
 }
+
+type ReservationRepository interface {
+	Get(ctx context.Context, orderUUID uuid.UUID) (serviceModel.Reservation, error)
+	Reserve(ctx context.Context, reservation serviceModel.Reservation) (serviceModel.Reservation, error)
+	Release(ctx context.Context, orderUUID uuid.UUID) error
+	Commit(ctx context.Context, orderUUID uuid.UUID) error
+	ListExpired(ctx context.Context, now time.Time, limit int64) ([]uuid.UUID, error)
+}
@@ -0,0 +1,45 @@
+package reservation
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.uber.org/zap"
+
+	serviceModel "github.com/crafty-ezhik/rocket-factory/inventory/internal/model"
+	"github.com/crafty-ezhik/rocket-factory/platform/pkg/logger"
+)
+
+// Commit - подтверждает активный резерв, после чего детали окончательно списаны со склада.
+// Повторное подтверждение не является ошибкой
+func (r *repository) Commit(ctx context.Context, orderUUID uuid.UUID) error {
+	res, err := r.reservations.UpdateOne(ctx,
+		bson.M{
+			reservationFieldOrderUUID: orderUUID,
+			reservationFieldStatus:    string(serviceModel.ReservationStatusActive),
+		},
+		bson.M{"$set": bson.M{
+			reservationFieldStatus:    string(serviceModel.ReservationStatusCommitted),
+			reservationFieldUpdatedAt: time.Now(),
+		}},
+	)
+	if err != nil {
+		logger.Error(ctx, "Ошибка подтверждения резерва", zap.Error(err))
+		return fmt.Errorf("error committing reservation: %w", err)
+	}
+	if res.MatchedCount == 1 {
+		return nil
+	}
+
+	existing, err := r.Get(ctx, orderUUID)
+	if err != nil {
+		return err
+	}
+	if existing.Status == serviceModel.ReservationStatusReleased {
+		return serviceModel.ErrReservationReleased
+	}
+	return nil
+}
@@ -0,0 +1,53 @@
+package reservation
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.uber.org/zap"
+
+	serviceModel "github.com/crafty-ezhik/rocket-factory/inventory/internal/model"
+	repoModel "github.com/crafty-ezhik/rocket-factory/inventory/internal/repository/model"
+	"github.com/crafty-ezhik/rocket-factory/platform/pkg/logger"
+)
+
+// ListExpired - возвращает UUID заказов, активные резервы которых истекли к моменту now
+func (r *repository) ListExpired(ctx context.Context, now time.Time, limit int64) ([]uuid.UUID, error) {
+	cursor, err := r.reservations.Find(ctx,
+		bson.M{
+			reservationFieldStatus:    string(serviceModel.ReservationStatusActive),
+			reservationFieldExpiresAt: bson.M{"$lte": now},
+		},
+		options.Find().
+			SetLimit(limit).
+			SetSort(bson.M{reservationFieldExpiresAt: 1}).
+			SetProjection(bson.M{reservationFieldOrderUUID: 1}),
+	)
+	if err != nil {
+		logger.Error(ctx, "Ошибка при поиске истекших резервов", zap.Error(err))
+		return nil, fmt.Errorf("error finding expired reservations: %w", err)
+	}
+	defer func() {
+		cerr := cursor.Close(ctx)
+		if cerr != nil {
+			logger.Error(ctx, "error closing cursor", zap.Error(cerr))
+		}
+	}()
+
+	var reservations []repoModel.Reservation
+	err = cursor.All(ctx, &reservations)
+	if err != nil {
+		logger.Error(ctx, "Ошибка получения истекших резервов", zap.Error(err))
+		return nil, fmt.Errorf("error getting expired reservations: %w", err)
+	}
+
+	orderUUIDs := make([]uuid.UUID, len(reservations))
+	for i, reservation := range reservations {
+		orderUUIDs[i] = reservation.OrderUUID
+	}
+	return orderUUIDs, nil
+}
@@ -0,0 +1,51 @@
+package reservation
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.uber.org/zap"
+
+	serviceModel "github.com/crafty-ezhik/rocket-factory/inventory/internal/model"
+	"github.com/crafty-ezhik/rocket-factory/inventory/internal/repository/converter"
+	repoModel "github.com/crafty-ezhik/rocket-factory/inventory/internal/repository/model"
+	"github.com/crafty-ezhik/rocket-factory/platform/pkg/logger"
+)
+
+// Release - отменяет активный резерв и возвращает детали на склад. Повторная отмена не является ошибкой
+func (r *repository) Release(ctx context.Context, orderUUID uuid.UUID) error {
+	var reservation repoModel.Reservation
+	err := r.reservations.FindOneAndUpdate(ctx,
+		bson.M{
+			reservationFieldOrderUUID: orderUUID,
+			reservationFieldStatus:    string(serviceModel.ReservationStatusActive),
+		},
+		bson.M{"$set": bson.M{
+			reservationFieldStatus:    string(serviceModel.ReservationStatusReleased),
+			reservationFieldUpdatedAt: time.Now(),
+		}},
+	).Decode(&reservation)
+	if err != nil {
+		if !errors.Is(err, mongo.ErrNoDocuments) {
+			logger.Error(ctx, "Ошибка отмены резерва", zap.Error(err))
+			return fmt.Errorf("error releasing reservation: %w", err)
+		}
+
+		existing, err := r.Get(ctx, orderUUID)
+		if err != nil {
+			return err
+		}
+		if existing.Status == serviceModel.ReservationStatusCommitted {
+			return serviceModel.ErrReservationCommitted
+		}
+		return nil
+	}
+
+	r.restoreStock(ctx, converter.ReservationToServiceModel(reservation).Items)
+	return nil
+}
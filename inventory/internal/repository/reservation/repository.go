@@ -0,0 +1,60 @@
+package reservation
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.uber.org/zap"
+
+	serviceModel "github.com/crafty-ezhik/rocket-factory/inventory/internal/model"
+	def "github.com/crafty-ezhik/rocket-factory/inventory/internal/repository"
+	"github.com/crafty-ezhik/rocket-factory/inventory/internal/repository/converter"
+	repoModel "github.com/crafty-ezhik/rocket-factory/inventory/internal/repository/model"
+	"github.com/crafty-ezhik/rocket-factory/platform/pkg/logger"
+)
+
+var _ def.ReservationRepository = (*repository)(nil)
+
+const (
+	partsCollection        = "parts"
+	reservationsCollection = "reservations"
+
+	partFieldPartUUID      = "part_uuid"
+	partFieldStockQuantity = "stock_quantity"
+	partFieldUpdatedAt     = "updated_at"
+
+	reservationFieldOrderUUID = "order_uuid"
+	reservationFieldStatus    = "status"
+	reservationFieldExpiresAt = "expires_at"
+	reservationFieldUpdatedAt = "updated_at"
+)
+
+type repository struct {
+	parts        *mongo.Collection
+	reservations *mongo.Collection
+}
+
+func NewRepository(_ context.Context, db *mongo.Database) *repository {
+	return &repository{
+		parts:        db.Collection(partsCollection),
+		reservations: db.Collection(reservationsCollection),
+	}
+}
+
+// Get - возвращает резерв по UUID заказа
+func (r *repository) Get(ctx context.Context, orderUUID uuid.UUID) (serviceModel.Reservation, error) {
+	var reservation repoModel.Reservation
+	err := r.reservations.FindOne(ctx, bson.M{reservationFieldOrderUUID: orderUUID}).Decode(&reservation)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return serviceModel.Reservation{}, serviceModel.ErrReservationNotFound
+		}
+		logger.Error(ctx, "Ошибка получения резерва", zap.Error(err))
+		return serviceModel.Reservation{}, fmt.Errorf("error receiving reservation: %w", err)
+	}
+	return converter.ReservationToServiceModel(reservation), nil
+}
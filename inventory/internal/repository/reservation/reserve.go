@@ -0,0 +1,142 @@
+package reservation
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.uber.org/zap"
+
+	serviceModel "github.com/crafty-ezhik/rocket-factory/inventory/internal/model"
+	"github.com/crafty-ezhik/rocket-factory/inventory/internal/repository/converter"
+	repoModel "github.com/crafty-ezhik/rocket-factory/inventory/internal/repository/model"
+	"github.com/crafty-ezhik/rocket-factory/platform/pkg/logger"
+)
+
+// Reserve - списывает детали со склада и создает резерв для заказа.
+//
+//	Остаток каждой детали уменьшается атомарно, только если его хватает.
+//	Если хотя бы одной детали не хватает, уже списанные детали возвращаются на склад.
+//	Повторный вызов для того же заказа возвращает существующий резерв.
+func (r *repository) Reserve(ctx context.Context, reservation serviceModel.Reservation) (serviceModel.Reservation, error) {
+	existing, err := r.Get(ctx, reservation.OrderUUID)
+	switch {
+	case err == nil:
+		return checkExisting(existing)
+	case !errors.Is(err, serviceModel.ErrReservationNotFound):
+		return serviceModel.Reservation{}, err
+	}
+
+	reserved := make([]serviceModel.ReservationItem, 0, len(reservation.Items))
+	var shortages []serviceModel.StockShortage
+
+	for _, item := range reservation.Items {
+		ok, err := r.decrementStock(ctx, item)
+		if err != nil {
+			r.restoreStock(ctx, reserved)
+			return serviceModel.Reservation{}, err
+		}
+		if ok {
+			reserved = append(reserved, item)
+			continue
+		}
+
+		available, err := r.availableStock(ctx, item)
+		if err != nil {
+			r.restoreStock(ctx, reserved)
+			return serviceModel.Reservation{}, err
+		}
+		shortages = append(shortages, serviceModel.StockShortage{
+			PartUUID:  item.PartUUID,
+			Requested: item.Quantity,
+			Available: available,
+		})
+	}
+
+	if len(shortages) > 0 {
+		r.restoreStock(ctx, reserved)
+		return serviceModel.Reservation{}, serviceModel.NewPartsOutOfStockError(shortages)
+	}
+
+	_, err = r.reservations.InsertOne(ctx, converter.ReservationToRepoModel(reservation))
+	if err != nil {
+		r.restoreStock(ctx, reserved)
+
+		// Резерв для этого заказа успел создать конкурентный запрос
+		if mongo.IsDuplicateKeyError(err) {
+			existing, err = r.Get(ctx, reservation.OrderUUID)
+			if err != nil {
+				return serviceModel.Reservation{}, err
+			}
+			return checkExisting(existing)
+		}
+
+		logger.Error(ctx, "Ошибка создания резерва", zap.Error(err))
+		return serviceModel.Reservation{}, fmt.Errorf("error creating reservation: %w", err)
+	}
+
+	return reservation, nil
+}
+
+func checkExisting(reservation serviceModel.Reservation) (serviceModel.Reservation, error) {
+	if reservation.Status == serviceModel.ReservationStatusReleased {
+		return serviceModel.Reservation{}, serviceModel.ErrReservationReleased
+	}
+	return reservation, nil
+}
+
+// decrementStock - уменьшает остаток детали, если его достаточно. Возвращает false, если остатка не хватает
+func (r *repository) decrementStock(ctx context.Context, item serviceModel.ReservationItem) (bool, error) {
+	res, err := r.parts.UpdateOne(ctx,
+		bson.M{
+			partFieldPartUUID:      item.PartUUID,
+			partFieldStockQuantity: bson.M{"$gte": item.Quantity},
+		},
+		bson.M{
+			"$inc": bson.M{partFieldStockQuantity: -item.Quantity},
+			"$set": bson.M{partFieldUpdatedAt: time.Now()},
+		},
+	)
+	if err != nil {
+		logger.Error(ctx, "Ошибка списания детали со склада", zap.Error(err))
+		return false, fmt.Errorf("error decrementing stock: %w", err)
+	}
+	return res.ModifiedCount == 1, nil
+}
+
+// availableStock - возвращает текущий остаток детали. Для несуществующей детали остаток равен 0
+func (r *repository) availableStock(ctx context.Context, item serviceModel.ReservationItem) (int64, error) {
+	var part repoModel.Part
+	err := r.parts.FindOne(ctx, bson.M{partFieldPartUUID: item.PartUUID}).Decode(&part)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return 0, nil
+		}
+		logger.Error(ctx, "Ошибка получения остатка детали", zap.Error(err))
+		return 0, fmt.Errorf("error receiving stock quantity: %w", err)
+	}
+	return part.StockQuantity, nil
+}
+
+// restoreStock - возвращает детали на склад
+func (r *repository) restoreStock(ctx context.Context, items []serviceModel.ReservationItem) {
+	for _, item := range items {
+		_, err := r.parts.UpdateOne(ctx,
+			bson.M{partFieldPartUUID: item.PartUUID},
+			bson.M{
+				"$inc": bson.M{partFieldStockQuantity: item.Quantity},
+				"$set": bson.M{partFieldUpdatedAt: time.Now()},
+			},
+		)
+		if err != nil {
+			logger.Error(ctx, "Ошибка возврата детали на склад",
+				zap.String("part_uuid", item.PartUUID.String()),
+				zap.Int64("quantity", item.Quantity),
+				zap.Error(err),
+			)
+		}
+	}
+}
@@ -0,0 +1,370 @@
+// Code generated for crafty-ezhik service
+// © Crafty-ezhik 2025.
+
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/crafty-ezhik/rocket-factory/inventory/internal/model"
+	"github.com/google/uuid"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockReservationService creates a new instance of MockReservationService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockReservationService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockReservationService {
+	mock := &MockReservationService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockReservationService is an autogenerated mock type for the ReservationService type
+type MockReservationService struct {
+	mock.Mock
+}
+
+type MockReservationService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockReservationService) EXPECT() *MockReservationService_Expecter {
+	return &MockReservationService_Expecter{mock: &_m.Mock}
+}
+
+// Commit provides a mock function for the type MockReservationService
+func (_mock *MockReservationService) Commit(ctx context.Context, caller model.ReservationCaller, orderUUID uuid.UUID) error {
+	ret := _mock.Called(ctx, caller, orderUUID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Commit")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, model.ReservationCaller, uuid.UUID) error); ok {
+		r0 = returnFunc(ctx, caller, orderUUID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockReservationService_Commit_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Commit'
+type MockReservationService_Commit_Call struct {
+	*mock.Call
+}
+
+// Commit is a helper method to define mock.On call
+//   - ctx context.Context
+//   - caller model.ReservationCaller
+//   - orderUUID uuid.UUID
+func (_e *MockReservationService_Expecter) Commit(ctx interface{}, caller interface{}, orderUUID interface{}) *MockReservationService_Commit_Call {
+	return &MockReservationService_Commit_Call{Call: _e.mock.On("Commit", ctx, caller, orderUUID)}
+}
+
+func (_c *MockReservationService_Commit_Call) Run(run func(ctx context.Context, caller model.ReservationCaller, orderUUID uuid.UUID)) *MockReservationService_Commit_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 model.ReservationCaller
+		if args[1] != nil {
+			arg1 = args[1].(model.ReservationCaller)
+		}
+		var arg2 uuid.UUID
+		if args[2] != nil {
+			arg2 = args[2].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockReservationService_Commit_Call) Return(err error) *MockReservationService_Commit_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockReservationService_Commit_Call) RunAndReturn(run func(ctx context.Context, caller model.ReservationCaller, orderUUID uuid.UUID) error) *MockReservationService_Commit_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Get provides a mock function for the type MockReservationService
+func (_mock *MockReservationService) Get(ctx context.Context, caller model.ReservationCaller, orderUUID uuid.UUID) (model.Reservation, error) {
+	ret := _mock.Called(ctx, caller, orderUUID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 model.Reservation
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, model.ReservationCaller, uuid.UUID) (model.Reservation, error)); ok {
+		return returnFunc(ctx, caller, orderUUID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, model.ReservationCaller, uuid.UUID) model.Reservation); ok {
+		r0 = returnFunc(ctx, caller, orderUUID)
+	} else {
+		r0 = ret.Get(0).(model.Reservation)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, model.ReservationCaller, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, caller, orderUUID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockReservationService_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
+type MockReservationService_Get_Call struct {
+	*mock.Call
+}
+
+// Get is a helper method to define mock.On call
+//   - ctx context.Context
+//   - caller model.ReservationCaller
+//   - orderUUID uuid.UUID
+func (_e *MockReservationService_Expecter) Get(ctx interface{}, caller interface{}, orderUUID interface{}) *MockReservationService_Get_Call {
+	return &MockReservationService_Get_Call{Call: _e.mock.On("Get", ctx, caller, orderUUID)}
+}
+
+func (_c *MockReservationService_Get_Call) Run(run func(ctx context.Context, caller model.ReservationCaller, orderUUID uuid.UUID)) *MockReservationService_Get_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 model.ReservationCaller
+		if args[1] != nil {
+			arg1 = args[1].(model.ReservationCaller)
+		}
+		var arg2 uuid.UUID
+		if args[2] != nil {
+			arg2 = args[2].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockReservationService_Get_Call) Return(reservation model.Reservation, err error) *MockReservationService_Get_Call {
+	_c.Call.Return(reservation, err)
+	return _c
+}
+
+func (_c *MockReservationService_Get_Call) RunAndReturn(run func(ctx context.Context, caller model.ReservationCaller, orderUUID uuid.UUID) (model.Reservation, error)) *MockReservationService_Get_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Release provides a mock function for the type MockReservationService
+func (_mock *MockReservationService) Release(ctx context.Context, caller model.ReservationCaller, orderUUID uuid.UUID) error {
+	ret := _mock.Called(ctx, caller, orderUUID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Release")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, model.ReservationCaller, uuid.UUID) error); ok {
+		r0 = returnFunc(ctx, caller, orderUUID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockReservationService_Release_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Release'
+type MockReservationService_Release_Call struct {
+	*mock.Call
+}
+
+// Release is a helper method to define mock.On call
+//   - ctx context.Context
+//   - caller model.ReservationCaller
+//   - orderUUID uuid.UUID
+func (_e *MockReservationService_Expecter) Release(ctx interface{}, caller interface{}, orderUUID interface{}) *MockReservationService_Release_Call {
+	return &MockReservationService_Release_Call{Call: _e.mock.On("Release", ctx, caller, orderUUID)}
+}
+
+func (_c *MockReservationService_Release_Call) Run(run func(ctx context.Context, caller model.ReservationCaller, orderUUID uuid.UUID)) *MockReservationService_Release_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 model.ReservationCaller
+		if args[1] != nil {
+			arg1 = args[1].(model.ReservationCaller)
+		}
+		var arg2 uuid.UUID
+		if args[2] != nil {
+			arg2 = args[2].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockReservationService_Release_Call) Return(err error) *MockReservationService_Release_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockReservationService_Release_Call) RunAndReturn(run func(ctx context.Context, caller model.ReservationCaller, orderUUID uuid.UUID) error) *MockReservationService_Release_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Reserve provides a mock function for the type MockReservationService
+func (_mock *MockReservationService) Reserve(ctx context.Context, caller model.ReservationCaller, orderUUID uuid.UUID, items []model.ReservationItem) (model.Reservation, error) {
+	ret := _mock.Called(ctx, caller, orderUUID, items)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Reserve")
+	}
+
+	var r0 model.Reservation
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, model.ReservationCaller, uuid.UUID, []model.ReservationItem) (model.Reservation, error)); ok {
+		return returnFunc(ctx, caller, orderUUID, items)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, model.ReservationCaller, uuid.UUID, []model.ReservationItem) model.Reservation); ok {
+		r0 = returnFunc(ctx, caller, orderUUID, items)
+	} else {
+		r0 = ret.Get(0).(model.Reservation)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, model.ReservationCaller, uuid.UUID, []model.ReservationItem) error); ok {
+		r1 = returnFunc(ctx, caller, orderUUID, items)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockReservationService_Reserve_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Reserve'
+type MockReservationService_Reserve_Call struct {
+	*mock.Call
+}
+
+// Reserve is a helper method to define mock.On call
+//   - ctx context.Context
+//   - caller model.ReservationCaller
+//   - orderUUID uuid.UUID
+//   - items []model.ReservationItem
+func (_e *MockReservationService_Expecter) Reserve(ctx interface{}, caller interface{}, orderUUID interface{}, items interface{}) *MockReservationService_Reserve_Call {
+	return &MockReservationService_Reserve_Call{Call: _e.mock.On("Reserve", ctx, caller, orderUUID, items)}
+}
+
+func (_c *MockReservationService_Reserve_Call) Run(run func(ctx context.Context, caller model.ReservationCaller, orderUUID uuid.UUID, items []model.ReservationItem)) *MockReservationService_Reserve_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 model.ReservationCaller
+		if args[1] != nil {
+			arg1 = args[1].(model.ReservationCaller)
+		}
+		var arg2 uuid.UUID
+		if args[2] != nil {
+			arg2 = args[2].(uuid.UUID)
+		}
+		var arg3 []model.ReservationItem
+		if args[3] != nil {
+			arg3 = args[3].([]model.ReservationItem)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockReservationService_Reserve_Call) Return(reservation model.Reservation, err error) *MockReservationService_Reserve_Call {
+	_c.Call.Return(reservation, err)
+	return _c
+}
+
+func (_c *MockReservationService_Reserve_Call) RunAndReturn(run func(ctx context.Context, caller model.ReservationCaller, orderUUID uuid.UUID, items []model.ReservationItem) (model.Reservation, error)) *MockReservationService_Reserve_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RunSweeper provides a mock function for the type MockReservationService
+func (_mock *MockReservationService) RunSweeper(ctx context.Context) error {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RunSweeper")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockReservationService_RunSweeper_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RunSweeper'
+type MockReservationService_RunSweeper_Call struct {
+	*mock.Call
+}
+
+// RunSweeper is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockReservationService_Expecter) RunSweeper(ctx interface{}) *MockReservationService_RunSweeper_Call {
+	return &MockReservationService_RunSweeper_Call{Call: _e.mock.On("RunSweeper", ctx)}
+}
+
+func (_c *MockReservationService_RunSweeper_Call) Run(run func(ctx context.Context)) *MockReservationService_RunSweeper_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockReservationService_RunSweeper_Call) Return(err error) *MockReservationService_RunSweeper_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockReservationService_RunSweeper_Call) RunAndReturn(run func(ctx context.Context) error) *MockReservationService_RunSweeper_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -0,0 +1,17 @@
+package reservation
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/crafty-ezhik/rocket-factory/inventory/internal/model"
+)
+
+// Commit - подтверждает резерв заказа
+func (s *service) Commit(ctx context.Context, caller model.ReservationCaller, orderUUID uuid.UUID) error {
+	if err := s.checkAccess(ctx, caller, orderUUID); err != nil {
+		return err
+	}
+	return s.reservationRepo.Commit(ctx, orderUUID)
+}
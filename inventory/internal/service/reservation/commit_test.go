@@ -0,0 +1,74 @@
+package reservation
+
+import (
+	"errors"
+
+	"github.com/google/uuid"
+
+	"github.com/crafty-ezhik/rocket-factory/inventory/internal/model"
+)
+
+func (s *ServiceSuite) TestCommit() {
+	orderUUID := uuid.New()
+	dbErr := errors.New("db error")
+
+	tests := []struct {
+		name        string
+		repoErr     error
+		expectedErr error
+	}{
+		{name: "success"},
+		{name: "not found", repoErr: model.ErrReservationNotFound, expectedErr: model.ErrReservationNotFound},
+		{name: "released", repoErr: model.ErrReservationReleased, expectedErr: model.ErrReservationReleased},
+		{name: "db error", repoErr: dbErr, expectedErr: dbErr},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			s.reservationRepo.On("Get", s.ctx, orderUUID).
+				Return(model.Reservation{OrderUUID: orderUUID, UserUUID: s.owner.UserUUID}, nil).
+				Once()
+			s.reservationRepo.On("Commit", s.ctx, orderUUID).
+				Return(tt.repoErr).
+				Once()
+
+			err := s.service.Commit(s.ctx, s.owner, orderUUID)
+
+			if tt.expectedErr == nil {
+				s.Require().NoError(err)
+				return
+			}
+			s.Require().ErrorIs(err, tt.expectedErr)
+		})
+	}
+}
+
+func (s *ServiceSuite) TestCommitAccess() {
+	orderUUID := uuid.New()
+	otherUser := model.Reservation{OrderUUID: orderUUID, UserUUID: uuid.New()}
+
+	s.Run("reservation of another user", func() {
+		s.reservationRepo.On("Get", s.ctx, orderUUID).Return(otherUser, nil).Once()
+
+		err := s.service.Commit(s.ctx, s.owner, orderUUID)
+
+		s.Require().ErrorIs(err, model.ErrReservationAccessDenied)
+		s.reservationRepo.AssertNotCalled(s.T(), "Commit", s.ctx, orderUUID)
+	})
+
+	s.Run("reservation not found", func() {
+		s.reservationRepo.On("Get", s.ctx, orderUUID).Return(model.Reservation{}, model.ErrReservationNotFound).Once()
+
+		err := s.service.Commit(s.ctx, s.owner, orderUUID)
+
+		s.Require().ErrorIs(err, model.ErrReservationNotFound)
+	})
+
+	s.Run("manager skips ownership check", func() {
+		s.reservationRepo.On("Commit", s.ctx, orderUUID).Return(nil).Once()
+
+		err := s.service.Commit(s.ctx, model.ReservationCaller{UserUUID: uuid.New(), ManageAll: true}, orderUUID)
+
+		s.Require().NoError(err)
+	})
+}
@@ -0,0 +1,22 @@
+package reservation
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/crafty-ezhik/rocket-factory/inventory/internal/model"
+)
+
+// Get - возвращает резерв заказа
+func (s *service) Get(ctx context.Context, caller model.ReservationCaller, orderUUID uuid.UUID) (model.Reservation, error) {
+	reservation, err := s.reservationRepo.Get(ctx, orderUUID)
+	if err != nil {
+		return model.Reservation{}, err
+	}
+
+	if !caller.CanAccess(reservation) {
+		return model.Reservation{}, model.ErrReservationAccessDenied
+	}
+	return reservation, nil
+}
@@ -0,0 +1,63 @@
+package reservation
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/crafty-ezhik/rocket-factory/inventory/internal/model"
+)
+
+func (s *ServiceSuite) TestGet() {
+	orderUUID := uuid.New()
+	reservation := model.Reservation{
+		OrderUUID: orderUUID,
+		UserUUID:  s.owner.UserUUID,
+		Status:    model.ReservationStatusActive,
+		ExpiresAt: time.Now().Add(time.Minute),
+	}
+	dbErr := errors.New("db error")
+
+	s.Run("owner", func() {
+		s.reservationRepo.On("Get", s.ctx, orderUUID).Return(reservation, nil).Once()
+
+		res, err := s.service.Get(s.ctx, s.owner, orderUUID)
+
+		s.Require().NoError(err)
+		s.Require().Equal(reservation, res)
+	})
+
+	s.Run("reservation of another user", func() {
+		s.reservationRepo.On("Get", s.ctx, orderUUID).Return(reservation, nil).Once()
+
+		_, err := s.service.Get(s.ctx, model.ReservationCaller{UserUUID: uuid.New()}, orderUUID)
+
+		s.Require().ErrorIs(err, model.ErrReservationAccessDenied)
+	})
+
+	s.Run("manager", func() {
+		s.reservationRepo.On("Get", s.ctx, orderUUID).Return(reservation, nil).Once()
+
+		res, err := s.service.Get(s.ctx, model.ReservationCaller{UserUUID: uuid.New(), ManageAll: true}, orderUUID)
+
+		s.Require().NoError(err)
+		s.Require().Equal(reservation, res)
+	})
+
+	s.Run("not found", func() {
+		s.reservationRepo.On("Get", s.ctx, orderUUID).Return(model.Reservation{}, model.ErrReservationNotFound).Once()
+
+		_, err := s.service.Get(s.ctx, s.owner, orderUUID)
+
+		s.Require().ErrorIs(err, model.ErrReservationNotFound)
+	})
+
+	s.Run("db error", func() {
+		s.reservationRepo.On("Get", s.ctx, orderUUID).Return(model.Reservation{}, dbErr).Once()
+
+		_, err := s.service.Get(s.ctx, s.owner, orderUUID)
+
+		s.Require().ErrorIs(err, dbErr)
+	})
+}
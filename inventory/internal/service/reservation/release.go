@@ -0,0 +1,33 @@
+package reservation
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/crafty-ezhik/rocket-factory/inventory/internal/model"
+)
+
+// Release - отменяет резерв заказа и возвращает детали на склад
+func (s *service) Release(ctx context.Context, caller model.ReservationCaller, orderUUID uuid.UUID) error {
+	if err := s.checkAccess(ctx, caller, orderUUID); err != nil {
+		return err
+	}
+	return s.reservationRepo.Release(ctx, orderUUID)
+}
+
+// checkAccess - возвращает ErrReservationAccessDenied, если резерв заказа принадлежит другому пользователю
+func (s *service) checkAccess(ctx context.Context, caller model.ReservationCaller, orderUUID uuid.UUID) error {
+	if caller.ManageAll {
+		return nil
+	}
+
+	reservation, err := s.reservationRepo.Get(ctx, orderUUID)
+	if err != nil {
+		return err
+	}
+	if !caller.CanAccess(reservation) {
+		return model.ErrReservationAccessDenied
+	}
+	return nil
+}
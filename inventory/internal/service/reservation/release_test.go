@@ -0,0 +1,108 @@
+package reservation
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/crafty-ezhik/rocket-factory/inventory/internal/model"
+)
+
+func (s *ServiceSuite) TestRelease() {
+	orderUUID := uuid.New()
+	dbErr := errors.New("db error")
+
+	tests := []struct {
+		name        string
+		repoErr     error
+		expectedErr error
+	}{
+		{name: "success"},
+		{name: "not found", repoErr: model.ErrReservationNotFound, expectedErr: model.ErrReservationNotFound},
+		{name: "committed", repoErr: model.ErrReservationCommitted, expectedErr: model.ErrReservationCommitted},
+		{name: "db error", repoErr: dbErr, expectedErr: dbErr},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			s.reservationRepo.On("Get", s.ctx, orderUUID).
+				Return(model.Reservation{OrderUUID: orderUUID, UserUUID: s.owner.UserUUID}, nil).
+				Once()
+			s.reservationRepo.On("Release", s.ctx, orderUUID).
+				Return(tt.repoErr).
+				Once()
+
+			err := s.service.Release(s.ctx, s.owner, orderUUID)
+
+			if tt.expectedErr == nil {
+				s.Require().NoError(err)
+				return
+			}
+			s.Require().ErrorIs(err, tt.expectedErr)
+		})
+	}
+}
+
+func (s *ServiceSuite) TestReleaseExpired() {
+	expired := []uuid.UUID{uuid.New(), uuid.New()}
+
+	s.reservationRepo.On("ListExpired", s.ctx, mock.AnythingOfType("time.Time"), int64(100)).
+		Return(expired, nil).
+		Once()
+	s.reservationRepo.On("Release", s.ctx, expired[0]).
+		Return(errors.New("db error")).
+		Once()
+	s.reservationRepo.On("Release", s.ctx, expired[1]).
+		Return(nil).
+		Once()
+
+	err := s.service.releaseExpired(s.ctx)
+
+	s.Require().NoError(err)
+}
+
+func (s *ServiceSuite) TestReleaseExpiredListFailure() {
+	dbErr := errors.New("db error")
+
+	s.reservationRepo.On("ListExpired", s.ctx, mock.MatchedBy(func(now time.Time) bool {
+		return !now.After(time.Now())
+	}), int64(100)).
+		Return(nil, dbErr).
+		Once()
+
+	err := s.service.releaseExpired(s.ctx)
+
+	s.Require().ErrorIs(err, dbErr)
+}
+
+func (s *ServiceSuite) TestReleaseAccess() {
+	orderUUID := uuid.New()
+	otherUser := model.Reservation{OrderUUID: orderUUID, UserUUID: uuid.New()}
+
+	s.Run("reservation of another user", func() {
+		s.reservationRepo.On("Get", s.ctx, orderUUID).Return(otherUser, nil).Once()
+
+		err := s.service.Release(s.ctx, s.owner, orderUUID)
+
+		s.Require().ErrorIs(err, model.ErrReservationAccessDenied)
+		s.reservationRepo.AssertNotCalled(s.T(), "Release", s.ctx, orderUUID)
+	})
+
+	s.Run("reservation not found", func() {
+		s.reservationRepo.On("Get", s.ctx, orderUUID).Return(model.Reservation{}, model.ErrReservationNotFound).Once()
+
+		err := s.service.Release(s.ctx, s.owner, orderUUID)
+
+		s.Require().ErrorIs(err, model.ErrReservationNotFound)
+	})
+
+	s.Run("manager skips ownership check", func() {
+		s.reservationRepo.On("Release", s.ctx, orderUUID).Return(nil).Once()
+
+		err := s.service.Release(s.ctx, model.ReservationCaller{UserUUID: uuid.New(), ManageAll: true}, orderUUID)
+
+		s.Require().NoError(err)
+	})
+}
@@ -0,0 +1,60 @@
+package reservation
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/crafty-ezhik/rocket-factory/inventory/internal/model"
+)
+
+// Reserve - резервирует детали для заказа на время ttl. Владельцем резерва становится caller.
+// Повторный вызов для заказа с резервом другого пользователя возвращает ErrReservationAccessDenied
+func (s *service) Reserve(ctx context.Context, caller model.ReservationCaller, orderUUID uuid.UUID, items []model.ReservationItem) (model.Reservation, error) {
+	merged, err := mergeItems(items)
+	if err != nil {
+		return model.Reservation{}, err
+	}
+
+	now := time.Now()
+	reservation, err := s.reservationRepo.Reserve(ctx, model.Reservation{
+		OrderUUID: orderUUID,
+		UserUUID:  caller.UserUUID,
+		Items:     merged,
+		Status:    model.ReservationStatusActive,
+		ExpiresAt: now.Add(s.ttl),
+		CreatedAt: now,
+		UpdatedAt: now,
+	})
+	if err != nil {
+		return model.Reservation{}, err
+	}
+
+	if !caller.CanAccess(reservation) {
+		return model.Reservation{}, model.ErrReservationAccessDenied
+	}
+	return reservation, nil
+}
+
+// mergeItems - проверяет позиции резерва и объединяет повторяющиеся детали, сохраняя порядок
+func mergeItems(items []model.ReservationItem) ([]model.ReservationItem, error) {
+	if len(items) == 0 {
+		return nil, model.ErrEmptyReservation
+	}
+
+	merged := make([]model.ReservationItem, 0, len(items))
+	index := make(map[uuid.UUID]int, len(items))
+	for _, item := range items {
+		if item.Quantity <= 0 {
+			return nil, model.ErrInvalidReservationAmount
+		}
+		if i, ok := index[item.PartUUID]; ok {
+			merged[i].Quantity += item.Quantity
+			continue
+		}
+		index[item.PartUUID] = len(merged)
+		merged = append(merged, item)
+	}
+	return merged, nil
+}
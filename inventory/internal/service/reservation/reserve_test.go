@@ -0,0 +1,146 @@
+package reservation
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/crafty-ezhik/rocket-factory/inventory/internal/model"
+)
+
+func (s *ServiceSuite) TestReserveSuccess() {
+	orderUUID := uuid.New()
+	firstPart := uuid.New()
+	secondPart := uuid.New()
+
+	items := []model.ReservationItem{
+		{PartUUID: firstPart, Quantity: 1},
+		{PartUUID: secondPart, Quantity: 2},
+		{PartUUID: firstPart, Quantity: 3},
+	}
+
+	s.reservationRepo.On("Reserve", s.ctx, mock.MatchedBy(func(r model.Reservation) bool {
+		return r.OrderUUID == orderUUID &&
+			r.UserUUID == s.owner.UserUUID &&
+			r.Status == model.ReservationStatusActive &&
+			len(r.Items) == 2 &&
+			r.Items[0] == model.ReservationItem{PartUUID: firstPart, Quantity: 4} &&
+			r.Items[1] == model.ReservationItem{PartUUID: secondPart, Quantity: 2} &&
+			r.ExpiresAt.Sub(r.CreatedAt) == 15*time.Minute
+	})).
+		Return(model.Reservation{
+			OrderUUID: orderUUID,
+			UserUUID:  s.owner.UserUUID,
+			Items: []model.ReservationItem{
+				{PartUUID: firstPart, Quantity: 4},
+				{PartUUID: secondPart, Quantity: 2},
+			},
+			Status: model.ReservationStatusActive,
+		}, nil).
+		Once()
+
+	res, err := s.service.Reserve(s.ctx, s.owner, orderUUID, items)
+
+	s.Require().NoError(err)
+	s.Equal(orderUUID, res.OrderUUID)
+	s.Len(res.Items, 2)
+}
+
+func (s *ServiceSuite) TestReserveFailure() {
+	orderUUID := uuid.New()
+	partUUID := uuid.New()
+	outOfStockErr := model.NewPartsOutOfStockError([]model.StockShortage{
+		{PartUUID: partUUID, Requested: 5, Available: 1},
+	})
+	dbErr := errors.New("db error")
+
+	tests := []struct {
+		name        string
+		items       []model.ReservationItem
+		repoErr     error
+		expectedErr error
+		setupMock   bool
+	}{
+		{
+			name:        "empty items",
+			items:       nil,
+			expectedErr: model.ErrEmptyReservation,
+		},
+		{
+			name:        "non positive quantity",
+			items:       []model.ReservationItem{{PartUUID: partUUID, Quantity: 0}},
+			expectedErr: model.ErrInvalidReservationAmount,
+		},
+		{
+			name:        "out of stock",
+			items:       []model.ReservationItem{{PartUUID: partUUID, Quantity: 5}},
+			repoErr:     outOfStockErr,
+			expectedErr: model.ErrPartsOutOfStock,
+			setupMock:   true,
+		},
+		{
+			name:        "already released",
+			items:       []model.ReservationItem{{PartUUID: partUUID, Quantity: 5}},
+			repoErr:     model.ErrReservationReleased,
+			expectedErr: model.ErrReservationReleased,
+			setupMock:   true,
+		},
+		{
+			name:        "db error",
+			items:       []model.ReservationItem{{PartUUID: partUUID, Quantity: 5}},
+			repoErr:     dbErr,
+			expectedErr: dbErr,
+			setupMock:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			if tt.setupMock {
+				s.reservationRepo.On("Reserve", s.ctx, mock.AnythingOfType("model.Reservation")).
+					Return(model.Reservation{}, tt.repoErr).
+					Once()
+			}
+
+			_, err := s.service.Reserve(s.ctx, s.owner, orderUUID, tt.items)
+
+			s.Require().Error(err)
+			s.Require().ErrorIs(err, tt.expectedErr)
+		})
+	}
+}
+
+func (s *ServiceSuite) TestReserveOrderOfAnotherUser() {
+	orderUUID := uuid.New()
+	items := []model.ReservationItem{{PartUUID: uuid.New(), Quantity: 1}}
+
+	// Резерв заказа уже создан другим пользователем, повторный вызов возвращает существующий резерв
+	s.reservationRepo.On("Reserve", s.ctx, mock.AnythingOfType("model.Reservation")).
+		Return(model.Reservation{
+			OrderUUID: orderUUID,
+			UserUUID:  uuid.New(),
+			Items:     items,
+			Status:    model.ReservationStatusActive,
+		}, nil).
+		Once()
+
+	_, err := s.service.Reserve(s.ctx, s.owner, orderUUID, items)
+
+	s.Require().ErrorIs(err, model.ErrReservationAccessDenied)
+}
+
+func (s *ServiceSuite) TestOutOfStockErrorListsParts() {
+	firstPart := uuid.New()
+	secondPart := uuid.New()
+
+	err := model.NewPartsOutOfStockError([]model.StockShortage{
+		{PartUUID: firstPart, Requested: 3, Available: 1},
+		{PartUUID: secondPart, Requested: 2, Available: 0},
+	})
+
+	s.Require().ErrorIs(err, model.ErrPartsOutOfStock)
+	s.Contains(err.Error(), firstPart.String()+" (requested 3, available 1)")
+	s.Contains(err.Error(), secondPart.String()+" (requested 2, available 0)")
+}
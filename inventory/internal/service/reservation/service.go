@@ -0,0 +1,32 @@
+package reservation
+
+import (
+	"time"
+
+	"github.com/crafty-ezhik/rocket-factory/inventory/internal/repository"
+	def "github.com/crafty-ezhik/rocket-factory/inventory/internal/service"
+)
+
+var _ def.ReservationService = (*service)(nil)
+
+type service struct {
+	reservationRepo repository.ReservationRepository
+
+	ttl            time.Duration
+	sweepInterval  time.Duration
+	sweepBatchSize int64
+}
+
+func NewService(
+	reservationRepo repository.ReservationRepository,
+	ttl time.Duration,
+	sweepInterval time.Duration,
+	sweepBatchSize int64,
+) *service {
+	return &service{
+		reservationRepo: reservationRepo,
+		ttl:             ttl,
+		sweepInterval:   sweepInterval,
+		sweepBatchSize:  sweepBatchSize,
+	}
+}
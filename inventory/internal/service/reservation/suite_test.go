@@ -0,0 +1,43 @@
+package reservation
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/crafty-ezhik/rocket-factory/inventory/internal/model"
+	"github.com/crafty-ezhik/rocket-factory/inventory/internal/repository/mocks"
+	"github.com/crafty-ezhik/rocket-factory/platform/pkg/logger"
+)
+
+type ServiceSuite struct {
+	suite.Suite
+
+	ctx context.Context //nolint:containedctx
+	// owner - покупатель, от имени которого order создает резервы
+	owner model.ReservationCaller
+
+	reservationRepo *mocks.MockReservationRepository
+
+	service *service
+}
+
+func (s *ServiceSuite) SetupSuite() {
+	logger.SetNopLogger()
+}
+
+func (s *ServiceSuite) SetupTest() {
+	s.ctx = context.Background()
+	s.owner = model.ReservationCaller{UserUUID: uuid.New()}
+	s.reservationRepo = mocks.NewMockReservationRepository(s.T())
+	s.service = NewService(s.reservationRepo, 15*time.Minute, time.Second, 100)
+}
+
+func (s *ServiceSuite) TearDownTest() {}
+
+func TestServiceIntegration(t *testing.T) {
+	suite.Run(t, new(ServiceSuite))
+}
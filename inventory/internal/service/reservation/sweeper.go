@@ -0,0 +1,51 @@
+package reservation
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/crafty-ezhik/rocket-factory/platform/pkg/logger"
+)
+
+// RunSweeper - периодически отменяет истекшие резервы, пока не будет отменен контекст
+func (s *service) RunSweeper(ctx context.Context) error {
+	logger.Info(ctx, "Starting reservation sweeper")
+
+	ticker := time.NewTicker(s.sweepInterval)
+	defer ticker.Stop()
+
+	for {
+		if err := s.releaseExpired(ctx); err != nil {
+			logger.Error(ctx, "Reservation sweeper iteration failed", zap.Error(err))
+		}
+
+		select {
+		case <-ctx.Done():
+			logger.Info(ctx, "Reservation sweeper stopped")
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// releaseExpired - отменяет одну пачку истекших резервов
+func (s *service) releaseExpired(ctx context.Context) error {
+	orderUUIDs, err := s.reservationRepo.ListExpired(ctx, time.Now(), s.sweepBatchSize)
+	if err != nil {
+		return err
+	}
+
+	for _, orderUUID := range orderUUIDs {
+		if err = s.reservationRepo.Release(ctx, orderUUID); err != nil {
+			logger.Error(ctx, "Failed to release expired reservation",
+				zap.String("order_uuid", orderUUID.String()),
+				zap.Error(err),
+			)
+			continue
+		}
+		logger.Info(ctx, "Expired reservation released", zap.String("order_uuid", orderUUID.String()))
+	}
+	return nil
+}
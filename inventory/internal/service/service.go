@@ -12,3 +12,11 @@ type InventoryService interface {
 	Get(ctx context.Context, partID uuid.UUID) (serviceModel.Part, error)
 	List(ctx context.Context, filters serviceModel.PartsFilter) ([]serviceModel.Part, error)
 }
+
+type ReservationService interface {
+	Reserve(ctx context.Context, caller serviceModel.ReservationCaller, orderUUID uuid.UUID, items []serviceModel.ReservationItem) (serviceModel.Reservation, error)
+	Release(ctx context.Context, caller serviceModel.ReservationCaller, orderUUID uuid.UUID) error
+	Commit(ctx context.Context, caller serviceModel.ReservationCaller, orderUUID uuid.UUID) error
+	Get(ctx context.Context, caller serviceModel.ReservationCaller, orderUUID uuid.UUID) (serviceModel.Reservation, error)
+	RunSweeper(ctx context.Context) error
+}
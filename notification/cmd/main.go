@@ -6,6 +6,8 @@ import (
 	"os/signal"
 	"syscall"
 	"time"
+	// База часовых поясов нужна для дат в уведомлениях и в образе без tzdata
+	_ "time/tzdata"
 
 	"go.uber.org/zap"
 
@@ -0,0 +1,60 @@
+package v1
+
+import (
+	"context"
+
+	"github.com/go-telegram/bot"
+	"github.com/go-telegram/bot/models"
+	"go.uber.org/zap"
+
+	"github.com/crafty-ezhik/rocket-factory/notification/internal/model"
+	"github.com/crafty-ezhik/rocket-factory/notification/internal/service"
+	"github.com/crafty-ezhik/rocket-factory/platform/pkg/logger"
+)
+
+// Команды бота
+const (
+	commandStart  = "start"
+	commandHelp   = "help"
+	commandLink   = "link"
+	commandOrders = "orders"
+	commandStatus = "status"
+)
+
+// api - команды Telegram-бота
+type api struct {
+	botService service.TelegramBotService
+}
+
+func NewAPI(botService service.TelegramBotService) *api {
+	return &api{
+		botService: botService,
+	}
+}
+
+// Register - регистрирует обработчики команд. Обновления начинают поступать после bot.Start
+func (a *api) Register(b *bot.Bot) {
+	b.RegisterHandler(bot.HandlerTypeMessageText, commandStart, bot.MatchTypeCommandStartOnly, a.handle(commandStart, a.botService.Help))
+	b.RegisterHandler(bot.HandlerTypeMessageText, commandHelp, bot.MatchTypeCommandStartOnly, a.handle(commandHelp, a.botService.Help))
+	b.RegisterHandler(bot.HandlerTypeMessageText, commandLink, bot.MatchTypeCommandStartOnly, a.handle(commandLink, a.botService.Link))
+	b.RegisterHandler(bot.HandlerTypeMessageText, commandOrders, bot.MatchTypeCommandStartOnly, a.handle(commandOrders, a.botService.Orders))
+	b.RegisterHandler(bot.HandlerTypeMessageText, commandStatus, bot.MatchTypeCommandStartOnly, a.handle(commandStatus, a.botService.Status))
+}
+
+// handle - обработчик команды. Текст сообщения не логируется: в /link он содержит UUID сессии
+func (a *api) handle(command string, run func(ctx context.Context, msg model.ChatMessage) error) bot.HandlerFunc {
+	return func(ctx context.Context, _ *bot.Bot, update *models.Update) {
+		if update.Message == nil {
+			return
+		}
+
+		msg := chatMessage(update.Message)
+		if err := run(ctx, msg); err != nil {
+			logger.Error(ctx, "Failed to handle bot command",
+				zap.String("command", command),
+				zap.Int64("chat_id", msg.ChatID),
+				zap.Error(err),
+			)
+		}
+	}
+}
@@ -0,0 +1,25 @@
+package v1
+
+import (
+	"strings"
+
+	"github.com/go-telegram/bot/models"
+
+	"github.com/crafty-ezhik/rocket-factory/notification/internal/model"
+)
+
+// chatMessage - команда из сообщения. Args - текст после команды, "/status <uuid>" -> "<uuid>"
+func chatMessage(message *models.Message) model.ChatMessage {
+	msg := model.ChatMessage{
+		ChatID:    message.Chat.ID,
+		MessageID: message.ID,
+		Private:   message.Chat.Type == models.ChatTypePrivate,
+	}
+	if message.From != nil {
+		msg.LanguageCode = message.From.LanguageCode
+	}
+	if _, args, ok := strings.Cut(message.Text, " "); ok {
+		msg.Args = strings.TrimSpace(args)
+	}
+	return msg
+}
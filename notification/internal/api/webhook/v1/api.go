@@ -0,0 +1,52 @@
+package v1
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+
+	"github.com/crafty-ezhik/rocket-factory/notification/internal/service"
+	HTTPMiddleware "github.com/crafty-ezhik/rocket-factory/platform/pkg/middleware/http"
+)
+
+// api - admin API журнала доставки webhook-уведомлений
+type api struct {
+	deliveryService service.WebhookDeliveryService
+}
+
+func NewAPI(deliveryService service.WebhookDeliveryService) *api {
+	return &api{
+		deliveryService: deliveryService,
+	}
+}
+
+// Routes - маршруты admin API, монтируются в /admin/v1/webhook-deliveries
+func (a *api) Routes() http.Handler {
+	r := chi.NewRouter()
+	r.Get("/", a.List)
+	r.Get("/{delivery_uuid}", a.Get)
+	return r
+}
+
+// SecretRoutes - маршруты выдачи секретов подписи, монтируются в /admin/v1/webhook-secrets
+func (a *api) SecretRoutes() http.Handler {
+	r := chi.NewRouter()
+	r.Get("/", a.Secret)
+	return r
+}
+
+func writeJSON(w http.ResponseWriter, status int, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(body) //nolint:errchkjson
+}
+
+func writeError(w http.ResponseWriter, status int, code, message string) {
+	writeJSON(w, status, HTTPMiddleware.ErrorResponse{
+		Error: HTTPMiddleware.ErrorDetail{
+			Code:    code,
+			Message: message,
+		},
+	})
+}
@@ -0,0 +1,35 @@
+package v1
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/crafty-ezhik/rocket-factory/notification/internal/model"
+	"github.com/crafty-ezhik/rocket-factory/platform/pkg/logger"
+)
+
+// Get - GET /{delivery_uuid}: запись журнала доставки
+func (a *api) Get(w http.ResponseWriter, r *http.Request) {
+	deliveryUUID, err := uuid.Parse(chi.URLParam(r, "delivery_uuid"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "INVALID_ARGUMENT", "delivery_uuid must be a valid UUID")
+		return
+	}
+
+	delivery, err := a.deliveryService.Get(r.Context(), deliveryUUID)
+	if err != nil {
+		if errors.Is(err, model.ErrWebhookDeliveryNotFound) {
+			writeError(w, http.StatusNotFound, "NOT_FOUND", "webhook delivery not found")
+			return
+		}
+		logger.Error(r.Context(), "Failed to get webhook delivery", zap.Error(err))
+		writeError(w, http.StatusInternalServerError, "INTERNAL", "internal error")
+		return
+	}
+
+	writeJSON(w, http.StatusOK, deliveryToResponse(delivery))
+}
@@ -0,0 +1,86 @@
+package v1
+
+import (
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/crafty-ezhik/rocket-factory/notification/internal/model"
+	"github.com/crafty-ezhik/rocket-factory/platform/pkg/logger"
+)
+
+const (
+	defaultListLimit = 50
+	maxListLimit     = 500
+)
+
+// List - GET /?user_uuid=&event_uuid=&status=&limit=: последние записи журнала доставки
+func (a *api) List(w http.ResponseWriter, r *http.Request) {
+	filter, err := parseListFilter(r.URL.Query())
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "INVALID_ARGUMENT", err.Error())
+		return
+	}
+
+	deliveries, err := a.deliveryService.List(r.Context(), filter)
+	if err != nil {
+		logger.Error(r.Context(), "Failed to list webhook deliveries", zap.Error(err))
+		writeError(w, http.StatusInternalServerError, "INTERNAL", "internal error")
+		return
+	}
+
+	resp := listWebhookDeliveriesResponse{
+		Deliveries: make([]webhookDeliveryResponse, 0, len(deliveries)),
+	}
+	for _, delivery := range deliveries {
+		resp.Deliveries = append(resp.Deliveries, deliveryToResponse(delivery))
+	}
+	writeJSON(w, http.StatusOK, resp)
+}
+
+func parseListFilter(query url.Values) (model.WebhookDeliveryFilter, error) {
+	filter := model.WebhookDeliveryFilter{Limit: defaultListLimit}
+
+	if value := query.Get("user_uuid"); value != "" {
+		userUUID, err := uuid.Parse(value)
+		if err != nil {
+			return model.WebhookDeliveryFilter{}, errInvalidParam("user_uuid must be a valid UUID")
+		}
+		filter.UserUUID = &userUUID
+	}
+
+	if value := query.Get("event_uuid"); value != "" {
+		eventUUID, err := uuid.Parse(value)
+		if err != nil {
+			return model.WebhookDeliveryFilter{}, errInvalidParam("event_uuid must be a valid UUID")
+		}
+		filter.EventUUID = &eventUUID
+	}
+
+	if value := query.Get("status"); value != "" {
+		status := model.WebhookDeliveryStatus(value)
+		if !status.IsValid() {
+			return model.WebhookDeliveryFilter{}, errInvalidParam("status must be one of PENDING, DELIVERED, FAILED")
+		}
+		filter.Status = &status
+	}
+
+	if value := query.Get("limit"); value != "" {
+		limit, err := strconv.ParseUint(value, 10, 64)
+		if err != nil || limit == 0 || limit > maxListLimit {
+			return model.WebhookDeliveryFilter{}, errInvalidParam("limit must be between 1 and " + strconv.Itoa(maxListLimit))
+		}
+		filter.Limit = limit
+	}
+
+	return filter, nil
+}
+
+type errInvalidParam string
+
+func (e errInvalidParam) Error() string {
+	return string(e)
+}
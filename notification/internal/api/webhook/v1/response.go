@@ -0,0 +1,50 @@
+package v1
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/crafty-ezhik/rocket-factory/notification/internal/model"
+)
+
+type webhookDeliveryResponse struct {
+	DeliveryUUID   string          `json:"delivery_uuid"`
+	EventUUID      string          `json:"event_uuid"`
+	EventType      string          `json:"event_type"`
+	UserUUID       string          `json:"user_uuid"`
+	Target         string          `json:"target"`
+	Payload        json.RawMessage `json:"payload"`
+	Status         string          `json:"status"`
+	Attempts       int             `json:"attempts"`
+	LastError      *string         `json:"last_error,omitempty"`
+	LastStatusCode *int            `json:"last_status_code,omitempty"`
+	CreatedAt      time.Time       `json:"created_at"`
+	UpdatedAt      time.Time       `json:"updated_at"`
+	DeliveredAt    *time.Time      `json:"delivered_at,omitempty"`
+}
+
+type webhookSecretResponse struct {
+	Secret string `json:"secret"`
+}
+
+type listWebhookDeliveriesResponse struct {
+	Deliveries []webhookDeliveryResponse `json:"deliveries"`
+}
+
+func deliveryToResponse(delivery model.WebhookDelivery) webhookDeliveryResponse {
+	return webhookDeliveryResponse{
+		DeliveryUUID:   delivery.UUID.String(),
+		EventUUID:      delivery.EventUUID.String(),
+		EventType:      delivery.EventType.String(),
+		UserUUID:       delivery.UserUUID.String(),
+		Target:         delivery.Target,
+		Payload:        delivery.Payload,
+		Status:         delivery.Status.String(),
+		Attempts:       delivery.Attempts,
+		LastError:      delivery.LastError,
+		LastStatusCode: delivery.LastStatusCode,
+		CreatedAt:      delivery.CreatedAt,
+		UpdatedAt:      delivery.UpdatedAt,
+		DeliveredAt:    delivery.DeliveredAt,
+	}
+}
@@ -0,0 +1,30 @@
+package v1
+
+import (
+	"net/http"
+	"net/url"
+
+	"github.com/google/uuid"
+)
+
+// Secret - GET /?user_uuid=&url=: секрет подписи webhook-адреса пользователя.
+// Секрет свой у каждого адреса, интегратор проверяет им заголовок X-Rocket-Signature
+func (a *api) Secret(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+
+	userUUID, err := uuid.Parse(query.Get("user_uuid"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "INVALID_ARGUMENT", "user_uuid must be a valid UUID")
+		return
+	}
+
+	target := query.Get("url")
+	if u, err := url.Parse(target); err != nil || u.Host == "" {
+		writeError(w, http.StatusBadRequest, "INVALID_ARGUMENT", "url must be an absolute URL")
+		return
+	}
+
+	writeJSON(w, http.StatusOK, webhookSecretResponse{
+		Secret: a.deliveryService.SigningSecret(userUUID, target),
+	})
+}
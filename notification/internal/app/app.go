@@ -2,15 +2,24 @@ package app
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"net/http"
 
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/chi/v5/middleware"
+	"go.uber.org/zap"
+
+	telegramV1API "github.com/crafty-ezhik/rocket-factory/notification/internal/api/telegram/v1"
 	"github.com/crafty-ezhik/rocket-factory/notification/internal/config"
 	"github.com/crafty-ezhik/rocket-factory/platform/pkg/closer"
 	"github.com/crafty-ezhik/rocket-factory/platform/pkg/logger"
+	HTTPMiddleware "github.com/crafty-ezhik/rocket-factory/platform/pkg/middleware/http"
 )
 
 type App struct {
 	diContainer *diContainer
+	httpServer  *http.Server
 }
 
 func New(ctx context.Context) (*App, error) {
@@ -24,11 +33,17 @@ func New(ctx context.Context) (*App, error) {
 }
 
 func (a *App) Run(ctx context.Context) error {
-	errCh := make(chan error, 2)
+	errCh := make(chan error, 5)
 
 	ctx, cancel := context.WithCancel(ctx)
 	defer cancel()
 
+	go func() {
+		if err := a.runHTTPServer(ctx); err != nil {
+			errCh <- fmt.Errorf("http server error: %w", err)
+		}
+	}()
+
 	go func() {
 		if err := a.runPaidConsumer(ctx); err != nil {
 			errCh <- fmt.Errorf("consumer error: %w", err)
@@ -41,6 +56,20 @@ func (a *App) Run(ctx context.Context) error {
 		}
 	}()
 
+	go func() {
+		if err := a.runRefundedConsumer(ctx); err != nil {
+			errCh <- fmt.Errorf("consumer error: %w", err)
+		}
+	}()
+
+	go func() {
+		if err := a.runAssemblyFailedConsumer(ctx); err != nil {
+			errCh <- fmt.Errorf("consumer error: %w", err)
+		}
+	}()
+
+	go a.runTelegramBot(ctx)
+
 	select {
 	case err := <-errCh:
 		// Триггерим cancel, чтобы остановить второй компонент
@@ -60,6 +89,8 @@ func (a *App) initDeps(ctx context.Context) error {
 		a.initDI,
 		a.initLogger,
 		a.initCloser,
+		a.initHTTPServer,
+		a.initTelegramBot,
 	}
 
 	for _, f := range inits {
@@ -87,6 +118,54 @@ func (a *App) initCloser(_ context.Context) error {
 	return nil
 }
 
+// initHTTPServer - admin API. Доступ только с разрешениями IAM из adminAccessPolicy
+func (a *App) initHTTPServer(_ context.Context) error {
+	r := chi.NewRouter()
+
+	authMiddleware := HTTPMiddleware.NewAuthMiddleware(a.diContainer.AuthClient(), a.diContainer.TokenVerifier(), adminAccessPolicy)
+
+	r.Use(middleware.Logger)
+	r.Use(middleware.Recoverer)
+	r.Use(middleware.Heartbeat("/admin/v1/ping"))
+	r.Use(middleware.Timeout(config.AppConfig().AdminHTTP.ReadTimeout()))
+	r.Use(authMiddleware.Handle)
+
+	r.Mount("/admin/v1/webhook-deliveries", a.diContainer.WebhookV1Handler())
+	r.Mount("/admin/v1/webhook-secrets", a.diContainer.WebhookSecretV1Handler())
+
+	a.httpServer = &http.Server{
+		Addr:              config.AppConfig().AdminHTTP.Address(),
+		Handler:           r,
+		ReadHeaderTimeout: config.AppConfig().AdminHTTP.ReadTimeout(),
+	}
+
+	closer.AddNamed("Admin HTTP server", func(ctx context.Context) error {
+		if err := a.httpServer.Shutdown(ctx); err != nil {
+			logger.Error(ctx, "❌ Ошибка при остановке admin HTTP-сервера", zap.Error(err))
+			return err
+		}
+		return nil
+	})
+
+	return nil
+}
+
+// initTelegramBot - регистрирует команды бота. Команды выполняются от имени привязанного к чату пользователя
+func (a *App) initTelegramBot(_ context.Context) error {
+	telegramV1API.NewAPI(a.diContainer.TelegramBotService()).Register(a.diContainer.TelegramBot())
+	return nil
+}
+
+func (a *App) runHTTPServer(ctx context.Context) error {
+	logger.Info(ctx, fmt.Sprintf("🚀 Admin HTTP-сервер запущен на %s", config.AppConfig().AdminHTTP.Address()))
+	err := a.httpServer.ListenAndServe()
+	if err != nil && !errors.Is(err, http.ErrServerClosed) {
+		logger.Error(ctx, "❌ Ошибка запуска admin HTTP-сервера", zap.Error(err))
+		return err
+	}
+	return nil
+}
+
 func (a *App) runPaidConsumer(ctx context.Context) error {
 	logger.Info(ctx, "🚀 OrderPaid Kafka consumer запущен")
 
@@ -108,3 +187,32 @@ func (a *App) runAssembledConsumer(ctx context.Context) error {
 	}
 	return nil
 }
+
+func (a *App) runRefundedConsumer(ctx context.Context) error {
+	logger.Info(ctx, "🚀 OrderRefunded Kafka consumer запущен")
+
+	service := a.diContainer.OrderRefundedConsumerService()
+	err := service.RunConsumer(ctx)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (a *App) runAssemblyFailedConsumer(ctx context.Context) error {
+	logger.Info(ctx, "🚀 ShipAssemblyFailed Kafka consumer запущен")
+
+	service := a.diContainer.OrderAssemblyFailedConsumerService()
+	err := service.RunConsumer(ctx)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// runTelegramBot - получает обновления бота через long polling до отмены ctx
+func (a *App) runTelegramBot(ctx context.Context) {
+	logger.Info(ctx, "🚀 Telegram bot запущен")
+
+	a.diContainer.TelegramBot().Start(ctx)
+}
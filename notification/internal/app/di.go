@@ -3,56 +3,254 @@ package app
 import (
 	"context"
 	"fmt"
+	stdHTTP "net/http"
+	"time"
 
 	"github.com/IBM/sarama"
 	"github.com/go-telegram/bot"
+	"github.com/go-telegram/bot/models"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"go.uber.org/zap"
+	googleGRPC "google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
 
+	webhookV1API "github.com/crafty-ezhik/rocket-factory/notification/internal/api/webhook/v1"
+	"github.com/crafty-ezhik/rocket-factory/notification/internal/client/grpc"
+	iamV1GRPC "github.com/crafty-ezhik/rocket-factory/notification/internal/client/grpc/iam/v1"
 	"github.com/crafty-ezhik/rocket-factory/notification/internal/client/http"
+	orderV1HTTP "github.com/crafty-ezhik/rocket-factory/notification/internal/client/http/order/v1"
 	telegramClient "github.com/crafty-ezhik/rocket-factory/notification/internal/client/http/telegram"
+	webhookClient "github.com/crafty-ezhik/rocket-factory/notification/internal/client/http/webhook"
+	smtpClient "github.com/crafty-ezhik/rocket-factory/notification/internal/client/smtp"
 	"github.com/crafty-ezhik/rocket-factory/notification/internal/config"
 	kafkaConv "github.com/crafty-ezhik/rocket-factory/notification/internal/converter/kafka"
 	"github.com/crafty-ezhik/rocket-factory/notification/internal/converter/kafka/decoder"
+	"github.com/crafty-ezhik/rocket-factory/notification/internal/model"
+	"github.com/crafty-ezhik/rocket-factory/notification/internal/repository"
+	telegramLinkRepo "github.com/crafty-ezhik/rocket-factory/notification/internal/repository/telegram_link"
+	webhookDeliveryRepo "github.com/crafty-ezhik/rocket-factory/notification/internal/repository/webhook_delivery"
 	"github.com/crafty-ezhik/rocket-factory/notification/internal/service"
 	"github.com/crafty-ezhik/rocket-factory/notification/internal/service/consumer/order_assembled_consumer"
+	"github.com/crafty-ezhik/rocket-factory/notification/internal/service/consumer/order_assembly_failed_consumer"
 	"github.com/crafty-ezhik/rocket-factory/notification/internal/service/consumer/order_paid_consumer"
+	"github.com/crafty-ezhik/rocket-factory/notification/internal/service/consumer/order_refunded_consumer"
+	"github.com/crafty-ezhik/rocket-factory/notification/internal/service/email"
+	"github.com/crafty-ezhik/rocket-factory/notification/internal/service/notification"
+	"github.com/crafty-ezhik/rocket-factory/notification/internal/service/recipient"
 	"github.com/crafty-ezhik/rocket-factory/notification/internal/service/telegram"
+	"github.com/crafty-ezhik/rocket-factory/notification/internal/service/telegram_bot"
+	"github.com/crafty-ezhik/rocket-factory/notification/internal/service/webhook"
+	"github.com/crafty-ezhik/rocket-factory/notification/internal/service/webhook_delivery"
+	"github.com/crafty-ezhik/rocket-factory/notification/internal/template"
+	"github.com/crafty-ezhik/rocket-factory/notification/internal/template/registry"
 	"github.com/crafty-ezhik/rocket-factory/platform/pkg/closer"
 	wrapperKafka "github.com/crafty-ezhik/rocket-factory/platform/pkg/kafka"
 	wrapperKafkaConsumer "github.com/crafty-ezhik/rocket-factory/platform/pkg/kafka/consumer"
+	"github.com/crafty-ezhik/rocket-factory/platform/pkg/kafka/consumer/dedup"
 	"github.com/crafty-ezhik/rocket-factory/platform/pkg/logger"
+	middlewareGRPC "github.com/crafty-ezhik/rocket-factory/platform/pkg/middleware/grpc"
+	"github.com/crafty-ezhik/rocket-factory/platform/pkg/secretbox"
+	"github.com/crafty-ezhik/rocket-factory/platform/pkg/token"
+	orderV1 "github.com/crafty-ezhik/rocket-factory/shared/pkg/openapi/order/v1"
+	authV1 "github.com/crafty-ezhik/rocket-factory/shared/pkg/proto/auth/v1"
+	userV1 "github.com/crafty-ezhik/rocket-factory/shared/pkg/proto/user/v1"
 )
 
 type diContainer struct {
-	telegramService service.TelegramService
-	telegramClient  http.TelegramClient
-	telegramBot     *bot.Bot
+	notificationService service.NotificationService
+	recipientService    service.RecipientService
+	telegramService     service.ChannelService
+	emailService        service.ChannelService
+	webhookService      service.ChannelService
+	templateRenderer    template.Renderer
+	telegramClient      http.TelegramClient
+	smtpClient          http.Notifier
+	webhookClient       http.WebhookClient
+	telegramBot         *bot.Bot
+	iamClient           grpc.IAMClient
+	orderClient         http.OrderClient
+	iamConn             *googleGRPC.ClientConn
+
+	telegramBotService service.TelegramBotService
+	telegramLinkRepo   repository.TelegramLinkRepository
+
+	webhookDeliveryService service.WebhookDeliveryService
+	webhookDeliveryRepo    repository.WebhookDeliveryRepository
+	webhookV1Handler       stdHTTP.Handler
+	webhookSecretV1Handler stdHTTP.Handler
+	pgConnPool             *pgxpool.Pool
+	authClient             middlewareGRPC.IAMClient
+	tokenVerifier          *token.Verifier
 
 	orderPaidConsumerService      service.OrderPaidConsumerService
 	orderAssembledConsumerService service.OrderAssembledConsumerService
+	orderRefundedConsumerService  service.OrderRefundedConsumerService
+	assemblyFailedConsumerService service.OrderAssemblyFailedConsumerService
 
 	consumerGroupPaid      sarama.ConsumerGroup
 	consumerGroupAssembled sarama.ConsumerGroup
+	consumerGroupRefunded  sarama.ConsumerGroup
 	orderPaidConsumer      wrapperKafka.Consumer
 	orderPaidDecoder       kafkaConv.OrderPaidDecoder
 	orderAssembledConsumer wrapperKafka.Consumer
+	dedupStore             wrapperKafkaConsumer.DedupStore
+	syncProducer           sarama.SyncProducer
 	orderAssembledDecoder  kafkaConv.OrderAssembledDecoder
+	orderRefundedConsumer  wrapperKafka.Consumer
+	orderRefundedDecoder   kafkaConv.OrderRefundedDecoder
+
+	consumerGroupAssemblyFailed sarama.ConsumerGroup
+	orderAssemblyFailedConsumer wrapperKafka.Consumer
+	orderAssemblyFailedDecoder  kafkaConv.OrderAssemblyFailedDecoder
 }
 
 func NewDiContainer() *diContainer { return &diContainer{} }
 
-func (d *diContainer) TelegramService() service.TelegramService {
+func (d *diContainer) NotificationService() service.NotificationService {
+	if d.notificationService == nil {
+		d.notificationService = notification.NewService(d.RecipientService(), map[string]service.ChannelService{
+			model.ProviderTelegram: d.TelegramService(),
+			model.ProviderEmail:    d.EmailService(),
+			model.ProviderWebhook:  d.WebhookService(),
+		})
+	}
+	return d.notificationService
+}
+
+func (d *diContainer) RecipientService() service.RecipientService {
+	if d.recipientService == nil {
+		d.recipientService = recipient.NewService(d.IAMClient(), config.AppConfig().IamGRPC.UserCacheTTL())
+	}
+	return d.recipientService
+}
+
+func (d *diContainer) TelegramService() service.ChannelService {
 	if d.telegramService == nil {
-		d.telegramService = telegram.NewService(d.TelegramClient())
+		d.telegramService = telegram.NewService(d.TelegramClient(), d.TemplateRenderer())
 	}
 	return d.telegramService
 }
 
+func (d *diContainer) EmailService() service.ChannelService {
+	if d.emailService == nil {
+		d.emailService = email.NewService(d.SMTPClient(), d.TemplateRenderer())
+	}
+	return d.emailService
+}
+
+func (d *diContainer) TelegramBotService() service.TelegramBotService {
+	if d.telegramBotService == nil {
+		d.telegramBotService = telegram_bot.NewService(
+			d.TelegramClient(),
+			d.OrderClient(),
+			d.IAMClient(),
+			d.TelegramLinkRepository(),
+			d.RecipientService(),
+			d.TemplateRenderer(),
+		)
+	}
+	return d.telegramBotService
+}
+
+func (d *diContainer) TelegramLinkRepository() repository.TelegramLinkRepository {
+	if d.telegramLinkRepo == nil {
+		box, err := secretbox.NewFromBase64(config.AppConfig().TgBot.LinkEncryptionKey())
+		if err != nil {
+			panic(fmt.Sprintf("❌ Некорректный ключ шифрования привязок Telegram: %v\n", err))
+		}
+		d.telegramLinkRepo = telegramLinkRepo.NewRepository(d.PgConnPool(), box)
+	}
+	return d.telegramLinkRepo
+}
+
+func (d *diContainer) TemplateRenderer() template.Renderer {
+	if d.templateRenderer == nil {
+		renderer, err := registry.New()
+		if err != nil {
+			panic(fmt.Sprintf("❌ Ошибка загрузки шаблонов уведомлений: %v\n", err))
+		}
+		d.templateRenderer = renderer
+	}
+	return d.templateRenderer
+}
+
+func (d *diContainer) WebhookService() service.ChannelService {
+	if d.webhookService == nil {
+		d.webhookService = webhook.NewService(d.WebhookClient(), d.WebhookDeliveryRepository(), webhook.RetryPolicy{
+			Attempts:   config.AppConfig().Webhook.RetryAttempts(),
+			Backoff:    config.AppConfig().Webhook.RetryBackoff(),
+			MaxBackoff: config.AppConfig().Webhook.RetryMaxBackoff(),
+		})
+	}
+	return d.webhookService
+}
+
+func (d *diContainer) WebhookDeliveryService() service.WebhookDeliveryService {
+	if d.webhookDeliveryService == nil {
+		d.webhookDeliveryService = webhook_delivery.NewService(
+			d.WebhookDeliveryRepository(),
+			config.AppConfig().Webhook.SigningKey(),
+		)
+	}
+	return d.webhookDeliveryService
+}
+
+func (d *diContainer) WebhookV1Handler() stdHTTP.Handler {
+	if d.webhookV1Handler == nil {
+		d.webhookV1Handler = webhookV1API.NewAPI(d.WebhookDeliveryService()).Routes()
+	}
+	return d.webhookV1Handler
+}
+
+func (d *diContainer) WebhookSecretV1Handler() stdHTTP.Handler {
+	if d.webhookSecretV1Handler == nil {
+		d.webhookSecretV1Handler = webhookV1API.NewAPI(d.WebhookDeliveryService()).SecretRoutes()
+	}
+	return d.webhookSecretV1Handler
+}
+
+func (d *diContainer) WebhookDeliveryRepository() repository.WebhookDeliveryRepository {
+	if d.webhookDeliveryRepo == nil {
+		d.webhookDeliveryRepo = webhookDeliveryRepo.NewRepository(d.PgConnPool())
+	}
+	return d.webhookDeliveryRepo
+}
+
+// PgConnPool - пул соединений с Postgres, где хранится журнал доставки webhook-уведомлений
+func (d *diContainer) PgConnPool() *pgxpool.Pool {
+	if d.pgConnPool == nil {
+		ctx := context.Background()
+		pool, err := pgxpool.New(ctx, config.AppConfig().Postgres.URI())
+		if err != nil {
+			panic(fmt.Sprintf("❌ Ошибка подключения к базе данных: %v\n", err))
+		}
+
+		// Проверка соединения
+		pingCtx, pingCancel := context.WithTimeout(ctx, 5*time.Second)
+		defer pingCancel()
+
+		err = pool.Ping(pingCtx)
+		if err != nil {
+			panic(fmt.Sprintf("failed to ping Postgres: %v\n", err))
+		}
+
+		// Добавляем закрытие пула в closer
+		closer.AddNamed("Postgres connection pool", func(ctx context.Context) error {
+			pool.Close()
+			return nil
+		})
+
+		d.pgConnPool = pool
+	}
+	return d.pgConnPool
+}
+
 func (d *diContainer) OrderPaidConsumerService() service.OrderPaidConsumerService {
 	if d.orderPaidConsumerService == nil {
 		d.orderPaidConsumerService = order_paid_consumer.NewService(
 			d.OrderPaidDecoder(),
 			d.OrderPaidConsumer(),
-			d.TelegramService(),
+			d.NotificationService(),
 		)
 	}
 	return d.orderPaidConsumerService
@@ -63,12 +261,34 @@ func (d *diContainer) OrderAssembledConsumerService() service.OrderAssembledCons
 		d.orderAssembledConsumerService = order_assembled_consumer.NewService(
 			d.OrderAssembledConsumer(),
 			d.OrderAssembledDecoder(),
-			d.TelegramService(),
+			d.NotificationService(),
 		)
 	}
 	return d.orderAssembledConsumerService
 }
 
+func (d *diContainer) OrderRefundedConsumerService() service.OrderRefundedConsumerService {
+	if d.orderRefundedConsumerService == nil {
+		d.orderRefundedConsumerService = order_refunded_consumer.NewService(
+			d.OrderRefundedConsumer(),
+			d.OrderRefundedDecoder(),
+			d.NotificationService(),
+		)
+	}
+	return d.orderRefundedConsumerService
+}
+
+func (d *diContainer) OrderAssemblyFailedConsumerService() service.OrderAssemblyFailedConsumerService {
+	if d.assemblyFailedConsumerService == nil {
+		d.assemblyFailedConsumerService = order_assembly_failed_consumer.NewService(
+			d.OrderAssemblyFailedConsumer(),
+			d.OrderAssemblyFailedDecoder(),
+			d.NotificationService(),
+		)
+	}
+	return d.assemblyFailedConsumerService
+}
+
 func (d *diContainer) OrderPaidConsumer() wrapperKafka.Consumer {
 	if d.orderPaidConsumer == nil {
 		d.orderPaidConsumer = wrapperKafkaConsumer.NewConsumer(
@@ -77,7 +297,17 @@ func (d *diContainer) OrderPaidConsumer() wrapperKafka.Consumer {
 				config.AppConfig().OrderPaidConsumer.Topic(),
 			},
 			logger.Logger(),
-			// kafkaMiddleware.Logging(logger.Logger()),
+			wrapperKafkaConsumer.WithMiddlewares(
+				// kafkaMiddleware.Logging(logger.Logger()),
+				wrapperKafkaConsumer.Deduplicate(
+					d.DedupStore(),
+					config.AppConfig().OrderPaidConsumer.GroupID(),
+					config.AppConfig().OrderPaidConsumer.DedupRetention(),
+					d.orderPaidEventKey,
+					logger.Logger(),
+				),
+			),
+			wrapperKafkaConsumer.WithRetry(d.ConsumerRetryPolicy(), d.SyncProducer()),
 		)
 	}
 	return d.orderPaidConsumer
@@ -91,12 +321,68 @@ func (d *diContainer) OrderAssembledConsumer() wrapperKafka.Consumer {
 				config.AppConfig().OrderAssembledConsumer.Topic(),
 			},
 			logger.Logger(),
-			// kafkaMiddleware.Logging(logger.Logger()),
+			wrapperKafkaConsumer.WithMiddlewares(
+				// kafkaMiddleware.Logging(logger.Logger()),
+				wrapperKafkaConsumer.Deduplicate(
+					d.DedupStore(),
+					config.AppConfig().OrderAssembledConsumer.GroupID(),
+					config.AppConfig().OrderAssembledConsumer.DedupRetention(),
+					d.orderAssembledEventKey,
+					logger.Logger(),
+				),
+			),
+			wrapperKafkaConsumer.WithRetry(d.ConsumerRetryPolicy(), d.SyncProducer()),
 		)
 	}
 	return d.orderAssembledConsumer
 }
 
+func (d *diContainer) OrderRefundedConsumer() wrapperKafka.Consumer {
+	if d.orderRefundedConsumer == nil {
+		d.orderRefundedConsumer = wrapperKafkaConsumer.NewConsumer(
+			d.ConsumerGroupRefunded(),
+			[]string{
+				config.AppConfig().OrderRefundedConsumer.Topic(),
+			},
+			logger.Logger(),
+			wrapperKafkaConsumer.WithMiddlewares(
+				wrapperKafkaConsumer.Deduplicate(
+					d.DedupStore(),
+					config.AppConfig().OrderRefundedConsumer.GroupID(),
+					config.AppConfig().OrderRefundedConsumer.DedupRetention(),
+					d.orderRefundedEventKey,
+					logger.Logger(),
+				),
+			),
+			wrapperKafkaConsumer.WithRetry(d.ConsumerRetryPolicy(), d.SyncProducer()),
+		)
+	}
+	return d.orderRefundedConsumer
+}
+
+func (d *diContainer) OrderAssemblyFailedConsumer() wrapperKafka.Consumer {
+	if d.orderAssemblyFailedConsumer == nil {
+		d.orderAssemblyFailedConsumer = wrapperKafkaConsumer.NewConsumer(
+			d.ConsumerGroupAssemblyFailed(),
+			[]string{
+				config.AppConfig().OrderAssemblyFailed.Topic(),
+			},
+			logger.Logger(),
+			wrapperKafkaConsumer.WithMiddlewares(
+				wrapperKafkaConsumer.Deduplicate(
+					d.DedupStore(),
+					config.AppConfig().OrderAssemblyFailed.GroupID(),
+					config.AppConfig().OrderAssemblyFailed.DedupRetention(),
+					d.orderAssemblyFailedEventKey,
+					logger.Logger(),
+				),
+			),
+			wrapperKafkaConsumer.WithRetry(d.ConsumerRetryPolicy(), d.SyncProducer()),
+		)
+	}
+	return d.orderAssemblyFailedConsumer
+}
+
 func (d *diContainer) ConsumerGroupPaid() sarama.ConsumerGroup {
 	if d.consumerGroupPaid == nil {
 		consumerGroup, err := sarama.NewConsumerGroup(
@@ -136,6 +422,44 @@ func (d *diContainer) ConsumerGroupAssembled() sarama.ConsumerGroup {
 	return d.consumerGroupAssembled
 }
 
+func (d *diContainer) ConsumerGroupRefunded() sarama.ConsumerGroup {
+	if d.consumerGroupRefunded == nil {
+		consumerGroup, err := sarama.NewConsumerGroup(
+			config.AppConfig().Kafka.Brokers(),
+			config.AppConfig().OrderRefundedConsumer.GroupID(),
+			config.AppConfig().OrderRefundedConsumer.Config(),
+		)
+		if err != nil {
+			panic(fmt.Sprintf("❌ Ошибка создания consumer group: %s\n", err.Error()))
+		}
+
+		closer.AddNamed("Kafka refunded consumer group", func(ctx context.Context) error {
+			return d.consumerGroupRefunded.Close()
+		})
+		d.consumerGroupRefunded = consumerGroup
+	}
+	return d.consumerGroupRefunded
+}
+
+func (d *diContainer) ConsumerGroupAssemblyFailed() sarama.ConsumerGroup {
+	if d.consumerGroupAssemblyFailed == nil {
+		consumerGroup, err := sarama.NewConsumerGroup(
+			config.AppConfig().Kafka.Brokers(),
+			config.AppConfig().OrderAssemblyFailed.GroupID(),
+			config.AppConfig().OrderAssemblyFailed.Config(),
+		)
+		if err != nil {
+			panic(fmt.Sprintf("❌ Ошибка создания consumer group: %s\n", err.Error()))
+		}
+
+		closer.AddNamed("Kafka assembly failed consumer group", func(ctx context.Context) error {
+			return d.consumerGroupAssemblyFailed.Close()
+		})
+		d.consumerGroupAssemblyFailed = consumerGroup
+	}
+	return d.consumerGroupAssemblyFailed
+}
+
 func (d *diContainer) OrderPaidDecoder() kafkaConv.OrderPaidDecoder {
 	if d.orderPaidDecoder == nil {
 		d.orderPaidDecoder = decoder.NewOrderPaidDecoder()
@@ -150,16 +474,140 @@ func (d *diContainer) OrderAssembledDecoder() kafkaConv.OrderAssembledDecoder {
 	return d.orderAssembledDecoder
 }
 
+func (d *diContainer) OrderRefundedDecoder() kafkaConv.OrderRefundedDecoder {
+	if d.orderRefundedDecoder == nil {
+		d.orderRefundedDecoder = decoder.NewOrderRefundedDecoder()
+	}
+	return d.orderRefundedDecoder
+}
+
+func (d *diContainer) OrderAssemblyFailedDecoder() kafkaConv.OrderAssemblyFailedDecoder {
+	if d.orderAssemblyFailedDecoder == nil {
+		d.orderAssemblyFailedDecoder = decoder.NewOrderAssemblyFailedDecoder()
+	}
+	return d.orderAssemblyFailedDecoder
+}
+
+// ConsumerRetryPolicy - Создается политика повторной обработки сообщений на основе конфигурации
+func (d *diContainer) ConsumerRetryPolicy() wrapperKafkaConsumer.RetryPolicy {
+	return wrapperKafkaConsumer.RetryPolicy{
+		Attempts:   config.AppConfig().ConsumerRetry.Attempts(),
+		Backoff:    config.AppConfig().ConsumerRetry.Backoff(),
+		MaxBackoff: config.AppConfig().ConsumerRetry.MaxBackoff(),
+		Delays:     config.AppConfig().ConsumerRetry.TopicDelays(),
+	}
+}
+
+// SyncProducer - создает producer для переотправки сообщений в retry-топики и DLQ
+func (d *diContainer) SyncProducer() sarama.SyncProducer {
+	if d.syncProducer == nil {
+		p, err := sarama.NewSyncProducer(
+			config.AppConfig().Kafka.Brokers(),
+			config.AppConfig().ConsumerRetry.ProducerConfig(),
+		)
+		if err != nil {
+			panic(fmt.Sprintf("❌ Ошибка создания sync producer: %s\n", err.Error()))
+		}
+
+		// Добавляем закрытие producer
+		closer.AddNamed("Kafka sync producer", func(ctx context.Context) error { return p.Close() })
+
+		d.syncProducer = p
+	}
+	return d.syncProducer
+}
+
+// DedupStore - Создается хранилище ключей обработанных событий в PostgreSQL
+func (d *diContainer) DedupStore() wrapperKafkaConsumer.DedupStore {
+	if d.dedupStore == nil {
+		d.dedupStore = dedup.NewPostgresStore(d.PgConnPool(), "processed_messages")
+	}
+	return d.dedupStore
+}
+
+// orderPaidEventKey - ключ идемпотентности события "Заказ оплачен"
+func (d *diContainer) orderPaidEventKey(msg wrapperKafka.Message) (string, error) {
+	event, err := d.OrderPaidDecoder().Decode(msg.Value)
+	if err != nil {
+		return "", err
+	}
+	return event.EventUUID.String(), nil
+}
+
+// orderAssembledEventKey - ключ идемпотентности события "Заказ собран"
+func (d *diContainer) orderAssembledEventKey(msg wrapperKafka.Message) (string, error) {
+	event, err := d.OrderAssembledDecoder().Decode(msg.Value)
+	if err != nil {
+		return "", err
+	}
+	return event.EventUUID.String(), nil
+}
+
+// orderRefundedEventKey - ключ идемпотентности события "Заказ отменен, средства возвращены"
+func (d *diContainer) orderRefundedEventKey(msg wrapperKafka.Message) (string, error) {
+	event, err := d.OrderRefundedDecoder().Decode(msg.Value)
+	if err != nil {
+		return "", err
+	}
+	return event.EventUUID.String(), nil
+}
+
+// orderAssemblyFailedEventKey - ключ идемпотентности события "Сборка заказа завершилась ошибкой"
+func (d *diContainer) orderAssemblyFailedEventKey(msg wrapperKafka.Message) (string, error) {
+	event, err := d.OrderAssemblyFailedDecoder().Decode(msg.Value)
+	if err != nil {
+		return "", err
+	}
+	return event.EventUUID.String(), nil
+}
+
 func (d *diContainer) TelegramClient() http.TelegramClient {
 	if d.telegramClient == nil {
-		d.telegramClient = telegramClient.NewClient(d.TelegramBot())
+		d.telegramClient = telegramClient.NewClient(d.TelegramBot(), telegramClient.Config{
+			RatePerSecond: config.AppConfig().TgBot.RatePerSecond(),
+			MaxRetries:    config.AppConfig().TgBot.MaxRetries(),
+		})
 	}
 	return d.telegramClient
 }
 
+func (d *diContainer) SMTPClient() http.Notifier {
+	if d.smtpClient == nil {
+		d.smtpClient = smtpClient.NewClient(smtpClient.Config{
+			Host:     config.AppConfig().SMTP.Host(),
+			Port:     config.AppConfig().SMTP.Port(),
+			Username: config.AppConfig().SMTP.Username(),
+			Password: config.AppConfig().SMTP.Password(),
+			From:     config.AppConfig().SMTP.From(),
+			StartTLS: config.AppConfig().SMTP.StartTLS(),
+			Timeout:  config.AppConfig().SMTP.Timeout(),
+		})
+	}
+	return d.smtpClient
+}
+
+func (d *diContainer) WebhookClient() http.WebhookClient {
+	if d.webhookClient == nil {
+		d.webhookClient = webhookClient.NewClient(
+			config.AppConfig().Webhook.SigningKey(),
+			config.AppConfig().Webhook.RequestTimeout(),
+			config.AppConfig().Webhook.AllowPrivateTargets(),
+		)
+	}
+	return d.webhookClient
+}
+
 func (d *diContainer) TelegramBot() *bot.Bot {
 	if d.telegramBot == nil {
-		b, err := bot.New(config.AppConfig().TgBot.Token())
+		// Сообщения без зарегистрированной команды игнорируются: обработчик по умолчанию пишет их в лог целиком
+		opts := []bot.Option{
+			bot.WithDefaultHandler(func(context.Context, *bot.Bot, *models.Update) {}),
+		}
+		if apiURL := config.AppConfig().TgBot.APIURL(); apiURL != "" {
+			opts = append(opts, bot.WithServerURL(apiURL))
+		}
+
+		b, err := bot.New(config.AppConfig().TgBot.Token(), opts...)
 		if err != nil {
 			panic(fmt.Sprintf("failed to create telegram bot: %s\n", err.Error()))
 		}
@@ -167,3 +615,66 @@ func (d *diContainer) TelegramBot() *bot.Bot {
 	}
 	return d.telegramBot
 }
+
+func (d *diContainer) IAMClient() grpc.IAMClient {
+	if d.iamClient == nil {
+		d.iamClient = iamV1GRPC.NewIAMClient(
+			userV1.NewUserServiceClient(d.IAMConn()),
+			authV1.NewAuthServiceClient(d.IAMConn()),
+		)
+	}
+	return d.iamClient
+}
+
+// OrderClient - HTTP API Order Service для команд бота
+func (d *diContainer) OrderClient() http.OrderClient {
+	if d.orderClient == nil {
+		genClient, err := orderV1.NewClient(
+			config.AppConfig().OrderHTTP.URL(),
+			orderV1.WithClient(&stdHTTP.Client{Timeout: config.AppConfig().OrderHTTP.Timeout()}),
+		)
+		if err != nil {
+			panic(fmt.Sprintf("❌ Ошибка создания клиента Order Service: %v", err))
+		}
+		d.orderClient = orderV1HTTP.NewOrderClient(genClient)
+	}
+	return d.orderClient
+}
+
+// AuthClient - клиент AuthService IAM для аутентификации запросов к admin API
+func (d *diContainer) AuthClient() middlewareGRPC.IAMClient {
+	if d.authClient == nil {
+		d.authClient = authV1.NewAuthServiceClient(d.IAMConn())
+	}
+	return d.authClient
+}
+
+func (d *diContainer) TokenVerifier() *token.Verifier {
+	if d.tokenVerifier == nil {
+		d.tokenVerifier = middlewareGRPC.NewTokenVerifier(d.AuthClient(), config.AppConfig().IamGRPC.TokenIssuer())
+	}
+	return d.tokenVerifier
+}
+
+func (d *diContainer) IAMConn() *googleGRPC.ClientConn {
+	if d.iamConn == nil {
+		conn, err := googleGRPC.NewClient(
+			config.AppConfig().IamGRPC.Address(),
+			googleGRPC.WithTransportCredentials(insecure.NewCredentials()),
+		)
+		if err != nil {
+			panic(fmt.Sprintf("❌ Ошибка подключения к IAM Service: %v", err))
+		}
+
+		closer.AddNamed("IAM client", func(ctx context.Context) error {
+			if err := conn.Close(); err != nil {
+				logger.Error(ctx, "❌ Ошибка при закрытии подключения с IAM Service", zap.Error(err))
+				return err
+			}
+			return nil
+		})
+
+		d.iamConn = conn
+	}
+	return d.iamConn
+}
@@ -0,0 +1,20 @@
+package app
+
+import (
+	"net/http"
+
+	HTTPMiddleware "github.com/crafty-ezhik/rocket-factory/platform/pkg/middleware/http"
+)
+
+// Разрешения IAM, которые проверяются для маршрутов admin API
+const (
+	permissionWebhooksRead   = "webhooks:read"
+	permissionWebhooksManage = "webhooks:manage"
+)
+
+// adminAccessPolicy - разрешения, необходимые для обращения к admin API
+var adminAccessPolicy = HTTPMiddleware.RoutePolicy{
+	{Method: http.MethodGet, Path: "/admin/v1/webhook-deliveries", Permission: permissionWebhooksRead},
+	{Method: http.MethodGet, Path: "/admin/v1/webhook-deliveries/{delivery_uuid}", Permission: permissionWebhooksRead},
+	{Method: http.MethodGet, Path: "/admin/v1/webhook-secrets", Permission: permissionWebhooksManage},
+}
@@ -0,0 +1,27 @@
+package converter
+
+import (
+	"time"
+
+	"github.com/crafty-ezhik/rocket-factory/notification/internal/model"
+	orderV1 "github.com/crafty-ezhik/rocket-factory/shared/pkg/openapi/order/v1"
+)
+
+func OrderToModel(order orderV1.OrderDto) model.Order {
+	return model.Order{
+		UUID:          order.GetOrderUUID(),
+		Status:        string(order.GetStatus()),
+		TotalPrice:    order.GetTotalPrice(),
+		PaymentMethod: string(order.GetPaymentMethod().Or("")),
+		CreatedAt:     order.GetCreatedAt().Or(time.Time{}),
+		UpdatedAt:     order.GetUpdatedAt().Or(time.Time{}),
+	}
+}
+
+func OrdersToModel(orders []orderV1.OrderDto) []model.Order {
+	result := make([]model.Order, 0, len(orders))
+	for _, order := range orders {
+		result = append(result, OrderToModel(order))
+	}
+	return result
+}
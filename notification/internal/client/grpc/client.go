@@ -0,0 +1,18 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/crafty-ezhik/rocket-factory/notification/internal/model"
+)
+
+type IAMClient interface {
+	GetUser(ctx context.Context, userUUID uuid.UUID) (model.User, error)
+	// Whoami - пользователь активной сессии. Для завершенной или неизвестной сессии возвращается model.ErrSessionExpired
+	Whoami(ctx context.Context, sessionUUID uuid.UUID) (model.User, error)
+	// AddNotificationMethod - добавляет канал уведомлений от имени пользователя сессии sessionUUID.
+	// Если канал уже есть, возвращается model.ErrNotificationMethodExist
+	AddNotificationMethod(ctx context.Context, sessionUUID, userUUID uuid.UUID, method model.NotificationMethod) error
+}
@@ -0,0 +1,37 @@
+package v1
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/crafty-ezhik/rocket-factory/notification/internal/model"
+	grpcAuth "github.com/crafty-ezhik/rocket-factory/platform/pkg/middleware/grpc"
+	genUserV1 "github.com/crafty-ezhik/rocket-factory/shared/pkg/proto/user/v1"
+)
+
+// AddNotificationMethod - IAM меняет учетную запись только от имени ее владельца, поэтому вызов идет с сессией пользователя
+func (c *client) AddNotificationMethod(ctx context.Context, sessionUUID, userUUID uuid.UUID, method model.NotificationMethod) error {
+	ctx = metadata.AppendToOutgoingContext(ctx, grpcAuth.SessionUUIDMetadataKey, sessionUUID.String())
+
+	_, err := c.generatedClient.AddNotificationMethod(ctx, &genUserV1.AddNotificationMethodRequest{
+		UserUuid:     userUUID.String(),
+		ProviderName: method.ProviderName,
+		Target:       method.Target,
+	})
+	if err != nil {
+		switch status.Code(err) {
+		case codes.FailedPrecondition:
+			return model.ErrNotificationMethodExist
+		case codes.NotFound:
+			return model.ErrUserNotFound
+		case codes.Unauthenticated:
+			return model.ErrSessionExpired
+		}
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,21 @@
+package v1
+
+import (
+	def "github.com/crafty-ezhik/rocket-factory/notification/internal/client/grpc"
+	generatedAuthV1 "github.com/crafty-ezhik/rocket-factory/shared/pkg/proto/auth/v1"
+	generatedUserV1 "github.com/crafty-ezhik/rocket-factory/shared/pkg/proto/user/v1"
+)
+
+var _ def.IAMClient = (*client)(nil)
+
+type client struct {
+	generatedClient generatedUserV1.UserServiceClient
+	authClient      generatedAuthV1.AuthServiceClient
+}
+
+func NewIAMClient(genClient generatedUserV1.UserServiceClient, authClient generatedAuthV1.AuthServiceClient) *client {
+	return &client{
+		generatedClient: genClient,
+		authClient:      authClient,
+	}
+}
@@ -0,0 +1,44 @@
+package v1
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/crafty-ezhik/rocket-factory/notification/internal/model"
+	genCommonV1 "github.com/crafty-ezhik/rocket-factory/shared/pkg/proto/common/v1"
+	genUserV1 "github.com/crafty-ezhik/rocket-factory/shared/pkg/proto/user/v1"
+)
+
+func (c *client) GetUser(ctx context.Context, userUUID uuid.UUID) (model.User, error) {
+	resp, err := c.generatedClient.GetUser(ctx, &genUserV1.GetUserRequest{
+		UserUuid: userUUID.String(),
+	})
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return model.User{}, model.ErrUserNotFound
+		}
+		return model.User{}, err
+	}
+
+	return userToModel(userUUID, resp.GetUser().GetInfo()), nil
+}
+
+func userToModel(userUUID uuid.UUID, info *genCommonV1.UserInfo) model.User {
+	methods := info.GetNotificationMethod()
+	user := model.User{
+		UUID:                userUUID,
+		NotificationMethods: make([]model.NotificationMethod, 0, len(methods)),
+		Locale:              info.GetLocale(),
+		Timezone:            info.GetTimezone(),
+	}
+	for _, method := range methods {
+		user.NotificationMethods = append(user.NotificationMethods, model.NotificationMethod{
+			ProviderName: method.GetProviderName(),
+			Target:       method.GetTarget(),
+		})
+	}
+	return user
+}
@@ -0,0 +1,32 @@
+package v1
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/crafty-ezhik/rocket-factory/notification/internal/model"
+	genAuthV1 "github.com/crafty-ezhik/rocket-factory/shared/pkg/proto/auth/v1"
+)
+
+func (c *client) Whoami(ctx context.Context, sessionUUID uuid.UUID) (model.User, error) {
+	resp, err := c.authClient.Whoami(ctx, &genAuthV1.WhoamiRequest{
+		SessionUuid: sessionUUID.String(),
+	})
+	if err != nil {
+		switch status.Code(err) {
+		case codes.Unauthenticated, codes.NotFound:
+			return model.User{}, model.ErrSessionExpired
+		}
+		return model.User{}, err
+	}
+
+	userUUID, err := uuid.Parse(resp.GetUser().GetUuid())
+	if err != nil {
+		return model.User{}, err
+	}
+
+	return userToModel(userUUID, resp.GetUser().GetInfo()), nil
+}
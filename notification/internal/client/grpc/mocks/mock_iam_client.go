@@ -0,0 +1,244 @@
+// Code generated for crafty-ezhik service
+// © Crafty-ezhik 2025.
+
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/crafty-ezhik/rocket-factory/notification/internal/model"
+	"github.com/google/uuid"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockIAMClient creates a new instance of MockIAMClient. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockIAMClient(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockIAMClient {
+	mock := &MockIAMClient{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockIAMClient is an autogenerated mock type for the IAMClient type
+type MockIAMClient struct {
+	mock.Mock
+}
+
+type MockIAMClient_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockIAMClient) EXPECT() *MockIAMClient_Expecter {
+	return &MockIAMClient_Expecter{mock: &_m.Mock}
+}
+
+// AddNotificationMethod provides a mock function for the type MockIAMClient
+func (_mock *MockIAMClient) AddNotificationMethod(ctx context.Context, sessionUUID uuid.UUID, userUUID uuid.UUID, method model.NotificationMethod) error {
+	ret := _mock.Called(ctx, sessionUUID, userUUID, method)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddNotificationMethod")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, model.NotificationMethod) error); ok {
+		r0 = returnFunc(ctx, sessionUUID, userUUID, method)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockIAMClient_AddNotificationMethod_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddNotificationMethod'
+type MockIAMClient_AddNotificationMethod_Call struct {
+	*mock.Call
+}
+
+// AddNotificationMethod is a helper method to define mock.On call
+//   - ctx context.Context
+//   - sessionUUID uuid.UUID
+//   - userUUID uuid.UUID
+//   - method model.NotificationMethod
+func (_e *MockIAMClient_Expecter) AddNotificationMethod(ctx interface{}, sessionUUID interface{}, userUUID interface{}, method interface{}) *MockIAMClient_AddNotificationMethod_Call {
+	return &MockIAMClient_AddNotificationMethod_Call{Call: _e.mock.On("AddNotificationMethod", ctx, sessionUUID, userUUID, method)}
+}
+
+func (_c *MockIAMClient_AddNotificationMethod_Call) Run(run func(ctx context.Context, sessionUUID uuid.UUID, userUUID uuid.UUID, method model.NotificationMethod)) *MockIAMClient_AddNotificationMethod_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 uuid.UUID
+		if args[2] != nil {
+			arg2 = args[2].(uuid.UUID)
+		}
+		var arg3 model.NotificationMethod
+		if args[3] != nil {
+			arg3 = args[3].(model.NotificationMethod)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIAMClient_AddNotificationMethod_Call) Return(err error) *MockIAMClient_AddNotificationMethod_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockIAMClient_AddNotificationMethod_Call) RunAndReturn(run func(ctx context.Context, sessionUUID uuid.UUID, userUUID uuid.UUID, method model.NotificationMethod) error) *MockIAMClient_AddNotificationMethod_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetUser provides a mock function for the type MockIAMClient
+func (_mock *MockIAMClient) GetUser(ctx context.Context, userUUID uuid.UUID) (model.User, error) {
+	ret := _mock.Called(ctx, userUUID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUser")
+	}
+
+	var r0 model.User
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (model.User, error)); ok {
+		return returnFunc(ctx, userUUID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) model.User); ok {
+		r0 = returnFunc(ctx, userUUID)
+	} else {
+		r0 = ret.Get(0).(model.User)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, userUUID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIAMClient_GetUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUser'
+type MockIAMClient_GetUser_Call struct {
+	*mock.Call
+}
+
+// GetUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userUUID uuid.UUID
+func (_e *MockIAMClient_Expecter) GetUser(ctx interface{}, userUUID interface{}) *MockIAMClient_GetUser_Call {
+	return &MockIAMClient_GetUser_Call{Call: _e.mock.On("GetUser", ctx, userUUID)}
+}
+
+func (_c *MockIAMClient_GetUser_Call) Run(run func(ctx context.Context, userUUID uuid.UUID)) *MockIAMClient_GetUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIAMClient_GetUser_Call) Return(user model.User, err error) *MockIAMClient_GetUser_Call {
+	_c.Call.Return(user, err)
+	return _c
+}
+
+func (_c *MockIAMClient_GetUser_Call) RunAndReturn(run func(ctx context.Context, userUUID uuid.UUID) (model.User, error)) *MockIAMClient_GetUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Whoami provides a mock function for the type MockIAMClient
+func (_mock *MockIAMClient) Whoami(ctx context.Context, sessionUUID uuid.UUID) (model.User, error) {
+	ret := _mock.Called(ctx, sessionUUID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Whoami")
+	}
+
+	var r0 model.User
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (model.User, error)); ok {
+		return returnFunc(ctx, sessionUUID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) model.User); ok {
+		r0 = returnFunc(ctx, sessionUUID)
+	} else {
+		r0 = ret.Get(0).(model.User)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, sessionUUID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIAMClient_Whoami_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Whoami'
+type MockIAMClient_Whoami_Call struct {
+	*mock.Call
+}
+
+// Whoami is a helper method to define mock.On call
+//   - ctx context.Context
+//   - sessionUUID uuid.UUID
+func (_e *MockIAMClient_Expecter) Whoami(ctx interface{}, sessionUUID interface{}) *MockIAMClient_Whoami_Call {
+	return &MockIAMClient_Whoami_Call{Call: _e.mock.On("Whoami", ctx, sessionUUID)}
+}
+
+func (_c *MockIAMClient_Whoami_Call) Run(run func(ctx context.Context, sessionUUID uuid.UUID)) *MockIAMClient_Whoami_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIAMClient_Whoami_Call) Return(user model.User, err error) *MockIAMClient_Whoami_Call {
+	_c.Call.Return(user, err)
+	return _c
+}
+
+func (_c *MockIAMClient_Whoami_Call) RunAndReturn(run func(ctx context.Context, sessionUUID uuid.UUID) (model.User, error)) *MockIAMClient_Whoami_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -1,7 +1,39 @@
 package http
 
-import "context"
+import (
+	"context"
 
+	"github.com/google/uuid"
+
+	"github.com/crafty-ezhik/rocket-factory/notification/internal/model"
+)
+
+// Notifier - канал доставки сообщений. target - адрес в формате канала: чат-id, email.
+// Для некорректного адреса возвращается model.ErrInvalidTarget
+type Notifier interface {
+	Notify(ctx context.Context, target string, msg model.Message) error
+}
+
+// TelegramClient - отправка в Bot API с parse_mode HTML. message должен быть корректным HTML Telegram,
+// значения в нем экранируются вызывающей стороной
 type TelegramClient interface {
+	Notifier
 	SendMessage(ctx context.Context, chatID int64, message string) error
+	// DeleteMessage - удаляет сообщение из чата. В личном чате бот может удалить и сообщение пользователя
+	DeleteMessage(ctx context.Context, chatID int64, messageID int) error
+}
+
+// WebhookClient - отправляет подписанный запрос на адрес интегратора.
+// Ответ с кодом не 2xx возвращается как *model.WebhookResponseError
+type WebhookClient interface {
+	Send(ctx context.Context, target string, req model.WebhookRequest) error
+}
+
+// OrderClient - HTTP API Order Service от имени пользователя с сессией sessionUUID.
+// Для недействительной сессии возвращается model.ErrSessionExpired
+type OrderClient interface {
+	// ListOrders - последние заказы пользователя, новые первыми
+	ListOrders(ctx context.Context, sessionUUID uuid.UUID, limit int) ([]model.Order, error)
+	// GetOrder - заказ пользователя. Чужой или несуществующий заказ - model.ErrOrderNotFound
+	GetOrder(ctx context.Context, sessionUUID, orderUUID uuid.UUID) (model.Order, error)
 }
@@ -0,0 +1,189 @@
+// Code generated for crafty-ezhik service
+// © Crafty-ezhik 2025.
+
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/crafty-ezhik/rocket-factory/notification/internal/model"
+	"github.com/google/uuid"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockOrderClient creates a new instance of MockOrderClient. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockOrderClient(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockOrderClient {
+	mock := &MockOrderClient{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockOrderClient is an autogenerated mock type for the OrderClient type
+type MockOrderClient struct {
+	mock.Mock
+}
+
+type MockOrderClient_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockOrderClient) EXPECT() *MockOrderClient_Expecter {
+	return &MockOrderClient_Expecter{mock: &_m.Mock}
+}
+
+// GetOrder provides a mock function for the type MockOrderClient
+func (_mock *MockOrderClient) GetOrder(ctx context.Context, sessionUUID uuid.UUID, orderUUID uuid.UUID) (model.Order, error) {
+	ret := _mock.Called(ctx, sessionUUID, orderUUID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOrder")
+	}
+
+	var r0 model.Order
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) (model.Order, error)); ok {
+		return returnFunc(ctx, sessionUUID, orderUUID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) model.Order); ok {
+		r0 = returnFunc(ctx, sessionUUID, orderUUID)
+	} else {
+		r0 = ret.Get(0).(model.Order)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, sessionUUID, orderUUID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockOrderClient_GetOrder_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetOrder'
+type MockOrderClient_GetOrder_Call struct {
+	*mock.Call
+}
+
+// GetOrder is a helper method to define mock.On call
+//   - ctx context.Context
+//   - sessionUUID uuid.UUID
+//   - orderUUID uuid.UUID
+func (_e *MockOrderClient_Expecter) GetOrder(ctx interface{}, sessionUUID interface{}, orderUUID interface{}) *MockOrderClient_GetOrder_Call {
+	return &MockOrderClient_GetOrder_Call{Call: _e.mock.On("GetOrder", ctx, sessionUUID, orderUUID)}
+}
+
+func (_c *MockOrderClient_GetOrder_Call) Run(run func(ctx context.Context, sessionUUID uuid.UUID, orderUUID uuid.UUID)) *MockOrderClient_GetOrder_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 uuid.UUID
+		if args[2] != nil {
+			arg2 = args[2].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockOrderClient_GetOrder_Call) Return(order model.Order, err error) *MockOrderClient_GetOrder_Call {
+	_c.Call.Return(order, err)
+	return _c
+}
+
+func (_c *MockOrderClient_GetOrder_Call) RunAndReturn(run func(ctx context.Context, sessionUUID uuid.UUID, orderUUID uuid.UUID) (model.Order, error)) *MockOrderClient_GetOrder_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListOrders provides a mock function for the type MockOrderClient
+func (_mock *MockOrderClient) ListOrders(ctx context.Context, sessionUUID uuid.UUID, limit int) ([]model.Order, error) {
+	ret := _mock.Called(ctx, sessionUUID, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListOrders")
+	}
+
+	var r0 []model.Order
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, int) ([]model.Order, error)); ok {
+		return returnFunc(ctx, sessionUUID, limit)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, int) []model.Order); ok {
+		r0 = returnFunc(ctx, sessionUUID, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.Order)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, int) error); ok {
+		r1 = returnFunc(ctx, sessionUUID, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockOrderClient_ListOrders_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListOrders'
+type MockOrderClient_ListOrders_Call struct {
+	*mock.Call
+}
+
+// ListOrders is a helper method to define mock.On call
+//   - ctx context.Context
+//   - sessionUUID uuid.UUID
+//   - limit int
+func (_e *MockOrderClient_Expecter) ListOrders(ctx interface{}, sessionUUID interface{}, limit interface{}) *MockOrderClient_ListOrders_Call {
+	return &MockOrderClient_ListOrders_Call{Call: _e.mock.On("ListOrders", ctx, sessionUUID, limit)}
+}
+
+func (_c *MockOrderClient_ListOrders_Call) Run(run func(ctx context.Context, sessionUUID uuid.UUID, limit int)) *MockOrderClient_ListOrders_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 int
+		if args[2] != nil {
+			arg2 = args[2].(int)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockOrderClient_ListOrders_Call) Return(orders []model.Order, err error) *MockOrderClient_ListOrders_Call {
+	_c.Call.Return(orders, err)
+	return _c
+}
+
+func (_c *MockOrderClient_ListOrders_Call) RunAndReturn(run func(ctx context.Context, sessionUUID uuid.UUID, limit int) ([]model.Order, error)) *MockOrderClient_ListOrders_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -0,0 +1,231 @@
+// Code generated for crafty-ezhik service
+// © Crafty-ezhik 2025.
+
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/crafty-ezhik/rocket-factory/notification/internal/model"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockTelegramClient creates a new instance of MockTelegramClient. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockTelegramClient(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockTelegramClient {
+	mock := &MockTelegramClient{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockTelegramClient is an autogenerated mock type for the TelegramClient type
+type MockTelegramClient struct {
+	mock.Mock
+}
+
+type MockTelegramClient_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockTelegramClient) EXPECT() *MockTelegramClient_Expecter {
+	return &MockTelegramClient_Expecter{mock: &_m.Mock}
+}
+
+// DeleteMessage provides a mock function for the type MockTelegramClient
+func (_mock *MockTelegramClient) DeleteMessage(ctx context.Context, chatID int64, messageID int) error {
+	ret := _mock.Called(ctx, chatID, messageID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteMessage")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, int) error); ok {
+		r0 = returnFunc(ctx, chatID, messageID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockTelegramClient_DeleteMessage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteMessage'
+type MockTelegramClient_DeleteMessage_Call struct {
+	*mock.Call
+}
+
+// DeleteMessage is a helper method to define mock.On call
+//   - ctx context.Context
+//   - chatID int64
+//   - messageID int
+func (_e *MockTelegramClient_Expecter) DeleteMessage(ctx interface{}, chatID interface{}, messageID interface{}) *MockTelegramClient_DeleteMessage_Call {
+	return &MockTelegramClient_DeleteMessage_Call{Call: _e.mock.On("DeleteMessage", ctx, chatID, messageID)}
+}
+
+func (_c *MockTelegramClient_DeleteMessage_Call) Run(run func(ctx context.Context, chatID int64, messageID int)) *MockTelegramClient_DeleteMessage_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int64
+		if args[1] != nil {
+			arg1 = args[1].(int64)
+		}
+		var arg2 int
+		if args[2] != nil {
+			arg2 = args[2].(int)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockTelegramClient_DeleteMessage_Call) Return(err error) *MockTelegramClient_DeleteMessage_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockTelegramClient_DeleteMessage_Call) RunAndReturn(run func(ctx context.Context, chatID int64, messageID int) error) *MockTelegramClient_DeleteMessage_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Notify provides a mock function for the type MockTelegramClient
+func (_mock *MockTelegramClient) Notify(ctx context.Context, target string, msg model.Message) error {
+	ret := _mock.Called(ctx, target, msg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Notify")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, model.Message) error); ok {
+		r0 = returnFunc(ctx, target, msg)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockTelegramClient_Notify_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Notify'
+type MockTelegramClient_Notify_Call struct {
+	*mock.Call
+}
+
+// Notify is a helper method to define mock.On call
+//   - ctx context.Context
+//   - target string
+//   - msg model.Message
+func (_e *MockTelegramClient_Expecter) Notify(ctx interface{}, target interface{}, msg interface{}) *MockTelegramClient_Notify_Call {
+	return &MockTelegramClient_Notify_Call{Call: _e.mock.On("Notify", ctx, target, msg)}
+}
+
+func (_c *MockTelegramClient_Notify_Call) Run(run func(ctx context.Context, target string, msg model.Message)) *MockTelegramClient_Notify_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 model.Message
+		if args[2] != nil {
+			arg2 = args[2].(model.Message)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockTelegramClient_Notify_Call) Return(err error) *MockTelegramClient_Notify_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockTelegramClient_Notify_Call) RunAndReturn(run func(ctx context.Context, target string, msg model.Message) error) *MockTelegramClient_Notify_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SendMessage provides a mock function for the type MockTelegramClient
+func (_mock *MockTelegramClient) SendMessage(ctx context.Context, chatID int64, message string) error {
+	ret := _mock.Called(ctx, chatID, message)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SendMessage")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, string) error); ok {
+		r0 = returnFunc(ctx, chatID, message)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockTelegramClient_SendMessage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SendMessage'
+type MockTelegramClient_SendMessage_Call struct {
+	*mock.Call
+}
+
+// SendMessage is a helper method to define mock.On call
+//   - ctx context.Context
+//   - chatID int64
+//   - message string
+func (_e *MockTelegramClient_Expecter) SendMessage(ctx interface{}, chatID interface{}, message interface{}) *MockTelegramClient_SendMessage_Call {
+	return &MockTelegramClient_SendMessage_Call{Call: _e.mock.On("SendMessage", ctx, chatID, message)}
+}
+
+func (_c *MockTelegramClient_SendMessage_Call) Run(run func(ctx context.Context, chatID int64, message string)) *MockTelegramClient_SendMessage_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int64
+		if args[1] != nil {
+			arg1 = args[1].(int64)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockTelegramClient_SendMessage_Call) Return(err error) *MockTelegramClient_SendMessage_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockTelegramClient_SendMessage_Call) RunAndReturn(run func(ctx context.Context, chatID int64, message string) error) *MockTelegramClient_SendMessage_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -0,0 +1,105 @@
+// Code generated for crafty-ezhik service
+// © Crafty-ezhik 2025.
+
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/crafty-ezhik/rocket-factory/notification/internal/model"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockWebhookClient creates a new instance of MockWebhookClient. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockWebhookClient(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockWebhookClient {
+	mock := &MockWebhookClient{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockWebhookClient is an autogenerated mock type for the WebhookClient type
+type MockWebhookClient struct {
+	mock.Mock
+}
+
+type MockWebhookClient_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockWebhookClient) EXPECT() *MockWebhookClient_Expecter {
+	return &MockWebhookClient_Expecter{mock: &_m.Mock}
+}
+
+// Send provides a mock function for the type MockWebhookClient
+func (_mock *MockWebhookClient) Send(ctx context.Context, target string, req model.WebhookRequest) error {
+	ret := _mock.Called(ctx, target, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Send")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, model.WebhookRequest) error); ok {
+		r0 = returnFunc(ctx, target, req)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockWebhookClient_Send_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Send'
+type MockWebhookClient_Send_Call struct {
+	*mock.Call
+}
+
+// Send is a helper method to define mock.On call
+//   - ctx context.Context
+//   - target string
+//   - req model.WebhookRequest
+func (_e *MockWebhookClient_Expecter) Send(ctx interface{}, target interface{}, req interface{}) *MockWebhookClient_Send_Call {
+	return &MockWebhookClient_Send_Call{Call: _e.mock.On("Send", ctx, target, req)}
+}
+
+func (_c *MockWebhookClient_Send_Call) Run(run func(ctx context.Context, target string, req model.WebhookRequest)) *MockWebhookClient_Send_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 model.WebhookRequest
+		if args[2] != nil {
+			arg2 = args[2].(model.WebhookRequest)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockWebhookClient_Send_Call) Return(err error) *MockWebhookClient_Send_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockWebhookClient_Send_Call) RunAndReturn(run func(ctx context.Context, target string, req model.WebhookRequest) error) *MockWebhookClient_Send_Call {
+	_c.Call.Return(run)
+	return _c
+}
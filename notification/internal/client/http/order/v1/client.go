@@ -0,0 +1,18 @@
+package v1
+
+import (
+	def "github.com/crafty-ezhik/rocket-factory/notification/internal/client/http"
+	orderV1 "github.com/crafty-ezhik/rocket-factory/shared/pkg/openapi/order/v1"
+)
+
+var _ def.OrderClient = (*client)(nil)
+
+type client struct {
+	generatedClient *orderV1.Client
+}
+
+func NewOrderClient(genClient *orderV1.Client) *client {
+	return &client{
+		generatedClient: genClient,
+	}
+}
@@ -0,0 +1,34 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/crafty-ezhik/rocket-factory/notification/internal/client/converter"
+	"github.com/crafty-ezhik/rocket-factory/notification/internal/model"
+	orderV1 "github.com/crafty-ezhik/rocket-factory/shared/pkg/openapi/order/v1"
+)
+
+func (c *client) GetOrder(ctx context.Context, sessionUUID, orderUUID uuid.UUID) (model.Order, error) {
+	res, err := c.generatedClient.OrderGet(ctx, orderV1.OrderGetParams{
+		XSessionUUID: orderV1.NewOptUUID(sessionUUID),
+		OrderUUID:    orderUUID.String(),
+	})
+	if err != nil {
+		return model.Order{}, err
+	}
+
+	switch resp := res.(type) {
+	case *orderV1.OrderDto:
+		return converter.OrderToModel(*resp), nil
+	case *orderV1.UnauthorizedError:
+		return model.Order{}, model.ErrSessionExpired
+	// Чужой заказ не отличаем от несуществующего
+	case *orderV1.NotFoundError, *orderV1.ForbiddenError:
+		return model.Order{}, model.ErrOrderNotFound
+	default:
+		return model.Order{}, fmt.Errorf("get order: unexpected response %T", res)
+	}
+}
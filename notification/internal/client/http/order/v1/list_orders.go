@@ -0,0 +1,32 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/crafty-ezhik/rocket-factory/notification/internal/client/converter"
+	"github.com/crafty-ezhik/rocket-factory/notification/internal/model"
+	orderV1 "github.com/crafty-ezhik/rocket-factory/shared/pkg/openapi/order/v1"
+)
+
+func (c *client) ListOrders(ctx context.Context, sessionUUID uuid.UUID, limit int) ([]model.Order, error) {
+	res, err := c.generatedClient.OrderList(ctx, orderV1.OrderListParams{
+		XSessionUUID: orderV1.NewOptUUID(sessionUUID),
+		Sort:         orderV1.NewOptOrderSort(orderV1.OrderSortCREATEDATDESC),
+		Limit:        orderV1.NewOptInt(limit),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	switch resp := res.(type) {
+	case *orderV1.ListOrdersResponse:
+		return converter.OrdersToModel(resp.GetOrders()), nil
+	case *orderV1.UnauthorizedError:
+		return nil, model.ErrSessionExpired
+	default:
+		return nil, fmt.Errorf("list orders: unexpected response %T", res)
+	}
+}
@@ -2,28 +2,98 @@ package telegram
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"html"
+	"strconv"
+	"time"
 
 	"github.com/go-telegram/bot"
+	"github.com/go-telegram/bot/models"
+
+	def "github.com/crafty-ezhik/rocket-factory/notification/internal/client/http"
+	"github.com/crafty-ezhik/rocket-factory/notification/internal/model"
 )
 
+var _ def.TelegramClient = (*client)(nil)
+
+// Config - ограничения отправки в Bot API
+type Config struct {
+	// RatePerSecond - сколько сообщений в секунду отправляет бот во все чаты
+	RatePerSecond int
+	// MaxRetries - сколько раз повторять сообщение после ответа 429
+	MaxRetries int
+}
+
 type client struct {
-	bot *bot.Bot
+	bot        *bot.Bot
+	limiter    *limiter
+	maxRetries int
 }
 
-func NewClient(bot *bot.Bot) *client {
+func NewClient(bot *bot.Bot, cfg Config) *client {
 	return &client{
-		bot: bot,
+		bot:        bot,
+		limiter:    newLimiter(time.Second / time.Duration(max(cfg.RatePerSecond, 1))),
+		maxRetries: cfg.MaxRetries,
 	}
 }
 
-func (c *client) SendMessage(ctx context.Context, chatID int64, message string) error {
-	_, err := c.bot.SendMessage(ctx, &bot.SendMessageParams{
-		ChatID:    chatID,
-		Text:      message,
-		ParseMode: "Markdown",
-	})
+// Notify - отправляет сообщение в чат. target - идентификатор чата.
+// Используется HTML-версия сообщения, а при ее отсутствии экранированный текст
+func (c *client) Notify(ctx context.Context, target string, msg model.Message) error {
+	chatID, err := strconv.ParseInt(target, 10, 64)
 	if err != nil {
-		return err
+		return fmt.Errorf("%w: telegram chat id %q", model.ErrInvalidTarget, target)
+	}
+
+	text := msg.HTML
+	if text == "" {
+		text = html.EscapeString(msg.Text)
+	}
+	return c.SendMessage(ctx, chatID, text)
+}
+
+// SendMessage - отправляет HTML-текст в чат. Текст длиннее лимита Bot API отправляется несколькими сообщениями
+func (c *client) SendMessage(ctx context.Context, chatID int64, message string) error {
+	for _, chunk := range splitMessage(message, maxMessageLength) {
+		if err := c.send(ctx, chatID, chunk); err != nil {
+			return err
+		}
 	}
 	return nil
 }
+
+// send - отправляет одно сообщение. На ответ 429 бот замолкает на retry_after для всех чатов
+func (c *client) send(ctx context.Context, chatID int64, text string) error {
+	for attempt := 0; ; attempt++ {
+		if err := c.limiter.Wait(ctx); err != nil {
+			return err
+		}
+
+		_, err := c.bot.SendMessage(ctx, &bot.SendMessageParams{
+			ChatID:    chatID,
+			Text:      text,
+			ParseMode: models.ParseModeHTML,
+		})
+
+		var tooManyErr *bot.TooManyRequestsError
+		if !errors.As(err, &tooManyErr) || attempt >= c.maxRetries {
+			return err
+		}
+
+		c.limiter.Pause(time.Duration(max(tooManyErr.RetryAfter, 1)) * time.Second)
+	}
+}
+
+func (c *client) DeleteMessage(ctx context.Context, chatID int64, messageID int) error {
+	if err := c.limiter.Wait(ctx); err != nil {
+		return err
+	}
+
+	_, err := c.bot.DeleteMessage(ctx, &bot.DeleteMessageParams{
+		ChatID:    chatID,
+		MessageID: messageID,
+	})
+	return err
+}
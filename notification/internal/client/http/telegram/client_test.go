@@ -0,0 +1,239 @@
+package telegram_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+	"unicode/utf16"
+
+	"github.com/go-telegram/bot"
+	"github.com/stretchr/testify/suite"
+
+	def "github.com/crafty-ezhik/rocket-factory/notification/internal/client/http"
+	"github.com/crafty-ezhik/rocket-factory/notification/internal/client/http/telegram"
+	"github.com/crafty-ezhik/rocket-factory/notification/internal/model"
+)
+
+const testToken = "123456:test-token"
+
+// sentMessage - запрос sendMessage, полученный фейковым Bot API
+type sentMessage struct {
+	ChatID    string
+	Text      string
+	ParseMode string
+}
+
+// fakeBotAPI - локальный Bot API: запоминает сообщения и отвечает 429 на первые tooManyRequests запросов
+type fakeBotAPI struct {
+	mu              sync.Mutex
+	messages        []sentMessage
+	requests        int
+	tooManyRequests int
+}
+
+func (f *fakeBotAPI) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path != "/bot"+testToken+"/sendMessage" {
+		http.NotFound(w, r)
+		return
+	}
+	if err := r.ParseMultipartForm(1 << 20); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.requests++
+	w.Header().Set("Content-Type", "application/json")
+	if f.requests <= f.tooManyRequests {
+		w.WriteHeader(http.StatusTooManyRequests)
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"ok":          false,
+			"error_code":  http.StatusTooManyRequests,
+			"description": "Too Many Requests: retry after 1",
+			"parameters":  map[string]any{"retry_after": 1},
+		})
+		return
+	}
+
+	f.messages = append(f.messages, sentMessage{
+		ChatID:    r.FormValue("chat_id"),
+		Text:      r.FormValue("text"),
+		ParseMode: r.FormValue("parse_mode"),
+	})
+	_ = json.NewEncoder(w).Encode(map[string]any{
+		"ok": true,
+		"result": map[string]any{
+			"message_id": len(f.messages),
+			"date":       time.Now().Unix(),
+			"chat":       map[string]any{"id": 42, "type": "private"},
+		},
+	})
+}
+
+func (f *fakeBotAPI) sent() []sentMessage {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]sentMessage(nil), f.messages...)
+}
+
+type ClientSuite struct {
+	suite.Suite
+
+	ctx context.Context //nolint:containedctx
+
+	api *fakeBotAPI
+	srv *httptest.Server
+}
+
+func (s *ClientSuite) SetupTest() {
+	s.ctx = context.Background()
+	s.api = &fakeBotAPI{}
+	s.srv = httptest.NewServer(s.api)
+}
+
+func (s *ClientSuite) TearDownTest() {
+	s.srv.Close()
+}
+
+func TestClient(t *testing.T) {
+	suite.Run(t, new(ClientSuite))
+}
+
+func (s *ClientSuite) newClient(maxRetries int) def.TelegramClient {
+	b, err := bot.New(testToken, bot.WithServerURL(s.srv.URL), bot.WithSkipGetMe())
+	s.Require().NoError(err)
+
+	return telegram.NewClient(b, telegram.Config{RatePerSecond: 1000, MaxRetries: maxRetries})
+}
+
+func (s *ClientSuite) TestNotifySendsHTML() {
+	err := s.newClient(0).Notify(s.ctx, "42", model.Message{
+		Text: "plain",
+		HTML: "<b>Order:</b> a_b*c",
+	})
+	s.Require().NoError(err)
+
+	s.Equal([]sentMessage{{ChatID: "42", Text: "<b>Order:</b> a_b*c", ParseMode: "HTML"}}, s.api.sent())
+}
+
+func (s *ClientSuite) TestNotifyEscapesPlainText() {
+	err := s.newClient(0).Notify(s.ctx, "42", model.Message{Text: "1 < 2 & <b>"})
+	s.Require().NoError(err)
+
+	sent := s.api.sent()
+	s.Require().Len(sent, 1)
+	s.Equal("1 &lt; 2 &amp; &lt;b&gt;", sent[0].Text)
+}
+
+func (s *ClientSuite) TestNotifyRetriesAfterTooManyRequests() {
+	s.api.tooManyRequests = 1
+
+	start := time.Now()
+	err := s.newClient(3).Notify(s.ctx, "42", model.Message{HTML: "hello"})
+	s.Require().NoError(err)
+
+	s.GreaterOrEqual(time.Since(start), time.Second, "повтор должен ждать retry_after")
+	s.Len(s.api.sent(), 1)
+	s.Equal(2, s.api.requests)
+}
+
+func (s *ClientSuite) TestNotifyGivesUpAfterMaxRetries() {
+	s.api.tooManyRequests = 10
+
+	err := s.newClient(0).Notify(s.ctx, "42", model.Message{HTML: "hello"})
+
+	var tooManyErr *bot.TooManyRequestsError
+	s.Require().ErrorAs(err, &tooManyErr)
+	s.Equal(1, tooManyErr.RetryAfter)
+	s.Empty(s.api.sent())
+}
+
+func (s *ClientSuite) TestNotifySplitsLongMessage() {
+	line := "📦 <b>Line</b> &amp; " + strings.Repeat("x", 80)
+	lines := make([]string, 100)
+	for i := range lines {
+		lines[i] = line
+	}
+	text := strings.Join(lines, "\n")
+
+	err := s.newClient(0).Notify(s.ctx, "42", model.Message{HTML: text})
+	s.Require().NoError(err)
+
+	sent := s.api.sent()
+	s.Require().Greater(len(sent), 1)
+
+	parts := make([]string, 0, len(sent))
+	for _, msg := range sent {
+		s.LessOrEqual(len(utf16.Encode([]rune(msg.Text))), 4096)
+		parts = append(parts, msg.Text)
+	}
+	s.Equal(text, strings.Join(parts, "\n"))
+}
+
+func (s *ClientSuite) TestNotifySplitsLongLineOutsideEntities() {
+	text := strings.Repeat("a&amp;", 1000)
+
+	err := s.newClient(0).Notify(s.ctx, "42", model.Message{HTML: text})
+	s.Require().NoError(err)
+
+	sent := s.api.sent()
+	s.Require().Len(sent, 2)
+	for _, msg := range sent {
+		s.LessOrEqual(len(msg.Text), 4096)
+		s.True(strings.HasSuffix(msg.Text, "&amp;") || strings.HasSuffix(msg.Text, "a"), "сущность не должна разрываться")
+	}
+	s.Equal(text, sent[0].Text+sent[1].Text)
+}
+
+func (s *ClientSuite) TestNotifySplitsLongLineInsideTags() {
+	text := "Заказ <b>" + strings.Repeat("x", 3000) + ` <a href="https://example.com/orders">` +
+		strings.Repeat("y", 3000) + "</a></b> &amp; готово"
+
+	err := s.newClient(0).Notify(s.ctx, "42", model.Message{HTML: text})
+	s.Require().NoError(err)
+
+	sent := s.api.sent()
+	s.Require().Len(sent, 2)
+	for _, msg := range sent {
+		s.LessOrEqual(len(utf16.Encode([]rune(msg.Text))), 4096)
+		s.True(balancedTags(msg.Text), "теги части должны быть сбалансированы: %q", msg.Text)
+	}
+
+	// Разрез пришелся внутрь <b>: первая часть закрывает тег, вторая открывает его заново
+	s.True(strings.HasSuffix(sent[0].Text, "</b>"))
+	s.True(strings.HasPrefix(sent[1].Text, "<b>"))
+}
+
+// balancedTags - каждый закрывающий тег закрывает последний открытый, незакрытых тегов не остается
+func balancedTags(text string) bool {
+	var open []string
+	for _, tag := range htmlTagRe.FindAllStringSubmatch(text, -1) {
+		name := tag[2]
+		if tag[1] == "" {
+			open = append(open, name)
+			continue
+		}
+		if len(open) == 0 || open[len(open)-1] != name {
+			return false
+		}
+		open = open[:len(open)-1]
+	}
+	return len(open) == 0
+}
+
+var htmlTagRe = regexp.MustCompile(`<(/?)([a-z-]+)[^>]*>`)
+
+func (s *ClientSuite) TestNotifyInvalidTarget() {
+	err := s.newClient(0).Notify(s.ctx, "not-a-chat", model.Message{HTML: "hello"})
+
+	s.ErrorIs(err, model.ErrInvalidTarget)
+	s.Zero(s.api.requests)
+}
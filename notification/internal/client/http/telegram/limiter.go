@@ -0,0 +1,58 @@
+package telegram
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// limiter - очередь запросов к Bot API: не чаще одного запроса в interval.
+// После ответа 429 следующий запрос откладывается на retry_after
+type limiter struct {
+	interval time.Duration
+
+	mu   sync.Mutex
+	next time.Time
+}
+
+func newLimiter(interval time.Duration) *limiter {
+	return &limiter{interval: interval}
+}
+
+// Wait - занимает очередное окно и ждет его начала
+func (l *limiter) Wait(ctx context.Context) error {
+	l.mu.Lock()
+	now := time.Now()
+	at := l.next
+	if at.Before(now) {
+		at = now
+	}
+	l.next = at.Add(l.interval)
+	l.mu.Unlock()
+
+	delay := at.Sub(now)
+	if delay <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// Pause - запрещает запросы на d, уже занятые окна сдвигаются
+func (l *limiter) Pause(d time.Duration) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	until := time.Now().Add(d)
+	if l.next.Before(until) {
+		l.next = until
+	}
+}
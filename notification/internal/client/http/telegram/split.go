@@ -0,0 +1,184 @@
+package telegram
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// maxMessageLength - предел Bot API на длину текста сообщения в символах UTF-16
+const maxMessageLength = 4096
+
+// splitMessage - делит HTML-текст на части не длиннее limit.
+// Текст режется по строкам, поэтому теги в шаблонах не должны переходить через перенос строки.
+// Строка длиннее limit режется по символам, но не внутри тега или HTML-сущности, открытые теги переносятся в следующую часть
+func splitMessage(text string, limit int) []string {
+	if utf16Len(text) <= limit {
+		return []string{text}
+	}
+
+	var (
+		chunks []string
+		cur    strings.Builder
+		curLen int
+	)
+	flush := func() {
+		if chunk := strings.TrimRight(cur.String(), "\n"); chunk != "" {
+			chunks = append(chunks, chunk)
+		}
+		cur.Reset()
+		curLen = 0
+	}
+
+	for _, line := range strings.SplitAfter(text, "\n") {
+		lineLen := utf16Len(line)
+		if curLen+lineLen > limit {
+			flush()
+		}
+		if lineLen > limit {
+			for _, part := range splitLine(line, limit) {
+				if part = strings.TrimRight(part, "\n"); part != "" {
+					chunks = append(chunks, part)
+				}
+			}
+			continue
+		}
+		cur.WriteString(line)
+		curLen += lineLen
+	}
+	flush()
+
+	return chunks
+}
+
+// splitLine - режет строку по символам. Резать можно только вне тега <...> и сущности &...;.
+// Теги, открытые на месте разреза, закрываются в конце части и открываются заново в начале следующей,
+// чтобы каждая часть оставалась корректным HTML для Bot API
+func splitLine(line string, limit int) []string {
+	var (
+		parts []string
+		open  []htmlTag
+		cur   strings.Builder
+		size  int
+		// content - в части есть что-то кроме заново открытых тегов
+		content bool
+	)
+
+	for _, token := range htmlTokens(line) {
+		width := utf16Len(token)
+		next := nextOpenTags(open, token)
+
+		if content && size+width+closingLen(next) > limit {
+			cur.WriteString(closingTags(open))
+			parts = append(parts, cur.String())
+
+			cur.Reset()
+			size, content = 0, false
+			for _, tag := range open {
+				cur.WriteString(tag.open)
+				size += utf16Len(tag.open)
+			}
+		}
+
+		cur.WriteString(token)
+		size += width
+		content = true
+		open = next
+	}
+	return append(parts, cur.String())
+}
+
+// htmlTag - открытый тег: имя и открывающий тег целиком вместе с атрибутами
+type htmlTag struct {
+	name string
+	open string
+}
+
+// htmlTokens - делит строку на неделимые части: теги <...>, сущности &...; и отдельные символы
+func htmlTokens(line string) []string {
+	var tokens []string
+	for i := 0; i < len(line); {
+		end := i + 1
+		switch line[i] {
+		case '<':
+			end = tokenEnd(line, i, '>')
+		case '&':
+			end = tokenEnd(line, i, ';')
+		default:
+			_, width := utf8.DecodeRuneInString(line[i:])
+			end = i + width
+		}
+		tokens = append(tokens, line[i:end])
+		i = end
+	}
+	return tokens
+}
+
+// tokenEnd - позиция после символа closer, который завершает тег или сущность, начатые с позиции start
+func tokenEnd(line string, start int, closer byte) int {
+	if n := strings.IndexByte(line[start:], closer); n >= 0 {
+		return start + n + 1
+	}
+	return len(line)
+}
+
+// nextOpenTags - теги, открытые после token
+func nextOpenTags(open []htmlTag, token string) []htmlTag {
+	if len(token) < 3 || token[0] != '<' || token[len(token)-1] != '>' {
+		return open
+	}
+
+	if token[1] == '/' {
+		name := tagName(token[2:])
+		for i := len(open) - 1; i >= 0; i-- {
+			if open[i].name == name {
+				return open[:i:i]
+			}
+		}
+		return open
+	}
+
+	next := make([]htmlTag, len(open), len(open)+1)
+	copy(next, open)
+	return append(next, htmlTag{name: tagName(token[1:]), open: token})
+}
+
+// tagName - имя тега в начале s, до пробела, '/' или '>'
+func tagName(s string) string {
+	if n := strings.IndexAny(s, " \t\n/>"); n >= 0 {
+		return strings.ToLower(s[:n])
+	}
+	return strings.ToLower(s)
+}
+
+// closingTags - закрывающие теги для открытых тегов в обратном порядке
+func closingTags(open []htmlTag) string {
+	var b strings.Builder
+	for i := len(open) - 1; i >= 0; i-- {
+		b.WriteString("</" + open[i].name + ">")
+	}
+	return b.String()
+}
+
+func closingLen(open []htmlTag) int {
+	n := 0
+	for _, tag := range open {
+		n += len(tag.name) + 3
+	}
+	return n
+}
+
+// utf16Len - длина строки так, как ее считает Bot API
+func utf16Len(s string) int {
+	n := 0
+	for _, r := range s {
+		n += runeLen(r)
+	}
+	return n
+}
+
+func runeLen(r rune) int {
+	if r >= 0x10000 {
+		return 2
+	}
+	return 1
+}
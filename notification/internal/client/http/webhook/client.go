@@ -0,0 +1,91 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	def "github.com/crafty-ezhik/rocket-factory/notification/internal/client/http"
+	"github.com/crafty-ezhik/rocket-factory/notification/internal/model"
+)
+
+var _ def.WebhookClient = (*client)(nil)
+
+const (
+	userAgent = "rocket-factory-webhooks/1.0"
+	// maxDrainBytes - сколько байт ответа дочитывать, чтобы переиспользовать соединение
+	maxDrainBytes = 64 << 10
+)
+
+type client struct {
+	httpClient *http.Client
+	signingKey []byte
+	now        func() time.Time
+}
+
+// NewClient - клиент webhook. timeout ограничивает одну попытку доставки.
+// Адрес доставки задает пользователь, поэтому редиректы не выполняются (3xx считается ошибкой),
+// прокси из окружения не используются, а соединения с внутренними адресами запрещены, если не allowPrivateTargets
+func NewClient(signingKey string, timeout time.Duration, allowPrivateTargets bool) *client {
+	dialer := &net.Dialer{Timeout: timeout}
+	if !allowPrivateTargets {
+		dialer.Control = denyInternalAddresses
+	}
+
+	return &client{
+		httpClient: &http.Client{
+			Timeout: timeout,
+			Transport: &http.Transport{
+				DialContext:         dialer.DialContext,
+				TLSHandshakeTimeout: timeout,
+				MaxIdleConns:        100,
+				IdleConnTimeout:     90 * time.Second,
+			},
+			CheckRedirect: func(*http.Request, []*http.Request) error {
+				return http.ErrUseLastResponse
+			},
+		},
+		signingKey: []byte(signingKey),
+		now:        time.Now,
+	}
+}
+
+func (c *client) Send(ctx context.Context, target string, req model.WebhookRequest) error {
+	u, err := url.Parse(target)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf("%w: webhook url %q", model.ErrInvalidTarget, target)
+	}
+
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, u.String(), bytes.NewReader(req.Body))
+	if err != nil {
+		return fmt.Errorf("build webhook request: %w", err)
+	}
+
+	// Метка времени берется на каждую попытку, чтобы повтор не выглядел как replay
+	timestamp := c.now().Unix()
+	httpReq.Header.Set("Content-Type", "application/json")
+	httpReq.Header.Set("User-Agent", userAgent)
+	httpReq.Header.Set(HeaderEvent, req.EventType.String())
+	httpReq.Header.Set(HeaderDelivery, req.DeliveryUUID.String())
+	httpReq.Header.Set(HeaderTimestamp, strconv.FormatInt(timestamp, 10))
+	secret := MethodSecret(c.signingKey, req.UserUUID, target)
+	httpReq.Header.Set(HeaderSignature, Sign([]byte(secret), timestamp, req.Body))
+
+	resp, err := c.httpClient.Do(httpReq)
+	if err != nil {
+		return fmt.Errorf("send webhook: %w", err)
+	}
+	defer resp.Body.Close() //nolint:errcheck
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, maxDrainBytes))
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return &model.WebhookResponseError{StatusCode: resp.StatusCode}
+	}
+	return nil
+}
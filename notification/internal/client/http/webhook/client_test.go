@@ -0,0 +1,201 @@
+package webhook_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/crafty-ezhik/rocket-factory/notification/internal/client/http/webhook"
+	"github.com/crafty-ezhik/rocket-factory/notification/internal/model"
+)
+
+const testSecret = "test-signing-secret"
+
+type ClientSuite struct {
+	suite.Suite
+
+	ctx context.Context //nolint:containedctx
+}
+
+func (s *ClientSuite) SetupTest() {
+	s.ctx = context.Background()
+}
+
+func TestClient(t *testing.T) {
+	suite.Run(t, new(ClientSuite))
+}
+
+func (s *ClientSuite) TestSendSignsRequest() {
+	var (
+		gotHeader http.Header
+		gotBody   []byte
+	)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotHeader = r.Header.Clone()
+		gotBody, _ = io.ReadAll(r.Body)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	s.T().Cleanup(srv.Close)
+
+	req := model.WebhookRequest{
+		DeliveryUUID: uuid.New(),
+		UserUUID:     uuid.New(),
+		EventType:    model.EventOrderPaid,
+		Body:         []byte(`{"event_type":"order.paid"}`),
+	}
+	target := srv.URL + "/hooks"
+
+	// httptest слушает 127.0.0.1, поэтому внутренние адреса разрешаются явно
+	err := webhook.NewClient(testSecret, time.Second, true).Send(s.ctx, target, req)
+	s.Require().NoError(err)
+
+	s.Equal(req.Body, gotBody)
+	s.Equal("application/json", gotHeader.Get("Content-Type"))
+	s.Equal(req.EventType.String(), gotHeader.Get(webhook.HeaderEvent))
+	s.Equal(req.DeliveryUUID.String(), gotHeader.Get(webhook.HeaderDelivery))
+
+	timestamp, err := strconv.ParseInt(gotHeader.Get(webhook.HeaderTimestamp), 10, 64)
+	s.Require().NoError(err)
+	s.InDelta(time.Now().Unix(), timestamp, 5)
+
+	secret := []byte(webhook.MethodSecret([]byte(testSecret), req.UserUUID, target))
+	signature := gotHeader.Get(webhook.HeaderSignature)
+	s.True(webhook.Verify(secret, timestamp, gotBody, signature))
+	// Подпись привязана к метке времени: повтор с другой меткой не проходит проверку
+	s.False(webhook.Verify(secret, timestamp+1, gotBody, signature))
+	// Мастер-ключ и секреты других адресов подпись не проверяют
+	s.False(webhook.Verify([]byte(testSecret), timestamp, gotBody, signature))
+	otherTarget := []byte(webhook.MethodSecret([]byte(testSecret), req.UserUUID, srv.URL+"/other"))
+	s.False(webhook.Verify(otherTarget, timestamp, gotBody, signature))
+	otherUser := []byte(webhook.MethodSecret([]byte(testSecret), uuid.New(), target))
+	s.False(webhook.Verify(otherUser, timestamp, gotBody, signature))
+}
+
+func (s *ClientSuite) TestMethodSecret() {
+	userUUID := uuid.New()
+
+	secret := webhook.MethodSecret([]byte(testSecret), userUUID, "https://example.com/hook")
+	s.Len(secret, 64)
+	s.Equal(secret, webhook.MethodSecret([]byte(testSecret), userUUID, "https://example.com/hook"))
+	s.NotEqual(secret, webhook.MethodSecret([]byte("other-key"), userUUID, "https://example.com/hook"))
+	s.NotEqual(secret, webhook.MethodSecret([]byte(testSecret), userUUID, "https://example.com/hook2"))
+}
+
+func (s *ClientSuite) TestSendRejectsInternalTargets() {
+	var called bool
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		called = true
+		w.WriteHeader(http.StatusOK)
+	}))
+	s.T().Cleanup(srv.Close)
+
+	_, port, _ := strings.Cut(strings.TrimPrefix(srv.URL, "http://"), ":")
+
+	targets := []string{
+		srv.URL,
+		"http://localhost:" + port,
+		"http://[::1]:" + port,
+		"http://[::ffff:127.0.0.1]:" + port,
+		"http://0.0.0.0:" + port,
+		"http://10.0.0.1:" + port,
+		"http://192.168.1.1:" + port,
+		"http://100.64.0.1:" + port,
+		"http://169.254.169.254/latest/meta-data",
+	}
+
+	client := webhook.NewClient(testSecret, time.Second, false)
+	for _, target := range targets {
+		s.Run(target, func() {
+			err := client.Send(s.ctx, target, model.WebhookRequest{
+				DeliveryUUID: uuid.New(),
+				UserUUID:     uuid.New(),
+				EventType:    model.EventOrderPaid,
+				Body:         []byte(`{}`),
+			})
+			s.Require().ErrorIs(err, model.ErrInvalidTarget)
+		})
+	}
+	s.False(called)
+}
+
+func (s *ClientSuite) TestSendErrors() {
+	tests := []struct {
+		name              string
+		handler           http.HandlerFunc
+		target            func(srvURL string) string
+		expectedErr       error
+		expectedStatus    int
+		expectedRetryable bool
+	}{
+		{
+			name:        "invalid target",
+			target:      func(string) string { return "ftp://example.com/hook" },
+			expectedErr: model.ErrInvalidTarget,
+		},
+		{
+			name:              "server error is retryable",
+			handler:           func(w http.ResponseWriter, _ *http.Request) { w.WriteHeader(http.StatusServiceUnavailable) },
+			expectedStatus:    http.StatusServiceUnavailable,
+			expectedRetryable: true,
+		},
+		{
+			name:              "too many requests is retryable",
+			handler:           func(w http.ResponseWriter, _ *http.Request) { w.WriteHeader(http.StatusTooManyRequests) },
+			expectedStatus:    http.StatusTooManyRequests,
+			expectedRetryable: true,
+		},
+		{
+			name:           "client error is permanent",
+			handler:        func(w http.ResponseWriter, _ *http.Request) { w.WriteHeader(http.StatusBadRequest) },
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name: "redirect is not followed",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				http.Redirect(w, r, "http://169.254.169.254/", http.StatusFound)
+			},
+			expectedStatus: http.StatusFound,
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			handler := tt.handler
+			if handler == nil {
+				handler = func(w http.ResponseWriter, _ *http.Request) { w.WriteHeader(http.StatusOK) }
+			}
+			srv := httptest.NewServer(handler)
+			defer srv.Close()
+
+			target := srv.URL
+			if tt.target != nil {
+				target = tt.target(srv.URL)
+			}
+
+			err := webhook.NewClient(testSecret, time.Second, true).Send(s.ctx, target, model.WebhookRequest{
+				DeliveryUUID: uuid.New(),
+				EventType:    model.EventOrderPaid,
+				Body:         []byte(`{}`),
+			})
+			s.Require().Error(err)
+
+			if tt.expectedErr != nil {
+				s.ErrorIs(err, tt.expectedErr)
+				return
+			}
+
+			var respErr *model.WebhookResponseError
+			s.Require().ErrorAs(err, &respErr)
+			s.Equal(tt.expectedStatus, respErr.StatusCode)
+			s.Equal(tt.expectedRetryable, respErr.Retryable())
+		})
+	}
+}
@@ -0,0 +1,46 @@
+package webhook
+
+import (
+	"fmt"
+	"net"
+	"net/netip"
+	"syscall"
+
+	"github.com/crafty-ezhik/rocket-factory/notification/internal/model"
+)
+
+// cgnatPrefix - разделяемое адресное пространство провайдеров (RFC 6598), снаружи недоступно
+var cgnatPrefix = netip.MustParsePrefix("100.64.0.0/10")
+
+// denyInternalAddresses - запрещает соединения с внутренними адресами.
+//
+//	Проверяется адрес, к которому действительно идет подключение после DNS-резолва,
+//	поэтому запрет не обходится доменом, указывающим на внутренний адрес, или сменой DNS-записи между попытками
+func denyInternalAddresses(_, address string, _ syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return fmt.Errorf("%w: dial address %q", model.ErrInvalidTarget, address)
+	}
+
+	ip, err := netip.ParseAddr(host)
+	if err != nil {
+		return fmt.Errorf("%w: dial address %q", model.ErrInvalidTarget, address)
+	}
+
+	if isInternalAddr(ip) {
+		return fmt.Errorf("%w: address %s is not publicly routable", model.ErrInvalidTarget, ip)
+	}
+	return nil
+}
+
+func isInternalAddr(ip netip.Addr) bool {
+	ip = ip.Unmap()
+	return ip.IsLoopback() ||
+		ip.IsPrivate() ||
+		ip.IsLinkLocalUnicast() ||
+		ip.IsLinkLocalMulticast() ||
+		ip.IsInterfaceLocalMulticast() ||
+		ip.IsMulticast() ||
+		ip.IsUnspecified() ||
+		cgnatPrefix.Contains(ip)
+}
@@ -0,0 +1,47 @@
+package webhook
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"strconv"
+
+	"github.com/google/uuid"
+)
+
+// Заголовки webhook-запроса
+const (
+	HeaderEvent     = "X-Rocket-Event"
+	HeaderDelivery  = "X-Rocket-Delivery"
+	HeaderTimestamp = "X-Rocket-Timestamp"
+	HeaderSignature = "X-Rocket-Signature"
+)
+
+const signaturePrefix = "sha256="
+
+// MethodSecret - секрет подписи одного webhook-адреса пользователя, выведенный из мастер-ключа.
+// Утечка секрета одного интегратора не позволяет подделать запросы к другим адресам
+func MethodSecret(signingKey []byte, userUUID uuid.UUID, target string) string {
+	mac := hmac.New(sha256.New, signingKey)
+	mac.Write([]byte("webhook-secret"))
+	mac.Write([]byte{0})
+	mac.Write([]byte(userUUID.String()))
+	mac.Write([]byte{0})
+	mac.Write([]byte(target))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// Sign - подпись тела запроса: HMAC-SHA256 от "<timestamp>.<body>" в hex.
+// Метка времени входит в подпись, поэтому получатель может отбросить старый перехваченный запрос
+func Sign(secret []byte, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10)))
+	mac.Write([]byte{'.'})
+	mac.Write(body)
+	return signaturePrefix + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Verify - проверка подписи на стороне получателя
+func Verify(secret []byte, timestamp int64, body []byte, signature string) bool {
+	return hmac.Equal([]byte(Sign(secret, timestamp, body)), []byte(signature))
+}
@@ -0,0 +1,124 @@
+package smtp
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/smtp"
+	"time"
+
+	def "github.com/crafty-ezhik/rocket-factory/notification/internal/client/http"
+	"github.com/crafty-ezhik/rocket-factory/notification/internal/model"
+)
+
+var _ def.Notifier = (*client)(nil)
+
+// Config - параметры подключения к SMTP-серверу
+type Config struct {
+	Host string
+	Port string
+	// Username и Password - учетные данные PLAIN-аутентификации. Пустой Username отключает аутентификацию
+	Username string
+	Password string
+	// From - адрес отправителя
+	From string
+	// StartTLS - требовать STARTTLS перед аутентификацией и отправкой письма
+	StartTLS bool
+	// Timeout - ограничение на всю отправку письма
+	Timeout time.Duration
+}
+
+type client struct {
+	cfg Config
+}
+
+func NewClient(cfg Config) *client {
+	return &client{cfg: cfg}
+}
+
+// Notify - отправляет письмо на адрес target
+func (c *client) Notify(ctx context.Context, target string, msg model.Message) error {
+	to, err := mail.ParseAddress(target)
+	if err != nil {
+		return fmt.Errorf("%w: email %q", model.ErrInvalidTarget, target)
+	}
+
+	from, err := mail.ParseAddress(c.cfg.From)
+	if err != nil {
+		return fmt.Errorf("parse sender address: %w", err)
+	}
+
+	body, err := buildMessage(from, to, msg, time.Now())
+	if err != nil {
+		return err
+	}
+
+	return c.send(ctx, from.Address, to.Address, body)
+}
+
+func (c *client) send(ctx context.Context, from, to string, body []byte) error {
+	if c.cfg.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, c.cfg.Timeout)
+		defer cancel()
+	}
+
+	var dialer net.Dialer
+	conn, err := dialer.DialContext(ctx, "tcp", net.JoinHostPort(c.cfg.Host, c.cfg.Port))
+	if err != nil {
+		return fmt.Errorf("dial smtp server: %w", err)
+	}
+	// Дедлайн контекста распространяется на весь SMTP-диалог
+	if deadline, ok := ctx.Deadline(); ok {
+		if err = conn.SetDeadline(deadline); err != nil {
+			_ = conn.Close()
+			return fmt.Errorf("set smtp deadline: %w", err)
+		}
+	}
+
+	smtpClient, err := smtp.NewClient(conn, c.cfg.Host)
+	if err != nil {
+		_ = conn.Close()
+		return fmt.Errorf("smtp handshake: %w", err)
+	}
+	defer smtpClient.Close() //nolint:errcheck
+
+	if c.cfg.StartTLS {
+		if ok, _ := smtpClient.Extension("STARTTLS"); !ok {
+			return fmt.Errorf("smtp server does not support STARTTLS")
+		}
+		if err = smtpClient.StartTLS(&tls.Config{ServerName: c.cfg.Host, MinVersion: tls.VersionTLS12}); err != nil {
+			return fmt.Errorf("smtp starttls: %w", err)
+		}
+	}
+
+	if c.cfg.Username != "" {
+		auth := smtp.PlainAuth("", c.cfg.Username, c.cfg.Password, c.cfg.Host)
+		if err = smtpClient.Auth(auth); err != nil {
+			return fmt.Errorf("smtp auth: %w", err)
+		}
+	}
+
+	if err = smtpClient.Mail(from); err != nil {
+		return fmt.Errorf("smtp mail from: %w", err)
+	}
+	if err = smtpClient.Rcpt(to); err != nil {
+		return fmt.Errorf("smtp rcpt to: %w", err)
+	}
+
+	w, err := smtpClient.Data()
+	if err != nil {
+		return fmt.Errorf("smtp data: %w", err)
+	}
+	if _, err = w.Write(body); err != nil {
+		_ = w.Close()
+		return fmt.Errorf("write smtp data: %w", err)
+	}
+	if err = w.Close(); err != nil {
+		return fmt.Errorf("smtp data: %w", err)
+	}
+
+	return smtpClient.Quit()
+}
@@ -0,0 +1,156 @@
+package smtp_test
+
+import (
+	"context"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/mail"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/crafty-ezhik/rocket-factory/notification/internal/client/smtp"
+	"github.com/crafty-ezhik/rocket-factory/notification/internal/model"
+)
+
+type ClientSuite struct {
+	suite.Suite
+
+	ctx context.Context //nolint:containedctx
+}
+
+func (s *ClientSuite) SetupTest() {
+	s.ctx = context.Background()
+}
+
+func TestClient(t *testing.T) {
+	suite.Run(t, new(ClientSuite))
+}
+
+func (s *ClientSuite) newConfig(srv *stubServer) smtp.Config {
+	return smtp.Config{
+		Host:    srv.Host(),
+		Port:    srv.Port(),
+		From:    "Rocket Factory <noreply@rocket-factory.local>",
+		Timeout: 5 * time.Second,
+	}
+}
+
+func (s *ClientSuite) TestNotifySendsMultipartMessage() {
+	srv := newStubServer(s.T())
+	client := smtp.NewClient(s.newConfig(srv))
+
+	msg := model.Message{
+		Subject: "Заказ собран",
+		Text:    "Заказ 42 собран.\nВремя сборки: 10 сек.",
+		HTML:    "<p>Заказ <b>42</b> собран.</p>",
+	}
+
+	err := client.Notify(s.ctx, "user@example.com", msg)
+	s.Require().NoError(err)
+
+	mails := srv.Mails()
+	s.Require().Len(mails, 1)
+	s.Equal("noreply@rocket-factory.local", mails[0].From)
+	s.Equal([]string{"user@example.com"}, mails[0].To)
+	s.Empty(mails[0].Auth)
+
+	parsed, err := mail.ReadMessage(strings.NewReader(mails[0].Data))
+	s.Require().NoError(err)
+
+	subject, err := new(mime.WordDecoder).DecodeHeader(parsed.Header.Get("Subject"))
+	s.Require().NoError(err)
+	s.Equal(msg.Subject, subject)
+	s.Equal("<user@example.com>", parsed.Header.Get("To"))
+
+	mediaType, params, err := mime.ParseMediaType(parsed.Header.Get("Content-Type"))
+	s.Require().NoError(err)
+	s.Equal("multipart/alternative", mediaType)
+
+	// multipart.Reader сам снимает quoted-printable кодирование частей,
+	// переводы строк в письме передаются как CRLF
+	parts := map[string]string{}
+	mr := multipart.NewReader(parsed.Body, params["boundary"])
+	for {
+		part, err := mr.NextPart()
+		if err == io.EOF {
+			break
+		}
+		s.Require().NoError(err)
+
+		partType, _, err := mime.ParseMediaType(part.Header.Get("Content-Type"))
+		s.Require().NoError(err)
+
+		body, err := io.ReadAll(part)
+		s.Require().NoError(err)
+		parts[partType] = strings.ReplaceAll(string(body), "\r\n", "\n")
+	}
+
+	s.Equal(map[string]string{
+		"text/plain": msg.Text,
+		"text/html":  msg.HTML,
+	}, parts)
+}
+
+func (s *ClientSuite) TestNotifyAuthenticates() {
+	srv := newStubServer(s.T(), "AUTH PLAIN")
+	cfg := s.newConfig(srv)
+	cfg.Username = "mailer"
+	cfg.Password = "secret"
+
+	err := smtp.NewClient(cfg).Notify(s.ctx, "user@example.com", model.Message{Subject: "s", Text: "t"})
+	s.Require().NoError(err)
+
+	mails := srv.Mails()
+	s.Require().Len(mails, 1)
+	s.Equal("\x00mailer\x00secret", mails[0].Auth)
+}
+
+func (s *ClientSuite) TestNotifyErrors() {
+	tests := []struct {
+		name        string
+		target      string
+		setup       func(srv *stubServer, cfg *smtp.Config)
+		expectedErr error
+	}{
+		{
+			name:        "invalid target",
+			target:      "not-an-email",
+			expectedErr: model.ErrInvalidTarget,
+		},
+		{
+			name:   "starttls required but not supported",
+			target: "user@example.com",
+			setup: func(_ *stubServer, cfg *smtp.Config) {
+				cfg.StartTLS = true
+			},
+		},
+		{
+			name:   "recipient rejected",
+			target: "user@example.com",
+			setup: func(srv *stubServer, _ *smtp.Config) {
+				srv.rejectRcpt = true
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			srv := newStubServer(s.T())
+			cfg := s.newConfig(srv)
+			if tt.setup != nil {
+				tt.setup(srv, &cfg)
+			}
+
+			err := smtp.NewClient(cfg).Notify(s.ctx, tt.target, model.Message{Subject: "s", Text: "t"})
+			s.Require().Error(err)
+			if tt.expectedErr != nil {
+				s.ErrorIs(err, tt.expectedErr)
+			}
+			s.Empty(srv.Mails())
+		})
+	}
+}
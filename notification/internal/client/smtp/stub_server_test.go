@@ -0,0 +1,181 @@
+package smtp_test
+
+import (
+	"bufio"
+	"encoding/base64"
+	"net"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// receivedMail - письмо, принятое stub-сервером
+type receivedMail struct {
+	From string
+	To   []string
+	Data string
+	// Auth - расшифрованные учетные данные AUTH PLAIN: identity\x00username\x00password
+	Auth string
+}
+
+// stubServer - минимальный SMTP-сервер в памяти процесса для тестов клиента
+type stubServer struct {
+	listener net.Listener
+	// extensions - расширения, объявляемые в ответе на EHLO
+	extensions []string
+	// rejectRcpt - отвечать ошибкой на RCPT TO
+	rejectRcpt bool
+
+	mu    sync.Mutex
+	mails []receivedMail
+	wg    sync.WaitGroup
+}
+
+func newStubServer(t *testing.T, extensions ...string) *stubServer {
+	t.Helper()
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listen stub smtp server: %v", err)
+	}
+
+	srv := &stubServer{listener: listener, extensions: extensions}
+	srv.wg.Add(1)
+	go srv.serve()
+
+	t.Cleanup(func() {
+		_ = listener.Close()
+		srv.wg.Wait()
+	})
+	return srv
+}
+
+func (s *stubServer) Host() string {
+	host, _, _ := net.SplitHostPort(s.listener.Addr().String())
+	return host
+}
+
+func (s *stubServer) Port() string {
+	_, port, _ := net.SplitHostPort(s.listener.Addr().String())
+	return port
+}
+
+func (s *stubServer) Mails() []receivedMail {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]receivedMail(nil), s.mails...)
+}
+
+func (s *stubServer) serve() {
+	defer s.wg.Done()
+	for {
+		conn, err := s.listener.Accept()
+		if err != nil {
+			return
+		}
+		s.wg.Add(1)
+		go func() {
+			defer s.wg.Done()
+			s.handle(conn)
+		}()
+	}
+}
+
+func (s *stubServer) handle(conn net.Conn) {
+	defer conn.Close() //nolint:errcheck
+
+	r := bufio.NewReader(conn)
+	w := bufio.NewWriter(conn)
+	reply := func(lines ...string) {
+		for _, line := range lines {
+			_, _ = w.WriteString(line + "\r\n")
+		}
+		_ = w.Flush()
+	}
+
+	reply("220 stub ESMTP ready")
+
+	var mail receivedMail
+	for {
+		line, err := r.ReadString('\n')
+		if err != nil {
+			return
+		}
+		line = strings.TrimRight(line, "\r\n")
+		cmd := strings.ToUpper(line)
+
+		switch {
+		case strings.HasPrefix(cmd, "EHLO"):
+			lines := []string{"250-stub"}
+			for _, ext := range s.extensions {
+				lines = append(lines, "250-"+ext)
+			}
+			lines = append(lines, "250 8BITMIME")
+			reply(lines...)
+		case strings.HasPrefix(cmd, "AUTH PLAIN"):
+			decoded, err := base64.StdEncoding.DecodeString(strings.TrimSpace(line[len("AUTH PLAIN"):]))
+			if err != nil {
+				reply("501 invalid auth payload")
+				continue
+			}
+			mail.Auth = string(decoded)
+			reply("235 authenticated")
+		case strings.HasPrefix(cmd, "MAIL FROM:"):
+			mail.From = trimPath(line[len("MAIL FROM:"):])
+			reply("250 ok")
+		case strings.HasPrefix(cmd, "RCPT TO:"):
+			if s.rejectRcpt {
+				reply("550 mailbox unavailable")
+				continue
+			}
+			mail.To = append(mail.To, trimPath(line[len("RCPT TO:"):]))
+			reply("250 ok")
+		case cmd == "DATA":
+			reply("354 end data with <CR><LF>.<CR><LF>")
+			data, err := readData(r)
+			if err != nil {
+				return
+			}
+			mail.Data = data
+
+			s.mu.Lock()
+			s.mails = append(s.mails, mail)
+			s.mu.Unlock()
+
+			mail = receivedMail{}
+			reply("250 queued")
+		case cmd == "RSET":
+			mail = receivedMail{}
+			reply("250 ok")
+		case cmd == "QUIT":
+			reply("221 bye")
+			return
+		default:
+			reply("502 command not implemented")
+		}
+	}
+}
+
+// trimPath - извлекает адрес из "<user@example.com> BODY=8BITMIME"
+func trimPath(s string) string {
+	s = strings.TrimSpace(s)
+	if i := strings.IndexByte(s, '>'); i >= 0 {
+		s = s[:i]
+	}
+	return strings.TrimPrefix(s, "<")
+}
+
+// readData - читает тело письма до строки "." и снимает dot-stuffing
+func readData(r *bufio.Reader) (string, error) {
+	var sb strings.Builder
+	for {
+		line, err := r.ReadString('\n')
+		if err != nil {
+			return "", err
+		}
+		if line == ".\r\n" {
+			return sb.String(), nil
+		}
+		sb.WriteString(strings.TrimPrefix(line, "."))
+	}
+}
@@ -12,10 +12,19 @@ var appConfig *config
 
 type config struct {
 	Kafka                  KafkaConfig
+	ConsumerRetry          ConsumerRetryConfig
 	Logger                 LoggerConfig
 	OrderPaidConsumer      OrderConsumerConfig
 	OrderAssembledConsumer OrderConsumerConfig
+	OrderRefundedConsumer  OrderConsumerConfig
+	OrderAssemblyFailed    OrderConsumerConfig
 	TgBot                  TelegramBotConfig
+	SMTP                   SMTPConfig
+	IamGRPC                IAMGRPCConfig
+	OrderHTTP              OrderHTTPConfig
+	Postgres               PostgresConfig
+	AdminHTTP              AdminHTTPConfig
+	Webhook                WebhookConfig
 }
 
 func Load(path ...string) error {
@@ -27,6 +36,11 @@ func Load(path ...string) error {
 	if err != nil {
 		return err
 	}
+
+	consumerRetryConfig, err := env.NewConsumerRetryConfig()
+	if err != nil {
+		return err
+	}
 	loggerConfig, err := env.NewLoggerConfig()
 	if err != nil {
 		return err
@@ -39,15 +53,56 @@ func Load(path ...string) error {
 	if err != nil {
 		return err
 	}
+	orderRefundedConsumerConfig, err := env.NewOrderRefundedConsumerConfig()
+	if err != nil {
+		return err
+	}
+	orderAssemblyFailedConsumerConfig, err := env.NewOrderAssemblyFailedConsumerConfig()
+	if err != nil {
+		return err
+	}
 	tgBotConfig, err := env.NewTelegramBotConfig()
 	if err != nil {
 		return err
 	}
+	smtpConfig, err := env.NewSMTPConfig()
+	if err != nil {
+		return err
+	}
+	iamGRPCConfig, err := env.NewIAMGRPCConfig()
+	if err != nil {
+		return err
+	}
+	orderHTTPConfig, err := env.NewOrderHTTPConfig()
+	if err != nil {
+		return err
+	}
+	postgresConfig, err := env.NewPostgresConfig()
+	if err != nil {
+		return err
+	}
+	adminHTTPConfig, err := env.NewAdminHTTPConfig()
+	if err != nil {
+		return err
+	}
+	webhookConfig, err := env.NewWebhookConfig()
+	if err != nil {
+		return err
+	}
 	appConfig = &config{
 		Kafka:                  kafkaConfig,
+		ConsumerRetry:          consumerRetryConfig,
 		OrderPaidConsumer:      orderPaidConsumerConfig,
 		OrderAssembledConsumer: orderAssembledConsumerConfig,
+		OrderRefundedConsumer:  orderRefundedConsumerConfig,
+		OrderAssemblyFailed:    orderAssemblyFailedConsumerConfig,
 		TgBot:                  tgBotConfig,
+		SMTP:                   smtpConfig,
+		IamGRPC:                iamGRPCConfig,
+		OrderHTTP:              orderHTTPConfig,
+		Postgres:               postgresConfig,
+		AdminHTTP:              adminHTTPConfig,
+		Webhook:                webhookConfig,
 		Logger:                 loggerConfig,
 	}
 
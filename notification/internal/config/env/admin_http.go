@@ -0,0 +1,34 @@
+package env
+
+import (
+	"net"
+	"time"
+
+	"github.com/caarlos0/env/v11"
+)
+
+type adminHTTPEnvConfig struct {
+	Host        string        `env:"ADMIN_HTTP_HOST,required"`
+	Port        string        `env:"ADMIN_HTTP_PORT,required"`
+	ReadTimeout time.Duration `env:"ADMIN_HTTP_READ_TIMEOUT,required"`
+}
+
+type adminHTTPConfig struct {
+	raw adminHTTPEnvConfig
+}
+
+func NewAdminHTTPConfig() (*adminHTTPConfig, error) {
+	var raw adminHTTPEnvConfig
+	if err := env.Parse(&raw); err != nil {
+		return nil, err
+	}
+	return &adminHTTPConfig{raw: raw}, nil
+}
+
+func (cfg *adminHTTPConfig) Address() string {
+	return net.JoinHostPort(cfg.raw.Host, cfg.raw.Port)
+}
+
+func (cfg *adminHTTPConfig) ReadTimeout() time.Duration {
+	return cfg.raw.ReadTimeout
+}
@@ -0,0 +1,51 @@
+package env
+
+import (
+	"time"
+
+	"github.com/IBM/sarama"
+	"github.com/caarlos0/env/v11"
+)
+
+type consumerRetryEnvConfig struct {
+	Attempts    int             `env:"KAFKA_RETRY_ATTEMPTS,required"`
+	Backoff     time.Duration   `env:"KAFKA_RETRY_BACKOFF,required"`
+	MaxBackoff  time.Duration   `env:"KAFKA_RETRY_MAX_BACKOFF,required"`
+	TopicDelays []time.Duration `env:"KAFKA_RETRY_TOPIC_DELAYS,required"`
+}
+
+type consumerRetryConfig struct {
+	raw consumerRetryEnvConfig
+}
+
+func NewConsumerRetryConfig() (*consumerRetryConfig, error) {
+	var raw consumerRetryEnvConfig
+	if err := env.Parse(&raw); err != nil {
+		return nil, err
+	}
+	return &consumerRetryConfig{raw: raw}, nil
+}
+
+func (cfg *consumerRetryConfig) Attempts() int {
+	return cfg.raw.Attempts
+}
+
+func (cfg *consumerRetryConfig) Backoff() time.Duration {
+	return cfg.raw.Backoff
+}
+
+func (cfg *consumerRetryConfig) MaxBackoff() time.Duration {
+	return cfg.raw.MaxBackoff
+}
+
+func (cfg *consumerRetryConfig) TopicDelays() []time.Duration {
+	return cfg.raw.TopicDelays
+}
+
+func (cfg *consumerRetryConfig) ProducerConfig() *sarama.Config {
+	config := sarama.NewConfig()
+	config.Version = sarama.V4_0_0_0
+	config.Producer.Return.Successes = true
+
+	return config
+}
@@ -0,0 +1,41 @@
+package env
+
+import (
+	"net"
+	"time"
+
+	"github.com/caarlos0/env/v11"
+)
+
+type iamGRPCEnvConfig struct {
+	Host string `env:"IAM_GRPC_HOST,required"`
+	Port string `env:"IAM_GRPC_PORT,required"`
+	// UserCacheTTL - сколько хранить каналы уведомлений пользователя, полученные из IAM
+	UserCacheTTL time.Duration `env:"IAM_USER_CACHE_TTL,required"`
+	// TokenIssuer - издатель, которого ожидаем в токенах доступа к admin API
+	TokenIssuer string `env:"IAM_TOKEN_ISSUER,required"`
+}
+
+type iamGRPCConfig struct {
+	raw iamGRPCEnvConfig
+}
+
+func NewIAMGRPCConfig() (*iamGRPCConfig, error) {
+	var raw iamGRPCEnvConfig
+	if err := env.Parse(&raw); err != nil {
+		return nil, err
+	}
+	return &iamGRPCConfig{raw: raw}, nil
+}
+
+func (cfg *iamGRPCConfig) Address() string {
+	return net.JoinHostPort(cfg.raw.Host, cfg.raw.Port)
+}
+
+func (cfg *iamGRPCConfig) UserCacheTTL() time.Duration {
+	return cfg.raw.UserCacheTTL
+}
+
+func (cfg *iamGRPCConfig) TokenIssuer() string {
+	return cfg.raw.TokenIssuer
+}
@@ -0,0 +1,47 @@
+package env
+
+import (
+	"time"
+
+	"github.com/IBM/sarama"
+	"github.com/caarlos0/env/v11"
+)
+
+type orderAssemblyFailedConsumerEnvConfig struct {
+	TopicName      string        `env:"ORDER_ASSEMBLY_FAILED_TOPIC_NAME,required"`
+	GroupID        string        `env:"ORDER_ASSEMBLY_FAILED_CONSUMER_GROUP_ID,required"`
+	DedupRetention time.Duration `env:"ORDER_ASSEMBLY_FAILED_DEDUP_RETENTION,required"`
+}
+
+type orderAssemblyFailedConsumerConfig struct {
+	raw orderAssemblyFailedConsumerEnvConfig
+}
+
+func NewOrderAssemblyFailedConsumerConfig() (*orderAssemblyFailedConsumerConfig, error) {
+	var raw orderAssemblyFailedConsumerEnvConfig
+	if err := env.Parse(&raw); err != nil {
+		return nil, err
+	}
+	return &orderAssemblyFailedConsumerConfig{raw}, nil
+}
+
+func (cfg *orderAssemblyFailedConsumerConfig) Topic() string {
+	return cfg.raw.TopicName
+}
+
+func (cfg *orderAssemblyFailedConsumerConfig) GroupID() string {
+	return cfg.raw.GroupID
+}
+
+func (cfg *orderAssemblyFailedConsumerConfig) DedupRetention() time.Duration {
+	return cfg.raw.DedupRetention
+}
+
+func (cfg *orderAssemblyFailedConsumerConfig) Config() *sarama.Config {
+	config := sarama.NewConfig()
+	config.Version = sarama.V4_0_0_0
+	config.Consumer.Offsets.Initial = sarama.OffsetOldest
+	config.Consumer.Group.Rebalance.GroupStrategies = []sarama.BalanceStrategy{sarama.NewBalanceStrategyRoundRobin()}
+
+	return config
+}
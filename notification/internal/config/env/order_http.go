@@ -0,0 +1,35 @@
+package env
+
+import (
+	"net"
+	"time"
+
+	"github.com/caarlos0/env/v11"
+)
+
+type orderHTTPEnvConfig struct {
+	Host string `env:"ORDER_HTTP_HOST,required"`
+	Port string `env:"ORDER_HTTP_PORT,required"`
+	// Timeout - ограничение на один запрос команды бота к Order Service
+	Timeout time.Duration `env:"ORDER_HTTP_TIMEOUT,required"`
+}
+
+type orderHTTPConfig struct {
+	raw orderHTTPEnvConfig
+}
+
+func NewOrderHTTPConfig() (*orderHTTPConfig, error) {
+	var raw orderHTTPEnvConfig
+	if err := env.Parse(&raw); err != nil {
+		return nil, err
+	}
+	return &orderHTTPConfig{raw: raw}, nil
+}
+
+func (cfg *orderHTTPConfig) URL() string {
+	return "http://" + net.JoinHostPort(cfg.raw.Host, cfg.raw.Port)
+}
+
+func (cfg *orderHTTPConfig) Timeout() time.Duration {
+	return cfg.raw.Timeout
+}
@@ -1,13 +1,16 @@
 package env
 
 import (
+	"time"
+
 	"github.com/IBM/sarama"
 	"github.com/caarlos0/env/v11"
 )
 
 type orderPaidConsumerEnvConfig struct {
-	TopicName string `env:"ORDER_PAID_TOPIC_NAME,required"`
-	GroupID   string `env:"ORDER_PAID_CONSUMER_GROUP_ID,required"`
+	TopicName      string        `env:"ORDER_PAID_TOPIC_NAME,required"`
+	GroupID        string        `env:"ORDER_PAID_CONSUMER_GROUP_ID,required"`
+	DedupRetention time.Duration `env:"ORDER_PAID_DEDUP_RETENTION,required"`
 }
 
 type orderPaidConsumerConfig struct {
@@ -30,6 +33,10 @@ func (cfg *orderPaidConsumerConfig) GroupID() string {
 	return cfg.raw.GroupID
 }
 
+func (cfg *orderPaidConsumerConfig) DedupRetention() time.Duration {
+	return cfg.raw.DedupRetention
+}
+
 func (cfg *orderPaidConsumerConfig) Config() *sarama.Config {
 	config := sarama.NewConfig()
 	config.Version = sarama.V4_0_0_0
@@ -0,0 +1,47 @@
+package env
+
+import (
+	"time"
+
+	"github.com/IBM/sarama"
+	"github.com/caarlos0/env/v11"
+)
+
+type orderRefundedConsumerEnvConfig struct {
+	TopicName      string        `env:"ORDER_REFUNDED_TOPIC_NAME,required"`
+	GroupID        string        `env:"ORDER_REFUNDED_CONSUMER_GROUP_ID,required"`
+	DedupRetention time.Duration `env:"ORDER_REFUNDED_DEDUP_RETENTION,required"`
+}
+
+type orderRefundedConsumerConfig struct {
+	raw orderRefundedConsumerEnvConfig
+}
+
+func NewOrderRefundedConsumerConfig() (*orderRefundedConsumerConfig, error) {
+	var raw orderRefundedConsumerEnvConfig
+	if err := env.Parse(&raw); err != nil {
+		return nil, err
+	}
+	return &orderRefundedConsumerConfig{raw}, nil
+}
+
+func (cfg *orderRefundedConsumerConfig) Topic() string {
+	return cfg.raw.TopicName
+}
+
+func (cfg *orderRefundedConsumerConfig) GroupID() string {
+	return cfg.raw.GroupID
+}
+
+func (cfg *orderRefundedConsumerConfig) DedupRetention() time.Duration {
+	return cfg.raw.DedupRetention
+}
+
+func (cfg *orderRefundedConsumerConfig) Config() *sarama.Config {
+	config := sarama.NewConfig()
+	config.Version = sarama.V4_0_0_0
+	config.Consumer.Offsets.Initial = sarama.OffsetOldest
+	config.Consumer.Group.Rebalance.GroupStrategies = []sarama.BalanceStrategy{sarama.NewBalanceStrategyRoundRobin()}
+
+	return config
+}
@@ -0,0 +1,59 @@
+package env
+
+import (
+	"time"
+
+	"github.com/caarlos0/env/v11"
+)
+
+type smtpEnvConfig struct {
+	Host string `env:"SMTP_HOST,required"`
+	Port string `env:"SMTP_PORT,required"`
+	// Username и Password - учетные данные сервера. Пустой SMTP_USERNAME отключает аутентификацию
+	Username string `env:"SMTP_USERNAME"`
+	Password string `env:"SMTP_PASSWORD"`
+	From     string `env:"SMTP_FROM,required"`
+	StartTLS bool   `env:"SMTP_STARTTLS" envDefault:"true"`
+	// Timeout - ограничение на отправку одного письма
+	Timeout time.Duration `env:"SMTP_TIMEOUT" envDefault:"10s"`
+}
+
+type smtpConfig struct {
+	raw smtpEnvConfig
+}
+
+func NewSMTPConfig() (*smtpConfig, error) {
+	var raw smtpEnvConfig
+	if err := env.Parse(&raw); err != nil {
+		return nil, err
+	}
+	return &smtpConfig{raw: raw}, nil
+}
+
+func (cfg *smtpConfig) Host() string {
+	return cfg.raw.Host
+}
+
+func (cfg *smtpConfig) Port() string {
+	return cfg.raw.Port
+}
+
+func (cfg *smtpConfig) Username() string {
+	return cfg.raw.Username
+}
+
+func (cfg *smtpConfig) Password() string {
+	return cfg.raw.Password
+}
+
+func (cfg *smtpConfig) From() string {
+	return cfg.raw.From
+}
+
+func (cfg *smtpConfig) StartTLS() bool {
+	return cfg.raw.StartTLS
+}
+
+func (cfg *smtpConfig) Timeout() time.Duration {
+	return cfg.raw.Timeout
+}
@@ -3,8 +3,15 @@ package env
 import "github.com/caarlos0/env/v11"
 
 type telegramBotEnvConfig struct {
-	Token  string `env:"TELEGRAM_BOT_TOKEN,required"`
-	ChatID int64  `env:"CHAT_ID,required"`
+	Token string `env:"TELEGRAM_BOT_TOKEN,required"`
+	// APIURL - адрес Bot API. Пустое значение - api.telegram.org, для тестов можно указать локальный сервер
+	APIURL string `env:"TELEGRAM_BOT_API_URL"`
+	// RatePerSecond - сколько сообщений в секунду бот отправляет во все чаты, лимит Bot API - 30
+	RatePerSecond int `env:"TELEGRAM_BOT_RATE_PER_SECOND" envDefault:"25"`
+	// MaxRetries - сколько раз повторять сообщение после ответа 429 Too Many Requests
+	MaxRetries int `env:"TELEGRAM_BOT_MAX_RETRIES" envDefault:"3"`
+	// LinkEncryptionKey - ключ AES-256 в base64 для шифрования сессий привязанных чатов
+	LinkEncryptionKey string `env:"TELEGRAM_BOT_LINK_ENCRYPTION_KEY,required"`
 }
 
 type telegramBotConfig struct {
@@ -23,6 +30,18 @@ func (cfg *telegramBotConfig) Token() string {
 	return cfg.raw.Token
 }
 
-func (cfg *telegramBotConfig) ChatID() int64 {
-	return cfg.raw.ChatID
+func (cfg *telegramBotConfig) APIURL() string {
+	return cfg.raw.APIURL
+}
+
+func (cfg *telegramBotConfig) RatePerSecond() int {
+	return cfg.raw.RatePerSecond
+}
+
+func (cfg *telegramBotConfig) MaxRetries() int {
+	return cfg.raw.MaxRetries
+}
+
+func (cfg *telegramBotConfig) LinkEncryptionKey() string {
+	return cfg.raw.LinkEncryptionKey
 }
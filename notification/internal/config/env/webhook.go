@@ -0,0 +1,56 @@
+package env
+
+import (
+	"time"
+
+	"github.com/caarlos0/env/v11"
+)
+
+type webhookEnvConfig struct {
+	// SigningKey - мастер-ключ, из которого выводится отдельный секрет подписи для каждого webhook-адреса.
+	// Сам ключ интеграторам не выдается
+	SigningKey     string        `env:"WEBHOOK_SIGNING_KEY,required"`
+	RequestTimeout time.Duration `env:"WEBHOOK_REQUEST_TIMEOUT" envDefault:"5s"`
+	// AllowPrivateTargets - разрешить доставку на приватные и loopback-адреса, только для локальной разработки
+	AllowPrivateTargets bool `env:"WEBHOOK_ALLOW_PRIVATE_TARGETS" envDefault:"false"`
+	// RetryAttempts - попыток доставки за одну обработку события, дальше событие повторит consumer
+	RetryAttempts   int           `env:"WEBHOOK_RETRY_ATTEMPTS" envDefault:"5"`
+	RetryBackoff    time.Duration `env:"WEBHOOK_RETRY_BACKOFF" envDefault:"500ms"`
+	RetryMaxBackoff time.Duration `env:"WEBHOOK_RETRY_MAX_BACKOFF" envDefault:"30s"`
+}
+
+type webhookConfig struct {
+	raw webhookEnvConfig
+}
+
+func NewWebhookConfig() (*webhookConfig, error) {
+	var raw webhookEnvConfig
+	if err := env.Parse(&raw); err != nil {
+		return nil, err
+	}
+	return &webhookConfig{raw: raw}, nil
+}
+
+func (cfg *webhookConfig) SigningKey() string {
+	return cfg.raw.SigningKey
+}
+
+func (cfg *webhookConfig) AllowPrivateTargets() bool {
+	return cfg.raw.AllowPrivateTargets
+}
+
+func (cfg *webhookConfig) RequestTimeout() time.Duration {
+	return cfg.raw.RequestTimeout
+}
+
+func (cfg *webhookConfig) RetryAttempts() int {
+	return cfg.raw.RetryAttempts
+}
+
+func (cfg *webhookConfig) RetryBackoff() time.Duration {
+	return cfg.raw.RetryBackoff
+}
+
+func (cfg *webhookConfig) RetryMaxBackoff() time.Duration {
+	return cfg.raw.RetryMaxBackoff
+}
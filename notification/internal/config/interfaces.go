@@ -1,6 +1,10 @@
 package config
 
-import "github.com/IBM/sarama"
+import (
+	"time"
+
+	"github.com/IBM/sarama"
+)
 
 type LoggerConfig interface {
 	Level() string
@@ -9,15 +13,68 @@ type LoggerConfig interface {
 
 type TelegramBotConfig interface {
 	Token() string
-	ChatID() int64
+	APIURL() string
+	RatePerSecond() int
+	MaxRetries() int
+	LinkEncryptionKey() string
+}
+
+type SMTPConfig interface {
+	Host() string
+	Port() string
+	Username() string
+	Password() string
+	From() string
+	StartTLS() bool
+	Timeout() time.Duration
+}
+
+type IAMGRPCConfig interface {
+	Address() string
+	UserCacheTTL() time.Duration
+	TokenIssuer() string
+}
+
+type OrderHTTPConfig interface {
+	URL() string
+	Timeout() time.Duration
+}
+
+type PostgresConfig interface {
+	URI() string
+	DBName() string
+	MigrationsDir() string
+}
+
+type AdminHTTPConfig interface {
+	Address() string
+	ReadTimeout() time.Duration
+}
+
+type WebhookConfig interface {
+	SigningKey() string
+	RequestTimeout() time.Duration
+	AllowPrivateTargets() bool
+	RetryAttempts() int
+	RetryBackoff() time.Duration
+	RetryMaxBackoff() time.Duration
 }
 
 type OrderConsumerConfig interface {
 	Topic() string
 	GroupID() string
+	DedupRetention() time.Duration
 	Config() *sarama.Config
 }
 
 type KafkaConfig interface {
 	Brokers() []string
 }
+
+type ConsumerRetryConfig interface {
+	Attempts() int
+	Backoff() time.Duration
+	MaxBackoff() time.Duration
+	TopicDelays() []time.Duration
+	ProducerConfig() *sarama.Config
+}
@@ -0,0 +1,129 @@
+// Code generated for crafty-ezhik service
+// © Crafty-ezhik 2025.
+
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"time"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockAdminHTTPConfig creates a new instance of MockAdminHTTPConfig. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockAdminHTTPConfig(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockAdminHTTPConfig {
+	mock := &MockAdminHTTPConfig{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockAdminHTTPConfig is an autogenerated mock type for the AdminHTTPConfig type
+type MockAdminHTTPConfig struct {
+	mock.Mock
+}
+
+type MockAdminHTTPConfig_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockAdminHTTPConfig) EXPECT() *MockAdminHTTPConfig_Expecter {
+	return &MockAdminHTTPConfig_Expecter{mock: &_m.Mock}
+}
+
+// Address provides a mock function for the type MockAdminHTTPConfig
+func (_mock *MockAdminHTTPConfig) Address() string {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Address")
+	}
+
+	var r0 string
+	if returnFunc, ok := ret.Get(0).(func() string); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	return r0
+}
+
+// MockAdminHTTPConfig_Address_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Address'
+type MockAdminHTTPConfig_Address_Call struct {
+	*mock.Call
+}
+
+// Address is a helper method to define mock.On call
+func (_e *MockAdminHTTPConfig_Expecter) Address() *MockAdminHTTPConfig_Address_Call {
+	return &MockAdminHTTPConfig_Address_Call{Call: _e.mock.On("Address")}
+}
+
+func (_c *MockAdminHTTPConfig_Address_Call) Run(run func()) *MockAdminHTTPConfig_Address_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockAdminHTTPConfig_Address_Call) Return(s string) *MockAdminHTTPConfig_Address_Call {
+	_c.Call.Return(s)
+	return _c
+}
+
+func (_c *MockAdminHTTPConfig_Address_Call) RunAndReturn(run func() string) *MockAdminHTTPConfig_Address_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReadTimeout provides a mock function for the type MockAdminHTTPConfig
+func (_mock *MockAdminHTTPConfig) ReadTimeout() time.Duration {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReadTimeout")
+	}
+
+	var r0 time.Duration
+	if returnFunc, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+	return r0
+}
+
+// MockAdminHTTPConfig_ReadTimeout_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReadTimeout'
+type MockAdminHTTPConfig_ReadTimeout_Call struct {
+	*mock.Call
+}
+
+// ReadTimeout is a helper method to define mock.On call
+func (_e *MockAdminHTTPConfig_Expecter) ReadTimeout() *MockAdminHTTPConfig_ReadTimeout_Call {
+	return &MockAdminHTTPConfig_ReadTimeout_Call{Call: _e.mock.On("ReadTimeout")}
+}
+
+func (_c *MockAdminHTTPConfig_ReadTimeout_Call) Run(run func()) *MockAdminHTTPConfig_ReadTimeout_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockAdminHTTPConfig_ReadTimeout_Call) Return(duration time.Duration) *MockAdminHTTPConfig_ReadTimeout_Call {
+	_c.Call.Return(duration)
+	return _c
+}
+
+func (_c *MockAdminHTTPConfig_ReadTimeout_Call) RunAndReturn(run func() time.Duration) *MockAdminHTTPConfig_ReadTimeout_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -0,0 +1,266 @@
+// Code generated for crafty-ezhik service
+// © Crafty-ezhik 2025.
+
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"time"
+
+	"github.com/IBM/sarama"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockConsumerRetryConfig creates a new instance of MockConsumerRetryConfig. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockConsumerRetryConfig(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockConsumerRetryConfig {
+	mock := &MockConsumerRetryConfig{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockConsumerRetryConfig is an autogenerated mock type for the ConsumerRetryConfig type
+type MockConsumerRetryConfig struct {
+	mock.Mock
+}
+
+type MockConsumerRetryConfig_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockConsumerRetryConfig) EXPECT() *MockConsumerRetryConfig_Expecter {
+	return &MockConsumerRetryConfig_Expecter{mock: &_m.Mock}
+}
+
+// Attempts provides a mock function for the type MockConsumerRetryConfig
+func (_mock *MockConsumerRetryConfig) Attempts() int {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Attempts")
+	}
+
+	var r0 int
+	if returnFunc, ok := ret.Get(0).(func() int); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+	return r0
+}
+
+// MockConsumerRetryConfig_Attempts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Attempts'
+type MockConsumerRetryConfig_Attempts_Call struct {
+	*mock.Call
+}
+
+// Attempts is a helper method to define mock.On call
+func (_e *MockConsumerRetryConfig_Expecter) Attempts() *MockConsumerRetryConfig_Attempts_Call {
+	return &MockConsumerRetryConfig_Attempts_Call{Call: _e.mock.On("Attempts")}
+}
+
+func (_c *MockConsumerRetryConfig_Attempts_Call) Run(run func()) *MockConsumerRetryConfig_Attempts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockConsumerRetryConfig_Attempts_Call) Return(n int) *MockConsumerRetryConfig_Attempts_Call {
+	_c.Call.Return(n)
+	return _c
+}
+
+func (_c *MockConsumerRetryConfig_Attempts_Call) RunAndReturn(run func() int) *MockConsumerRetryConfig_Attempts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Backoff provides a mock function for the type MockConsumerRetryConfig
+func (_mock *MockConsumerRetryConfig) Backoff() time.Duration {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Backoff")
+	}
+
+	var r0 time.Duration
+	if returnFunc, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+	return r0
+}
+
+// MockConsumerRetryConfig_Backoff_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Backoff'
+type MockConsumerRetryConfig_Backoff_Call struct {
+	*mock.Call
+}
+
+// Backoff is a helper method to define mock.On call
+func (_e *MockConsumerRetryConfig_Expecter) Backoff() *MockConsumerRetryConfig_Backoff_Call {
+	return &MockConsumerRetryConfig_Backoff_Call{Call: _e.mock.On("Backoff")}
+}
+
+func (_c *MockConsumerRetryConfig_Backoff_Call) Run(run func()) *MockConsumerRetryConfig_Backoff_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockConsumerRetryConfig_Backoff_Call) Return(duration time.Duration) *MockConsumerRetryConfig_Backoff_Call {
+	_c.Call.Return(duration)
+	return _c
+}
+
+func (_c *MockConsumerRetryConfig_Backoff_Call) RunAndReturn(run func() time.Duration) *MockConsumerRetryConfig_Backoff_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MaxBackoff provides a mock function for the type MockConsumerRetryConfig
+func (_mock *MockConsumerRetryConfig) MaxBackoff() time.Duration {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for MaxBackoff")
+	}
+
+	var r0 time.Duration
+	if returnFunc, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+	return r0
+}
+
+// MockConsumerRetryConfig_MaxBackoff_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MaxBackoff'
+type MockConsumerRetryConfig_MaxBackoff_Call struct {
+	*mock.Call
+}
+
+// MaxBackoff is a helper method to define mock.On call
+func (_e *MockConsumerRetryConfig_Expecter) MaxBackoff() *MockConsumerRetryConfig_MaxBackoff_Call {
+	return &MockConsumerRetryConfig_MaxBackoff_Call{Call: _e.mock.On("MaxBackoff")}
+}
+
+func (_c *MockConsumerRetryConfig_MaxBackoff_Call) Run(run func()) *MockConsumerRetryConfig_MaxBackoff_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockConsumerRetryConfig_MaxBackoff_Call) Return(duration time.Duration) *MockConsumerRetryConfig_MaxBackoff_Call {
+	_c.Call.Return(duration)
+	return _c
+}
+
+func (_c *MockConsumerRetryConfig_MaxBackoff_Call) RunAndReturn(run func() time.Duration) *MockConsumerRetryConfig_MaxBackoff_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ProducerConfig provides a mock function for the type MockConsumerRetryConfig
+func (_mock *MockConsumerRetryConfig) ProducerConfig() *sarama.Config {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for ProducerConfig")
+	}
+
+	var r0 *sarama.Config
+	if returnFunc, ok := ret.Get(0).(func() *sarama.Config); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*sarama.Config)
+		}
+	}
+	return r0
+}
+
+// MockConsumerRetryConfig_ProducerConfig_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ProducerConfig'
+type MockConsumerRetryConfig_ProducerConfig_Call struct {
+	*mock.Call
+}
+
+// ProducerConfig is a helper method to define mock.On call
+func (_e *MockConsumerRetryConfig_Expecter) ProducerConfig() *MockConsumerRetryConfig_ProducerConfig_Call {
+	return &MockConsumerRetryConfig_ProducerConfig_Call{Call: _e.mock.On("ProducerConfig")}
+}
+
+func (_c *MockConsumerRetryConfig_ProducerConfig_Call) Run(run func()) *MockConsumerRetryConfig_ProducerConfig_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockConsumerRetryConfig_ProducerConfig_Call) Return(config *sarama.Config) *MockConsumerRetryConfig_ProducerConfig_Call {
+	_c.Call.Return(config)
+	return _c
+}
+
+func (_c *MockConsumerRetryConfig_ProducerConfig_Call) RunAndReturn(run func() *sarama.Config) *MockConsumerRetryConfig_ProducerConfig_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// TopicDelays provides a mock function for the type MockConsumerRetryConfig
+func (_mock *MockConsumerRetryConfig) TopicDelays() []time.Duration {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for TopicDelays")
+	}
+
+	var r0 []time.Duration
+	if returnFunc, ok := ret.Get(0).(func() []time.Duration); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]time.Duration)
+		}
+	}
+	return r0
+}
+
+// MockConsumerRetryConfig_TopicDelays_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TopicDelays'
+type MockConsumerRetryConfig_TopicDelays_Call struct {
+	*mock.Call
+}
+
+// TopicDelays is a helper method to define mock.On call
+func (_e *MockConsumerRetryConfig_Expecter) TopicDelays() *MockConsumerRetryConfig_TopicDelays_Call {
+	return &MockConsumerRetryConfig_TopicDelays_Call{Call: _e.mock.On("TopicDelays")}
+}
+
+func (_c *MockConsumerRetryConfig_TopicDelays_Call) Run(run func()) *MockConsumerRetryConfig_TopicDelays_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockConsumerRetryConfig_TopicDelays_Call) Return(durations []time.Duration) *MockConsumerRetryConfig_TopicDelays_Call {
+	_c.Call.Return(durations)
+	return _c
+}
+
+func (_c *MockConsumerRetryConfig_TopicDelays_Call) RunAndReturn(run func() []time.Duration) *MockConsumerRetryConfig_TopicDelays_Call {
+	_c.Call.Return(run)
+	return _c
+}
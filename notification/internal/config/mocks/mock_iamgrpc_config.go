@@ -0,0 +1,173 @@
+// Code generated for crafty-ezhik service
+// © Crafty-ezhik 2025.
+
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"time"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockIAMGRPCConfig creates a new instance of MockIAMGRPCConfig. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockIAMGRPCConfig(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockIAMGRPCConfig {
+	mock := &MockIAMGRPCConfig{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockIAMGRPCConfig is an autogenerated mock type for the IAMGRPCConfig type
+type MockIAMGRPCConfig struct {
+	mock.Mock
+}
+
+type MockIAMGRPCConfig_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockIAMGRPCConfig) EXPECT() *MockIAMGRPCConfig_Expecter {
+	return &MockIAMGRPCConfig_Expecter{mock: &_m.Mock}
+}
+
+// Address provides a mock function for the type MockIAMGRPCConfig
+func (_mock *MockIAMGRPCConfig) Address() string {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Address")
+	}
+
+	var r0 string
+	if returnFunc, ok := ret.Get(0).(func() string); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	return r0
+}
+
+// MockIAMGRPCConfig_Address_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Address'
+type MockIAMGRPCConfig_Address_Call struct {
+	*mock.Call
+}
+
+// Address is a helper method to define mock.On call
+func (_e *MockIAMGRPCConfig_Expecter) Address() *MockIAMGRPCConfig_Address_Call {
+	return &MockIAMGRPCConfig_Address_Call{Call: _e.mock.On("Address")}
+}
+
+func (_c *MockIAMGRPCConfig_Address_Call) Run(run func()) *MockIAMGRPCConfig_Address_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockIAMGRPCConfig_Address_Call) Return(s string) *MockIAMGRPCConfig_Address_Call {
+	_c.Call.Return(s)
+	return _c
+}
+
+func (_c *MockIAMGRPCConfig_Address_Call) RunAndReturn(run func() string) *MockIAMGRPCConfig_Address_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// TokenIssuer provides a mock function for the type MockIAMGRPCConfig
+func (_mock *MockIAMGRPCConfig) TokenIssuer() string {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for TokenIssuer")
+	}
+
+	var r0 string
+	if returnFunc, ok := ret.Get(0).(func() string); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	return r0
+}
+
+// MockIAMGRPCConfig_TokenIssuer_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TokenIssuer'
+type MockIAMGRPCConfig_TokenIssuer_Call struct {
+	*mock.Call
+}
+
+// TokenIssuer is a helper method to define mock.On call
+func (_e *MockIAMGRPCConfig_Expecter) TokenIssuer() *MockIAMGRPCConfig_TokenIssuer_Call {
+	return &MockIAMGRPCConfig_TokenIssuer_Call{Call: _e.mock.On("TokenIssuer")}
+}
+
+func (_c *MockIAMGRPCConfig_TokenIssuer_Call) Run(run func()) *MockIAMGRPCConfig_TokenIssuer_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockIAMGRPCConfig_TokenIssuer_Call) Return(s string) *MockIAMGRPCConfig_TokenIssuer_Call {
+	_c.Call.Return(s)
+	return _c
+}
+
+func (_c *MockIAMGRPCConfig_TokenIssuer_Call) RunAndReturn(run func() string) *MockIAMGRPCConfig_TokenIssuer_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UserCacheTTL provides a mock function for the type MockIAMGRPCConfig
+func (_mock *MockIAMGRPCConfig) UserCacheTTL() time.Duration {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for UserCacheTTL")
+	}
+
+	var r0 time.Duration
+	if returnFunc, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+	return r0
+}
+
+// MockIAMGRPCConfig_UserCacheTTL_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UserCacheTTL'
+type MockIAMGRPCConfig_UserCacheTTL_Call struct {
+	*mock.Call
+}
+
+// UserCacheTTL is a helper method to define mock.On call
+func (_e *MockIAMGRPCConfig_Expecter) UserCacheTTL() *MockIAMGRPCConfig_UserCacheTTL_Call {
+	return &MockIAMGRPCConfig_UserCacheTTL_Call{Call: _e.mock.On("UserCacheTTL")}
+}
+
+func (_c *MockIAMGRPCConfig_UserCacheTTL_Call) Run(run func()) *MockIAMGRPCConfig_UserCacheTTL_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockIAMGRPCConfig_UserCacheTTL_Call) Return(duration time.Duration) *MockIAMGRPCConfig_UserCacheTTL_Call {
+	_c.Call.Return(duration)
+	return _c
+}
+
+func (_c *MockIAMGRPCConfig_UserCacheTTL_Call) RunAndReturn(run func() time.Duration) *MockIAMGRPCConfig_UserCacheTTL_Call {
+	_c.Call.Return(run)
+	return _c
+}
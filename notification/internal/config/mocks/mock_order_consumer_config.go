@@ -8,6 +8,8 @@
 package mocks
 
 import (
+	"time"
+
 	"github.com/IBM/sarama"
 	mock "github.com/stretchr/testify/mock"
 )
@@ -85,6 +87,50 @@ func (_c *MockOrderConsumerConfig_Config_Call) RunAndReturn(run func() *sarama.C
 	return _c
 }
 
+// DedupRetention provides a mock function for the type MockOrderConsumerConfig
+func (_mock *MockOrderConsumerConfig) DedupRetention() time.Duration {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for DedupRetention")
+	}
+
+	var r0 time.Duration
+	if returnFunc, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+	return r0
+}
+
+// MockOrderConsumerConfig_DedupRetention_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DedupRetention'
+type MockOrderConsumerConfig_DedupRetention_Call struct {
+	*mock.Call
+}
+
+// DedupRetention is a helper method to define mock.On call
+func (_e *MockOrderConsumerConfig_Expecter) DedupRetention() *MockOrderConsumerConfig_DedupRetention_Call {
+	return &MockOrderConsumerConfig_DedupRetention_Call{Call: _e.mock.On("DedupRetention")}
+}
+
+func (_c *MockOrderConsumerConfig_DedupRetention_Call) Run(run func()) *MockOrderConsumerConfig_DedupRetention_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockOrderConsumerConfig_DedupRetention_Call) Return(duration time.Duration) *MockOrderConsumerConfig_DedupRetention_Call {
+	_c.Call.Return(duration)
+	return _c
+}
+
+func (_c *MockOrderConsumerConfig_DedupRetention_Call) RunAndReturn(run func() time.Duration) *MockOrderConsumerConfig_DedupRetention_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GroupID provides a mock function for the type MockOrderConsumerConfig
 func (_mock *MockOrderConsumerConfig) GroupID() string {
 	ret := _mock.Called()
@@ -0,0 +1,129 @@
+// Code generated for crafty-ezhik service
+// © Crafty-ezhik 2025.
+
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"time"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockOrderHTTPConfig creates a new instance of MockOrderHTTPConfig. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockOrderHTTPConfig(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockOrderHTTPConfig {
+	mock := &MockOrderHTTPConfig{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockOrderHTTPConfig is an autogenerated mock type for the OrderHTTPConfig type
+type MockOrderHTTPConfig struct {
+	mock.Mock
+}
+
+type MockOrderHTTPConfig_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockOrderHTTPConfig) EXPECT() *MockOrderHTTPConfig_Expecter {
+	return &MockOrderHTTPConfig_Expecter{mock: &_m.Mock}
+}
+
+// Timeout provides a mock function for the type MockOrderHTTPConfig
+func (_mock *MockOrderHTTPConfig) Timeout() time.Duration {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Timeout")
+	}
+
+	var r0 time.Duration
+	if returnFunc, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+	return r0
+}
+
+// MockOrderHTTPConfig_Timeout_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Timeout'
+type MockOrderHTTPConfig_Timeout_Call struct {
+	*mock.Call
+}
+
+// Timeout is a helper method to define mock.On call
+func (_e *MockOrderHTTPConfig_Expecter) Timeout() *MockOrderHTTPConfig_Timeout_Call {
+	return &MockOrderHTTPConfig_Timeout_Call{Call: _e.mock.On("Timeout")}
+}
+
+func (_c *MockOrderHTTPConfig_Timeout_Call) Run(run func()) *MockOrderHTTPConfig_Timeout_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockOrderHTTPConfig_Timeout_Call) Return(duration time.Duration) *MockOrderHTTPConfig_Timeout_Call {
+	_c.Call.Return(duration)
+	return _c
+}
+
+func (_c *MockOrderHTTPConfig_Timeout_Call) RunAndReturn(run func() time.Duration) *MockOrderHTTPConfig_Timeout_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// URL provides a mock function for the type MockOrderHTTPConfig
+func (_mock *MockOrderHTTPConfig) URL() string {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for URL")
+	}
+
+	var r0 string
+	if returnFunc, ok := ret.Get(0).(func() string); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	return r0
+}
+
+// MockOrderHTTPConfig_URL_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'URL'
+type MockOrderHTTPConfig_URL_Call struct {
+	*mock.Call
+}
+
+// URL is a helper method to define mock.On call
+func (_e *MockOrderHTTPConfig_Expecter) URL() *MockOrderHTTPConfig_URL_Call {
+	return &MockOrderHTTPConfig_URL_Call{Call: _e.mock.On("URL")}
+}
+
+func (_c *MockOrderHTTPConfig_URL_Call) Run(run func()) *MockOrderHTTPConfig_URL_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockOrderHTTPConfig_URL_Call) Return(s string) *MockOrderHTTPConfig_URL_Call {
+	_c.Call.Return(s)
+	return _c
+}
+
+func (_c *MockOrderHTTPConfig_URL_Call) RunAndReturn(run func() string) *MockOrderHTTPConfig_URL_Call {
+	_c.Call.Return(run)
+	return _c
+}
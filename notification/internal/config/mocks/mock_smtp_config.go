@@ -0,0 +1,349 @@
+// Code generated for crafty-ezhik service
+// © Crafty-ezhik 2025.
+
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"time"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockSMTPConfig creates a new instance of MockSMTPConfig. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockSMTPConfig(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockSMTPConfig {
+	mock := &MockSMTPConfig{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockSMTPConfig is an autogenerated mock type for the SMTPConfig type
+type MockSMTPConfig struct {
+	mock.Mock
+}
+
+type MockSMTPConfig_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockSMTPConfig) EXPECT() *MockSMTPConfig_Expecter {
+	return &MockSMTPConfig_Expecter{mock: &_m.Mock}
+}
+
+// From provides a mock function for the type MockSMTPConfig
+func (_mock *MockSMTPConfig) From() string {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for From")
+	}
+
+	var r0 string
+	if returnFunc, ok := ret.Get(0).(func() string); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	return r0
+}
+
+// MockSMTPConfig_From_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'From'
+type MockSMTPConfig_From_Call struct {
+	*mock.Call
+}
+
+// From is a helper method to define mock.On call
+func (_e *MockSMTPConfig_Expecter) From() *MockSMTPConfig_From_Call {
+	return &MockSMTPConfig_From_Call{Call: _e.mock.On("From")}
+}
+
+func (_c *MockSMTPConfig_From_Call) Run(run func()) *MockSMTPConfig_From_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockSMTPConfig_From_Call) Return(s string) *MockSMTPConfig_From_Call {
+	_c.Call.Return(s)
+	return _c
+}
+
+func (_c *MockSMTPConfig_From_Call) RunAndReturn(run func() string) *MockSMTPConfig_From_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Host provides a mock function for the type MockSMTPConfig
+func (_mock *MockSMTPConfig) Host() string {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Host")
+	}
+
+	var r0 string
+	if returnFunc, ok := ret.Get(0).(func() string); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	return r0
+}
+
+// MockSMTPConfig_Host_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Host'
+type MockSMTPConfig_Host_Call struct {
+	*mock.Call
+}
+
+// Host is a helper method to define mock.On call
+func (_e *MockSMTPConfig_Expecter) Host() *MockSMTPConfig_Host_Call {
+	return &MockSMTPConfig_Host_Call{Call: _e.mock.On("Host")}
+}
+
+func (_c *MockSMTPConfig_Host_Call) Run(run func()) *MockSMTPConfig_Host_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockSMTPConfig_Host_Call) Return(s string) *MockSMTPConfig_Host_Call {
+	_c.Call.Return(s)
+	return _c
+}
+
+func (_c *MockSMTPConfig_Host_Call) RunAndReturn(run func() string) *MockSMTPConfig_Host_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Password provides a mock function for the type MockSMTPConfig
+func (_mock *MockSMTPConfig) Password() string {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Password")
+	}
+
+	var r0 string
+	if returnFunc, ok := ret.Get(0).(func() string); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	return r0
+}
+
+// MockSMTPConfig_Password_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Password'
+type MockSMTPConfig_Password_Call struct {
+	*mock.Call
+}
+
+// Password is a helper method to define mock.On call
+func (_e *MockSMTPConfig_Expecter) Password() *MockSMTPConfig_Password_Call {
+	return &MockSMTPConfig_Password_Call{Call: _e.mock.On("Password")}
+}
+
+func (_c *MockSMTPConfig_Password_Call) Run(run func()) *MockSMTPConfig_Password_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockSMTPConfig_Password_Call) Return(s string) *MockSMTPConfig_Password_Call {
+	_c.Call.Return(s)
+	return _c
+}
+
+func (_c *MockSMTPConfig_Password_Call) RunAndReturn(run func() string) *MockSMTPConfig_Password_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Port provides a mock function for the type MockSMTPConfig
+func (_mock *MockSMTPConfig) Port() string {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Port")
+	}
+
+	var r0 string
+	if returnFunc, ok := ret.Get(0).(func() string); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	return r0
+}
+
+// MockSMTPConfig_Port_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Port'
+type MockSMTPConfig_Port_Call struct {
+	*mock.Call
+}
+
+// Port is a helper method to define mock.On call
+func (_e *MockSMTPConfig_Expecter) Port() *MockSMTPConfig_Port_Call {
+	return &MockSMTPConfig_Port_Call{Call: _e.mock.On("Port")}
+}
+
+func (_c *MockSMTPConfig_Port_Call) Run(run func()) *MockSMTPConfig_Port_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockSMTPConfig_Port_Call) Return(s string) *MockSMTPConfig_Port_Call {
+	_c.Call.Return(s)
+	return _c
+}
+
+func (_c *MockSMTPConfig_Port_Call) RunAndReturn(run func() string) *MockSMTPConfig_Port_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// StartTLS provides a mock function for the type MockSMTPConfig
+func (_mock *MockSMTPConfig) StartTLS() bool {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for StartTLS")
+	}
+
+	var r0 bool
+	if returnFunc, ok := ret.Get(0).(func() bool); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	return r0
+}
+
+// MockSMTPConfig_StartTLS_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StartTLS'
+type MockSMTPConfig_StartTLS_Call struct {
+	*mock.Call
+}
+
+// StartTLS is a helper method to define mock.On call
+func (_e *MockSMTPConfig_Expecter) StartTLS() *MockSMTPConfig_StartTLS_Call {
+	return &MockSMTPConfig_StartTLS_Call{Call: _e.mock.On("StartTLS")}
+}
+
+func (_c *MockSMTPConfig_StartTLS_Call) Run(run func()) *MockSMTPConfig_StartTLS_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockSMTPConfig_StartTLS_Call) Return(b bool) *MockSMTPConfig_StartTLS_Call {
+	_c.Call.Return(b)
+	return _c
+}
+
+func (_c *MockSMTPConfig_StartTLS_Call) RunAndReturn(run func() bool) *MockSMTPConfig_StartTLS_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Timeout provides a mock function for the type MockSMTPConfig
+func (_mock *MockSMTPConfig) Timeout() time.Duration {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Timeout")
+	}
+
+	var r0 time.Duration
+	if returnFunc, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+	return r0
+}
+
+// MockSMTPConfig_Timeout_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Timeout'
+type MockSMTPConfig_Timeout_Call struct {
+	*mock.Call
+}
+
+// Timeout is a helper method to define mock.On call
+func (_e *MockSMTPConfig_Expecter) Timeout() *MockSMTPConfig_Timeout_Call {
+	return &MockSMTPConfig_Timeout_Call{Call: _e.mock.On("Timeout")}
+}
+
+func (_c *MockSMTPConfig_Timeout_Call) Run(run func()) *MockSMTPConfig_Timeout_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockSMTPConfig_Timeout_Call) Return(duration time.Duration) *MockSMTPConfig_Timeout_Call {
+	_c.Call.Return(duration)
+	return _c
+}
+
+func (_c *MockSMTPConfig_Timeout_Call) RunAndReturn(run func() time.Duration) *MockSMTPConfig_Timeout_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Username provides a mock function for the type MockSMTPConfig
+func (_mock *MockSMTPConfig) Username() string {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Username")
+	}
+
+	var r0 string
+	if returnFunc, ok := ret.Get(0).(func() string); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	return r0
+}
+
+// MockSMTPConfig_Username_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Username'
+type MockSMTPConfig_Username_Call struct {
+	*mock.Call
+}
+
+// Username is a helper method to define mock.On call
+func (_e *MockSMTPConfig_Expecter) Username() *MockSMTPConfig_Username_Call {
+	return &MockSMTPConfig_Username_Call{Call: _e.mock.On("Username")}
+}
+
+func (_c *MockSMTPConfig_Username_Call) Run(run func()) *MockSMTPConfig_Username_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockSMTPConfig_Username_Call) Return(s string) *MockSMTPConfig_Username_Call {
+	_c.Call.Return(s)
+	return _c
+}
+
+func (_c *MockSMTPConfig_Username_Call) RunAndReturn(run func() string) *MockSMTPConfig_Username_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -38,46 +38,178 @@ func (_m *MockTelegramBotConfig) EXPECT() *MockTelegramBotConfig_Expecter {
 	return &MockTelegramBotConfig_Expecter{mock: &_m.Mock}
 }
 
-// ChatID provides a mock function for the type MockTelegramBotConfig
-func (_mock *MockTelegramBotConfig) ChatID() int64 {
+// APIURL provides a mock function for the type MockTelegramBotConfig
+func (_mock *MockTelegramBotConfig) APIURL() string {
 	ret := _mock.Called()
 
 	if len(ret) == 0 {
-		panic("no return value specified for ChatID")
+		panic("no return value specified for APIURL")
 	}
 
-	var r0 int64
-	if returnFunc, ok := ret.Get(0).(func() int64); ok {
+	var r0 string
+	if returnFunc, ok := ret.Get(0).(func() string); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	return r0
+}
+
+// MockTelegramBotConfig_APIURL_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'APIURL'
+type MockTelegramBotConfig_APIURL_Call struct {
+	*mock.Call
+}
+
+// APIURL is a helper method to define mock.On call
+func (_e *MockTelegramBotConfig_Expecter) APIURL() *MockTelegramBotConfig_APIURL_Call {
+	return &MockTelegramBotConfig_APIURL_Call{Call: _e.mock.On("APIURL")}
+}
+
+func (_c *MockTelegramBotConfig_APIURL_Call) Run(run func()) *MockTelegramBotConfig_APIURL_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockTelegramBotConfig_APIURL_Call) Return(s string) *MockTelegramBotConfig_APIURL_Call {
+	_c.Call.Return(s)
+	return _c
+}
+
+func (_c *MockTelegramBotConfig_APIURL_Call) RunAndReturn(run func() string) *MockTelegramBotConfig_APIURL_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// LinkEncryptionKey provides a mock function for the type MockTelegramBotConfig
+func (_mock *MockTelegramBotConfig) LinkEncryptionKey() string {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for LinkEncryptionKey")
+	}
+
+	var r0 string
+	if returnFunc, ok := ret.Get(0).(func() string); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	return r0
+}
+
+// MockTelegramBotConfig_LinkEncryptionKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LinkEncryptionKey'
+type MockTelegramBotConfig_LinkEncryptionKey_Call struct {
+	*mock.Call
+}
+
+// LinkEncryptionKey is a helper method to define mock.On call
+func (_e *MockTelegramBotConfig_Expecter) LinkEncryptionKey() *MockTelegramBotConfig_LinkEncryptionKey_Call {
+	return &MockTelegramBotConfig_LinkEncryptionKey_Call{Call: _e.mock.On("LinkEncryptionKey")}
+}
+
+func (_c *MockTelegramBotConfig_LinkEncryptionKey_Call) Run(run func()) *MockTelegramBotConfig_LinkEncryptionKey_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockTelegramBotConfig_LinkEncryptionKey_Call) Return(s string) *MockTelegramBotConfig_LinkEncryptionKey_Call {
+	_c.Call.Return(s)
+	return _c
+}
+
+func (_c *MockTelegramBotConfig_LinkEncryptionKey_Call) RunAndReturn(run func() string) *MockTelegramBotConfig_LinkEncryptionKey_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MaxRetries provides a mock function for the type MockTelegramBotConfig
+func (_mock *MockTelegramBotConfig) MaxRetries() int {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for MaxRetries")
+	}
+
+	var r0 int
+	if returnFunc, ok := ret.Get(0).(func() int); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+	return r0
+}
+
+// MockTelegramBotConfig_MaxRetries_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MaxRetries'
+type MockTelegramBotConfig_MaxRetries_Call struct {
+	*mock.Call
+}
+
+// MaxRetries is a helper method to define mock.On call
+func (_e *MockTelegramBotConfig_Expecter) MaxRetries() *MockTelegramBotConfig_MaxRetries_Call {
+	return &MockTelegramBotConfig_MaxRetries_Call{Call: _e.mock.On("MaxRetries")}
+}
+
+func (_c *MockTelegramBotConfig_MaxRetries_Call) Run(run func()) *MockTelegramBotConfig_MaxRetries_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockTelegramBotConfig_MaxRetries_Call) Return(n int) *MockTelegramBotConfig_MaxRetries_Call {
+	_c.Call.Return(n)
+	return _c
+}
+
+func (_c *MockTelegramBotConfig_MaxRetries_Call) RunAndReturn(run func() int) *MockTelegramBotConfig_MaxRetries_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RatePerSecond provides a mock function for the type MockTelegramBotConfig
+func (_mock *MockTelegramBotConfig) RatePerSecond() int {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for RatePerSecond")
+	}
+
+	var r0 int
+	if returnFunc, ok := ret.Get(0).(func() int); ok {
 		r0 = returnFunc()
 	} else {
-		r0 = ret.Get(0).(int64)
+		r0 = ret.Get(0).(int)
 	}
 	return r0
 }
 
-// MockTelegramBotConfig_ChatID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ChatID'
-type MockTelegramBotConfig_ChatID_Call struct {
+// MockTelegramBotConfig_RatePerSecond_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RatePerSecond'
+type MockTelegramBotConfig_RatePerSecond_Call struct {
 	*mock.Call
 }
 
-// ChatID is a helper method to define mock.On call
-func (_e *MockTelegramBotConfig_Expecter) ChatID() *MockTelegramBotConfig_ChatID_Call {
-	return &MockTelegramBotConfig_ChatID_Call{Call: _e.mock.On("ChatID")}
+// RatePerSecond is a helper method to define mock.On call
+func (_e *MockTelegramBotConfig_Expecter) RatePerSecond() *MockTelegramBotConfig_RatePerSecond_Call {
+	return &MockTelegramBotConfig_RatePerSecond_Call{Call: _e.mock.On("RatePerSecond")}
 }
 
-func (_c *MockTelegramBotConfig_ChatID_Call) Run(run func()) *MockTelegramBotConfig_ChatID_Call {
+func (_c *MockTelegramBotConfig_RatePerSecond_Call) Run(run func()) *MockTelegramBotConfig_RatePerSecond_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run()
 	})
 	return _c
 }
 
-func (_c *MockTelegramBotConfig_ChatID_Call) Return(n int64) *MockTelegramBotConfig_ChatID_Call {
+func (_c *MockTelegramBotConfig_RatePerSecond_Call) Return(n int) *MockTelegramBotConfig_RatePerSecond_Call {
 	_c.Call.Return(n)
 	return _c
 }
 
-func (_c *MockTelegramBotConfig_ChatID_Call) RunAndReturn(run func() int64) *MockTelegramBotConfig_ChatID_Call {
+func (_c *MockTelegramBotConfig_RatePerSecond_Call) RunAndReturn(run func() int) *MockTelegramBotConfig_RatePerSecond_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -0,0 +1,305 @@
+// Code generated for crafty-ezhik service
+// © Crafty-ezhik 2025.
+
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"time"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockWebhookConfig creates a new instance of MockWebhookConfig. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockWebhookConfig(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockWebhookConfig {
+	mock := &MockWebhookConfig{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockWebhookConfig is an autogenerated mock type for the WebhookConfig type
+type MockWebhookConfig struct {
+	mock.Mock
+}
+
+type MockWebhookConfig_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockWebhookConfig) EXPECT() *MockWebhookConfig_Expecter {
+	return &MockWebhookConfig_Expecter{mock: &_m.Mock}
+}
+
+// AllowPrivateTargets provides a mock function for the type MockWebhookConfig
+func (_mock *MockWebhookConfig) AllowPrivateTargets() bool {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for AllowPrivateTargets")
+	}
+
+	var r0 bool
+	if returnFunc, ok := ret.Get(0).(func() bool); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	return r0
+}
+
+// MockWebhookConfig_AllowPrivateTargets_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AllowPrivateTargets'
+type MockWebhookConfig_AllowPrivateTargets_Call struct {
+	*mock.Call
+}
+
+// AllowPrivateTargets is a helper method to define mock.On call
+func (_e *MockWebhookConfig_Expecter) AllowPrivateTargets() *MockWebhookConfig_AllowPrivateTargets_Call {
+	return &MockWebhookConfig_AllowPrivateTargets_Call{Call: _e.mock.On("AllowPrivateTargets")}
+}
+
+func (_c *MockWebhookConfig_AllowPrivateTargets_Call) Run(run func()) *MockWebhookConfig_AllowPrivateTargets_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockWebhookConfig_AllowPrivateTargets_Call) Return(b bool) *MockWebhookConfig_AllowPrivateTargets_Call {
+	_c.Call.Return(b)
+	return _c
+}
+
+func (_c *MockWebhookConfig_AllowPrivateTargets_Call) RunAndReturn(run func() bool) *MockWebhookConfig_AllowPrivateTargets_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RequestTimeout provides a mock function for the type MockWebhookConfig
+func (_mock *MockWebhookConfig) RequestTimeout() time.Duration {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for RequestTimeout")
+	}
+
+	var r0 time.Duration
+	if returnFunc, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+	return r0
+}
+
+// MockWebhookConfig_RequestTimeout_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RequestTimeout'
+type MockWebhookConfig_RequestTimeout_Call struct {
+	*mock.Call
+}
+
+// RequestTimeout is a helper method to define mock.On call
+func (_e *MockWebhookConfig_Expecter) RequestTimeout() *MockWebhookConfig_RequestTimeout_Call {
+	return &MockWebhookConfig_RequestTimeout_Call{Call: _e.mock.On("RequestTimeout")}
+}
+
+func (_c *MockWebhookConfig_RequestTimeout_Call) Run(run func()) *MockWebhookConfig_RequestTimeout_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockWebhookConfig_RequestTimeout_Call) Return(duration time.Duration) *MockWebhookConfig_RequestTimeout_Call {
+	_c.Call.Return(duration)
+	return _c
+}
+
+func (_c *MockWebhookConfig_RequestTimeout_Call) RunAndReturn(run func() time.Duration) *MockWebhookConfig_RequestTimeout_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RetryAttempts provides a mock function for the type MockWebhookConfig
+func (_mock *MockWebhookConfig) RetryAttempts() int {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for RetryAttempts")
+	}
+
+	var r0 int
+	if returnFunc, ok := ret.Get(0).(func() int); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+	return r0
+}
+
+// MockWebhookConfig_RetryAttempts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RetryAttempts'
+type MockWebhookConfig_RetryAttempts_Call struct {
+	*mock.Call
+}
+
+// RetryAttempts is a helper method to define mock.On call
+func (_e *MockWebhookConfig_Expecter) RetryAttempts() *MockWebhookConfig_RetryAttempts_Call {
+	return &MockWebhookConfig_RetryAttempts_Call{Call: _e.mock.On("RetryAttempts")}
+}
+
+func (_c *MockWebhookConfig_RetryAttempts_Call) Run(run func()) *MockWebhookConfig_RetryAttempts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockWebhookConfig_RetryAttempts_Call) Return(n int) *MockWebhookConfig_RetryAttempts_Call {
+	_c.Call.Return(n)
+	return _c
+}
+
+func (_c *MockWebhookConfig_RetryAttempts_Call) RunAndReturn(run func() int) *MockWebhookConfig_RetryAttempts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RetryBackoff provides a mock function for the type MockWebhookConfig
+func (_mock *MockWebhookConfig) RetryBackoff() time.Duration {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for RetryBackoff")
+	}
+
+	var r0 time.Duration
+	if returnFunc, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+	return r0
+}
+
+// MockWebhookConfig_RetryBackoff_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RetryBackoff'
+type MockWebhookConfig_RetryBackoff_Call struct {
+	*mock.Call
+}
+
+// RetryBackoff is a helper method to define mock.On call
+func (_e *MockWebhookConfig_Expecter) RetryBackoff() *MockWebhookConfig_RetryBackoff_Call {
+	return &MockWebhookConfig_RetryBackoff_Call{Call: _e.mock.On("RetryBackoff")}
+}
+
+func (_c *MockWebhookConfig_RetryBackoff_Call) Run(run func()) *MockWebhookConfig_RetryBackoff_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockWebhookConfig_RetryBackoff_Call) Return(duration time.Duration) *MockWebhookConfig_RetryBackoff_Call {
+	_c.Call.Return(duration)
+	return _c
+}
+
+func (_c *MockWebhookConfig_RetryBackoff_Call) RunAndReturn(run func() time.Duration) *MockWebhookConfig_RetryBackoff_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RetryMaxBackoff provides a mock function for the type MockWebhookConfig
+func (_mock *MockWebhookConfig) RetryMaxBackoff() time.Duration {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for RetryMaxBackoff")
+	}
+
+	var r0 time.Duration
+	if returnFunc, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+	return r0
+}
+
+// MockWebhookConfig_RetryMaxBackoff_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RetryMaxBackoff'
+type MockWebhookConfig_RetryMaxBackoff_Call struct {
+	*mock.Call
+}
+
+// RetryMaxBackoff is a helper method to define mock.On call
+func (_e *MockWebhookConfig_Expecter) RetryMaxBackoff() *MockWebhookConfig_RetryMaxBackoff_Call {
+	return &MockWebhookConfig_RetryMaxBackoff_Call{Call: _e.mock.On("RetryMaxBackoff")}
+}
+
+func (_c *MockWebhookConfig_RetryMaxBackoff_Call) Run(run func()) *MockWebhookConfig_RetryMaxBackoff_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockWebhookConfig_RetryMaxBackoff_Call) Return(duration time.Duration) *MockWebhookConfig_RetryMaxBackoff_Call {
+	_c.Call.Return(duration)
+	return _c
+}
+
+func (_c *MockWebhookConfig_RetryMaxBackoff_Call) RunAndReturn(run func() time.Duration) *MockWebhookConfig_RetryMaxBackoff_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SigningKey provides a mock function for the type MockWebhookConfig
+func (_mock *MockWebhookConfig) SigningKey() string {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for SigningKey")
+	}
+
+	var r0 string
+	if returnFunc, ok := ret.Get(0).(func() string); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	return r0
+}
+
+// MockWebhookConfig_SigningKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SigningKey'
+type MockWebhookConfig_SigningKey_Call struct {
+	*mock.Call
+}
+
+// SigningKey is a helper method to define mock.On call
+func (_e *MockWebhookConfig_Expecter) SigningKey() *MockWebhookConfig_SigningKey_Call {
+	return &MockWebhookConfig_SigningKey_Call{Call: _e.mock.On("SigningKey")}
+}
+
+func (_c *MockWebhookConfig_SigningKey_Call) Run(run func()) *MockWebhookConfig_SigningKey_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockWebhookConfig_SigningKey_Call) Return(s string) *MockWebhookConfig_SigningKey_Call {
+	_c.Call.Return(s)
+	return _c
+}
+
+func (_c *MockWebhookConfig_SigningKey_Call) RunAndReturn(run func() string) *MockWebhookConfig_SigningKey_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -42,5 +42,9 @@ func (d *decoder) Decode(data []byte) (model.OrderAssembledEvent, error) {
 
 	event.BuildTimeSec = int(pb.BuildTimeSec)
 
+	if pb.AssembledAt != nil {
+		event.AssembledAt = pb.AssembledAt.AsTime()
+	}
+
 	return event, nil
 }
@@ -0,0 +1,54 @@
+package decoder
+
+import (
+	"fmt"
+
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/crafty-ezhik/rocket-factory/notification/internal/model"
+	eventsV1 "github.com/crafty-ezhik/rocket-factory/shared/pkg/proto/events/v1"
+)
+
+type orderAssemblyFailedDecoder struct{}
+
+func NewOrderAssemblyFailedDecoder() *orderAssemblyFailedDecoder {
+	return &orderAssemblyFailedDecoder{}
+}
+
+func (d *orderAssemblyFailedDecoder) Decode(data []byte) (model.OrderAssemblyFailedEvent, error) {
+	var pb eventsV1.ShipAssemblyFailed
+	if err := proto.Unmarshal(data, &pb); err != nil {
+		return model.OrderAssemblyFailedEvent{}, fmt.Errorf("failed to unmarshal protobuf: %w", err)
+	}
+
+	var event model.OrderAssemblyFailedEvent
+
+	eventUUID, err := uuid.Parse(pb.EventUuid)
+	if err != nil {
+		return model.OrderAssemblyFailedEvent{}, fmt.Errorf("failed to parse event uuid: %w", err)
+	}
+	event.EventUUID = eventUUID
+
+	orderUUID, err := uuid.Parse(pb.OrderUuid)
+	if err != nil {
+		return model.OrderAssemblyFailedEvent{}, fmt.Errorf("failed to parse order uuid: %w", err)
+	}
+	event.OrderUUID = orderUUID
+
+	userUUID, err := uuid.Parse(pb.UserUuid)
+	if err != nil {
+		return model.OrderAssemblyFailedEvent{}, fmt.Errorf("failed to parse user uuid: %w", err)
+	}
+	event.UserUUID = userUUID
+
+	event.Stage = pb.Stage
+	event.Reason = pb.Reason
+	event.Attempt = int(pb.Attempt)
+
+	if pb.FailedAt != nil {
+		event.FailedAt = pb.FailedAt.AsTime()
+	}
+
+	return event, nil
+}
@@ -50,5 +50,9 @@ func (d *decoderPaid) Decode(data []byte) (model.OrderPaidEvent, error) {
 
 	event.PaymentMethod = pb.PaymentMethod
 
+	if pb.PaidAt != nil {
+		event.PaidAt = pb.PaidAt.AsTime()
+	}
+
 	return event, nil
 }
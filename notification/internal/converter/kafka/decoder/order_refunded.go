@@ -0,0 +1,56 @@
+package decoder
+
+import (
+	"fmt"
+
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/crafty-ezhik/rocket-factory/notification/internal/model"
+	eventsV1 "github.com/crafty-ezhik/rocket-factory/shared/pkg/proto/events/v1"
+)
+
+type orderRefundedDecoder struct{}
+
+func NewOrderRefundedDecoder() *orderRefundedDecoder { return &orderRefundedDecoder{} }
+
+func (d *orderRefundedDecoder) Decode(data []byte) (model.OrderRefundedEvent, error) {
+	var pb eventsV1.OrderRefunded
+	if err := proto.Unmarshal(data, &pb); err != nil {
+		return model.OrderRefundedEvent{}, fmt.Errorf("failed to unmarshal protobuf: %w", err)
+	}
+
+	var event model.OrderRefundedEvent
+
+	eventUUID, err := uuid.Parse(pb.EventUuid)
+	if err != nil {
+		return model.OrderRefundedEvent{}, fmt.Errorf("failed to parse event uuid: %w", err)
+	}
+	event.EventUUID = eventUUID
+
+	orderUUID, err := uuid.Parse(pb.OrderUuid)
+	if err != nil {
+		return model.OrderRefundedEvent{}, fmt.Errorf("failed to parse order uuid: %w", err)
+	}
+	event.OrderUUID = orderUUID
+
+	userUUID, err := uuid.Parse(pb.UserUuid)
+	if err != nil {
+		return model.OrderRefundedEvent{}, fmt.Errorf("failed to parse user uuid: %w", err)
+	}
+	event.UserUUID = userUUID
+
+	transactionUUID, err := uuid.Parse(pb.TransactionUuid)
+	if err != nil {
+		return model.OrderRefundedEvent{}, fmt.Errorf("failed to parse transaction uuid: %w", err)
+	}
+	event.TransactionUUID = transactionUUID
+
+	event.RefundedAmount = pb.RefundedAmount
+
+	if pb.RefundedAt != nil {
+		event.RefundedAt = pb.RefundedAt.AsTime()
+	}
+
+	return event, nil
+}
@@ -9,3 +9,11 @@ type OrderPaidDecoder interface {
 type OrderAssembledDecoder interface {
 	Decode(data []byte) (model.OrderAssembledEvent, error)
 }
+
+type OrderRefundedDecoder interface {
+	Decode(data []byte) (model.OrderRefundedEvent, error)
+}
+
+type OrderAssemblyFailedDecoder interface {
+	Decode(data []byte) (model.OrderAssemblyFailedEvent, error)
+}
@@ -0,0 +1,15 @@
+package model
+
+import "errors"
+
+var (
+	ErrUserNotFound  = errors.New("user not found")
+	ErrInvalidTarget = errors.New("invalid notification target")
+
+	ErrWebhookDeliveryNotFound = errors.New("webhook delivery not found")
+
+	ErrSessionExpired          = errors.New("session expired")
+	ErrChatNotLinked           = errors.New("telegram chat is not linked")
+	ErrOrderNotFound           = errors.New("order not found")
+	ErrNotificationMethodExist = errors.New("notification method already exists")
+)
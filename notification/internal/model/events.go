@@ -1,15 +1,44 @@
 package model
 
 import (
+	"time"
+
 	"github.com/google/uuid"
 )
 
+// EventType - тип события заказа. Используется в реестре шаблонов, в теле webhook и в заголовке X-Rocket-Event
+type EventType string
+
+const (
+	EventOrderPaid      EventType = "order.paid"
+	EventOrderAssembled EventType = "order.assembled"
+	EventOrderRefunded  EventType = "order.refunded"
+	// EventOrderAssemblyFailed - сборка завершилась ошибкой, дальше заказ соберут заново или вернут средства
+	EventOrderAssemblyFailed EventType = "order.assembly_failed"
+)
+
+func (t EventType) String() string {
+	return string(t)
+}
+
 type OrderPaidEvent struct {
 	EventUUID       uuid.UUID
 	OrderUUID       uuid.UUID
 	UserUUID        uuid.UUID
 	PaymentMethod   string
 	TransactionUUID uuid.UUID
+	// PaidAt - время оплаты по данным order. Нулевое, если событие отправлено без него
+	PaidAt time.Time
+}
+
+type OrderRefundedEvent struct {
+	EventUUID       uuid.UUID
+	OrderUUID       uuid.UUID
+	UserUUID        uuid.UUID
+	TransactionUUID uuid.UUID
+	RefundedAmount  float64
+	// RefundedAt - время возврата средств. Нулевое, если событие отправлено без него
+	RefundedAt time.Time
 }
 
 type OrderAssembledEvent struct {
@@ -17,4 +46,19 @@ type OrderAssembledEvent struct {
 	OrderUUID    uuid.UUID
 	UserUUID     uuid.UUID
 	BuildTimeSec int
+	// AssembledAt - время завершения сборки. Нулевое, если событие отправлено без него
+	AssembledAt time.Time
+}
+
+type OrderAssemblyFailedEvent struct {
+	EventUUID uuid.UUID
+	OrderUUID uuid.UUID
+	UserUUID  uuid.UUID
+	// Stage - этап сборки, на котором произошла ошибка, например ENGINE
+	Stage string
+	// Reason - причина ошибки: PART_DEFECT или RETRIES_EXHAUSTED
+	Reason  string
+	Attempt int
+	// FailedAt - время ошибки. Нулевое, если событие отправлено без него
+	FailedAt time.Time
 }
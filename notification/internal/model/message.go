@@ -0,0 +1,9 @@
+package model
+
+// Message - сообщение, отрисованное для конкретного канала.
+// Канал использует нужные ему части: Telegram - Text, email - Subject, Text и HTML
+type Message struct {
+	Subject string
+	Text    string
+	HTML    string
+}
@@ -0,0 +1,17 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Order - заказ пользователя из Order Service. Status и PaymentMethod - значения API заказов
+type Order struct {
+	UUID          uuid.UUID
+	Status        string
+	TotalPrice    float64
+	PaymentMethod string
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
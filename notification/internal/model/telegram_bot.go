@@ -0,0 +1,64 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// TelegramLink - привязка чата Telegram к пользователю IAM.
+// Команды бота выполняются от имени пользователя с сессией SessionUUID
+type TelegramLink struct {
+	ChatID      int64
+	UserUUID    uuid.UUID
+	SessionUUID uuid.UUID
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+// ChatMessage - команда, полученная ботом. Args - текст после команды
+type ChatMessage struct {
+	ChatID    int64
+	MessageID int
+	// LanguageCode - язык клиента Telegram, используется пока чат не привязан
+	LanguageCode string
+	// Private - команда отправлена в личный чат с ботом
+	Private bool
+	Args    string
+}
+
+// BotReply - ответ бота на команду, имя шаблона в templates/telegram_bot
+type BotReply string
+
+const (
+	BotReplyHelp           BotReply = "help"
+	BotReplyPrivateOnly    BotReply = "private_only"
+	BotReplyLinkUsage      BotReply = "link_usage"
+	BotReplyLinked         BotReply = "linked"
+	BotReplySessionExpired BotReply = "session_expired"
+	BotReplyNotLinked      BotReply = "not_linked"
+	BotReplyOrders         BotReply = "orders"
+	BotReplyStatusUsage    BotReply = "status_usage"
+	BotReplyOrderStatus    BotReply = "order_status"
+	BotReplyOrderNotFound  BotReply = "order_not_found"
+	BotReplyError          BotReply = "error"
+)
+
+// BotReplies - все ответы бота, шаблон каждого обязателен на языке по умолчанию
+var BotReplies = []BotReply{
+	BotReplyHelp,
+	BotReplyPrivateOnly,
+	BotReplyLinkUsage,
+	BotReplyLinked,
+	BotReplySessionExpired,
+	BotReplyNotLinked,
+	BotReplyOrders,
+	BotReplyStatusUsage,
+	BotReplyOrderStatus,
+	BotReplyOrderNotFound,
+	BotReplyError,
+}
+
+func (r BotReply) String() string {
+	return string(r)
+}
@@ -0,0 +1,43 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Провайдеры каналов уведомлений, см. NotificationMethod.ProviderName в IAM
+const (
+	ProviderTelegram = "telegram"
+	ProviderEmail    = "email"
+)
+
+// Языки уведомлений. Для языка без шаблона используется DefaultLocale
+const (
+	LocaleRU = "ru"
+	LocaleEN = "en"
+
+	DefaultLocale = LocaleRU
+)
+
+type User struct {
+	UUID                uuid.UUID
+	NotificationMethods []NotificationMethod
+	// Locale и Timezone - настройки пользователя в IAM, могут быть пустыми
+	Locale   string
+	Timezone string
+}
+
+// NotificationMethod - канал уведомлений пользователя: провайдер и адрес назначения (email, чат-id)
+type NotificationMethod struct {
+	ProviderName string
+	Target       string
+}
+
+// Recipient - получатель уведомления: каналы, язык и часовой пояс, в котором показываются даты
+type Recipient struct {
+	UserUUID            uuid.UUID
+	NotificationMethods []NotificationMethod
+	Locale              string
+	Location            *time.Location
+}
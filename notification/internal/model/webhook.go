@@ -0,0 +1,87 @@
+package model
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ProviderWebhook - канал доставки событий заказа на HTTP-адрес интегратора
+const ProviderWebhook = "webhook"
+
+type WebhookDeliveryStatus string
+
+const (
+	WebhookDeliveryStatusPENDING   WebhookDeliveryStatus = "PENDING"
+	WebhookDeliveryStatusDELIVERED WebhookDeliveryStatus = "DELIVERED"
+	WebhookDeliveryStatusFAILED    WebhookDeliveryStatus = "FAILED"
+)
+
+func (s WebhookDeliveryStatus) String() string {
+	return string(s)
+}
+
+func (s WebhookDeliveryStatus) IsValid() bool {
+	switch s {
+	case WebhookDeliveryStatusPENDING, WebhookDeliveryStatusDELIVERED, WebhookDeliveryStatusFAILED:
+		return true
+	}
+	return false
+}
+
+// WebhookDelivery - запись журнала доставки события на один адрес
+type WebhookDelivery struct {
+	UUID           uuid.UUID
+	EventUUID      uuid.UUID
+	EventType      EventType
+	UserUUID       uuid.UUID
+	Target         string
+	Payload        []byte
+	Status         WebhookDeliveryStatus
+	Attempts       int
+	LastError      *string
+	LastStatusCode *int
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+	DeliveredAt    *time.Time
+}
+
+// WebhookAttempt - результат одной попытки доставки
+type WebhookAttempt struct {
+	Status WebhookDeliveryStatus
+	// StatusCode - HTTP-код ответа, nil если ответ не получен
+	StatusCode *int
+	Error      *string
+}
+
+// WebhookDeliveryFilter - фильтр журнала доставки. Пустые поля не ограничивают выборку
+type WebhookDeliveryFilter struct {
+	UserUUID  *uuid.UUID
+	EventUUID *uuid.UUID
+	Status    *WebhookDeliveryStatus
+	Limit     uint64
+}
+
+// WebhookRequest - подписываемый запрос к адресу интегратора
+type WebhookRequest struct {
+	DeliveryUUID uuid.UUID
+	// UserUUID - владелец адреса, секрет подписи выводится из пользователя и адреса
+	UserUUID  uuid.UUID
+	EventType EventType
+	Body      []byte
+}
+
+// WebhookResponseError - адрес интегратора ответил кодом, отличным от 2xx
+type WebhookResponseError struct {
+	StatusCode int
+}
+
+func (e *WebhookResponseError) Error() string {
+	return "webhook responded with status " + strconv.Itoa(e.StatusCode)
+}
+
+// Retryable - 5xx, 408 и 429 считаются временными, остальные ответы повторять бессмысленно
+func (e *WebhookResponseError) Retryable() bool {
+	return e.StatusCode >= 500 || e.StatusCode == 408 || e.StatusCode == 429
+}
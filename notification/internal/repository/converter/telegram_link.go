@@ -0,0 +1,19 @@
+package converter
+
+import (
+	"github.com/google/uuid"
+
+	serviceModel "github.com/crafty-ezhik/rocket-factory/notification/internal/model"
+	repoModel "github.com/crafty-ezhik/rocket-factory/notification/internal/repository/model"
+)
+
+// TelegramLinkToServiceModel - привязка с уже расшифрованной сессией
+func TelegramLinkToServiceModel(link repoModel.TelegramLink, sessionUUID uuid.UUID) serviceModel.TelegramLink {
+	return serviceModel.TelegramLink{
+		ChatID:      link.ChatID,
+		UserUUID:    link.UserUUID,
+		SessionUUID: sessionUUID,
+		CreatedAt:   link.CreatedAt,
+		UpdatedAt:   link.UpdatedAt,
+	}
+}
@@ -0,0 +1,24 @@
+package converter
+
+import (
+	serviceModel "github.com/crafty-ezhik/rocket-factory/notification/internal/model"
+	repoModel "github.com/crafty-ezhik/rocket-factory/notification/internal/repository/model"
+)
+
+func WebhookDeliveryToServiceModel(delivery repoModel.WebhookDelivery) serviceModel.WebhookDelivery {
+	return serviceModel.WebhookDelivery{
+		UUID:           delivery.UUID,
+		EventUUID:      delivery.EventUUID,
+		EventType:      serviceModel.EventType(delivery.EventType),
+		UserUUID:       delivery.UserUUID,
+		Target:         delivery.Target,
+		Payload:        delivery.Payload,
+		Status:         serviceModel.WebhookDeliveryStatus(delivery.Status),
+		Attempts:       delivery.Attempts,
+		LastError:      delivery.LastError,
+		LastStatusCode: delivery.LastStatusCode,
+		CreatedAt:      delivery.CreatedAt,
+		UpdatedAt:      delivery.UpdatedAt,
+		DeliveredAt:    delivery.DeliveredAt,
+	}
+}
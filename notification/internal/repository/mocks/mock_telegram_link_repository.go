@@ -0,0 +1,222 @@
+// Code generated for crafty-ezhik service
+// © Crafty-ezhik 2025.
+
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/crafty-ezhik/rocket-factory/notification/internal/model"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockTelegramLinkRepository creates a new instance of MockTelegramLinkRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockTelegramLinkRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockTelegramLinkRepository {
+	mock := &MockTelegramLinkRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockTelegramLinkRepository is an autogenerated mock type for the TelegramLinkRepository type
+type MockTelegramLinkRepository struct {
+	mock.Mock
+}
+
+type MockTelegramLinkRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockTelegramLinkRepository) EXPECT() *MockTelegramLinkRepository_Expecter {
+	return &MockTelegramLinkRepository_Expecter{mock: &_m.Mock}
+}
+
+// Delete provides a mock function for the type MockTelegramLinkRepository
+func (_mock *MockTelegramLinkRepository) Delete(ctx context.Context, chatID int64) error {
+	ret := _mock.Called(ctx, chatID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) error); ok {
+		r0 = returnFunc(ctx, chatID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockTelegramLinkRepository_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type MockTelegramLinkRepository_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - chatID int64
+func (_e *MockTelegramLinkRepository_Expecter) Delete(ctx interface{}, chatID interface{}) *MockTelegramLinkRepository_Delete_Call {
+	return &MockTelegramLinkRepository_Delete_Call{Call: _e.mock.On("Delete", ctx, chatID)}
+}
+
+func (_c *MockTelegramLinkRepository_Delete_Call) Run(run func(ctx context.Context, chatID int64)) *MockTelegramLinkRepository_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int64
+		if args[1] != nil {
+			arg1 = args[1].(int64)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockTelegramLinkRepository_Delete_Call) Return(err error) *MockTelegramLinkRepository_Delete_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockTelegramLinkRepository_Delete_Call) RunAndReturn(run func(ctx context.Context, chatID int64) error) *MockTelegramLinkRepository_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Get provides a mock function for the type MockTelegramLinkRepository
+func (_mock *MockTelegramLinkRepository) Get(ctx context.Context, chatID int64) (model.TelegramLink, error) {
+	ret := _mock.Called(ctx, chatID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 model.TelegramLink
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) (model.TelegramLink, error)); ok {
+		return returnFunc(ctx, chatID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) model.TelegramLink); ok {
+		r0 = returnFunc(ctx, chatID)
+	} else {
+		r0 = ret.Get(0).(model.TelegramLink)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = returnFunc(ctx, chatID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockTelegramLinkRepository_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
+type MockTelegramLinkRepository_Get_Call struct {
+	*mock.Call
+}
+
+// Get is a helper method to define mock.On call
+//   - ctx context.Context
+//   - chatID int64
+func (_e *MockTelegramLinkRepository_Expecter) Get(ctx interface{}, chatID interface{}) *MockTelegramLinkRepository_Get_Call {
+	return &MockTelegramLinkRepository_Get_Call{Call: _e.mock.On("Get", ctx, chatID)}
+}
+
+func (_c *MockTelegramLinkRepository_Get_Call) Run(run func(ctx context.Context, chatID int64)) *MockTelegramLinkRepository_Get_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int64
+		if args[1] != nil {
+			arg1 = args[1].(int64)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockTelegramLinkRepository_Get_Call) Return(telegramLink model.TelegramLink, err error) *MockTelegramLinkRepository_Get_Call {
+	_c.Call.Return(telegramLink, err)
+	return _c
+}
+
+func (_c *MockTelegramLinkRepository_Get_Call) RunAndReturn(run func(ctx context.Context, chatID int64) (model.TelegramLink, error)) *MockTelegramLinkRepository_Get_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Save provides a mock function for the type MockTelegramLinkRepository
+func (_mock *MockTelegramLinkRepository) Save(ctx context.Context, link model.TelegramLink) error {
+	ret := _mock.Called(ctx, link)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Save")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, model.TelegramLink) error); ok {
+		r0 = returnFunc(ctx, link)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockTelegramLinkRepository_Save_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Save'
+type MockTelegramLinkRepository_Save_Call struct {
+	*mock.Call
+}
+
+// Save is a helper method to define mock.On call
+//   - ctx context.Context
+//   - link model.TelegramLink
+func (_e *MockTelegramLinkRepository_Expecter) Save(ctx interface{}, link interface{}) *MockTelegramLinkRepository_Save_Call {
+	return &MockTelegramLinkRepository_Save_Call{Call: _e.mock.On("Save", ctx, link)}
+}
+
+func (_c *MockTelegramLinkRepository_Save_Call) Run(run func(ctx context.Context, link model.TelegramLink)) *MockTelegramLinkRepository_Save_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 model.TelegramLink
+		if args[1] != nil {
+			arg1 = args[1].(model.TelegramLink)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockTelegramLinkRepository_Save_Call) Return(err error) *MockTelegramLinkRepository_Save_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockTelegramLinkRepository_Save_Call) RunAndReturn(run func(ctx context.Context, link model.TelegramLink) error) *MockTelegramLinkRepository_Save_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -0,0 +1,306 @@
+// Code generated for crafty-ezhik service
+// © Crafty-ezhik 2025.
+
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/crafty-ezhik/rocket-factory/notification/internal/model"
+	"github.com/google/uuid"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockWebhookDeliveryRepository creates a new instance of MockWebhookDeliveryRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockWebhookDeliveryRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockWebhookDeliveryRepository {
+	mock := &MockWebhookDeliveryRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockWebhookDeliveryRepository is an autogenerated mock type for the WebhookDeliveryRepository type
+type MockWebhookDeliveryRepository struct {
+	mock.Mock
+}
+
+type MockWebhookDeliveryRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockWebhookDeliveryRepository) EXPECT() *MockWebhookDeliveryRepository_Expecter {
+	return &MockWebhookDeliveryRepository_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function for the type MockWebhookDeliveryRepository
+func (_mock *MockWebhookDeliveryRepository) Create(ctx context.Context, delivery model.WebhookDelivery) (model.WebhookDelivery, error) {
+	ret := _mock.Called(ctx, delivery)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 model.WebhookDelivery
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, model.WebhookDelivery) (model.WebhookDelivery, error)); ok {
+		return returnFunc(ctx, delivery)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, model.WebhookDelivery) model.WebhookDelivery); ok {
+		r0 = returnFunc(ctx, delivery)
+	} else {
+		r0 = ret.Get(0).(model.WebhookDelivery)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, model.WebhookDelivery) error); ok {
+		r1 = returnFunc(ctx, delivery)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockWebhookDeliveryRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockWebhookDeliveryRepository_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - delivery model.WebhookDelivery
+func (_e *MockWebhookDeliveryRepository_Expecter) Create(ctx interface{}, delivery interface{}) *MockWebhookDeliveryRepository_Create_Call {
+	return &MockWebhookDeliveryRepository_Create_Call{Call: _e.mock.On("Create", ctx, delivery)}
+}
+
+func (_c *MockWebhookDeliveryRepository_Create_Call) Run(run func(ctx context.Context, delivery model.WebhookDelivery)) *MockWebhookDeliveryRepository_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 model.WebhookDelivery
+		if args[1] != nil {
+			arg1 = args[1].(model.WebhookDelivery)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockWebhookDeliveryRepository_Create_Call) Return(webhookDelivery model.WebhookDelivery, err error) *MockWebhookDeliveryRepository_Create_Call {
+	_c.Call.Return(webhookDelivery, err)
+	return _c
+}
+
+func (_c *MockWebhookDeliveryRepository_Create_Call) RunAndReturn(run func(ctx context.Context, delivery model.WebhookDelivery) (model.WebhookDelivery, error)) *MockWebhookDeliveryRepository_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Get provides a mock function for the type MockWebhookDeliveryRepository
+func (_mock *MockWebhookDeliveryRepository) Get(ctx context.Context, deliveryUUID uuid.UUID) (model.WebhookDelivery, error) {
+	ret := _mock.Called(ctx, deliveryUUID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 model.WebhookDelivery
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (model.WebhookDelivery, error)); ok {
+		return returnFunc(ctx, deliveryUUID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) model.WebhookDelivery); ok {
+		r0 = returnFunc(ctx, deliveryUUID)
+	} else {
+		r0 = ret.Get(0).(model.WebhookDelivery)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, deliveryUUID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockWebhookDeliveryRepository_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
+type MockWebhookDeliveryRepository_Get_Call struct {
+	*mock.Call
+}
+
+// Get is a helper method to define mock.On call
+//   - ctx context.Context
+//   - deliveryUUID uuid.UUID
+func (_e *MockWebhookDeliveryRepository_Expecter) Get(ctx interface{}, deliveryUUID interface{}) *MockWebhookDeliveryRepository_Get_Call {
+	return &MockWebhookDeliveryRepository_Get_Call{Call: _e.mock.On("Get", ctx, deliveryUUID)}
+}
+
+func (_c *MockWebhookDeliveryRepository_Get_Call) Run(run func(ctx context.Context, deliveryUUID uuid.UUID)) *MockWebhookDeliveryRepository_Get_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockWebhookDeliveryRepository_Get_Call) Return(webhookDelivery model.WebhookDelivery, err error) *MockWebhookDeliveryRepository_Get_Call {
+	_c.Call.Return(webhookDelivery, err)
+	return _c
+}
+
+func (_c *MockWebhookDeliveryRepository_Get_Call) RunAndReturn(run func(ctx context.Context, deliveryUUID uuid.UUID) (model.WebhookDelivery, error)) *MockWebhookDeliveryRepository_Get_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// List provides a mock function for the type MockWebhookDeliveryRepository
+func (_mock *MockWebhookDeliveryRepository) List(ctx context.Context, filter model.WebhookDeliveryFilter) ([]model.WebhookDelivery, error) {
+	ret := _mock.Called(ctx, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for List")
+	}
+
+	var r0 []model.WebhookDelivery
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, model.WebhookDeliveryFilter) ([]model.WebhookDelivery, error)); ok {
+		return returnFunc(ctx, filter)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, model.WebhookDeliveryFilter) []model.WebhookDelivery); ok {
+		r0 = returnFunc(ctx, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.WebhookDelivery)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, model.WebhookDeliveryFilter) error); ok {
+		r1 = returnFunc(ctx, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockWebhookDeliveryRepository_List_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'List'
+type MockWebhookDeliveryRepository_List_Call struct {
+	*mock.Call
+}
+
+// List is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filter model.WebhookDeliveryFilter
+func (_e *MockWebhookDeliveryRepository_Expecter) List(ctx interface{}, filter interface{}) *MockWebhookDeliveryRepository_List_Call {
+	return &MockWebhookDeliveryRepository_List_Call{Call: _e.mock.On("List", ctx, filter)}
+}
+
+func (_c *MockWebhookDeliveryRepository_List_Call) Run(run func(ctx context.Context, filter model.WebhookDeliveryFilter)) *MockWebhookDeliveryRepository_List_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 model.WebhookDeliveryFilter
+		if args[1] != nil {
+			arg1 = args[1].(model.WebhookDeliveryFilter)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockWebhookDeliveryRepository_List_Call) Return(webhookDeliverys []model.WebhookDelivery, err error) *MockWebhookDeliveryRepository_List_Call {
+	_c.Call.Return(webhookDeliverys, err)
+	return _c
+}
+
+func (_c *MockWebhookDeliveryRepository_List_Call) RunAndReturn(run func(ctx context.Context, filter model.WebhookDeliveryFilter) ([]model.WebhookDelivery, error)) *MockWebhookDeliveryRepository_List_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SaveAttempt provides a mock function for the type MockWebhookDeliveryRepository
+func (_mock *MockWebhookDeliveryRepository) SaveAttempt(ctx context.Context, deliveryUUID uuid.UUID, attempt model.WebhookAttempt) error {
+	ret := _mock.Called(ctx, deliveryUUID, attempt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveAttempt")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, model.WebhookAttempt) error); ok {
+		r0 = returnFunc(ctx, deliveryUUID, attempt)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockWebhookDeliveryRepository_SaveAttempt_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveAttempt'
+type MockWebhookDeliveryRepository_SaveAttempt_Call struct {
+	*mock.Call
+}
+
+// SaveAttempt is a helper method to define mock.On call
+//   - ctx context.Context
+//   - deliveryUUID uuid.UUID
+//   - attempt model.WebhookAttempt
+func (_e *MockWebhookDeliveryRepository_Expecter) SaveAttempt(ctx interface{}, deliveryUUID interface{}, attempt interface{}) *MockWebhookDeliveryRepository_SaveAttempt_Call {
+	return &MockWebhookDeliveryRepository_SaveAttempt_Call{Call: _e.mock.On("SaveAttempt", ctx, deliveryUUID, attempt)}
+}
+
+func (_c *MockWebhookDeliveryRepository_SaveAttempt_Call) Run(run func(ctx context.Context, deliveryUUID uuid.UUID, attempt model.WebhookAttempt)) *MockWebhookDeliveryRepository_SaveAttempt_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 model.WebhookAttempt
+		if args[2] != nil {
+			arg2 = args[2].(model.WebhookAttempt)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockWebhookDeliveryRepository_SaveAttempt_Call) Return(err error) *MockWebhookDeliveryRepository_SaveAttempt_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockWebhookDeliveryRepository_SaveAttempt_Call) RunAndReturn(run func(ctx context.Context, deliveryUUID uuid.UUID, attempt model.WebhookAttempt) error) *MockWebhookDeliveryRepository_SaveAttempt_Call {
+	_c.Call.Return(run)
+	return _c
+}
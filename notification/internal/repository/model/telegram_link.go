@@ -0,0 +1,16 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type TelegramLink struct {
+	ChatID   int64     `db:"chat_id"`
+	UserUUID uuid.UUID `db:"user_uuid"`
+	// SessionCiphertext - UUID сессии, зашифрованный secretbox с chat_id в associatedData
+	SessionCiphertext []byte    `db:"session_ciphertext"`
+	CreatedAt         time.Time `db:"created_at"`
+	UpdatedAt         time.Time `db:"updated_at"`
+}
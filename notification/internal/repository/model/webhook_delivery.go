@@ -0,0 +1,23 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type WebhookDelivery struct {
+	UUID           uuid.UUID  `db:"delivery_uuid"`
+	EventUUID      uuid.UUID  `db:"event_uuid"`
+	EventType      string     `db:"event_type"`
+	UserUUID       uuid.UUID  `db:"user_uuid"`
+	Target         string     `db:"target"`
+	Payload        []byte     `db:"payload"`
+	Status         string     `db:"status"`
+	Attempts       int        `db:"attempts"`
+	LastError      *string    `db:"last_error"`
+	LastStatusCode *int       `db:"last_status_code"`
+	CreatedAt      time.Time  `db:"created_at"`
+	UpdatedAt      time.Time  `db:"updated_at"`
+	DeliveredAt    *time.Time `db:"delivered_at"`
+}
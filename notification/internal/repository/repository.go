@@ -0,0 +1,27 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/crafty-ezhik/rocket-factory/notification/internal/model"
+)
+
+// WebhookDeliveryRepository - журнал доставки webhook-уведомлений
+type WebhookDeliveryRepository interface {
+	// Create - сохраняет запись о доставке. Если событие уже доставлялось на этот адрес, возвращает существующую запись
+	Create(ctx context.Context, delivery model.WebhookDelivery) (model.WebhookDelivery, error)
+	SaveAttempt(ctx context.Context, deliveryUUID uuid.UUID, attempt model.WebhookAttempt) error
+	Get(ctx context.Context, deliveryUUID uuid.UUID) (model.WebhookDelivery, error)
+	List(ctx context.Context, filter model.WebhookDeliveryFilter) ([]model.WebhookDelivery, error)
+}
+
+// TelegramLinkRepository - привязки чатов Telegram к пользователям для команд бота.
+// Сессия пользователя хранится только зашифрованной, привязка с нерасшифровываемой сессией - model.ErrChatNotLinked
+type TelegramLinkRepository interface {
+	// Save - привязывает чат к пользователю. Повторная привязка заменяет пользователя и сессию
+	Save(ctx context.Context, link model.TelegramLink) error
+	Get(ctx context.Context, chatID int64) (model.TelegramLink, error)
+	Delete(ctx context.Context, chatID int64) error
+}
@@ -0,0 +1,25 @@
+package telegram_link
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Masterminds/squirrel"
+)
+
+// Delete - отвязывает чат. Удаление непривязанного чата не ошибка
+func (r *repository) Delete(ctx context.Context, chatID int64) error {
+	query, args, err := squirrel.Delete(telegramLinksTable).
+		Where(squirrel.Eq{fieldChatID: chatID}).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("build telegram link delete: %w", err)
+	}
+
+	_, err = r.pool.Exec(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("delete telegram link: %w", err)
+	}
+	return nil
+}
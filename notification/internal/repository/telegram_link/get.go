@@ -0,0 +1,61 @@
+package telegram_link
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	serviceModel "github.com/crafty-ezhik/rocket-factory/notification/internal/model"
+	"github.com/crafty-ezhik/rocket-factory/notification/internal/repository/converter"
+	repoModel "github.com/crafty-ezhik/rocket-factory/notification/internal/repository/model"
+)
+
+func (r *repository) Get(ctx context.Context, chatID int64) (serviceModel.TelegramLink, error) {
+	query, args, err := squirrel.Select(fieldChatID, fieldUserUUID, fieldSessionCiphertext, fieldCreatedAt, fieldUpdatedAt).
+		From(telegramLinksTable).
+		Where(squirrel.Eq{fieldChatID: chatID}).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return serviceModel.TelegramLink{}, fmt.Errorf("build telegram link select: %w", err)
+	}
+
+	rows, err := r.pool.Query(ctx, query, args...)
+	if err != nil {
+		return serviceModel.TelegramLink{}, fmt.Errorf("query telegram link: %w", err)
+	}
+
+	link, err := pgx.CollectExactlyOneRow(rows, pgx.RowToStructByName[repoModel.TelegramLink])
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return serviceModel.TelegramLink{}, serviceModel.ErrChatNotLinked
+		}
+		return serviceModel.TelegramLink{}, fmt.Errorf("collect telegram link: %w", err)
+	}
+
+	sessionUUID, err := r.openSession(link)
+	if err != nil {
+		return serviceModel.TelegramLink{}, err
+	}
+
+	return converter.TelegramLinkToServiceModel(link, sessionUUID), nil
+}
+
+// openSession - расшифровывает сессию привязки. Привязку, которую нельзя расшифровать
+// (например, после смены ключа), считаем отсутствующей: пользователь привяжет чат заново
+func (r *repository) openSession(link repoModel.TelegramLink) (uuid.UUID, error) {
+	plaintext, err := r.box.Open(link.SessionCiphertext, sessionAssociatedData(link.ChatID))
+	if err != nil {
+		return uuid.Nil, serviceModel.ErrChatNotLinked
+	}
+
+	sessionUUID, err := uuid.Parse(string(plaintext))
+	if err != nil {
+		return uuid.Nil, serviceModel.ErrChatNotLinked
+	}
+	return sessionUUID, nil
+}
@@ -0,0 +1,40 @@
+package telegram_link
+
+import (
+	"strconv"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	def "github.com/crafty-ezhik/rocket-factory/notification/internal/repository"
+	"github.com/crafty-ezhik/rocket-factory/platform/pkg/secretbox"
+)
+
+var _ def.TelegramLinkRepository = (*repository)(nil)
+
+const (
+	telegramLinksTable = "telegram_links"
+
+	fieldChatID            = "chat_id"
+	fieldUserUUID          = "user_uuid"
+	fieldSessionCiphertext = "session_ciphertext"
+	fieldCreatedAt         = "created_at"
+	fieldUpdatedAt         = "updated_at"
+)
+
+// repository хранит сессию пользователя только в зашифрованном виде: по утечке БД нельзя войти от имени пользователя
+type repository struct {
+	pool *pgxpool.Pool
+	box  *secretbox.Box
+}
+
+func NewRepository(pool *pgxpool.Pool, box *secretbox.Box) *repository {
+	return &repository{
+		pool: pool,
+		box:  box,
+	}
+}
+
+// sessionAssociatedData - привязывает шифротекст сессии к чату, чтобы его нельзя было перенести в другую запись
+func sessionAssociatedData(chatID int64) []byte {
+	return []byte("telegram_link:" + strconv.FormatInt(chatID, 10))
+}
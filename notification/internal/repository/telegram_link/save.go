@@ -0,0 +1,39 @@
+package telegram_link
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Masterminds/squirrel"
+
+	"github.com/crafty-ezhik/rocket-factory/notification/internal/model"
+)
+
+// Save - привязывает чат к пользователю. Для уже привязанного чата заменяются пользователь и сессия
+func (r *repository) Save(ctx context.Context, link model.TelegramLink) error {
+	sessionCiphertext, err := r.box.Seal([]byte(link.SessionUUID.String()), sessionAssociatedData(link.ChatID))
+	if err != nil {
+		return fmt.Errorf("encrypt telegram link session: %w", err)
+	}
+
+	query, args, err := squirrel.Insert(telegramLinksTable).
+		Columns(fieldChatID, fieldUserUUID, fieldSessionCiphertext).
+		Values(link.ChatID, link.UserUUID, sessionCiphertext).
+		Suffix(fmt.Sprintf("ON CONFLICT (%s) DO UPDATE SET %s = EXCLUDED.%s, %s = EXCLUDED.%s, %s = now()",
+			fieldChatID,
+			fieldUserUUID, fieldUserUUID,
+			fieldSessionCiphertext, fieldSessionCiphertext,
+			fieldUpdatedAt,
+		)).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("build telegram link insert: %w", err)
+	}
+
+	_, err = r.pool.Exec(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("insert telegram link: %w", err)
+	}
+	return nil
+}
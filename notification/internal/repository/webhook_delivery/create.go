@@ -0,0 +1,64 @@
+package webhook_delivery
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+
+	serviceModel "github.com/crafty-ezhik/rocket-factory/notification/internal/model"
+	"github.com/crafty-ezhik/rocket-factory/notification/internal/repository/converter"
+	repoModel "github.com/crafty-ezhik/rocket-factory/notification/internal/repository/model"
+)
+
+// Create - сохраняет запись о доставке или возвращает уже существующую для пары событие-адрес.
+// Повторная обработка события из Kafka продолжает прежнюю запись, а не создает новую
+func (r *repository) Create(ctx context.Context, delivery serviceModel.WebhookDelivery) (serviceModel.WebhookDelivery, error) {
+	// Пустой update нужен, чтобы RETURNING вернул существующую строку при конфликте
+	query, args, err := squirrel.Insert(webhookDeliveriesTable).
+		Columns(
+			fieldDeliveryUUID,
+			fieldEventUUID,
+			fieldEventType,
+			fieldUserUUID,
+			fieldTarget,
+			fieldPayload,
+			fieldStatus,
+		).
+		Values(
+			delivery.UUID,
+			delivery.EventUUID,
+			delivery.EventType.String(),
+			delivery.UserUUID,
+			delivery.Target,
+			delivery.Payload,
+			serviceModel.WebhookDeliveryStatusPENDING.String(),
+		).
+		Suffix(fmt.Sprintf("ON CONFLICT (%s, %s) DO UPDATE SET %s = %s.%s RETURNING %s",
+			fieldEventUUID,
+			fieldTarget,
+			fieldUpdatedAt,
+			webhookDeliveriesTable,
+			fieldUpdatedAt,
+			strings.Join(deliveryColumns, ", "),
+		)).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return serviceModel.WebhookDelivery{}, fmt.Errorf("build webhook delivery insert: %w", err)
+	}
+
+	rows, err := r.pool.Query(ctx, query, args...)
+	if err != nil {
+		return serviceModel.WebhookDelivery{}, fmt.Errorf("insert webhook delivery: %w", err)
+	}
+
+	saved, err := pgx.CollectExactlyOneRow(rows, pgx.RowToStructByName[repoModel.WebhookDelivery])
+	if err != nil {
+		return serviceModel.WebhookDelivery{}, fmt.Errorf("collect webhook delivery: %w", err)
+	}
+
+	return converter.WebhookDeliveryToServiceModel(saved), nil
+}
@@ -0,0 +1,41 @@
+package webhook_delivery
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	serviceModel "github.com/crafty-ezhik/rocket-factory/notification/internal/model"
+	"github.com/crafty-ezhik/rocket-factory/notification/internal/repository/converter"
+	repoModel "github.com/crafty-ezhik/rocket-factory/notification/internal/repository/model"
+)
+
+func (r *repository) Get(ctx context.Context, deliveryUUID uuid.UUID) (serviceModel.WebhookDelivery, error) {
+	query, args, err := squirrel.Select(deliveryColumns...).
+		From(webhookDeliveriesTable).
+		Where(squirrel.Eq{fieldDeliveryUUID: deliveryUUID}).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return serviceModel.WebhookDelivery{}, fmt.Errorf("build webhook delivery select: %w", err)
+	}
+
+	rows, err := r.pool.Query(ctx, query, args...)
+	if err != nil {
+		return serviceModel.WebhookDelivery{}, fmt.Errorf("query webhook delivery: %w", err)
+	}
+
+	delivery, err := pgx.CollectExactlyOneRow(rows, pgx.RowToStructByName[repoModel.WebhookDelivery])
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return serviceModel.WebhookDelivery{}, serviceModel.ErrWebhookDeliveryNotFound
+		}
+		return serviceModel.WebhookDelivery{}, fmt.Errorf("collect webhook delivery: %w", err)
+	}
+
+	return converter.WebhookDeliveryToServiceModel(delivery), nil
+}
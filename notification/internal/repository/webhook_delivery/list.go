@@ -0,0 +1,53 @@
+package webhook_delivery
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+
+	serviceModel "github.com/crafty-ezhik/rocket-factory/notification/internal/model"
+	"github.com/crafty-ezhik/rocket-factory/notification/internal/repository/converter"
+	repoModel "github.com/crafty-ezhik/rocket-factory/notification/internal/repository/model"
+)
+
+// List - последние записи журнала по фильтру, новые первыми
+func (r *repository) List(ctx context.Context, filter serviceModel.WebhookDeliveryFilter) ([]serviceModel.WebhookDelivery, error) {
+	builder := squirrel.Select(deliveryColumns...).
+		From(webhookDeliveriesTable).
+		OrderBy(fieldCreatedAt+" DESC", fieldDeliveryUUID+" DESC").
+		Limit(filter.Limit).
+		PlaceholderFormat(squirrel.Dollar)
+
+	if filter.UserUUID != nil {
+		builder = builder.Where(squirrel.Eq{fieldUserUUID: *filter.UserUUID})
+	}
+	if filter.EventUUID != nil {
+		builder = builder.Where(squirrel.Eq{fieldEventUUID: *filter.EventUUID})
+	}
+	if filter.Status != nil {
+		builder = builder.Where(squirrel.Eq{fieldStatus: filter.Status.String()})
+	}
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("build webhook deliveries select: %w", err)
+	}
+
+	rows, err := r.pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("query webhook deliveries: %w", err)
+	}
+
+	deliveries, err := pgx.CollectRows(rows, pgx.RowToStructByName[repoModel.WebhookDelivery])
+	if err != nil {
+		return nil, fmt.Errorf("collect webhook deliveries: %w", err)
+	}
+
+	result := make([]serviceModel.WebhookDelivery, 0, len(deliveries))
+	for _, delivery := range deliveries {
+		result = append(result, converter.WebhookDeliveryToServiceModel(delivery))
+	}
+	return result, nil
+}
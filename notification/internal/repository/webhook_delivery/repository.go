@@ -0,0 +1,52 @@
+package webhook_delivery
+
+import (
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	def "github.com/crafty-ezhik/rocket-factory/notification/internal/repository"
+)
+
+var _ def.WebhookDeliveryRepository = (*repository)(nil)
+
+const (
+	webhookDeliveriesTable = "webhook_deliveries"
+
+	fieldDeliveryUUID   = "delivery_uuid"
+	fieldEventUUID      = "event_uuid"
+	fieldEventType      = "event_type"
+	fieldUserUUID       = "user_uuid"
+	fieldTarget         = "target"
+	fieldPayload        = "payload"
+	fieldStatus         = "status"
+	fieldAttempts       = "attempts"
+	fieldLastError      = "last_error"
+	fieldLastStatusCode = "last_status_code"
+	fieldCreatedAt      = "created_at"
+	fieldUpdatedAt      = "updated_at"
+	fieldDeliveredAt    = "delivered_at"
+)
+
+// deliveryColumns - колонки в порядке полей repoModel.WebhookDelivery
+var deliveryColumns = []string{
+	fieldDeliveryUUID,
+	fieldEventUUID,
+	fieldEventType,
+	fieldUserUUID,
+	fieldTarget,
+	fieldPayload,
+	fieldStatus,
+	fieldAttempts,
+	fieldLastError,
+	fieldLastStatusCode,
+	fieldCreatedAt,
+	fieldUpdatedAt,
+	fieldDeliveredAt,
+}
+
+type repository struct {
+	pool *pgxpool.Pool
+}
+
+func NewRepository(pool *pgxpool.Pool) *repository {
+	return &repository{pool: pool}
+}
@@ -0,0 +1,41 @@
+package webhook_delivery
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+
+	"github.com/crafty-ezhik/rocket-factory/notification/internal/model"
+)
+
+// SaveAttempt - учитывает попытку доставки: увеличивает счетчик и сохраняет статус и последнюю ошибку
+func (r *repository) SaveAttempt(ctx context.Context, deliveryUUID uuid.UUID, attempt model.WebhookAttempt) error {
+	builder := squirrel.Update(webhookDeliveriesTable).
+		Set(fieldStatus, attempt.Status.String()).
+		Set(fieldAttempts, squirrel.Expr(fieldAttempts+" + 1")).
+		Set(fieldLastStatusCode, attempt.StatusCode).
+		Set(fieldLastError, attempt.Error).
+		Set(fieldUpdatedAt, squirrel.Expr("now()")).
+		Where(squirrel.Eq{fieldDeliveryUUID: deliveryUUID}).
+		PlaceholderFormat(squirrel.Dollar)
+
+	if attempt.Status == model.WebhookDeliveryStatusDELIVERED {
+		builder = builder.Set(fieldDeliveredAt, squirrel.Expr("now()"))
+	}
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return fmt.Errorf("build webhook delivery update: %w", err)
+	}
+
+	tag, err := r.pool.Exec(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("update webhook delivery: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return model.ErrWebhookDeliveryNotFound
+	}
+	return nil
+}
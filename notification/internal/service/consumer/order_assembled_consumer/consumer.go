@@ -14,14 +14,14 @@ import (
 type service struct {
 	orderAssembledConsumer kafka.Consumer
 	orderAssembledDecoder  kafkaConv.OrderAssembledDecoder
-	tgService              def.TelegramService
+	notificationService    def.NotificationService
 }
 
-func NewService(orderAssembledConsumer kafka.Consumer, orderAssembledDecoder kafkaConv.OrderAssembledDecoder, tgService def.TelegramService) *service {
+func NewService(orderAssembledConsumer kafka.Consumer, orderAssembledDecoder kafkaConv.OrderAssembledDecoder, notificationService def.NotificationService) *service {
 	return &service{
 		orderAssembledConsumer: orderAssembledConsumer,
 		orderAssembledDecoder:  orderAssembledDecoder,
-		tgService:              tgService,
+		notificationService:    notificationService,
 	}
 }
 
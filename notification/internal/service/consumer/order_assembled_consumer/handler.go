@@ -16,8 +16,8 @@ func (s *service) OrderAssembledHandler(ctx context.Context, msg kafka.Message)
 		return err
 	}
 
-	// Отправка в ТГ
-	err = s.tgService.SendOrderAssembledNotification(ctx, event)
+	// Отправка во все каналы пользователя
+	err = s.notificationService.SendOrderAssembledNotification(ctx, event)
 	if err != nil {
 		logger.Error(ctx, "Failed to send order assemble notification", zap.Error(err))
 		return err
@@ -0,0 +1,26 @@
+package order_assembly_failed_consumer
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"github.com/crafty-ezhik/rocket-factory/platform/pkg/kafka"
+	"github.com/crafty-ezhik/rocket-factory/platform/pkg/logger"
+)
+
+func (s *service) OrderAssemblyFailedHandler(ctx context.Context, msg kafka.Message) error {
+	event, err := s.orderAssemblyFailedDecoder.Decode(msg.Value)
+	if err != nil {
+		logger.Error(ctx, "Failed to decode ShipAssemblyFailed event", zap.Error(err))
+		return err
+	}
+
+	// Отправка во все каналы пользователя
+	err = s.notificationService.SendOrderAssemblyFailedNotification(ctx, event)
+	if err != nil {
+		logger.Error(ctx, "Failed to send order assembly failed notification", zap.Error(err))
+		return err
+	}
+	return nil
+}
@@ -12,16 +12,16 @@ import (
 )
 
 type service struct {
-	orderPaidConsumer kafka.Consumer
-	orderPaidDecoder  kafkaConv.OrderPaidDecoder
-	tgService         def.TelegramService
+	orderPaidConsumer   kafka.Consumer
+	orderPaidDecoder    kafkaConv.OrderPaidDecoder
+	notificationService def.NotificationService
 }
 
-func NewService(orderPaidDecoder kafkaConv.OrderPaidDecoder, orderPaidConsumer kafka.Consumer, tgService def.TelegramService) *service {
+func NewService(orderPaidDecoder kafkaConv.OrderPaidDecoder, orderPaidConsumer kafka.Consumer, notificationService def.NotificationService) *service {
 	return &service{
-		orderPaidDecoder:  orderPaidDecoder,
-		orderPaidConsumer: orderPaidConsumer,
-		tgService:         tgService,
+		orderPaidDecoder:    orderPaidDecoder,
+		orderPaidConsumer:   orderPaidConsumer,
+		notificationService: notificationService,
 	}
 }
 
@@ -16,8 +16,8 @@ func (s *service) OrderPaidHandler(ctx context.Context, msg kafka.Message) error
 		return err
 	}
 
-	// Отправка в ТГ
-	err = s.tgService.SendOrderPaidNotification(ctx, event)
+	// Отправка во все каналы пользователя
+	err = s.notificationService.SendOrderPaidNotification(ctx, event)
 	if err != nil {
 		logger.Error(ctx, "Failed to send order paid notification", zap.Error(err))
 		return err
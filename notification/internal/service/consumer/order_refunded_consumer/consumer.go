@@ -0,0 +1,37 @@
+package order_refunded_consumer
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	kafkaConv "github.com/crafty-ezhik/rocket-factory/notification/internal/converter/kafka"
+	def "github.com/crafty-ezhik/rocket-factory/notification/internal/service"
+	"github.com/crafty-ezhik/rocket-factory/platform/pkg/kafka"
+	"github.com/crafty-ezhik/rocket-factory/platform/pkg/logger"
+)
+
+type service struct {
+	orderRefundedConsumer kafka.Consumer
+	orderRefundedDecoder  kafkaConv.OrderRefundedDecoder
+	notificationService   def.NotificationService
+}
+
+func NewService(orderRefundedConsumer kafka.Consumer, orderRefundedDecoder kafkaConv.OrderRefundedDecoder, notificationService def.NotificationService) *service {
+	return &service{
+		orderRefundedConsumer: orderRefundedConsumer,
+		orderRefundedDecoder:  orderRefundedDecoder,
+		notificationService:   notificationService,
+	}
+}
+
+func (s *service) RunConsumer(ctx context.Context) error {
+	logger.Info(ctx, "Starting orderRefundedConsumer service")
+
+	err := s.orderRefundedConsumer.Consume(ctx, s.OrderRefundedHandler)
+	if err != nil {
+		logger.Error(ctx, "Consume from order.refunded topic error", zap.Error(err))
+		return err
+	}
+	return nil
+}
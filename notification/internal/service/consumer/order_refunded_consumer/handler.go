@@ -0,0 +1,26 @@
+package order_refunded_consumer
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"github.com/crafty-ezhik/rocket-factory/platform/pkg/kafka"
+	"github.com/crafty-ezhik/rocket-factory/platform/pkg/logger"
+)
+
+func (s *service) OrderRefundedHandler(ctx context.Context, msg kafka.Message) error {
+	event, err := s.orderRefundedDecoder.Decode(msg.Value)
+	if err != nil {
+		logger.Error(ctx, "Failed to decode OrderRefunded event", zap.Error(err))
+		return err
+	}
+
+	// Отправка во все каналы пользователя
+	err = s.notificationService.SendOrderRefundedNotification(ctx, event)
+	if err != nil {
+		logger.Error(ctx, "Failed to send order refunded notification", zap.Error(err))
+		return err
+	}
+	return nil
+}
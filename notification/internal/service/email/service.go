@@ -0,0 +1,59 @@
+package email
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"github.com/crafty-ezhik/rocket-factory/notification/internal/client/http"
+	"github.com/crafty-ezhik/rocket-factory/notification/internal/model"
+	def "github.com/crafty-ezhik/rocket-factory/notification/internal/service"
+	"github.com/crafty-ezhik/rocket-factory/notification/internal/template"
+	"github.com/crafty-ezhik/rocket-factory/platform/pkg/logger"
+)
+
+var _ def.ChannelService = (*service)(nil)
+
+type service struct {
+	notifier  http.Notifier
+	templates template.Renderer
+}
+
+func NewService(notifier http.Notifier, templates template.Renderer) *service {
+	return &service{
+		notifier:  notifier,
+		templates: templates,
+	}
+}
+
+func (s *service) SendOrderPaidNotification(ctx context.Context, recipient model.Recipient, target string, msg model.OrderPaidEvent) error {
+	return s.send(ctx, recipient, target, model.EventOrderPaid, msg)
+}
+
+func (s *service) SendOrderAssembledNotification(ctx context.Context, recipient model.Recipient, target string, msg model.OrderAssembledEvent) error {
+	return s.send(ctx, recipient, target, model.EventOrderAssembled, msg)
+}
+
+func (s *service) SendOrderRefundedNotification(ctx context.Context, recipient model.Recipient, target string, msg model.OrderRefundedEvent) error {
+	return s.send(ctx, recipient, target, model.EventOrderRefunded, msg)
+}
+
+func (s *service) SendOrderAssemblyFailedNotification(ctx context.Context, recipient model.Recipient, target string, msg model.OrderAssemblyFailedEvent) error {
+	return s.send(ctx, recipient, target, model.EventOrderAssemblyFailed, msg)
+}
+
+// send - письмо содержит текстовую и HTML-версии, см. smtp.Client
+func (s *service) send(ctx context.Context, recipient model.Recipient, target string, event model.EventType, data any) error {
+	message, err := s.templates.Render(model.ProviderEmail, event, recipient, data)
+	if err != nil {
+		return err
+	}
+
+	err = s.notifier.Notify(ctx, target, message)
+	if err != nil {
+		return err
+	}
+
+	logger.Info(ctx, "Email sent", zap.String("subject", message.Subject))
+	return nil
+}
@@ -0,0 +1,318 @@
+// Code generated for crafty-ezhik service
+// © Crafty-ezhik 2025.
+
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/crafty-ezhik/rocket-factory/notification/internal/model"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockChannelService creates a new instance of MockChannelService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockChannelService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockChannelService {
+	mock := &MockChannelService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockChannelService is an autogenerated mock type for the ChannelService type
+type MockChannelService struct {
+	mock.Mock
+}
+
+type MockChannelService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockChannelService) EXPECT() *MockChannelService_Expecter {
+	return &MockChannelService_Expecter{mock: &_m.Mock}
+}
+
+// SendOrderAssembledNotification provides a mock function for the type MockChannelService
+func (_mock *MockChannelService) SendOrderAssembledNotification(ctx context.Context, recipient model.Recipient, target string, msg model.OrderAssembledEvent) error {
+	ret := _mock.Called(ctx, recipient, target, msg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SendOrderAssembledNotification")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, model.Recipient, string, model.OrderAssembledEvent) error); ok {
+		r0 = returnFunc(ctx, recipient, target, msg)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockChannelService_SendOrderAssembledNotification_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SendOrderAssembledNotification'
+type MockChannelService_SendOrderAssembledNotification_Call struct {
+	*mock.Call
+}
+
+// SendOrderAssembledNotification is a helper method to define mock.On call
+//   - ctx context.Context
+//   - recipient model.Recipient
+//   - target string
+//   - msg model.OrderAssembledEvent
+func (_e *MockChannelService_Expecter) SendOrderAssembledNotification(ctx interface{}, recipient interface{}, target interface{}, msg interface{}) *MockChannelService_SendOrderAssembledNotification_Call {
+	return &MockChannelService_SendOrderAssembledNotification_Call{Call: _e.mock.On("SendOrderAssembledNotification", ctx, recipient, target, msg)}
+}
+
+func (_c *MockChannelService_SendOrderAssembledNotification_Call) Run(run func(ctx context.Context, recipient model.Recipient, target string, msg model.OrderAssembledEvent)) *MockChannelService_SendOrderAssembledNotification_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 model.Recipient
+		if args[1] != nil {
+			arg1 = args[1].(model.Recipient)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 model.OrderAssembledEvent
+		if args[3] != nil {
+			arg3 = args[3].(model.OrderAssembledEvent)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockChannelService_SendOrderAssembledNotification_Call) Return(err error) *MockChannelService_SendOrderAssembledNotification_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockChannelService_SendOrderAssembledNotification_Call) RunAndReturn(run func(ctx context.Context, recipient model.Recipient, target string, msg model.OrderAssembledEvent) error) *MockChannelService_SendOrderAssembledNotification_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SendOrderAssemblyFailedNotification provides a mock function for the type MockChannelService
+func (_mock *MockChannelService) SendOrderAssemblyFailedNotification(ctx context.Context, recipient model.Recipient, target string, msg model.OrderAssemblyFailedEvent) error {
+	ret := _mock.Called(ctx, recipient, target, msg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SendOrderAssemblyFailedNotification")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, model.Recipient, string, model.OrderAssemblyFailedEvent) error); ok {
+		r0 = returnFunc(ctx, recipient, target, msg)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockChannelService_SendOrderAssemblyFailedNotification_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SendOrderAssemblyFailedNotification'
+type MockChannelService_SendOrderAssemblyFailedNotification_Call struct {
+	*mock.Call
+}
+
+// SendOrderAssemblyFailedNotification is a helper method to define mock.On call
+//   - ctx context.Context
+//   - recipient model.Recipient
+//   - target string
+//   - msg model.OrderAssemblyFailedEvent
+func (_e *MockChannelService_Expecter) SendOrderAssemblyFailedNotification(ctx interface{}, recipient interface{}, target interface{}, msg interface{}) *MockChannelService_SendOrderAssemblyFailedNotification_Call {
+	return &MockChannelService_SendOrderAssemblyFailedNotification_Call{Call: _e.mock.On("SendOrderAssemblyFailedNotification", ctx, recipient, target, msg)}
+}
+
+func (_c *MockChannelService_SendOrderAssemblyFailedNotification_Call) Run(run func(ctx context.Context, recipient model.Recipient, target string, msg model.OrderAssemblyFailedEvent)) *MockChannelService_SendOrderAssemblyFailedNotification_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 model.Recipient
+		if args[1] != nil {
+			arg1 = args[1].(model.Recipient)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 model.OrderAssemblyFailedEvent
+		if args[3] != nil {
+			arg3 = args[3].(model.OrderAssemblyFailedEvent)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockChannelService_SendOrderAssemblyFailedNotification_Call) Return(err error) *MockChannelService_SendOrderAssemblyFailedNotification_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockChannelService_SendOrderAssemblyFailedNotification_Call) RunAndReturn(run func(ctx context.Context, recipient model.Recipient, target string, msg model.OrderAssemblyFailedEvent) error) *MockChannelService_SendOrderAssemblyFailedNotification_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SendOrderPaidNotification provides a mock function for the type MockChannelService
+func (_mock *MockChannelService) SendOrderPaidNotification(ctx context.Context, recipient model.Recipient, target string, msg model.OrderPaidEvent) error {
+	ret := _mock.Called(ctx, recipient, target, msg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SendOrderPaidNotification")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, model.Recipient, string, model.OrderPaidEvent) error); ok {
+		r0 = returnFunc(ctx, recipient, target, msg)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockChannelService_SendOrderPaidNotification_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SendOrderPaidNotification'
+type MockChannelService_SendOrderPaidNotification_Call struct {
+	*mock.Call
+}
+
+// SendOrderPaidNotification is a helper method to define mock.On call
+//   - ctx context.Context
+//   - recipient model.Recipient
+//   - target string
+//   - msg model.OrderPaidEvent
+func (_e *MockChannelService_Expecter) SendOrderPaidNotification(ctx interface{}, recipient interface{}, target interface{}, msg interface{}) *MockChannelService_SendOrderPaidNotification_Call {
+	return &MockChannelService_SendOrderPaidNotification_Call{Call: _e.mock.On("SendOrderPaidNotification", ctx, recipient, target, msg)}
+}
+
+func (_c *MockChannelService_SendOrderPaidNotification_Call) Run(run func(ctx context.Context, recipient model.Recipient, target string, msg model.OrderPaidEvent)) *MockChannelService_SendOrderPaidNotification_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 model.Recipient
+		if args[1] != nil {
+			arg1 = args[1].(model.Recipient)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 model.OrderPaidEvent
+		if args[3] != nil {
+			arg3 = args[3].(model.OrderPaidEvent)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockChannelService_SendOrderPaidNotification_Call) Return(err error) *MockChannelService_SendOrderPaidNotification_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockChannelService_SendOrderPaidNotification_Call) RunAndReturn(run func(ctx context.Context, recipient model.Recipient, target string, msg model.OrderPaidEvent) error) *MockChannelService_SendOrderPaidNotification_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SendOrderRefundedNotification provides a mock function for the type MockChannelService
+func (_mock *MockChannelService) SendOrderRefundedNotification(ctx context.Context, recipient model.Recipient, target string, msg model.OrderRefundedEvent) error {
+	ret := _mock.Called(ctx, recipient, target, msg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SendOrderRefundedNotification")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, model.Recipient, string, model.OrderRefundedEvent) error); ok {
+		r0 = returnFunc(ctx, recipient, target, msg)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockChannelService_SendOrderRefundedNotification_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SendOrderRefundedNotification'
+type MockChannelService_SendOrderRefundedNotification_Call struct {
+	*mock.Call
+}
+
+// SendOrderRefundedNotification is a helper method to define mock.On call
+//   - ctx context.Context
+//   - recipient model.Recipient
+//   - target string
+//   - msg model.OrderRefundedEvent
+func (_e *MockChannelService_Expecter) SendOrderRefundedNotification(ctx interface{}, recipient interface{}, target interface{}, msg interface{}) *MockChannelService_SendOrderRefundedNotification_Call {
+	return &MockChannelService_SendOrderRefundedNotification_Call{Call: _e.mock.On("SendOrderRefundedNotification", ctx, recipient, target, msg)}
+}
+
+func (_c *MockChannelService_SendOrderRefundedNotification_Call) Run(run func(ctx context.Context, recipient model.Recipient, target string, msg model.OrderRefundedEvent)) *MockChannelService_SendOrderRefundedNotification_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 model.Recipient
+		if args[1] != nil {
+			arg1 = args[1].(model.Recipient)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 model.OrderRefundedEvent
+		if args[3] != nil {
+			arg3 = args[3].(model.OrderRefundedEvent)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockChannelService_SendOrderRefundedNotification_Call) Return(err error) *MockChannelService_SendOrderRefundedNotification_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockChannelService_SendOrderRefundedNotification_Call) RunAndReturn(run func(ctx context.Context, recipient model.Recipient, target string, msg model.OrderRefundedEvent) error) *MockChannelService_SendOrderRefundedNotification_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -0,0 +1,270 @@
+// Code generated for crafty-ezhik service
+// © Crafty-ezhik 2025.
+
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/crafty-ezhik/rocket-factory/notification/internal/model"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockNotificationService creates a new instance of MockNotificationService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockNotificationService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockNotificationService {
+	mock := &MockNotificationService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockNotificationService is an autogenerated mock type for the NotificationService type
+type MockNotificationService struct {
+	mock.Mock
+}
+
+type MockNotificationService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockNotificationService) EXPECT() *MockNotificationService_Expecter {
+	return &MockNotificationService_Expecter{mock: &_m.Mock}
+}
+
+// SendOrderAssembledNotification provides a mock function for the type MockNotificationService
+func (_mock *MockNotificationService) SendOrderAssembledNotification(ctx context.Context, msg model.OrderAssembledEvent) error {
+	ret := _mock.Called(ctx, msg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SendOrderAssembledNotification")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, model.OrderAssembledEvent) error); ok {
+		r0 = returnFunc(ctx, msg)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockNotificationService_SendOrderAssembledNotification_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SendOrderAssembledNotification'
+type MockNotificationService_SendOrderAssembledNotification_Call struct {
+	*mock.Call
+}
+
+// SendOrderAssembledNotification is a helper method to define mock.On call
+//   - ctx context.Context
+//   - msg model.OrderAssembledEvent
+func (_e *MockNotificationService_Expecter) SendOrderAssembledNotification(ctx interface{}, msg interface{}) *MockNotificationService_SendOrderAssembledNotification_Call {
+	return &MockNotificationService_SendOrderAssembledNotification_Call{Call: _e.mock.On("SendOrderAssembledNotification", ctx, msg)}
+}
+
+func (_c *MockNotificationService_SendOrderAssembledNotification_Call) Run(run func(ctx context.Context, msg model.OrderAssembledEvent)) *MockNotificationService_SendOrderAssembledNotification_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 model.OrderAssembledEvent
+		if args[1] != nil {
+			arg1 = args[1].(model.OrderAssembledEvent)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockNotificationService_SendOrderAssembledNotification_Call) Return(err error) *MockNotificationService_SendOrderAssembledNotification_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockNotificationService_SendOrderAssembledNotification_Call) RunAndReturn(run func(ctx context.Context, msg model.OrderAssembledEvent) error) *MockNotificationService_SendOrderAssembledNotification_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SendOrderAssemblyFailedNotification provides a mock function for the type MockNotificationService
+func (_mock *MockNotificationService) SendOrderAssemblyFailedNotification(ctx context.Context, msg model.OrderAssemblyFailedEvent) error {
+	ret := _mock.Called(ctx, msg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SendOrderAssemblyFailedNotification")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, model.OrderAssemblyFailedEvent) error); ok {
+		r0 = returnFunc(ctx, msg)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockNotificationService_SendOrderAssemblyFailedNotification_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SendOrderAssemblyFailedNotification'
+type MockNotificationService_SendOrderAssemblyFailedNotification_Call struct {
+	*mock.Call
+}
+
+// SendOrderAssemblyFailedNotification is a helper method to define mock.On call
+//   - ctx context.Context
+//   - msg model.OrderAssemblyFailedEvent
+func (_e *MockNotificationService_Expecter) SendOrderAssemblyFailedNotification(ctx interface{}, msg interface{}) *MockNotificationService_SendOrderAssemblyFailedNotification_Call {
+	return &MockNotificationService_SendOrderAssemblyFailedNotification_Call{Call: _e.mock.On("SendOrderAssemblyFailedNotification", ctx, msg)}
+}
+
+func (_c *MockNotificationService_SendOrderAssemblyFailedNotification_Call) Run(run func(ctx context.Context, msg model.OrderAssemblyFailedEvent)) *MockNotificationService_SendOrderAssemblyFailedNotification_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 model.OrderAssemblyFailedEvent
+		if args[1] != nil {
+			arg1 = args[1].(model.OrderAssemblyFailedEvent)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockNotificationService_SendOrderAssemblyFailedNotification_Call) Return(err error) *MockNotificationService_SendOrderAssemblyFailedNotification_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockNotificationService_SendOrderAssemblyFailedNotification_Call) RunAndReturn(run func(ctx context.Context, msg model.OrderAssemblyFailedEvent) error) *MockNotificationService_SendOrderAssemblyFailedNotification_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SendOrderPaidNotification provides a mock function for the type MockNotificationService
+func (_mock *MockNotificationService) SendOrderPaidNotification(ctx context.Context, msg model.OrderPaidEvent) error {
+	ret := _mock.Called(ctx, msg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SendOrderPaidNotification")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, model.OrderPaidEvent) error); ok {
+		r0 = returnFunc(ctx, msg)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockNotificationService_SendOrderPaidNotification_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SendOrderPaidNotification'
+type MockNotificationService_SendOrderPaidNotification_Call struct {
+	*mock.Call
+}
+
+// SendOrderPaidNotification is a helper method to define mock.On call
+//   - ctx context.Context
+//   - msg model.OrderPaidEvent
+func (_e *MockNotificationService_Expecter) SendOrderPaidNotification(ctx interface{}, msg interface{}) *MockNotificationService_SendOrderPaidNotification_Call {
+	return &MockNotificationService_SendOrderPaidNotification_Call{Call: _e.mock.On("SendOrderPaidNotification", ctx, msg)}
+}
+
+func (_c *MockNotificationService_SendOrderPaidNotification_Call) Run(run func(ctx context.Context, msg model.OrderPaidEvent)) *MockNotificationService_SendOrderPaidNotification_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 model.OrderPaidEvent
+		if args[1] != nil {
+			arg1 = args[1].(model.OrderPaidEvent)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockNotificationService_SendOrderPaidNotification_Call) Return(err error) *MockNotificationService_SendOrderPaidNotification_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockNotificationService_SendOrderPaidNotification_Call) RunAndReturn(run func(ctx context.Context, msg model.OrderPaidEvent) error) *MockNotificationService_SendOrderPaidNotification_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SendOrderRefundedNotification provides a mock function for the type MockNotificationService
+func (_mock *MockNotificationService) SendOrderRefundedNotification(ctx context.Context, msg model.OrderRefundedEvent) error {
+	ret := _mock.Called(ctx, msg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SendOrderRefundedNotification")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, model.OrderRefundedEvent) error); ok {
+		r0 = returnFunc(ctx, msg)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockNotificationService_SendOrderRefundedNotification_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SendOrderRefundedNotification'
+type MockNotificationService_SendOrderRefundedNotification_Call struct {
+	*mock.Call
+}
+
+// SendOrderRefundedNotification is a helper method to define mock.On call
+//   - ctx context.Context
+//   - msg model.OrderRefundedEvent
+func (_e *MockNotificationService_Expecter) SendOrderRefundedNotification(ctx interface{}, msg interface{}) *MockNotificationService_SendOrderRefundedNotification_Call {
+	return &MockNotificationService_SendOrderRefundedNotification_Call{Call: _e.mock.On("SendOrderRefundedNotification", ctx, msg)}
+}
+
+func (_c *MockNotificationService_SendOrderRefundedNotification_Call) Run(run func(ctx context.Context, msg model.OrderRefundedEvent)) *MockNotificationService_SendOrderRefundedNotification_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 model.OrderRefundedEvent
+		if args[1] != nil {
+			arg1 = args[1].(model.OrderRefundedEvent)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockNotificationService_SendOrderRefundedNotification_Call) Return(err error) *MockNotificationService_SendOrderRefundedNotification_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockNotificationService_SendOrderRefundedNotification_Call) RunAndReturn(run func(ctx context.Context, msg model.OrderRefundedEvent) error) *MockNotificationService_SendOrderRefundedNotification_Call {
+	_c.Call.Return(run)
+	return _c
+}
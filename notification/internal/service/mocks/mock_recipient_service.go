@@ -0,0 +1,149 @@
+// Code generated for crafty-ezhik service
+// © Crafty-ezhik 2025.
+
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/crafty-ezhik/rocket-factory/notification/internal/model"
+	"github.com/google/uuid"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockRecipientService creates a new instance of MockRecipientService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockRecipientService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockRecipientService {
+	mock := &MockRecipientService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockRecipientService is an autogenerated mock type for the RecipientService type
+type MockRecipientService struct {
+	mock.Mock
+}
+
+type MockRecipientService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockRecipientService) EXPECT() *MockRecipientService_Expecter {
+	return &MockRecipientService_Expecter{mock: &_m.Mock}
+}
+
+// Invalidate provides a mock function for the type MockRecipientService
+func (_mock *MockRecipientService) Invalidate(userUUID uuid.UUID) {
+	_mock.Called(userUUID)
+	return
+}
+
+// MockRecipientService_Invalidate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Invalidate'
+type MockRecipientService_Invalidate_Call struct {
+	*mock.Call
+}
+
+// Invalidate is a helper method to define mock.On call
+//   - userUUID uuid.UUID
+func (_e *MockRecipientService_Expecter) Invalidate(userUUID interface{}) *MockRecipientService_Invalidate_Call {
+	return &MockRecipientService_Invalidate_Call{Call: _e.mock.On("Invalidate", userUUID)}
+}
+
+func (_c *MockRecipientService_Invalidate_Call) Run(run func(userUUID uuid.UUID)) *MockRecipientService_Invalidate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 uuid.UUID
+		if args[0] != nil {
+			arg0 = args[0].(uuid.UUID)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRecipientService_Invalidate_Call) Return() *MockRecipientService_Invalidate_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockRecipientService_Invalidate_Call) RunAndReturn(run func(userUUID uuid.UUID)) *MockRecipientService_Invalidate_Call {
+	_c.Run(run)
+	return _c
+}
+
+// Recipient provides a mock function for the type MockRecipientService
+func (_mock *MockRecipientService) Recipient(ctx context.Context, userUUID uuid.UUID) (model.Recipient, error) {
+	ret := _mock.Called(ctx, userUUID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Recipient")
+	}
+
+	var r0 model.Recipient
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (model.Recipient, error)); ok {
+		return returnFunc(ctx, userUUID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) model.Recipient); ok {
+		r0 = returnFunc(ctx, userUUID)
+	} else {
+		r0 = ret.Get(0).(model.Recipient)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, userUUID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRecipientService_Recipient_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Recipient'
+type MockRecipientService_Recipient_Call struct {
+	*mock.Call
+}
+
+// Recipient is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userUUID uuid.UUID
+func (_e *MockRecipientService_Expecter) Recipient(ctx interface{}, userUUID interface{}) *MockRecipientService_Recipient_Call {
+	return &MockRecipientService_Recipient_Call{Call: _e.mock.On("Recipient", ctx, userUUID)}
+}
+
+func (_c *MockRecipientService_Recipient_Call) Run(run func(ctx context.Context, userUUID uuid.UUID)) *MockRecipientService_Recipient_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRecipientService_Recipient_Call) Return(recipient model.Recipient, err error) *MockRecipientService_Recipient_Call {
+	_c.Call.Return(recipient, err)
+	return _c
+}
+
+func (_c *MockRecipientService_Recipient_Call) RunAndReturn(run func(ctx context.Context, userUUID uuid.UUID) (model.Recipient, error)) *MockRecipientService_Recipient_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -0,0 +1,270 @@
+// Code generated for crafty-ezhik service
+// © Crafty-ezhik 2025.
+
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/crafty-ezhik/rocket-factory/notification/internal/model"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockTelegramBotService creates a new instance of MockTelegramBotService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockTelegramBotService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockTelegramBotService {
+	mock := &MockTelegramBotService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockTelegramBotService is an autogenerated mock type for the TelegramBotService type
+type MockTelegramBotService struct {
+	mock.Mock
+}
+
+type MockTelegramBotService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockTelegramBotService) EXPECT() *MockTelegramBotService_Expecter {
+	return &MockTelegramBotService_Expecter{mock: &_m.Mock}
+}
+
+// Help provides a mock function for the type MockTelegramBotService
+func (_mock *MockTelegramBotService) Help(ctx context.Context, msg model.ChatMessage) error {
+	ret := _mock.Called(ctx, msg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Help")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, model.ChatMessage) error); ok {
+		r0 = returnFunc(ctx, msg)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockTelegramBotService_Help_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Help'
+type MockTelegramBotService_Help_Call struct {
+	*mock.Call
+}
+
+// Help is a helper method to define mock.On call
+//   - ctx context.Context
+//   - msg model.ChatMessage
+func (_e *MockTelegramBotService_Expecter) Help(ctx interface{}, msg interface{}) *MockTelegramBotService_Help_Call {
+	return &MockTelegramBotService_Help_Call{Call: _e.mock.On("Help", ctx, msg)}
+}
+
+func (_c *MockTelegramBotService_Help_Call) Run(run func(ctx context.Context, msg model.ChatMessage)) *MockTelegramBotService_Help_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 model.ChatMessage
+		if args[1] != nil {
+			arg1 = args[1].(model.ChatMessage)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockTelegramBotService_Help_Call) Return(err error) *MockTelegramBotService_Help_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockTelegramBotService_Help_Call) RunAndReturn(run func(ctx context.Context, msg model.ChatMessage) error) *MockTelegramBotService_Help_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Link provides a mock function for the type MockTelegramBotService
+func (_mock *MockTelegramBotService) Link(ctx context.Context, msg model.ChatMessage) error {
+	ret := _mock.Called(ctx, msg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Link")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, model.ChatMessage) error); ok {
+		r0 = returnFunc(ctx, msg)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockTelegramBotService_Link_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Link'
+type MockTelegramBotService_Link_Call struct {
+	*mock.Call
+}
+
+// Link is a helper method to define mock.On call
+//   - ctx context.Context
+//   - msg model.ChatMessage
+func (_e *MockTelegramBotService_Expecter) Link(ctx interface{}, msg interface{}) *MockTelegramBotService_Link_Call {
+	return &MockTelegramBotService_Link_Call{Call: _e.mock.On("Link", ctx, msg)}
+}
+
+func (_c *MockTelegramBotService_Link_Call) Run(run func(ctx context.Context, msg model.ChatMessage)) *MockTelegramBotService_Link_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 model.ChatMessage
+		if args[1] != nil {
+			arg1 = args[1].(model.ChatMessage)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockTelegramBotService_Link_Call) Return(err error) *MockTelegramBotService_Link_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockTelegramBotService_Link_Call) RunAndReturn(run func(ctx context.Context, msg model.ChatMessage) error) *MockTelegramBotService_Link_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Orders provides a mock function for the type MockTelegramBotService
+func (_mock *MockTelegramBotService) Orders(ctx context.Context, msg model.ChatMessage) error {
+	ret := _mock.Called(ctx, msg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Orders")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, model.ChatMessage) error); ok {
+		r0 = returnFunc(ctx, msg)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockTelegramBotService_Orders_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Orders'
+type MockTelegramBotService_Orders_Call struct {
+	*mock.Call
+}
+
+// Orders is a helper method to define mock.On call
+//   - ctx context.Context
+//   - msg model.ChatMessage
+func (_e *MockTelegramBotService_Expecter) Orders(ctx interface{}, msg interface{}) *MockTelegramBotService_Orders_Call {
+	return &MockTelegramBotService_Orders_Call{Call: _e.mock.On("Orders", ctx, msg)}
+}
+
+func (_c *MockTelegramBotService_Orders_Call) Run(run func(ctx context.Context, msg model.ChatMessage)) *MockTelegramBotService_Orders_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 model.ChatMessage
+		if args[1] != nil {
+			arg1 = args[1].(model.ChatMessage)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockTelegramBotService_Orders_Call) Return(err error) *MockTelegramBotService_Orders_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockTelegramBotService_Orders_Call) RunAndReturn(run func(ctx context.Context, msg model.ChatMessage) error) *MockTelegramBotService_Orders_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Status provides a mock function for the type MockTelegramBotService
+func (_mock *MockTelegramBotService) Status(ctx context.Context, msg model.ChatMessage) error {
+	ret := _mock.Called(ctx, msg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Status")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, model.ChatMessage) error); ok {
+		r0 = returnFunc(ctx, msg)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockTelegramBotService_Status_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Status'
+type MockTelegramBotService_Status_Call struct {
+	*mock.Call
+}
+
+// Status is a helper method to define mock.On call
+//   - ctx context.Context
+//   - msg model.ChatMessage
+func (_e *MockTelegramBotService_Expecter) Status(ctx interface{}, msg interface{}) *MockTelegramBotService_Status_Call {
+	return &MockTelegramBotService_Status_Call{Call: _e.mock.On("Status", ctx, msg)}
+}
+
+func (_c *MockTelegramBotService_Status_Call) Run(run func(ctx context.Context, msg model.ChatMessage)) *MockTelegramBotService_Status_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 model.ChatMessage
+		if args[1] != nil {
+			arg1 = args[1].(model.ChatMessage)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockTelegramBotService_Status_Call) Return(err error) *MockTelegramBotService_Status_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockTelegramBotService_Status_Call) RunAndReturn(run func(ctx context.Context, msg model.ChatMessage) error) *MockTelegramBotService_Status_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -0,0 +1,234 @@
+// Code generated for crafty-ezhik service
+// © Crafty-ezhik 2025.
+
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/crafty-ezhik/rocket-factory/notification/internal/model"
+	"github.com/google/uuid"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockWebhookDeliveryService creates a new instance of MockWebhookDeliveryService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockWebhookDeliveryService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockWebhookDeliveryService {
+	mock := &MockWebhookDeliveryService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockWebhookDeliveryService is an autogenerated mock type for the WebhookDeliveryService type
+type MockWebhookDeliveryService struct {
+	mock.Mock
+}
+
+type MockWebhookDeliveryService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockWebhookDeliveryService) EXPECT() *MockWebhookDeliveryService_Expecter {
+	return &MockWebhookDeliveryService_Expecter{mock: &_m.Mock}
+}
+
+// Get provides a mock function for the type MockWebhookDeliveryService
+func (_mock *MockWebhookDeliveryService) Get(ctx context.Context, deliveryUUID uuid.UUID) (model.WebhookDelivery, error) {
+	ret := _mock.Called(ctx, deliveryUUID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 model.WebhookDelivery
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (model.WebhookDelivery, error)); ok {
+		return returnFunc(ctx, deliveryUUID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) model.WebhookDelivery); ok {
+		r0 = returnFunc(ctx, deliveryUUID)
+	} else {
+		r0 = ret.Get(0).(model.WebhookDelivery)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, deliveryUUID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockWebhookDeliveryService_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
+type MockWebhookDeliveryService_Get_Call struct {
+	*mock.Call
+}
+
+// Get is a helper method to define mock.On call
+//   - ctx context.Context
+//   - deliveryUUID uuid.UUID
+func (_e *MockWebhookDeliveryService_Expecter) Get(ctx interface{}, deliveryUUID interface{}) *MockWebhookDeliveryService_Get_Call {
+	return &MockWebhookDeliveryService_Get_Call{Call: _e.mock.On("Get", ctx, deliveryUUID)}
+}
+
+func (_c *MockWebhookDeliveryService_Get_Call) Run(run func(ctx context.Context, deliveryUUID uuid.UUID)) *MockWebhookDeliveryService_Get_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockWebhookDeliveryService_Get_Call) Return(webhookDelivery model.WebhookDelivery, err error) *MockWebhookDeliveryService_Get_Call {
+	_c.Call.Return(webhookDelivery, err)
+	return _c
+}
+
+func (_c *MockWebhookDeliveryService_Get_Call) RunAndReturn(run func(ctx context.Context, deliveryUUID uuid.UUID) (model.WebhookDelivery, error)) *MockWebhookDeliveryService_Get_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// List provides a mock function for the type MockWebhookDeliveryService
+func (_mock *MockWebhookDeliveryService) List(ctx context.Context, filter model.WebhookDeliveryFilter) ([]model.WebhookDelivery, error) {
+	ret := _mock.Called(ctx, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for List")
+	}
+
+	var r0 []model.WebhookDelivery
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, model.WebhookDeliveryFilter) ([]model.WebhookDelivery, error)); ok {
+		return returnFunc(ctx, filter)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, model.WebhookDeliveryFilter) []model.WebhookDelivery); ok {
+		r0 = returnFunc(ctx, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.WebhookDelivery)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, model.WebhookDeliveryFilter) error); ok {
+		r1 = returnFunc(ctx, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockWebhookDeliveryService_List_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'List'
+type MockWebhookDeliveryService_List_Call struct {
+	*mock.Call
+}
+
+// List is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filter model.WebhookDeliveryFilter
+func (_e *MockWebhookDeliveryService_Expecter) List(ctx interface{}, filter interface{}) *MockWebhookDeliveryService_List_Call {
+	return &MockWebhookDeliveryService_List_Call{Call: _e.mock.On("List", ctx, filter)}
+}
+
+func (_c *MockWebhookDeliveryService_List_Call) Run(run func(ctx context.Context, filter model.WebhookDeliveryFilter)) *MockWebhookDeliveryService_List_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 model.WebhookDeliveryFilter
+		if args[1] != nil {
+			arg1 = args[1].(model.WebhookDeliveryFilter)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockWebhookDeliveryService_List_Call) Return(webhookDeliverys []model.WebhookDelivery, err error) *MockWebhookDeliveryService_List_Call {
+	_c.Call.Return(webhookDeliverys, err)
+	return _c
+}
+
+func (_c *MockWebhookDeliveryService_List_Call) RunAndReturn(run func(ctx context.Context, filter model.WebhookDeliveryFilter) ([]model.WebhookDelivery, error)) *MockWebhookDeliveryService_List_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SigningSecret provides a mock function for the type MockWebhookDeliveryService
+func (_mock *MockWebhookDeliveryService) SigningSecret(userUUID uuid.UUID, target string) string {
+	ret := _mock.Called(userUUID, target)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SigningSecret")
+	}
+
+	var r0 string
+	if returnFunc, ok := ret.Get(0).(func(uuid.UUID, string) string); ok {
+		r0 = returnFunc(userUUID, target)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	return r0
+}
+
+// MockWebhookDeliveryService_SigningSecret_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SigningSecret'
+type MockWebhookDeliveryService_SigningSecret_Call struct {
+	*mock.Call
+}
+
+// SigningSecret is a helper method to define mock.On call
+//   - userUUID uuid.UUID
+//   - target string
+func (_e *MockWebhookDeliveryService_Expecter) SigningSecret(userUUID interface{}, target interface{}) *MockWebhookDeliveryService_SigningSecret_Call {
+	return &MockWebhookDeliveryService_SigningSecret_Call{Call: _e.mock.On("SigningSecret", userUUID, target)}
+}
+
+func (_c *MockWebhookDeliveryService_SigningSecret_Call) Run(run func(userUUID uuid.UUID, target string)) *MockWebhookDeliveryService_SigningSecret_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 uuid.UUID
+		if args[0] != nil {
+			arg0 = args[0].(uuid.UUID)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockWebhookDeliveryService_SigningSecret_Call) Return(s string) *MockWebhookDeliveryService_SigningSecret_Call {
+	_c.Call.Return(s)
+	return _c
+}
+
+func (_c *MockWebhookDeliveryService_SigningSecret_Call) RunAndReturn(run func(userUUID uuid.UUID, target string) string) *MockWebhookDeliveryService_SigningSecret_Call {
+	_c.Call.Return(run)
+	return _c
+}
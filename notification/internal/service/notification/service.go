@@ -0,0 +1,93 @@
+package notification
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/crafty-ezhik/rocket-factory/notification/internal/model"
+	def "github.com/crafty-ezhik/rocket-factory/notification/internal/service"
+	"github.com/crafty-ezhik/rocket-factory/platform/pkg/logger"
+)
+
+var _ def.NotificationService = (*service)(nil)
+
+type service struct {
+	recipientService def.RecipientService
+	// channels - сервисы каналов доставки по имени провайдера
+	channels map[string]def.ChannelService
+}
+
+func NewService(recipientService def.RecipientService, channels map[string]def.ChannelService) *service {
+	return &service{
+		recipientService: recipientService,
+		channels:         channels,
+	}
+}
+
+func (s *service) SendOrderPaidNotification(ctx context.Context, event model.OrderPaidEvent) error {
+	return s.fanOut(ctx, event.UserUUID, func(ctx context.Context, channel def.ChannelService, recipient model.Recipient, target string) error {
+		return channel.SendOrderPaidNotification(ctx, recipient, target, event)
+	})
+}
+
+func (s *service) SendOrderAssembledNotification(ctx context.Context, event model.OrderAssembledEvent) error {
+	return s.fanOut(ctx, event.UserUUID, func(ctx context.Context, channel def.ChannelService, recipient model.Recipient, target string) error {
+		return channel.SendOrderAssembledNotification(ctx, recipient, target, event)
+	})
+}
+
+func (s *service) SendOrderRefundedNotification(ctx context.Context, event model.OrderRefundedEvent) error {
+	return s.fanOut(ctx, event.UserUUID, func(ctx context.Context, channel def.ChannelService, recipient model.Recipient, target string) error {
+		return channel.SendOrderRefundedNotification(ctx, recipient, target, event)
+	})
+}
+
+func (s *service) SendOrderAssemblyFailedNotification(ctx context.Context, event model.OrderAssemblyFailedEvent) error {
+	return s.fanOut(ctx, event.UserUUID, func(ctx context.Context, channel def.ChannelService, recipient model.Recipient, target string) error {
+		return channel.SendOrderAssemblyFailedNotification(ctx, recipient, target, event)
+	})
+}
+
+// fanOut - отправляет уведомление во все каналы пользователя.
+// Ошибки отдельных каналов объединяются, чтобы событие было обработано повторно.
+// Некорректный адрес получателя не исправится при повторе, поэтому такой канал пропускается
+func (s *service) fanOut(ctx context.Context, userUUID uuid.UUID, send func(ctx context.Context, channel def.ChannelService, recipient model.Recipient, target string) error) error {
+	recipient, err := s.recipientService.Recipient(ctx, userUUID)
+	if err != nil {
+		return err
+	}
+
+	if len(recipient.NotificationMethods) == 0 {
+		logger.Info(ctx, "User has no notification methods, notification skipped", zap.String("user_uuid", userUUID.String()))
+		return nil
+	}
+
+	var errs []error
+	for _, method := range recipient.NotificationMethods {
+		channel, ok := s.channels[method.ProviderName]
+		if !ok {
+			logger.Warn(ctx, "Unsupported notification provider, notification skipped",
+				zap.String("user_uuid", userUUID.String()),
+				zap.String("provider", method.ProviderName),
+			)
+			continue
+		}
+
+		err = send(ctx, channel, recipient, method.Target)
+		switch {
+		case err == nil:
+		case errors.Is(err, model.ErrInvalidTarget):
+			logger.Warn(ctx, "Invalid notification target, notification skipped",
+				zap.String("user_uuid", userUUID.String()),
+				zap.String("provider", method.ProviderName),
+				zap.Error(err),
+			)
+		default:
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
@@ -0,0 +1,135 @@
+package recipient
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/crafty-ezhik/rocket-factory/notification/internal/client/grpc"
+	"github.com/crafty-ezhik/rocket-factory/notification/internal/model"
+	def "github.com/crafty-ezhik/rocket-factory/notification/internal/service"
+	"github.com/crafty-ezhik/rocket-factory/platform/pkg/logger"
+)
+
+var _ def.RecipientService = (*service)(nil)
+
+// maxCacheEntries - при достижении этого размера из кеша вычищаются истекшие записи,
+// а если их не хватило - произвольные
+const maxCacheEntries = 10000
+
+type cacheEntry struct {
+	recipient model.Recipient
+	expiresAt time.Time
+}
+
+type service struct {
+	iamClient grpc.IAMClient
+	ttl       time.Duration
+
+	mu    sync.Mutex
+	cache map[uuid.UUID]cacheEntry
+	now   func() time.Time
+}
+
+// NewService - создает сервис получателей уведомлений.
+// Каналы пользователя запрашиваются в IAM и кешируются на ttl
+func NewService(iamClient grpc.IAMClient, ttl time.Duration) *service {
+	return &service{
+		iamClient: iamClient,
+		ttl:       ttl,
+		cache:     make(map[uuid.UUID]cacheEntry),
+		now:       time.Now,
+	}
+}
+
+// Recipient - возвращает каналы уведомлений, язык и часовой пояс пользователя.
+// Для неизвестного IAM пользователя возвращается получатель без каналов, чтобы событие не обрабатывалось повторно
+func (s *service) Recipient(ctx context.Context, userUUID uuid.UUID) (model.Recipient, error) {
+	now := s.now()
+	if recipient, ok := s.get(userUUID, now); ok {
+		return recipient, nil
+	}
+
+	user, err := s.iamClient.GetUser(ctx, userUUID)
+	if err != nil {
+		if !errors.Is(err, model.ErrUserNotFound) {
+			return model.Recipient{}, err
+		}
+		logger.Warn(ctx, "User not found in IAM", zap.String("user_uuid", userUUID.String()))
+	}
+
+	recipient := model.Recipient{
+		UserUUID:            userUUID,
+		NotificationMethods: user.NotificationMethods,
+		Locale:              user.Locale,
+		Location:            loadLocation(ctx, userUUID, user.Timezone),
+	}
+
+	s.set(userUUID, recipient, now)
+	return recipient, nil
+}
+
+// loadLocation - часовой пояс пользователя. Пустой или неизвестный пояс заменяется UTC,
+// чтобы уведомление все равно было отправлено
+func loadLocation(ctx context.Context, userUUID uuid.UUID, timezone string) *time.Location {
+	if timezone == "" {
+		return time.UTC
+	}
+
+	location, err := time.LoadLocation(timezone)
+	if err != nil {
+		logger.Warn(ctx, "Unknown user timezone, UTC used",
+			zap.String("user_uuid", userUUID.String()),
+			zap.String("timezone", timezone),
+		)
+		return time.UTC
+	}
+	return location
+}
+
+func (s *service) Invalidate(userUUID uuid.UUID) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.cache, userUUID)
+}
+
+func (s *service) get(userUUID uuid.UUID, now time.Time) (model.Recipient, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	entry, ok := s.cache[userUUID]
+	if !ok || !now.Before(entry.expiresAt) {
+		return model.Recipient{}, false
+	}
+	return entry.recipient, true
+}
+
+func (s *service) set(userUUID uuid.UUID, recipient model.Recipient, now time.Time) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if len(s.cache) >= maxCacheEntries {
+		for key, entry := range s.cache {
+			if !now.Before(entry.expiresAt) {
+				delete(s.cache, key)
+			}
+		}
+
+		for key := range s.cache {
+			if len(s.cache) < maxCacheEntries {
+				break
+			}
+			delete(s.cache, key)
+		}
+	}
+
+	s.cache[userUUID] = cacheEntry{
+		recipient: recipient,
+		expiresAt: now.Add(s.ttl),
+	}
+}
@@ -0,0 +1,165 @@
+package recipient
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/crafty-ezhik/rocket-factory/notification/internal/model"
+)
+
+func (s *ServiceSuite) user(userUUID uuid.UUID) model.User {
+	return model.User{
+		UUID: userUUID,
+		NotificationMethods: []model.NotificationMethod{
+			{ProviderName: "telegram", Target: "12345"},
+		},
+		Locale:   "ru",
+		Timezone: "Europe/Moscow",
+	}
+}
+
+func (s *ServiceSuite) TestRecipient() {
+	userUUID := uuid.New()
+	s.iamClient.EXPECT().GetUser(s.ctx, userUUID).Return(s.user(userUUID), nil).Once()
+
+	recipient, err := s.service.Recipient(s.ctx, userUUID)
+	s.Require().NoError(err)
+
+	s.Equal(userUUID, recipient.UserUUID)
+	s.Equal(s.user(userUUID).NotificationMethods, recipient.NotificationMethods)
+	s.Equal("ru", recipient.Locale)
+	s.Equal("Europe/Moscow", recipient.Location.String())
+}
+
+func (s *ServiceSuite) TestCachedForTTL() {
+	userUUID := uuid.New()
+	s.iamClient.EXPECT().GetUser(s.ctx, userUUID).Return(s.user(userUUID), nil).Once()
+
+	first, err := s.service.Recipient(s.ctx, userUUID)
+	s.Require().NoError(err)
+
+	s.now = s.now.Add(testTTL - time.Second)
+	second, err := s.service.Recipient(s.ctx, userUUID)
+	s.Require().NoError(err)
+
+	s.Equal(first, second)
+}
+
+func (s *ServiceSuite) TestReloadedAfterTTL() {
+	userUUID := uuid.New()
+	updated := s.user(userUUID)
+	updated.Locale = "en"
+
+	s.iamClient.EXPECT().GetUser(s.ctx, userUUID).Return(s.user(userUUID), nil).Once()
+	s.iamClient.EXPECT().GetUser(s.ctx, userUUID).Return(updated, nil).Once()
+
+	_, err := s.service.Recipient(s.ctx, userUUID)
+	s.Require().NoError(err)
+
+	s.now = s.now.Add(testTTL)
+	recipient, err := s.service.Recipient(s.ctx, userUUID)
+	s.Require().NoError(err)
+	s.Equal("en", recipient.Locale)
+}
+
+func (s *ServiceSuite) TestNotFoundIsCached() {
+	userUUID := uuid.New()
+	s.iamClient.EXPECT().GetUser(s.ctx, userUUID).Return(model.User{}, model.ErrUserNotFound).Once()
+
+	// Получатель без каналов, чтобы событие не обрабатывалось повторно
+	for range 2 {
+		recipient, err := s.service.Recipient(s.ctx, userUUID)
+		s.Require().NoError(err)
+		s.Equal(userUUID, recipient.UserUUID)
+		s.Empty(recipient.NotificationMethods)
+		s.Equal(time.UTC, recipient.Location)
+	}
+}
+
+func (s *ServiceSuite) TestErrorIsNotCached() {
+	userUUID := uuid.New()
+	iamErr := errors.New("iam unavailable")
+
+	s.iamClient.EXPECT().GetUser(s.ctx, userUUID).Return(model.User{}, iamErr).Once()
+	s.iamClient.EXPECT().GetUser(s.ctx, userUUID).Return(s.user(userUUID), nil).Once()
+
+	_, err := s.service.Recipient(s.ctx, userUUID)
+	s.ErrorIs(err, iamErr)
+
+	recipient, err := s.service.Recipient(s.ctx, userUUID)
+	s.Require().NoError(err)
+	s.Len(recipient.NotificationMethods, 1)
+}
+
+func (s *ServiceSuite) TestInvalidate() {
+	userUUID := uuid.New()
+	other := uuid.New()
+
+	s.iamClient.EXPECT().GetUser(s.ctx, userUUID).Return(s.user(userUUID), nil).Twice()
+	s.iamClient.EXPECT().GetUser(s.ctx, other).Return(s.user(other), nil).Once()
+
+	_, err := s.service.Recipient(s.ctx, userUUID)
+	s.Require().NoError(err)
+	_, err = s.service.Recipient(s.ctx, other)
+	s.Require().NoError(err)
+
+	// Пользователь изменил каналы: следующий запрос идет в IAM, кеш других пользователей не затронут
+	s.service.Invalidate(userUUID)
+
+	_, err = s.service.Recipient(s.ctx, userUUID)
+	s.Require().NoError(err)
+	_, err = s.service.Recipient(s.ctx, other)
+	s.Require().NoError(err)
+}
+
+func (s *ServiceSuite) TestUnknownTimezone() {
+	userUUID := uuid.New()
+	user := s.user(userUUID)
+	user.Timezone = "Mars/Olympus_Mons"
+	s.iamClient.EXPECT().GetUser(s.ctx, userUUID).Return(user, nil).Once()
+
+	recipient, err := s.service.Recipient(s.ctx, userUUID)
+	s.Require().NoError(err)
+	s.Equal(time.UTC, recipient.Location)
+}
+
+// fillCache - заполняет кеш до предела: expired записей уже истекли, остальные действуют
+func (s *ServiceSuite) fillCache(expired int) {
+	for i := range maxCacheEntries {
+		expiresAt := s.now.Add(testTTL)
+		if i < expired {
+			expiresAt = s.now
+		}
+		s.service.cache[uuid.New()] = cacheEntry{expiresAt: expiresAt}
+	}
+}
+
+func (s *ServiceSuite) TestEvictsExpiredEntries() {
+	s.fillCache(maxCacheEntries / 2)
+
+	userUUID := uuid.New()
+	s.iamClient.EXPECT().GetUser(s.ctx, userUUID).Return(s.user(userUUID), nil).Once()
+
+	_, err := s.service.Recipient(s.ctx, userUUID)
+	s.Require().NoError(err)
+
+	s.Len(s.service.cache, maxCacheEntries/2+1)
+	for _, entry := range s.service.cache {
+		s.True(s.now.Before(entry.expiresAt))
+	}
+}
+
+func (s *ServiceSuite) TestCacheSizeIsBounded() {
+	s.fillCache(0)
+
+	userUUID := uuid.New()
+	s.iamClient.EXPECT().GetUser(s.ctx, userUUID).Return(s.user(userUUID), nil).Once()
+
+	_, err := s.service.Recipient(s.ctx, userUUID)
+	s.Require().NoError(err)
+
+	s.Len(s.service.cache, maxCacheEntries)
+	s.Contains(s.service.cache, userUUID)
+}
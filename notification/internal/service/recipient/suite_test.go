@@ -0,0 +1,39 @@
+package recipient
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	clientMocks "github.com/crafty-ezhik/rocket-factory/notification/internal/client/grpc/mocks"
+	"github.com/crafty-ezhik/rocket-factory/platform/pkg/logger"
+)
+
+const testTTL = time.Minute
+
+type ServiceSuite struct {
+	suite.Suite
+
+	ctx context.Context //nolint:containedctx
+	now time.Time
+
+	iamClient *clientMocks.MockIAMClient
+	service   *service
+}
+
+func (s *ServiceSuite) SetupTest() {
+	s.ctx = context.Background()
+	s.now = time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	logger.SetNopLogger()
+
+	s.iamClient = clientMocks.NewMockIAMClient(s.T())
+	s.service = NewService(s.iamClient, testTTL)
+	s.service.now = func() time.Time { return s.now }
+}
+
+func TestServiceIntegration(t *testing.T) {
+	suite.Run(t, new(ServiceSuite))
+}
@@ -3,12 +3,55 @@ package service
 import (
 	"context"
 
+	"github.com/google/uuid"
+
 	"github.com/crafty-ezhik/rocket-factory/notification/internal/model"
 )
 
-type TelegramService interface {
+// NotificationService - отправляет уведомления во все каналы пользователя
+type NotificationService interface {
 	SendOrderPaidNotification(ctx context.Context, msg model.OrderPaidEvent) error
 	SendOrderAssembledNotification(ctx context.Context, msg model.OrderAssembledEvent) error
+	SendOrderRefundedNotification(ctx context.Context, msg model.OrderRefundedEvent) error
+	SendOrderAssemblyFailedNotification(ctx context.Context, msg model.OrderAssemblyFailedEvent) error
+}
+
+// RecipientService - каналы уведомлений и настройки пользователя из IAM
+type RecipientService interface {
+	Recipient(ctx context.Context, userUUID uuid.UUID) (model.Recipient, error)
+	// Invalidate - сбрасывает кеш пользователя, например после добавления канала уведомлений
+	Invalidate(userUUID uuid.UUID)
+}
+
+// ChannelService - формирует и отправляет уведомление в один канал доставки.
+// target - адрес получателя в формате канала: чат-id для Telegram, адрес почты для email.
+// Язык и часовой пояс текста берутся из recipient
+type ChannelService interface {
+	SendOrderPaidNotification(ctx context.Context, recipient model.Recipient, target string, msg model.OrderPaidEvent) error
+	SendOrderAssembledNotification(ctx context.Context, recipient model.Recipient, target string, msg model.OrderAssembledEvent) error
+	SendOrderRefundedNotification(ctx context.Context, recipient model.Recipient, target string, msg model.OrderRefundedEvent) error
+	SendOrderAssemblyFailedNotification(ctx context.Context, recipient model.Recipient, target string, msg model.OrderAssemblyFailedEvent) error
+}
+
+// WebhookDeliveryService - журнал доставки webhook-уведомлений для admin API
+type WebhookDeliveryService interface {
+	Get(ctx context.Context, deliveryUUID uuid.UUID) (model.WebhookDelivery, error)
+	List(ctx context.Context, filter model.WebhookDeliveryFilter) ([]model.WebhookDelivery, error)
+	// SigningSecret - секрет, которым подписываются запросы к адресу target пользователя
+	SigningSecret(userUUID uuid.UUID, target string) string
+}
+
+// TelegramBotService - команды Telegram-бота. Ответ на команду отправляется в чат, из которого она пришла.
+// Ошибка возвращается, если команду не удалось выполнить, пользователь в этом случае получает ответ model.BotReplyError
+type TelegramBotService interface {
+	// Help - список команд, /start и /help
+	Help(ctx context.Context, msg model.ChatMessage) error
+	// Link - /link <session_uuid>, привязывает чат к пользователю сессии и добавляет чат в его каналы уведомлений
+	Link(ctx context.Context, msg model.ChatMessage) error
+	// Orders - /orders, последние заказы привязанного пользователя
+	Orders(ctx context.Context, msg model.ChatMessage) error
+	// Status - /status <order_uuid>, текущий статус заказа
+	Status(ctx context.Context, msg model.ChatMessage) error
 }
 
 type OrderPaidConsumerService interface {
@@ -18,3 +61,11 @@ type OrderPaidConsumerService interface {
 type OrderAssembledConsumerService interface {
 	RunConsumer(ctx context.Context) error
 }
+
+type OrderRefundedConsumerService interface {
+	RunConsumer(ctx context.Context) error
+}
+
+type OrderAssemblyFailedConsumerService interface {
+	RunConsumer(ctx context.Context) error
+}
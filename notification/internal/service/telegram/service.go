@@ -1,104 +1,58 @@
 package telegram
 
 import (
-	"bytes"
 	"context"
-	"text/template"
-	"time"
 
 	"go.uber.org/zap"
 
 	"github.com/crafty-ezhik/rocket-factory/notification/internal/client/http"
-	"github.com/crafty-ezhik/rocket-factory/notification/internal/config"
 	"github.com/crafty-ezhik/rocket-factory/notification/internal/model"
-	"github.com/crafty-ezhik/rocket-factory/notification/internal/service/telegram/templates"
+	def "github.com/crafty-ezhik/rocket-factory/notification/internal/service"
+	"github.com/crafty-ezhik/rocket-factory/notification/internal/template"
 	"github.com/crafty-ezhik/rocket-factory/platform/pkg/logger"
 )
 
-type orderPaidTemplateData struct {
-	OrderUUID       string
-	TransactionUUID string
-	PaymentMethod   string
-	PaymentDate     string
-}
-
-type orderAssembledTemplateData struct {
-	OrderUUID    string
-	BuildTimeSec int
-}
-
-var (
-	orderPaidTemplate      = template.Must(template.ParseFS(templates.FS, "order_paid_notification.tmpl"))
-	orderAssembledTemplate = template.Must(template.ParseFS(templates.FS, "order_assembled_notification.tmpl"))
-)
+var _ def.ChannelService = (*service)(nil)
 
 type service struct {
-	tgClient http.TelegramClient
+	notifier  http.Notifier
+	templates template.Renderer
 }
 
-func NewService(tgClient http.TelegramClient) *service {
+func NewService(notifier http.Notifier, templates template.Renderer) *service {
 	return &service{
-		tgClient: tgClient,
+		notifier:  notifier,
+		templates: templates,
 	}
 }
 
-func (s *service) SendOrderPaidNotification(ctx context.Context, msg model.OrderPaidEvent) error {
-	chatID := config.AppConfig().TgBot.ChatID()
-	message, err := s.buildPaidMsg(msg)
-	if err != nil {
-		return err
-	}
+func (s *service) SendOrderPaidNotification(ctx context.Context, recipient model.Recipient, target string, msg model.OrderPaidEvent) error {
+	return s.send(ctx, recipient, target, model.EventOrderPaid, msg)
+}
 
-	err = s.tgClient.SendMessage(ctx, chatID, message)
-	if err != nil {
-		return err
-	}
-	logger.Info(ctx, "Telegram message sent to chat", zap.Int64("chat_id", chatID), zap.String("message", message))
-	return nil
+func (s *service) SendOrderAssembledNotification(ctx context.Context, recipient model.Recipient, target string, msg model.OrderAssembledEvent) error {
+	return s.send(ctx, recipient, target, model.EventOrderAssembled, msg)
 }
 
-func (s *service) SendOrderAssembledNotification(ctx context.Context, msg model.OrderAssembledEvent) error {
-	chatID := config.AppConfig().TgBot.ChatID()
+func (s *service) SendOrderRefundedNotification(ctx context.Context, recipient model.Recipient, target string, msg model.OrderRefundedEvent) error {
+	return s.send(ctx, recipient, target, model.EventOrderRefunded, msg)
+}
 
-	message, err := s.buildAssembledMsg(msg)
+func (s *service) SendOrderAssemblyFailedNotification(ctx context.Context, recipient model.Recipient, target string, msg model.OrderAssemblyFailedEvent) error {
+	return s.send(ctx, recipient, target, model.EventOrderAssemblyFailed, msg)
+}
+
+func (s *service) send(ctx context.Context, recipient model.Recipient, target string, event model.EventType, data any) error {
+	message, err := s.templates.Render(model.ProviderTelegram, event, recipient, data)
 	if err != nil {
 		return err
 	}
-	err = s.tgClient.SendMessage(ctx, chatID, message)
+
+	err = s.notifier.Notify(ctx, target, message)
 	if err != nil {
 		return err
 	}
 
-	logger.Info(ctx, "Telegram message sent to chat", zap.Int64("chat_id", chatID), zap.String("message", message))
+	logger.Info(ctx, "Telegram message sent to chat", zap.String("chat_id", target), zap.String("message", message.HTML))
 	return nil
 }
-
-func (s *service) buildPaidMsg(msg model.OrderPaidEvent) (string, error) {
-	data := orderPaidTemplateData{
-		OrderUUID:       msg.OrderUUID.String(),
-		TransactionUUID: msg.TransactionUUID.String(),
-		PaymentMethod:   msg.PaymentMethod,
-		PaymentDate:     time.Now().Format(time.DateTime),
-	}
-
-	var buf bytes.Buffer
-	err := orderPaidTemplate.Execute(&buf, data)
-	if err != nil {
-		return "", err
-	}
-	return buf.String(), nil
-}
-
-func (s *service) buildAssembledMsg(msg model.OrderAssembledEvent) (string, error) {
-	data := orderAssembledTemplateData{
-		OrderUUID:    msg.OrderUUID.String(),
-		BuildTimeSec: msg.BuildTimeSec,
-	}
-
-	var buf bytes.Buffer
-	err := orderAssembledTemplate.Execute(&buf, data)
-	if err != nil {
-		return "", err
-	}
-	return buf.String(), nil
-}
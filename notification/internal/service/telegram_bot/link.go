@@ -0,0 +1,67 @@
+package telegram_bot
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/crafty-ezhik/rocket-factory/notification/internal/model"
+	"github.com/crafty-ezhik/rocket-factory/platform/pkg/logger"
+)
+
+// Link - привязывает чат к пользователю сессии. Сообщение с сессией удаляется из чата до проверки,
+// чтобы UUID сессии не оставался в истории. Сессия хранится в привязке зашифрованной, см. TelegramLinkRepository
+func (s *service) Link(ctx context.Context, msg model.ChatMessage) error {
+	if !msg.Private {
+		return s.reply(ctx, msg, guest(msg), model.BotReplyPrivateOnly, nil)
+	}
+
+	sessionUUID, err := uuid.Parse(strings.TrimSpace(msg.Args))
+	if err != nil {
+		return s.reply(ctx, msg, guest(msg), model.BotReplyLinkUsage, nil)
+	}
+
+	deleted := true
+	if err = s.telegramClient.DeleteMessage(ctx, msg.ChatID, msg.MessageID); err != nil {
+		logger.Warn(ctx, "Failed to delete message with session", zap.Int64("chat_id", msg.ChatID), zap.Error(err))
+		deleted = false
+	}
+
+	user, err := s.iamClient.Whoami(ctx, sessionUUID)
+	if err != nil {
+		if errors.Is(err, model.ErrSessionExpired) {
+			return s.reply(ctx, msg, guest(msg), model.BotReplySessionExpired, nil)
+		}
+		return s.fail(ctx, msg, err)
+	}
+
+	err = s.iamClient.AddNotificationMethod(ctx, sessionUUID, user.UUID, model.NotificationMethod{
+		ProviderName: model.ProviderTelegram,
+		Target:       strconv.FormatInt(msg.ChatID, 10),
+	})
+	if err != nil && !errors.Is(err, model.ErrNotificationMethodExist) {
+		if errors.Is(err, model.ErrSessionExpired) {
+			return s.reply(ctx, msg, guest(msg), model.BotReplySessionExpired, nil)
+		}
+		return s.fail(ctx, msg, err)
+	}
+
+	err = s.linkRepo.Save(ctx, model.TelegramLink{
+		ChatID:      msg.ChatID,
+		UserUUID:    user.UUID,
+		SessionUUID: sessionUUID,
+	})
+	if err != nil {
+		return s.fail(ctx, msg, err)
+	}
+
+	// Новый канал должен получать уведомления сразу, а не после истечения кеша
+	s.recipients.Invalidate(user.UUID)
+
+	logger.Info(ctx, "Telegram chat linked", zap.Int64("chat_id", msg.ChatID), zap.String("user_uuid", user.UUID.String()))
+	return s.reply(ctx, msg, s.recipient(ctx, msg, user.UUID), model.BotReplyLinked, deleted)
+}
@@ -0,0 +1,123 @@
+package telegram_bot_test
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/crafty-ezhik/rocket-factory/notification/internal/model"
+)
+
+const chatID int64 = 42
+
+var (
+	userUUID    = uuid.MustParse("00000000-0000-0000-0000-000000000001")
+	sessionUUID = uuid.MustParse("00000000-0000-0000-0000-000000000002")
+)
+
+func linkMessage(args string) model.ChatMessage {
+	return model.ChatMessage{
+		ChatID:       chatID,
+		MessageID:    7,
+		LanguageCode: model.LocaleEN,
+		Private:      true,
+		Args:         args,
+	}
+}
+
+// expectReply - ожидает ответ в чат, содержащий contains
+func (s *ServiceSuite) expectReply(contains string) {
+	s.telegramClient.On("SendMessage", mock.Anything, chatID, mock.MatchedBy(func(text string) bool {
+		return strings.Contains(text, contains)
+	})).Return(nil).Once()
+}
+
+func (s *ServiceSuite) TestLink() {
+	s.telegramClient.On("DeleteMessage", mock.Anything, chatID, 7).Return(nil).Once()
+	s.iamClient.On("Whoami", mock.Anything, sessionUUID).Return(model.User{UUID: userUUID}, nil).Once()
+	// Канал добавляется от имени пользователя привязываемой сессии
+	s.iamClient.On("AddNotificationMethod", mock.Anything, sessionUUID, userUUID, model.NotificationMethod{
+		ProviderName: model.ProviderTelegram,
+		Target:       "42",
+	}).Return(model.ErrNotificationMethodExist).Once()
+	s.linkRepo.On("Save", mock.Anything, model.TelegramLink{
+		ChatID:      chatID,
+		UserUUID:    userUUID,
+		SessionUUID: sessionUUID,
+	}).Return(nil).Once()
+	s.recipients.On("Invalidate", userUUID).Return().Once()
+	s.recipients.On("Recipient", mock.Anything, userUUID).
+		Return(model.Recipient{UserUUID: userUUID, Locale: model.LocaleRU, Location: time.UTC}, nil).Once()
+	s.expectReply("Сообщение с сессией удалено из чата")
+
+	s.Require().NoError(s.service.Link(s.ctx, linkMessage(" "+sessionUUID.String()+" ")))
+}
+
+func (s *ServiceSuite) TestLinkRejected() {
+	tests := []struct {
+		name     string
+		msg      model.ChatMessage
+		whoami   error
+		contains string
+	}{
+		{
+			name:     "group chat",
+			msg:      model.ChatMessage{ChatID: chatID, LanguageCode: model.LocaleEN, Args: sessionUUID.String()},
+			contains: "only in a private chat",
+		},
+		{
+			name:     "missing session",
+			msg:      linkMessage(""),
+			contains: "Send your session UUID",
+		},
+		{
+			name:     "invalid session",
+			msg:      linkMessage("not-a-uuid"),
+			contains: "Send your session UUID",
+		},
+		{
+			name:     "expired session",
+			msg:      linkMessage(sessionUUID.String()),
+			whoami:   model.ErrSessionExpired,
+			contains: "The session is no longer valid",
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			s.SetupTest()
+
+			if tt.whoami != nil {
+				s.telegramClient.On("DeleteMessage", mock.Anything, chatID, 7).Return(nil).Once()
+				s.iamClient.On("Whoami", mock.Anything, sessionUUID).Return(model.User{}, tt.whoami).Once()
+			}
+			s.expectReply(tt.contains)
+
+			s.Require().NoError(s.service.Link(s.ctx, tt.msg))
+		})
+	}
+}
+
+func (s *ServiceSuite) TestLinkSessionRevokedBeforeAdd() {
+	s.telegramClient.On("DeleteMessage", mock.Anything, chatID, 7).Return(nil).Once()
+	s.iamClient.On("Whoami", mock.Anything, sessionUUID).Return(model.User{UUID: userUUID}, nil).Once()
+	s.iamClient.On("AddNotificationMethod", mock.Anything, sessionUUID, userUUID, mock.Anything).
+		Return(model.ErrSessionExpired).Once()
+	s.expectReply("The session is no longer valid")
+
+	s.Require().NoError(s.service.Link(s.ctx, linkMessage(sessionUUID.String())))
+}
+
+func (s *ServiceSuite) TestLinkFailure() {
+	iamErr := errors.New("iam unavailable")
+
+	s.telegramClient.On("DeleteMessage", mock.Anything, chatID, 7).Return(errors.New("message can't be deleted")).Once()
+	s.iamClient.On("Whoami", mock.Anything, sessionUUID).Return(model.User{}, iamErr).Once()
+	s.expectReply("Failed to run the command")
+
+	err := s.service.Link(s.ctx, linkMessage(sessionUUID.String()))
+	s.Require().ErrorIs(err, iamErr)
+}
@@ -0,0 +1,85 @@
+package telegram_bot
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/crafty-ezhik/rocket-factory/notification/internal/model"
+	"github.com/crafty-ezhik/rocket-factory/platform/pkg/logger"
+)
+
+func (s *service) Orders(ctx context.Context, msg model.ChatMessage) error {
+	link, ok, err := s.link(ctx, msg)
+	if !ok {
+		return err
+	}
+
+	orders, err := s.orderClient.ListOrders(ctx, link.SessionUUID, ordersLimit)
+	if err != nil {
+		return s.orderError(ctx, msg, link, err)
+	}
+
+	return s.reply(ctx, msg, s.recipient(ctx, msg, link.UserUUID), model.BotReplyOrders, orders)
+}
+
+func (s *service) Status(ctx context.Context, msg model.ChatMessage) error {
+	if !msg.Private {
+		return s.reply(ctx, msg, guest(msg), model.BotReplyPrivateOnly, nil)
+	}
+
+	orderUUID, err := uuid.Parse(strings.TrimSpace(msg.Args))
+	if err != nil {
+		return s.reply(ctx, msg, guest(msg), model.BotReplyStatusUsage, nil)
+	}
+
+	link, ok, err := s.link(ctx, msg)
+	if !ok {
+		return err
+	}
+
+	order, err := s.orderClient.GetOrder(ctx, link.SessionUUID, orderUUID)
+	if err != nil {
+		return s.orderError(ctx, msg, link, err)
+	}
+
+	return s.reply(ctx, msg, s.recipient(ctx, msg, link.UserUUID), model.BotReplyOrderStatus, order)
+}
+
+// link - привязка чата команды. Если команду выполнить нельзя, ответ уже отправлен и ok == false
+func (s *service) link(ctx context.Context, msg model.ChatMessage) (link model.TelegramLink, ok bool, err error) {
+	if !msg.Private {
+		return model.TelegramLink{}, false, s.reply(ctx, msg, guest(msg), model.BotReplyPrivateOnly, nil)
+	}
+
+	link, err = s.linkRepo.Get(ctx, msg.ChatID)
+	if err != nil {
+		if errors.Is(err, model.ErrChatNotLinked) {
+			return model.TelegramLink{}, false, s.reply(ctx, msg, guest(msg), model.BotReplyNotLinked, nil)
+		}
+		return model.TelegramLink{}, false, s.fail(ctx, msg, err)
+	}
+	return link, true, nil
+}
+
+// orderError - ответ на ошибку Order Service. С завершенной сессией чат отвязывается,
+// канал уведомлений в IAM при этом остается
+func (s *service) orderError(ctx context.Context, msg model.ChatMessage, link model.TelegramLink, err error) error {
+	recipient := s.recipient(ctx, msg, link.UserUUID)
+
+	switch {
+	case errors.Is(err, model.ErrOrderNotFound):
+		return s.reply(ctx, msg, recipient, model.BotReplyOrderNotFound, nil)
+	case errors.Is(err, model.ErrSessionExpired):
+		if err = s.linkRepo.Delete(ctx, msg.ChatID); err != nil {
+			return s.fail(ctx, msg, err)
+		}
+		logger.Info(ctx, "Telegram chat unlinked, session expired", zap.Int64("chat_id", msg.ChatID))
+		return s.reply(ctx, msg, recipient, model.BotReplySessionExpired, nil)
+	default:
+		return s.fail(ctx, msg, err)
+	}
+}
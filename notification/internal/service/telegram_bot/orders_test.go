@@ -0,0 +1,91 @@
+package telegram_bot_test
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/crafty-ezhik/rocket-factory/notification/internal/model"
+)
+
+func (s *ServiceSuite) linked() {
+	s.linkRepo.On("Get", mock.Anything, chatID).Return(model.TelegramLink{
+		ChatID:      chatID,
+		UserUUID:    userUUID,
+		SessionUUID: sessionUUID,
+	}, nil).Once()
+	s.recipients.On("Recipient", mock.Anything, userUUID).
+		Return(model.Recipient{UserUUID: userUUID, Locale: model.LocaleEN, Location: time.UTC}, nil).Once()
+}
+
+func (s *ServiceSuite) TestOrders() {
+	orderUUID := uuid.New()
+
+	s.linked()
+	s.orderClient.On("ListOrders", mock.Anything, sessionUUID, 10).
+		Return([]model.Order{{UUID: orderUUID, Status: "PAID", TotalPrice: 100}}, nil).Once()
+	s.expectReply(orderUUID.String())
+
+	s.Require().NoError(s.service.Orders(s.ctx, linkMessage("")))
+}
+
+func (s *ServiceSuite) TestOrdersNotLinked() {
+	s.linkRepo.On("Get", mock.Anything, chatID).Return(model.TelegramLink{}, model.ErrChatNotLinked).Once()
+	s.expectReply("The chat is not linked")
+
+	s.Require().NoError(s.service.Orders(s.ctx, linkMessage("")))
+}
+
+func (s *ServiceSuite) TestOrdersSessionExpired() {
+	s.linked()
+	s.orderClient.On("ListOrders", mock.Anything, sessionUUID, 10).Return(nil, model.ErrSessionExpired).Once()
+	s.linkRepo.On("Delete", mock.Anything, chatID).Return(nil).Once()
+	s.expectReply("The session is no longer valid")
+
+	s.Require().NoError(s.service.Orders(s.ctx, linkMessage("")))
+}
+
+func (s *ServiceSuite) TestStatus() {
+	orderUUID := uuid.New()
+
+	tests := []struct {
+		name     string
+		args     string
+		order    model.Order
+		err      error
+		contains string
+	}{
+		{
+			name:     "order status",
+			args:     orderUUID.String(),
+			order:    model.Order{UUID: orderUUID, Status: "ASSEMBLED", TotalPrice: 100},
+			contains: "<b>Status:</b> Assembled",
+		},
+		{
+			name:     "order not found",
+			args:     orderUUID.String(),
+			err:      model.ErrOrderNotFound,
+			contains: "Order not found",
+		},
+		{
+			name:     "invalid order uuid",
+			args:     "42",
+			contains: "Send the order UUID",
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			s.SetupTest()
+
+			if tt.order.UUID != uuid.Nil || tt.err != nil {
+				s.linked()
+				s.orderClient.On("GetOrder", mock.Anything, sessionUUID, orderUUID).Return(tt.order, tt.err).Once()
+			}
+			s.expectReply(tt.contains)
+
+			s.Require().NoError(s.service.Status(s.ctx, linkMessage(tt.args)))
+		})
+	}
+}
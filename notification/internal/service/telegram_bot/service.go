@@ -0,0 +1,88 @@
+package telegram_bot
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/crafty-ezhik/rocket-factory/notification/internal/client/grpc"
+	"github.com/crafty-ezhik/rocket-factory/notification/internal/client/http"
+	"github.com/crafty-ezhik/rocket-factory/notification/internal/model"
+	"github.com/crafty-ezhik/rocket-factory/notification/internal/repository"
+	def "github.com/crafty-ezhik/rocket-factory/notification/internal/service"
+	"github.com/crafty-ezhik/rocket-factory/notification/internal/template"
+	"github.com/crafty-ezhik/rocket-factory/platform/pkg/logger"
+)
+
+var _ def.TelegramBotService = (*service)(nil)
+
+// ordersLimit - сколько последних заказов показывает /orders
+const ordersLimit = 10
+
+type service struct {
+	telegramClient http.TelegramClient
+	orderClient    http.OrderClient
+	iamClient      grpc.IAMClient
+	linkRepo       repository.TelegramLinkRepository
+	recipients     def.RecipientService
+	templates      template.Renderer
+}
+
+func NewService(
+	telegramClient http.TelegramClient,
+	orderClient http.OrderClient,
+	iamClient grpc.IAMClient,
+	linkRepo repository.TelegramLinkRepository,
+	recipients def.RecipientService,
+	templates template.Renderer,
+) *service {
+	return &service{
+		telegramClient: telegramClient,
+		orderClient:    orderClient,
+		iamClient:      iamClient,
+		linkRepo:       linkRepo,
+		recipients:     recipients,
+		templates:      templates,
+	}
+}
+
+func (s *service) Help(ctx context.Context, msg model.ChatMessage) error {
+	return s.reply(ctx, msg, guest(msg), model.BotReplyHelp, nil)
+}
+
+// reply - отправляет ответ на команду на языке получателя
+func (s *service) reply(ctx context.Context, msg model.ChatMessage, recipient model.Recipient, reply model.BotReply, data any) error {
+	text, err := s.templates.RenderReply(reply, recipient, data)
+	if err != nil {
+		return err
+	}
+	return s.telegramClient.SendMessage(ctx, msg.ChatID, text)
+}
+
+// fail - сообщает пользователю, что команда не выполнена, и возвращает исходную ошибку
+func (s *service) fail(ctx context.Context, msg model.ChatMessage, err error) error {
+	if replyErr := s.reply(ctx, msg, guest(msg), model.BotReplyError, nil); replyErr != nil {
+		logger.Error(ctx, "Failed to send bot error reply", zap.Int64("chat_id", msg.ChatID), zap.Error(replyErr))
+	}
+	return err
+}
+
+// recipient - язык и часовой пояс привязанного пользователя. Если IAM недоступен, используется язык клиента Telegram
+func (s *service) recipient(ctx context.Context, msg model.ChatMessage, userUUID uuid.UUID) model.Recipient {
+	recipient, err := s.recipients.Recipient(ctx, userUUID)
+	if err != nil {
+		logger.Warn(ctx, "Failed to get recipient for bot reply", zap.String("user_uuid", userUUID.String()), zap.Error(err))
+		return guest(msg)
+	}
+	return recipient
+}
+
+// guest - получатель для непривязанного чата: язык клиента Telegram, время в UTC
+func guest(msg model.ChatMessage) model.Recipient {
+	return model.Recipient{
+		Locale:   msg.LanguageCode,
+		Location: time.UTC,
+	}
+}
@@ -0,0 +1,50 @@
+package telegram_bot_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	grpcMocks "github.com/crafty-ezhik/rocket-factory/notification/internal/client/grpc/mocks"
+	httpMocks "github.com/crafty-ezhik/rocket-factory/notification/internal/client/http/mocks"
+	repoMocks "github.com/crafty-ezhik/rocket-factory/notification/internal/repository/mocks"
+	"github.com/crafty-ezhik/rocket-factory/notification/internal/service"
+	serviceMocks "github.com/crafty-ezhik/rocket-factory/notification/internal/service/mocks"
+	"github.com/crafty-ezhik/rocket-factory/notification/internal/service/telegram_bot"
+	"github.com/crafty-ezhik/rocket-factory/notification/internal/template/registry"
+	"github.com/crafty-ezhik/rocket-factory/platform/pkg/logger"
+)
+
+type ServiceSuite struct {
+	suite.Suite
+
+	ctx context.Context //nolint:containedctx
+
+	telegramClient *httpMocks.MockTelegramClient
+	orderClient    *httpMocks.MockOrderClient
+	iamClient      *grpcMocks.MockIAMClient
+	linkRepo       *repoMocks.MockTelegramLinkRepository
+	recipients     *serviceMocks.MockRecipientService
+	service        service.TelegramBotService
+}
+
+func (s *ServiceSuite) SetupTest() {
+	s.ctx = context.Background()
+
+	logger.SetNopLogger()
+
+	templates, err := registry.New()
+	s.Require().NoError(err)
+
+	s.telegramClient = httpMocks.NewMockTelegramClient(s.T())
+	s.orderClient = httpMocks.NewMockOrderClient(s.T())
+	s.iamClient = grpcMocks.NewMockIAMClient(s.T())
+	s.linkRepo = repoMocks.NewMockTelegramLinkRepository(s.T())
+	s.recipients = serviceMocks.NewMockRecipientService(s.T())
+	s.service = telegram_bot.NewService(s.telegramClient, s.orderClient, s.iamClient, s.linkRepo, s.recipients, templates)
+}
+
+func TestServiceIntegration(t *testing.T) {
+	suite.Run(t, new(ServiceSuite))
+}
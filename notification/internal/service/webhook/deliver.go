@@ -0,0 +1,127 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/crafty-ezhik/rocket-factory/notification/internal/model"
+	"github.com/crafty-ezhik/rocket-factory/platform/pkg/logger"
+)
+
+// deliver - доставляет событие на адрес с повторами и ведет журнал доставки.
+//
+//	Ошибка возвращается, только если все попытки исчерпаны на временных ошибках:
+//	тогда событие повторно обработает consumer, а журнал продолжит ту же запись.
+//	Ответ 4xx повторять бессмысленно, такая доставка помечается FAILED без ошибки.
+func (s *service) deliver(ctx context.Context, target string, p payload) error {
+	body, err := json.Marshal(p)
+	if err != nil {
+		return fmt.Errorf("marshal webhook payload: %w", err)
+	}
+
+	delivery, err := s.deliveryRepo.Create(ctx, model.WebhookDelivery{
+		UUID:      uuid.New(),
+		EventUUID: p.EventUUID,
+		EventType: p.EventType,
+		UserUUID:  p.UserUUID,
+		Target:    target,
+		Payload:   body,
+	})
+	if err != nil {
+		return err
+	}
+
+	fields := []zap.Field{
+		zap.String("delivery_uuid", delivery.UUID.String()),
+		zap.String("event_uuid", delivery.EventUUID.String()),
+		zap.String("event_type", delivery.EventType.String()),
+	}
+
+	if delivery.Status == model.WebhookDeliveryStatusDELIVERED {
+		logger.Info(ctx, "Webhook already delivered, skipped", fields...)
+		return nil
+	}
+
+	req := model.WebhookRequest{
+		DeliveryUUID: delivery.UUID,
+		UserUUID:     delivery.UserUUID,
+		EventType:    delivery.EventType,
+		Body:         delivery.Payload,
+	}
+
+	backoff := s.retry.Backoff
+	for attempt := 1; ; attempt++ {
+		sendErr := s.client.Send(ctx, target, req)
+
+		retryable := isRetryable(sendErr)
+		lastAttempt := attempt >= s.retry.Attempts || ctx.Err() != nil
+
+		result := newAttempt(sendErr)
+		if sendErr != nil && (!retryable || lastAttempt) {
+			result.Status = model.WebhookDeliveryStatusFAILED
+		}
+
+		// Журнал сохраняется и при отмене контекста, иначе попытка потеряется
+		if err = s.deliveryRepo.SaveAttempt(context.WithoutCancel(ctx), delivery.UUID, result); err != nil {
+			return err
+		}
+
+		switch {
+		case sendErr == nil:
+			logger.Info(ctx, "Webhook delivered", append(fields, zap.Int("attempt", attempt))...)
+			return nil
+		case errors.Is(sendErr, model.ErrInvalidTarget):
+			return sendErr
+		case !retryable:
+			logger.Warn(ctx, "Webhook rejected by receiver, delivery failed", append(fields, zap.Error(sendErr))...)
+			return nil
+		case lastAttempt:
+			return fmt.Errorf("webhook delivery %s failed after %d attempts: %w", delivery.UUID, attempt, sendErr)
+		}
+
+		logger.Warn(ctx, "Webhook delivery attempt failed, retrying",
+			append(fields, zap.Int("attempt", attempt), zap.Duration("backoff", backoff), zap.Error(sendErr))...)
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(backoff):
+		}
+		backoff = min(backoff*2, s.retry.MaxBackoff)
+	}
+}
+
+// isRetryable - сетевые ошибки и временные ответы получателя повторяются
+func isRetryable(err error) bool {
+	if err == nil || errors.Is(err, model.ErrInvalidTarget) {
+		return false
+	}
+
+	var respErr *model.WebhookResponseError
+	if errors.As(err, &respErr) {
+		return respErr.Retryable()
+	}
+	return true
+}
+
+func newAttempt(err error) model.WebhookAttempt {
+	if err == nil {
+		return model.WebhookAttempt{Status: model.WebhookDeliveryStatusDELIVERED}
+	}
+
+	attempt := model.WebhookAttempt{Status: model.WebhookDeliveryStatusPENDING}
+	var respErr *model.WebhookResponseError
+	if errors.As(err, &respErr) {
+		attempt.StatusCode = &respErr.StatusCode
+	}
+
+	msg := err.Error()
+	attempt.Error = &msg
+	return attempt
+}
@@ -0,0 +1,128 @@
+package webhook_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/crafty-ezhik/rocket-factory/notification/internal/model"
+)
+
+const target = "https://integrator.example.com/hooks"
+
+func (s *ServiceSuite) TestDeliver() {
+	event := model.OrderPaidEvent{
+		EventUUID:       uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+		OrderUUID:       uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+		UserUUID:        uuid.MustParse("00000000-0000-0000-0000-000000000003"),
+		PaymentMethod:   "CARD",
+		TransactionUUID: uuid.MustParse("00000000-0000-0000-0000-000000000004"),
+	}
+	deliveryUUID := uuid.MustParse("00000000-0000-0000-0000-000000000005")
+
+	unavailable := &model.WebhookResponseError{StatusCode: http.StatusServiceUnavailable}
+	badRequest := &model.WebhookResponseError{StatusCode: http.StatusBadRequest}
+
+	tests := []struct {
+		name             string
+		existingStatus   model.WebhookDeliveryStatus
+		sendErrs         []error
+		expectedStatuses []model.WebhookDeliveryStatus
+		expectedErr      error
+		expectErr        bool
+	}{
+		{
+			name:             "delivered on first attempt",
+			sendErrs:         []error{nil},
+			expectedStatuses: []model.WebhookDeliveryStatus{model.WebhookDeliveryStatusDELIVERED},
+		},
+		{
+			name:     "delivered after temporary error",
+			sendErrs: []error{unavailable, nil},
+			expectedStatuses: []model.WebhookDeliveryStatus{
+				model.WebhookDeliveryStatusPENDING,
+				model.WebhookDeliveryStatusDELIVERED,
+			},
+		},
+		{
+			name:     "attempts exhausted",
+			sendErrs: []error{unavailable, errors.New("connection refused"), unavailable},
+			expectedStatuses: []model.WebhookDeliveryStatus{
+				model.WebhookDeliveryStatusPENDING,
+				model.WebhookDeliveryStatusPENDING,
+				model.WebhookDeliveryStatusFAILED,
+			},
+			expectErr: true,
+		},
+		{
+			name:             "rejected by receiver",
+			sendErrs:         []error{badRequest},
+			expectedStatuses: []model.WebhookDeliveryStatus{model.WebhookDeliveryStatusFAILED},
+		},
+		{
+			name:             "invalid target",
+			sendErrs:         []error{model.ErrInvalidTarget},
+			expectedStatuses: []model.WebhookDeliveryStatus{model.WebhookDeliveryStatusFAILED},
+			expectedErr:      model.ErrInvalidTarget,
+		},
+		{
+			name:           "already delivered",
+			existingStatus: model.WebhookDeliveryStatusDELIVERED,
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			s.SetupTest()
+
+			status := tt.existingStatus
+			if status == "" {
+				status = model.WebhookDeliveryStatusPENDING
+			}
+
+			var payload []byte
+			s.repo.On("Create", s.ctx, mock.MatchedBy(func(d model.WebhookDelivery) bool {
+				payload = d.Payload
+				return d.EventUUID == event.EventUUID &&
+					d.UserUUID == event.UserUUID &&
+					d.EventType == model.EventOrderPaid &&
+					d.Target == target
+			})).Return(func(_ context.Context, d model.WebhookDelivery) (model.WebhookDelivery, error) {
+				d.UUID = deliveryUUID
+				d.Status = status
+				return d, nil
+			}).Once()
+
+			for _, sendErr := range tt.sendErrs {
+				s.client.On("Send", s.ctx, target, mock.MatchedBy(func(req model.WebhookRequest) bool {
+					return req.DeliveryUUID == deliveryUUID && string(req.Body) == string(payload)
+				})).Return(sendErr).Once()
+			}
+
+			for _, expected := range tt.expectedStatuses {
+				s.repo.On("SaveAttempt", mock.Anything, deliveryUUID, mock.MatchedBy(func(a model.WebhookAttempt) bool {
+					return a.Status == expected
+				})).Return(nil).Once()
+			}
+
+			err := s.service.SendOrderPaidNotification(s.ctx, model.Recipient{}, target, event)
+			switch {
+			case tt.expectedErr != nil:
+				s.ErrorIs(err, tt.expectedErr)
+			case tt.expectErr:
+				s.Error(err)
+			default:
+				s.NoError(err)
+			}
+
+			var body map[string]any
+			s.Require().NoError(json.Unmarshal(payload, &body))
+			s.Equal("order.paid", body["event_type"])
+			s.Equal(event.EventUUID.String(), body["event_uuid"])
+		})
+	}
+}
@@ -0,0 +1,114 @@
+package webhook
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/crafty-ezhik/rocket-factory/notification/internal/model"
+)
+
+// payload - тело webhook-запроса. Поле data зависит от event_type
+type payload struct {
+	EventUUID uuid.UUID       `json:"event_uuid"`
+	EventType model.EventType `json:"event_type"`
+	UserUUID  uuid.UUID       `json:"user_uuid"`
+	Data      any             `json:"data"`
+}
+
+type orderPaidData struct {
+	OrderUUID       string `json:"order_uuid"`
+	PaymentMethod   string `json:"payment_method"`
+	TransactionUUID string `json:"transaction_uuid"`
+	// PaidAt - время в UTC, RFC 3339. Не передается, если неизвестно
+	PaidAt *time.Time `json:"paid_at,omitempty"`
+}
+
+type orderAssembledData struct {
+	OrderUUID    string     `json:"order_uuid"`
+	BuildTimeSec int        `json:"build_time_sec"`
+	AssembledAt  *time.Time `json:"assembled_at,omitempty"`
+}
+
+type orderRefundedData struct {
+	OrderUUID       string `json:"order_uuid"`
+	TransactionUUID string `json:"transaction_uuid"`
+	// RefundedAmount - сумма строкой, чтобы не терять точность на стороне получателя
+	RefundedAmount string     `json:"refunded_amount"`
+	RefundedAt     *time.Time `json:"refunded_at,omitempty"`
+}
+
+type orderAssemblyFailedData struct {
+	OrderUUID string `json:"order_uuid"`
+	Stage     string `json:"stage"`
+	Reason    string `json:"reason"`
+	// Attempt - номер попытки сборки, начиная с 1
+	Attempt  int        `json:"attempt"`
+	FailedAt *time.Time `json:"failed_at,omitempty"`
+}
+
+func orderPaidPayload(event model.OrderPaidEvent) payload {
+	return payload{
+		EventUUID: event.EventUUID,
+		EventType: model.EventOrderPaid,
+		UserUUID:  event.UserUUID,
+		Data: orderPaidData{
+			OrderUUID:       event.OrderUUID.String(),
+			PaymentMethod:   event.PaymentMethod,
+			TransactionUUID: event.TransactionUUID.String(),
+			PaidAt:          utcTime(event.PaidAt),
+		},
+	}
+}
+
+func orderAssembledPayload(event model.OrderAssembledEvent) payload {
+	return payload{
+		EventUUID: event.EventUUID,
+		EventType: model.EventOrderAssembled,
+		UserUUID:  event.UserUUID,
+		Data: orderAssembledData{
+			OrderUUID:    event.OrderUUID.String(),
+			BuildTimeSec: event.BuildTimeSec,
+			AssembledAt:  utcTime(event.AssembledAt),
+		},
+	}
+}
+
+func orderRefundedPayload(event model.OrderRefundedEvent) payload {
+	return payload{
+		EventUUID: event.EventUUID,
+		EventType: model.EventOrderRefunded,
+		UserUUID:  event.UserUUID,
+		Data: orderRefundedData{
+			OrderUUID:       event.OrderUUID.String(),
+			TransactionUUID: event.TransactionUUID.String(),
+			RefundedAmount:  strconv.FormatFloat(event.RefundedAmount, 'f', 2, 64),
+			RefundedAt:      utcTime(event.RefundedAt),
+		},
+	}
+}
+
+func orderAssemblyFailedPayload(event model.OrderAssemblyFailedEvent) payload {
+	return payload{
+		EventUUID: event.EventUUID,
+		EventType: model.EventOrderAssemblyFailed,
+		UserUUID:  event.UserUUID,
+		Data: orderAssemblyFailedData{
+			OrderUUID: event.OrderUUID.String(),
+			Stage:     event.Stage,
+			Reason:    event.Reason,
+			Attempt:   event.Attempt,
+			FailedAt:  utcTime(event.FailedAt),
+		},
+	}
+}
+
+// utcTime - время события для тела webhook. Часовой пояс получателя здесь не важен, поэтому всегда UTC
+func utcTime(t time.Time) *time.Time {
+	if t.IsZero() {
+		return nil
+	}
+	t = t.UTC()
+	return &t
+}
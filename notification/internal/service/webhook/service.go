@@ -0,0 +1,51 @@
+package webhook
+
+import (
+	"context"
+	"time"
+
+	"github.com/crafty-ezhik/rocket-factory/notification/internal/client/http"
+	"github.com/crafty-ezhik/rocket-factory/notification/internal/model"
+	"github.com/crafty-ezhik/rocket-factory/notification/internal/repository"
+	def "github.com/crafty-ezhik/rocket-factory/notification/internal/service"
+)
+
+var _ def.ChannelService = (*service)(nil)
+
+// RetryPolicy - повторная доставка внутри одной обработки события.
+// Пауза удваивается после каждой неудачной попытки, но не превышает MaxBackoff
+type RetryPolicy struct {
+	Attempts   int
+	Backoff    time.Duration
+	MaxBackoff time.Duration
+}
+
+type service struct {
+	client       http.WebhookClient
+	deliveryRepo repository.WebhookDeliveryRepository
+	retry        RetryPolicy
+}
+
+func NewService(client http.WebhookClient, deliveryRepo repository.WebhookDeliveryRepository, retry RetryPolicy) *service {
+	return &service{
+		client:       client,
+		deliveryRepo: deliveryRepo,
+		retry:        retry,
+	}
+}
+
+func (s *service) SendOrderPaidNotification(ctx context.Context, _ model.Recipient, target string, msg model.OrderPaidEvent) error {
+	return s.deliver(ctx, target, orderPaidPayload(msg))
+}
+
+func (s *service) SendOrderAssembledNotification(ctx context.Context, _ model.Recipient, target string, msg model.OrderAssembledEvent) error {
+	return s.deliver(ctx, target, orderAssembledPayload(msg))
+}
+
+func (s *service) SendOrderRefundedNotification(ctx context.Context, _ model.Recipient, target string, msg model.OrderRefundedEvent) error {
+	return s.deliver(ctx, target, orderRefundedPayload(msg))
+}
+
+func (s *service) SendOrderAssemblyFailedNotification(ctx context.Context, _ model.Recipient, target string, msg model.OrderAssemblyFailedEvent) error {
+	return s.deliver(ctx, target, orderAssemblyFailedPayload(msg))
+}
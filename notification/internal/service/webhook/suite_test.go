@@ -0,0 +1,45 @@
+package webhook_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	clientMocks "github.com/crafty-ezhik/rocket-factory/notification/internal/client/http/mocks"
+	repoMocks "github.com/crafty-ezhik/rocket-factory/notification/internal/repository/mocks"
+	"github.com/crafty-ezhik/rocket-factory/notification/internal/service"
+	"github.com/crafty-ezhik/rocket-factory/notification/internal/service/webhook"
+	"github.com/crafty-ezhik/rocket-factory/platform/pkg/logger"
+)
+
+const maxAttempts = 3
+
+type ServiceSuite struct {
+	suite.Suite
+
+	ctx context.Context //nolint:containedctx
+
+	client  *clientMocks.MockWebhookClient
+	repo    *repoMocks.MockWebhookDeliveryRepository
+	service service.ChannelService
+}
+
+func (s *ServiceSuite) SetupTest() {
+	s.ctx = context.Background()
+
+	logger.SetNopLogger()
+
+	s.client = clientMocks.NewMockWebhookClient(s.T())
+	s.repo = repoMocks.NewMockWebhookDeliveryRepository(s.T())
+	s.service = webhook.NewService(s.client, s.repo, webhook.RetryPolicy{
+		Attempts:   maxAttempts,
+		Backoff:    time.Millisecond,
+		MaxBackoff: 2 * time.Millisecond,
+	})
+}
+
+func TestServiceIntegration(t *testing.T) {
+	suite.Run(t, new(ServiceSuite))
+}
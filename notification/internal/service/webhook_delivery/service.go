@@ -0,0 +1,38 @@
+package webhook_delivery
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	webhookClient "github.com/crafty-ezhik/rocket-factory/notification/internal/client/http/webhook"
+	"github.com/crafty-ezhik/rocket-factory/notification/internal/model"
+	"github.com/crafty-ezhik/rocket-factory/notification/internal/repository"
+	def "github.com/crafty-ezhik/rocket-factory/notification/internal/service"
+)
+
+var _ def.WebhookDeliveryService = (*service)(nil)
+
+type service struct {
+	deliveryRepo repository.WebhookDeliveryRepository
+	signingKey   []byte
+}
+
+func NewService(deliveryRepo repository.WebhookDeliveryRepository, signingKey string) *service {
+	return &service{
+		deliveryRepo: deliveryRepo,
+		signingKey:   []byte(signingKey),
+	}
+}
+
+func (s *service) Get(ctx context.Context, deliveryUUID uuid.UUID) (model.WebhookDelivery, error) {
+	return s.deliveryRepo.Get(ctx, deliveryUUID)
+}
+
+func (s *service) List(ctx context.Context, filter model.WebhookDeliveryFilter) ([]model.WebhookDelivery, error) {
+	return s.deliveryRepo.List(ctx, filter)
+}
+
+func (s *service) SigningSecret(userUUID uuid.UUID, target string) string {
+	return webhookClient.MethodSecret(s.signingKey, userUUID, target)
+}
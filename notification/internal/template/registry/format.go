@@ -0,0 +1,75 @@
+package registry
+
+import (
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/crafty-ezhik/rocket-factory/notification/internal/model"
+)
+
+// localeFormat - форматы дат и сумм для языка. Разряды в русском разделяются неразрывным пробелом
+type localeFormat struct {
+	dateLayout string
+	decimalSep string
+	groupSep   string
+}
+
+var localeFormats = map[string]localeFormat{
+	model.LocaleRU: {dateLayout: "02.01.2006 15:04 MST", decimalSep: ",", groupSep: "\u00a0"},
+	model.LocaleEN: {dateLayout: "Jan 2, 2006 3:04 PM MST", decimalSep: ".", groupSep: ","},
+}
+
+// view - данные шаблона: событие или данные ответа бота и форматирование под язык и часовой пояс получателя.
+// В шаблоне данные доступны через .Event, а форматирование через {{.Date ...}} и {{.Money ...}}
+type view struct {
+	Event any
+
+	format   localeFormat
+	location *time.Location
+}
+
+func newView(locale string, location *time.Location, data any) view {
+	format, ok := localeFormats[locale]
+	if !ok {
+		format = localeFormats[model.DefaultLocale]
+	}
+	if location == nil {
+		location = time.UTC
+	}
+
+	return view{
+		Event:    data,
+		format:   format,
+		location: location,
+	}
+}
+
+// Date - время в часовом поясе получателя. Для нулевого времени возвращается пустая строка
+func (v view) Date(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.In(v.location).Format(v.format.dateLayout)
+}
+
+// Money - сумма с двумя знаками после запятой и разделителями разрядов языка
+func (v view) Money(amount float64) string {
+	str := strconv.FormatFloat(math.Abs(amount), 'f', 2, 64)
+	intPart, fracPart, _ := strings.Cut(str, ".")
+
+	var b strings.Builder
+	if amount < 0 {
+		b.WriteByte('-')
+	}
+	for i, r := range intPart {
+		if i > 0 && (len(intPart)-i)%3 == 0 {
+			b.WriteString(v.format.groupSep)
+		}
+		b.WriteRune(r)
+	}
+	b.WriteString(v.format.decimalSep)
+	b.WriteString(fracPart)
+	return b.String()
+}
@@ -0,0 +1,256 @@
+package registry
+
+import (
+	"bytes"
+	"embed"
+	"errors"
+	"fmt"
+	htmlTemplate "html/template"
+	"io"
+	"io/fs"
+	"path"
+	"strings"
+	textTemplate "text/template"
+
+	"github.com/crafty-ezhik/rocket-factory/notification/internal/model"
+	def "github.com/crafty-ezhik/rocket-factory/notification/internal/template"
+)
+
+var _ def.Renderer = (*registry)(nil)
+
+// templatesFS - шаблоны в виде templates/<канал>/<язык>/<событие>.<часть>.tmpl,
+// например templates/email/en/order_paid.html.tmpl. Событие order.paid хранится в файле order_paid.
+// Ответы бота на команды - блоки {{define "<ответ>"}} в templates/telegram_bot/<язык>/replies.html.tmpl
+//
+//go:embed templates
+var templatesFS embed.FS
+
+// Части уведомления. Нужен текст или HTML: email использует тему, текст и HTML, Telegram - только HTML.
+// HTML разбирается через html/template, поэтому значения из событий экранируются автоматически
+const (
+	partSubject = "subject"
+	partText    = "txt"
+	partHTML    = "html"
+)
+
+var (
+	channels = []string{model.ProviderTelegram, model.ProviderEmail}
+	events   = []model.EventType{
+		model.EventOrderPaid,
+		model.EventOrderAssembled,
+		model.EventOrderRefunded,
+		model.EventOrderAssemblyFailed,
+	}
+)
+
+type key struct {
+	channel string
+	event   model.EventType
+	locale  string
+}
+
+// templateSet - шаблоны одного уведомления на одном языке
+type templateSet struct {
+	subject *textTemplate.Template
+	text    *textTemplate.Template
+	html    *htmlTemplate.Template
+}
+
+// repliesDir - каталог шаблонов ответов Telegram-бота
+const repliesDir = "telegram_bot"
+
+type registry struct {
+	sets map[key]templateSet
+	// replies - ответы бота по языкам
+	replies map[string]*htmlTemplate.Template
+}
+
+// New - разбирает встроенные шаблоны. Для каждого канала и события обязателен шаблон на языке по умолчанию
+func New() (*registry, error) {
+	r := &registry{
+		sets:    make(map[key]templateSet),
+		replies: make(map[string]*htmlTemplate.Template),
+	}
+
+	for _, channel := range channels {
+		locales, err := fs.ReadDir(templatesFS, path.Join("templates", channel))
+		if err != nil {
+			return nil, fmt.Errorf("read %s templates: %w", channel, err)
+		}
+
+		for _, locale := range locales {
+			if !locale.IsDir() {
+				continue
+			}
+			for _, event := range events {
+				if err = r.load(channel, locale.Name(), event); err != nil {
+					return nil, err
+				}
+			}
+		}
+
+		for _, event := range events {
+			if _, ok := r.sets[key{channel: channel, event: event, locale: model.DefaultLocale}]; !ok {
+				return nil, fmt.Errorf("no %s template for %s in default locale %s", channel, event, model.DefaultLocale)
+			}
+		}
+	}
+
+	if err := r.loadReplies(); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// loadReplies - разбирает ответы бота. На языке по умолчанию обязателен каждый ответ из model.BotReplies
+func (r *registry) loadReplies() error {
+	locales, err := fs.ReadDir(templatesFS, path.Join("templates", repliesDir))
+	if err != nil {
+		return fmt.Errorf("read bot reply templates: %w", err)
+	}
+
+	for _, locale := range locales {
+		if !locale.IsDir() {
+			continue
+		}
+		tmpl, err := htmlTemplate.ParseFS(templatesFS, path.Join("templates", repliesDir, locale.Name(), "replies.html.tmpl"))
+		if err != nil {
+			return err
+		}
+		r.replies[locale.Name()] = tmpl
+	}
+
+	defaultReplies, ok := r.replies[model.DefaultLocale]
+	if !ok {
+		return fmt.Errorf("no bot reply templates in default locale %s", model.DefaultLocale)
+	}
+	for _, reply := range model.BotReplies {
+		if defaultReplies.Lookup(reply.String()) == nil {
+			return fmt.Errorf("no bot reply template for %s in default locale %s", reply, model.DefaultLocale)
+		}
+	}
+	return nil
+}
+
+// load - разбирает шаблоны события на одном языке. Отсутствие шаблона на языке не ошибка,
+// его заменит язык по умолчанию
+func (r *registry) load(channel, locale string, event model.EventType) error {
+	base := path.Join("templates", channel, locale, strings.ReplaceAll(event.String(), ".", "_"))
+	file := func(part string) string { return base + "." + part + ".tmpl" }
+
+	if !exists(file(partText)) && !exists(file(partHTML)) {
+		return nil
+	}
+
+	var (
+		set templateSet
+		err error
+	)
+	if exists(file(partText)) {
+		if set.text, err = textTemplate.ParseFS(templatesFS, file(partText)); err != nil {
+			return err
+		}
+	}
+	if exists(file(partSubject)) {
+		if set.subject, err = textTemplate.ParseFS(templatesFS, file(partSubject)); err != nil {
+			return err
+		}
+	}
+	if exists(file(partHTML)) {
+		if set.html, err = htmlTemplate.ParseFS(templatesFS, file(partHTML)); err != nil {
+			return err
+		}
+	}
+
+	r.sets[key{channel: channel, event: event, locale: locale}] = set
+	return nil
+}
+
+func exists(name string) bool {
+	_, err := fs.Stat(templatesFS, name)
+	return !errors.Is(err, fs.ErrNotExist)
+}
+
+// Render - формирует уведомление на языке получателя.
+// Язык ищется так: точное совпадение ("pt-br"), затем основной язык ("en" для "en-US"), затем язык по умолчанию
+func (r *registry) Render(channel string, event model.EventType, recipient model.Recipient, data any) (model.Message, error) {
+	locale, set, ok := r.lookup(channel, event, recipient.Locale)
+	if !ok {
+		return model.Message{}, fmt.Errorf("no %s template for %s", channel, event)
+	}
+
+	v := newView(locale, recipient.Location, data)
+
+	var msg model.Message
+	var err error
+	if set.text != nil {
+		if msg.Text, err = execute(set.text, v); err != nil {
+			return model.Message{}, err
+		}
+	}
+	if set.subject != nil {
+		if msg.Subject, err = execute(set.subject, v); err != nil {
+			return model.Message{}, err
+		}
+		msg.Subject = strings.TrimSpace(msg.Subject)
+	}
+	if set.html != nil {
+		if msg.HTML, err = execute(set.html, v); err != nil {
+			return model.Message{}, err
+		}
+	}
+	return msg, nil
+}
+
+// RenderReply - формирует ответ бота. Язык ищется так же, как в Render
+func (r *registry) RenderReply(reply model.BotReply, recipient model.Recipient, data any) (string, error) {
+	for _, locale := range localeCandidates(recipient.Locale) {
+		tmpl, ok := r.replies[locale]
+		if !ok || tmpl.Lookup(reply.String()) == nil {
+			continue
+		}
+
+		var buf bytes.Buffer
+		if err := tmpl.ExecuteTemplate(&buf, reply.String(), newView(locale, recipient.Location, data)); err != nil {
+			return "", err
+		}
+		return strings.TrimSpace(buf.String()), nil
+	}
+	return "", fmt.Errorf("no bot reply template for %s", reply)
+}
+
+func (r *registry) lookup(channel string, event model.EventType, locale string) (string, templateSet, bool) {
+	for _, candidate := range localeCandidates(locale) {
+		if set, ok := r.sets[key{channel: channel, event: event, locale: candidate}]; ok {
+			return candidate, set, true
+		}
+	}
+	return "", templateSet{}, false
+}
+
+// localeCandidates - языки в порядке поиска шаблона
+func localeCandidates(locale string) []string {
+	locale = strings.ReplaceAll(strings.ToLower(strings.TrimSpace(locale)), "_", "-")
+	if locale == "" {
+		return []string{model.DefaultLocale}
+	}
+
+	candidates := []string{locale}
+	if base, _, ok := strings.Cut(locale, "-"); ok && base != "" {
+		candidates = append(candidates, base)
+	}
+	return append(candidates, model.DefaultLocale)
+}
+
+// executor - общий метод text/template и html/template
+type executor interface {
+	Execute(w io.Writer, data any) error
+}
+
+func execute(tmpl executor, data any) (string, error) {
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, data); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
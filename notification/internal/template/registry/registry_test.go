@@ -0,0 +1,221 @@
+package registry_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/crafty-ezhik/rocket-factory/notification/internal/model"
+	"github.com/crafty-ezhik/rocket-factory/notification/internal/template"
+	"github.com/crafty-ezhik/rocket-factory/notification/internal/template/registry"
+)
+
+type RegistrySuite struct {
+	suite.Suite
+
+	registry template.Renderer
+	moscow   *time.Location
+}
+
+func (s *RegistrySuite) SetupTest() {
+	r, err := registry.New()
+	s.Require().NoError(err)
+	s.registry = r
+
+	s.moscow, err = time.LoadLocation("Europe/Moscow")
+	s.Require().NoError(err)
+}
+
+func TestRegistry(t *testing.T) {
+	suite.Run(t, new(RegistrySuite))
+}
+
+func paidEvent() model.OrderPaidEvent {
+	return model.OrderPaidEvent{
+		EventUUID:       uuid.New(),
+		OrderUUID:       uuid.New(),
+		UserUUID:        uuid.New(),
+		PaymentMethod:   "CARD",
+		TransactionUUID: uuid.New(),
+		PaidAt:          time.Date(2026, 3, 1, 9, 30, 0, 0, time.UTC),
+	}
+}
+
+func (s *RegistrySuite) TestLocaleFallback() {
+	event := paidEvent()
+
+	tests := []struct {
+		name     string
+		locale   string
+		contains string
+	}{
+		{name: "exact locale", locale: "en", contains: "Payment received"},
+		{name: "region falls back to language", locale: "en-US", contains: "Payment received"},
+		{name: "underscore region", locale: "EN_gb", contains: "Payment received"},
+		{name: "unknown locale falls back to default", locale: "de", contains: "Входящий платёж"},
+		{name: "empty locale falls back to default", locale: "", contains: "Входящий платёж"},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			msg, err := s.registry.Render(model.ProviderTelegram, model.EventOrderPaid, model.Recipient{Locale: tt.locale}, event)
+			s.Require().NoError(err)
+			s.Contains(msg.HTML, tt.contains)
+			s.Contains(msg.HTML, event.OrderUUID.String())
+		})
+	}
+}
+
+func (s *RegistrySuite) TestDateInRecipientTimezone() {
+	event := paidEvent()
+
+	msg, err := s.registry.Render(model.ProviderTelegram, model.EventOrderPaid, model.Recipient{Locale: model.LocaleRU, Location: s.moscow}, event)
+	s.Require().NoError(err)
+	s.Contains(msg.HTML, "01.03.2026 12:30 MSK")
+
+	msg, err = s.registry.Render(model.ProviderTelegram, model.EventOrderPaid, model.Recipient{Locale: model.LocaleEN}, event)
+	s.Require().NoError(err)
+	s.Contains(msg.HTML, "Mar 1, 2026 9:30 AM UTC")
+}
+
+func (s *RegistrySuite) TestZeroTimeOmitted() {
+	event := paidEvent()
+	event.PaidAt = time.Time{}
+
+	msg, err := s.registry.Render(model.ProviderEmail, model.EventOrderPaid, model.Recipient{Locale: model.LocaleRU}, event)
+	s.Require().NoError(err)
+	s.NotContains(msg.Text, "Дата оплаты")
+	s.NotContains(msg.HTML, "Дата оплаты")
+}
+
+func (s *RegistrySuite) TestEmailParts() {
+	event := model.OrderRefundedEvent{
+		OrderUUID:       uuid.New(),
+		TransactionUUID: uuid.New(),
+		RefundedAmount:  1234567.5,
+		RefundedAt:      time.Date(2026, 3, 1, 9, 30, 0, 0, time.UTC),
+	}
+
+	msg, err := s.registry.Render(model.ProviderEmail, model.EventOrderRefunded, model.Recipient{Locale: model.LocaleEN}, event)
+	s.Require().NoError(err)
+	s.Equal("Order refunded", msg.Subject)
+	s.Contains(msg.Text, "Refunded amount: 1,234,567.50")
+	s.Contains(msg.HTML, `<html lang="en">`)
+
+	msg, err = s.registry.Render(model.ProviderEmail, model.EventOrderRefunded, model.Recipient{Locale: model.LocaleRU}, event)
+	s.Require().NoError(err)
+	s.Equal("Средства по заказу возвращены", msg.Subject)
+	s.Contains(msg.Text, "Сумма возврата: 1\u00a0234\u00a0567,50")
+}
+
+func (s *RegistrySuite) TestAllTemplatesRender() {
+	events := map[model.EventType]any{
+		model.EventOrderPaid:      paidEvent(),
+		model.EventOrderAssembled: model.OrderAssembledEvent{OrderUUID: uuid.New(), BuildTimeSec: 10, AssembledAt: time.Now()},
+		model.EventOrderRefunded:  model.OrderRefundedEvent{OrderUUID: uuid.New(), RefundedAmount: 10, RefundedAt: time.Now()},
+		model.EventOrderAssemblyFailed: model.OrderAssemblyFailedEvent{
+			OrderUUID: uuid.New(),
+			Stage:     "ENGINE",
+			Reason:    "PART_DEFECT",
+			Attempt:   1,
+			FailedAt:  time.Now(),
+		},
+	}
+
+	for _, channel := range []string{model.ProviderTelegram, model.ProviderEmail} {
+		for _, locale := range []string{model.LocaleRU, model.LocaleEN} {
+			for event, data := range events {
+				msg, err := s.registry.Render(channel, event, model.Recipient{Locale: locale}, data)
+				s.Require().NoError(err, "%s/%s/%s", channel, locale, event)
+				s.NotEmpty(msg.HTML)
+				if channel == model.ProviderEmail {
+					s.NotEmpty(msg.Subject)
+					s.NotEmpty(msg.Text)
+				}
+			}
+		}
+	}
+}
+
+func (s *RegistrySuite) TestAssemblyFailedReason() {
+	event := model.OrderAssemblyFailedEvent{OrderUUID: uuid.New(), Stage: "ENGINE", Reason: "PART_DEFECT"}
+
+	msg, err := s.registry.Render(model.ProviderTelegram, model.EventOrderAssemblyFailed, model.Recipient{Locale: model.LocaleRU}, event)
+	s.Require().NoError(err)
+	s.Contains(msg.HTML, "<b>Причина:</b> брак детали")
+	s.NotContains(msg.HTML, "Дата")
+
+	event.Reason = "<UNKNOWN>"
+	msg, err = s.registry.Render(model.ProviderEmail, model.EventOrderAssemblyFailed, model.Recipient{Locale: model.LocaleEN}, event)
+	s.Require().NoError(err)
+	s.Contains(msg.Text, "Reason: <UNKNOWN>")
+	s.Contains(msg.HTML, "&lt;UNKNOWN&gt;")
+}
+
+func (s *RegistrySuite) TestTelegramEscapesValues() {
+	event := paidEvent()
+	event.PaymentMethod = "<i>CARD_*</i> & co"
+
+	msg, err := s.registry.Render(model.ProviderTelegram, model.EventOrderPaid, model.Recipient{Locale: model.LocaleEN}, event)
+	s.Require().NoError(err)
+	s.Empty(msg.Text)
+	s.Contains(msg.HTML, "<b>Payment method:</b> &lt;i&gt;CARD_*&lt;/i&gt; &amp; co")
+}
+
+func (s *RegistrySuite) TestUnknownChannel() {
+	_, err := s.registry.Render(model.ProviderWebhook, model.EventOrderPaid, model.Recipient{}, paidEvent())
+	s.Error(err)
+}
+
+func (s *RegistrySuite) TestAllRepliesRender() {
+	data := map[model.BotReply]any{
+		model.BotReplyLinked: true,
+		model.BotReplyOrders: []model.Order{{UUID: uuid.New(), Status: "PAID", TotalPrice: 10, CreatedAt: time.Now()}},
+		model.BotReplyOrderStatus: model.Order{
+			UUID:          uuid.New(),
+			Status:        "ASSEMBLED",
+			TotalPrice:    10,
+			PaymentMethod: "CARD",
+			UpdatedAt:     time.Now(),
+		},
+	}
+
+	for _, locale := range []string{model.LocaleRU, model.LocaleEN} {
+		for _, reply := range model.BotReplies {
+			text, err := s.registry.RenderReply(reply, model.Recipient{Locale: locale}, data[reply])
+			s.Require().NoError(err, "%s/%s", locale, reply)
+			s.NotEmpty(text, "%s/%s", locale, reply)
+		}
+	}
+}
+
+func (s *RegistrySuite) TestReplyOrders() {
+	orderUUID := uuid.New()
+	orders := []model.Order{{
+		UUID:       orderUUID,
+		Status:     "PENDING_PAYMENT",
+		TotalPrice: 1234.5,
+		CreatedAt:  time.Date(2026, 3, 1, 9, 30, 0, 0, time.UTC),
+	}}
+
+	text, err := s.registry.RenderReply(model.BotReplyOrders, model.Recipient{Locale: model.LocaleRU, Location: s.moscow}, orders)
+	s.Require().NoError(err)
+	s.Contains(text, "<code>"+orderUUID.String()+"</code>")
+	s.Contains(text, "Ожидает оплаты · 💰 1\u00a0234,50 · 📅 01.03.2026 12:30 MSK")
+
+	text, err = s.registry.RenderReply(model.BotReplyOrders, model.Recipient{Locale: "en-US"}, []model.Order(nil))
+	s.Require().NoError(err)
+	s.Equal("You have no orders yet", text)
+}
+
+func (s *RegistrySuite) TestReplyUnknownStatusAndEscaping() {
+	order := model.Order{UUID: uuid.New(), Status: "ASSEMBLY_<FAILED>", PaymentMethod: "<b>CARD</b>"}
+
+	text, err := s.registry.RenderReply(model.BotReplyOrderStatus, model.Recipient{Locale: model.LocaleEN}, order)
+	s.Require().NoError(err)
+	s.Contains(text, "<b>Status:</b> ASSEMBLY_&lt;FAILED&gt;")
+	s.Contains(text, "<b>Payment method:</b> &lt;b&gt;CARD&lt;/b&gt;")
+	s.NotContains(text, "Updated at")
+}
@@ -0,0 +1,13 @@
+package template
+
+import (
+	"github.com/crafty-ezhik/rocket-factory/notification/internal/model"
+)
+
+// Renderer - формирует текст уведомления по типу события, каналу и языку получателя.
+// channel - имя провайдера канала, например model.ProviderTelegram
+type Renderer interface {
+	Render(channel string, event model.EventType, recipient model.Recipient, data any) (model.Message, error)
+	// RenderReply - формирует HTML-ответ Telegram-бота на команду на языке получателя
+	RenderReply(reply model.BotReply, recipient model.Recipient, data any) (string, error)
+}
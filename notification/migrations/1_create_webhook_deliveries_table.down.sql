@@ -0,0 +1,4 @@
+-- удаляем журнал доставки webhook-уведомлений
+DROP INDEX IF EXISTS idx_webhook_deliveries_user_uuid;
+DROP INDEX IF EXISTS idx_webhook_deliveries_created_at;
+DROP TABLE IF EXISTS webhook_deliveries;
@@ -0,0 +1,23 @@
+-- +goose Up
+
+-- создаем журнал доставки webhook-уведомлений. Одно событие доставляется на адрес не больше одного раза
+CREATE TABLE webhook_deliveries (
+    delivery_uuid UUID PRIMARY KEY,
+    event_uuid UUID NOT NULL,
+    event_type VARCHAR(50) NOT NULL,
+    user_uuid UUID NOT NULL,
+    target TEXT NOT NULL,
+    payload BYTEA NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'PENDING',
+    attempts INTEGER NOT NULL DEFAULT 0,
+    last_error TEXT,
+    last_status_code INTEGER,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now(),
+    delivered_at TIMESTAMP WITH TIME ZONE,
+    UNIQUE (event_uuid, target)
+);
+
+-- создаем индексы для выборки журнала в admin API
+CREATE INDEX IF NOT EXISTS idx_webhook_deliveries_created_at ON webhook_deliveries (created_at DESC, delivery_uuid DESC);
+CREATE INDEX IF NOT EXISTS idx_webhook_deliveries_user_uuid ON webhook_deliveries (user_uuid, created_at DESC);
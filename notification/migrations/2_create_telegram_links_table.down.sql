@@ -0,0 +1,2 @@
+-- удаляем привязки чатов Telegram к пользователям
+DROP TABLE IF EXISTS telegram_links;
@@ -0,0 +1,10 @@
+-- +goose Up
+
+-- создаем привязки чатов Telegram к пользователям. Команды бота выполняются с сохраненной сессией пользователя
+CREATE TABLE telegram_links (
+    chat_id BIGINT PRIMARY KEY,
+    user_uuid UUID NOT NULL,
+    session_uuid UUID NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now()
+);
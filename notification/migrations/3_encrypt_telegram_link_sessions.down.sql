@@ -0,0 +1,5 @@
+-- возвращаем открытую сессию, зашифрованные привязки восстановить нельзя
+DELETE FROM telegram_links;
+
+ALTER TABLE telegram_links DROP COLUMN session_ciphertext;
+ALTER TABLE telegram_links ADD COLUMN session_uuid UUID NOT NULL;
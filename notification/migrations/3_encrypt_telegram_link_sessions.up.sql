@@ -0,0 +1,8 @@
+-- +goose Up
+
+-- храним сессию привязанного чата только в зашифрованном виде. Открытые сессии зашифровать в SQL нельзя,
+-- поэтому существующие привязки удаляются и чаты нужно привязать заново
+DELETE FROM telegram_links;
+
+ALTER TABLE telegram_links DROP COLUMN session_uuid;
+ALTER TABLE telegram_links ADD COLUMN session_ciphertext BYTEA NOT NULL;
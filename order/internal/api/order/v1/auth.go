@@ -0,0 +1,62 @@
+package v1
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/crafty-ezhik/rocket-factory/order/internal/model"
+	HTTPMiddleware "github.com/crafty-ezhik/rocket-factory/platform/pkg/middleware/http"
+	commonV1 "github.com/crafty-ezhik/rocket-factory/shared/pkg/proto/common/v1"
+)
+
+const (
+	roleAdmin               = "admin"
+	permissionOrdersReadAll = "orders:read_all"
+)
+
+// sessionUser - возвращает пользователя, аутентифицированного AuthMiddleware, и его UUID
+func sessionUser(ctx context.Context) (*commonV1.User, uuid.UUID, bool) {
+	user, ok := HTTPMiddleware.GetUserFromContext(ctx)
+	if !ok || user == nil {
+		return nil, uuid.Nil, false
+	}
+
+	userUUID, err := uuid.Parse(user.GetUuid())
+	if err != nil {
+		return nil, uuid.Nil, false
+	}
+	return user, userUUID, true
+}
+
+func isAdmin(user *commonV1.User) bool {
+	return HTTPMiddleware.HasRole(user, roleAdmin)
+}
+
+// canReadAllOrders - просматривать чужие заказы могут роли с разрешением orders:read_all
+func canReadAllOrders(user *commonV1.User) bool {
+	return HTTPMiddleware.HasPermission(user, permissionOrdersReadAll)
+}
+
+// canReadOrder - заказ доступен для просмотра владельцу и ролям с разрешением orders:read_all
+func canReadOrder(user *commonV1.User, userUUID uuid.UUID, order model.Order) bool {
+	return order.UserUUID == userUUID || canReadAllOrders(user)
+}
+
+// canManageOrder - оплатить или отменить заказ может его владелец и администратор
+func canManageOrder(user *commonV1.User, userUUID uuid.UUID, order model.Order) bool {
+	return order.UserUUID == userUUID || isAdmin(user)
+}
+
+// checkOrderAccess - проверяет, что пользователь сессии может оплатить или отменить заказ
+func (a *api) checkOrderAccess(ctx context.Context, user *commonV1.User, userUUID, orderUUID uuid.UUID) error {
+	order, err := a.orderService.Get(ctx, orderUUID)
+	if err != nil {
+		return err
+	}
+
+	if !canManageOrder(user, userUUID, order) {
+		return model.ErrOrderAccessDenied
+	}
+	return nil
+}
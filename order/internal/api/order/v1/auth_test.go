@@ -0,0 +1,92 @@
+package v1
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/google/uuid"
+
+	"github.com/crafty-ezhik/rocket-factory/order/internal/converter"
+	"github.com/crafty-ezhik/rocket-factory/order/internal/model"
+	grpcAuth "github.com/crafty-ezhik/rocket-factory/platform/pkg/middleware/grpc"
+	orderV1 "github.com/crafty-ezhik/rocket-factory/shared/pkg/openapi/order/v1"
+	commonV1 "github.com/crafty-ezhik/rocket-factory/shared/pkg/proto/common/v1"
+)
+
+func (s *ApiSuite) TestOrderOwnership() {
+	orderUUID := uuid.MustParse("00000000-0000-0000-0000-000000000002")
+	otherUserUUID := uuid.MustParse("00000000-0000-0000-0000-000000000005")
+	foreignOrder := model.Order{UUID: orderUUID, UserUUID: otherUserUUID, Status: model.OrderStatusPENDINGPAYMENT}
+
+	forbidden := &orderV1.ForbiddenError{
+		Code:    http.StatusForbidden,
+		Message: model.ErrOrderAccessDenied.Error(),
+	}
+
+	s.Run("get foreign order", func() {
+		s.orderService.On("Get", s.ctx, orderUUID).Return(foreignOrder, nil).Once()
+
+		res, err := s.api.OrderGet(s.ctx, orderV1.OrderGetParams{OrderUUID: orderUUID.String()})
+
+		s.Require().NoError(err)
+		s.Require().Equal(forbidden, res)
+	})
+
+	s.Run("pay foreign order", func() {
+		s.orderService.On("Get", s.ctx, orderUUID).Return(foreignOrder, nil).Once()
+
+		res, err := s.api.OrderPay(s.ctx,
+			&orderV1.PayOrderRequest{PaymentMethod: orderV1.NilPaymentMethod{Value: orderV1.PaymentMethodCARD}},
+			orderV1.OrderPayParams{OrderUUID: orderUUID.String()},
+		)
+
+		s.Require().NoError(err)
+		s.Require().Equal(forbidden, res)
+	})
+
+	s.Run("cancel foreign order", func() {
+		s.orderService.On("Get", s.ctx, orderUUID).Return(foreignOrder, nil).Once()
+
+		res, err := s.api.OrderCancel(s.ctx, orderV1.OrderCancelParams{OrderUUID: orderUUID.String()})
+
+		s.Require().NoError(err)
+		s.Require().Equal(forbidden, res)
+	})
+
+	s.Run("admin gets foreign order", func() {
+		adminCtx := context.WithValue(s.ctx, grpcAuth.GetUserContextKey(), &commonV1.User{
+			Uuid:        uuid.NewString(),
+			Roles:       []string{"admin"},
+			Permissions: []string{"orders:read", "orders:write", "orders:read_all"},
+		})
+		s.orderService.On("Get", adminCtx, orderUUID).Return(foreignOrder, nil).Once()
+
+		res, err := s.api.OrderGet(adminCtx, orderV1.OrderGetParams{OrderUUID: orderUUID.String()})
+
+		s.Require().NoError(err)
+		s.Require().Equal(converter.OrderToHTTP(foreignOrder), res)
+	})
+
+	s.Run("create on behalf of another user", func() {
+		res, err := s.api.OrderCreate(s.ctx, &orderV1.CreateOrderRequest{
+			UserUUID:  orderV1.NewOptUUID(otherUserUUID),
+			PartUuids: []uuid.UUID{uuid.New()},
+		}, orderV1.OrderCreateParams{})
+
+		s.Require().NoError(err)
+		s.Require().Equal(&orderV1.ForbiddenError{
+			Code:    http.StatusForbidden,
+			Message: "cannot create an order on behalf of another user",
+		}, res)
+	})
+
+	s.Run("unauthenticated", func() {
+		res, err := s.api.OrderGet(context.Background(), orderV1.OrderGetParams{OrderUUID: orderUUID.String()})
+
+		s.Require().NoError(err)
+		s.Require().Equal(&orderV1.UnauthorizedError{
+			Code:    http.StatusUnauthorized,
+			Message: "authentication required",
+		}, res)
+	})
+}
@@ -12,6 +12,14 @@ import (
 )
 
 func (a *api) OrderCancel(ctx context.Context, req orderV1.OrderCancelParams) (orderV1.OrderCancelRes, error) {
+	user, userUUID, ok := sessionUser(ctx)
+	if !ok {
+		return &orderV1.UnauthorizedError{
+			Code:    http.StatusUnauthorized,
+			Message: "authentication required",
+		}, nil
+	}
+
 	orderUUID, err := uuid.Parse(req.OrderUUID)
 	if err != nil {
 		return &orderV1.BadRequestError{
@@ -20,7 +28,10 @@ func (a *api) OrderCancel(ctx context.Context, req orderV1.OrderCancelParams) (o
 		}, nil
 	}
 
-	err = a.orderService.Cancel(ctx, orderUUID)
+	err = a.checkOrderAccess(ctx, user, userUUID, orderUUID)
+	if err == nil {
+		err = a.orderService.Cancel(ctx, orderUUID)
+	}
 	if err != nil {
 		if errors.Is(err, model.ErrOrderNotFound) {
 			return &orderV1.NotFoundError{
@@ -29,6 +40,13 @@ func (a *api) OrderCancel(ctx context.Context, req orderV1.OrderCancelParams) (o
 			}, nil
 		}
 
+		if errors.Is(err, model.ErrOrderAccessDenied) {
+			return &orderV1.ForbiddenError{
+				Code:    http.StatusForbidden,
+				Message: err.Error(),
+			}, nil
+		}
+
 		if errors.Is(err, model.ErrOrderIsPaid) {
 			return &orderV1.ConflictError{
 				Code:    http.StatusConflict,
@@ -36,7 +54,7 @@ func (a *api) OrderCancel(ctx context.Context, req orderV1.OrderCancelParams) (o
 			}, nil
 		}
 
-		if errors.Is(err, model.ErrOrderIsCancel) {
+		if errors.Is(err, model.ErrOrderIsCancel) || errors.Is(err, model.ErrOrderStatusConflict) {
 			return &orderV1.ConflictError{
 				Code:    http.StatusConflict,
 				Message: err.Error(),
@@ -28,6 +28,8 @@ func (s *ApiSuite) TestOrderCancelSuccess() {
 			},
 			expectedRes: &orderV1.OrderCancelNoContent{},
 			setupMock: func() {
+				s.mockOwnedOrder(orderUUID)
+
 				s.orderService.On("Cancel", s.ctx, orderUUID).
 					Return(nil).
 					Once()
@@ -77,6 +79,8 @@ func (s *ApiSuite) TestOrderCancelFailure() {
 				Message: "order not found",
 			},
 			setupMock: func() {
+				s.mockOwnedOrder(orderUUID)
+
 				s.orderService.On("Cancel", s.ctx, orderUUID).
 					Return(model.ErrOrderNotFound).
 					Once()
@@ -92,6 +96,8 @@ func (s *ApiSuite) TestOrderCancelFailure() {
 				Message: "order has already been cancelled",
 			},
 			setupMock: func() {
+				s.mockOwnedOrder(orderUUID)
+
 				s.orderService.On("Cancel", s.ctx, orderUUID).
 					Return(model.ErrOrderIsCancel).
 					Once()
@@ -107,11 +113,30 @@ func (s *ApiSuite) TestOrderCancelFailure() {
 				Message: "order has already been paid for",
 			},
 			setupMock: func() {
+				s.mockOwnedOrder(orderUUID)
+
 				s.orderService.On("Cancel", s.ctx, orderUUID).
 					Return(model.ErrOrderIsPaid).
 					Once()
 			},
 		},
+		{
+			name: "order status changed concurrently",
+			param: orderV1.OrderCancelParams{
+				OrderUUID: orderUUID.String(),
+			},
+			expectedRes: &orderV1.ConflictError{
+				Code:    http.StatusConflict,
+				Message: "order status has been changed concurrently",
+			},
+			setupMock: func() {
+				s.mockOwnedOrder(orderUUID)
+
+				s.orderService.On("Cancel", s.ctx, orderUUID).
+					Return(model.ErrOrderStatusConflict).
+					Once()
+			},
+		},
 		{
 			name: "service timeout",
 			param: orderV1.OrderCancelParams{
@@ -122,6 +147,8 @@ func (s *ApiSuite) TestOrderCancelFailure() {
 				Message: "request timeout exceeded",
 			},
 			setupMock: func() {
+				s.mockOwnedOrder(orderUUID)
+
 				s.orderService.On("Cancel", s.ctx, orderUUID).
 					Return(context.DeadlineExceeded).
 					Once()
@@ -137,6 +164,8 @@ func (s *ApiSuite) TestOrderCancelFailure() {
 				Message: "request cancelled",
 			},
 			setupMock: func() {
+				s.mockOwnedOrder(orderUUID)
+
 				s.orderService.On("Cancel", s.ctx, orderUUID).
 					Return(context.Canceled).
 					Once()
@@ -152,6 +181,8 @@ func (s *ApiSuite) TestOrderCancelFailure() {
 				Message: "something went wrong",
 			},
 			setupMock: func() {
+				s.mockOwnedOrder(orderUUID)
+
 				s.orderService.On("Cancel", s.ctx, orderUUID).
 					Return(dbErr).
 					Once()
@@ -5,19 +5,40 @@ import (
 	"errors"
 	"net/http"
 
+	"github.com/crafty-ezhik/rocket-factory/order/internal/converter"
 	"github.com/crafty-ezhik/rocket-factory/order/internal/model"
 	orderV1 "github.com/crafty-ezhik/rocket-factory/shared/pkg/openapi/order/v1"
 )
 
 func (a *api) OrderCreate(ctx context.Context, req *orderV1.CreateOrderRequest, params orderV1.OrderCreateParams) (orderV1.OrderCreateRes, error) {
-	if err := req.Validate(); err != nil {
+	if err := req.Validate(); err != nil || (len(req.Items) == 0 && len(req.PartUuids) == 0) {
 		return &orderV1.BadRequestError{
 			Code:    http.StatusBadRequest,
 			Message: "Invalid Create Request",
 		}, nil
 	}
 
-	orderUUID, totalPrice, err := a.orderService.Create(ctx, req.UserUUID, req.PartUuids)
+	user, userUUID, ok := sessionUser(ctx)
+	if !ok {
+		return &orderV1.UnauthorizedError{
+			Code:    http.StatusUnauthorized,
+			Message: "authentication required",
+		}, nil
+	}
+
+	// Устаревший user_uuid из тела запроса допускается, только если совпадает с пользователем сессии.
+	// Создавать заказы от имени других пользователей может только администратор
+	if bodyUserUUID, set := req.UserUUID.Get(); set && bodyUserUUID != userUUID {
+		if !isAdmin(user) {
+			return &orderV1.ForbiddenError{
+				Code:    http.StatusForbidden,
+				Message: "cannot create an order on behalf of another user",
+			}, nil
+		}
+		userUUID = bodyUserUUID
+	}
+
+	orderUUID, totalPrice, err := a.orderService.Create(ctx, userUUID, converter.OrderItemsToServiceModel(req))
 	if err != nil {
 		if errors.Is(err, context.DeadlineExceeded) {
 			return &orderV1.RequestTimeoutError{
@@ -31,7 +52,15 @@ func (a *api) OrderCreate(ctx context.Context, req *orderV1.CreateOrderRequest,
 				Message: "request cancelled",
 			}, nil
 		}
-		if errors.Is(err, model.ErrOrderPartNotFound) {
+		if errors.Is(err, model.ErrOrderPartsOutOfStock) {
+			return &orderV1.ConflictError{
+				Code:    http.StatusConflict,
+				Message: err.Error(),
+			}, nil
+		}
+		if errors.Is(err, model.ErrOrderPartNotFound) ||
+			errors.Is(err, model.ErrOrderEmpty) ||
+			errors.Is(err, model.ErrOrderInvalidQuantity) {
 			return &orderV1.BadRequestError{
 				Code:    http.StatusBadRequest,
 				Message: err.Error(),
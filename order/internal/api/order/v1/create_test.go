@@ -3,19 +3,25 @@ package v1
 import (
 	"context"
 	"errors"
+	"fmt"
 	"net/http"
 
 	"github.com/google/uuid"
 
+	"github.com/crafty-ezhik/rocket-factory/order/internal/model"
 	orderV1 "github.com/crafty-ezhik/rocket-factory/shared/pkg/openapi/order/v1"
 )
 
 func (s *ApiSuite) TestCreateOrderSuccess() {
-	userUUID := uuid.MustParse("00000000-0000-0000-0000-000000000002")
+	userUUID := s.userUUID
 	partUUIDs := []uuid.UUID{
 		uuid.MustParse("00000000-0000-0000-0000-000000000001"),
 		uuid.MustParse("00000000-0000-0000-0000-000000000003"),
 	}
+	legacyItems := []model.OrderItem{
+		{PartUUID: partUUIDs[0], Quantity: 1},
+		{PartUUID: partUUIDs[1], Quantity: 1},
+	}
 
 	orderUUID := uuid.MustParse("00000000-0000-0000-0000-000000000006")
 	totalPrice := 100.99
@@ -28,20 +34,44 @@ func (s *ApiSuite) TestCreateOrderSuccess() {
 		setupMock   func()
 	}{
 		{
-			name: "success",
+			name: "success with items",
+			req: &orderV1.CreateOrderRequest{
+				UserUUID: orderV1.NewOptUUID(userUUID),
+				Items: []orderV1.OrderItemRequest{
+					{PartUUID: partUUIDs[0], Quantity: 4},
+					{PartUUID: partUUIDs[1], Quantity: 2},
+				},
+			},
+			params: orderV1.OrderCreateParams{
+				XSessionUUID: orderV1.NewOptUUID(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+			},
+			expectedRes: &orderV1.CreateOrderResponse{
+				OrderUUID:  orderUUID,
+				TotalPrice: totalPrice,
+			},
+			setupMock: func() {
+				s.orderService.On("Create", s.ctx, userUUID, []model.OrderItem{
+					{PartUUID: partUUIDs[0], Quantity: 4},
+					{PartUUID: partUUIDs[1], Quantity: 2},
+				}).
+					Return(orderUUID, totalPrice, nil).
+					Once()
+			},
+		},
+		{
+			name: "success with legacy part uuids",
 			req: &orderV1.CreateOrderRequest{
-				UserUUID:  userUUID,
 				PartUuids: partUUIDs,
 			},
 			params: orderV1.OrderCreateParams{
-				XSessionUUID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				XSessionUUID: orderV1.NewOptUUID(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
 			},
 			expectedRes: &orderV1.CreateOrderResponse{
 				OrderUUID:  orderUUID,
 				TotalPrice: totalPrice,
 			},
 			setupMock: func() {
-				s.orderService.On("Create", s.ctx, userUUID, partUUIDs).
+				s.orderService.On("Create", s.ctx, userUUID, legacyItems).
 					Return(orderUUID, totalPrice, nil).
 					Once()
 			},
@@ -61,12 +91,17 @@ func (s *ApiSuite) TestCreateOrderSuccess() {
 }
 
 func (s *ApiSuite) TestCreateOrderFailure() {
-	userUUID := uuid.MustParse("00000000-0000-0000-0000-000000000002")
+	userUUID := s.userUUID
 	partUUIDs := []uuid.UUID{
 		uuid.MustParse("00000000-0000-0000-0000-000000000001"),
 		uuid.MustParse("00000000-0000-0000-0000-000000000003"),
 	}
+	legacyItems := []model.OrderItem{
+		{PartUUID: partUUIDs[0], Quantity: 1},
+		{PartUUID: partUUIDs[1], Quantity: 1},
+	}
 	dbErr := errors.New("something went wrong")
+	outOfStockErr := fmt.Errorf("%w: %s (requested 2, available 1)", model.ErrOrderPartsOutOfStock, partUUIDs[0])
 
 	tests := []struct {
 		name        string
@@ -78,11 +113,10 @@ func (s *ApiSuite) TestCreateOrderFailure() {
 		{
 			name: "invalid request",
 			req: &orderV1.CreateOrderRequest{
-				UserUUID:  userUUID,
 				PartUuids: nil,
 			},
 			params: orderV1.OrderCreateParams{
-				XSessionUUID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				XSessionUUID: orderV1.NewOptUUID(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
 			},
 			expectedRes: &orderV1.BadRequestError{
 				Code:    http.StatusBadRequest,
@@ -93,18 +127,17 @@ func (s *ApiSuite) TestCreateOrderFailure() {
 		{
 			name: "service timeout",
 			req: &orderV1.CreateOrderRequest{
-				UserUUID:  userUUID,
 				PartUuids: partUUIDs,
 			},
 			params: orderV1.OrderCreateParams{
-				XSessionUUID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				XSessionUUID: orderV1.NewOptUUID(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
 			},
 			expectedRes: &orderV1.RequestTimeoutError{
 				Code:    http.StatusRequestTimeout,
 				Message: "request timeout exceeded",
 			},
 			setupMock: func() {
-				s.orderService.On("Create", s.ctx, userUUID, partUUIDs).
+				s.orderService.On("Create", s.ctx, userUUID, legacyItems).
 					Return(uuid.Nil, 0.00, context.DeadlineExceeded).
 					Once()
 			},
@@ -112,37 +145,53 @@ func (s *ApiSuite) TestCreateOrderFailure() {
 		{
 			name: "service canceled",
 			req: &orderV1.CreateOrderRequest{
-				UserUUID:  userUUID,
 				PartUuids: partUUIDs,
 			},
 			params: orderV1.OrderCreateParams{
-				XSessionUUID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				XSessionUUID: orderV1.NewOptUUID(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
 			},
 			expectedRes: &orderV1.BadRequestError{
 				Code:    http.StatusBadRequest,
 				Message: "request cancelled",
 			},
 			setupMock: func() {
-				s.orderService.On("Create", s.ctx, userUUID, partUUIDs).
+				s.orderService.On("Create", s.ctx, userUUID, legacyItems).
 					Return(uuid.Nil, 0.00, context.Canceled).
 					Once()
 			},
 		},
+		{
+			name: "parts out of stock",
+			req: &orderV1.CreateOrderRequest{
+				PartUuids: partUUIDs,
+			},
+			params: orderV1.OrderCreateParams{
+				XSessionUUID: orderV1.NewOptUUID(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+			},
+			expectedRes: &orderV1.ConflictError{
+				Code:    http.StatusConflict,
+				Message: outOfStockErr.Error(),
+			},
+			setupMock: func() {
+				s.orderService.On("Create", s.ctx, userUUID, legacyItems).
+					Return(uuid.Nil, 0.00, outOfStockErr).
+					Once()
+			},
+		},
 		{
 			name: "service internal error",
 			req: &orderV1.CreateOrderRequest{
-				UserUUID:  userUUID,
 				PartUuids: partUUIDs,
 			},
 			params: orderV1.OrderCreateParams{
-				XSessionUUID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				XSessionUUID: orderV1.NewOptUUID(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
 			},
 			expectedRes: &orderV1.InternalServerError{
 				Code:    http.StatusInternalServerError,
 				Message: "something went wrong",
 			},
 			setupMock: func() {
-				s.orderService.On("Create", s.ctx, userUUID, partUUIDs).
+				s.orderService.On("Create", s.ctx, userUUID, legacyItems).
 					Return(uuid.Nil, 0.00, dbErr).
 					Once()
 			},
@@ -14,6 +14,14 @@ import (
 )
 
 func (a *api) OrderGet(ctx context.Context, req orderV1.OrderGetParams) (orderV1.OrderGetRes, error) {
+	user, userUUID, ok := sessionUser(ctx)
+	if !ok {
+		return &orderV1.UnauthorizedError{
+			Code:    http.StatusUnauthorized,
+			Message: "authentication required",
+		}, nil
+	}
+
 	orderUUID, err := uuid.Parse(req.OrderUUID)
 	if err != nil {
 		return &orderV1.BadRequestError{
@@ -50,5 +58,12 @@ func (a *api) OrderGet(ctx context.Context, req orderV1.OrderGetParams) (orderV1
 		}, nil
 	}
 
+	if !canReadOrder(user, userUUID, order) {
+		return &orderV1.ForbiddenError{
+			Code:    http.StatusForbidden,
+			Message: model.ErrOrderAccessDenied.Error(),
+		}, nil
+	}
+
 	return converter.OrderToHTTP(order), nil
 }
@@ -14,15 +14,15 @@ import (
 
 func (s *ApiSuite) TestGetOrderSuccess() {
 	orderUUID := uuid.MustParse("00000000-0000-0000-0000-000000000002")
-	partUUIDs := []uuid.UUID{
-		uuid.MustParse("00000000-0000-0000-0000-000000000001"),
-		uuid.MustParse("00000000-0000-0000-0000-000000000010"),
+	items := []model.OrderItem{
+		{PartUUID: uuid.MustParse("00000000-0000-0000-0000-000000000001"), Quantity: 2, UnitPrice: 30, Name: "Engine"},
+		{PartUUID: uuid.MustParse("00000000-0000-0000-0000-000000000010"), Quantity: 1, UnitPrice: 40, Name: "Wing"},
 	}
 
 	order := model.Order{
 		UUID:            orderUUID,
 		UserUUID:        uuid.MustParse("00000000-0000-0000-0000-000000000003"),
-		PartUUIDs:       partUUIDs,
+		Items:           items,
 		TotalPrice:      100,
 		TransactionUUID: uuid.MustParse("00000000-0000-0000-0000-000000000004"),
 		PaymentMethod:   model.PaymentMethodCARD,
@@ -154,3 +154,30 @@ func (s *ApiSuite) TestGetOrderFailure() {
 		})
 	}
 }
+
+func (s *ApiSuite) TestGetOrderExpandsPartUUIDs() {
+	orderUUID := uuid.MustParse("00000000-0000-0000-0000-000000000002")
+	engineUUID := uuid.MustParse("00000000-0000-0000-0000-000000000001")
+	wingUUID := uuid.MustParse("00000000-0000-0000-0000-000000000010")
+
+	s.orderService.On("Get", s.ctx, orderUUID).
+		Return(model.Order{
+			UUID:     orderUUID,
+			UserUUID: s.userUUID,
+			Items: []model.OrderItem{
+				{PartUUID: engineUUID, Quantity: 2, UnitPrice: 30},
+				{PartUUID: wingUUID, Quantity: 1, UnitPrice: 40},
+			},
+			TotalPrice: 100,
+			Status:     model.OrderStatusPENDINGPAYMENT,
+		}, nil).
+		Once()
+
+	res, err := s.api.OrderGet(s.ctx, orderV1.OrderGetParams{OrderUUID: orderUUID.String()})
+
+	s.Require().NoError(err)
+	dto, ok := res.(*orderV1.OrderDto)
+	s.Require().True(ok)
+	s.Require().Len(dto.Items, 2)
+	s.Require().Equal([]uuid.UUID{engineUUID, engineUUID, wingUUID}, dto.PartUuids)
+}
@@ -0,0 +1,62 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/crafty-ezhik/rocket-factory/order/internal/converter"
+	"github.com/crafty-ezhik/rocket-factory/order/internal/model"
+	orderV1 "github.com/crafty-ezhik/rocket-factory/shared/pkg/openapi/order/v1"
+)
+
+func (a *api) OrderList(ctx context.Context, params orderV1.OrderListParams) (orderV1.OrderListRes, error) {
+	user, userUUID, ok := sessionUser(ctx)
+	if !ok {
+		return &orderV1.UnauthorizedError{
+			Code:    http.StatusUnauthorized,
+			Message: "authentication required",
+		}, nil
+	}
+
+	filter, err := converter.OrdersFilterToServiceModel(params)
+	if err != nil {
+		return &orderV1.BadRequestError{
+			Code:    http.StatusBadRequest,
+			Message: err.Error(),
+		}, nil
+	}
+
+	// Покупатель видит только свои заказы, фильтр по пользователю доступен ролям с orders:read_all
+	if !canReadAllOrders(user) {
+		filter.UserUUID = &userUUID
+	}
+
+	page, err := a.orderService.List(ctx, filter)
+	if err != nil {
+		if errors.Is(err, model.ErrInvalidOrdersFilter) || errors.Is(err, model.ErrInvalidOrdersCursor) {
+			return &orderV1.BadRequestError{
+				Code:    http.StatusBadRequest,
+				Message: err.Error(),
+			}, nil
+		}
+		if errors.Is(err, context.DeadlineExceeded) {
+			return &orderV1.RequestTimeoutError{
+				Code:    http.StatusRequestTimeout,
+				Message: "request timeout exceeded",
+			}, nil
+		}
+		if errors.Is(err, context.Canceled) {
+			return &orderV1.BadRequestError{
+				Code:    http.StatusBadRequest,
+				Message: "request cancelled",
+			}, nil
+		}
+		return &orderV1.InternalServerError{
+			Code:    http.StatusInternalServerError,
+			Message: "something went wrong",
+		}, nil
+	}
+
+	return converter.OrdersPageToHTTP(page), nil
+}
@@ -0,0 +1,150 @@
+package v1
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/google/uuid"
+
+	"github.com/crafty-ezhik/rocket-factory/order/internal/converter"
+	"github.com/crafty-ezhik/rocket-factory/order/internal/model"
+	grpcAuth "github.com/crafty-ezhik/rocket-factory/platform/pkg/middleware/grpc"
+	orderV1 "github.com/crafty-ezhik/rocket-factory/shared/pkg/openapi/order/v1"
+	commonV1 "github.com/crafty-ezhik/rocket-factory/shared/pkg/proto/common/v1"
+)
+
+func (s *ApiSuite) TestListOrders() {
+	userUUID := uuid.MustParse("00000000-0000-0000-0000-000000000003")
+	otherUserUUID := uuid.MustParse("00000000-0000-0000-0000-000000000005")
+
+	customerCtx := context.WithValue(s.ctx, grpcAuth.GetUserContextKey(), &commonV1.User{
+		Uuid:  userUUID.String(),
+		Roles: []string{"customer"},
+	})
+	adminCtx := context.WithValue(s.ctx, grpcAuth.GetUserContextKey(), &commonV1.User{
+		Uuid:        uuid.NewString(),
+		Roles:       []string{"admin"},
+		Permissions: []string{"orders:read", "orders:write", "orders:read_all"},
+	})
+
+	order := model.Order{
+		UUID:       uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+		UserUUID:   userUUID,
+		TotalPrice: 100,
+		Status:     model.OrderStatusPAID,
+	}
+	page := model.OrdersPage{
+		Orders: []model.Order{order},
+		NextCursor: &model.OrdersCursor{
+			Sort:       model.OrderSortCREATEDATDESC,
+			TotalPrice: order.TotalPrice,
+			OrderUUID:  order.UUID,
+		},
+	}
+
+	tests := []struct {
+		name        string
+		ctx         context.Context
+		params      orderV1.OrderListParams
+		expectedRes orderV1.OrderListRes
+		setupMock   func(ctx context.Context)
+	}{
+		{
+			name: "customer sees only own orders",
+			ctx:  customerCtx,
+			params: orderV1.OrderListParams{
+				UserUUID: orderV1.NewOptUUID(otherUserUUID),
+				Status:   []orderV1.OrderStatus{orderV1.OrderStatusPAID},
+			},
+			expectedRes: converter.OrdersPageToHTTP(page),
+			setupMock: func(ctx context.Context) {
+				s.orderService.On("List", ctx, model.OrdersFilter{
+					UserUUID: &userUUID,
+					Statuses: []model.OrderStatus{model.OrderStatusPAID},
+					Sort:     model.OrderSortCREATEDATDESC,
+				}).Return(page, nil).Once()
+			},
+		},
+		{
+			name: "admin filters by user",
+			ctx:  adminCtx,
+			params: orderV1.OrderListParams{
+				UserUUID: orderV1.NewOptUUID(otherUserUUID),
+				Sort:     orderV1.NewOptOrderSort(orderV1.OrderSortTOTALPRICEASC),
+				Limit:    orderV1.NewOptInt(10),
+			},
+			expectedRes: converter.OrdersPageToHTTP(model.OrdersPage{Orders: []model.Order{}}),
+			setupMock: func(ctx context.Context) {
+				s.orderService.On("List", ctx, model.OrdersFilter{
+					UserUUID: &otherUserUUID,
+					Sort:     model.OrderSortTOTALPRICEASC,
+					Limit:    10,
+				}).Return(model.OrdersPage{Orders: []model.Order{}}, nil).Once()
+			},
+		},
+		{
+			name:   "unauthenticated",
+			ctx:    context.Background(),
+			params: orderV1.OrderListParams{},
+			expectedRes: &orderV1.UnauthorizedError{
+				Code:    http.StatusUnauthorized,
+				Message: "authentication required",
+			},
+			setupMock: func(ctx context.Context) {},
+		},
+		{
+			name:   "malformed cursor",
+			ctx:    customerCtx,
+			params: orderV1.OrderListParams{Cursor: orderV1.NewOptString("not a cursor")},
+			expectedRes: &orderV1.BadRequestError{
+				Code:    http.StatusBadRequest,
+				Message: model.ErrInvalidOrdersCursor.Error(),
+			},
+			setupMock: func(ctx context.Context) {},
+		},
+		{
+			name:   "invalid filter",
+			ctx:    adminCtx,
+			params: orderV1.OrderListParams{MinTotal: orderV1.NewOptFloat64(200), MaxTotal: orderV1.NewOptFloat64(100)},
+			expectedRes: &orderV1.BadRequestError{
+				Code:    http.StatusBadRequest,
+				Message: model.ErrInvalidOrdersFilter.Error(),
+			},
+			setupMock: func(ctx context.Context) {
+				minTotal, maxTotal := 200.0, 100.0
+				s.orderService.On("List", ctx, model.OrdersFilter{
+					MinTotal: &minTotal,
+					MaxTotal: &maxTotal,
+					Sort:     model.OrderSortCREATEDATDESC,
+				}).Return(model.OrdersPage{}, model.ErrInvalidOrdersFilter).Once()
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			tt.setupMock(tt.ctx)
+
+			res, err := s.api.OrderList(tt.ctx, tt.params)
+
+			s.Require().NoError(err)
+			s.Require().Equal(tt.expectedRes, res)
+		})
+	}
+}
+
+func (s *ApiSuite) TestOrdersCursorRoundTrip() {
+	cursor := model.OrdersCursor{
+		Sort:       model.OrderSortTOTALPRICEDESC,
+		TotalPrice: 150.5,
+		OrderUUID:  uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+	}
+
+	decoded, err := converter.OrdersCursorToServiceModel(converter.OrdersCursorToHTTP(cursor))
+
+	s.Require().NoError(err)
+	s.Require().Equal(cursor.Sort, decoded.Sort)
+	s.Require().Equal(cursor.TotalPrice, decoded.TotalPrice)
+	s.Require().Equal(cursor.OrderUUID, decoded.OrderUUID)
+	s.Require().True(cursor.CreatedAt.Equal(decoded.CreatedAt))
+}
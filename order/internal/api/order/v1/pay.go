@@ -13,6 +13,14 @@ import (
 )
 
 func (a *api) OrderPay(ctx context.Context, req *orderV1.PayOrderRequest, params orderV1.OrderPayParams) (orderV1.OrderPayRes, error) {
+	user, userUUID, ok := sessionUser(ctx)
+	if !ok {
+		return &orderV1.UnauthorizedError{
+			Code:    http.StatusUnauthorized,
+			Message: "authentication required",
+		}, nil
+	}
+
 	orderUUID, err := uuid.Parse(params.OrderUUID)
 	if err != nil {
 		return &orderV1.BadRequestError{
@@ -28,7 +36,11 @@ func (a *api) OrderPay(ctx context.Context, req *orderV1.PayOrderRequest, params
 		}, nil
 	}
 
-	transactionUUID, err := a.orderService.Pay(ctx, orderUUID, converter.PaymentMethodToService(req.PaymentMethod))
+	var transactionUUID uuid.UUID
+	err = a.checkOrderAccess(ctx, user, userUUID, orderUUID)
+	if err == nil {
+		transactionUUID, err = a.orderService.Pay(ctx, orderUUID, converter.PaymentMethodToService(req.PaymentMethod))
+	}
 	if err != nil {
 		if errors.Is(err, model.ErrOrderNotFound) {
 			return &orderV1.NotFoundError{
@@ -37,13 +49,38 @@ func (a *api) OrderPay(ctx context.Context, req *orderV1.PayOrderRequest, params
 			}, nil
 		}
 
-		if errors.Is(err, model.ErrOrderCannotPay) {
+		if errors.Is(err, model.ErrOrderAccessDenied) {
+			return &orderV1.ForbiddenError{
+				Code:    http.StatusForbidden,
+				Message: err.Error(),
+			}, nil
+		}
+
+		if errors.Is(err, model.ErrPaymentInvalid) {
+			return &orderV1.BadRequestError{
+				Code:    http.StatusBadRequest,
+				Message: err.Error(),
+			}, nil
+		}
+
+		if errors.Is(err, model.ErrOrderCannotPay) ||
+			errors.Is(err, model.ErrOrderStatusConflict) ||
+			errors.Is(err, model.ErrOrderReservationExpired) ||
+			errors.Is(err, model.ErrPaymentDeclined) ||
+			errors.Is(err, model.ErrPaymentConflict) {
 			return &orderV1.ConflictError{
 				Code:    http.StatusConflict,
 				Message: err.Error(),
 			}, nil
 		}
 
+		if errors.Is(err, model.ErrPaymentUnavailable) {
+			return &orderV1.ServiceUnavailableError{
+				Code:    http.StatusServiceUnavailable,
+				Message: err.Error(),
+			}, nil
+		}
+
 		if errors.Is(err, context.DeadlineExceeded) {
 			return &orderV1.RequestTimeoutError{
 				Code:    http.StatusRequestTimeout,
@@ -3,6 +3,7 @@ package v1
 import (
 	"context"
 	"errors"
+	"fmt"
 	"net/http"
 
 	"github.com/google/uuid"
@@ -35,6 +36,8 @@ func (s *ApiSuite) TestPayOrderSuccess() {
 				TransactionUUID: transactionUUID,
 			},
 			setupMock: func(paymentMethod orderV1.NilPaymentMethod) {
+				s.mockOwnedOrder(orderUUID)
+
 				s.orderService.On("Pay", s.ctx, orderUUID, converter.PaymentMethodToService(paymentMethod)).
 					Return(transactionUUID, nil).
 					Once()
@@ -107,6 +110,8 @@ func (s *ApiSuite) TestPayOrderFailure() {
 				Message: "order not found",
 			},
 			setupMock: func(paymentMethod orderV1.NilPaymentMethod) {
+				s.mockOwnedOrder(orderUUID)
+
 				s.orderService.On("Pay", s.ctx, orderUUID, converter.PaymentMethodToService(paymentMethod)).
 					Return(uuid.Nil, model.ErrOrderNotFound).
 					Once()
@@ -125,11 +130,113 @@ func (s *ApiSuite) TestPayOrderFailure() {
 				Message: "order has already been paid or cancelled",
 			},
 			setupMock: func(paymentMethod orderV1.NilPaymentMethod) {
+				s.mockOwnedOrder(orderUUID)
+
 				s.orderService.On("Pay", s.ctx, orderUUID, converter.PaymentMethodToService(paymentMethod)).
 					Return(uuid.Nil, model.ErrOrderCannotPay).
 					Once()
 			},
 		},
+		{
+			name: "parts reservation expired",
+			req: &orderV1.PayOrderRequest{
+				PaymentMethod: orderV1.NilPaymentMethod{Value: orderV1.PaymentMethodCREDITCARD},
+			},
+			params: orderV1.OrderPayParams{
+				OrderUUID: orderUUID.String(),
+			},
+			expectedRes: &orderV1.ConflictError{
+				Code:    http.StatusConflict,
+				Message: "parts reservation has expired",
+			},
+			setupMock: func(paymentMethod orderV1.NilPaymentMethod) {
+				s.mockOwnedOrder(orderUUID)
+
+				s.orderService.On("Pay", s.ctx, orderUUID, converter.PaymentMethodToService(paymentMethod)).
+					Return(uuid.Nil, model.ErrOrderReservationExpired).
+					Once()
+			},
+		},
+		{
+			name: "payment declined",
+			req: &orderV1.PayOrderRequest{
+				PaymentMethod: orderV1.NilPaymentMethod{Value: orderV1.PaymentMethodCREDITCARD},
+			},
+			params: orderV1.OrderPayParams{
+				OrderUUID: orderUUID.String(),
+			},
+			expectedRes: &orderV1.ConflictError{
+				Code:    http.StatusConflict,
+				Message: fmt.Errorf("%w: payment declined by provider", model.ErrPaymentDeclined).Error(),
+			},
+			setupMock: func(paymentMethod orderV1.NilPaymentMethod) {
+				s.mockOwnedOrder(orderUUID)
+
+				s.orderService.On("Pay", s.ctx, orderUUID, converter.PaymentMethodToService(paymentMethod)).
+					Return(uuid.Nil, fmt.Errorf("%w: payment declined by provider", model.ErrPaymentDeclined)).
+					Once()
+			},
+		},
+		{
+			name: "payment request rejected",
+			req: &orderV1.PayOrderRequest{
+				PaymentMethod: orderV1.NilPaymentMethod{Value: orderV1.PaymentMethodCREDITCARD},
+			},
+			params: orderV1.OrderPayParams{
+				OrderUUID: orderUUID.String(),
+			},
+			expectedRes: &orderV1.BadRequestError{
+				Code:    http.StatusBadRequest,
+				Message: fmt.Errorf("%w: unsupported payment method", model.ErrPaymentInvalid).Error(),
+			},
+			setupMock: func(paymentMethod orderV1.NilPaymentMethod) {
+				s.mockOwnedOrder(orderUUID)
+
+				s.orderService.On("Pay", s.ctx, orderUUID, converter.PaymentMethodToService(paymentMethod)).
+					Return(uuid.Nil, fmt.Errorf("%w: unsupported payment method", model.ErrPaymentInvalid)).
+					Once()
+			},
+		},
+		{
+			name: "order paid by another user",
+			req: &orderV1.PayOrderRequest{
+				PaymentMethod: orderV1.NilPaymentMethod{Value: orderV1.PaymentMethodCREDITCARD},
+			},
+			params: orderV1.OrderPayParams{
+				OrderUUID: orderUUID.String(),
+			},
+			expectedRes: &orderV1.ConflictError{
+				Code:    http.StatusConflict,
+				Message: fmt.Errorf("%w: order payment belongs to another user", model.ErrPaymentConflict).Error(),
+			},
+			setupMock: func(paymentMethod orderV1.NilPaymentMethod) {
+				s.mockOwnedOrder(orderUUID)
+
+				s.orderService.On("Pay", s.ctx, orderUUID, converter.PaymentMethodToService(paymentMethod)).
+					Return(uuid.Nil, fmt.Errorf("%w: order payment belongs to another user", model.ErrPaymentConflict)).
+					Once()
+			},
+		},
+		{
+			name: "payment service unavailable",
+			req: &orderV1.PayOrderRequest{
+				PaymentMethod: orderV1.NilPaymentMethod{Value: orderV1.PaymentMethodCREDITCARD},
+			},
+			params: orderV1.OrderPayParams{
+				OrderUUID: orderUUID.String(),
+			},
+			expectedRes: &orderV1.ServiceUnavailableError{
+				Code:    http.StatusServiceUnavailable,
+				Message: fmt.Errorf("%w: connection refused", model.ErrPaymentUnavailable).Error(),
+			},
+			setupMock: func(paymentMethod orderV1.NilPaymentMethod) {
+				s.mockOwnedOrder(orderUUID)
+
+				s.orderService.On("Pay", s.ctx, orderUUID, converter.PaymentMethodToService(paymentMethod)).
+					Return(uuid.Nil, fmt.Errorf("%w: connection refused", model.ErrPaymentUnavailable)).
+					Once()
+			},
+		},
 		{
 			name: "service timeout",
 			req: &orderV1.PayOrderRequest{
@@ -143,6 +250,8 @@ func (s *ApiSuite) TestPayOrderFailure() {
 				Message: "request timeout exceeded",
 			},
 			setupMock: func(paymentMethod orderV1.NilPaymentMethod) {
+				s.mockOwnedOrder(orderUUID)
+
 				s.orderService.On("Pay", s.ctx, orderUUID, converter.PaymentMethodToService(paymentMethod)).
 					Return(uuid.Nil, context.DeadlineExceeded).
 					Once()
@@ -161,6 +270,8 @@ func (s *ApiSuite) TestPayOrderFailure() {
 				Message: "request cancelled",
 			},
 			setupMock: func(paymentMethod orderV1.NilPaymentMethod) {
+				s.mockOwnedOrder(orderUUID)
+
 				s.orderService.On("Pay", s.ctx, orderUUID, converter.PaymentMethodToService(paymentMethod)).
 					Return(uuid.Nil, context.Canceled).
 					Once()
@@ -179,6 +290,8 @@ func (s *ApiSuite) TestPayOrderFailure() {
 				Message: "something went wrong",
 			},
 			setupMock: func(paymentMethod orderV1.NilPaymentMethod) {
+				s.mockOwnedOrder(orderUUID)
+
 				s.orderService.On("Pay", s.ctx, orderUUID, converter.PaymentMethodToService(paymentMethod)).
 					Return(uuid.Nil, dbErr).
 					Once()
@@ -4,25 +4,42 @@ import (
 	"context"
 	"testing"
 
+	"github.com/google/uuid"
 	"github.com/stretchr/testify/suite"
 
+	"github.com/crafty-ezhik/rocket-factory/order/internal/model"
 	"github.com/crafty-ezhik/rocket-factory/order/internal/service/mocks"
+	grpcAuth "github.com/crafty-ezhik/rocket-factory/platform/pkg/middleware/grpc"
+	commonV1 "github.com/crafty-ezhik/rocket-factory/shared/pkg/proto/common/v1"
 )
 
 type ApiSuite struct {
 	suite.Suite
 	ctx          context.Context
+	userUUID     uuid.UUID
 	orderService *mocks.MockOrderService
 	api          *api
 }
 
 func (s *ApiSuite) SetupSuite() {
-	s.ctx = context.Background()
+	// Пользователь сессии, которого AuthMiddleware кладет в контекст
+	s.userUUID = uuid.MustParse("00000000-0000-0000-0000-000000000003")
+	s.ctx = context.WithValue(context.Background(), grpcAuth.GetUserContextKey(), &commonV1.User{
+		Uuid:  s.userUUID.String(),
+		Roles: []string{"customer"},
+	})
 	s.orderService = mocks.NewMockOrderService(s.T())
 	s.api = NewAPI(s.orderService)
 }
 func (s *ApiSuite) TearDownSuite() {}
 
+// mockOwnedOrder - заказ принадлежит пользователю сессии
+func (s *ApiSuite) mockOwnedOrder(orderUUID uuid.UUID) {
+	s.orderService.On("Get", s.ctx, orderUUID).
+		Return(model.Order{UUID: orderUUID, UserUUID: s.userUUID}, nil).
+		Once()
+}
+
 func TestApiIntegration(t *testing.T) {
 	suite.Run(t, new(ApiSuite))
 }
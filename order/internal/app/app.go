@@ -33,7 +33,7 @@ func New(ctx context.Context) (*App, error) {
 }
 
 func (a *App) Run(ctx context.Context) error {
-	errCh := make(chan error, 2)
+	errCh := make(chan error, 4)
 
 	ctx, cancel := context.WithCancel(ctx)
 	defer cancel()
@@ -45,6 +45,20 @@ func (a *App) Run(ctx context.Context) error {
 		}
 	}()
 
+	// Запускаем консьюмер ошибок сборки
+	go func() {
+		if err := a.runAssemblyFailedConsumer(ctx); err != nil {
+			errCh <- fmt.Errorf("consumer error: %w", err)
+		}
+	}()
+
+	// Запускаем relay для отправки событий из outbox
+	go func() {
+		if err := a.runOutboxRelay(ctx); err != nil {
+			errCh <- fmt.Errorf("outbox relay error: %w", err)
+		}
+	}()
+
 	// Запускаем HTTP-сервер
 	go func() {
 		if err := a.runHTTPServer(ctx); err != nil {
@@ -55,7 +69,7 @@ func (a *App) Run(ctx context.Context) error {
 	select {
 	case err := <-errCh:
 		logger.Error(ctx, "❌ Компонент завершился с ошибкой, завершение работы", zap.Error(err))
-		// Триггерим cancel, чтобы остановить второй компонент
+		// Триггерим cancel, чтобы остановить остальные компоненты
 		cancel()
 		// Дождись завершения всех задач (если есть graceful shutdown внутри)
 		<-ctx.Done()
@@ -110,7 +124,7 @@ func (a *App) initHTTPServer(ctx context.Context) error {
 	// Инициализируем роутер Chi
 	r := chi.NewRouter()
 
-	authMiddleware := HTTPMiddleware.NewAuthMiddleware(a.diContainer.IAMClient(ctx))
+	authMiddleware := HTTPMiddleware.NewAuthMiddleware(a.diContainer.IAMClient(ctx), a.diContainer.TokenVerifier(ctx), accessPolicy)
 
 	// Добавляем middleware
 	r.Use(middleware.Logger)
@@ -162,3 +176,25 @@ func (a *App) runConsumer(ctx context.Context) error {
 
 	return nil
 }
+
+func (a *App) runAssemblyFailedConsumer(ctx context.Context) error {
+	logger.Info(ctx, "🚀 ShipAssemblyFailed Kafka consumer запущен")
+
+	err := a.diContainer.AssemblyFailedConsumerService(ctx).RunConsumer(ctx)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (a *App) runOutboxRelay(ctx context.Context) error {
+	logger.Info(ctx, "🚀 Outbox relay запущен")
+
+	err := a.diContainer.OutboxRelayService(ctx).RunRelay(ctx)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
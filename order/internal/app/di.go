@@ -18,20 +18,26 @@ import (
 	"github.com/crafty-ezhik/rocket-factory/order/internal/config"
 	kafkaConv "github.com/crafty-ezhik/rocket-factory/order/internal/converter/kafka"
 	"github.com/crafty-ezhik/rocket-factory/order/internal/converter/kafka/decoder"
+	"github.com/crafty-ezhik/rocket-factory/order/internal/converter/kafka/encoder"
+	"github.com/crafty-ezhik/rocket-factory/order/internal/model"
 	"github.com/crafty-ezhik/rocket-factory/order/internal/repository"
 	orderRepo "github.com/crafty-ezhik/rocket-factory/order/internal/repository/order"
+	outboxRepo "github.com/crafty-ezhik/rocket-factory/order/internal/repository/outbox"
 	"github.com/crafty-ezhik/rocket-factory/order/internal/service"
+	"github.com/crafty-ezhik/rocket-factory/order/internal/service/consumer/order_assembly_failed_consumer"
 	"github.com/crafty-ezhik/rocket-factory/order/internal/service/consumer/order_consumer"
 	orderService "github.com/crafty-ezhik/rocket-factory/order/internal/service/order"
-	"github.com/crafty-ezhik/rocket-factory/order/internal/service/producer/order_producer"
+	"github.com/crafty-ezhik/rocket-factory/order/internal/service/relay/outbox_relay"
 	"github.com/crafty-ezhik/rocket-factory/platform/pkg/closer"
 	wrapperKafka "github.com/crafty-ezhik/rocket-factory/platform/pkg/kafka"
 	wrapperKafkaConsumer "github.com/crafty-ezhik/rocket-factory/platform/pkg/kafka/consumer"
+	"github.com/crafty-ezhik/rocket-factory/platform/pkg/kafka/consumer/dedup"
 	wrapperKafkaProducer "github.com/crafty-ezhik/rocket-factory/platform/pkg/kafka/producer"
 	"github.com/crafty-ezhik/rocket-factory/platform/pkg/logger"
 	middlewareGRPC "github.com/crafty-ezhik/rocket-factory/platform/pkg/middleware/grpc"
 	HTTPMiddleware "github.com/crafty-ezhik/rocket-factory/platform/pkg/middleware/http"
 	kafkaMiddleware "github.com/crafty-ezhik/rocket-factory/platform/pkg/middleware/kafka"
+	"github.com/crafty-ezhik/rocket-factory/platform/pkg/token"
 	orderV1 "github.com/crafty-ezhik/rocket-factory/shared/pkg/openapi/order/v1"
 	auth_v1 "github.com/crafty-ezhik/rocket-factory/shared/pkg/proto/auth/v1"
 	inventoryV1 "github.com/crafty-ezhik/rocket-factory/shared/pkg/proto/inventory/v1"
@@ -39,24 +45,38 @@ import (
 )
 
 type diContainer struct {
-	orderV1API           orderV1.Handler
-	orderService         service.OrderService
-	orderRepository      repository.OrderRepository
-	orderConsumerService service.ConsumerService
-	orderProducerService service.OrderProducerService
+	orderV1API            orderV1.Handler
+	orderService          service.OrderService
+	orderRepository       repository.OrderRepository
+	orderConsumerService  service.ConsumerService
+	assemblyFailedService service.ConsumerService
+	outboxRelayService    service.OutboxRelayService
+	outboxRepository      repository.OutboxRepository
 
 	pgConnPool *pgxpool.Pool
 
 	inventoryClient grpc.InventoryClient
 	paymentClient   grpc.PaymentClient
 	iamClient       HTTPMiddleware.IAMClient
+	tokenVerifier   *token.Verifier
 
 	consumerGroup          sarama.ConsumerGroup
 	orderAssembledConsumer wrapperKafka.Consumer
+	dedupStore             wrapperKafkaConsumer.DedupStore
+
+	consumerGroupAssemblyFailed sarama.ConsumerGroup
+	orderAssemblyFailedConsumer wrapperKafka.Consumer
 
 	orderAssembledDecoder kafkaConv.OrderAssembledDecoder
+	orderPaidEncoder      kafkaConv.OrderPaidEncoder
+	orderRefundedEncoder  kafkaConv.OrderRefundedEncoder
 	syncProducer          sarama.SyncProducer
 	orderPaidProducer     wrapperKafka.Producer
+	orderRefundedProducer wrapperKafka.Producer
+
+	orderAssemblyFailedDecoder kafkaConv.OrderAssemblyFailedDecoder
+	assemblyRequestedEncoder   kafkaConv.AssemblyRequestedEncoder
+	assemblyRequestedProducer  wrapperKafka.Producer
 }
 
 func NewDIContainer() *diContainer {
@@ -72,28 +92,59 @@ func (d *diContainer) OrderV1API(ctx context.Context) orderV1.Handler {
 
 func (d *diContainer) PartService(ctx context.Context) service.OrderService {
 	if d.orderService == nil {
-		d.orderService = orderService.NewService(d.PartRepository(ctx), d.InventoryClient(ctx), d.PaymentClient(ctx), d.OrderProducerService())
+		d.orderService = orderService.NewService(
+			d.PartRepository(ctx),
+			d.InventoryClient(ctx),
+			d.PaymentClient(ctx),
+			d.OrderPaidEncoder(),
+			d.OrderRefundedEncoder(),
+			d.AssemblyRequestedEncoder(),
+			orderService.AssemblyPolicy{
+				OnFailure:   model.AssemblyFailurePolicy(config.AppConfig().AssemblyFailure.Policy()),
+				MaxAttempts: config.AppConfig().AssemblyFailure.MaxAttempts(),
+			},
+		)
 	}
 	return d.orderService
 }
 
-// Kafka producer
-
-// OrderProducerService - Создает сервис kafka producer
-func (d *diContainer) OrderProducerService() service.OrderProducerService {
-	if d.orderProducerService == nil {
-		d.orderProducerService = order_producer.NewService(d.OrderPaidProducer())
+// OutboxRelayService - Создает сервис, отправляющий события из outbox в Kafka
+func (d *diContainer) OutboxRelayService(ctx context.Context) service.OutboxRelayService {
+	if d.outboxRelayService == nil {
+		d.outboxRelayService = outbox_relay.NewService(
+			d.OutboxRepository(ctx),
+			map[model.EventType]wrapperKafka.Producer{
+				model.EventTypeOrderPaid:         d.OrderPaidProducer(),
+				model.EventTypeOrderRefunded:     d.OrderRefundedProducer(),
+				model.EventTypeAssemblyRequested: d.AssemblyRequestedProducer(),
+			},
+			config.AppConfig().OutboxRelay.PollInterval(),
+			config.AppConfig().OutboxRelay.BatchSize(),
+			config.AppConfig().OutboxRelay.LockTimeout(),
+		)
 	}
-	return d.orderProducerService
+	return d.outboxRelayService
 }
 
 func (d *diContainer) OrderConsumerService(ctx context.Context) service.ConsumerService {
 	if d.orderConsumerService == nil {
-		d.orderConsumerService = order_consumer.NewService(d.OrderAssembledConsumer(), d.PartRepository(ctx), d.OrderAssembledDecoder())
+		d.orderConsumerService = order_consumer.NewService(d.OrderAssembledConsumer(ctx), d.PartService(ctx), d.OrderAssembledDecoder())
 	}
 	return d.orderConsumerService
 }
 
+// AssemblyFailedConsumerService - Создает сервис, обрабатывающий ошибки сборки заказов
+func (d *diContainer) AssemblyFailedConsumerService(ctx context.Context) service.ConsumerService {
+	if d.assemblyFailedService == nil {
+		d.assemblyFailedService = order_assembly_failed_consumer.NewService(
+			d.OrderAssemblyFailedConsumer(ctx),
+			d.OrderAssemblyFailedDecoder(),
+			d.PartService(ctx),
+		)
+	}
+	return d.assemblyFailedService
+}
+
 func (d *diContainer) PartRepository(ctx context.Context) repository.OrderRepository {
 	if d.orderRepository == nil {
 		d.orderRepository = orderRepo.NewRepository(d.PgConnPool(ctx))
@@ -101,6 +152,13 @@ func (d *diContainer) PartRepository(ctx context.Context) repository.OrderReposi
 	return d.orderRepository
 }
 
+func (d *diContainer) OutboxRepository(ctx context.Context) repository.OutboxRepository {
+	if d.outboxRepository == nil {
+		d.outboxRepository = outboxRepo.NewRepository(d.PgConnPool(ctx))
+	}
+	return d.outboxRepository
+}
+
 func (d *diContainer) PgConnPool(ctx context.Context) *pgxpool.Pool {
 	if d.pgConnPool == nil {
 		pool, err := pgxpool.New(ctx, config.AppConfig().Postgres.URI())
@@ -194,6 +252,13 @@ func (d *diContainer) InventoryConn(_ context.Context) *googleGRPC.ClientConn {
 	return conn
 }
 
+func (d *diContainer) TokenVerifier(ctx context.Context) *token.Verifier {
+	if d.tokenVerifier == nil {
+		d.tokenVerifier = middlewareGRPC.NewTokenVerifier(d.IAMClient(ctx), config.AppConfig().IamGRPC.TokenIssuer())
+	}
+	return d.tokenVerifier
+}
+
 func (d *diContainer) IAMConn(_ context.Context) *googleGRPC.ClientConn {
 	conn, err := googleGRPC.NewClient(
 		config.AppConfig().IamGRPC.Address(),
@@ -238,7 +303,7 @@ func (d *diContainer) ConsumerGroup() sarama.ConsumerGroup {
 }
 
 // OrderAssembledConsumer - Создается consumer с определенной consumer group и списком топиков для прослушивания
-func (d *diContainer) OrderAssembledConsumer() wrapperKafka.Consumer {
+func (d *diContainer) OrderAssembledConsumer(ctx context.Context) wrapperKafka.Consumer {
 	if d.orderAssembledConsumer == nil {
 		d.orderAssembledConsumer = wrapperKafkaConsumer.NewConsumer(
 			d.ConsumerGroup(),
@@ -246,13 +311,98 @@ func (d *diContainer) OrderAssembledConsumer() wrapperKafka.Consumer {
 				config.AppConfig().OrderAssembledConsumer.Topic(),
 			},
 			logger.Logger(),
-			kafkaMiddleware.Logging(logger.Logger()),
+			wrapperKafkaConsumer.WithMiddlewares(
+				kafkaMiddleware.Logging(logger.Logger()),
+				wrapperKafkaConsumer.Deduplicate(
+					d.DedupStore(ctx),
+					config.AppConfig().OrderAssembledConsumer.GroupID(),
+					config.AppConfig().OrderAssembledConsumer.DedupRetention(),
+					d.orderAssembledEventKey,
+					logger.Logger(),
+				),
+			),
+			wrapperKafkaConsumer.WithRetry(d.ConsumerRetryPolicy(), d.SyncProducer()),
 		)
 	}
 
 	return d.orderAssembledConsumer
 }
 
+// ConsumerGroupAssemblyFailed - Создается consumer group для событий ошибки сборки
+func (d *diContainer) ConsumerGroupAssemblyFailed() sarama.ConsumerGroup {
+	if d.consumerGroupAssemblyFailed == nil {
+		consumerGroup, err := sarama.NewConsumerGroup(
+			config.AppConfig().Kafka.Brokers(),
+			config.AppConfig().OrderAssemblyFailed.GroupID(),
+			config.AppConfig().OrderAssemblyFailed.Config(),
+		)
+		if err != nil {
+			panic(fmt.Sprintf("❌ Ошибка создания consumer group: %s\n", err.Error()))
+		}
+
+		// Добавляем закрытие ConsumerGroup
+		closer.AddNamed("Kafka assembly failed consumer group", func(ctx context.Context) error {
+			return d.consumerGroupAssemblyFailed.Close()
+		})
+
+		d.consumerGroupAssemblyFailed = consumerGroup
+	}
+
+	return d.consumerGroupAssemblyFailed
+}
+
+// OrderAssemblyFailedConsumer - Создается consumer событий ошибки сборки
+func (d *diContainer) OrderAssemblyFailedConsumer(ctx context.Context) wrapperKafka.Consumer {
+	if d.orderAssemblyFailedConsumer == nil {
+		d.orderAssemblyFailedConsumer = wrapperKafkaConsumer.NewConsumer(
+			d.ConsumerGroupAssemblyFailed(),
+			[]string{
+				config.AppConfig().OrderAssemblyFailed.Topic(),
+			},
+			logger.Logger(),
+			wrapperKafkaConsumer.WithMiddlewares(
+				kafkaMiddleware.Logging(logger.Logger()),
+				wrapperKafkaConsumer.Deduplicate(
+					d.DedupStore(ctx),
+					config.AppConfig().OrderAssemblyFailed.GroupID(),
+					config.AppConfig().OrderAssemblyFailed.DedupRetention(),
+					d.orderAssemblyFailedEventKey,
+					logger.Logger(),
+				),
+			),
+			wrapperKafkaConsumer.WithRetry(d.ConsumerRetryPolicy(), d.SyncProducer()),
+		)
+	}
+
+	return d.orderAssemblyFailedConsumer
+}
+
+// DedupStore - Создается хранилище ключей обработанных событий в PostgreSQL
+func (d *diContainer) DedupStore(ctx context.Context) wrapperKafkaConsumer.DedupStore {
+	if d.dedupStore == nil {
+		d.dedupStore = dedup.NewPostgresStore(d.PgConnPool(ctx), "processed_messages")
+	}
+	return d.dedupStore
+}
+
+// orderAssembledEventKey - ключ идемпотентности события "Заказ собран"
+func (d *diContainer) orderAssembledEventKey(msg wrapperKafka.Message) (string, error) {
+	event, err := d.OrderAssembledDecoder().Decode(msg.Value)
+	if err != nil {
+		return "", err
+	}
+	return event.EventUUID.String(), nil
+}
+
+// orderAssemblyFailedEventKey - ключ идемпотентности события "Сборка завершилась ошибкой"
+func (d *diContainer) orderAssemblyFailedEventKey(msg wrapperKafka.Message) (string, error) {
+	event, err := d.OrderAssemblyFailedDecoder().Decode(msg.Value)
+	if err != nil {
+		return "", err
+	}
+	return event.EventUUID.String(), nil
+}
+
 // OrderAssembledDecoder - Создается декодер для входящих событий
 func (d *diContainer) OrderAssembledDecoder() kafkaConv.OrderAssembledDecoder {
 	if d.orderAssembledDecoder == nil {
@@ -261,6 +411,48 @@ func (d *diContainer) OrderAssembledDecoder() kafkaConv.OrderAssembledDecoder {
 	return d.orderAssembledDecoder
 }
 
+// OrderAssemblyFailedDecoder - Создается декодер событий ошибки сборки
+func (d *diContainer) OrderAssemblyFailedDecoder() kafkaConv.OrderAssemblyFailedDecoder {
+	if d.orderAssemblyFailedDecoder == nil {
+		d.orderAssemblyFailedDecoder = decoder.NewOrderAssemblyFailedDecoder()
+	}
+	return d.orderAssemblyFailedDecoder
+}
+
+// OrderPaidEncoder - Создается энкодер для исходящих событий OrderPaid
+func (d *diContainer) OrderPaidEncoder() kafkaConv.OrderPaidEncoder {
+	if d.orderPaidEncoder == nil {
+		d.orderPaidEncoder = encoder.NewOrderPaidEncoder()
+	}
+	return d.orderPaidEncoder
+}
+
+// OrderRefundedEncoder - Создается энкодер для исходящих событий OrderRefunded
+func (d *diContainer) OrderRefundedEncoder() kafkaConv.OrderRefundedEncoder {
+	if d.orderRefundedEncoder == nil {
+		d.orderRefundedEncoder = encoder.NewOrderRefundedEncoder()
+	}
+	return d.orderRefundedEncoder
+}
+
+// AssemblyRequestedEncoder - Создается энкодер для исходящих запросов повторной сборки
+func (d *diContainer) AssemblyRequestedEncoder() kafkaConv.AssemblyRequestedEncoder {
+	if d.assemblyRequestedEncoder == nil {
+		d.assemblyRequestedEncoder = encoder.NewAssemblyRequestedEncoder()
+	}
+	return d.assemblyRequestedEncoder
+}
+
+// ConsumerRetryPolicy - Создается политика повторной обработки сообщений на основе конфигурации
+func (d *diContainer) ConsumerRetryPolicy() wrapperKafkaConsumer.RetryPolicy {
+	return wrapperKafkaConsumer.RetryPolicy{
+		Attempts:   config.AppConfig().ConsumerRetry.Attempts(),
+		Backoff:    config.AppConfig().ConsumerRetry.Backoff(),
+		MaxBackoff: config.AppConfig().ConsumerRetry.MaxBackoff(),
+		Delays:     config.AppConfig().ConsumerRetry.TopicDelays(),
+	}
+}
+
 // SyncProducer - создает базового producer с указанными брокерами
 func (d *diContainer) SyncProducer() sarama.SyncProducer {
 	if d.syncProducer == nil {
@@ -291,3 +483,27 @@ func (d *diContainer) OrderPaidProducer() wrapperKafka.Producer {
 	}
 	return d.orderPaidProducer
 }
+
+// OrderRefundedProducer - создает producer для событий возврата средств по отмененным заказам
+func (d *diContainer) OrderRefundedProducer() wrapperKafka.Producer {
+	if d.orderRefundedProducer == nil {
+		d.orderRefundedProducer = wrapperKafkaProducer.NewProducer(
+			d.SyncProducer(),
+			config.AppConfig().OrderRefundedProducer.Topic(),
+			logger.Logger(),
+		)
+	}
+	return d.orderRefundedProducer
+}
+
+// AssemblyRequestedProducer - создает producer для запросов повторной сборки
+func (d *diContainer) AssemblyRequestedProducer() wrapperKafka.Producer {
+	if d.assemblyRequestedProducer == nil {
+		d.assemblyRequestedProducer = wrapperKafkaProducer.NewProducer(
+			d.SyncProducer(),
+			config.AppConfig().AssemblyRequested.Topic(),
+			logger.Logger(),
+		)
+	}
+	return d.assemblyRequestedProducer
+}
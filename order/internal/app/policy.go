@@ -0,0 +1,23 @@
+package app
+
+import (
+	"net/http"
+
+	HTTPMiddleware "github.com/crafty-ezhik/rocket-factory/platform/pkg/middleware/http"
+)
+
+// Разрешения IAM, которые проверяются для маршрутов order
+const (
+	permissionOrdersRead  = "orders:read"
+	permissionOrdersWrite = "orders:write"
+)
+
+// accessPolicy - разрешения, необходимые для обращения к маршрутам OrderService.
+// Владение заказом дополнительно проверяется в обработчиках
+var accessPolicy = HTTPMiddleware.RoutePolicy{
+	{Method: http.MethodGet, Path: "/api/v1/orders", Permission: permissionOrdersRead},
+	{Method: http.MethodPost, Path: "/api/v1/orders", Permission: permissionOrdersWrite},
+	{Method: http.MethodGet, Path: "/api/v1/orders/{order_uuid}", Permission: permissionOrdersRead},
+	{Method: http.MethodPost, Path: "/api/v1/orders/{order_uuid}/pay", Permission: permissionOrdersWrite},
+	{Method: http.MethodPost, Path: "/api/v1/orders/{order_uuid}/cancel", Permission: permissionOrdersWrite},
+}
@@ -0,0 +1,24 @@
+package converter
+
+import (
+	serviceModel "github.com/crafty-ezhik/rocket-factory/order/internal/model"
+	genInventoryV1 "github.com/crafty-ezhik/rocket-factory/shared/pkg/proto/inventory/v1"
+)
+
+func ReservationItemsToProto(items []serviceModel.ReservationItem) []*genInventoryV1.ReservationItem {
+	result := make([]*genInventoryV1.ReservationItem, len(items))
+	for i, item := range items {
+		result[i] = &genInventoryV1.ReservationItem{
+			PartUuid: item.PartUUID.String(),
+			Quantity: item.Quantity,
+		}
+	}
+	return result
+}
+
+func ReservationToModel(reservation *genInventoryV1.GetReservationResponse) serviceModel.Reservation {
+	return serviceModel.Reservation{
+		Status:    serviceModel.ReservationStatus(reservation.GetStatus().String()),
+		ExpiresAt: reservation.GetExpiresAt().AsTime(),
+	}
+}
@@ -10,8 +10,14 @@ import (
 
 type InventoryClient interface {
 	ListParts(ctx context.Context, filter serviceModel.PartsFilter) ([]serviceModel.Part, error)
+	ReserveParts(ctx context.Context, orderUUID uuid.UUID, items []serviceModel.ReservationItem) error
+	ReleaseReservation(ctx context.Context, orderUUID uuid.UUID) error
+	CommitReservation(ctx context.Context, orderUUID uuid.UUID) error
+	GetReservation(ctx context.Context, orderUUID uuid.UUID) (serviceModel.Reservation, error)
 }
 
 type PaymentClient interface {
-	PayOrder(ctx context.Context, orderUUID, userUUID uuid.UUID, paymentMethod serviceModel.PaymentMethod) (string, error)
+	PayOrder(ctx context.Context, orderUUID, userUUID uuid.UUID, paymentMethod serviceModel.PaymentMethod, amount float64) (string, error)
+	// RefundPayment - возвращает все оплаченные по транзакции средства и сумму возврата
+	RefundPayment(ctx context.Context, transactionUUID uuid.UUID) (float64, error)
 }
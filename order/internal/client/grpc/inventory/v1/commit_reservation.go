@@ -0,0 +1,27 @@
+package v1
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	serviceModel "github.com/crafty-ezhik/rocket-factory/order/internal/model"
+	"github.com/crafty-ezhik/rocket-factory/platform/pkg/middleware/grpc"
+	generatedInventoryV1 "github.com/crafty-ezhik/rocket-factory/shared/pkg/proto/inventory/v1"
+)
+
+func (c *client) CommitReservation(ctx context.Context, orderUUID uuid.UUID) error {
+	_, err := c.generatedClient.CommitReservation(grpc.ForwardSessionUUIDToGRPC(ctx), &generatedInventoryV1.CommitReservationRequest{
+		OrderUuid: orderUUID.String(),
+	})
+	if err != nil {
+		// InventoryService отказывает в списании только снятого резерва: истек срок или заказ отменен
+		if status.Code(err) == codes.FailedPrecondition {
+			return serviceModel.ErrOrderReservationExpired
+		}
+		return err
+	}
+	return nil
+}
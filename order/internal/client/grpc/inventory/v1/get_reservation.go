@@ -0,0 +1,28 @@
+package v1
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	clientConverter "github.com/crafty-ezhik/rocket-factory/order/internal/client/converter"
+	serviceModel "github.com/crafty-ezhik/rocket-factory/order/internal/model"
+	"github.com/crafty-ezhik/rocket-factory/platform/pkg/middleware/grpc"
+	generatedInventoryV1 "github.com/crafty-ezhik/rocket-factory/shared/pkg/proto/inventory/v1"
+)
+
+func (c *client) GetReservation(ctx context.Context, orderUUID uuid.UUID) (serviceModel.Reservation, error) {
+	res, err := c.generatedClient.GetReservation(grpc.ForwardSessionUUIDToGRPC(ctx), &generatedInventoryV1.GetReservationRequest{
+		OrderUuid: orderUUID.String(),
+	})
+	if err != nil {
+		// Без резерва детали заказа не обеспечены так же, как при истекшем резерве
+		if status.Code(err) == codes.NotFound {
+			return serviceModel.Reservation{}, serviceModel.ErrOrderReservationExpired
+		}
+		return serviceModel.Reservation{}, err
+	}
+	return clientConverter.ReservationToModel(res), nil
+}
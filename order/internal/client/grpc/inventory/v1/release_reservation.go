@@ -0,0 +1,17 @@
+package v1
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/crafty-ezhik/rocket-factory/platform/pkg/middleware/grpc"
+	generatedInventoryV1 "github.com/crafty-ezhik/rocket-factory/shared/pkg/proto/inventory/v1"
+)
+
+func (c *client) ReleaseReservation(ctx context.Context, orderUUID uuid.UUID) error {
+	_, err := c.generatedClient.ReleaseReservation(grpc.ForwardSessionUUIDToGRPC(ctx), &generatedInventoryV1.ReleaseReservationRequest{
+		OrderUuid: orderUUID.String(),
+	})
+	return err
+}
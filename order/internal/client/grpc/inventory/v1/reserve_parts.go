@@ -0,0 +1,34 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	clientConverter "github.com/crafty-ezhik/rocket-factory/order/internal/client/converter"
+	serviceModel "github.com/crafty-ezhik/rocket-factory/order/internal/model"
+	"github.com/crafty-ezhik/rocket-factory/platform/pkg/middleware/grpc"
+	generatedInventoryV1 "github.com/crafty-ezhik/rocket-factory/shared/pkg/proto/inventory/v1"
+)
+
+// outOfStockPrefix - начало сообщения InventoryService о нехватке деталей, за которым следует их список
+const outOfStockPrefix = "parts out of stock: "
+
+func (c *client) ReserveParts(ctx context.Context, orderUUID uuid.UUID, items []serviceModel.ReservationItem) error {
+	_, err := c.generatedClient.ReserveParts(grpc.ForwardSessionUUIDToGRPC(ctx), &generatedInventoryV1.ReservePartsRequest{
+		OrderUuid: orderUUID.String(),
+		Items:     clientConverter.ReservationItemsToProto(items),
+	})
+	if err != nil {
+		st := status.Convert(err)
+		if st.Code() == codes.FailedPrecondition && strings.HasPrefix(st.Message(), outOfStockPrefix) {
+			return fmt.Errorf("%w: %s", serviceModel.ErrOrderPartsOutOfStock, strings.TrimPrefix(st.Message(), outOfStockPrefix))
+		}
+		return err
+	}
+	return nil
+}
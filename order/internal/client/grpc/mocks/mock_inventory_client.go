@@ -11,6 +11,7 @@ import (
 	"context"
 
 	"github.com/crafty-ezhik/rocket-factory/order/internal/model"
+	"github.com/google/uuid"
 	mock "github.com/stretchr/testify/mock"
 )
 
@@ -41,6 +42,129 @@ func (_m *MockInventoryClient) EXPECT() *MockInventoryClient_Expecter {
 	return &MockInventoryClient_Expecter{mock: &_m.Mock}
 }
 
+// CommitReservation provides a mock function for the type MockInventoryClient
+func (_mock *MockInventoryClient) CommitReservation(ctx context.Context, orderUUID uuid.UUID) error {
+	ret := _mock.Called(ctx, orderUUID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CommitReservation")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = returnFunc(ctx, orderUUID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockInventoryClient_CommitReservation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CommitReservation'
+type MockInventoryClient_CommitReservation_Call struct {
+	*mock.Call
+}
+
+// CommitReservation is a helper method to define mock.On call
+//   - ctx context.Context
+//   - orderUUID uuid.UUID
+func (_e *MockInventoryClient_Expecter) CommitReservation(ctx interface{}, orderUUID interface{}) *MockInventoryClient_CommitReservation_Call {
+	return &MockInventoryClient_CommitReservation_Call{Call: _e.mock.On("CommitReservation", ctx, orderUUID)}
+}
+
+func (_c *MockInventoryClient_CommitReservation_Call) Run(run func(ctx context.Context, orderUUID uuid.UUID)) *MockInventoryClient_CommitReservation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockInventoryClient_CommitReservation_Call) Return(err error) *MockInventoryClient_CommitReservation_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockInventoryClient_CommitReservation_Call) RunAndReturn(run func(ctx context.Context, orderUUID uuid.UUID) error) *MockInventoryClient_CommitReservation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetReservation provides a mock function for the type MockInventoryClient
+func (_mock *MockInventoryClient) GetReservation(ctx context.Context, orderUUID uuid.UUID) (model.Reservation, error) {
+	ret := _mock.Called(ctx, orderUUID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetReservation")
+	}
+
+	var r0 model.Reservation
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (model.Reservation, error)); ok {
+		return returnFunc(ctx, orderUUID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) model.Reservation); ok {
+		r0 = returnFunc(ctx, orderUUID)
+	} else {
+		r0 = ret.Get(0).(model.Reservation)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, orderUUID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockInventoryClient_GetReservation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetReservation'
+type MockInventoryClient_GetReservation_Call struct {
+	*mock.Call
+}
+
+// GetReservation is a helper method to define mock.On call
+//   - ctx context.Context
+//   - orderUUID uuid.UUID
+func (_e *MockInventoryClient_Expecter) GetReservation(ctx interface{}, orderUUID interface{}) *MockInventoryClient_GetReservation_Call {
+	return &MockInventoryClient_GetReservation_Call{Call: _e.mock.On("GetReservation", ctx, orderUUID)}
+}
+
+func (_c *MockInventoryClient_GetReservation_Call) Run(run func(ctx context.Context, orderUUID uuid.UUID)) *MockInventoryClient_GetReservation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockInventoryClient_GetReservation_Call) Return(reservation model.Reservation, err error) *MockInventoryClient_GetReservation_Call {
+	_c.Call.Return(reservation, err)
+	return _c
+}
+
+func (_c *MockInventoryClient_GetReservation_Call) RunAndReturn(run func(ctx context.Context, orderUUID uuid.UUID) (model.Reservation, error)) *MockInventoryClient_GetReservation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ListParts provides a mock function for the type MockInventoryClient
 func (_mock *MockInventoryClient) ListParts(ctx context.Context, filter model.PartsFilter) ([]model.Part, error) {
 	ret := _mock.Called(ctx, filter)
@@ -108,3 +232,123 @@ func (_c *MockInventoryClient_ListParts_Call) RunAndReturn(run func(ctx context.
 	_c.Call.Return(run)
 	return _c
 }
+
+// ReleaseReservation provides a mock function for the type MockInventoryClient
+func (_mock *MockInventoryClient) ReleaseReservation(ctx context.Context, orderUUID uuid.UUID) error {
+	ret := _mock.Called(ctx, orderUUID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReleaseReservation")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = returnFunc(ctx, orderUUID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockInventoryClient_ReleaseReservation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReleaseReservation'
+type MockInventoryClient_ReleaseReservation_Call struct {
+	*mock.Call
+}
+
+// ReleaseReservation is a helper method to define mock.On call
+//   - ctx context.Context
+//   - orderUUID uuid.UUID
+func (_e *MockInventoryClient_Expecter) ReleaseReservation(ctx interface{}, orderUUID interface{}) *MockInventoryClient_ReleaseReservation_Call {
+	return &MockInventoryClient_ReleaseReservation_Call{Call: _e.mock.On("ReleaseReservation", ctx, orderUUID)}
+}
+
+func (_c *MockInventoryClient_ReleaseReservation_Call) Run(run func(ctx context.Context, orderUUID uuid.UUID)) *MockInventoryClient_ReleaseReservation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockInventoryClient_ReleaseReservation_Call) Return(err error) *MockInventoryClient_ReleaseReservation_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockInventoryClient_ReleaseReservation_Call) RunAndReturn(run func(ctx context.Context, orderUUID uuid.UUID) error) *MockInventoryClient_ReleaseReservation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReserveParts provides a mock function for the type MockInventoryClient
+func (_mock *MockInventoryClient) ReserveParts(ctx context.Context, orderUUID uuid.UUID, items []model.ReservationItem) error {
+	ret := _mock.Called(ctx, orderUUID, items)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReserveParts")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, []model.ReservationItem) error); ok {
+		r0 = returnFunc(ctx, orderUUID, items)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockInventoryClient_ReserveParts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReserveParts'
+type MockInventoryClient_ReserveParts_Call struct {
+	*mock.Call
+}
+
+// ReserveParts is a helper method to define mock.On call
+//   - ctx context.Context
+//   - orderUUID uuid.UUID
+//   - items []model.ReservationItem
+func (_e *MockInventoryClient_Expecter) ReserveParts(ctx interface{}, orderUUID interface{}, items interface{}) *MockInventoryClient_ReserveParts_Call {
+	return &MockInventoryClient_ReserveParts_Call{Call: _e.mock.On("ReserveParts", ctx, orderUUID, items)}
+}
+
+func (_c *MockInventoryClient_ReserveParts_Call) Run(run func(ctx context.Context, orderUUID uuid.UUID, items []model.ReservationItem)) *MockInventoryClient_ReserveParts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 []model.ReservationItem
+		if args[2] != nil {
+			arg2 = args[2].([]model.ReservationItem)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockInventoryClient_ReserveParts_Call) Return(err error) *MockInventoryClient_ReserveParts_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockInventoryClient_ReserveParts_Call) RunAndReturn(run func(ctx context.Context, orderUUID uuid.UUID, items []model.ReservationItem) error) *MockInventoryClient_ReserveParts_Call {
+	_c.Call.Return(run)
+	return _c
+}
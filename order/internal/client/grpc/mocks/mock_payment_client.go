@@ -43,8 +43,8 @@ func (_m *MockPaymentClient) EXPECT() *MockPaymentClient_Expecter {
 }
 
 // PayOrder provides a mock function for the type MockPaymentClient
-func (_mock *MockPaymentClient) PayOrder(ctx context.Context, orderUUID uuid.UUID, userUUID uuid.UUID, paymentMethod model.PaymentMethod) (string, error) {
-	ret := _mock.Called(ctx, orderUUID, userUUID, paymentMethod)
+func (_mock *MockPaymentClient) PayOrder(ctx context.Context, orderUUID uuid.UUID, userUUID uuid.UUID, paymentMethod model.PaymentMethod, amount float64) (string, error) {
+	ret := _mock.Called(ctx, orderUUID, userUUID, paymentMethod, amount)
 
 	if len(ret) == 0 {
 		panic("no return value specified for PayOrder")
@@ -52,16 +52,16 @@ func (_mock *MockPaymentClient) PayOrder(ctx context.Context, orderUUID uuid.UUI
 
 	var r0 string
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, model.PaymentMethod) (string, error)); ok {
-		return returnFunc(ctx, orderUUID, userUUID, paymentMethod)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, model.PaymentMethod, float64) (string, error)); ok {
+		return returnFunc(ctx, orderUUID, userUUID, paymentMethod, amount)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, model.PaymentMethod) string); ok {
-		r0 = returnFunc(ctx, orderUUID, userUUID, paymentMethod)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, model.PaymentMethod, float64) string); ok {
+		r0 = returnFunc(ctx, orderUUID, userUUID, paymentMethod, amount)
 	} else {
 		r0 = ret.Get(0).(string)
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID, model.PaymentMethod) error); ok {
-		r1 = returnFunc(ctx, orderUUID, userUUID, paymentMethod)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID, model.PaymentMethod, float64) error); ok {
+		r1 = returnFunc(ctx, orderUUID, userUUID, paymentMethod, amount)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -78,11 +78,12 @@ type MockPaymentClient_PayOrder_Call struct {
 //   - orderUUID uuid.UUID
 //   - userUUID uuid.UUID
 //   - paymentMethod model.PaymentMethod
-func (_e *MockPaymentClient_Expecter) PayOrder(ctx interface{}, orderUUID interface{}, userUUID interface{}, paymentMethod interface{}) *MockPaymentClient_PayOrder_Call {
-	return &MockPaymentClient_PayOrder_Call{Call: _e.mock.On("PayOrder", ctx, orderUUID, userUUID, paymentMethod)}
+//   - amount float64
+func (_e *MockPaymentClient_Expecter) PayOrder(ctx interface{}, orderUUID interface{}, userUUID interface{}, paymentMethod interface{}, amount interface{}) *MockPaymentClient_PayOrder_Call {
+	return &MockPaymentClient_PayOrder_Call{Call: _e.mock.On("PayOrder", ctx, orderUUID, userUUID, paymentMethod, amount)}
 }
 
-func (_c *MockPaymentClient_PayOrder_Call) Run(run func(ctx context.Context, orderUUID uuid.UUID, userUUID uuid.UUID, paymentMethod model.PaymentMethod)) *MockPaymentClient_PayOrder_Call {
+func (_c *MockPaymentClient_PayOrder_Call) Run(run func(ctx context.Context, orderUUID uuid.UUID, userUUID uuid.UUID, paymentMethod model.PaymentMethod, amount float64)) *MockPaymentClient_PayOrder_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -100,11 +101,16 @@ func (_c *MockPaymentClient_PayOrder_Call) Run(run func(ctx context.Context, ord
 		if args[3] != nil {
 			arg3 = args[3].(model.PaymentMethod)
 		}
+		var arg4 float64
+		if args[4] != nil {
+			arg4 = args[4].(float64)
+		}
 		run(
 			arg0,
 			arg1,
 			arg2,
 			arg3,
+			arg4,
 		)
 	})
 	return _c
@@ -115,7 +121,73 @@ func (_c *MockPaymentClient_PayOrder_Call) Return(s string, err error) *MockPaym
 	return _c
 }
 
-func (_c *MockPaymentClient_PayOrder_Call) RunAndReturn(run func(ctx context.Context, orderUUID uuid.UUID, userUUID uuid.UUID, paymentMethod model.PaymentMethod) (string, error)) *MockPaymentClient_PayOrder_Call {
+func (_c *MockPaymentClient_PayOrder_Call) RunAndReturn(run func(ctx context.Context, orderUUID uuid.UUID, userUUID uuid.UUID, paymentMethod model.PaymentMethod, amount float64) (string, error)) *MockPaymentClient_PayOrder_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RefundPayment provides a mock function for the type MockPaymentClient
+func (_mock *MockPaymentClient) RefundPayment(ctx context.Context, transactionUUID uuid.UUID) (float64, error) {
+	ret := _mock.Called(ctx, transactionUUID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RefundPayment")
+	}
+
+	var r0 float64
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (float64, error)); ok {
+		return returnFunc(ctx, transactionUUID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) float64); ok {
+		r0 = returnFunc(ctx, transactionUUID)
+	} else {
+		r0 = ret.Get(0).(float64)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, transactionUUID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPaymentClient_RefundPayment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RefundPayment'
+type MockPaymentClient_RefundPayment_Call struct {
+	*mock.Call
+}
+
+// RefundPayment is a helper method to define mock.On call
+//   - ctx context.Context
+//   - transactionUUID uuid.UUID
+func (_e *MockPaymentClient_Expecter) RefundPayment(ctx interface{}, transactionUUID interface{}) *MockPaymentClient_RefundPayment_Call {
+	return &MockPaymentClient_RefundPayment_Call{Call: _e.mock.On("RefundPayment", ctx, transactionUUID)}
+}
+
+func (_c *MockPaymentClient_RefundPayment_Call) Run(run func(ctx context.Context, transactionUUID uuid.UUID)) *MockPaymentClient_RefundPayment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPaymentClient_RefundPayment_Call) Return(f float64, err error) *MockPaymentClient_RefundPayment_Call {
+	_c.Call.Return(f, err)
+	return _c
+}
+
+func (_c *MockPaymentClient_RefundPayment_Call) RunAndReturn(run func(ctx context.Context, transactionUUID uuid.UUID) (float64, error)) *MockPaymentClient_RefundPayment_Call {
 	_c.Call.Return(run)
 	return _c
 }
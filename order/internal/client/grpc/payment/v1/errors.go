@@ -0,0 +1,39 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/crafty-ezhik/rocket-factory/order/internal/model"
+)
+
+// paymentError - переводит gRPC статус PaymentService в ошибку модели заказа, сохраняя текст причины
+func paymentError(err error) error {
+	st, ok := status.FromError(err)
+	if !ok {
+		return err
+	}
+
+	switch st.Code() {
+	case codes.InvalidArgument:
+		// Например, неподдерживаемый способ оплаты
+		return fmt.Errorf("%w: %s", model.ErrPaymentInvalid, st.Message())
+	case codes.FailedPrecondition:
+		// Отказ провайдера, оплата уже выполняется или транзакция возвращена
+		return fmt.Errorf("%w: %s", model.ErrPaymentDeclined, st.Message())
+	case codes.AlreadyExists, codes.PermissionDenied:
+		// Заказ уже оплачивается другим пользователем
+		return fmt.Errorf("%w: %s", model.ErrPaymentConflict, st.Message())
+	case codes.Unavailable:
+		return fmt.Errorf("%w: %s", model.ErrPaymentUnavailable, st.Message())
+	case codes.DeadlineExceeded:
+		return context.DeadlineExceeded
+	case codes.Canceled:
+		return context.Canceled
+	default:
+		return err
+	}
+}
@@ -9,14 +9,15 @@ import (
 	genPaymentV1 "github.com/crafty-ezhik/rocket-factory/shared/pkg/proto/payment/v1"
 )
 
-func (c *client) PayOrder(ctx context.Context, orderUUID, userUUID uuid.UUID, paymentMethod model.PaymentMethod) (string, error) {
+func (c *client) PayOrder(ctx context.Context, orderUUID, userUUID uuid.UUID, paymentMethod model.PaymentMethod, amount float64) (string, error) {
 	transactionUUIDstr, err := c.generatedClient.PayOrder(ctx, &genPaymentV1.PayOrderRequest{
 		OrderUuid:     orderUUID.String(),
 		UserUuid:      userUUID.String(),
 		PaymentMethod: genPaymentV1.PaymentMethod(genPaymentV1.PaymentMethod_value[paymentMethod.String()]),
+		Amount:        amount,
 	})
 	if err != nil {
-		return "", err
+		return "", paymentError(err)
 	}
 	return transactionUUIDstr.TransactionUuid, nil
 }
@@ -0,0 +1,97 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/crafty-ezhik/rocket-factory/order/internal/model"
+	genPaymentV1 "github.com/crafty-ezhik/rocket-factory/shared/pkg/proto/payment/v1"
+)
+
+// fakePaymentClient - PaymentService, который отвечает заданной ошибкой
+type fakePaymentClient struct {
+	genPaymentV1.PaymentServiceClient
+	err error
+}
+
+func (c fakePaymentClient) PayOrder(context.Context, *genPaymentV1.PayOrderRequest, ...grpc.CallOption) (*genPaymentV1.PayOrderResponse, error) {
+	if c.err != nil {
+		return nil, c.err
+	}
+	return &genPaymentV1.PayOrderResponse{TransactionUuid: "transaction"}, nil
+}
+
+func TestPayOrderErrors(t *testing.T) {
+	otherErr := errors.New("connection reset")
+
+	tests := []struct {
+		name        string
+		err         error
+		expectedErr error
+		expectedMsg string
+	}{
+		{
+			name:        "provider declined",
+			err:         status.Error(codes.FailedPrecondition, "payment declined by provider"),
+			expectedErr: model.ErrPaymentDeclined,
+			expectedMsg: "payment declined: payment declined by provider",
+		},
+		{
+			name:        "transaction refunded",
+			err:         status.Error(codes.FailedPrecondition, "transaction has already been refunded"),
+			expectedErr: model.ErrPaymentDeclined,
+			expectedMsg: "payment declined: transaction has already been refunded",
+		},
+		{
+			name:        "unsupported payment method",
+			err:         status.Error(codes.InvalidArgument, "unsupported payment method"),
+			expectedErr: model.ErrPaymentInvalid,
+			expectedMsg: "payment request rejected: unsupported payment method",
+		},
+		{
+			name:        "payment already exists",
+			err:         status.Error(codes.AlreadyExists, "payment already exists"),
+			expectedErr: model.ErrPaymentConflict,
+		},
+		{
+			name:        "paid by another user",
+			err:         status.Error(codes.PermissionDenied, "order payment belongs to another user"),
+			expectedErr: model.ErrPaymentConflict,
+		},
+		{
+			name:        "unavailable",
+			err:         status.Error(codes.Unavailable, "connection refused"),
+			expectedErr: model.ErrPaymentUnavailable,
+		},
+		{
+			name:        "deadline exceeded",
+			err:         status.Error(codes.DeadlineExceeded, "deadline exceeded"),
+			expectedErr: context.DeadlineExceeded,
+		},
+		{
+			name:        "not a grpc status",
+			err:         otherErr,
+			expectedErr: otherErr,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := NewPaymentClient(fakePaymentClient{err: tt.err})
+
+			_, err := c.PayOrder(context.Background(), uuid.New(), uuid.New(), model.PaymentMethodCARD, 100)
+
+			require.ErrorIs(t, err, tt.expectedErr)
+			if tt.expectedMsg != "" {
+				require.EqualError(t, err, tt.expectedMsg)
+			}
+		})
+	}
+}
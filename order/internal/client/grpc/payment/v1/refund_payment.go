@@ -0,0 +1,19 @@
+package v1
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	genPaymentV1 "github.com/crafty-ezhik/rocket-factory/shared/pkg/proto/payment/v1"
+)
+
+func (c *client) RefundPayment(ctx context.Context, transactionUUID uuid.UUID) (float64, error) {
+	resp, err := c.generatedClient.RefundPayment(ctx, &genPaymentV1.RefundPaymentRequest{
+		TransactionUuid: transactionUUID.String(),
+	})
+	if err != nil {
+		return 0, err
+	}
+	return resp.RefundedAmount, nil
+}
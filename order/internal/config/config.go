@@ -17,8 +17,14 @@ type config struct {
 	PaymentGRPC            PaymentGRPCConfig
 	IamGRPC                IAMConfig
 	Kafka                  KafkaConfig
+	ConsumerRetry          ConsumerRetryConfig
 	OrderAssembledConsumer OrderAssembledConsumerConfig
+	OrderAssemblyFailed    OrderAssemblyFailedConsumerConfig
 	OrderPaidProducer      OrderPaidProducerConfig
+	OrderRefundedProducer  OrderRefundedProducerConfig
+	AssemblyRequested      AssemblyRequestedProducerConfig
+	AssemblyFailure        AssemblyFailureConfig
+	OutboxRelay            OutboxRelayConfig
 	Logger                 LoggerConfig
 }
 
@@ -58,16 +64,46 @@ func Load(path ...string) error {
 		return err
 	}
 
+	orderAssemblyFailedConsumerConfig, err := env.NewOrderAssemblyFailedConsumerConfig()
+	if err != nil {
+		return err
+	}
+
 	orderPaidProducerConfig, err := env.NewOrderPaidProducerConfig()
 	if err != nil {
 		return err
 	}
 
+	orderRefundedProducerConfig, err := env.NewOrderRefundedProducerConfig()
+	if err != nil {
+		return err
+	}
+
+	assemblyRequestedProducerConfig, err := env.NewAssemblyRequestedProducerConfig()
+	if err != nil {
+		return err
+	}
+
+	assemblyFailureConfig, err := env.NewAssemblyFailureConfig()
+	if err != nil {
+		return err
+	}
+
+	outboxRelayConfig, err := env.NewOutboxRelayConfig()
+	if err != nil {
+		return err
+	}
+
 	kafkaConfig, err := env.NewKafkaConfig()
 	if err != nil {
 		return err
 	}
 
+	consumerRetryConfig, err := env.NewConsumerRetryConfig()
+	if err != nil {
+		return err
+	}
+
 	loggerConfig, err := env.NewLoggerConfig()
 	if err != nil {
 		return err
@@ -80,8 +116,14 @@ func Load(path ...string) error {
 		PaymentGRPC:            paymentGRPCConfig,
 		IamGRPC:                iamGRPCConfig,
 		OrderAssembledConsumer: orderAssembledConsumerConfig,
+		OrderAssemblyFailed:    orderAssemblyFailedConsumerConfig,
 		OrderPaidProducer:      orderPaidProducerConfig,
+		OrderRefundedProducer:  orderRefundedProducerConfig,
+		AssemblyRequested:      assemblyRequestedProducerConfig,
+		AssemblyFailure:        assemblyFailureConfig,
+		OutboxRelay:            outboxRelayConfig,
 		Kafka:                  kafkaConfig,
+		ConsumerRetry:          consumerRetryConfig,
 		Logger:                 loggerConfig,
 	}
 	return nil
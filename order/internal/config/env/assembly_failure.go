@@ -0,0 +1,40 @@
+package env
+
+import (
+	"fmt"
+
+	"github.com/caarlos0/env/v11"
+)
+
+type assemblyFailureEnvConfig struct {
+	Policy      string `env:"ASSEMBLY_FAILURE_POLICY" envDefault:"refund"`
+	MaxAttempts int    `env:"ASSEMBLY_MAX_ATTEMPTS" envDefault:"1"`
+}
+
+type assemblyFailureConfig struct {
+	raw assemblyFailureEnvConfig
+}
+
+func NewAssemblyFailureConfig() (*assemblyFailureConfig, error) {
+	var raw assemblyFailureEnvConfig
+	if err := env.Parse(&raw); err != nil {
+		return nil, err
+	}
+
+	if raw.Policy != "refund" && raw.Policy != "reassemble" {
+		return nil, fmt.Errorf("invalid ASSEMBLY_FAILURE_POLICY %q: expected refund or reassemble", raw.Policy)
+	}
+	if raw.MaxAttempts < 1 {
+		return nil, fmt.Errorf("invalid ASSEMBLY_MAX_ATTEMPTS %d: must be positive", raw.MaxAttempts)
+	}
+
+	return &assemblyFailureConfig{raw: raw}, nil
+}
+
+func (cfg *assemblyFailureConfig) Policy() string {
+	return cfg.raw.Policy
+}
+
+func (cfg *assemblyFailureConfig) MaxAttempts() int {
+	return cfg.raw.MaxAttempts
+}
@@ -0,0 +1,35 @@
+package env
+
+import (
+	"github.com/IBM/sarama"
+	"github.com/caarlos0/env/v11"
+)
+
+type assemblyRequestedProducerEnvConfig struct {
+	TopicName string `env:"ASSEMBLY_REQUESTED_TOPIC_NAME,required"`
+}
+
+type assemblyRequestedProducerConfig struct {
+	raw assemblyRequestedProducerEnvConfig
+}
+
+func NewAssemblyRequestedProducerConfig() (*assemblyRequestedProducerConfig, error) {
+	var raw assemblyRequestedProducerEnvConfig
+
+	if err := env.Parse(&raw); err != nil {
+		return nil, err
+	}
+	return &assemblyRequestedProducerConfig{raw: raw}, nil
+}
+
+func (cfg *assemblyRequestedProducerConfig) Topic() string {
+	return cfg.raw.TopicName
+}
+
+func (cfg *assemblyRequestedProducerConfig) Config() *sarama.Config {
+	config := sarama.NewConfig()
+	config.Version = sarama.V4_0_0_0
+	config.Producer.Return.Successes = true
+
+	return config
+}
@@ -1,13 +1,16 @@
 package env
 
 import (
+	"time"
+
 	"github.com/IBM/sarama"
 	"github.com/caarlos0/env/v11"
 )
 
 type orderAssembledConsumerEnvConfig struct {
-	Topic   string `env:"ORDER_ASSEMBLED_TOPIC_NAME,required"`
-	GroupID string `env:"ORDER_ASSEMBLED_CONSUMER_GROUP_ID,required"`
+	Topic          string        `env:"ORDER_ASSEMBLED_TOPIC_NAME,required"`
+	GroupID        string        `env:"ORDER_ASSEMBLED_CONSUMER_GROUP_ID,required"`
+	DedupRetention time.Duration `env:"ORDER_ASSEMBLED_DEDUP_RETENTION,required"`
 }
 
 type orderAssembledConsumerConfig struct {
@@ -30,6 +33,10 @@ func (o *orderAssembledConsumerConfig) GroupID() string {
 	return o.raw.GroupID
 }
 
+func (o *orderAssembledConsumerConfig) DedupRetention() time.Duration {
+	return o.raw.DedupRetention
+}
+
 func (o *orderAssembledConsumerConfig) Config() *sarama.Config {
 	config := sarama.NewConfig()
 	config.Version = sarama.V4_0_0_0
@@ -0,0 +1,47 @@
+package env
+
+import (
+	"time"
+
+	"github.com/IBM/sarama"
+	"github.com/caarlos0/env/v11"
+)
+
+type orderAssemblyFailedConsumerEnvConfig struct {
+	Topic          string        `env:"ORDER_ASSEMBLY_FAILED_TOPIC_NAME,required"`
+	GroupID        string        `env:"ORDER_ASSEMBLY_FAILED_CONSUMER_GROUP_ID,required"`
+	DedupRetention time.Duration `env:"ORDER_ASSEMBLY_FAILED_DEDUP_RETENTION,required"`
+}
+
+type orderAssemblyFailedConsumerConfig struct {
+	raw orderAssemblyFailedConsumerEnvConfig
+}
+
+func NewOrderAssemblyFailedConsumerConfig() (*orderAssemblyFailedConsumerConfig, error) {
+	var raw orderAssemblyFailedConsumerEnvConfig
+	if err := env.Parse(&raw); err != nil {
+		return nil, err
+	}
+	return &orderAssemblyFailedConsumerConfig{raw: raw}, nil
+}
+
+func (o *orderAssemblyFailedConsumerConfig) Topic() string {
+	return o.raw.Topic
+}
+
+func (o *orderAssemblyFailedConsumerConfig) GroupID() string {
+	return o.raw.GroupID
+}
+
+func (o *orderAssemblyFailedConsumerConfig) DedupRetention() time.Duration {
+	return o.raw.DedupRetention
+}
+
+func (o *orderAssemblyFailedConsumerConfig) Config() *sarama.Config {
+	config := sarama.NewConfig()
+	config.Version = sarama.V4_0_0_0
+	config.Consumer.Group.Rebalance.GroupStrategies = []sarama.BalanceStrategy{sarama.NewBalanceStrategyRoundRobin()}
+	config.Consumer.Offsets.Initial = sarama.OffsetOldest
+
+	return config
+}
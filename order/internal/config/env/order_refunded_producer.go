@@ -0,0 +1,35 @@
+package env
+
+import (
+	"github.com/IBM/sarama"
+	"github.com/caarlos0/env/v11"
+)
+
+type orderRefundedProducerEnvConfig struct {
+	TopicName string `env:"ORDER_REFUNDED_TOPIC_NAME,required"`
+}
+
+type orderRefundedProducerConfig struct {
+	raw orderRefundedProducerEnvConfig
+}
+
+func NewOrderRefundedProducerConfig() (*orderRefundedProducerConfig, error) {
+	var raw orderRefundedProducerEnvConfig
+
+	if err := env.Parse(&raw); err != nil {
+		return nil, err
+	}
+	return &orderRefundedProducerConfig{raw: raw}, nil
+}
+
+func (cfg *orderRefundedProducerConfig) Topic() string {
+	return cfg.raw.TopicName
+}
+
+func (cfg *orderRefundedProducerConfig) Config() *sarama.Config {
+	config := sarama.NewConfig()
+	config.Version = sarama.V4_0_0_0
+	config.Producer.Return.Successes = true
+
+	return config
+}
@@ -0,0 +1,37 @@
+package env
+
+import (
+	"time"
+
+	"github.com/caarlos0/env/v11"
+)
+
+type outboxRelayEnvConfig struct {
+	PollInterval time.Duration `env:"OUTBOX_RELAY_POLL_INTERVAL,required"`
+	BatchSize    uint64        `env:"OUTBOX_RELAY_BATCH_SIZE,required"`
+	LockTimeout  time.Duration `env:"OUTBOX_RELAY_LOCK_TIMEOUT,required"`
+}
+
+type outboxRelayConfig struct {
+	raw outboxRelayEnvConfig
+}
+
+func NewOutboxRelayConfig() (*outboxRelayConfig, error) {
+	var raw outboxRelayEnvConfig
+	if err := env.Parse(&raw); err != nil {
+		return nil, err
+	}
+	return &outboxRelayConfig{raw: raw}, nil
+}
+
+func (cfg *outboxRelayConfig) PollInterval() time.Duration {
+	return cfg.raw.PollInterval
+}
+
+func (cfg *outboxRelayConfig) BatchSize() uint64 {
+	return cfg.raw.BatchSize
+}
+
+func (cfg *outboxRelayConfig) LockTimeout() time.Duration {
+	return cfg.raw.LockTimeout
+}
@@ -40,12 +40,51 @@ type OrderPaidProducerConfig interface {
 	Config() *sarama.Config
 }
 
+type OrderRefundedProducerConfig interface {
+	Topic() string
+	Config() *sarama.Config
+}
+
 type OrderAssembledConsumerConfig interface {
 	Topic() string
 	GroupID() string
+	DedupRetention() time.Duration
+	Config() *sarama.Config
+}
+
+type OrderAssemblyFailedConsumerConfig interface {
+	Topic() string
+	GroupID() string
+	DedupRetention() time.Duration
 	Config() *sarama.Config
 }
 
+type AssemblyRequestedProducerConfig interface {
+	Topic() string
+	Config() *sarama.Config
+}
+
+// AssemblyFailureConfig - политика обработки ошибок сборки: refund или reassemble,
+// и сколько всего попыток сборки допускается при reassemble
+type AssemblyFailureConfig interface {
+	Policy() string
+	MaxAttempts() int
+}
+
 type IAMConfig interface {
 	Address() string
+	TokenIssuer() string
+}
+
+type OutboxRelayConfig interface {
+	PollInterval() time.Duration
+	BatchSize() uint64
+	LockTimeout() time.Duration
+}
+
+type ConsumerRetryConfig interface {
+	Attempts() int
+	Backoff() time.Duration
+	MaxBackoff() time.Duration
+	TopicDelays() []time.Duration
 }
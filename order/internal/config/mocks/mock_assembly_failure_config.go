@@ -0,0 +1,127 @@
+// Code generated for crafty-ezhik service
+// © Crafty-ezhik 2025.
+
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockAssemblyFailureConfig creates a new instance of MockAssemblyFailureConfig. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockAssemblyFailureConfig(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockAssemblyFailureConfig {
+	mock := &MockAssemblyFailureConfig{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockAssemblyFailureConfig is an autogenerated mock type for the AssemblyFailureConfig type
+type MockAssemblyFailureConfig struct {
+	mock.Mock
+}
+
+type MockAssemblyFailureConfig_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockAssemblyFailureConfig) EXPECT() *MockAssemblyFailureConfig_Expecter {
+	return &MockAssemblyFailureConfig_Expecter{mock: &_m.Mock}
+}
+
+// MaxAttempts provides a mock function for the type MockAssemblyFailureConfig
+func (_mock *MockAssemblyFailureConfig) MaxAttempts() int {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for MaxAttempts")
+	}
+
+	var r0 int
+	if returnFunc, ok := ret.Get(0).(func() int); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+	return r0
+}
+
+// MockAssemblyFailureConfig_MaxAttempts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MaxAttempts'
+type MockAssemblyFailureConfig_MaxAttempts_Call struct {
+	*mock.Call
+}
+
+// MaxAttempts is a helper method to define mock.On call
+func (_e *MockAssemblyFailureConfig_Expecter) MaxAttempts() *MockAssemblyFailureConfig_MaxAttempts_Call {
+	return &MockAssemblyFailureConfig_MaxAttempts_Call{Call: _e.mock.On("MaxAttempts")}
+}
+
+func (_c *MockAssemblyFailureConfig_MaxAttempts_Call) Run(run func()) *MockAssemblyFailureConfig_MaxAttempts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockAssemblyFailureConfig_MaxAttempts_Call) Return(n int) *MockAssemblyFailureConfig_MaxAttempts_Call {
+	_c.Call.Return(n)
+	return _c
+}
+
+func (_c *MockAssemblyFailureConfig_MaxAttempts_Call) RunAndReturn(run func() int) *MockAssemblyFailureConfig_MaxAttempts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Policy provides a mock function for the type MockAssemblyFailureConfig
+func (_mock *MockAssemblyFailureConfig) Policy() string {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Policy")
+	}
+
+	var r0 string
+	if returnFunc, ok := ret.Get(0).(func() string); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	return r0
+}
+
+// MockAssemblyFailureConfig_Policy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Policy'
+type MockAssemblyFailureConfig_Policy_Call struct {
+	*mock.Call
+}
+
+// Policy is a helper method to define mock.On call
+func (_e *MockAssemblyFailureConfig_Expecter) Policy() *MockAssemblyFailureConfig_Policy_Call {
+	return &MockAssemblyFailureConfig_Policy_Call{Call: _e.mock.On("Policy")}
+}
+
+func (_c *MockAssemblyFailureConfig_Policy_Call) Run(run func()) *MockAssemblyFailureConfig_Policy_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockAssemblyFailureConfig_Policy_Call) Return(s string) *MockAssemblyFailureConfig_Policy_Call {
+	_c.Call.Return(s)
+	return _c
+}
+
+func (_c *MockAssemblyFailureConfig_Policy_Call) RunAndReturn(run func() string) *MockAssemblyFailureConfig_Policy_Call {
+	_c.Call.Return(run)
+	return _c
+}
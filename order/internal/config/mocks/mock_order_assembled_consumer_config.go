@@ -8,6 +8,8 @@
 package mocks
 
 import (
+	"time"
+
 	"github.com/IBM/sarama"
 	mock "github.com/stretchr/testify/mock"
 )
@@ -85,6 +87,50 @@ func (_c *MockOrderAssembledConsumerConfig_Config_Call) RunAndReturn(run func()
 	return _c
 }
 
+// DedupRetention provides a mock function for the type MockOrderAssembledConsumerConfig
+func (_mock *MockOrderAssembledConsumerConfig) DedupRetention() time.Duration {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for DedupRetention")
+	}
+
+	var r0 time.Duration
+	if returnFunc, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+	return r0
+}
+
+// MockOrderAssembledConsumerConfig_DedupRetention_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DedupRetention'
+type MockOrderAssembledConsumerConfig_DedupRetention_Call struct {
+	*mock.Call
+}
+
+// DedupRetention is a helper method to define mock.On call
+func (_e *MockOrderAssembledConsumerConfig_Expecter) DedupRetention() *MockOrderAssembledConsumerConfig_DedupRetention_Call {
+	return &MockOrderAssembledConsumerConfig_DedupRetention_Call{Call: _e.mock.On("DedupRetention")}
+}
+
+func (_c *MockOrderAssembledConsumerConfig_DedupRetention_Call) Run(run func()) *MockOrderAssembledConsumerConfig_DedupRetention_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockOrderAssembledConsumerConfig_DedupRetention_Call) Return(duration time.Duration) *MockOrderAssembledConsumerConfig_DedupRetention_Call {
+	_c.Call.Return(duration)
+	return _c
+}
+
+func (_c *MockOrderAssembledConsumerConfig_DedupRetention_Call) RunAndReturn(run func() time.Duration) *MockOrderAssembledConsumerConfig_DedupRetention_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GroupID provides a mock function for the type MockOrderAssembledConsumerConfig
 func (_mock *MockOrderAssembledConsumerConfig) GroupID() string {
 	ret := _mock.Called()
@@ -0,0 +1,220 @@
+// Code generated for crafty-ezhik service
+// © Crafty-ezhik 2025.
+
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"time"
+
+	"github.com/IBM/sarama"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockOrderAssemblyFailedConsumerConfig creates a new instance of MockOrderAssemblyFailedConsumerConfig. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockOrderAssemblyFailedConsumerConfig(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockOrderAssemblyFailedConsumerConfig {
+	mock := &MockOrderAssemblyFailedConsumerConfig{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockOrderAssemblyFailedConsumerConfig is an autogenerated mock type for the OrderAssemblyFailedConsumerConfig type
+type MockOrderAssemblyFailedConsumerConfig struct {
+	mock.Mock
+}
+
+type MockOrderAssemblyFailedConsumerConfig_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockOrderAssemblyFailedConsumerConfig) EXPECT() *MockOrderAssemblyFailedConsumerConfig_Expecter {
+	return &MockOrderAssemblyFailedConsumerConfig_Expecter{mock: &_m.Mock}
+}
+
+// Config provides a mock function for the type MockOrderAssemblyFailedConsumerConfig
+func (_mock *MockOrderAssemblyFailedConsumerConfig) Config() *sarama.Config {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Config")
+	}
+
+	var r0 *sarama.Config
+	if returnFunc, ok := ret.Get(0).(func() *sarama.Config); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*sarama.Config)
+		}
+	}
+	return r0
+}
+
+// MockOrderAssemblyFailedConsumerConfig_Config_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Config'
+type MockOrderAssemblyFailedConsumerConfig_Config_Call struct {
+	*mock.Call
+}
+
+// Config is a helper method to define mock.On call
+func (_e *MockOrderAssemblyFailedConsumerConfig_Expecter) Config() *MockOrderAssemblyFailedConsumerConfig_Config_Call {
+	return &MockOrderAssemblyFailedConsumerConfig_Config_Call{Call: _e.mock.On("Config")}
+}
+
+func (_c *MockOrderAssemblyFailedConsumerConfig_Config_Call) Run(run func()) *MockOrderAssemblyFailedConsumerConfig_Config_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockOrderAssemblyFailedConsumerConfig_Config_Call) Return(config *sarama.Config) *MockOrderAssemblyFailedConsumerConfig_Config_Call {
+	_c.Call.Return(config)
+	return _c
+}
+
+func (_c *MockOrderAssemblyFailedConsumerConfig_Config_Call) RunAndReturn(run func() *sarama.Config) *MockOrderAssemblyFailedConsumerConfig_Config_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DedupRetention provides a mock function for the type MockOrderAssemblyFailedConsumerConfig
+func (_mock *MockOrderAssemblyFailedConsumerConfig) DedupRetention() time.Duration {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for DedupRetention")
+	}
+
+	var r0 time.Duration
+	if returnFunc, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+	return r0
+}
+
+// MockOrderAssemblyFailedConsumerConfig_DedupRetention_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DedupRetention'
+type MockOrderAssemblyFailedConsumerConfig_DedupRetention_Call struct {
+	*mock.Call
+}
+
+// DedupRetention is a helper method to define mock.On call
+func (_e *MockOrderAssemblyFailedConsumerConfig_Expecter) DedupRetention() *MockOrderAssemblyFailedConsumerConfig_DedupRetention_Call {
+	return &MockOrderAssemblyFailedConsumerConfig_DedupRetention_Call{Call: _e.mock.On("DedupRetention")}
+}
+
+func (_c *MockOrderAssemblyFailedConsumerConfig_DedupRetention_Call) Run(run func()) *MockOrderAssemblyFailedConsumerConfig_DedupRetention_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockOrderAssemblyFailedConsumerConfig_DedupRetention_Call) Return(duration time.Duration) *MockOrderAssemblyFailedConsumerConfig_DedupRetention_Call {
+	_c.Call.Return(duration)
+	return _c
+}
+
+func (_c *MockOrderAssemblyFailedConsumerConfig_DedupRetention_Call) RunAndReturn(run func() time.Duration) *MockOrderAssemblyFailedConsumerConfig_DedupRetention_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GroupID provides a mock function for the type MockOrderAssemblyFailedConsumerConfig
+func (_mock *MockOrderAssemblyFailedConsumerConfig) GroupID() string {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GroupID")
+	}
+
+	var r0 string
+	if returnFunc, ok := ret.Get(0).(func() string); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	return r0
+}
+
+// MockOrderAssemblyFailedConsumerConfig_GroupID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GroupID'
+type MockOrderAssemblyFailedConsumerConfig_GroupID_Call struct {
+	*mock.Call
+}
+
+// GroupID is a helper method to define mock.On call
+func (_e *MockOrderAssemblyFailedConsumerConfig_Expecter) GroupID() *MockOrderAssemblyFailedConsumerConfig_GroupID_Call {
+	return &MockOrderAssemblyFailedConsumerConfig_GroupID_Call{Call: _e.mock.On("GroupID")}
+}
+
+func (_c *MockOrderAssemblyFailedConsumerConfig_GroupID_Call) Run(run func()) *MockOrderAssemblyFailedConsumerConfig_GroupID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockOrderAssemblyFailedConsumerConfig_GroupID_Call) Return(s string) *MockOrderAssemblyFailedConsumerConfig_GroupID_Call {
+	_c.Call.Return(s)
+	return _c
+}
+
+func (_c *MockOrderAssemblyFailedConsumerConfig_GroupID_Call) RunAndReturn(run func() string) *MockOrderAssemblyFailedConsumerConfig_GroupID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Topic provides a mock function for the type MockOrderAssemblyFailedConsumerConfig
+func (_mock *MockOrderAssemblyFailedConsumerConfig) Topic() string {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Topic")
+	}
+
+	var r0 string
+	if returnFunc, ok := ret.Get(0).(func() string); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	return r0
+}
+
+// MockOrderAssemblyFailedConsumerConfig_Topic_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Topic'
+type MockOrderAssemblyFailedConsumerConfig_Topic_Call struct {
+	*mock.Call
+}
+
+// Topic is a helper method to define mock.On call
+func (_e *MockOrderAssemblyFailedConsumerConfig_Expecter) Topic() *MockOrderAssemblyFailedConsumerConfig_Topic_Call {
+	return &MockOrderAssemblyFailedConsumerConfig_Topic_Call{Call: _e.mock.On("Topic")}
+}
+
+func (_c *MockOrderAssemblyFailedConsumerConfig_Topic_Call) Run(run func()) *MockOrderAssemblyFailedConsumerConfig_Topic_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockOrderAssemblyFailedConsumerConfig_Topic_Call) Return(s string) *MockOrderAssemblyFailedConsumerConfig_Topic_Call {
+	_c.Call.Return(s)
+	return _c
+}
+
+func (_c *MockOrderAssemblyFailedConsumerConfig_Topic_Call) RunAndReturn(run func() string) *MockOrderAssemblyFailedConsumerConfig_Topic_Call {
+	_c.Call.Return(run)
+	return _c
+}
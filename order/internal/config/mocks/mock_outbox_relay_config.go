@@ -0,0 +1,173 @@
+// Code generated for crafty-ezhik service
+// © Crafty-ezhik 2025.
+
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"time"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockOutboxRelayConfig creates a new instance of MockOutboxRelayConfig. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockOutboxRelayConfig(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockOutboxRelayConfig {
+	mock := &MockOutboxRelayConfig{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockOutboxRelayConfig is an autogenerated mock type for the OutboxRelayConfig type
+type MockOutboxRelayConfig struct {
+	mock.Mock
+}
+
+type MockOutboxRelayConfig_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockOutboxRelayConfig) EXPECT() *MockOutboxRelayConfig_Expecter {
+	return &MockOutboxRelayConfig_Expecter{mock: &_m.Mock}
+}
+
+// BatchSize provides a mock function for the type MockOutboxRelayConfig
+func (_mock *MockOutboxRelayConfig) BatchSize() uint64 {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for BatchSize")
+	}
+
+	var r0 uint64
+	if returnFunc, ok := ret.Get(0).(func() uint64); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(uint64)
+	}
+	return r0
+}
+
+// MockOutboxRelayConfig_BatchSize_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BatchSize'
+type MockOutboxRelayConfig_BatchSize_Call struct {
+	*mock.Call
+}
+
+// BatchSize is a helper method to define mock.On call
+func (_e *MockOutboxRelayConfig_Expecter) BatchSize() *MockOutboxRelayConfig_BatchSize_Call {
+	return &MockOutboxRelayConfig_BatchSize_Call{Call: _e.mock.On("BatchSize")}
+}
+
+func (_c *MockOutboxRelayConfig_BatchSize_Call) Run(run func()) *MockOutboxRelayConfig_BatchSize_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockOutboxRelayConfig_BatchSize_Call) Return(v uint64) *MockOutboxRelayConfig_BatchSize_Call {
+	_c.Call.Return(v)
+	return _c
+}
+
+func (_c *MockOutboxRelayConfig_BatchSize_Call) RunAndReturn(run func() uint64) *MockOutboxRelayConfig_BatchSize_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// LockTimeout provides a mock function for the type MockOutboxRelayConfig
+func (_mock *MockOutboxRelayConfig) LockTimeout() time.Duration {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for LockTimeout")
+	}
+
+	var r0 time.Duration
+	if returnFunc, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+	return r0
+}
+
+// MockOutboxRelayConfig_LockTimeout_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LockTimeout'
+type MockOutboxRelayConfig_LockTimeout_Call struct {
+	*mock.Call
+}
+
+// LockTimeout is a helper method to define mock.On call
+func (_e *MockOutboxRelayConfig_Expecter) LockTimeout() *MockOutboxRelayConfig_LockTimeout_Call {
+	return &MockOutboxRelayConfig_LockTimeout_Call{Call: _e.mock.On("LockTimeout")}
+}
+
+func (_c *MockOutboxRelayConfig_LockTimeout_Call) Run(run func()) *MockOutboxRelayConfig_LockTimeout_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockOutboxRelayConfig_LockTimeout_Call) Return(duration time.Duration) *MockOutboxRelayConfig_LockTimeout_Call {
+	_c.Call.Return(duration)
+	return _c
+}
+
+func (_c *MockOutboxRelayConfig_LockTimeout_Call) RunAndReturn(run func() time.Duration) *MockOutboxRelayConfig_LockTimeout_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PollInterval provides a mock function for the type MockOutboxRelayConfig
+func (_mock *MockOutboxRelayConfig) PollInterval() time.Duration {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for PollInterval")
+	}
+
+	var r0 time.Duration
+	if returnFunc, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+	return r0
+}
+
+// MockOutboxRelayConfig_PollInterval_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PollInterval'
+type MockOutboxRelayConfig_PollInterval_Call struct {
+	*mock.Call
+}
+
+// PollInterval is a helper method to define mock.On call
+func (_e *MockOutboxRelayConfig_Expecter) PollInterval() *MockOutboxRelayConfig_PollInterval_Call {
+	return &MockOutboxRelayConfig_PollInterval_Call{Call: _e.mock.On("PollInterval")}
+}
+
+func (_c *MockOutboxRelayConfig_PollInterval_Call) Run(run func()) *MockOutboxRelayConfig_PollInterval_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockOutboxRelayConfig_PollInterval_Call) Return(duration time.Duration) *MockOutboxRelayConfig_PollInterval_Call {
+	_c.Call.Return(duration)
+	return _c
+}
+
+func (_c *MockOutboxRelayConfig_PollInterval_Call) RunAndReturn(run func() time.Duration) *MockOutboxRelayConfig_PollInterval_Call {
+	_c.Call.Return(run)
+	return _c
+}
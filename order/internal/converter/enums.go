@@ -32,6 +32,12 @@ func OrderStatusToService(v genOrderV1.OrderStatus) model.OrderStatus {
 		return model.OrderStatusPAID
 	case genOrderV1.OrderStatusCANCELLED:
 		return model.OrderStatusCANCELLED
+	case genOrderV1.OrderStatusASSEMBLED:
+		return model.OrderStatusASSEMBLED
+	case genOrderV1.OrderStatusREFUNDED:
+		return model.OrderStatusREFUNDED
+	case genOrderV1.OrderStatusASSEMBLYFAILED:
+		return model.OrderStatusASSEMBLYFAILED
 	default:
 		return model.OrderStatusPENDINGPAYMENT
 	}
@@ -0,0 +1,32 @@
+package encoder
+
+import (
+	"fmt"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/crafty-ezhik/rocket-factory/order/internal/model"
+	eventsV1 "github.com/crafty-ezhik/rocket-factory/shared/pkg/proto/events/v1"
+)
+
+type assemblyRequestedEncoder struct{}
+
+func NewAssemblyRequestedEncoder() *assemblyRequestedEncoder { return &assemblyRequestedEncoder{} }
+
+func (e *assemblyRequestedEncoder) Encode(event model.AssemblyRequestedEvent) ([]byte, error) {
+	msg := &eventsV1.AssemblyRequested{
+		EventUuid: event.EventUUID.String(),
+		OrderUuid: event.OrderUUID.String(),
+		UserUuid:  event.UserUUID.String(),
+		Attempt:   int32(event.Attempt), //nolint:gosec
+		Parts:     assemblyPartsToProto(event.Parts),
+	}
+
+	// Преобразуем структуру в слайс байт для передачи в Kafka
+	payload, err := proto.Marshal(msg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal protobuf: %w", err)
+	}
+
+	return payload, nil
+}
@@ -0,0 +1,56 @@
+package encoder
+
+import (
+	"fmt"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/crafty-ezhik/rocket-factory/order/internal/model"
+	eventsV1 "github.com/crafty-ezhik/rocket-factory/shared/pkg/proto/events/v1"
+)
+
+type orderPaidEncoder struct{}
+
+func NewOrderPaidEncoder() *orderPaidEncoder { return &orderPaidEncoder{} }
+
+func (e *orderPaidEncoder) Encode(event model.OrderPaidEvent) ([]byte, error) {
+	msg := &eventsV1.OrderPaid{
+		EventUuid:       event.EventUUID.String(),
+		OrderUuid:       event.OrderUUID.String(),
+		UserUuid:        event.UserUUID.String(),
+		PaymentMethod:   event.PaymentMethod,
+		TransactionUuid: event.TransactionUUID.String(),
+		PaidAt:          timestamppb.New(event.PaidAt),
+		Parts:           assemblyPartsToProto(event.Parts),
+	}
+
+	// Преобразуем структуру в слайс байт для передачи в Kafka
+	payload, err := proto.Marshal(msg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal protobuf: %w", err)
+	}
+
+	return payload, nil
+}
+
+func assemblyPartsToProto(parts []model.AssemblyPart) []*eventsV1.AssemblyPart {
+	res := make([]*eventsV1.AssemblyPart, 0, len(parts))
+	for _, part := range parts {
+		pb := &eventsV1.AssemblyPart{
+			PartUuid: part.PartUUID.String(),
+			Quantity: part.Quantity,
+			Category: part.Category,
+		}
+		if part.Dimensions != nil {
+			pb.Dimensions = &eventsV1.PartDimensions{
+				Length: part.Dimensions.Length,
+				Width:  part.Dimensions.Width,
+				Height: part.Dimensions.Height,
+				Weight: part.Dimensions.Weight,
+			}
+		}
+		res = append(res, pb)
+	}
+	return res
+}
@@ -0,0 +1,34 @@
+package encoder
+
+import (
+	"fmt"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/crafty-ezhik/rocket-factory/order/internal/model"
+	eventsV1 "github.com/crafty-ezhik/rocket-factory/shared/pkg/proto/events/v1"
+)
+
+type orderRefundedEncoder struct{}
+
+func NewOrderRefundedEncoder() *orderRefundedEncoder { return &orderRefundedEncoder{} }
+
+func (e *orderRefundedEncoder) Encode(event model.OrderRefundedEvent) ([]byte, error) {
+	msg := &eventsV1.OrderRefunded{
+		EventUuid:       event.EventUUID.String(),
+		OrderUuid:       event.OrderUUID.String(),
+		UserUuid:        event.UserUUID.String(),
+		TransactionUuid: event.TransactionUUID.String(),
+		RefundedAmount:  event.RefundedAmount,
+		RefundedAt:      timestamppb.New(event.RefundedAt),
+	}
+
+	// Преобразуем структуру в слайс байт для передачи в Kafka
+	payload, err := proto.Marshal(msg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal protobuf: %w", err)
+	}
+
+	return payload, nil
+}
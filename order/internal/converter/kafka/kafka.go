@@ -5,3 +5,19 @@ import "github.com/crafty-ezhik/rocket-factory/order/internal/model"
 type OrderAssembledDecoder interface {
 	Decode(data []byte) (model.OrderAssembledEvent, error)
 }
+
+type OrderPaidEncoder interface {
+	Encode(event model.OrderPaidEvent) ([]byte, error)
+}
+
+type OrderRefundedEncoder interface {
+	Encode(event model.OrderRefundedEvent) ([]byte, error)
+}
+
+type OrderAssemblyFailedDecoder interface {
+	Decode(data []byte) (model.OrderAssemblyFailedEvent, error)
+}
+
+type AssemblyRequestedEncoder interface {
+	Encode(event model.AssemblyRequestedEvent) ([]byte, error)
+}
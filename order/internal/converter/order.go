@@ -13,7 +13,8 @@ func OrderToHTTP(order model.Order) *orderV1.OrderDto {
 	return &orderV1.OrderDto{
 		OrderUUID:       order.UUID,
 		UserUUID:        order.UserUUID,
-		PartUuids:       order.PartUUIDs,
+		Items:           orderItemsToHTTP(order.Items),
+		PartUuids:       partUUIDsToHTTP(order.Items),
 		TotalPrice:      order.TotalPrice,
 		TransactionUUID: transactionUUIDToHTTP(order.TransactionUUID),
 		PaymentMethod:   paymentMethodToHTTP(order.PaymentMethod),
@@ -23,6 +24,55 @@ func OrderToHTTP(order model.Order) *orderV1.OrderDto {
 	}
 }
 
+// OrderItemsToServiceModel - конвертирует позиции запроса на создание заказа.
+//
+//	Для клиентов, передающих устаревший part_uuids, каждое вхождение детали считается одной штукой
+func OrderItemsToServiceModel(req *orderV1.CreateOrderRequest) []model.OrderItem {
+	if len(req.Items) > 0 {
+		items := make([]model.OrderItem, len(req.Items))
+		for i, item := range req.Items {
+			items[i] = model.OrderItem{
+				PartUUID: item.PartUUID,
+				Quantity: item.Quantity,
+			}
+		}
+		return items
+	}
+
+	items := make([]model.OrderItem, len(req.PartUuids))
+	for i, partUUID := range req.PartUuids {
+		items[i] = model.OrderItem{
+			PartUUID: partUUID,
+			Quantity: 1,
+		}
+	}
+	return items
+}
+
+func orderItemsToHTTP(items []model.OrderItem) []orderV1.OrderItem {
+	result := make([]orderV1.OrderItem, len(items))
+	for i, item := range items {
+		result[i] = orderV1.OrderItem{
+			PartUUID:  item.PartUUID,
+			Quantity:  item.Quantity,
+			UnitPrice: item.UnitPrice,
+			Name:      item.Name,
+		}
+	}
+	return result
+}
+
+// partUUIDsToHTTP - разворачивает позиции в устаревший массив part_uuids, повторяя деталь по количеству
+func partUUIDsToHTTP(items []model.OrderItem) []uuid.UUID {
+	result := make([]uuid.UUID, 0, len(items))
+	for _, item := range items {
+		for range item.Quantity {
+			result = append(result, item.PartUUID)
+		}
+	}
+	return result
+}
+
 func transactionUUIDToHTTP(transactionUUID uuid.UUID) orderV1.OptNilUUID {
 	return orderV1.OptNilUUID{
 		Value: transactionUUID,
@@ -61,6 +111,10 @@ func orderStatusToHTTP(status model.OrderStatus) orderV1.OrderStatus {
 		return orderV1.OrderStatusCANCELLED
 	case model.OrderStatusASSEMBLED:
 		return orderV1.OrderStatusASSEMBLED
+	case model.OrderStatusREFUNDED:
+		return orderV1.OrderStatusREFUNDED
+	case model.OrderStatusASSEMBLYFAILED:
+		return orderV1.OrderStatusASSEMBLYFAILED
 	default:
 		return orderV1.OrderStatusPENDINGPAYMENT
 	}
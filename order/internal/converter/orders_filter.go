@@ -0,0 +1,116 @@
+package converter
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/crafty-ezhik/rocket-factory/order/internal/model"
+	orderV1 "github.com/crafty-ezhik/rocket-factory/shared/pkg/openapi/order/v1"
+)
+
+// ordersCursor - представление курсора в ответе API
+type ordersCursor struct {
+	Sort       string    `json:"s"`
+	CreatedAt  time.Time `json:"c"`
+	TotalPrice float64   `json:"t"`
+	OrderUUID  uuid.UUID `json:"u"`
+}
+
+// OrdersFilterToServiceModel - конвертирует параметры запроса списка заказов в фильтр сервисного слоя
+func OrdersFilterToServiceModel(params orderV1.OrderListParams) (model.OrdersFilter, error) {
+	filter := model.OrdersFilter{
+		Sort: model.OrderSortCREATEDATDESC,
+	}
+
+	if len(params.Status) > 0 {
+		filter.Statuses = make([]model.OrderStatus, len(params.Status))
+		for i, status := range params.Status {
+			filter.Statuses[i] = OrderStatusToService(status)
+		}
+	}
+	if len(params.PaymentMethod) > 0 {
+		filter.PaymentMethods = make([]model.PaymentMethod, len(params.PaymentMethod))
+		for i, paymentMethod := range params.PaymentMethod {
+			filter.PaymentMethods[i] = model.PaymentMethod(paymentMethod)
+		}
+	}
+	if userUUID, ok := params.UserUUID.Get(); ok {
+		filter.UserUUID = &userUUID
+	}
+	if createdFrom, ok := params.CreatedFrom.Get(); ok {
+		filter.CreatedFrom = &createdFrom
+	}
+	if createdTo, ok := params.CreatedTo.Get(); ok {
+		filter.CreatedTo = &createdTo
+	}
+	if minTotal, ok := params.MinTotal.Get(); ok {
+		filter.MinTotal = &minTotal
+	}
+	if maxTotal, ok := params.MaxTotal.Get(); ok {
+		filter.MaxTotal = &maxTotal
+	}
+	if sort, ok := params.Sort.Get(); ok {
+		filter.Sort = model.OrderSort(sort)
+	}
+	if limit, ok := params.Limit.Get(); ok {
+		filter.Limit = uint64(limit)
+	}
+	if cursor, ok := params.Cursor.Get(); ok {
+		decoded, err := OrdersCursorToServiceModel(cursor)
+		if err != nil {
+			return model.OrdersFilter{}, err
+		}
+		filter.Cursor = &decoded
+	}
+	return filter, nil
+}
+
+// OrdersPageToHTTP - конвертирует страницу заказов в ответ API
+func OrdersPageToHTTP(page model.OrdersPage) *orderV1.ListOrdersResponse {
+	orders := make([]orderV1.OrderDto, len(page.Orders))
+	for i, order := range page.Orders {
+		orders[i] = *OrderToHTTP(order)
+	}
+
+	resp := &orderV1.ListOrdersResponse{
+		Orders: orders,
+	}
+	if page.NextCursor != nil {
+		resp.NextCursor = orderV1.NewOptString(OrdersCursorToHTTP(*page.NextCursor))
+	}
+	return resp
+}
+
+// OrdersCursorToHTTP - кодирует курсор в непрозрачную для клиента строку
+func OrdersCursorToHTTP(cursor model.OrdersCursor) string {
+	data, _ := json.Marshal(ordersCursor{
+		Sort:       cursor.Sort.String(),
+		CreatedAt:  cursor.CreatedAt,
+		TotalPrice: cursor.TotalPrice,
+		OrderUUID:  cursor.OrderUUID,
+	})
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// OrdersCursorToServiceModel - декодирует курсор, полученный от клиента
+func OrdersCursorToServiceModel(cursor string) (model.OrdersCursor, error) {
+	data, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return model.OrdersCursor{}, model.ErrInvalidOrdersCursor
+	}
+
+	var decoded ordersCursor
+	if err = json.Unmarshal(data, &decoded); err != nil || decoded.OrderUUID == uuid.Nil {
+		return model.OrdersCursor{}, model.ErrInvalidOrdersCursor
+	}
+
+	return model.OrdersCursor{
+		Sort:       model.OrderSort(decoded.Sort),
+		CreatedAt:  decoded.CreatedAt,
+		TotalPrice: decoded.TotalPrice,
+		OrderUUID:  decoded.OrderUUID,
+	}, nil
+}
@@ -21,8 +21,38 @@ const (
 	OrderStatusPAID           OrderStatus = "PAID"
 	OrderStatusCANCELLED      OrderStatus = "CANCELLED"
 	OrderStatusASSEMBLED      OrderStatus = "ASSEMBLED"
+	OrderStatusREFUNDED       OrderStatus = "REFUNDED"
+	// OrderStatusASSEMBLYFAILED - сборка завершилась ошибкой, заказ ждет повторной сборки или возврата средств
+	OrderStatusASSEMBLYFAILED OrderStatus = "ASSEMBLY_FAILED"
 )
 
 func (s OrderStatus) String() string {
 	return string(s)
 }
+
+// AssemblyFailurePolicy - что делать с заказом, сборка которого завершилась ошибкой
+type AssemblyFailurePolicy string
+
+const (
+	// AssemblyFailurePolicyREFUND - сразу вернуть средства
+	AssemblyFailurePolicyREFUND AssemblyFailurePolicy = "refund"
+	// AssemblyFailurePolicyREASSEMBLE - запросить повторную сборку, а после исчерпания попыток вернуть средства
+	AssemblyFailurePolicyREASSEMBLE AssemblyFailurePolicy = "reassemble"
+)
+
+func (p AssemblyFailurePolicy) String() string {
+	return string(p)
+}
+
+type OrderSort string
+
+const (
+	OrderSortCREATEDATDESC  OrderSort = "CREATED_AT_DESC"
+	OrderSortCREATEDATASC   OrderSort = "CREATED_AT_ASC"
+	OrderSortTOTALPRICEDESC OrderSort = "TOTAL_PRICE_DESC"
+	OrderSortTOTALPRICEASC  OrderSort = "TOTAL_PRICE_ASC"
+)
+
+func (s OrderSort) String() string {
+	return string(s)
+}
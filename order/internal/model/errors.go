@@ -8,4 +8,23 @@ var (
 	ErrOrderIsCancel     = errors.New("order has already been cancelled")
 	ErrOrderCannotPay    = errors.New("order has already been paid or cancelled")
 	ErrOrderPartNotFound = errors.New("part not found")
+	ErrOrderAccessDenied = errors.New("access to the order is denied")
+
+	ErrOrderEmpty           = errors.New("order must contain at least one part")
+	ErrOrderInvalidQuantity = errors.New("part quantity must be positive")
+
+	ErrOrderPartsOutOfStock    = errors.New("parts out of stock")
+	ErrOrderReservationExpired = errors.New("parts reservation has expired")
+
+	ErrPaymentInvalid     = errors.New("payment request rejected")
+	ErrPaymentDeclined    = errors.New("payment declined")
+	ErrPaymentConflict    = errors.New("order payment conflict")
+	ErrPaymentUnavailable = errors.New("payment service unavailable")
+
+	ErrOrderInvalidTransition = errors.New("order status transition is not allowed")
+	ErrOrderStatusConflict    = errors.New("order status has been changed concurrently")
+	ErrOrderNoTransaction     = errors.New("order has no payment transaction")
+
+	ErrInvalidOrdersFilter = errors.New("invalid orders filter")
+	ErrInvalidOrdersCursor = errors.New("invalid orders cursor")
 )
@@ -1,6 +1,8 @@
 package model
 
 import (
+	"time"
+
 	"github.com/google/uuid"
 )
 
@@ -10,6 +12,25 @@ type OrderPaidEvent struct {
 	UserUUID        uuid.UUID
 	PaymentMethod   string
 	TransactionUUID uuid.UUID
+	PaidAt          time.Time
+	Parts           []AssemblyPart
+}
+
+// AssemblyPart - деталь заказа с характеристиками, от которых зависит время сборки
+type AssemblyPart struct {
+	PartUUID   uuid.UUID
+	Quantity   int64
+	Category   string
+	Dimensions *Dimensions
+}
+
+type OrderRefundedEvent struct {
+	EventUUID       uuid.UUID
+	OrderUUID       uuid.UUID
+	UserUUID        uuid.UUID
+	TransactionUUID uuid.UUID
+	RefundedAmount  float64
+	RefundedAt      time.Time
 }
 
 type OrderAssembledEvent struct {
@@ -18,3 +39,23 @@ type OrderAssembledEvent struct {
 	UserUUID     uuid.UUID
 	BuildTimeSec int
 }
+
+// OrderAssemblyFailedEvent - сборка заказа завершилась ошибкой на этапе Stage
+type OrderAssemblyFailedEvent struct {
+	EventUUID uuid.UUID
+	OrderUUID uuid.UUID
+	UserUUID  uuid.UUID
+	Stage     string
+	Reason    string
+	Attempt   int
+	FailedAt  time.Time
+}
+
+// AssemblyRequestedEvent - запрос повторной сборки заказа после ошибки
+type AssemblyRequestedEvent struct {
+	EventUUID uuid.UUID
+	OrderUUID uuid.UUID
+	UserUUID  uuid.UUID
+	Attempt   int
+	Parts     []AssemblyPart
+}
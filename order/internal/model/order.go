@@ -9,7 +9,7 @@ import (
 type Order struct {
 	UUID            uuid.UUID
 	UserUUID        uuid.UUID
-	PartUUIDs       []uuid.UUID
+	Items           []OrderItem
 	TotalPrice      float64
 	TransactionUUID uuid.UUID
 	PaymentMethod   PaymentMethod
@@ -18,6 +18,14 @@ type Order struct {
 	UpdatedAt       *time.Time
 }
 
+// OrderItem - позиция заказа со снимком цены и названия детали на момент создания заказа
+type OrderItem struct {
+	PartUUID  uuid.UUID
+	Quantity  int64
+	UnitPrice float64
+	Name      string
+}
+
 type UpdateOrderInfo struct {
 	UUID            uuid.UUID
 	TransactionUUID uuid.UUID
@@ -0,0 +1,114 @@
+package model
+
+import (
+	"fmt"
+
+	"github.com/google/uuid"
+)
+
+// OrderTrigger - событие жизненного цикла, по которому заказ переходит в другой статус
+type OrderTrigger string
+
+const (
+	OrderTriggerCREATE OrderTrigger = "CREATE"
+	// OrderTriggerPAY - оплата проведена и сборка запрошена, детали списываются следующим шагом саги
+	OrderTriggerPAY    OrderTrigger = "PAY"
+	OrderTriggerCANCEL OrderTrigger = "CANCEL"
+	// OrderTriggerASSEMBLYCOMPLETE - AssemblyService сообщил об успешной сборке
+	OrderTriggerASSEMBLYCOMPLETE OrderTrigger = "ASSEMBLY_COMPLETE"
+	// OrderTriggerASSEMBLYFAIL - AssemblyService сообщил об ошибке сборки
+	OrderTriggerASSEMBLYFAIL OrderTrigger = "ASSEMBLY_FAIL"
+	// OrderTriggerREFUND - компенсация оплаты: средства возвращены пользователю
+	OrderTriggerREFUND OrderTrigger = "REFUND"
+)
+
+func (t OrderTrigger) String() string {
+	return string(t)
+}
+
+// OrderTransition - переход заказа между статусами, сохраняется в историю вместе с изменением заказа
+type OrderTransition struct {
+	OrderUUID uuid.UUID
+	// From - пустой статус у перехода создания заказа
+	From    OrderStatus
+	To      OrderStatus
+	Trigger OrderTrigger
+	Reason  string
+}
+
+// orderTransitionRule - целевой статус перехода и условие, которому должен удовлетворять заказ
+type orderTransitionRule struct {
+	to    OrderStatus
+	guard func(order Order) error
+}
+
+// orderTransitions - таблица допустимых переходов: из статуса по событию.
+//
+//	Все остальные переходы запрещены, в том числе из финальных CANCELLED, REFUNDED и ASSEMBLED.
+//	Повторная ошибка сборки оставляет заказ в ASSEMBLY_FAILED, но попадает в историю
+var orderTransitions = map[OrderStatus]map[OrderTrigger]orderTransitionRule{
+	"": {
+		OrderTriggerCREATE: {to: OrderStatusPENDINGPAYMENT, guard: guardOrderHasItems},
+	},
+	OrderStatusPENDINGPAYMENT: {
+		OrderTriggerPAY:    {to: OrderStatusPAID, guard: guardOrderHasTransaction},
+		OrderTriggerCANCEL: {to: OrderStatusCANCELLED},
+	},
+	OrderStatusPAID: {
+		OrderTriggerASSEMBLYCOMPLETE: {to: OrderStatusASSEMBLED},
+		OrderTriggerASSEMBLYFAIL:     {to: OrderStatusASSEMBLYFAILED},
+		OrderTriggerREFUND:           {to: OrderStatusREFUNDED, guard: guardOrderHasTransaction},
+	},
+	OrderStatusASSEMBLYFAILED: {
+		OrderTriggerASSEMBLYCOMPLETE: {to: OrderStatusASSEMBLED},
+		OrderTriggerASSEMBLYFAIL:     {to: OrderStatusASSEMBLYFAILED},
+		OrderTriggerREFUND:           {to: OrderStatusREFUNDED, guard: guardOrderHasTransaction},
+	},
+}
+
+// CanTransition - проверяет по таблице переходов, допускает ли статус событие, без проверки условий
+func CanTransition(from OrderStatus, trigger OrderTrigger) bool {
+	_, ok := orderTransitions[from][trigger]
+	return ok
+}
+
+// Transition - переводит заказ в новый статус по событию и возвращает переход для сохранения в историю.
+//
+//	Недопустимый переход или невыполненное условие возвращают ErrOrderInvalidTransition, статус при этом не меняется
+func (o *Order) Transition(trigger OrderTrigger, reason string) (OrderTransition, error) {
+	rule, ok := orderTransitions[o.Status][trigger]
+	if !ok {
+		return OrderTransition{}, fmt.Errorf("%w: %s from status %q", ErrOrderInvalidTransition, trigger, o.Status)
+	}
+
+	if rule.guard != nil {
+		if err := rule.guard(*o); err != nil {
+			return OrderTransition{}, fmt.Errorf("%w: %s from status %q: %w", ErrOrderInvalidTransition, trigger, o.Status, err)
+		}
+	}
+
+	transition := OrderTransition{
+		OrderUUID: o.UUID,
+		From:      o.Status,
+		To:        rule.to,
+		Trigger:   trigger,
+		Reason:    reason,
+	}
+	o.Status = rule.to
+
+	return transition, nil
+}
+
+func guardOrderHasItems(order Order) error {
+	if len(order.Items) == 0 {
+		return ErrOrderEmpty
+	}
+	return nil
+}
+
+func guardOrderHasTransaction(order Order) error {
+	if order.TransactionUUID == uuid.Nil {
+		return ErrOrderNoTransaction
+	}
+	return nil
+}
@@ -0,0 +1,152 @@
+package model
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+)
+
+type OrderStateSuite struct {
+	suite.Suite
+}
+
+func TestOrderState(t *testing.T) {
+	suite.Run(t, new(OrderStateSuite))
+}
+
+var (
+	allStatuses = []OrderStatus{
+		"",
+		OrderStatusPENDINGPAYMENT,
+		OrderStatusPAID,
+		OrderStatusCANCELLED,
+		OrderStatusASSEMBLED,
+		OrderStatusREFUNDED,
+		OrderStatusASSEMBLYFAILED,
+	}
+	allTriggers = []OrderTrigger{
+		OrderTriggerCREATE,
+		OrderTriggerPAY,
+		OrderTriggerCANCEL,
+		OrderTriggerASSEMBLYCOMPLETE,
+		OrderTriggerASSEMBLYFAIL,
+		OrderTriggerREFUND,
+	}
+)
+
+// validOrder - заказ, который проходит все условия переходов
+func validOrder(status OrderStatus) Order {
+	return Order{
+		UUID:            uuid.New(),
+		Status:          status,
+		TransactionUUID: uuid.New(),
+		Items:           []OrderItem{{PartUUID: uuid.New(), Quantity: 1}},
+	}
+}
+
+func (s *OrderStateSuite) TestTransitions() {
+	// expected - полная таблица допустимых переходов, все остальные пары статус/событие запрещены
+	expected := map[OrderStatus]map[OrderTrigger]OrderStatus{
+		"": {
+			OrderTriggerCREATE: OrderStatusPENDINGPAYMENT,
+		},
+		OrderStatusPENDINGPAYMENT: {
+			OrderTriggerPAY:    OrderStatusPAID,
+			OrderTriggerCANCEL: OrderStatusCANCELLED,
+		},
+		OrderStatusPAID: {
+			OrderTriggerASSEMBLYCOMPLETE: OrderStatusASSEMBLED,
+			OrderTriggerASSEMBLYFAIL:     OrderStatusASSEMBLYFAILED,
+			OrderTriggerREFUND:           OrderStatusREFUNDED,
+		},
+		OrderStatusASSEMBLYFAILED: {
+			OrderTriggerASSEMBLYCOMPLETE: OrderStatusASSEMBLED,
+			OrderTriggerASSEMBLYFAIL:     OrderStatusASSEMBLYFAILED,
+			OrderTriggerREFUND:           OrderStatusREFUNDED,
+		},
+	}
+
+	for _, from := range allStatuses {
+		for _, trigger := range allTriggers {
+			to, allowed := expected[from][trigger]
+
+			s.Run(string(from)+"/"+trigger.String(), func() {
+				order := validOrder(from)
+
+				s.Equal(allowed, CanTransition(from, trigger))
+
+				transition, err := order.Transition(trigger, "reason")
+				if !allowed {
+					s.Require().ErrorIs(err, ErrOrderInvalidTransition)
+					s.Equal(from, order.Status)
+					return
+				}
+
+				s.Require().NoError(err)
+				s.Equal(to, order.Status)
+				s.Equal(OrderTransition{
+					OrderUUID: order.UUID,
+					From:      from,
+					To:        to,
+					Trigger:   trigger,
+					Reason:    "reason",
+				}, transition)
+			})
+		}
+	}
+}
+
+func (s *OrderStateSuite) TestGuards() {
+	tests := []struct {
+		name        string
+		from        OrderStatus
+		trigger     OrderTrigger
+		modify      func(order *Order)
+		expectedErr error
+	}{
+		{
+			name:        "create without items",
+			from:        "",
+			trigger:     OrderTriggerCREATE,
+			modify:      func(order *Order) { order.Items = nil },
+			expectedErr: ErrOrderEmpty,
+		},
+		{
+			name:        "pay without transaction",
+			from:        OrderStatusPENDINGPAYMENT,
+			trigger:     OrderTriggerPAY,
+			modify:      func(order *Order) { order.TransactionUUID = uuid.Nil },
+			expectedErr: ErrOrderNoTransaction,
+		},
+		{
+			name:        "refund paid order without transaction",
+			from:        OrderStatusPAID,
+			trigger:     OrderTriggerREFUND,
+			modify:      func(order *Order) { order.TransactionUUID = uuid.Nil },
+			expectedErr: ErrOrderNoTransaction,
+		},
+		{
+			name:        "refund failed assembly without transaction",
+			from:        OrderStatusASSEMBLYFAILED,
+			trigger:     OrderTriggerREFUND,
+			modify:      func(order *Order) { order.TransactionUUID = uuid.Nil },
+			expectedErr: ErrOrderNoTransaction,
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			order := validOrder(tt.from)
+			tt.modify(&order)
+
+			// Условие проверяет только Transition, таблица переходов переход допускает
+			s.True(CanTransition(tt.from, tt.trigger))
+
+			_, err := order.Transition(tt.trigger, "")
+			s.Require().ErrorIs(err, ErrOrderInvalidTransition)
+			s.Require().ErrorIs(err, tt.expectedErr)
+			s.Equal(tt.from, order.Status)
+		})
+	}
+}
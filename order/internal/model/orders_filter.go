@@ -0,0 +1,35 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// OrdersFilter - параметры поиска заказов. Пустые поля не участвуют в фильтрации
+type OrdersFilter struct {
+	UserUUID       *uuid.UUID
+	Statuses       []OrderStatus
+	PaymentMethods []PaymentMethod
+	CreatedFrom    *time.Time
+	CreatedTo      *time.Time
+	MinTotal       *float64
+	MaxTotal       *float64
+	Sort           OrderSort
+	Limit          uint64
+	Cursor         *OrdersCursor
+}
+
+// OrdersCursor - позиция последнего заказа на странице для keyset-пагинации
+type OrdersCursor struct {
+	Sort       OrderSort
+	CreatedAt  time.Time
+	TotalPrice float64
+	OrderUUID  uuid.UUID
+}
+
+// OrdersPage - страница заказов. NextCursor равен nil на последней странице
+type OrdersPage struct {
+	Orders     []Order
+	NextCursor *OrdersCursor
+}
@@ -0,0 +1,46 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// EventType - тип события, по которому relay выбирает producer
+type EventType string
+
+const (
+	EventTypeOrderPaid     EventType = "ORDER_PAID"
+	EventTypeOrderRefunded EventType = "ORDER_REFUNDED"
+	// EventTypeAssemblyRequested - запрос повторной сборки после ошибки
+	EventTypeAssemblyRequested EventType = "ASSEMBLY_REQUESTED"
+)
+
+func (t EventType) String() string {
+	return string(t)
+}
+
+type OutboxStatus string
+
+const (
+	OutboxStatusPENDING OutboxStatus = "PENDING"
+	OutboxStatusSENT    OutboxStatus = "SENT"
+	// OutboxStatusFAILED - событие не удалось отправить за все попытки, relay его больше не отправляет
+	OutboxStatusFAILED OutboxStatus = "FAILED"
+)
+
+func (s OutboxStatus) String() string {
+	return string(s)
+}
+
+// OutboxMessage - событие, сохраненное в outbox в одной транзакции с изменением заказа
+type OutboxMessage struct {
+	ID        int64
+	EventUUID uuid.UUID
+	EventType EventType
+	Key       []byte
+	Payload   []byte
+	Status    OutboxStatus
+	Attempts  int
+	CreatedAt time.Time
+}
@@ -0,0 +1,32 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ReservationItem - деталь и ее количество для резервирования на складе
+type ReservationItem struct {
+	PartUUID uuid.UUID
+	Quantity int64
+}
+
+type ReservationStatus string
+
+const (
+	ReservationStatusACTIVE    ReservationStatus = "ACTIVE"
+	ReservationStatusCOMMITTED ReservationStatus = "COMMITTED"
+	ReservationStatusRELEASED  ReservationStatus = "RELEASED"
+)
+
+// Reservation - резерв деталей заказа на складе
+type Reservation struct {
+	Status    ReservationStatus
+	ExpiresAt time.Time
+}
+
+// ActiveUntil - резерв не подтвержден, не снят и не истечет до момента t
+func (r Reservation) ActiveUntil(t time.Time) bool {
+	return r.Status == ReservationStatusACTIVE && r.ExpiresAt.After(t)
+}
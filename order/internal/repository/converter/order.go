@@ -9,7 +9,7 @@ func OrderToServiceModel(order repoModel.Order) serviceModel.Order {
 	return serviceModel.Order{
 		UUID:            order.UUID,
 		UserUUID:        order.UserUUID,
-		PartUUIDs:       order.PartUUIDs,
+		Items:           orderItemsToServiceModel(order.Items),
 		TotalPrice:      order.TotalPrice,
 		TransactionUUID: order.TransactionUUID,
 		PaymentMethod:   order.PaymentMethod,
@@ -23,7 +23,7 @@ func OrderToRepoModel(order serviceModel.Order) repoModel.Order {
 	return repoModel.Order{
 		UUID:            order.UUID,
 		UserUUID:        order.UserUUID,
-		PartUUIDs:       order.PartUUIDs,
+		Items:           orderItemsToRepoModel(order.Items),
 		TotalPrice:      order.TotalPrice,
 		TransactionUUID: order.TransactionUUID,
 		PaymentMethod:   order.PaymentMethod,
@@ -32,3 +32,29 @@ func OrderToRepoModel(order serviceModel.Order) repoModel.Order {
 		UpdatedAt:       order.UpdatedAt,
 	}
 }
+
+func orderItemsToServiceModel(items []repoModel.OrderItem) []serviceModel.OrderItem {
+	result := make([]serviceModel.OrderItem, len(items))
+	for i, item := range items {
+		result[i] = serviceModel.OrderItem{
+			PartUUID:  item.PartUUID,
+			Quantity:  item.Quantity,
+			UnitPrice: item.UnitPrice,
+			Name:      item.Name,
+		}
+	}
+	return result
+}
+
+func orderItemsToRepoModel(items []serviceModel.OrderItem) []repoModel.OrderItem {
+	result := make([]repoModel.OrderItem, len(items))
+	for i, item := range items {
+		result[i] = repoModel.OrderItem{
+			PartUUID:  item.PartUUID,
+			Quantity:  item.Quantity,
+			UnitPrice: item.UnitPrice,
+			Name:      item.Name,
+		}
+	}
+	return result
+}
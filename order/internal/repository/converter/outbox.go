@@ -0,0 +1,32 @@
+package converter
+
+import (
+	serviceModel "github.com/crafty-ezhik/rocket-factory/order/internal/model"
+	repoModel "github.com/crafty-ezhik/rocket-factory/order/internal/repository/model"
+)
+
+func OutboxMessageToServiceModel(msg repoModel.OutboxMessage) serviceModel.OutboxMessage {
+	return serviceModel.OutboxMessage{
+		ID:        msg.ID,
+		EventUUID: msg.EventUUID,
+		EventType: msg.EventType,
+		Key:       msg.Key,
+		Payload:   msg.Payload,
+		Status:    msg.Status,
+		Attempts:  msg.Attempts,
+		CreatedAt: msg.CreatedAt,
+	}
+}
+
+func OutboxMessageToRepoModel(msg serviceModel.OutboxMessage) repoModel.OutboxMessage {
+	return repoModel.OutboxMessage{
+		ID:        msg.ID,
+		EventUUID: msg.EventUUID,
+		EventType: msg.EventType,
+		Key:       msg.Key,
+		Payload:   msg.Payload,
+		Status:    msg.Status,
+		Attempts:  msg.Attempts,
+		CreatedAt: msg.CreatedAt,
+	}
+}
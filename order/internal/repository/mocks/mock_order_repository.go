@@ -43,8 +43,8 @@ func (_m *MockOrderRepository) EXPECT() *MockOrderRepository_Expecter {
 }
 
 // Create provides a mock function for the type MockOrderRepository
-func (_mock *MockOrderRepository) Create(ctx context.Context, order model.Order) (uuid.UUID, error) {
-	ret := _mock.Called(ctx, order)
+func (_mock *MockOrderRepository) Create(ctx context.Context, order model.Order, transition model.OrderTransition) (uuid.UUID, error) {
+	ret := _mock.Called(ctx, order, transition)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Create")
@@ -52,18 +52,18 @@ func (_mock *MockOrderRepository) Create(ctx context.Context, order model.Order)
 
 	var r0 uuid.UUID
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, model.Order) (uuid.UUID, error)); ok {
-		return returnFunc(ctx, order)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, model.Order, model.OrderTransition) (uuid.UUID, error)); ok {
+		return returnFunc(ctx, order, transition)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, model.Order) uuid.UUID); ok {
-		r0 = returnFunc(ctx, order)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, model.Order, model.OrderTransition) uuid.UUID); ok {
+		r0 = returnFunc(ctx, order, transition)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(uuid.UUID)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, model.Order) error); ok {
-		r1 = returnFunc(ctx, order)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, model.Order, model.OrderTransition) error); ok {
+		r1 = returnFunc(ctx, order, transition)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -78,11 +78,12 @@ type MockOrderRepository_Create_Call struct {
 // Create is a helper method to define mock.On call
 //   - ctx context.Context
 //   - order model.Order
-func (_e *MockOrderRepository_Expecter) Create(ctx interface{}, order interface{}) *MockOrderRepository_Create_Call {
-	return &MockOrderRepository_Create_Call{Call: _e.mock.On("Create", ctx, order)}
+//   - transition model.OrderTransition
+func (_e *MockOrderRepository_Expecter) Create(ctx interface{}, order interface{}, transition interface{}) *MockOrderRepository_Create_Call {
+	return &MockOrderRepository_Create_Call{Call: _e.mock.On("Create", ctx, order, transition)}
 }
 
-func (_c *MockOrderRepository_Create_Call) Run(run func(ctx context.Context, order model.Order)) *MockOrderRepository_Create_Call {
+func (_c *MockOrderRepository_Create_Call) Run(run func(ctx context.Context, order model.Order, transition model.OrderTransition)) *MockOrderRepository_Create_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -92,9 +93,14 @@ func (_c *MockOrderRepository_Create_Call) Run(run func(ctx context.Context, ord
 		if args[1] != nil {
 			arg1 = args[1].(model.Order)
 		}
+		var arg2 model.OrderTransition
+		if args[2] != nil {
+			arg2 = args[2].(model.OrderTransition)
+		}
 		run(
 			arg0,
 			arg1,
+			arg2,
 		)
 	})
 	return _c
@@ -105,7 +111,7 @@ func (_c *MockOrderRepository_Create_Call) Return(uUID uuid.UUID, err error) *Mo
 	return _c
 }
 
-func (_c *MockOrderRepository_Create_Call) RunAndReturn(run func(ctx context.Context, order model.Order) (uuid.UUID, error)) *MockOrderRepository_Create_Call {
+func (_c *MockOrderRepository_Create_Call) RunAndReturn(run func(ctx context.Context, order model.Order, transition model.OrderTransition) (uuid.UUID, error)) *MockOrderRepository_Create_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -176,36 +182,115 @@ func (_c *MockOrderRepository_Get_Call) RunAndReturn(run func(ctx context.Contex
 	return _c
 }
 
-// Update provides a mock function for the type MockOrderRepository
-func (_mock *MockOrderRepository) Update(ctx context.Context, order model.Order) error {
-	ret := _mock.Called(ctx, order)
+// List provides a mock function for the type MockOrderRepository
+func (_mock *MockOrderRepository) List(ctx context.Context, filter model.OrdersFilter) ([]model.Order, error) {
+	ret := _mock.Called(ctx, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for List")
+	}
+
+	var r0 []model.Order
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, model.OrdersFilter) ([]model.Order, error)); ok {
+		return returnFunc(ctx, filter)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, model.OrdersFilter) []model.Order); ok {
+		r0 = returnFunc(ctx, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.Order)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, model.OrdersFilter) error); ok {
+		r1 = returnFunc(ctx, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockOrderRepository_List_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'List'
+type MockOrderRepository_List_Call struct {
+	*mock.Call
+}
+
+// List is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filter model.OrdersFilter
+func (_e *MockOrderRepository_Expecter) List(ctx interface{}, filter interface{}) *MockOrderRepository_List_Call {
+	return &MockOrderRepository_List_Call{Call: _e.mock.On("List", ctx, filter)}
+}
+
+func (_c *MockOrderRepository_List_Call) Run(run func(ctx context.Context, filter model.OrdersFilter)) *MockOrderRepository_List_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 model.OrdersFilter
+		if args[1] != nil {
+			arg1 = args[1].(model.OrdersFilter)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockOrderRepository_List_Call) Return(orders []model.Order, err error) *MockOrderRepository_List_Call {
+	_c.Call.Return(orders, err)
+	return _c
+}
+
+func (_c *MockOrderRepository_List_Call) RunAndReturn(run func(ctx context.Context, filter model.OrdersFilter) ([]model.Order, error)) *MockOrderRepository_List_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Transition provides a mock function for the type MockOrderRepository
+func (_mock *MockOrderRepository) Transition(ctx context.Context, order model.Order, transition model.OrderTransition, messages ...model.OutboxMessage) error {
+	// model.OutboxMessage
+	_va := make([]interface{}, len(messages))
+	for _i := range messages {
+		_va[_i] = messages[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, order, transition)
+	_ca = append(_ca, _va...)
+	ret := _mock.Called(_ca...)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Update")
+		panic("no return value specified for Transition")
 	}
 
 	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, model.Order) error); ok {
-		r0 = returnFunc(ctx, order)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, model.Order, model.OrderTransition, ...model.OutboxMessage) error); ok {
+		r0 = returnFunc(ctx, order, transition, messages...)
 	} else {
 		r0 = ret.Error(0)
 	}
 	return r0
 }
 
-// MockOrderRepository_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
-type MockOrderRepository_Update_Call struct {
+// MockOrderRepository_Transition_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Transition'
+type MockOrderRepository_Transition_Call struct {
 	*mock.Call
 }
 
-// Update is a helper method to define mock.On call
+// Transition is a helper method to define mock.On call
 //   - ctx context.Context
 //   - order model.Order
-func (_e *MockOrderRepository_Expecter) Update(ctx interface{}, order interface{}) *MockOrderRepository_Update_Call {
-	return &MockOrderRepository_Update_Call{Call: _e.mock.On("Update", ctx, order)}
+//   - transition model.OrderTransition
+//   - messages ...model.OutboxMessage
+func (_e *MockOrderRepository_Expecter) Transition(ctx interface{}, order interface{}, transition interface{}, messages ...interface{}) *MockOrderRepository_Transition_Call {
+	return &MockOrderRepository_Transition_Call{Call: _e.mock.On("Transition",
+		append([]interface{}{ctx, order, transition}, messages...)...)}
 }
 
-func (_c *MockOrderRepository_Update_Call) Run(run func(ctx context.Context, order model.Order)) *MockOrderRepository_Update_Call {
+func (_c *MockOrderRepository_Transition_Call) Run(run func(ctx context.Context, order model.Order, transition model.OrderTransition, messages ...model.OutboxMessage)) *MockOrderRepository_Transition_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -215,20 +300,34 @@ func (_c *MockOrderRepository_Update_Call) Run(run func(ctx context.Context, ord
 		if args[1] != nil {
 			arg1 = args[1].(model.Order)
 		}
+		var arg2 model.OrderTransition
+		if args[2] != nil {
+			arg2 = args[2].(model.OrderTransition)
+		}
+		var arg3 []model.OutboxMessage
+		variadicArgs := make([]model.OutboxMessage, len(args)-3)
+		for i, a := range args[3:] {
+			if a != nil {
+				variadicArgs[i] = a.(model.OutboxMessage)
+			}
+		}
+		arg3 = variadicArgs
 		run(
 			arg0,
 			arg1,
+			arg2,
+			arg3...,
 		)
 	})
 	return _c
 }
 
-func (_c *MockOrderRepository_Update_Call) Return(err error) *MockOrderRepository_Update_Call {
+func (_c *MockOrderRepository_Transition_Call) Return(err error) *MockOrderRepository_Transition_Call {
 	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockOrderRepository_Update_Call) RunAndReturn(run func(ctx context.Context, order model.Order) error) *MockOrderRepository_Update_Call {
+func (_c *MockOrderRepository_Transition_Call) RunAndReturn(run func(ctx context.Context, order model.Order, transition model.OrderTransition, messages ...model.OutboxMessage) error) *MockOrderRepository_Transition_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -0,0 +1,306 @@
+// Code generated for crafty-ezhik service
+// © Crafty-ezhik 2025.
+
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"context"
+	"time"
+
+	"github.com/crafty-ezhik/rocket-factory/order/internal/model"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockOutboxRepository creates a new instance of MockOutboxRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockOutboxRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockOutboxRepository {
+	mock := &MockOutboxRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockOutboxRepository is an autogenerated mock type for the OutboxRepository type
+type MockOutboxRepository struct {
+	mock.Mock
+}
+
+type MockOutboxRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockOutboxRepository) EXPECT() *MockOutboxRepository_Expecter {
+	return &MockOutboxRepository_Expecter{mock: &_m.Mock}
+}
+
+// FetchPending provides a mock function for the type MockOutboxRepository
+func (_mock *MockOutboxRepository) FetchPending(ctx context.Context, limit uint64, lockTimeout time.Duration) ([]model.OutboxMessage, error) {
+	ret := _mock.Called(ctx, limit, lockTimeout)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FetchPending")
+	}
+
+	var r0 []model.OutboxMessage
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uint64, time.Duration) ([]model.OutboxMessage, error)); ok {
+		return returnFunc(ctx, limit, lockTimeout)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uint64, time.Duration) []model.OutboxMessage); ok {
+		r0 = returnFunc(ctx, limit, lockTimeout)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.OutboxMessage)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uint64, time.Duration) error); ok {
+		r1 = returnFunc(ctx, limit, lockTimeout)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockOutboxRepository_FetchPending_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FetchPending'
+type MockOutboxRepository_FetchPending_Call struct {
+	*mock.Call
+}
+
+// FetchPending is a helper method to define mock.On call
+//   - ctx context.Context
+//   - limit uint64
+//   - lockTimeout time.Duration
+func (_e *MockOutboxRepository_Expecter) FetchPending(ctx interface{}, limit interface{}, lockTimeout interface{}) *MockOutboxRepository_FetchPending_Call {
+	return &MockOutboxRepository_FetchPending_Call{Call: _e.mock.On("FetchPending", ctx, limit, lockTimeout)}
+}
+
+func (_c *MockOutboxRepository_FetchPending_Call) Run(run func(ctx context.Context, limit uint64, lockTimeout time.Duration)) *MockOutboxRepository_FetchPending_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uint64
+		if args[1] != nil {
+			arg1 = args[1].(uint64)
+		}
+		var arg2 time.Duration
+		if args[2] != nil {
+			arg2 = args[2].(time.Duration)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockOutboxRepository_FetchPending_Call) Return(outboxMessages []model.OutboxMessage, err error) *MockOutboxRepository_FetchPending_Call {
+	_c.Call.Return(outboxMessages, err)
+	return _c
+}
+
+func (_c *MockOutboxRepository_FetchPending_Call) RunAndReturn(run func(ctx context.Context, limit uint64, lockTimeout time.Duration) ([]model.OutboxMessage, error)) *MockOutboxRepository_FetchPending_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MarkFailed provides a mock function for the type MockOutboxRepository
+func (_mock *MockOutboxRepository) MarkFailed(ctx context.Context, id int64, reason string) error {
+	ret := _mock.Called(ctx, id, reason)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarkFailed")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, string) error); ok {
+		r0 = returnFunc(ctx, id, reason)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockOutboxRepository_MarkFailed_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkFailed'
+type MockOutboxRepository_MarkFailed_Call struct {
+	*mock.Call
+}
+
+// MarkFailed is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+//   - reason string
+func (_e *MockOutboxRepository_Expecter) MarkFailed(ctx interface{}, id interface{}, reason interface{}) *MockOutboxRepository_MarkFailed_Call {
+	return &MockOutboxRepository_MarkFailed_Call{Call: _e.mock.On("MarkFailed", ctx, id, reason)}
+}
+
+func (_c *MockOutboxRepository_MarkFailed_Call) Run(run func(ctx context.Context, id int64, reason string)) *MockOutboxRepository_MarkFailed_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int64
+		if args[1] != nil {
+			arg1 = args[1].(int64)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockOutboxRepository_MarkFailed_Call) Return(err error) *MockOutboxRepository_MarkFailed_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockOutboxRepository_MarkFailed_Call) RunAndReturn(run func(ctx context.Context, id int64, reason string) error) *MockOutboxRepository_MarkFailed_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MarkRetry provides a mock function for the type MockOutboxRepository
+func (_mock *MockOutboxRepository) MarkRetry(ctx context.Context, id int64, reason string, backoff time.Duration) error {
+	ret := _mock.Called(ctx, id, reason, backoff)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarkRetry")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, string, time.Duration) error); ok {
+		r0 = returnFunc(ctx, id, reason, backoff)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockOutboxRepository_MarkRetry_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkRetry'
+type MockOutboxRepository_MarkRetry_Call struct {
+	*mock.Call
+}
+
+// MarkRetry is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+//   - reason string
+//   - backoff time.Duration
+func (_e *MockOutboxRepository_Expecter) MarkRetry(ctx interface{}, id interface{}, reason interface{}, backoff interface{}) *MockOutboxRepository_MarkRetry_Call {
+	return &MockOutboxRepository_MarkRetry_Call{Call: _e.mock.On("MarkRetry", ctx, id, reason, backoff)}
+}
+
+func (_c *MockOutboxRepository_MarkRetry_Call) Run(run func(ctx context.Context, id int64, reason string, backoff time.Duration)) *MockOutboxRepository_MarkRetry_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int64
+		if args[1] != nil {
+			arg1 = args[1].(int64)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 time.Duration
+		if args[3] != nil {
+			arg3 = args[3].(time.Duration)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockOutboxRepository_MarkRetry_Call) Return(err error) *MockOutboxRepository_MarkRetry_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockOutboxRepository_MarkRetry_Call) RunAndReturn(run func(ctx context.Context, id int64, reason string, backoff time.Duration) error) *MockOutboxRepository_MarkRetry_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MarkSent provides a mock function for the type MockOutboxRepository
+func (_mock *MockOutboxRepository) MarkSent(ctx context.Context, id int64) error {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarkSent")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) error); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockOutboxRepository_MarkSent_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkSent'
+type MockOutboxRepository_MarkSent_Call struct {
+	*mock.Call
+}
+
+// MarkSent is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+func (_e *MockOutboxRepository_Expecter) MarkSent(ctx interface{}, id interface{}) *MockOutboxRepository_MarkSent_Call {
+	return &MockOutboxRepository_MarkSent_Call{Call: _e.mock.On("MarkSent", ctx, id)}
+}
+
+func (_c *MockOutboxRepository_MarkSent_Call) Run(run func(ctx context.Context, id int64)) *MockOutboxRepository_MarkSent_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int64
+		if args[1] != nil {
+			arg1 = args[1].(int64)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockOutboxRepository_MarkSent_Call) Return(err error) *MockOutboxRepository_MarkSent_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockOutboxRepository_MarkSent_Call) RunAndReturn(run func(ctx context.Context, id int64) error) *MockOutboxRepository_MarkSent_Call {
+	_c.Call.Return(run)
+	return _c
+}
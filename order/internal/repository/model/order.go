@@ -11,7 +11,7 @@ import (
 type Order struct {
 	UUID            uuid.UUID
 	UserUUID        uuid.UUID
-	PartUUIDs       []uuid.UUID
+	Items           []OrderItem
 	TotalPrice      float64
 	TransactionUUID uuid.UUID
 	PaymentMethod   model.PaymentMethod
@@ -20,6 +20,13 @@ type Order struct {
 	UpdatedAt       *time.Time
 }
 
+type OrderItem struct {
+	PartUUID  uuid.UUID
+	Quantity  int64
+	UnitPrice float64
+	Name      string
+}
+
 type UpdateOrderInfo struct {
 	UUID            uuid.UUID
 	TransactionUUID uuid.UUID
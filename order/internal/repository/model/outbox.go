@@ -0,0 +1,20 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/crafty-ezhik/rocket-factory/order/internal/model"
+)
+
+type OutboxMessage struct {
+	ID        int64
+	EventUUID uuid.UUID
+	EventType model.EventType
+	Key       []byte
+	Payload   []byte
+	Status    model.OutboxStatus
+	Attempts  int
+	CreatedAt time.Time
+}
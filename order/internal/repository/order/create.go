@@ -6,6 +6,7 @@ import (
 
 	sq "github.com/Masterminds/squirrel"
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
 	"go.uber.org/zap"
 
 	serviceModel "github.com/crafty-ezhik/rocket-factory/order/internal/model"
@@ -13,13 +14,14 @@ import (
 	"github.com/crafty-ezhik/rocket-factory/platform/pkg/logger"
 )
 
-func (r *repository) Create(ctx context.Context, order serviceModel.Order) (uuid.UUID, error) {
+// Create - сохраняет заказ, его позиции и переход создания в одной транзакции
+func (r *repository) Create(ctx context.Context, order serviceModel.Order, transition serviceModel.OrderTransition) (uuid.UUID, error) {
 	repoOrder := converter.OrderToRepoModel(order)
 
 	builderInsert := sq.Insert(ordersTable).
 		PlaceholderFormat(sq.Dollar).
-		Columns(orderFieldUserUUID, orderFieldPartUuids, orderFieldTotalPrice).
-		Values(repoOrder.UserUUID, repoOrder.PartUUIDs, repoOrder.TotalPrice).
+		Columns(orderFieldOrderUUID, orderFieldUserUUID, orderFieldTotalPrice).
+		Values(repoOrder.UUID, repoOrder.UserUUID, repoOrder.TotalPrice).
 		Suffix(fmt.Sprintf("RETURNING %s", orderFieldOrderUUID))
 
 	query, args, err := builderInsert.ToSql()
@@ -28,10 +30,48 @@ func (r *repository) Create(ctx context.Context, order serviceModel.Order) (uuid
 		return uuid.Nil, err
 	}
 
+	builderItems := sq.Insert(orderItemsTable).
+		PlaceholderFormat(sq.Dollar).
+		Columns(orderItemFieldOrderUUID, orderItemFieldPartUUID, orderItemFieldQuantity, orderItemFieldUnitPrice, orderItemFieldName)
+	for _, item := range repoOrder.Items {
+		builderItems = builderItems.Values(repoOrder.UUID, item.PartUUID, item.Quantity, item.UnitPrice, item.Name)
+	}
+
+	itemsQuery, itemsArgs, err := builderItems.ToSql()
+	if err != nil {
+		logger.Error(ctx, "Ошибка при преобразовании запроса к SQL", zap.Error(err))
+		return uuid.Nil, err
+	}
+
+	transitionQuery, transitionArgs, err := buildInsertTransitionQuery(transition).ToSql()
+	if err != nil {
+		logger.Error(ctx, "Ошибка при преобразовании запроса к SQL", zap.Error(err))
+		return uuid.Nil, err
+	}
+
 	var orderUUID uuid.UUID
-	err = r.pool.QueryRow(ctx, query, args...).Scan(&orderUUID)
+	err = pgx.BeginFunc(ctx, r.pool, func(tx pgx.Tx) error {
+		err := tx.QueryRow(ctx, query, args...).Scan(&orderUUID)
+		if err != nil {
+			logger.Error(ctx, "Ошибка создании заказа", zap.Error(err))
+			return err
+		}
+
+		_, err = tx.Exec(ctx, itemsQuery, itemsArgs...)
+		if err != nil {
+			logger.Error(ctx, "Ошибка при сохранении позиций заказа", zap.Error(err))
+			return err
+		}
+
+		_, err = tx.Exec(ctx, transitionQuery, transitionArgs...)
+		if err != nil {
+			logger.Error(ctx, "Ошибка при сохранении перехода заказа", zap.Error(err))
+			return err
+		}
+
+		return nil
+	})
 	if err != nil {
-		logger.Error(ctx, "Ошибка создании заказа", zap.Error(err))
 		return uuid.Nil, err
 	}
 
@@ -26,7 +26,6 @@ func (r *repository) Get(ctx context.Context, orderID uuid.UUID) (serviceModel.O
 	err = r.pool.QueryRow(ctx, query, args...).Scan(
 		&order.UUID,
 		&order.UserUUID,
-		&order.PartUUIDs,
 		&order.TotalPrice,
 		&order.TransactionUUID,
 		&order.PaymentMethod,
@@ -42,14 +41,67 @@ func (r *repository) Get(ctx context.Context, orderID uuid.UUID) (serviceModel.O
 		return serviceModel.Order{}, err
 	}
 
+	items, err := r.getItems(ctx, orderID)
+	if err != nil {
+		return serviceModel.Order{}, err
+	}
+	order.Items = items[orderID]
+
 	return converter.OrderToServiceModel(order), nil
 }
 
+// getItems - возвращает позиции заказов, сгруппированные по UUID заказа, в порядке их добавления
+func (r *repository) getItems(ctx context.Context, orderIDs ...uuid.UUID) (map[uuid.UUID][]repoModel.OrderItem, error) {
+	query, args, err := sq.Select(
+		orderItemFieldOrderUUID,
+		orderItemFieldPartUUID,
+		orderItemFieldQuantity,
+		orderItemFieldUnitPrice,
+		orderItemFieldName,
+	).
+		From(orderItemsTable).
+		Where(sq.Eq{orderItemFieldOrderUUID: orderIDs}).
+		OrderBy(orderItemFieldID).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		logger.Error(ctx, "Ошибка при преобразовании запроса к SQL", zap.Error(err))
+		return nil, err
+	}
+
+	rows, err := r.pool.Query(ctx, query, args...)
+	if err != nil {
+		logger.Error(ctx, "Ошибка при получении позиций заказа", zap.Error(err))
+		return nil, err
+	}
+	defer rows.Close()
+
+	items := make(map[uuid.UUID][]repoModel.OrderItem, len(orderIDs))
+	for rows.Next() {
+		var (
+			orderID uuid.UUID
+			item    repoModel.OrderItem
+		)
+		err = rows.Scan(&orderID, &item.PartUUID, &item.Quantity, &item.UnitPrice, &item.Name)
+		if err != nil {
+			logger.Error(ctx, "Ошибка при чтении позиции заказа", zap.Error(err))
+			return nil, err
+		}
+		items[orderID] = append(items[orderID], item)
+	}
+
+	if err = rows.Err(); err != nil {
+		logger.Error(ctx, "Ошибка при получении позиций заказа", zap.Error(err))
+		return nil, err
+	}
+
+	return items, nil
+}
+
 func buildSelectOrderQuery(orderID uuid.UUID) sq.SelectBuilder {
 	builderSelect := sq.Select(
 		orderFieldOrderUUID,
 		orderFieldUserUUID,
-		orderFieldPartUuids,
 		orderFieldTotalPrice,
 		orderFieldTransactionUUID,
 		orderFieldPaymentMethod,
@@ -0,0 +1,150 @@
+package order
+
+import (
+	"context"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	serviceModel "github.com/crafty-ezhik/rocket-factory/order/internal/model"
+	"github.com/crafty-ezhik/rocket-factory/order/internal/repository/converter"
+	repoModel "github.com/crafty-ezhik/rocket-factory/order/internal/repository/model"
+	"github.com/crafty-ezhik/rocket-factory/platform/pkg/logger"
+)
+
+// List - возвращает заказы по фильтру, начиная с позиции курсора, не более filter.Limit штук
+func (r *repository) List(ctx context.Context, filter serviceModel.OrdersFilter) ([]serviceModel.Order, error) {
+	query, args, err := buildListOrdersQuery(filter).ToSql()
+	if err != nil {
+		logger.Error(ctx, "Ошибка при преобразовании запроса к SQL", zap.Error(err))
+		return nil, err
+	}
+
+	rows, err := r.pool.Query(ctx, query, args...)
+	if err != nil {
+		logger.Error(ctx, "Ошибка при получении списка заказов", zap.Error(err))
+		return nil, err
+	}
+	defer rows.Close()
+
+	var (
+		orders   []repoModel.Order
+		orderIDs []uuid.UUID
+	)
+	for rows.Next() {
+		var order repoModel.Order
+		err = rows.Scan(
+			&order.UUID,
+			&order.UserUUID,
+			&order.TotalPrice,
+			&order.TransactionUUID,
+			&order.PaymentMethod,
+			&order.Status,
+			&order.CreatedAt,
+			&order.UpdatedAt,
+		)
+		if err != nil {
+			logger.Error(ctx, "Ошибка при чтении заказа", zap.Error(err))
+			return nil, err
+		}
+		orders = append(orders, order)
+		orderIDs = append(orderIDs, order.UUID)
+	}
+
+	if err = rows.Err(); err != nil {
+		logger.Error(ctx, "Ошибка при получении списка заказов", zap.Error(err))
+		return nil, err
+	}
+
+	if len(orders) == 0 {
+		return []serviceModel.Order{}, nil
+	}
+
+	items, err := r.getItems(ctx, orderIDs...)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]serviceModel.Order, len(orders))
+	for i, order := range orders {
+		order.Items = items[order.UUID]
+		result[i] = converter.OrderToServiceModel(order)
+	}
+	return result, nil
+}
+
+func buildListOrdersQuery(filter serviceModel.OrdersFilter) sq.SelectBuilder {
+	builderSelect := sq.Select(
+		orderFieldOrderUUID,
+		orderFieldUserUUID,
+		orderFieldTotalPrice,
+		orderFieldTransactionUUID,
+		orderFieldPaymentMethod,
+		orderFieldStatus,
+		orderFieldCreatedAt,
+		orderFieldUpdatedAt,
+	).
+		From(ordersTable).
+		PlaceholderFormat(sq.Dollar)
+
+	if filter.UserUUID != nil {
+		builderSelect = builderSelect.Where(sq.Eq{orderFieldUserUUID: *filter.UserUUID})
+	}
+	if len(filter.Statuses) > 0 {
+		builderSelect = builderSelect.Where(sq.Eq{orderFieldStatus: filter.Statuses})
+	}
+	if len(filter.PaymentMethods) > 0 {
+		builderSelect = builderSelect.Where(sq.Eq{orderFieldPaymentMethod: filter.PaymentMethods})
+	}
+	if filter.CreatedFrom != nil {
+		builderSelect = builderSelect.Where(sq.GtOrEq{orderFieldCreatedAt: *filter.CreatedFrom})
+	}
+	if filter.CreatedTo != nil {
+		builderSelect = builderSelect.Where(sq.Lt{orderFieldCreatedAt: *filter.CreatedTo})
+	}
+	if filter.MinTotal != nil {
+		builderSelect = builderSelect.Where(sq.GtOrEq{orderFieldTotalPrice: *filter.MinTotal})
+	}
+	if filter.MaxTotal != nil {
+		builderSelect = builderSelect.Where(sq.LtOrEq{orderFieldTotalPrice: *filter.MaxTotal})
+	}
+
+	sortField, direction := sortFieldAndDirection(filter.Sort)
+
+	// Keyset-пагинация: продолжаем строго после последнего заказа предыдущей страницы,
+	// UUID заказа разрешает совпадения значения сортировки
+	if filter.Cursor != nil {
+		var value any = filter.Cursor.CreatedAt
+		if sortField == orderFieldTotalPrice {
+			value = filter.Cursor.TotalPrice
+		}
+
+		operator := "<"
+		if direction == "ASC" {
+			operator = ">"
+		}
+
+		builderSelect = builderSelect.Where(
+			sq.Expr("("+sortField+", "+orderFieldOrderUUID+") "+operator+" (?, ?)", value, filter.Cursor.OrderUUID),
+		)
+	}
+
+	return builderSelect.
+		OrderBy(sortField+" "+direction, orderFieldOrderUUID+" "+direction).
+		Limit(filter.Limit)
+}
+
+// sortFieldAndDirection - возвращает поле и направление сортировки. По умолчанию сначала новые заказы
+func sortFieldAndDirection(sort serviceModel.OrderSort) (string, string) {
+	switch sort {
+	case serviceModel.OrderSortCREATEDATASC:
+		return orderFieldCreatedAt, "ASC"
+	case serviceModel.OrderSortTOTALPRICEDESC:
+		return orderFieldTotalPrice, "DESC"
+	case serviceModel.OrderSortTOTALPRICEASC:
+		return orderFieldTotalPrice, "ASC"
+	default:
+		return orderFieldCreatedAt, "DESC"
+	}
+}
@@ -9,17 +9,31 @@ import (
 var _ def.OrderRepository = (*repository)(nil)
 
 const (
-	ordersTable = "orders"
+	ordersTable           = "orders"
+	orderItemsTable       = "order_items"
+	orderTransitionsTable = "order_status_transitions"
 
 	orderFieldOrderUUID       = "order_uuid"
 	orderFieldUserUUID        = "user_uuid"
-	orderFieldPartUuids       = "part_uuids"
 	orderFieldTotalPrice      = "total_price"
 	orderFieldTransactionUUID = "transaction_uuid"
 	orderFieldPaymentMethod   = "payment_method"
 	orderFieldStatus          = "status"
 	orderFieldCreatedAt       = "created_at"
 	orderFieldUpdatedAt       = "updated_at"
+
+	orderItemFieldID        = "id"
+	orderItemFieldOrderUUID = "order_uuid"
+	orderItemFieldPartUUID  = "part_uuid"
+	orderItemFieldQuantity  = "quantity"
+	orderItemFieldUnitPrice = "unit_price"
+	orderItemFieldName      = "name"
+
+	transitionFieldOrderUUID  = "order_uuid"
+	transitionFieldFromStatus = "from_status"
+	transitionFieldToStatus   = "to_status"
+	transitionFieldTrigger    = "trigger"
+	transitionFieldReason     = "reason"
 )
 
 type repository struct {
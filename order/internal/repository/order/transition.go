@@ -0,0 +1,144 @@
+package order
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+	"go.uber.org/zap"
+
+	serviceModel "github.com/crafty-ezhik/rocket-factory/order/internal/model"
+	"github.com/crafty-ezhik/rocket-factory/order/internal/repository/outbox"
+	"github.com/crafty-ezhik/rocket-factory/platform/pkg/logger"
+)
+
+// Transition - сохраняет заказ в новом статусе, переход в историю и события в outbox в одной транзакции.
+//
+//	Заказ обновляется только из статуса transition.From, поэтому конкурентный переход
+//	возвращает ErrOrderStatusConflict вместо перезаписи чужого статуса
+func (r *repository) Transition(
+	ctx context.Context,
+	order serviceModel.Order,
+	transition serviceModel.OrderTransition,
+	messages ...serviceModel.OutboxMessage,
+) error {
+	updateQuery, updateArgs, err := buildUpdateOrderQuery(order).
+		Where(sq.Eq{orderFieldStatus: transition.From}).
+		ToSql()
+	if err != nil {
+		logger.Error(ctx, "Ошибка при преобразовании запроса к SQL", zap.Error(err))
+		return fmt.Errorf("build update query: %w", err)
+	}
+
+	transitionQuery, transitionArgs, err := buildInsertTransitionQuery(transition).ToSql()
+	if err != nil {
+		logger.Error(ctx, "Ошибка при преобразовании запроса к SQL", zap.Error(err))
+		return fmt.Errorf("build transition insert query: %w", err)
+	}
+
+	err = pgx.BeginFunc(ctx, r.pool, func(tx pgx.Tx) error {
+		tag, err := tx.Exec(ctx, updateQuery, updateArgs...)
+		if err != nil {
+			logger.Error(ctx, "Ошибка при обновлении заказа", zap.Error(err))
+			return fmt.Errorf("execute update: %w", err)
+		}
+
+		if tag.RowsAffected() == 0 {
+			return r.transitionMissError(ctx, tx, order)
+		}
+
+		_, err = tx.Exec(ctx, transitionQuery, transitionArgs...)
+		if err != nil {
+			logger.Error(ctx, "Ошибка при сохранении перехода заказа", zap.Error(err))
+			return fmt.Errorf("execute transition insert: %w", err)
+		}
+
+		for _, message := range messages {
+			insertQuery, insertArgs, err := outbox.BuildInsertQuery(message).ToSql()
+			if err != nil {
+				logger.Error(ctx, "Ошибка при преобразовании запроса к SQL", zap.Error(err))
+				return fmt.Errorf("build outbox insert query: %w", err)
+			}
+
+			_, err = tx.Exec(ctx, insertQuery, insertArgs...)
+			if err != nil {
+				logger.Error(ctx, "Ошибка при сохранении события в outbox", zap.Error(err))
+				return fmt.Errorf("execute outbox insert: %w", err)
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// transitionMissError - определяет, почему заказ не обновился: его нет или статус уже изменен
+func (r *repository) transitionMissError(ctx context.Context, tx pgx.Tx, order serviceModel.Order) error {
+	query, args, err := sq.Select(orderFieldStatus).
+		PlaceholderFormat(sq.Dollar).
+		From(ordersTable).
+		Where(sq.Eq{orderFieldOrderUUID: order.UUID}).
+		ToSql()
+	if err != nil {
+		logger.Error(ctx, "Ошибка при преобразовании запроса к SQL", zap.Error(err))
+		return fmt.Errorf("build select status query: %w", err)
+	}
+
+	var status string
+	err = tx.QueryRow(ctx, query, args...).Scan(&status)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return serviceModel.ErrOrderNotFound
+		}
+		logger.Error(ctx, "Ошибка при получении статуса заказа", zap.Error(err))
+		return fmt.Errorf("execute select status: %w", err)
+	}
+
+	return fmt.Errorf("%w: order %s is %s", serviceModel.ErrOrderStatusConflict, order.UUID, status)
+}
+
+func buildUpdateOrderQuery(order serviceModel.Order) sq.UpdateBuilder {
+	builderUpdate := sq.Update(ordersTable).
+		PlaceholderFormat(sq.Dollar).
+		Set(orderFieldTotalPrice, order.TotalPrice).
+		Set(orderFieldTransactionUUID, order.TransactionUUID).
+		Set(orderFieldStatus, order.Status).
+		Set(orderFieldPaymentMethod, order.PaymentMethod).
+		Set(orderFieldUpdatedAt, time.Now()).
+		Where(sq.Eq{orderFieldOrderUUID: order.UUID})
+
+	return builderUpdate
+}
+
+// buildInsertTransitionQuery - строит запрос на добавление перехода в историю, у создания заказа нет исходного статуса
+func buildInsertTransitionQuery(transition serviceModel.OrderTransition) sq.InsertBuilder {
+	var from *string
+	if transition.From != "" {
+		status := transition.From.String()
+		from = &status
+	}
+
+	return sq.Insert(orderTransitionsTable).
+		PlaceholderFormat(sq.Dollar).
+		Columns(
+			transitionFieldOrderUUID,
+			transitionFieldFromStatus,
+			transitionFieldToStatus,
+			transitionFieldTrigger,
+			transitionFieldReason,
+		).
+		Values(
+			transition.OrderUUID,
+			from,
+			transition.To.String(),
+			transition.Trigger.String(),
+			transition.Reason,
+		)
+}
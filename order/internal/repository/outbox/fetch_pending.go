@@ -0,0 +1,96 @@
+package outbox
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"go.uber.org/zap"
+
+	serviceModel "github.com/crafty-ezhik/rocket-factory/order/internal/model"
+	"github.com/crafty-ezhik/rocket-factory/order/internal/repository/converter"
+	repoModel "github.com/crafty-ezhik/rocket-factory/order/internal/repository/model"
+	"github.com/crafty-ezhik/rocket-factory/platform/pkg/logger"
+)
+
+// FetchPending - захватывает пачку неотправленных событий на время lockTimeout.
+//
+//	Захват через locked_until позволяет запускать несколько relay одновременно,
+//	а после падения relay события снова станут доступны по истечении блокировки.
+//	Событие не захватывается, пока более раннее событие с тем же ключом заблокировано или отложено
+//	после неудачной отправки, чтобы события одного заказа уходили в порядке записи.
+func (r *repository) FetchPending(ctx context.Context, limit uint64, lockTimeout time.Duration) ([]serviceModel.OutboxMessage, error) {
+	subQuery := sq.Select(outboxFieldID).
+		From(outboxTable).
+		Where(sq.Eq{outboxFieldStatus: serviceModel.OutboxStatusPENDING}).
+		Where(sq.Or{
+			sq.Eq{outboxFieldLockedUntil: nil},
+			sq.Expr(fmt.Sprintf("%s < now()", outboxFieldLockedUntil)),
+		}).
+		Where(sq.Expr(fmt.Sprintf(
+			"NOT EXISTS (SELECT 1 FROM %[1]s AS earlier WHERE earlier.%[2]s = %[1]s.%[2]s AND earlier.%[3]s = ? AND earlier.%[4]s < %[1]s.%[4]s AND earlier.%[5]s >= now())",
+			outboxTable, outboxFieldMessageKey, outboxFieldStatus, outboxFieldID, outboxFieldLockedUntil,
+		), serviceModel.OutboxStatusPENDING)).
+		OrderBy(outboxFieldID).
+		Limit(limit).
+		Suffix("FOR UPDATE SKIP LOCKED")
+
+	builderUpdate := sq.Update(outboxTable).
+		PlaceholderFormat(sq.Dollar).
+		Set(outboxFieldLockedUntil, sq.Expr("now() + make_interval(secs => ?)", lockTimeout.Seconds())).
+		Where(sq.Expr(fmt.Sprintf("%s IN (?)", outboxFieldID), subQuery)).
+		Suffix(fmt.Sprintf("RETURNING %s, %s, %s, %s, %s, %s, %s, %s",
+			outboxFieldID,
+			outboxFieldEventUUID,
+			outboxFieldEventType,
+			outboxFieldMessageKey,
+			outboxFieldPayload,
+			outboxFieldStatus,
+			outboxFieldAttempts,
+			outboxFieldCreatedAt,
+		))
+
+	query, args, err := builderUpdate.ToSql()
+	if err != nil {
+		logger.Error(ctx, "Ошибка при преобразовании запроса к SQL", zap.Error(err))
+		return nil, err
+	}
+
+	rows, err := r.pool.Query(ctx, query, args...)
+	if err != nil {
+		logger.Error(ctx, "Ошибка при получении событий из outbox", zap.Error(err))
+		return nil, err
+	}
+	defer rows.Close()
+
+	messages := make([]serviceModel.OutboxMessage, 0, limit)
+	for rows.Next() {
+		var msg repoModel.OutboxMessage
+		err = rows.Scan(
+			&msg.ID,
+			&msg.EventUUID,
+			&msg.EventType,
+			&msg.Key,
+			&msg.Payload,
+			&msg.Status,
+			&msg.Attempts,
+			&msg.CreatedAt,
+		)
+		if err != nil {
+			logger.Error(ctx, "Ошибка при чтении события из outbox", zap.Error(err))
+			return nil, err
+		}
+		messages = append(messages, converter.OutboxMessageToServiceModel(msg))
+	}
+	if err = rows.Err(); err != nil {
+		logger.Error(ctx, "Ошибка при получении событий из outbox", zap.Error(err))
+		return nil, err
+	}
+
+	// RETURNING не гарантирует порядок, а события должны уходить в порядке записи
+	sort.Slice(messages, func(i, j int) bool { return messages[i].ID < messages[j].ID })
+
+	return messages, nil
+}
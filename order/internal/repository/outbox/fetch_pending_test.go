@@ -0,0 +1,68 @@
+package outbox
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+
+	serviceModel "github.com/crafty-ezhik/rocket-factory/order/internal/model"
+)
+
+func outboxRow(id int64) []any {
+	return []any{
+		id,
+		uuid.New(),
+		serviceModel.EventTypeOrderPaid,
+		[]byte("key"),
+		[]byte("payload"),
+		serviceModel.OutboxStatusPENDING,
+		0,
+		time.Now(),
+	}
+}
+
+func (s *RepositorySuite) TestFetchPendingOrdersByID() {
+	// RETURNING возвращает строки в произвольном порядке
+	s.pool.rows.rows = [][]any{outboxRow(3), outboxRow(1), outboxRow(2)}
+
+	messages, err := s.repository.FetchPending(s.ctx, 10, time.Minute)
+	s.Require().NoError(err)
+
+	s.Require().Len(messages, 3)
+	for i, msg := range messages {
+		s.Equal(int64(i+1), msg.ID)
+		s.Equal(serviceModel.EventTypeOrderPaid, msg.EventType)
+		s.Equal([]byte("payload"), msg.Payload)
+	}
+}
+
+func (s *RepositorySuite) TestFetchPendingQuery() {
+	_, err := s.repository.FetchPending(s.ctx, 10, 90*time.Second)
+	s.Require().NoError(err)
+
+	s.Require().Len(s.pool.queries, 1)
+	q := s.pool.queries[0]
+
+	// Захватываются самые старые неотправленные события, свободные или с истекшей блокировкой
+	s.Contains(q.sql, "UPDATE outbox SET locked_until = now() + make_interval(secs => $1)")
+	s.Contains(q.sql, "WHERE status = $2 AND (locked_until IS NULL OR locked_until < now()) AND NOT EXISTS")
+	// Событие ждет, пока более раннее событие того же заказа заблокировано или отложено
+	s.Contains(q.sql, "NOT EXISTS (SELECT 1 FROM outbox AS earlier WHERE earlier.message_key = outbox.message_key AND earlier.status = $3 AND earlier.id < outbox.id AND earlier.locked_until >= now()) ORDER BY id LIMIT 10 FOR UPDATE SKIP LOCKED")
+	s.Equal([]any{float64(90), serviceModel.OutboxStatusPENDING, serviceModel.OutboxStatusPENDING}, q.args)
+}
+
+func (s *RepositorySuite) TestFetchPendingQueryError() {
+	s.pool.queryErr = errors.New("database unavailable")
+
+	_, err := s.repository.FetchPending(s.ctx, 10, time.Minute)
+	s.ErrorIs(err, s.pool.queryErr)
+}
+
+func (s *RepositorySuite) TestFetchPendingRowsError() {
+	s.pool.rows.rows = [][]any{outboxRow(1)}
+	s.pool.rows.err = errors.New("connection lost")
+
+	_, err := s.repository.FetchPending(s.ctx, 10, time.Minute)
+	s.ErrorIs(err, s.pool.rows.err)
+}
@@ -0,0 +1,32 @@
+package outbox
+
+import (
+	sq "github.com/Masterminds/squirrel"
+
+	serviceModel "github.com/crafty-ezhik/rocket-factory/order/internal/model"
+	"github.com/crafty-ezhik/rocket-factory/order/internal/repository/converter"
+)
+
+// BuildInsertQuery - строит запрос на добавление события в outbox.
+//
+//	Используется репозиториями, которые пишут событие в одной транзакции с изменением своих данных
+func BuildInsertQuery(message serviceModel.OutboxMessage) sq.InsertBuilder {
+	repoMessage := converter.OutboxMessageToRepoModel(message)
+
+	return sq.Insert(outboxTable).
+		PlaceholderFormat(sq.Dollar).
+		Columns(
+			outboxFieldEventUUID,
+			outboxFieldEventType,
+			outboxFieldMessageKey,
+			outboxFieldPayload,
+			outboxFieldStatus,
+		).
+		Values(
+			repoMessage.EventUUID,
+			repoMessage.EventType,
+			repoMessage.Key,
+			repoMessage.Payload,
+			serviceModel.OutboxStatusPENDING,
+		)
+}
@@ -0,0 +1,68 @@
+package outbox
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"go.uber.org/zap"
+
+	serviceModel "github.com/crafty-ezhik/rocket-factory/order/internal/model"
+	"github.com/crafty-ezhik/rocket-factory/platform/pkg/logger"
+)
+
+// MarkSent - помечает событие отправленным
+func (r *repository) MarkSent(ctx context.Context, id int64) error {
+	builderUpdate := sq.Update(outboxTable).
+		PlaceholderFormat(sq.Dollar).
+		Set(outboxFieldStatus, serviceModel.OutboxStatusSENT).
+		Set(outboxFieldSentAt, time.Now()).
+		Set(outboxFieldLockedUntil, nil).
+		Set(outboxFieldAttempts, sq.Expr(fmt.Sprintf("%s + 1", outboxFieldAttempts))).
+		Where(sq.Eq{outboxFieldID: id})
+
+	return r.exec(ctx, builderUpdate)
+}
+
+// MarkRetry - сохраняет причину неудачной отправки и откладывает событие на backoff.
+// Событие остается PENDING, relay повторит отправку после истечения блокировки
+func (r *repository) MarkRetry(ctx context.Context, id int64, reason string, backoff time.Duration) error {
+	builderUpdate := sq.Update(outboxTable).
+		PlaceholderFormat(sq.Dollar).
+		Set(outboxFieldLastError, reason).
+		Set(outboxFieldLockedUntil, sq.Expr("now() + make_interval(secs => ?)", backoff.Seconds())).
+		Set(outboxFieldAttempts, sq.Expr(fmt.Sprintf("%s + 1", outboxFieldAttempts))).
+		Where(sq.Eq{outboxFieldID: id})
+
+	return r.exec(ctx, builderUpdate)
+}
+
+// MarkFailed - переводит событие, которое не удалось отправить за все попытки, в FAILED
+func (r *repository) MarkFailed(ctx context.Context, id int64, reason string) error {
+	builderUpdate := sq.Update(outboxTable).
+		PlaceholderFormat(sq.Dollar).
+		Set(outboxFieldStatus, serviceModel.OutboxStatusFAILED).
+		Set(outboxFieldLastError, reason).
+		Set(outboxFieldLockedUntil, nil).
+		Set(outboxFieldAttempts, sq.Expr(fmt.Sprintf("%s + 1", outboxFieldAttempts))).
+		Where(sq.Eq{outboxFieldID: id})
+
+	return r.exec(ctx, builderUpdate)
+}
+
+func (r *repository) exec(ctx context.Context, builder sq.UpdateBuilder) error {
+	query, args, err := builder.ToSql()
+	if err != nil {
+		logger.Error(ctx, "Ошибка при преобразовании запроса к SQL", zap.Error(err))
+		return fmt.Errorf("build update query: %w", err)
+	}
+
+	_, err = r.pool.Exec(ctx, query, args...)
+	if err != nil {
+		logger.Error(ctx, "Ошибка при обновлении события в outbox", zap.Error(err))
+		return fmt.Errorf("execute update: %w", err)
+	}
+
+	return nil
+}
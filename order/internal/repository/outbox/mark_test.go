@@ -0,0 +1,48 @@
+package outbox
+
+import (
+	"errors"
+	"time"
+
+	serviceModel "github.com/crafty-ezhik/rocket-factory/order/internal/model"
+)
+
+func (s *RepositorySuite) TestMarkSent() {
+	s.Require().NoError(s.repository.MarkSent(s.ctx, 42))
+
+	s.Require().Len(s.pool.queries, 1)
+	q := s.pool.queries[0]
+	s.Equal("UPDATE outbox SET status = $1, sent_at = $2, locked_until = $3, attempts = attempts + 1 WHERE id = $4", q.sql)
+	s.Require().Len(q.args, 4)
+	s.Equal(serviceModel.OutboxStatusSENT, q.args[0])
+	s.WithinDuration(time.Now(), q.args[1].(time.Time), time.Second)
+	s.Nil(q.args[2])
+	s.Equal(int64(42), q.args[3])
+}
+
+func (s *RepositorySuite) TestMarkRetry() {
+	s.Require().NoError(s.repository.MarkRetry(s.ctx, 42, "kafka unavailable", 30*time.Second))
+
+	// Событие остается в статусе PENDING и снова доступно relay после backoff
+	s.Require().Len(s.pool.queries, 1)
+	q := s.pool.queries[0]
+	s.Equal("UPDATE outbox SET last_error = $1, locked_until = now() + make_interval(secs => $2), attempts = attempts + 1 WHERE id = $3", q.sql)
+	s.Equal([]any{"kafka unavailable", float64(30), int64(42)}, q.args)
+}
+
+func (s *RepositorySuite) TestMarkFailed() {
+	s.Require().NoError(s.repository.MarkFailed(s.ctx, 42, "kafka unavailable"))
+
+	s.Require().Len(s.pool.queries, 1)
+	q := s.pool.queries[0]
+	s.Equal("UPDATE outbox SET status = $1, last_error = $2, locked_until = $3, attempts = attempts + 1 WHERE id = $4", q.sql)
+	s.Equal([]any{serviceModel.OutboxStatusFAILED, "kafka unavailable", nil, int64(42)}, q.args)
+}
+
+func (s *RepositorySuite) TestMarkError() {
+	s.pool.execErr = errors.New("database unavailable")
+
+	s.ErrorIs(s.repository.MarkSent(s.ctx, 42), s.pool.execErr)
+	s.ErrorIs(s.repository.MarkRetry(s.ctx, 42, "reason", time.Second), s.pool.execErr)
+	s.ErrorIs(s.repository.MarkFailed(s.ctx, 42, "reason"), s.pool.execErr)
+}
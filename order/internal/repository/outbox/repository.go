@@ -0,0 +1,44 @@
+package outbox
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+
+	def "github.com/crafty-ezhik/rocket-factory/order/internal/repository"
+)
+
+var _ def.OutboxRepository = (*repository)(nil)
+
+const (
+	outboxTable = "outbox"
+
+	outboxFieldID          = "id"
+	outboxFieldEventUUID   = "event_uuid"
+	outboxFieldEventType   = "event_type"
+	outboxFieldMessageKey  = "message_key"
+	outboxFieldPayload     = "payload"
+	outboxFieldStatus      = "status"
+	outboxFieldAttempts    = "attempts"
+	outboxFieldLastError   = "last_error"
+	outboxFieldLockedUntil = "locked_until"
+	outboxFieldCreatedAt   = "created_at"
+	outboxFieldSentAt      = "sent_at"
+)
+
+// dbPool - часть *pgxpool.Pool, которая нужна репозиторию
+type dbPool interface {
+	Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error)
+	Exec(ctx context.Context, sql string, args ...any) (pgconn.CommandTag, error)
+}
+
+type repository struct {
+	pool dbPool
+}
+
+func NewRepository(pool dbPool) *repository {
+	return &repository{
+		pool: pool,
+	}
+}
@@ -0,0 +1,84 @@
+package outbox
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/crafty-ezhik/rocket-factory/platform/pkg/logger"
+)
+
+type query struct {
+	sql  string
+	args []any
+}
+
+// fakeRows - строки результата запроса, значения подставляются в Scan по порядку колонок
+type fakeRows struct {
+	pgx.Rows
+	rows [][]any
+	next int
+	err  error
+}
+
+func (r *fakeRows) Next() bool {
+	r.next++
+	return r.next <= len(r.rows)
+}
+
+func (r *fakeRows) Scan(dest ...any) error {
+	for i, value := range r.rows[r.next-1] {
+		reflect.ValueOf(dest[i]).Elem().Set(reflect.ValueOf(value))
+	}
+	return nil
+}
+
+func (r *fakeRows) Err() error { return r.err }
+
+func (r *fakeRows) Close() {}
+
+// fakePool - запоминает выполненные запросы вместо обращения к базе
+type fakePool struct {
+	rows     *fakeRows
+	queryErr error
+	execErr  error
+	queries  []query
+}
+
+func (p *fakePool) Query(_ context.Context, sql string, args ...any) (pgx.Rows, error) {
+	p.queries = append(p.queries, query{sql: sql, args: args})
+	if p.queryErr != nil {
+		return nil, p.queryErr
+	}
+	return p.rows, nil
+}
+
+func (p *fakePool) Exec(_ context.Context, sql string, args ...any) (pgconn.CommandTag, error) {
+	p.queries = append(p.queries, query{sql: sql, args: args})
+	return pgconn.CommandTag{}, p.execErr
+}
+
+type RepositorySuite struct {
+	suite.Suite
+	ctx        context.Context //nolint:containedctx
+	pool       *fakePool
+	repository *repository
+}
+
+func (s *RepositorySuite) SetupSuite() {
+	logger.SetNopLogger()
+}
+
+func (s *RepositorySuite) SetupTest() {
+	s.ctx = context.Background()
+	s.pool = &fakePool{rows: &fakeRows{}}
+	s.repository = NewRepository(s.pool)
+}
+
+func TestRepositoryIntegration(t *testing.T) {
+	suite.Run(t, new(RepositorySuite))
+}
@@ -2,6 +2,7 @@ package repository
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 
@@ -9,7 +10,17 @@ import (
 )
 
 type OrderRepository interface {
-	Create(ctx context.Context, order serviceModel.Order) (uuid.UUID, error)
+	Create(ctx context.Context, order serviceModel.Order, transition serviceModel.OrderTransition) (uuid.UUID, error)
 	Get(ctx context.Context, orderID uuid.UUID) (serviceModel.Order, error)
-	Update(ctx context.Context, order serviceModel.Order) error
+	List(ctx context.Context, filter serviceModel.OrdersFilter) ([]serviceModel.Order, error)
+	// Transition - сохраняет заказ в новом статусе вместе с историей перехода и событиями outbox.
+	// Возвращает ErrOrderStatusConflict, если статус заказа уже не совпадает с transition.From
+	Transition(ctx context.Context, order serviceModel.Order, transition serviceModel.OrderTransition, messages ...serviceModel.OutboxMessage) error
+}
+
+type OutboxRepository interface {
+	FetchPending(ctx context.Context, limit uint64, lockTimeout time.Duration) ([]serviceModel.OutboxMessage, error)
+	MarkSent(ctx context.Context, id int64) error
+	MarkRetry(ctx context.Context, id int64, reason string, backoff time.Duration) error
+	MarkFailed(ctx context.Context, id int64, reason string) error
 }
@@ -0,0 +1,44 @@
+package order_assembly_failed_consumer
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	kafkaConv "github.com/crafty-ezhik/rocket-factory/order/internal/converter/kafka"
+	def "github.com/crafty-ezhik/rocket-factory/order/internal/service"
+	"github.com/crafty-ezhik/rocket-factory/platform/pkg/kafka"
+	"github.com/crafty-ezhik/rocket-factory/platform/pkg/logger"
+)
+
+var _ def.ConsumerService = (*service)(nil)
+
+type service struct {
+	orderAssemblyFailedConsumer kafka.Consumer
+	orderAssemblyFailedDecoder  kafkaConv.OrderAssemblyFailedDecoder
+	orderService                def.OrderService
+}
+
+func NewService(
+	orderAssemblyFailedConsumer kafka.Consumer,
+	orderAssemblyFailedDecoder kafkaConv.OrderAssemblyFailedDecoder,
+	orderService def.OrderService,
+) *service {
+	return &service{
+		orderAssemblyFailedConsumer: orderAssemblyFailedConsumer,
+		orderAssemblyFailedDecoder:  orderAssemblyFailedDecoder,
+		orderService:                orderService,
+	}
+}
+
+func (s *service) RunConsumer(ctx context.Context) error {
+	logger.Info(ctx, "Starting orderAssemblyFailedConsumer service")
+
+	err := s.orderAssemblyFailedConsumer.Consume(ctx, s.OrderAssemblyFailedHandler)
+	if err != nil {
+		logger.Error(ctx, "Consume from order.assembly-failed topic error", zap.Error(err))
+		return err
+	}
+
+	return nil
+}
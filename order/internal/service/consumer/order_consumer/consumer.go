@@ -6,7 +6,6 @@ import (
 	"go.uber.org/zap"
 
 	kafkaConv "github.com/crafty-ezhik/rocket-factory/order/internal/converter/kafka"
-	"github.com/crafty-ezhik/rocket-factory/order/internal/repository"
 	def "github.com/crafty-ezhik/rocket-factory/order/internal/service"
 	"github.com/crafty-ezhik/rocket-factory/platform/pkg/kafka"
 	"github.com/crafty-ezhik/rocket-factory/platform/pkg/logger"
@@ -17,14 +16,14 @@ var _ def.ConsumerService = (*service)(nil)
 type service struct {
 	orderAssembledConsumer kafka.Consumer
 	orderAssembledDecoder  kafkaConv.OrderAssembledDecoder
-	orderRepo              repository.OrderRepository
+	orderService           def.OrderService
 }
 
-func NewService(orderAssembledConsumer kafka.Consumer, orderRepo repository.OrderRepository, decoder kafkaConv.OrderAssembledDecoder) *service {
+func NewService(orderAssembledConsumer kafka.Consumer, orderService def.OrderService, decoder kafkaConv.OrderAssembledDecoder) *service {
 	return &service{
 		orderAssembledConsumer: orderAssembledConsumer,
 		orderAssembledDecoder:  decoder,
-		orderRepo:              orderRepo,
+		orderService:           orderService,
 	}
 }
 
@@ -5,7 +5,6 @@ import (
 
 	"go.uber.org/zap"
 
-	"github.com/crafty-ezhik/rocket-factory/order/internal/model"
 	"github.com/crafty-ezhik/rocket-factory/platform/pkg/kafka"
 	"github.com/crafty-ezhik/rocket-factory/platform/pkg/logger"
 )
@@ -17,16 +16,8 @@ func (s *service) OrderAssembledHandler(ctx context.Context, msg kafka.Message)
 		return err
 	}
 
-	order, err := s.orderRepo.Get(ctx, event.OrderUUID)
-	if err != nil {
-		logger.Error(ctx, "Failed to get order", zap.Error(err))
-		return err
-	}
-
-	order.Status = model.OrderStatusASSEMBLED
-
-	if err = s.orderRepo.Update(ctx, order); err != nil {
-		logger.Error(ctx, "Failed to update order", zap.Error(err))
+	if err = s.orderService.Assembled(ctx, event); err != nil {
+		logger.Error(ctx, "Failed to complete order assembly", zap.Error(err))
 		return err
 	}
 	return nil
@@ -42,6 +42,120 @@ func (_m *MockOrderService) EXPECT() *MockOrderService_Expecter {
 	return &MockOrderService_Expecter{mock: &_m.Mock}
 }
 
+// Assembled provides a mock function for the type MockOrderService
+func (_mock *MockOrderService) Assembled(ctx context.Context, event model.OrderAssembledEvent) error {
+	ret := _mock.Called(ctx, event)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Assembled")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, model.OrderAssembledEvent) error); ok {
+		r0 = returnFunc(ctx, event)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockOrderService_Assembled_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Assembled'
+type MockOrderService_Assembled_Call struct {
+	*mock.Call
+}
+
+// Assembled is a helper method to define mock.On call
+//   - ctx context.Context
+//   - event model.OrderAssembledEvent
+func (_e *MockOrderService_Expecter) Assembled(ctx interface{}, event interface{}) *MockOrderService_Assembled_Call {
+	return &MockOrderService_Assembled_Call{Call: _e.mock.On("Assembled", ctx, event)}
+}
+
+func (_c *MockOrderService_Assembled_Call) Run(run func(ctx context.Context, event model.OrderAssembledEvent)) *MockOrderService_Assembled_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 model.OrderAssembledEvent
+		if args[1] != nil {
+			arg1 = args[1].(model.OrderAssembledEvent)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockOrderService_Assembled_Call) Return(err error) *MockOrderService_Assembled_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockOrderService_Assembled_Call) RunAndReturn(run func(ctx context.Context, event model.OrderAssembledEvent) error) *MockOrderService_Assembled_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// AssemblyFailed provides a mock function for the type MockOrderService
+func (_mock *MockOrderService) AssemblyFailed(ctx context.Context, event model.OrderAssemblyFailedEvent) error {
+	ret := _mock.Called(ctx, event)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AssemblyFailed")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, model.OrderAssemblyFailedEvent) error); ok {
+		r0 = returnFunc(ctx, event)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockOrderService_AssemblyFailed_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AssemblyFailed'
+type MockOrderService_AssemblyFailed_Call struct {
+	*mock.Call
+}
+
+// AssemblyFailed is a helper method to define mock.On call
+//   - ctx context.Context
+//   - event model.OrderAssemblyFailedEvent
+func (_e *MockOrderService_Expecter) AssemblyFailed(ctx interface{}, event interface{}) *MockOrderService_AssemblyFailed_Call {
+	return &MockOrderService_AssemblyFailed_Call{Call: _e.mock.On("AssemblyFailed", ctx, event)}
+}
+
+func (_c *MockOrderService_AssemblyFailed_Call) Run(run func(ctx context.Context, event model.OrderAssemblyFailedEvent)) *MockOrderService_AssemblyFailed_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 model.OrderAssemblyFailedEvent
+		if args[1] != nil {
+			arg1 = args[1].(model.OrderAssemblyFailedEvent)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockOrderService_AssemblyFailed_Call) Return(err error) *MockOrderService_AssemblyFailed_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockOrderService_AssemblyFailed_Call) RunAndReturn(run func(ctx context.Context, event model.OrderAssemblyFailedEvent) error) *MockOrderService_AssemblyFailed_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Cancel provides a mock function for the type MockOrderService
 func (_mock *MockOrderService) Cancel(ctx context.Context, orderID uuid.UUID) error {
 	ret := _mock.Called(ctx, orderID)
@@ -100,8 +214,8 @@ func (_c *MockOrderService_Cancel_Call) RunAndReturn(run func(ctx context.Contex
 }
 
 // Create provides a mock function for the type MockOrderService
-func (_mock *MockOrderService) Create(ctx context.Context, userID uuid.UUID, parts []uuid.UUID) (uuid.UUID, float64, error) {
-	ret := _mock.Called(ctx, userID, parts)
+func (_mock *MockOrderService) Create(ctx context.Context, userID uuid.UUID, items []model.OrderItem) (uuid.UUID, float64, error) {
+	ret := _mock.Called(ctx, userID, items)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Create")
@@ -110,23 +224,23 @@ func (_mock *MockOrderService) Create(ctx context.Context, userID uuid.UUID, par
 	var r0 uuid.UUID
 	var r1 float64
 	var r2 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, []uuid.UUID) (uuid.UUID, float64, error)); ok {
-		return returnFunc(ctx, userID, parts)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, []model.OrderItem) (uuid.UUID, float64, error)); ok {
+		return returnFunc(ctx, userID, items)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, []uuid.UUID) uuid.UUID); ok {
-		r0 = returnFunc(ctx, userID, parts)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, []model.OrderItem) uuid.UUID); ok {
+		r0 = returnFunc(ctx, userID, items)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(uuid.UUID)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, []uuid.UUID) float64); ok {
-		r1 = returnFunc(ctx, userID, parts)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, []model.OrderItem) float64); ok {
+		r1 = returnFunc(ctx, userID, items)
 	} else {
 		r1 = ret.Get(1).(float64)
 	}
-	if returnFunc, ok := ret.Get(2).(func(context.Context, uuid.UUID, []uuid.UUID) error); ok {
-		r2 = returnFunc(ctx, userID, parts)
+	if returnFunc, ok := ret.Get(2).(func(context.Context, uuid.UUID, []model.OrderItem) error); ok {
+		r2 = returnFunc(ctx, userID, items)
 	} else {
 		r2 = ret.Error(2)
 	}
@@ -141,12 +255,12 @@ type MockOrderService_Create_Call struct {
 // Create is a helper method to define mock.On call
 //   - ctx context.Context
 //   - userID uuid.UUID
-//   - parts []uuid.UUID
-func (_e *MockOrderService_Expecter) Create(ctx interface{}, userID interface{}, parts interface{}) *MockOrderService_Create_Call {
-	return &MockOrderService_Create_Call{Call: _e.mock.On("Create", ctx, userID, parts)}
+//   - items []model.OrderItem
+func (_e *MockOrderService_Expecter) Create(ctx interface{}, userID interface{}, items interface{}) *MockOrderService_Create_Call {
+	return &MockOrderService_Create_Call{Call: _e.mock.On("Create", ctx, userID, items)}
 }
 
-func (_c *MockOrderService_Create_Call) Run(run func(ctx context.Context, userID uuid.UUID, parts []uuid.UUID)) *MockOrderService_Create_Call {
+func (_c *MockOrderService_Create_Call) Run(run func(ctx context.Context, userID uuid.UUID, items []model.OrderItem)) *MockOrderService_Create_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -156,9 +270,9 @@ func (_c *MockOrderService_Create_Call) Run(run func(ctx context.Context, userID
 		if args[1] != nil {
 			arg1 = args[1].(uuid.UUID)
 		}
-		var arg2 []uuid.UUID
+		var arg2 []model.OrderItem
 		if args[2] != nil {
-			arg2 = args[2].([]uuid.UUID)
+			arg2 = args[2].([]model.OrderItem)
 		}
 		run(
 			arg0,
@@ -174,7 +288,7 @@ func (_c *MockOrderService_Create_Call) Return(uUID uuid.UUID, f float64, err er
 	return _c
 }
 
-func (_c *MockOrderService_Create_Call) RunAndReturn(run func(ctx context.Context, userID uuid.UUID, parts []uuid.UUID) (uuid.UUID, float64, error)) *MockOrderService_Create_Call {
+func (_c *MockOrderService_Create_Call) RunAndReturn(run func(ctx context.Context, userID uuid.UUID, items []model.OrderItem) (uuid.UUID, float64, error)) *MockOrderService_Create_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -245,6 +359,72 @@ func (_c *MockOrderService_Get_Call) RunAndReturn(run func(ctx context.Context,
 	return _c
 }
 
+// List provides a mock function for the type MockOrderService
+func (_mock *MockOrderService) List(ctx context.Context, filter model.OrdersFilter) (model.OrdersPage, error) {
+	ret := _mock.Called(ctx, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for List")
+	}
+
+	var r0 model.OrdersPage
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, model.OrdersFilter) (model.OrdersPage, error)); ok {
+		return returnFunc(ctx, filter)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, model.OrdersFilter) model.OrdersPage); ok {
+		r0 = returnFunc(ctx, filter)
+	} else {
+		r0 = ret.Get(0).(model.OrdersPage)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, model.OrdersFilter) error); ok {
+		r1 = returnFunc(ctx, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockOrderService_List_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'List'
+type MockOrderService_List_Call struct {
+	*mock.Call
+}
+
+// List is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filter model.OrdersFilter
+func (_e *MockOrderService_Expecter) List(ctx interface{}, filter interface{}) *MockOrderService_List_Call {
+	return &MockOrderService_List_Call{Call: _e.mock.On("List", ctx, filter)}
+}
+
+func (_c *MockOrderService_List_Call) Run(run func(ctx context.Context, filter model.OrdersFilter)) *MockOrderService_List_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 model.OrdersFilter
+		if args[1] != nil {
+			arg1 = args[1].(model.OrdersFilter)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockOrderService_List_Call) Return(ordersPage model.OrdersPage, err error) *MockOrderService_List_Call {
+	_c.Call.Return(ordersPage, err)
+	return _c
+}
+
+func (_c *MockOrderService_List_Call) RunAndReturn(run func(ctx context.Context, filter model.OrdersFilter) (model.OrdersPage, error)) *MockOrderService_List_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Pay provides a mock function for the type MockOrderService
 func (_mock *MockOrderService) Pay(ctx context.Context, orderID uuid.UUID, paymentMethod model.PaymentMethod) (uuid.UUID, error) {
 	ret := _mock.Called(ctx, orderID, paymentMethod)
@@ -0,0 +1,92 @@
+// Code generated for crafty-ezhik service
+// © Crafty-ezhik 2025.
+
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockOutboxRelayService creates a new instance of MockOutboxRelayService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockOutboxRelayService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockOutboxRelayService {
+	mock := &MockOutboxRelayService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockOutboxRelayService is an autogenerated mock type for the OutboxRelayService type
+type MockOutboxRelayService struct {
+	mock.Mock
+}
+
+type MockOutboxRelayService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockOutboxRelayService) EXPECT() *MockOutboxRelayService_Expecter {
+	return &MockOutboxRelayService_Expecter{mock: &_m.Mock}
+}
+
+// RunRelay provides a mock function for the type MockOutboxRelayService
+func (_mock *MockOutboxRelayService) RunRelay(ctx context.Context) error {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RunRelay")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockOutboxRelayService_RunRelay_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RunRelay'
+type MockOutboxRelayService_RunRelay_Call struct {
+	*mock.Call
+}
+
+// RunRelay is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockOutboxRelayService_Expecter) RunRelay(ctx interface{}) *MockOutboxRelayService_RunRelay_Call {
+	return &MockOutboxRelayService_RunRelay_Call{Call: _e.mock.On("RunRelay", ctx)}
+}
+
+func (_c *MockOutboxRelayService_RunRelay_Call) Run(run func(ctx context.Context)) *MockOutboxRelayService_RunRelay_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockOutboxRelayService_RunRelay_Call) Return(err error) *MockOutboxRelayService_RunRelay_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockOutboxRelayService_RunRelay_Call) RunAndReturn(run func(ctx context.Context) error) *MockOutboxRelayService_RunRelay_Call {
+	_c.Call.Return(run)
+	return _c
+}
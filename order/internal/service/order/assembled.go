@@ -0,0 +1,29 @@
+package order
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"github.com/crafty-ezhik/rocket-factory/order/internal/model"
+	"github.com/crafty-ezhik/rocket-factory/platform/pkg/logger"
+)
+
+func (s *service) Assembled(ctx context.Context, event model.OrderAssembledEvent) error {
+	order, err := s.orderRepo.Get(ctx, event.OrderUUID)
+	if err != nil {
+		return err
+	}
+
+	// Сборка могла завершиться уже после отмены заказа с возвратом средств, такой заказ не меняется
+	transition, err := order.Transition(model.OrderTriggerASSEMBLYCOMPLETE, "")
+	if err != nil {
+		logger.Info(ctx, "Завершение сборки проигнорировано: заказ уже не ожидает сборки",
+			zap.String("order_uuid", event.OrderUUID.String()),
+			zap.String("status", order.Status.String()),
+		)
+		return nil
+	}
+
+	return s.orderRepo.Transition(ctx, order, transition)
+}
@@ -0,0 +1,92 @@
+package order
+
+import (
+	"errors"
+
+	"github.com/google/uuid"
+
+	"github.com/crafty-ezhik/rocket-factory/order/internal/model"
+)
+
+/*
+Success:
+1. Оплаченный заказ переведен в ASSEMBLED -> nil
+2. Повторная сборка после ошибки завершена -> nil
+3. Заказ уже отменен с возвратом средств: событие проигнорировано -> nil
+
+Failure:
+1. Заказ не найден -> model.ErrOrderNotFound
+2. Статус заказа изменен конкурентно -> model.ErrOrderStatusConflict
+*/
+
+func (s *ServiceSuite) TestAssembled() {
+	orderUUID := uuid.New()
+	transactionUUID := uuid.New()
+
+	order := func(status model.OrderStatus) model.Order {
+		return model.Order{UUID: orderUUID, TransactionUUID: transactionUUID, Status: status}
+	}
+	completeTransition := func(from model.OrderStatus) model.OrderTransition {
+		return model.OrderTransition{
+			OrderUUID: orderUUID,
+			From:      from,
+			To:        model.OrderStatusASSEMBLED,
+			Trigger:   model.OrderTriggerASSEMBLYCOMPLETE,
+		}
+	}
+
+	tests := []struct {
+		name        string
+		setupMock   func()
+		expectedErr error
+	}{
+		{
+			name: "paid order is assembled",
+			setupMock: func() {
+				s.repo.On("Get", s.ctx, orderUUID).Return(order(model.OrderStatusPAID), nil).Once()
+				s.repo.On("Transition", s.ctx, order(model.OrderStatusASSEMBLED), completeTransition(model.OrderStatusPAID)).
+					Return(nil).Once()
+			},
+		},
+		{
+			name: "reassembled order is assembled",
+			setupMock: func() {
+				s.repo.On("Get", s.ctx, orderUUID).Return(order(model.OrderStatusASSEMBLYFAILED), nil).Once()
+				s.repo.On("Transition", s.ctx, order(model.OrderStatusASSEMBLED), completeTransition(model.OrderStatusASSEMBLYFAILED)).
+					Return(nil).Once()
+			},
+		},
+		{
+			name: "refunded order is ignored",
+			setupMock: func() {
+				s.repo.On("Get", s.ctx, orderUUID).Return(order(model.OrderStatusREFUNDED), nil).Once()
+			},
+		},
+		{
+			name: "order not found",
+			setupMock: func() {
+				s.repo.On("Get", s.ctx, orderUUID).Return(model.Order{}, model.ErrOrderNotFound).Once()
+			},
+			expectedErr: model.ErrOrderNotFound,
+		},
+		{
+			name: "status changed concurrently",
+			setupMock: func() {
+				s.repo.On("Get", s.ctx, orderUUID).Return(order(model.OrderStatusPAID), nil).Once()
+				s.repo.On("Transition", s.ctx, order(model.OrderStatusASSEMBLED), completeTransition(model.OrderStatusPAID)).
+					Return(model.ErrOrderStatusConflict).Once()
+			},
+			expectedErr: model.ErrOrderStatusConflict,
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			tt.setupMock()
+
+			err := s.service.Assembled(s.ctx, model.OrderAssembledEvent{EventUUID: uuid.New(), OrderUUID: orderUUID})
+
+			s.Require().True(errors.Is(err, tt.expectedErr), "unexpected error: %v", err)
+		})
+	}
+}
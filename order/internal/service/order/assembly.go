@@ -0,0 +1,49 @@
+package order
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/crafty-ezhik/rocket-factory/order/internal/model"
+	"github.com/crafty-ezhik/rocket-factory/platform/pkg/logger"
+)
+
+// assemblyParts - собирает детали заказа с категориями и габаритами для AssemblyService.
+//
+//	Оплата к этому моменту уже проведена, поэтому ошибка InventoryService только логируется:
+//	детали уходят без характеристик, и сборка рассчитывает время по умолчанию
+func (s *service) assemblyParts(ctx context.Context, items []model.OrderItem) []model.AssemblyPart {
+	if len(items) == 0 {
+		return nil
+	}
+
+	parts := make([]model.AssemblyPart, len(items))
+	for i, item := range items {
+		parts[i] = model.AssemblyPart{PartUUID: item.PartUUID, Quantity: item.Quantity}
+	}
+
+	ctxReq, cancel := context.WithTimeout(ctx, time.Second*3)
+	defer cancel()
+
+	inventoryParts, err := s.inventoryClient.ListParts(ctxReq, model.PartsFilter{UUIDs: orderItemsPartUUIDs(items)})
+	if err != nil {
+		logger.Warn(ctx, "Не удалось получить характеристики деталей для сборки", zap.Error(err))
+		return parts
+	}
+
+	partsByUUID := make(map[uuid.UUID]model.Part, len(inventoryParts))
+	for _, part := range inventoryParts {
+		partsByUUID[part.UUID] = part
+	}
+
+	for i := range parts {
+		if part, ok := partsByUUID[parts[i].PartUUID]; ok {
+			parts[i].Category = part.Category
+			parts[i].Dimensions = part.Dimensions
+		}
+	}
+	return parts
+}
@@ -0,0 +1,82 @@
+package order
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/crafty-ezhik/rocket-factory/order/internal/model"
+	"github.com/crafty-ezhik/rocket-factory/platform/pkg/logger"
+)
+
+func (s *service) AssemblyFailed(ctx context.Context, event model.OrderAssemblyFailedEvent) error {
+	fields := []zap.Field{
+		zap.String("order_uuid", event.OrderUUID.String()),
+		zap.String("stage", event.Stage),
+		zap.String("reason", event.Reason),
+		zap.Int("attempt", event.Attempt),
+	}
+
+	order, err := s.orderRepo.Get(ctx, event.OrderUUID)
+	if err != nil {
+		return err
+	}
+
+	// Повторная ошибка из ASSEMBLY_FAILED допускается, чтобы повторная доставка после сбоя возврата довела заказ до конца
+	transition, err := order.Transition(model.OrderTriggerASSEMBLYFAIL, assemblyFailedReason(event))
+	if err != nil {
+		logger.Info(ctx, "Ошибка сборки проигнорирована: заказ уже не ожидает сборки",
+			append(fields, zap.String("status", order.Status.String()))...,
+		)
+		return nil
+	}
+
+	if s.assemblyPolicy.OnFailure == model.AssemblyFailurePolicyREASSEMBLE && event.Attempt < s.assemblyPolicy.MaxAttempts {
+		logger.Warn(ctx, "Сборка заказа завершилась ошибкой, запрошена повторная сборка", fields...)
+		return s.requestReassembly(ctx, order, transition, event.Attempt+1)
+	}
+
+	logger.Warn(ctx, "Сборка заказа завершилась ошибкой, средства будут возвращены", fields...)
+
+	// Сначала фиксируем ошибку сборки: если возврат не удастся, событие будет обработано повторно
+	err = s.orderRepo.Transition(ctx, order, transition)
+	if err != nil {
+		return err
+	}
+
+	return s.refund(ctx, order, reasonAssemblyFailed)
+}
+
+// reasonAssemblyFailed - причина возврата средств после неудачной сборки
+const reasonAssemblyFailed = "assembly failed"
+
+// assemblyFailedReason - причина перехода в ASSEMBLY_FAILED для истории заказа
+func assemblyFailedReason(event model.OrderAssemblyFailedEvent) string {
+	return fmt.Sprintf("%s at stage %s, attempt %d", event.Reason, event.Stage, event.Attempt)
+}
+
+// requestReassembly - сохраняет статус ASSEMBLY_FAILED вместе с запросом новой попытки сборки
+func (s *service) requestReassembly(ctx context.Context, order model.Order, transition model.OrderTransition, attempt int) error {
+	event := model.AssemblyRequestedEvent{
+		EventUUID: uuid.New(),
+		OrderUUID: order.UUID,
+		UserUUID:  order.UserUUID,
+		Attempt:   attempt,
+		Parts:     s.assemblyParts(ctx, order.Items),
+	}
+
+	payload, err := s.assemblyRequestedEncoder.Encode(event)
+	if err != nil {
+		logger.Error(ctx, "Failed to encode AssemblyRequested event", zap.Error(err))
+		return err
+	}
+
+	return s.orderRepo.Transition(ctx, order, transition, model.OutboxMessage{
+		EventUUID: event.EventUUID,
+		EventType: model.EventTypeAssemblyRequested,
+		Key:       []byte(order.UUID.String()),
+		Payload:   payload,
+	})
+}
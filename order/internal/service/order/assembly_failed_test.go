@@ -0,0 +1,165 @@
+package order
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/crafty-ezhik/rocket-factory/order/internal/model"
+	eventsV1 "github.com/crafty-ezhik/rocket-factory/shared/pkg/proto/events/v1"
+)
+
+/*
+Success:
+1. Политика refund: заказ переведен в ASSEMBLY_FAILED, средства возвращены -> nil
+2. Политика reassemble: запрошена следующая попытка сборки -> nil
+3. Политика reassemble, попытки исчерпаны: средства возвращены -> nil
+4. Заказ уже отменен: событие проигнорировано -> nil
+
+Failure:
+1. Ошибка возврата средств -> ошибка PaymentService, заказ остается в ASSEMBLY_FAILED
+*/
+
+func (s *ServiceSuite) TestAssemblyFailed() {
+	refundErr := errors.New("refund error")
+	orderUUID := uuid.New()
+	userUUID := uuid.New()
+	transactionUUID := uuid.New()
+	partUUID := uuid.New()
+
+	paidOrder := model.Order{
+		UUID:            orderUUID,
+		UserUUID:        userUUID,
+		Items:           []model.OrderItem{{PartUUID: partUUID, Quantity: 3}},
+		TotalPrice:      1500,
+		TransactionUUID: transactionUUID,
+		Status:          model.OrderStatusPAID,
+	}
+	failedOrder := paidOrder
+	failedOrder.Status = model.OrderStatusASSEMBLYFAILED
+	refundedOrder := paidOrder
+	refundedOrder.Status = model.OrderStatusREFUNDED
+
+	event := model.OrderAssemblyFailedEvent{
+		EventUUID: uuid.New(),
+		OrderUUID: orderUUID,
+		UserUUID:  userUUID,
+		Stage:     "ENGINE",
+		Reason:    "PART_DEFECT",
+		Attempt:   1,
+	}
+
+	reassemble := AssemblyPolicy{OnFailure: model.AssemblyFailurePolicyREASSEMBLE, MaxAttempts: 2}
+
+	failTransition := func(from model.OrderStatus, attempt int) model.OrderTransition {
+		return model.OrderTransition{
+			OrderUUID: orderUUID,
+			From:      from,
+			To:        model.OrderStatusASSEMBLYFAILED,
+			Trigger:   model.OrderTriggerASSEMBLYFAIL,
+			Reason:    fmt.Sprintf("PART_DEFECT at stage ENGINE, attempt %d", attempt),
+		}
+	}
+	refundTransition := model.OrderTransition{
+		OrderUUID: orderUUID,
+		From:      model.OrderStatusASSEMBLYFAILED,
+		To:        model.OrderStatusREFUNDED,
+		Trigger:   model.OrderTriggerREFUND,
+		Reason:    reasonAssemblyFailed,
+	}
+
+	expectRefund := func(from model.OrderStatus, attempt int, err error) {
+		s.repo.On("Transition", s.ctx, failedOrder, failTransition(from, attempt)).Return(nil).Once()
+
+		if err != nil {
+			s.paymentClient.On("RefundPayment", mock.Anything, transactionUUID).Return(0.0, err).Once()
+			return
+		}
+
+		s.paymentClient.On("RefundPayment", mock.Anything, transactionUUID).Return(1500.0, nil).Once()
+		s.repo.On("Transition", s.ctx, refundedOrder, refundTransition, mock.MatchedBy(func(msg model.OutboxMessage) bool {
+			return msg.EventType == model.EventTypeOrderRefunded
+		})).Return(nil).Once()
+	}
+
+	tests := []struct {
+		name        string
+		policy      AssemblyPolicy
+		attempt     int
+		order       model.Order
+		setupMock   func()
+		expectedErr error
+	}{
+		{
+			name:      "refund policy",
+			policy:    AssemblyPolicy{OnFailure: model.AssemblyFailurePolicyREFUND, MaxAttempts: 3},
+			attempt:   1,
+			order:     paidOrder,
+			setupMock: func() { expectRefund(model.OrderStatusPAID, 1, nil) },
+		},
+		{
+			name:    "reassemble policy requests next attempt",
+			policy:  reassemble,
+			attempt: 1,
+			order:   paidOrder,
+			setupMock: func() {
+				s.inventoryClient.On("ListParts", mock.Anything, model.PartsFilter{UUIDs: []string{partUUID.String()}}).
+					Return([]model.Part{{UUID: partUUID, Category: "ENGINE"}}, nil).Once()
+
+				s.repo.On("Transition", s.ctx, failedOrder, failTransition(model.OrderStatusPAID, 1), mock.MatchedBy(func(msg model.OutboxMessage) bool {
+					var pb eventsV1.AssemblyRequested
+					if msg.EventType != model.EventTypeAssemblyRequested || proto.Unmarshal(msg.Payload, &pb) != nil {
+						return false
+					}
+					return pb.OrderUuid == orderUUID.String() &&
+						pb.Attempt == 2 &&
+						len(pb.Parts) == 1 &&
+						pb.Parts[0].Quantity == 3 &&
+						pb.Parts[0].Category == "ENGINE"
+				})).Return(nil).Once()
+			},
+		},
+		{
+			name:      "reassemble policy attempts exhausted",
+			policy:    reassemble,
+			attempt:   2,
+			order:     failedOrder,
+			setupMock: func() { expectRefund(model.OrderStatusASSEMBLYFAILED, 2, nil) },
+		},
+		{
+			name:      "refunded order is ignored",
+			policy:    reassemble,
+			attempt:   1,
+			order:     refundedOrder,
+			setupMock: func() {},
+		},
+		{
+			name:        "refund error",
+			policy:      AssemblyPolicy{OnFailure: model.AssemblyFailurePolicyREFUND, MaxAttempts: 1},
+			attempt:     1,
+			order:       paidOrder,
+			setupMock:   func() { expectRefund(model.OrderStatusPAID, 1, refundErr) },
+			expectedErr: refundErr,
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			s.service.assemblyPolicy = tt.policy
+			defer func() { s.service.assemblyPolicy = AssemblyPolicy{} }()
+
+			s.repo.On("Get", s.ctx, orderUUID).Return(tt.order, nil).Once()
+			tt.setupMock()
+
+			e := event
+			e.Attempt = tt.attempt
+
+			err := s.service.AssemblyFailed(s.ctx, e)
+
+			s.Require().Equal(tt.expectedErr, err)
+		})
+	}
+}
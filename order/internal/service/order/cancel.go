@@ -2,31 +2,104 @@ package order
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
+	"go.uber.org/zap"
 
 	"github.com/crafty-ezhik/rocket-factory/order/internal/model"
+	"github.com/crafty-ezhik/rocket-factory/platform/pkg/logger"
 )
 
+// reasonCancelledByUser - причина перехода при отмене заказа пользователем, в том числе с возвратом средств
+const reasonCancelledByUser = "cancelled by user"
+
 func (s *service) Cancel(ctx context.Context, orderID uuid.UUID) error {
 	order, err := s.orderRepo.Get(ctx, orderID)
 	if err != nil {
 		return err
 	}
 
-	switch order.Status {
-	case model.OrderStatusCANCELLED:
-		return model.ErrOrderIsCancel
-	case model.OrderStatusPAID:
+	switch {
+	case model.CanTransition(order.Status, model.OrderTriggerCANCEL):
+	case model.CanTransition(order.Status, model.OrderTriggerREFUND):
+		// Оплаченный заказ отменяется компенсацией оплаты
+		return s.refund(ctx, order, reasonCancelledByUser)
+	case order.Status == model.OrderStatusASSEMBLED:
 		return model.ErrOrderIsPaid
+	default:
+		return model.ErrOrderIsCancel
 	}
 
-	order.Status = model.OrderStatusCANCELLED
+	transition, err := order.Transition(model.OrderTriggerCANCEL, reasonCancelledByUser)
+	if err != nil {
+		return err
+	}
 
-	err = s.orderRepo.Update(ctx, order)
+	err = s.orderRepo.Transition(ctx, order, transition)
 	if err != nil {
 		return err
 	}
 
+	s.releaseReservation(ctx, order.UUID)
+
 	return nil
 }
+
+// refund - компенсирует оплату заказа: возвращает средства и сообщает об отмене сборке и уведомлениям
+func (s *service) refund(ctx context.Context, order model.Order, reason string) error {
+	transition, err := order.Transition(model.OrderTriggerREFUND, reason)
+	if err != nil {
+		return err
+	}
+
+	refundedAmount, err := s.refundPayment(ctx, order)
+	if err != nil {
+		return err
+	}
+
+	// Формируем событие для остановки сборки и уведомления пользователя
+	event := model.OrderRefundedEvent{
+		EventUUID:       uuid.New(),
+		OrderUUID:       order.UUID,
+		UserUUID:        order.UserUUID,
+		TransactionUUID: order.TransactionUUID,
+		RefundedAmount:  refundedAmount,
+		RefundedAt:      time.Now(),
+	}
+
+	payload, err := s.orderRefundedEncoder.Encode(event)
+	if err != nil {
+		logger.Error(ctx, "Failed to encode OrderRefunded event", zap.Error(err))
+		return err
+	}
+
+	// Сохраняем заказ и событие в одной транзакции, в Kafka событие отправит outbox relay
+	return s.orderRepo.Transition(ctx, order, transition, model.OutboxMessage{
+		EventUUID: event.EventUUID,
+		EventType: model.EventTypeOrderRefunded,
+		Key:       []byte(order.UUID.String()),
+		Payload:   payload,
+	})
+}
+
+// refundPayment - возвращает средства по транзакции заказа и сумму возврата.
+//
+//	Запрашивается только полный возврат: повторный полный возврат уже возвращенной транзакции
+//	PaymentService считает успешным, поэтому повторная компенсация после сбоя не вернет средства дважды.
+//	Частичные возвраты так не защищены, и заказ их не использует. Пока возврат выполняется
+//	у провайдера, повторный запрос получает FailedPrecondition и компенсацию нужно повторить позже
+func (s *service) refundPayment(ctx context.Context, order model.Order) (float64, error) {
+	ctxReq, cancel := context.WithTimeout(ctx, time.Second*3)
+	defer cancel()
+
+	refundedAmount, err := s.paymentClient.RefundPayment(ctxReq, order.TransactionUUID)
+	if err != nil {
+		logger.Error(ctx, "Ошибка при возврате средств по заказу",
+			zap.String("order_uuid", order.UUID.String()),
+			zap.Error(err),
+		)
+		return 0, err
+	}
+	return refundedAmount, nil
+}
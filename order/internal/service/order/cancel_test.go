@@ -5,26 +5,60 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
 
 	"github.com/crafty-ezhik/rocket-factory/order/internal/model"
 )
 
 /*
 Success:
-1. Заказ успешно отменен -> nil
-2.
-3.
+1. Заказ успешно отменен, резерв деталей снят -> nil
+2. Оплаченный заказ отменен с возвратом средств -> nil
+3. Заказ с ошибкой сборки отменен с возвратом средств -> nil
+4. Ошибка снятия резерва не мешает отмене -> nil
 
 Failure:
 1. Заказ не найден -> model.Order{}, model.ErrOrderNotFound
 2. Заказ уже отменен ->model.ErrOrderIsCancel
-3. Заказ уже оплачен, нельзя отменить -> ErrOrderIsPaid
+3. Заказ уже собран, нельзя отменить -> ErrOrderIsPaid
 4. Внутренняя ошибка сервера -> model.Order{}, dbErr := errors.New("db_error")
+5. Ошибка возврата средств -> ошибка PaymentService
+6. Статус заказа изменен конкурентно -> model.ErrOrderStatusConflict
 */
 
 func (s *ServiceSuite) TestCancelOrder() {
 	dbErr := errors.New("DB error")
+	refundErr := errors.New("refund error")
 	orderUUID := uuid.New()
+	userUUID := uuid.New()
+	transactionUUID := uuid.New()
+
+	paidOrder := model.Order{
+		UUID:            orderUUID,
+		UserUUID:        userUUID,
+		TotalPrice:      1500,
+		TransactionUUID: transactionUUID,
+		Status:          model.OrderStatusPAID,
+	}
+	refundedOrder := paidOrder
+	refundedOrder.Status = model.OrderStatusREFUNDED
+
+	cancelTransition := model.OrderTransition{
+		OrderUUID: orderUUID,
+		From:      model.OrderStatusPENDINGPAYMENT,
+		To:        model.OrderStatusCANCELLED,
+		Trigger:   model.OrderTriggerCANCEL,
+		Reason:    reasonCancelledByUser,
+	}
+	refundTransition := func(from model.OrderStatus) model.OrderTransition {
+		return model.OrderTransition{
+			OrderUUID: orderUUID,
+			From:      from,
+			To:        model.OrderStatusREFUNDED,
+			Trigger:   model.OrderTriggerREFUND,
+			Reason:    reasonCancelledByUser,
+		}
+	}
 
 	tests := []struct {
 		name        string
@@ -39,7 +73,7 @@ func (s *ServiceSuite) TestCancelOrder() {
 			order: model.Order{
 				UUID:            orderUUID,
 				UserUUID:        uuid.UUID{},
-				PartUUIDs:       nil,
+				Items:           nil,
 				TotalPrice:      0,
 				TransactionUUID: uuid.UUID{},
 				Status:          model.OrderStatusCANCELLED,
@@ -51,8 +85,26 @@ func (s *ServiceSuite) TestCancelOrder() {
 				s.repo.On("Get", s.ctx, orderID).
 					Return(model.Order{UUID: orderUUID, Status: model.OrderStatusPENDINGPAYMENT}, nil).Once()
 
-				s.repo.On("Update", s.ctx, order).
+				s.repo.On("Transition", s.ctx, order, cancelTransition).
+					Return(nil).Once()
+
+				s.inventoryClient.On("ReleaseReservation", mock.Anything, orderID).
+					Return(nil).Once()
+			},
+		},
+		{
+			name:      "release reservation error is ignored",
+			orderUUID: orderUUID,
+			order:     model.Order{UUID: orderUUID, Status: model.OrderStatusCANCELLED},
+			setupMock: func(orderID uuid.UUID, order model.Order, err error) {
+				s.repo.On("Get", s.ctx, orderID).
+					Return(model.Order{UUID: orderUUID, Status: model.OrderStatusPENDINGPAYMENT}, nil).Once()
+
+				s.repo.On("Transition", s.ctx, order, cancelTransition).
 					Return(nil).Once()
+
+				s.inventoryClient.On("ReleaseReservation", mock.Anything, orderID).
+					Return(errors.New("inventory unavailable")).Once()
 			},
 		},
 		{
@@ -74,12 +126,72 @@ func (s *ServiceSuite) TestCancelOrder() {
 			},
 		},
 		{
-			name:        "paid order cannot be cancelled",
+			name:        "paid order is refunded",
+			orderUUID:   orderUUID,
+			order:       refundedOrder,
+			expectedErr: nil,
+			setupMock: func(orderID uuid.UUID, order model.Order, err error) {
+				s.repo.On("Get", s.ctx, orderID).
+					Return(paidOrder, nil).Once()
+
+				s.paymentClient.On("RefundPayment", mock.Anything, transactionUUID).
+					Return(1500.0, nil).Once()
+
+				s.repo.On("Transition", s.ctx, order, refundTransition(model.OrderStatusPAID), mock.MatchedBy(func(msg model.OutboxMessage) bool {
+					return msg.EventType == model.EventTypeOrderRefunded &&
+						string(msg.Key) == orderUUID.String() &&
+						len(msg.Payload) > 0
+				})).
+					Return(nil).Once()
+			},
+		},
+		{
+			name:      "assembly failed order is refunded",
+			orderUUID: orderUUID,
+			order:     refundedOrder,
+			setupMock: func(orderID uuid.UUID, order model.Order, err error) {
+				failedOrder := paidOrder
+				failedOrder.Status = model.OrderStatusASSEMBLYFAILED
+				s.repo.On("Get", s.ctx, orderID).
+					Return(failedOrder, nil).Once()
+
+				s.paymentClient.On("RefundPayment", mock.Anything, transactionUUID).
+					Return(1500.0, nil).Once()
+
+				s.repo.On("Transition", s.ctx, order, refundTransition(model.OrderStatusASSEMBLYFAILED), mock.MatchedBy(func(msg model.OutboxMessage) bool {
+					return msg.EventType == model.EventTypeOrderRefunded
+				})).
+					Return(nil).Once()
+			},
+		},
+		{
+			name:        "refunded order already cancelled",
+			orderUUID:   orderUUID,
+			expectedErr: model.ErrOrderIsCancel,
+			setupMock: func(orderID uuid.UUID, order model.Order, err error) {
+				s.repo.On("Get", s.ctx, orderID).
+					Return(refundedOrder, nil).Once()
+			},
+		},
+		{
+			name:        "assembled order cannot be cancelled",
 			orderUUID:   orderUUID,
 			expectedErr: model.ErrOrderIsPaid,
 			setupMock: func(orderID uuid.UUID, order model.Order, err error) {
 				s.repo.On("Get", s.ctx, orderID).
-					Return(model.Order{UUID: orderUUID, Status: model.OrderStatusPAID}, nil).Once()
+					Return(model.Order{UUID: orderUUID, Status: model.OrderStatusASSEMBLED}, nil).Once()
+			},
+		},
+		{
+			name:        "refund error",
+			orderUUID:   orderUUID,
+			expectedErr: refundErr,
+			setupMock: func(orderID uuid.UUID, order model.Order, err error) {
+				s.repo.On("Get", s.ctx, orderID).
+					Return(paidOrder, nil).Once()
+
+				s.paymentClient.On("RefundPayment", mock.Anything, transactionUUID).
+					Return(0.0, refundErr).Once()
 			},
 		},
 		{
@@ -89,12 +201,25 @@ func (s *ServiceSuite) TestCancelOrder() {
 			expectedErr: dbErr,
 			setupMock: func(orderID uuid.UUID, order model.Order, err error) {
 				s.repo.On("Get", s.ctx, orderID).
-					Return(model.Order{UUID: orderUUID}, nil).Once()
+					Return(model.Order{UUID: orderUUID, Status: model.OrderStatusPENDINGPAYMENT}, nil).Once()
 
-				s.repo.On("Update", s.ctx, order).
+				s.repo.On("Transition", s.ctx, order, cancelTransition).
 					Return(dbErr).Once()
 			},
 		},
+		{
+			name:        "status changed concurrently",
+			orderUUID:   orderUUID,
+			order:       model.Order{UUID: orderUUID, Status: model.OrderStatusCANCELLED},
+			expectedErr: model.ErrOrderStatusConflict,
+			setupMock: func(orderID uuid.UUID, order model.Order, err error) {
+				s.repo.On("Get", s.ctx, orderID).
+					Return(model.Order{UUID: orderUUID, Status: model.OrderStatusPENDINGPAYMENT}, nil).Once()
+
+				s.repo.On("Transition", s.ctx, order, cancelTransition).
+					Return(model.ErrOrderStatusConflict).Once()
+			},
+		},
 	}
 	for _, tt := range tests {
 		s.Run(tt.name, func() {
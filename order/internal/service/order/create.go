@@ -6,65 +6,124 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"go.uber.org/zap"
 
 	"github.com/crafty-ezhik/rocket-factory/order/internal/model"
+	"github.com/crafty-ezhik/rocket-factory/platform/pkg/logger"
 )
 
-func (s *service) Create(ctx context.Context, userID uuid.UUID, partsIDs []uuid.UUID) (uuid.UUID, float64, error) {
-	partStrUUIDs := convertUUIDStoStrings(partsIDs)
+func (s *service) Create(ctx context.Context, userID uuid.UUID, items []model.OrderItem) (uuid.UUID, float64, error) {
+	items, err := mergeOrderItems(items)
+	if err != nil {
+		return uuid.Nil, 0, err
+	}
 
 	ctxReq, cancel := context.WithTimeout(ctx, time.Second*3)
 	defer cancel()
 
-	parts, err := s.inventoryClient.ListParts(ctxReq, model.PartsFilter{UUIDs: partStrUUIDs})
+	parts, err := s.inventoryClient.ListParts(ctxReq, model.PartsFilter{UUIDs: orderItemsPartUUIDs(items)})
 	if err != nil {
 		// logger.Error(ctx, "Превышено время запроса к InventoryService", zap.Error(err))
 		return uuid.Nil, 0, context.DeadlineExceeded
 	}
 
-	totalPrice, err := checkPartsAndCountTotalPrice(partStrUUIDs, parts)
+	items, totalPrice, err := priceOrderItems(items, parts)
 	if err != nil {
 		return uuid.Nil, 0, err
 	}
 
+	// UUID заказа формируется заранее, чтобы зарезервировать под него детали на складе
 	newOrder := model.Order{
+		UUID:            uuid.New(),
 		UserUUID:        userID,
-		PartUUIDs:       partsIDs,
+		Items:           items,
 		TotalPrice:      totalPrice,
 		TransactionUUID: uuid.Nil,
 		PaymentMethod:   model.PaymentMethodUNKNOWN,
-		Status:          model.OrderStatusPENDINGPAYMENT,
 	}
 
-	orderUUID, err := s.orderRepo.Create(ctx, newOrder)
+	transition, err := newOrder.Transition(model.OrderTriggerCREATE, "")
 	if err != nil {
 		return uuid.Nil, 0, err
 	}
+
+	ctxReserve, cancelReserve := context.WithTimeout(ctx, time.Second*3)
+	defer cancelReserve()
+
+	err = s.inventoryClient.ReserveParts(ctxReserve, newOrder.UUID, orderItemsToReservation(items))
+	if err != nil {
+		logger.Error(ctx, "Ошибка резервирования деталей", zap.Error(err))
+		return uuid.Nil, 0, err
+	}
+
+	orderUUID, err := s.orderRepo.Create(ctx, newOrder, transition)
+	if err != nil {
+		s.releaseReservation(ctx, newOrder.UUID)
+		return uuid.Nil, 0, err
+	}
 	return orderUUID, totalPrice, nil
 }
 
-func convertUUIDStoStrings(parts []uuid.UUID) []string {
-	partsUUID := make([]string, 0, len(parts))
-	for _, partUUID := range parts {
-		partsUUID = append(partsUUID, partUUID.String())
+// mergeOrderItems - проверяет позиции и объединяет повторяющиеся детали, сохраняя порядок
+func mergeOrderItems(items []model.OrderItem) ([]model.OrderItem, error) {
+	if len(items) == 0 {
+		return nil, model.ErrOrderEmpty
+	}
+
+	merged := make([]model.OrderItem, 0, len(items))
+	index := make(map[uuid.UUID]int, len(items))
+	for _, item := range items {
+		if item.Quantity <= 0 {
+			return nil, fmt.Errorf("%w: part with uuid %s", model.ErrOrderInvalidQuantity, item.PartUUID)
+		}
+		if i, ok := index[item.PartUUID]; ok {
+			merged[i].Quantity += item.Quantity
+			continue
+		}
+		index[item.PartUUID] = len(merged)
+		merged = append(merged, model.OrderItem{PartUUID: item.PartUUID, Quantity: item.Quantity})
+	}
+	return merged, nil
+}
+
+func orderItemsPartUUIDs(items []model.OrderItem) []string {
+	partsUUID := make([]string, 0, len(items))
+	for _, item := range items {
+		partsUUID = append(partsUUID, item.PartUUID.String())
 	}
 	return partsUUID
 }
 
-func checkPartsAndCountTotalPrice(partsUUID []string, parts []model.Part) (float64, error) {
+// priceOrderItems - фиксирует в позициях текущие цену и название деталей и считает итоговую сумму заказа
+func priceOrderItems(items []model.OrderItem, parts []model.Part) ([]model.OrderItem, float64, error) {
+	partsByUUID := make(map[uuid.UUID]model.Part, len(parts))
+	for _, part := range parts {
+		partsByUUID[part.UUID] = part
+	}
+
 	totalPrice := 0.0
-	for _, UUID := range partsUUID {
-		exist := false
-		for _, part := range parts {
-			if part.UUID.String() == UUID {
-				exist = true
-				totalPrice += part.Price
-				break
-			}
+	priced := make([]model.OrderItem, len(items))
+	for i, item := range items {
+		part, ok := partsByUUID[item.PartUUID]
+		if !ok {
+			return nil, 0, fmt.Errorf("%w: part with uuid %s not found", model.ErrOrderPartNotFound, item.PartUUID)
 		}
-		if !exist {
-			return 0, fmt.Errorf("%w: part with uuid %s not found", model.ErrOrderPartNotFound, UUID)
+
+		item.UnitPrice = part.Price
+		item.Name = part.Name
+		priced[i] = item
+		totalPrice += part.Price * float64(item.Quantity)
+	}
+	return priced, totalPrice, nil
+}
+
+func orderItemsToReservation(items []model.OrderItem) []model.ReservationItem {
+	reservation := make([]model.ReservationItem, len(items))
+	for i, item := range items {
+		reservation[i] = model.ReservationItem{
+			PartUUID: item.PartUUID,
+			Quantity: item.Quantity,
 		}
 	}
-	return totalPrice, nil
+	return reservation
 }
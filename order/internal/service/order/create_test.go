@@ -19,11 +19,15 @@ func (s *ServiceSuite) TestCreateOrderSuccess() {
 		uuid.MustParse("d195a37b-f2cb-48e6-b739-29db0ddcc197"),
 		uuid.MustParse("a79178c5-a082-4884-b214-ee69e3972840"),
 	}
+	items := []model.OrderItem{
+		{PartUUID: partIDs[0], Quantity: 1},
+		{PartUUID: partIDs[1], Quantity: 1},
+	}
 
 	tests := []struct {
 		name               string
 		userID             uuid.UUID
-		partIDs            []uuid.UUID
+		items              []model.OrderItem
 		expectedOrderID    uuid.UUID
 		expectedTotalPrice float64
 		expectedErr        error
@@ -32,7 +36,7 @@ func (s *ServiceSuite) TestCreateOrderSuccess() {
 		{
 			name:               "success",
 			userID:             userID,
-			partIDs:            partIDs,
+			items:              items,
 			expectedOrderID:    orderID,
 			expectedTotalPrice: 300,
 			expectedErr:        nil,
@@ -46,7 +50,14 @@ func (s *ServiceSuite) TestCreateOrderSuccess() {
 					},
 						nil).Once()
 
-				s.repo.On("Create", s.ctx, mock.Anything).Return(orderID, nil)
+				s.inventoryClient.On("ReserveParts", mock.Anything, mock.AnythingOfType("uuid.UUID"), []model.ReservationItem{
+					{PartUUID: partIDs[0], Quantity: 1},
+					{PartUUID: partIDs[1], Quantity: 1},
+				}).
+					Return(nil).
+					Once()
+
+				s.repo.On("Create", s.ctx, mock.Anything, mock.Anything).Return(orderID, nil)
 			},
 		},
 	}
@@ -55,7 +66,7 @@ func (s *ServiceSuite) TestCreateOrderSuccess() {
 		s.Run(tt.name, func() {
 			tt.setupMocks()
 
-			orderUUID, totalPrice, err := s.service.Create(s.ctx, tt.userID, tt.partIDs)
+			orderUUID, totalPrice, err := s.service.Create(s.ctx, tt.userID, tt.items)
 
 			s.Require().NoError(err)
 			s.Require().Equal(tt.expectedOrderID, orderUUID)
@@ -64,6 +75,55 @@ func (s *ServiceSuite) TestCreateOrderSuccess() {
 	}
 }
 
+func (s *ServiceSuite) TestCreateOrderComputesTotalFromItems() {
+	userID := uuid.MustParse("00000000-0000-0000-0000-000000000001")
+	orderID := uuid.MustParse("00000000-0000-0000-0000-000000000002")
+	engineID := uuid.MustParse("d195a37b-f2cb-48e6-b739-29db0ddcc197")
+	wingID := uuid.MustParse("a79178c5-a082-4884-b214-ee69e3972840")
+
+	s.inventoryClient.On("ListParts", mock.Anything, model.PartsFilter{
+		UUIDs: []string{engineID.String(), wingID.String()},
+	}).
+		Return([]model.Part{
+			{UUID: wingID, Name: "Wing", Price: 50},
+			{UUID: engineID, Name: "Engine", Price: 100},
+		}, nil).
+		Once()
+
+	s.inventoryClient.On("ReserveParts", mock.Anything, mock.AnythingOfType("uuid.UUID"), []model.ReservationItem{
+		{PartUUID: engineID, Quantity: 4},
+		{PartUUID: wingID, Quantity: 2},
+	}).
+		Return(nil).
+		Once()
+
+	s.repo.On("Create", s.ctx, mock.MatchedBy(func(order model.Order) bool {
+		return order.UUID != uuid.Nil &&
+			order.TotalPrice == 500 &&
+			len(order.Items) == 2 &&
+			order.Items[0] == model.OrderItem{PartUUID: engineID, Quantity: 4, UnitPrice: 100, Name: "Engine"} &&
+			order.Items[1] == model.OrderItem{PartUUID: wingID, Quantity: 2, UnitPrice: 50, Name: "Wing"} &&
+			order.Status == model.OrderStatusPENDINGPAYMENT
+	}), mock.MatchedBy(func(transition model.OrderTransition) bool {
+		return transition.From == "" &&
+			transition.To == model.OrderStatusPENDINGPAYMENT &&
+			transition.Trigger == model.OrderTriggerCREATE
+	})).
+		Return(orderID, nil).
+		Once()
+
+	// Повторяющиеся детали объединяются в одну позицию
+	orderUUID, totalPrice, err := s.service.Create(s.ctx, userID, []model.OrderItem{
+		{PartUUID: engineID, Quantity: 3},
+		{PartUUID: wingID, Quantity: 2},
+		{PartUUID: engineID, Quantity: 1},
+	})
+
+	s.Require().NoError(err)
+	s.Require().Equal(orderID, orderUUID)
+	s.Require().Equal(float64(500), totalPrice)
+}
+
 func (s *ServiceSuite) TestCreateOrder() {
 	userID := uuid.MustParse("00000000-0000-0000-0000-000000000001")
 
@@ -71,14 +131,19 @@ func (s *ServiceSuite) TestCreateOrder() {
 		uuid.MustParse("d195a37b-f2cb-48e6-b739-29db0ddcc197"),
 		uuid.MustParse("a79178c5-a082-4884-b214-ee69e3972840"),
 	}
+	items := []model.OrderItem{
+		{PartUUID: partIDs[0], Quantity: 1},
+		{PartUUID: partIDs[1], Quantity: 1},
+	}
 
 	clientErr := errors.New("client error")
 	dbErr := errors.New("something went wrong")
+	outOfStockErr := fmt.Errorf("%w: %s (requested 1, available 0)", model.ErrOrderPartsOutOfStock, partIDs[1])
 
 	tests := []struct {
 		name               string
 		userID             uuid.UUID
-		partIDs            []uuid.UUID
+		items              []model.OrderItem
 		expectedOrderID    uuid.UUID
 		expectedTotalPrice float64
 		expectedErr        error
@@ -87,7 +152,7 @@ func (s *ServiceSuite) TestCreateOrder() {
 		{
 			name:               "client error",
 			userID:             userID,
-			partIDs:            partIDs,
+			items:              items,
 			expectedOrderID:    uuid.Nil,
 			expectedTotalPrice: 0,
 			expectedErr:        context.DeadlineExceeded,
@@ -102,7 +167,7 @@ func (s *ServiceSuite) TestCreateOrder() {
 		{
 			name:               "db error",
 			userID:             userID,
-			partIDs:            partIDs,
+			items:              items,
 			expectedOrderID:    uuid.Nil,
 			expectedTotalPrice: 0,
 			expectedErr:        dbErr,
@@ -117,15 +182,67 @@ func (s *ServiceSuite) TestCreateOrder() {
 						nil).
 					Once()
 
-				s.repo.On("Create", s.ctx, mock.Anything).
+				s.inventoryClient.On("ReserveParts", mock.Anything, mock.AnythingOfType("uuid.UUID"), []model.ReservationItem{
+					{PartUUID: partIDs[0], Quantity: 1},
+					{PartUUID: partIDs[1], Quantity: 1},
+				}).
+					Return(nil).
+					Once()
+
+				s.repo.On("Create", s.ctx, mock.Anything, mock.Anything).
 					Return(uuid.Nil, dbErr).
 					Once()
+
+				s.inventoryClient.On("ReleaseReservation", mock.Anything, mock.AnythingOfType("uuid.UUID")).
+					Return(nil).
+					Once()
 			},
 		},
+		{
+			name:               "parts out of stock",
+			userID:             userID,
+			items:              items,
+			expectedOrderID:    uuid.Nil,
+			expectedTotalPrice: 0,
+			expectedErr:        outOfStockErr,
+			setupMocks: func() {
+				s.inventoryClient.On("ListParts", mock.Anything, model.PartsFilter{
+					UUIDs: []string{partIDs[0].String(), partIDs[1].String()},
+				}).
+					Return([]model.Part{
+						{UUID: partIDs[0], Price: 100},
+						{UUID: partIDs[1], Price: 200},
+					},
+						nil).
+					Once()
+
+				s.inventoryClient.On("ReserveParts", mock.Anything, mock.AnythingOfType("uuid.UUID"), mock.Anything).
+					Return(outOfStockErr).
+					Once()
+			},
+		},
+		{
+			name:               "empty order",
+			userID:             userID,
+			items:              nil,
+			expectedOrderID:    uuid.Nil,
+			expectedTotalPrice: 0,
+			expectedErr:        model.ErrOrderEmpty,
+			setupMocks:         func() {},
+		},
+		{
+			name:               "invalid quantity",
+			userID:             userID,
+			items:              []model.OrderItem{{PartUUID: partIDs[0], Quantity: 0}},
+			expectedOrderID:    uuid.Nil,
+			expectedTotalPrice: 0,
+			expectedErr:        fmt.Errorf("%w: part with uuid %s", model.ErrOrderInvalidQuantity, partIDs[0]),
+			setupMocks:         func() {},
+		},
 		{
 			name:               "part not found",
 			userID:             userID,
-			partIDs:            partIDs,
+			items:              items,
 			expectedOrderID:    uuid.Nil,
 			expectedTotalPrice: 0,
 			expectedErr:        fmt.Errorf("part not found: part with uuid a79178c5-a082-4884-b214-ee69e3972840 not found"),
@@ -145,7 +262,7 @@ func (s *ServiceSuite) TestCreateOrder() {
 		s.Run(tt.name, func() {
 			tt.setupMocks()
 
-			orderUUID, totalPrice, err := s.service.Create(s.ctx, tt.userID, tt.partIDs)
+			orderUUID, totalPrice, err := s.service.Create(s.ctx, tt.userID, tt.items)
 			s.Require().Error(err)
 			s.Require().Contains(tt.expectedErr.Error(), err.Error())
 
@@ -0,0 +1,73 @@
+package order
+
+import (
+	"context"
+
+	"github.com/crafty-ezhik/rocket-factory/order/internal/model"
+)
+
+const (
+	defaultOrdersLimit = 20
+	maxOrdersLimit     = 100
+)
+
+// List - возвращает страницу заказов по фильтру и курсор на следующую страницу
+func (s *service) List(ctx context.Context, filter model.OrdersFilter) (model.OrdersPage, error) {
+	if filter.Sort == "" {
+		filter.Sort = model.OrderSortCREATEDATDESC
+	}
+	if filter.Limit == 0 {
+		filter.Limit = defaultOrdersLimit
+	}
+
+	if err := validateOrdersFilter(filter); err != nil {
+		return model.OrdersPage{}, err
+	}
+
+	limit := filter.Limit
+	// Запрашиваем на один заказ больше, чтобы понять, есть ли следующая страница
+	filter.Limit++
+
+	orders, err := s.orderRepo.List(ctx, filter)
+	if err != nil {
+		return model.OrdersPage{}, err
+	}
+
+	page := model.OrdersPage{Orders: orders}
+	if uint64(len(orders)) > limit {
+		page.Orders = orders[:limit]
+		last := page.Orders[limit-1]
+		page.NextCursor = &model.OrdersCursor{
+			Sort:       filter.Sort,
+			CreatedAt:  last.CreatedAt,
+			TotalPrice: last.TotalPrice,
+			OrderUUID:  last.UUID,
+		}
+	}
+	return page, nil
+}
+
+func validateOrdersFilter(filter model.OrdersFilter) error {
+	switch filter.Sort {
+	case model.OrderSortCREATEDATDESC, model.OrderSortCREATEDATASC,
+		model.OrderSortTOTALPRICEDESC, model.OrderSortTOTALPRICEASC:
+	default:
+		return model.ErrInvalidOrdersFilter
+	}
+
+	if filter.Limit > maxOrdersLimit {
+		return model.ErrInvalidOrdersFilter
+	}
+	if filter.MinTotal != nil && filter.MaxTotal != nil && *filter.MinTotal > *filter.MaxTotal {
+		return model.ErrInvalidOrdersFilter
+	}
+	if filter.CreatedFrom != nil && filter.CreatedTo != nil && !filter.CreatedFrom.Before(*filter.CreatedTo) {
+		return model.ErrInvalidOrdersFilter
+	}
+
+	// Курсор действителен только для той сортировки, с которой он был выдан
+	if filter.Cursor != nil && filter.Cursor.Sort != filter.Sort {
+		return model.ErrInvalidOrdersCursor
+	}
+	return nil
+}
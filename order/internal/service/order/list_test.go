@@ -0,0 +1,144 @@
+package order
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/crafty-ezhik/rocket-factory/order/internal/model"
+)
+
+/*
+Success:
+1. Заказов больше лимита -> страница обрезается, возвращается курсор по последнему заказу
+2. Последняя страница -> NextCursor == nil
+3. Пустые сортировка и лимит -> подставляются значения по умолчанию
+
+Failure:
+1. min_total больше max_total -> model.ErrInvalidOrdersFilter
+2. created_from не раньше created_to -> model.ErrInvalidOrdersFilter
+3. Курсор выдан для другой сортировки -> model.ErrInvalidOrdersCursor
+4. Ошибка БД -> dbErr
+*/
+
+func (s *ServiceSuite) TestListOrdersSuccess() {
+	createdAt := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	first := model.Order{UUID: uuid.New(), TotalPrice: 300, CreatedAt: createdAt}
+	second := model.Order{UUID: uuid.New(), TotalPrice: 200, CreatedAt: createdAt.Add(-time.Hour)}
+	third := model.Order{UUID: uuid.New(), TotalPrice: 100, CreatedAt: createdAt.Add(-2 * time.Hour)}
+
+	tests := []struct {
+		name         string
+		filter       model.OrdersFilter
+		repoFilter   model.OrdersFilter
+		repoOrders   []model.Order
+		expectedPage model.OrdersPage
+	}{
+		{
+			name:       "has next page",
+			filter:     model.OrdersFilter{Sort: model.OrderSortTOTALPRICEDESC, Limit: 2},
+			repoFilter: model.OrdersFilter{Sort: model.OrderSortTOTALPRICEDESC, Limit: 3},
+			repoOrders: []model.Order{first, second, third},
+			expectedPage: model.OrdersPage{
+				Orders: []model.Order{first, second},
+				NextCursor: &model.OrdersCursor{
+					Sort:       model.OrderSortTOTALPRICEDESC,
+					CreatedAt:  second.CreatedAt,
+					TotalPrice: second.TotalPrice,
+					OrderUUID:  second.UUID,
+				},
+			},
+		},
+		{
+			name:         "last page",
+			filter:       model.OrdersFilter{Sort: model.OrderSortCREATEDATASC, Limit: 3},
+			repoFilter:   model.OrdersFilter{Sort: model.OrderSortCREATEDATASC, Limit: 4},
+			repoOrders:   []model.Order{third, second, first},
+			expectedPage: model.OrdersPage{Orders: []model.Order{third, second, first}},
+		},
+		{
+			name:         "defaults",
+			filter:       model.OrdersFilter{},
+			repoFilter:   model.OrdersFilter{Sort: model.OrderSortCREATEDATDESC, Limit: defaultOrdersLimit + 1},
+			repoOrders:   []model.Order{},
+			expectedPage: model.OrdersPage{Orders: []model.Order{}},
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			s.repo.On("List", s.ctx, tt.repoFilter).
+				Return(tt.repoOrders, nil).
+				Once()
+
+			page, err := s.service.List(s.ctx, tt.filter)
+
+			s.Require().NoError(err)
+			s.Require().Equal(tt.expectedPage, page)
+		})
+	}
+}
+
+func (s *ServiceSuite) TestListOrdersFail() {
+	dbErr := errors.New("db error")
+	minTotal, maxTotal := 200.0, 100.0
+	createdFrom := time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC)
+	createdTo := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name        string
+		filter      model.OrdersFilter
+		expectedErr error
+		setupMock   func()
+	}{
+		{
+			name:        "min total greater than max total",
+			filter:      model.OrdersFilter{MinTotal: &minTotal, MaxTotal: &maxTotal},
+			expectedErr: model.ErrInvalidOrdersFilter,
+			setupMock:   func() {},
+		},
+		{
+			name:        "created from after created to",
+			filter:      model.OrdersFilter{CreatedFrom: &createdFrom, CreatedTo: &createdTo},
+			expectedErr: model.ErrInvalidOrdersFilter,
+			setupMock:   func() {},
+		},
+		{
+			name:        "unknown sort",
+			filter:      model.OrdersFilter{Sort: "NAME_ASC"},
+			expectedErr: model.ErrInvalidOrdersFilter,
+			setupMock:   func() {},
+		},
+		{
+			name: "cursor for another sort",
+			filter: model.OrdersFilter{
+				Sort:   model.OrderSortCREATEDATDESC,
+				Cursor: &model.OrdersCursor{Sort: model.OrderSortTOTALPRICEASC, OrderUUID: uuid.New()},
+			},
+			expectedErr: model.ErrInvalidOrdersCursor,
+			setupMock:   func() {},
+		},
+		{
+			name:        "db error",
+			filter:      model.OrdersFilter{Limit: 10},
+			expectedErr: dbErr,
+			setupMock: func() {
+				s.repo.On("List", s.ctx, model.OrdersFilter{Sort: model.OrderSortCREATEDATDESC, Limit: 11}).
+					Return(nil, dbErr).
+					Once()
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			tt.setupMock()
+
+			page, err := s.service.List(s.ctx, tt.filter)
+
+			s.Require().ErrorIs(err, tt.expectedErr)
+			s.Require().Equal(model.OrdersPage{}, page)
+		})
+	}
+}
@@ -5,55 +5,125 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"go.uber.org/zap"
 
 	"github.com/crafty-ezhik/rocket-factory/order/internal/model"
+	"github.com/crafty-ezhik/rocket-factory/platform/pkg/logger"
 )
 
+// reservationPayMargin - сколько резерв должен прожить после проверки, чтобы успеть оплатить заказ и подтвердить резерв
+const reservationPayMargin = 10 * time.Second
+
 func (s *service) Pay(ctx context.Context, orderID uuid.UUID, paymentMethod model.PaymentMethod) (uuid.UUID, error) {
 	order, err := s.orderRepo.Get(ctx, orderID)
 	if err != nil {
 		return uuid.Nil, err
 	}
 
-	if order.Status != model.OrderStatusPENDINGPAYMENT {
+	// Переход проверяется до списания средств, чтобы не оплатить отмененный или уже оплаченный заказ
+	if !model.CanTransition(order.Status, model.OrderTriggerPAY) {
 		return uuid.Nil, model.ErrOrderCannotPay
 	}
 
+	// Заказ с истекшим или снятым резервом не оплачивается: детали для сборки уже не обеспечены
+	if err = s.checkReservation(ctx, order.UUID); err != nil {
+		return uuid.Nil, err
+	}
+
+	err = runSaga(ctx, order.UUID,
+		sagaStep{
+			name: "pay",
+			action: func(ctx context.Context) error {
+				return s.charge(ctx, &order, paymentMethod)
+			},
+			compensate: func(ctx context.Context) error {
+				_, err := s.refundPayment(ctx, order)
+				return err
+			},
+		},
+		sagaStep{
+			// Детали резервируются при создании заказа, после оплаты резерв списывается окончательно.
+			// Списание необратимо, поэтому компенсации у шага нет
+			name: "reserve stock",
+			action: func(ctx context.Context) error {
+				return s.commitReservation(ctx, order.UUID)
+			},
+		},
+		sagaStep{
+			// Сборка запрашивается только для заказа со списанными деталями
+			name: "assemble",
+			action: func(ctx context.Context) error {
+				return s.requestAssembly(ctx, &order)
+			},
+		},
+	)
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	return order.TransactionUUID, nil
+}
+
+// charge - оплачивает заказ в PaymentService и запоминает транзакцию в заказе
+func (s *service) charge(ctx context.Context, order *model.Order, paymentMethod model.PaymentMethod) error {
 	ctxReq, cancel := context.WithTimeout(ctx, time.Second*3)
 	defer cancel()
 
-	// Оплачиваем заказ
-	strTransactionUUID, err := s.paymentClient.PayOrder(ctxReq, order.UUID, order.UserUUID, paymentMethod)
+	strTransactionUUID, err := s.paymentClient.PayOrder(ctxReq, order.UUID, order.UserUUID, paymentMethod, order.TotalPrice)
 	if err != nil {
-		// logger.Error(ctx, "Превышено время запроса к InventoryService", zap.Error(err))
-		return uuid.Nil, context.DeadlineExceeded
+		logger.Error(ctx, "Ошибка оплаты заказа в PaymentService",
+			zap.String("order_uuid", order.UUID.String()),
+			zap.Error(err),
+		)
+		return err
 	}
 
 	transactionUUID, err := uuid.Parse(strTransactionUUID)
 	if err != nil {
-		return uuid.Nil, err
+		return err
 	}
 
-	// Отправляем сообщение в Kafka для сборки заказа в Assembly Service
-	err = s.orderPaidProducer.ProduceOrderPaid(ctx, model.OrderPaidEvent{
+	order.PaymentMethod = paymentMethod
+	order.TransactionUUID = transactionUUID
+	return nil
+}
+
+// requestAssembly - переводит заказ в PAID и сохраняет событие для сборки заказа в AssemblyService.
+// Статус order меняется, только если заказ сохранен
+func (s *service) requestAssembly(ctx context.Context, order *model.Order) error {
+	event := model.OrderPaidEvent{
 		EventUUID:       uuid.New(),
 		OrderUUID:       order.UUID,
 		UserUUID:        order.UserUUID,
-		PaymentMethod:   paymentMethod.String(),
-		TransactionUUID: transactionUUID,
-	})
+		PaymentMethod:   order.PaymentMethod.String(),
+		TransactionUUID: order.TransactionUUID,
+		PaidAt:          time.Now(),
+		Parts:           s.assemblyParts(ctx, order.Items),
+	}
+
+	payload, err := s.orderPaidEncoder.Encode(event)
 	if err != nil {
-		return uuid.Nil, err
+		logger.Error(ctx, "Failed to encode OrderPaid event", zap.Error(err))
+		return err
 	}
 
-	// Обновляем данные по заказу
-	order.Status = model.OrderStatusPAID
-	order.PaymentMethod = paymentMethod
-	order.TransactionUUID = transactionUUID
+	paid := *order
+	transition, err := paid.Transition(model.OrderTriggerPAY, "")
+	if err != nil {
+		return err
+	}
 
-	err = s.orderRepo.Update(ctx, order)
+	// Сохраняем заказ и событие в одной транзакции, в Kafka событие отправит outbox relay
+	err = s.orderRepo.Transition(ctx, paid, transition, model.OutboxMessage{
+		EventUUID: event.EventUUID,
+		EventType: model.EventTypeOrderPaid,
+		Key:       []byte(order.UUID.String()),
+		Payload:   payload,
+	})
 	if err != nil {
-		return uuid.Nil, err
+		return err
 	}
-	return transactionUUID, nil
+
+	*order = paid
+	return nil
 }
@@ -2,11 +2,17 @@ package order
 
 import (
 	"errors"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/mock"
+	"google.golang.org/protobuf/proto"
 
+	clientMock "github.com/crafty-ezhik/rocket-factory/order/internal/client/grpc/mocks"
+	"github.com/crafty-ezhik/rocket-factory/order/internal/converter/kafka/encoder"
 	"github.com/crafty-ezhik/rocket-factory/order/internal/model"
+	repoMock "github.com/crafty-ezhik/rocket-factory/order/internal/repository/mocks"
+	eventsV1 "github.com/crafty-ezhik/rocket-factory/shared/pkg/proto/events/v1"
 )
 
 func (s *ServiceSuite) TestPayOrderSuccess() {
@@ -15,6 +21,13 @@ func (s *ServiceSuite) TestPayOrderSuccess() {
 	transactionUUID := uuid.MustParse("00000000-0000-0000-0000-000000000004")
 	paymentMethod := model.PaymentMethodCARD
 
+	payTransition := model.OrderTransition{
+		OrderUUID: orderId,
+		From:      model.OrderStatusPENDINGPAYMENT,
+		To:        model.OrderStatusPAID,
+		Trigger:   model.OrderTriggerPAY,
+	}
+
 	tests := []struct {
 		name           string
 		orderID        uuid.UUID
@@ -42,15 +55,23 @@ func (s *ServiceSuite) TestPayOrderSuccess() {
 					Return(model.Order{UUID: orderId, UserUUID: userId, Status: model.OrderStatusPENDINGPAYMENT}, nil).
 					Once()
 
-				s.paymentClient.On("PayOrder", mock.Anything, orderId, userId, paymentMethod).
+				s.inventoryClient.On("GetReservation", mock.Anything, orderId).
+					Return(s.activeReservation(), nil).
+					Once()
+
+				s.paymentClient.On("PayOrder", mock.Anything, orderId, userId, paymentMethod, float64(0)).
 					Return(transactionUUID.String(), nil).
 					Once()
 
-				s.orderPaidProducer.On("ProduceOrderPaid", s.ctx, mock.Anything).
+				s.inventoryClient.On("CommitReservation", mock.Anything, orderId).
 					Return(nil).
 					Once()
 
-				s.repo.On("Update", s.ctx, order).
+				s.repo.On("Transition", s.ctx, order, payTransition, mock.MatchedBy(func(msg model.OutboxMessage) bool {
+					return msg.EventType == model.EventTypeOrderPaid &&
+						string(msg.Key) == orderId.String() &&
+						len(msg.Payload) > 0
+				})).
 					Return(nil).
 					Once()
 			},
@@ -128,13 +149,17 @@ func (s *ServiceSuite) TestPayOrderFail() {
 			orderID:        orderId,
 			paymentMethod:  paymentMethod,
 			expectedResult: uuid.Nil,
-			expectedErr:    errors.New("context deadline exceeded"),
+			expectedErr:    clientErr,
 			setupMock: func(order model.Order) {
 				s.repo.On("Get", s.ctx, orderId).
 					Return(model.Order{UUID: orderId, UserUUID: userId, Status: model.OrderStatusPENDINGPAYMENT}, nil).
 					Once()
 
-				s.paymentClient.On("PayOrder", mock.Anything, orderId, userId, paymentMethod).
+				s.inventoryClient.On("GetReservation", mock.Anything, orderId).
+					Return(s.activeReservation(), nil).
+					Once()
+
+				s.paymentClient.On("PayOrder", mock.Anything, orderId, userId, paymentMethod, float64(0)).
 					Return("", clientErr).
 					Once()
 			},
@@ -150,13 +175,93 @@ func (s *ServiceSuite) TestPayOrderFail() {
 					Return(model.Order{UUID: orderId, UserUUID: userId, Status: model.OrderStatusPENDINGPAYMENT}, nil).
 					Once()
 
-				s.paymentClient.On("PayOrder", mock.Anything, orderId, userId, paymentMethod).
+				s.inventoryClient.On("GetReservation", mock.Anything, orderId).
+					Return(s.activeReservation(), nil).
+					Once()
+
+				s.paymentClient.On("PayOrder", mock.Anything, orderId, userId, paymentMethod, float64(0)).
 					Return("00000000-0000-0000-0000-00000000000333", nil).
 					Once()
 			},
 		},
 		{
-			name:    "db error",
+			name:           "reservation expires during payment",
+			orderID:        orderId,
+			paymentMethod:  paymentMethod,
+			expectedResult: uuid.Nil,
+			expectedErr:    model.ErrOrderReservationExpired,
+			setupMock: func(order model.Order) {
+				s.repo.On("Get", s.ctx, orderId).
+					Return(model.Order{UUID: orderId, UserUUID: userId, Status: model.OrderStatusPENDINGPAYMENT}, nil).
+					Once()
+
+				s.inventoryClient.On("GetReservation", mock.Anything, orderId).
+					Return(model.Reservation{Status: model.ReservationStatusACTIVE, ExpiresAt: s.now.Add(time.Second)}, nil).
+					Once()
+			},
+		},
+		{
+			name:           "reservation released",
+			orderID:        orderId,
+			paymentMethod:  paymentMethod,
+			expectedResult: uuid.Nil,
+			expectedErr:    model.ErrOrderReservationExpired,
+			setupMock: func(order model.Order) {
+				s.repo.On("Get", s.ctx, orderId).
+					Return(model.Order{UUID: orderId, UserUUID: userId, Status: model.OrderStatusPENDINGPAYMENT}, nil).
+					Once()
+
+				s.inventoryClient.On("GetReservation", mock.Anything, orderId).
+					Return(model.Reservation{Status: model.ReservationStatusRELEASED, ExpiresAt: s.now.Add(time.Minute)}, nil).
+					Once()
+			},
+		},
+		{
+			name:           "reservation not found",
+			orderID:        orderId,
+			paymentMethod:  paymentMethod,
+			expectedResult: uuid.Nil,
+			expectedErr:    model.ErrOrderReservationExpired,
+			setupMock: func(order model.Order) {
+				s.repo.On("Get", s.ctx, orderId).
+					Return(model.Order{UUID: orderId, UserUUID: userId, Status: model.OrderStatusPENDINGPAYMENT}, nil).
+					Once()
+
+				s.inventoryClient.On("GetReservation", mock.Anything, orderId).
+					Return(model.Reservation{}, model.ErrOrderReservationExpired).
+					Once()
+			},
+		},
+		{
+			name:           "compensation error returns step error",
+			orderID:        orderId,
+			paymentMethod:  paymentMethod,
+			expectedResult: uuid.Nil,
+			expectedErr:    clientErr,
+			setupMock: func(order model.Order) {
+				s.repo.On("Get", s.ctx, orderId).
+					Return(model.Order{UUID: orderId, UserUUID: userId, Status: model.OrderStatusPENDINGPAYMENT}, nil).
+					Once()
+
+				s.inventoryClient.On("GetReservation", mock.Anything, orderId).
+					Return(s.activeReservation(), nil).
+					Once()
+
+				s.paymentClient.On("PayOrder", mock.Anything, orderId, userId, paymentMethod, float64(0)).
+					Return(transactionUUID.String(), nil).
+					Once()
+
+				s.inventoryClient.On("CommitReservation", mock.Anything, orderId).
+					Return(clientErr).
+					Once()
+
+				s.paymentClient.On("RefundPayment", mock.Anything, transactionUUID).
+					Return(float64(0), errors.New("refund error")).
+					Once()
+			},
+		},
+		{
+			name:    "db error compensates payment",
 			orderID: orderId,
 			order: model.Order{
 				UUID:            orderId,
@@ -173,17 +278,25 @@ func (s *ServiceSuite) TestPayOrderFail() {
 					Return(model.Order{UUID: orderId, UserUUID: userId, Status: model.OrderStatusPENDINGPAYMENT}, nil).
 					Once()
 
-				s.paymentClient.On("PayOrder", mock.Anything, orderId, userId, paymentMethod).
+				s.inventoryClient.On("GetReservation", mock.Anything, orderId).
+					Return(s.activeReservation(), nil).
+					Once()
+
+				s.paymentClient.On("PayOrder", mock.Anything, orderId, userId, paymentMethod, float64(0)).
 					Return(transactionUUID.String(), nil).
 					Once()
 
-				s.orderPaidProducer.On("ProduceOrderPaid", s.ctx, mock.Anything).
+				s.inventoryClient.On("CommitReservation", mock.Anything, orderId).
 					Return(nil).
 					Once()
 
-				s.repo.On("Update", s.ctx, order).
+				s.repo.On("Transition", s.ctx, order, mock.Anything, mock.Anything).
 					Return(dbErr).
 					Once()
+
+				s.paymentClient.On("RefundPayment", mock.Anything, transactionUUID).
+					Return(float64(0), nil).
+					Once()
 			},
 		},
 	}
@@ -200,3 +313,131 @@ func (s *ServiceSuite) TestPayOrderFail() {
 		})
 	}
 }
+
+func (s *ServiceSuite) TestPayOrderAssemblyParts() {
+	orderId := uuid.MustParse("00000000-0000-0000-0000-000000000002")
+	userId := uuid.MustParse("00000000-0000-0000-0000-000000000003")
+	transactionUUID := uuid.MustParse("00000000-0000-0000-0000-000000000004")
+	engineUUID := uuid.MustParse("00000000-0000-0000-0000-000000000005")
+	wingUUID := uuid.MustParse("00000000-0000-0000-0000-000000000006")
+	paymentMethod := model.PaymentMethodCARD
+
+	items := []model.OrderItem{
+		{PartUUID: engineUUID, Quantity: 1},
+		{PartUUID: wingUUID, Quantity: 2},
+	}
+	filter := model.PartsFilter{UUIDs: []string{engineUUID.String(), wingUUID.String()}}
+
+	tests := []struct {
+		name          string
+		listParts     func()
+		expectedParts []*eventsV1.AssemblyPart
+	}{
+		{
+			name: "parts with characteristics",
+			listParts: func() {
+				s.inventoryClient.On("ListParts", mock.Anything, filter).
+					Return([]model.Part{
+						{UUID: wingUUID, Category: "WING", Dimensions: &model.Dimensions{Length: 500, Width: 200, Height: 30, Weight: 120}},
+						{UUID: engineUUID, Category: "ENGINE"},
+					}, nil).
+					Once()
+			},
+			expectedParts: []*eventsV1.AssemblyPart{
+				{PartUuid: engineUUID.String(), Quantity: 1, Category: "ENGINE"},
+				{
+					PartUuid:   wingUUID.String(),
+					Quantity:   2,
+					Category:   "WING",
+					Dimensions: &eventsV1.PartDimensions{Length: 500, Width: 200, Height: 30, Weight: 120},
+				},
+			},
+		},
+		{
+			name: "inventory error does not fail payment",
+			listParts: func() {
+				s.inventoryClient.On("ListParts", mock.Anything, filter).
+					Return(nil, errors.New("inventory unavailable")).
+					Once()
+			},
+			expectedParts: []*eventsV1.AssemblyPart{
+				{PartUuid: engineUUID.String(), Quantity: 1},
+				{PartUuid: wingUUID.String(), Quantity: 2},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			s.repo.On("Get", s.ctx, orderId).
+				Return(model.Order{UUID: orderId, UserUUID: userId, Items: items, Status: model.OrderStatusPENDINGPAYMENT}, nil).
+				Once()
+
+			s.inventoryClient.On("GetReservation", mock.Anything, orderId).
+				Return(s.activeReservation(), nil).
+				Once()
+
+			s.paymentClient.On("PayOrder", mock.Anything, orderId, userId, paymentMethod, float64(0)).
+				Return(transactionUUID.String(), nil).
+				Once()
+
+			s.inventoryClient.On("CommitReservation", mock.Anything, orderId).
+				Return(nil).
+				Once()
+
+			tt.listParts()
+
+			var payload []byte
+			s.repo.On("Transition", s.ctx, mock.Anything, mock.Anything, mock.MatchedBy(func(msg model.OutboxMessage) bool {
+				payload = msg.Payload
+				return msg.EventType == model.EventTypeOrderPaid
+			})).
+				Return(nil).
+				Once()
+
+			_, err := s.service.Pay(s.ctx, orderId, paymentMethod)
+			s.Require().NoError(err)
+
+			var event eventsV1.OrderPaid
+			s.Require().NoError(proto.Unmarshal(payload, &event))
+			s.Require().Len(event.Parts, len(tt.expectedParts))
+			for i, expected := range tt.expectedParts {
+				s.True(proto.Equal(expected, event.Parts[i]), "part %d: %v", i, event.Parts[i])
+			}
+		})
+	}
+}
+
+func (s *ServiceSuite) TestPayReservationNotCommitted() {
+	orderId := uuid.MustParse("00000000-0000-0000-0000-000000000002")
+	userId := uuid.MustParse("00000000-0000-0000-0000-000000000003")
+	transactionUUID := uuid.MustParse("00000000-0000-0000-0000-000000000004")
+
+	// Отдельные моки, чтобы проверить, что заказ не сохранялся ни в одном вызове
+	repo := repoMock.NewMockOrderRepository(s.T())
+	inventoryClient := clientMock.NewMockInventoryClient(s.T())
+	paymentClient := clientMock.NewMockPaymentClient(s.T())
+	svc := &service{
+		orderRepo:        repo,
+		inventoryClient:  inventoryClient,
+		paymentClient:    paymentClient,
+		orderPaidEncoder: encoder.NewOrderPaidEncoder(),
+		now:              func() time.Time { return s.now },
+	}
+
+	repo.EXPECT().Get(s.ctx, orderId).
+		Return(model.Order{UUID: orderId, UserUUID: userId, Status: model.OrderStatusPENDINGPAYMENT}, nil).
+		Once()
+	inventoryClient.EXPECT().GetReservation(mock.Anything, orderId).Return(s.activeReservation(), nil).Once()
+	paymentClient.EXPECT().PayOrder(mock.Anything, orderId, userId, model.PaymentMethodCARD, float64(0)).
+		Return(transactionUUID.String(), nil).
+		Once()
+	inventoryClient.EXPECT().CommitReservation(mock.Anything, orderId).Return(model.ErrOrderReservationExpired).Once()
+	paymentClient.EXPECT().RefundPayment(mock.Anything, transactionUUID).Return(float64(100), nil).Once()
+
+	_, err := svc.Pay(s.ctx, orderId, model.PaymentMethodCARD)
+
+	s.Require().ErrorIs(err, model.ErrOrderReservationExpired)
+	// Заказ не переведен в PAID, событие OrderPaid для сборки не сохранено в outbox
+	repo.AssertNotCalled(s.T(), "Transition", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
@@ -0,0 +1,62 @@
+package order
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/crafty-ezhik/rocket-factory/order/internal/model"
+	"github.com/crafty-ezhik/rocket-factory/platform/pkg/logger"
+)
+
+// releaseReservation - возвращает зарезервированные под заказ детали на склад.
+//
+//	Ошибка только логируется: неотмененный резерв будет снят InventoryService по истечении его срока
+func (s *service) releaseReservation(ctx context.Context, orderUUID uuid.UUID) {
+	ctxReq, cancel := context.WithTimeout(ctx, time.Second*3)
+	defer cancel()
+
+	if err := s.inventoryClient.ReleaseReservation(ctxReq, orderUUID); err != nil {
+		logger.Error(ctx, "Ошибка отмены резерва деталей",
+			zap.String("order_uuid", orderUUID.String()),
+			zap.Error(err),
+		)
+	}
+}
+
+// commitReservation - окончательно списывает зарезервированные под оплаченный заказ детали.
+//
+//	Повторное списание не является ошибкой, истекший резерв возвращает ErrOrderReservationExpired
+func (s *service) commitReservation(ctx context.Context, orderUUID uuid.UUID) error {
+	ctxReq, cancel := context.WithTimeout(ctx, time.Second*3)
+	defer cancel()
+
+	if err := s.inventoryClient.CommitReservation(ctxReq, orderUUID); err != nil {
+		logger.Error(ctx, "Ошибка подтверждения резерва деталей",
+			zap.String("order_uuid", orderUUID.String()),
+			zap.Error(err),
+		)
+		return err
+	}
+	return nil
+}
+
+// checkReservation - проверяет, что резерв деталей заказа активен и не истечет во время оплаты.
+//
+//	Неактивный резерв возвращает ErrOrderReservationExpired
+func (s *service) checkReservation(ctx context.Context, orderUUID uuid.UUID) error {
+	ctxReq, cancel := context.WithTimeout(ctx, time.Second*3)
+	defer cancel()
+
+	reservation, err := s.inventoryClient.GetReservation(ctxReq, orderUUID)
+	if err != nil {
+		return err
+	}
+
+	if !reservation.ActiveUntil(s.now().Add(reservationPayMargin)) {
+		return model.ErrOrderReservationExpired
+	}
+	return nil
+}
@@ -0,0 +1,62 @@
+package order
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/crafty-ezhik/rocket-factory/platform/pkg/logger"
+)
+
+// Сага заказа: pay -> reserve stock -> assemble -> complete.
+//
+//	Синхронные шаги до запроса сборки выполняет runSaga при оплате заказа. Завершение саги
+//	приходит асинхронно от AssemblyService: успешная сборка переводит заказ в ASSEMBLED (Assembled),
+//	ошибка - в ASSEMBLY_FAILED с повторной сборкой или компенсацией оплаты (AssemblyFailed).
+//	Каждый шаг меняет статус только через таблицу переходов model.Order.Transition
+
+// sagaStep - шаг саги и компенсация, отменяющая его результат при сбое следующих шагов
+type sagaStep struct {
+	name   string
+	action func(ctx context.Context) error
+	// compensate - nil, если шаг нечего отменять или его результат необратим
+	compensate func(ctx context.Context) error
+}
+
+// runSaga - выполняет шаги по порядку, при ошибке компенсирует выполненные шаги в обратном порядке.
+//
+//	Компенсации выполняются и после отмены запроса, их ошибки только логируются:
+//	вызывающему возвращается ошибка шага, прервавшего сагу
+func runSaga(ctx context.Context, orderUUID uuid.UUID, steps ...sagaStep) error {
+	for i, step := range steps {
+		err := step.action(ctx)
+		if err == nil {
+			continue
+		}
+
+		logger.Warn(ctx, "Шаг саги заказа завершился ошибкой, выполняются компенсации",
+			zap.String("order_uuid", orderUUID.String()),
+			zap.String("step", step.name),
+			zap.Error(err),
+		)
+
+		compensateCtx := context.WithoutCancel(ctx)
+		for j := i - 1; j >= 0; j-- {
+			if steps[j].compensate == nil {
+				continue
+			}
+
+			if compErr := steps[j].compensate(compensateCtx); compErr != nil {
+				logger.Error(ctx, "Ошибка компенсации шага саги заказа",
+					zap.String("order_uuid", orderUUID.String()),
+					zap.String("step", steps[j].name),
+					zap.Error(compErr),
+				)
+			}
+		}
+		return err
+	}
+
+	return nil
+}
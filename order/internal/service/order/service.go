@@ -1,7 +1,11 @@
 package order
 
 import (
+	"time"
+
 	"github.com/crafty-ezhik/rocket-factory/order/internal/client/grpc"
+	kafkaConv "github.com/crafty-ezhik/rocket-factory/order/internal/converter/kafka"
+	"github.com/crafty-ezhik/rocket-factory/order/internal/model"
 	"github.com/crafty-ezhik/rocket-factory/order/internal/repository"
 	def "github.com/crafty-ezhik/rocket-factory/order/internal/service"
 )
@@ -14,19 +18,41 @@ type service struct {
 	inventoryClient grpc.InventoryClient
 	paymentClient   grpc.PaymentClient
 
-	orderPaidProducer def.OrderProducerService
+	orderPaidEncoder         kafkaConv.OrderPaidEncoder
+	orderRefundedEncoder     kafkaConv.OrderRefundedEncoder
+	assemblyRequestedEncoder kafkaConv.AssemblyRequestedEncoder
+
+	assemblyPolicy AssemblyPolicy
+
+	now func() time.Time
+}
+
+// AssemblyPolicy - обработка ошибок сборки.
+//
+//	OnFailure - вернуть средства сразу или запросить повторную сборку.
+//	MaxAttempts - сколько всего попыток сборки допускается, после последней средства возвращаются
+type AssemblyPolicy struct {
+	OnFailure   model.AssemblyFailurePolicy
+	MaxAttempts int
 }
 
 func NewService(
 	orderRepo repository.OrderRepository,
 	inventoryClient grpc.InventoryClient,
 	paymentClient grpc.PaymentClient,
-	orderPaidProducer def.OrderProducerService,
+	orderPaidEncoder kafkaConv.OrderPaidEncoder,
+	orderRefundedEncoder kafkaConv.OrderRefundedEncoder,
+	assemblyRequestedEncoder kafkaConv.AssemblyRequestedEncoder,
+	assemblyPolicy AssemblyPolicy,
 ) *service {
 	return &service{
-		orderRepo:         orderRepo,
-		inventoryClient:   inventoryClient,
-		paymentClient:     paymentClient,
-		orderPaidProducer: orderPaidProducer,
+		orderRepo:                orderRepo,
+		inventoryClient:          inventoryClient,
+		paymentClient:            paymentClient,
+		orderPaidEncoder:         orderPaidEncoder,
+		orderRefundedEncoder:     orderRefundedEncoder,
+		assemblyRequestedEncoder: assemblyRequestedEncoder,
+		assemblyPolicy:           assemblyPolicy,
+		now:                      time.Now,
 	}
 }
@@ -3,38 +3,55 @@ package order
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/suite"
 
 	clientMock "github.com/crafty-ezhik/rocket-factory/order/internal/client/grpc/mocks"
+	"github.com/crafty-ezhik/rocket-factory/order/internal/converter/kafka/encoder"
+	"github.com/crafty-ezhik/rocket-factory/order/internal/model"
 	repoMock "github.com/crafty-ezhik/rocket-factory/order/internal/repository/mocks"
-	serviceMock "github.com/crafty-ezhik/rocket-factory/order/internal/service/mocks"
+	"github.com/crafty-ezhik/rocket-factory/platform/pkg/logger"
 )
 
 type ServiceSuite struct {
 	suite.Suite
-	ctx               context.Context //nolint:containedctx
-	repo              *repoMock.MockOrderRepository
-	inventoryClient   *clientMock.MockInventoryClient
-	paymentClient     *clientMock.MockPaymentClient
-	orderPaidProducer *serviceMock.MockOrderProducerService
-	service           *service
+	ctx             context.Context //nolint:containedctx
+	now             time.Time
+	repo            *repoMock.MockOrderRepository
+	inventoryClient *clientMock.MockInventoryClient
+	paymentClient   *clientMock.MockPaymentClient
+	service         *service
 }
 
 func (s *ServiceSuite) SetupSuite() {
 	s.ctx = context.Background()
+
+	logger.SetNopLogger()
+
 	s.inventoryClient = clientMock.NewMockInventoryClient(s.T())
 	s.paymentClient = clientMock.NewMockPaymentClient(s.T())
 	s.repo = repoMock.NewMockOrderRepository(s.T())
-	s.orderPaidProducer = serviceMock.NewMockOrderProducerService(s.T())
 	s.service = &service{
-		inventoryClient:   s.inventoryClient,
-		paymentClient:     s.paymentClient,
-		orderRepo:         s.repo,
-		orderPaidProducer: s.orderPaidProducer,
+		inventoryClient:          s.inventoryClient,
+		paymentClient:            s.paymentClient,
+		orderRepo:                s.repo,
+		orderPaidEncoder:         encoder.NewOrderPaidEncoder(),
+		orderRefundedEncoder:     encoder.NewOrderRefundedEncoder(),
+		assemblyRequestedEncoder: encoder.NewAssemblyRequestedEncoder(),
+		now:                      func() time.Time { return s.now },
 	}
 }
 
+func (s *ServiceSuite) SetupTest() {
+	s.now = time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+}
+
+// activeReservation - резерв, который переживет оплату заказа
+func (s *ServiceSuite) activeReservation() model.Reservation {
+	return model.Reservation{Status: model.ReservationStatusACTIVE, ExpiresAt: s.now.Add(time.Minute)}
+}
+
 func (s *ServiceSuite) TearDownSuite() {
 	s.inventoryClient.AssertExpectations(s.T())
 	s.repo.AssertExpectations(s.T())
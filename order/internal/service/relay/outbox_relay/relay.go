@@ -0,0 +1,167 @@
+package outbox_relay
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/crafty-ezhik/rocket-factory/order/internal/model"
+	"github.com/crafty-ezhik/rocket-factory/order/internal/repository"
+	def "github.com/crafty-ezhik/rocket-factory/order/internal/service"
+	"github.com/crafty-ezhik/rocket-factory/platform/pkg/kafka"
+	"github.com/crafty-ezhik/rocket-factory/platform/pkg/logger"
+)
+
+var _ def.OutboxRelayService = (*service)(nil)
+
+const (
+	// maxSendAttempts - после стольких неудачных отправок событие переводится в FAILED
+	maxSendAttempts = 10
+
+	retryBaseBackoff = time.Second
+	maxRetryBackoff  = 5 * time.Minute
+)
+
+type service struct {
+	outboxRepo repository.OutboxRepository
+	producers  map[model.EventType]kafka.Producer
+
+	pollInterval time.Duration
+	batchSize    uint64
+	lockTimeout  time.Duration
+}
+
+func NewService(
+	outboxRepo repository.OutboxRepository,
+	producers map[model.EventType]kafka.Producer,
+	pollInterval time.Duration,
+	batchSize uint64,
+	lockTimeout time.Duration,
+) *service {
+	return &service{
+		outboxRepo:   outboxRepo,
+		producers:    producers,
+		pollInterval: pollInterval,
+		batchSize:    batchSize,
+		lockTimeout:  lockTimeout,
+	}
+}
+
+// RunRelay - периодически отправляет события из outbox в Kafka, пока не будет отменен контекст
+func (s *service) RunRelay(ctx context.Context) error {
+	logger.Info(ctx, "Starting outbox relay service")
+
+	ticker := time.NewTicker(s.pollInterval)
+	defer ticker.Stop()
+
+	for {
+		if err := s.relayBatch(ctx); err != nil {
+			logger.Error(ctx, "Outbox relay iteration failed", zap.Error(err))
+		}
+
+		select {
+		case <-ctx.Done():
+			logger.Info(ctx, "Outbox relay stopped")
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// relayBatch - отправляет одну пачку событий.
+//
+//	Неудачно отправленное событие откладывается с растущей задержкой, а после maxSendAttempts
+//	попыток переводится в FAILED, чтобы не блокировать relay. Остальные события пачки отправляются,
+//	кроме событий с тем же ключом, что и неудачное, чтобы не нарушить порядок событий заказа.
+func (s *service) relayBatch(ctx context.Context) error {
+	messages, err := s.outboxRepo.FetchPending(ctx, s.batchSize, s.lockTimeout)
+	if err != nil {
+		return err
+	}
+
+	var (
+		sendErr    error
+		failedKeys [][]byte
+	)
+	for _, msg := range messages {
+		if hasKey(failedKeys, msg.Key) {
+			// Событие будет захвачено повторно после отправки более раннего события заказа
+			continue
+		}
+
+		if err = s.send(ctx, msg); err != nil {
+			if markErr := s.markFailure(ctx, msg, err); markErr != nil {
+				return markErr
+			}
+
+			if sendErr == nil {
+				sendErr = err
+			}
+			if len(msg.Key) > 0 {
+				failedKeys = append(failedKeys, msg.Key)
+			}
+			continue
+		}
+
+		// Если relay упадет до этой строки, событие будет отправлено повторно (at-least-once)
+		if err = s.outboxRepo.MarkSent(ctx, msg.ID); err != nil {
+			return err
+		}
+	}
+
+	return sendErr
+}
+
+// markFailure - откладывает событие до следующей попытки или переводит его в FAILED
+func (s *service) markFailure(ctx context.Context, msg model.OutboxMessage, sendErr error) error {
+	fields := []zap.Field{
+		zap.Int64("id", msg.ID),
+		zap.String("event_uuid", msg.EventUUID.String()),
+		zap.String("event_type", msg.EventType.String()),
+		zap.Int("attempt", msg.Attempts+1),
+		zap.Error(sendErr),
+	}
+
+	if msg.Attempts+1 >= maxSendAttempts {
+		logger.Error(ctx, "Outbox message moved to FAILED after max attempts", fields...)
+		return s.outboxRepo.MarkFailed(ctx, msg.ID, sendErr.Error())
+	}
+
+	logger.Error(ctx, "Failed to relay outbox message", fields...)
+	return s.outboxRepo.MarkRetry(ctx, msg.ID, sendErr.Error(), retryBackoff(msg.Attempts))
+}
+
+// retryBackoff - экспоненциальная задержка перед следующей попыткой, ограниченная maxRetryBackoff
+func retryBackoff(attempts int) time.Duration {
+	if attempts < 0 {
+		attempts = 0
+	}
+
+	backoff := retryBaseBackoff
+	for i := 0; i < attempts && backoff < maxRetryBackoff; i++ {
+		backoff *= 2
+	}
+
+	return min(backoff, maxRetryBackoff)
+}
+
+func hasKey(keys [][]byte, key []byte) bool {
+	for _, k := range keys {
+		if bytes.Equal(k, key) {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *service) send(ctx context.Context, msg model.OutboxMessage) error {
+	producer, ok := s.producers[msg.EventType]
+	if !ok {
+		return fmt.Errorf("no producer registered for event type %s", msg.EventType)
+	}
+
+	return producer.Send(ctx, msg.Key, msg.Payload)
+}
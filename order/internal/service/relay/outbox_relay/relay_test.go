@@ -0,0 +1,172 @@
+package outbox_relay
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/crafty-ezhik/rocket-factory/order/internal/model"
+)
+
+func message(id int64, eventType model.EventType) model.OutboxMessage {
+	return model.OutboxMessage{
+		ID:        id,
+		EventUUID: uuid.New(),
+		EventType: eventType,
+		Key:       []byte(fmt.Sprintf("key-%d", id)),
+		Payload:   []byte(fmt.Sprintf("payload-%d", id)),
+		Status:    model.OutboxStatusPENDING,
+	}
+}
+
+func (s *ServiceSuite) TestRelayBatchInOrder() {
+	first := message(1, model.EventTypeOrderPaid)
+	second := message(2, model.EventTypeOrderRefunded)
+
+	s.repo.EXPECT().FetchPending(s.ctx, uint64(testBatchSize), testLockTimeout).
+		Return([]model.OutboxMessage{first, second}, nil).Once()
+
+	sendFirst := s.paidProducer.EXPECT().Send(s.ctx, first.Key, first.Payload).Return(nil).Once()
+	markFirst := s.repo.EXPECT().MarkSent(s.ctx, first.ID).Return(nil).Once().NotBefore(sendFirst)
+	sendSecond := s.refundedProducer.EXPECT().Send(s.ctx, second.Key, second.Payload).Return(nil).Once().NotBefore(markFirst)
+	s.repo.EXPECT().MarkSent(s.ctx, second.ID).Return(nil).Once().NotBefore(sendSecond)
+
+	s.Require().NoError(s.service.relayBatch(s.ctx))
+}
+
+func (s *ServiceSuite) TestRelayBatchEmpty() {
+	s.repo.EXPECT().FetchPending(s.ctx, uint64(testBatchSize), testLockTimeout).Return(nil, nil).Once()
+
+	s.Require().NoError(s.service.relayBatch(s.ctx))
+}
+
+func (s *ServiceSuite) TestRelayBatchFetchError() {
+	fetchErr := errors.New("database unavailable")
+	s.repo.EXPECT().FetchPending(s.ctx, uint64(testBatchSize), testLockTimeout).Return(nil, fetchErr).Once()
+
+	s.ErrorIs(s.service.relayBatch(s.ctx), fetchErr)
+}
+
+func (s *ServiceSuite) TestSendFailureDoesNotBlockBatch() {
+	sendErr := errors.New("kafka unavailable")
+	failing := message(1, model.EventTypeOrderPaid)
+	good := message(2, model.EventTypeOrderPaid)
+
+	s.repo.EXPECT().FetchPending(s.ctx, uint64(testBatchSize), testLockTimeout).
+		Return([]model.OutboxMessage{failing, good}, nil).Once()
+	sendFailing := s.paidProducer.EXPECT().Send(s.ctx, failing.Key, failing.Payload).Return(sendErr).Once()
+	markRetry := s.repo.EXPECT().MarkRetry(s.ctx, failing.ID, sendErr.Error(), retryBaseBackoff).Return(nil).Once().NotBefore(sendFailing)
+	sendGood := s.paidProducer.EXPECT().Send(s.ctx, good.Key, good.Payload).Return(nil).Once().NotBefore(markRetry)
+	s.repo.EXPECT().MarkSent(s.ctx, good.ID).Return(nil).Once().NotBefore(sendGood)
+
+	s.ErrorIs(s.service.relayBatch(s.ctx), sendErr)
+}
+
+func (s *ServiceSuite) TestSendFailureSkipsSameKey() {
+	sendErr := errors.New("kafka unavailable")
+	first := message(1, model.EventTypeOrderPaid)
+	sameOrder := message(2, model.EventTypeOrderRefunded)
+	sameOrder.Key = first.Key
+
+	s.repo.EXPECT().FetchPending(s.ctx, uint64(testBatchSize), testLockTimeout).
+		Return([]model.OutboxMessage{first, sameOrder}, nil).Once()
+	s.paidProducer.EXPECT().Send(s.ctx, first.Key, first.Payload).Return(sendErr).Once()
+	s.repo.EXPECT().MarkRetry(s.ctx, first.ID, sendErr.Error(), retryBaseBackoff).Return(nil).Once()
+
+	// Событие того же заказа не отправляется раньше неудачного, чтобы не нарушить порядок
+	s.ErrorIs(s.service.relayBatch(s.ctx), sendErr)
+}
+
+func (s *ServiceSuite) TestSendFailureBacksOffByAttempts() {
+	sendErr := errors.New("kafka unavailable")
+	msg := message(1, model.EventTypeOrderPaid)
+	msg.Attempts = 3
+
+	s.repo.EXPECT().FetchPending(s.ctx, uint64(testBatchSize), testLockTimeout).
+		Return([]model.OutboxMessage{msg}, nil).Once()
+	s.paidProducer.EXPECT().Send(s.ctx, msg.Key, msg.Payload).Return(sendErr).Once()
+	s.repo.EXPECT().MarkRetry(s.ctx, msg.ID, sendErr.Error(), 8*time.Second).Return(nil).Once()
+
+	s.ErrorIs(s.service.relayBatch(s.ctx), sendErr)
+}
+
+func (s *ServiceSuite) TestSendFailureMaxAttemptsMarkedFailed() {
+	sendErr := errors.New("kafka unavailable")
+	msg := message(1, model.EventTypeOrderPaid)
+	msg.Attempts = maxSendAttempts - 1
+
+	s.repo.EXPECT().FetchPending(s.ctx, uint64(testBatchSize), testLockTimeout).
+		Return([]model.OutboxMessage{msg}, nil).Once()
+	s.paidProducer.EXPECT().Send(s.ctx, msg.Key, msg.Payload).Return(sendErr).Once()
+	s.repo.EXPECT().MarkFailed(s.ctx, msg.ID, sendErr.Error()).Return(nil).Once()
+
+	s.ErrorIs(s.service.relayBatch(s.ctx), sendErr)
+}
+
+func (s *ServiceSuite) TestUnknownEventTypeRetried() {
+	msg := message(1, model.EventTypeAssemblyRequested)
+
+	s.repo.EXPECT().FetchPending(s.ctx, uint64(testBatchSize), testLockTimeout).
+		Return([]model.OutboxMessage{msg}, nil).Once()
+	s.repo.EXPECT().MarkRetry(s.ctx, msg.ID, mock.MatchedBy(func(reason string) bool {
+		return reason == "no producer registered for event type ASSEMBLY_REQUESTED"
+	}), retryBaseBackoff).Return(nil).Once()
+
+	s.Error(s.service.relayBatch(s.ctx))
+}
+
+func (s *ServiceSuite) TestMarkRetryError() {
+	markErr := errors.New("database unavailable")
+	first := message(1, model.EventTypeOrderPaid)
+	second := message(2, model.EventTypeOrderPaid)
+
+	s.repo.EXPECT().FetchPending(s.ctx, uint64(testBatchSize), testLockTimeout).
+		Return([]model.OutboxMessage{first, second}, nil).Once()
+	s.paidProducer.EXPECT().Send(s.ctx, first.Key, first.Payload).Return(errors.New("kafka unavailable")).Once()
+	s.repo.EXPECT().MarkRetry(s.ctx, first.ID, mock.Anything, mock.Anything).Return(markErr).Once()
+
+	s.ErrorIs(s.service.relayBatch(s.ctx), markErr)
+}
+
+func (s *ServiceSuite) TestRetryBackoff() {
+	s.Equal(retryBaseBackoff, retryBackoff(0))
+	s.Equal(2*retryBaseBackoff, retryBackoff(1))
+	s.Equal(maxRetryBackoff, retryBackoff(maxSendAttempts))
+	s.Equal(maxRetryBackoff, retryBackoff(100))
+}
+
+func (s *ServiceSuite) TestMarkSentErrorStopsBatch() {
+	markErr := errors.New("database unavailable")
+	first := message(1, model.EventTypeOrderPaid)
+	second := message(2, model.EventTypeOrderPaid)
+
+	s.repo.EXPECT().FetchPending(s.ctx, uint64(testBatchSize), testLockTimeout).
+		Return([]model.OutboxMessage{first, second}, nil).Once()
+	s.paidProducer.EXPECT().Send(s.ctx, first.Key, first.Payload).Return(nil).Once()
+	s.repo.EXPECT().MarkSent(s.ctx, first.ID).Return(markErr).Once()
+
+	// Событие уже в Kafka, после истечения блокировки оно будет отправлено повторно
+	s.ErrorIs(s.service.relayBatch(s.ctx), markErr)
+}
+
+func (s *ServiceSuite) TestRunRelayUntilCancelled() {
+	ctx, cancel := context.WithCancel(s.ctx)
+
+	polls := 0
+	s.repo.EXPECT().FetchPending(ctx, uint64(testBatchSize), testLockTimeout).
+		RunAndReturn(func(context.Context, uint64, time.Duration) ([]model.OutboxMessage, error) {
+			polls++
+			if polls == 3 {
+				cancel()
+			}
+			// Ошибка итерации не останавливает relay
+			return nil, errors.New("database unavailable")
+		})
+
+	s.Require().NoError(s.service.RunRelay(ctx))
+	s.Equal(3, polls)
+}
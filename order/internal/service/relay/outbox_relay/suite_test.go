@@ -0,0 +1,54 @@
+package outbox_relay
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/crafty-ezhik/rocket-factory/order/internal/model"
+	repoMock "github.com/crafty-ezhik/rocket-factory/order/internal/repository/mocks"
+	"github.com/crafty-ezhik/rocket-factory/platform/pkg/kafka"
+	kafkaMock "github.com/crafty-ezhik/rocket-factory/platform/pkg/kafka/mocks"
+	"github.com/crafty-ezhik/rocket-factory/platform/pkg/logger"
+)
+
+const (
+	testBatchSize   = 10
+	testLockTimeout = time.Minute
+)
+
+type ServiceSuite struct {
+	suite.Suite
+	ctx              context.Context //nolint:containedctx
+	repo             *repoMock.MockOutboxRepository
+	paidProducer     *kafkaMock.MockProducer
+	refundedProducer *kafkaMock.MockProducer
+	service          *service
+}
+
+func (s *ServiceSuite) SetupSuite() {
+	logger.SetNopLogger()
+}
+
+func (s *ServiceSuite) SetupTest() {
+	s.ctx = context.Background()
+	s.repo = repoMock.NewMockOutboxRepository(s.T())
+	s.paidProducer = kafkaMock.NewMockProducer(s.T())
+	s.refundedProducer = kafkaMock.NewMockProducer(s.T())
+	s.service = NewService(
+		s.repo,
+		map[model.EventType]kafka.Producer{
+			model.EventTypeOrderPaid:     s.paidProducer,
+			model.EventTypeOrderRefunded: s.refundedProducer,
+		},
+		time.Millisecond,
+		testBatchSize,
+		testLockTimeout,
+	)
+}
+
+func TestServiceIntegration(t *testing.T) {
+	suite.Run(t, new(ServiceSuite))
+}
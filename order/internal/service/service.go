@@ -10,15 +10,20 @@ import (
 
 type OrderService interface {
 	Get(ctx context.Context, orderID uuid.UUID) (model.Order, error)
-	Create(ctx context.Context, userID uuid.UUID, parts []uuid.UUID) (uuid.UUID, float64, error)
+	List(ctx context.Context, filter model.OrdersFilter) (model.OrdersPage, error)
+	Create(ctx context.Context, userID uuid.UUID, items []model.OrderItem) (uuid.UUID, float64, error)
 	Cancel(ctx context.Context, orderID uuid.UUID) error
 	Pay(ctx context.Context, orderID uuid.UUID, paymentMethod model.PaymentMethod) (uuid.UUID, error)
-}
-
-type OrderProducerService interface {
-	ProduceOrderPaid(ctx context.Context, event model.OrderPaidEvent) error
+	// AssemblyFailed - переводит заказ в ASSEMBLY_FAILED и по политике запрашивает повторную сборку или возвращает средства
+	AssemblyFailed(ctx context.Context, event model.OrderAssemblyFailedEvent) error
+	// Assembled - завершает сагу заказа переводом в ASSEMBLED, если заказ еще ожидает сборки
+	Assembled(ctx context.Context, event model.OrderAssembledEvent) error
 }
 
 type ConsumerService interface {
 	RunConsumer(ctx context.Context) error
 }
+
+type OutboxRelayService interface {
+	RunRelay(ctx context.Context) error
+}
@@ -0,0 +1,5 @@
+-- удаляем индекс по неотправленным событиям
+DROP INDEX IF EXISTS idx_outbox_pending;
+
+-- удаляем таблицу исходящих событий
+DROP TABLE IF EXISTS outbox;
\ No newline at end of file
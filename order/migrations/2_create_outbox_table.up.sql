@@ -0,0 +1,19 @@
+-- +goose Up
+
+-- создаем таблицу исходящих событий (transactional outbox)
+CREATE TABLE outbox (
+    id BIGSERIAL PRIMARY KEY,
+    event_uuid UUID NOT NULL UNIQUE,
+    event_type VARCHAR(50) NOT NULL,
+    message_key BYTEA,
+    payload BYTEA NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'PENDING',
+    attempts INTEGER NOT NULL DEFAULT 0,
+    last_error TEXT,
+    locked_until TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now(),
+    sent_at TIMESTAMP WITH TIME ZONE
+);
+
+-- создаем частичный индекс для быстрого поиска неотправленных событий
+CREATE INDEX IF NOT EXISTS idx_outbox_pending ON outbox (id) WHERE status = 'PENDING';
@@ -0,0 +1,5 @@
+-- удаляем индекс по сроку хранения ключей
+DROP INDEX IF EXISTS idx_processed_messages_expires_at;
+
+-- удаляем таблицу ключей обработанных событий
+DROP TABLE IF EXISTS processed_messages;
@@ -0,0 +1,10 @@
+-- +goose Up
+
+-- создаем таблицу ключей обработанных входящих событий (дедупликация consumer'ов)
+CREATE TABLE processed_messages (
+    message_key TEXT PRIMARY KEY,
+    expires_at TIMESTAMP WITH TIME ZONE NOT NULL
+);
+
+-- создаем индекс для очистки истекших ключей
+CREATE INDEX IF NOT EXISTS idx_processed_messages_expires_at ON processed_messages (expires_at);
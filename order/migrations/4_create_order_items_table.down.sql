@@ -0,0 +1,16 @@
+-- возвращаем массив деталей
+ALTER TABLE orders ADD COLUMN part_uuids UUID[] NOT NULL DEFAULT '{}';
+
+-- заполняем массив из позиций заказа, каждая деталь повторяется по количеству
+UPDATE orders o
+SET part_uuids = items.part_uuids
+FROM (
+    SELECT i.order_uuid, array_agg(i.part_uuid ORDER BY i.id) AS part_uuids
+    FROM order_items i
+    CROSS JOIN LATERAL generate_series(1, i.quantity)
+    GROUP BY i.order_uuid
+) AS items
+WHERE o.order_uuid = items.order_uuid;
+
+-- удаляем таблицу позиций заказа
+DROP TABLE IF EXISTS order_items;
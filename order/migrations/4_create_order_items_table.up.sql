@@ -0,0 +1,24 @@
+-- +goose Up
+
+-- создаем таблицу позиций заказа
+CREATE TABLE order_items (
+    id BIGSERIAL PRIMARY KEY,
+    order_uuid UUID NOT NULL REFERENCES orders (order_uuid) ON DELETE CASCADE,
+    part_uuid UUID NOT NULL,
+    quantity INTEGER NOT NULL CHECK (quantity > 0),
+    unit_price NUMERIC(10,2) NOT NULL DEFAULT 0 CHECK (unit_price >= 0),
+    name VARCHAR(255) NOT NULL DEFAULT '',
+    UNIQUE (order_uuid, part_uuid)
+);
+
+-- переносим детали из массива part_uuids, повторяющиеся детали превращаются в количество.
+-- Цена и название на момент заказа неизвестны, поэтому остаются значениями по умолчанию
+INSERT INTO order_items (order_uuid, part_uuid, quantity)
+SELECT o.order_uuid, p.part_uuid, COUNT(*)
+FROM orders o
+CROSS JOIN LATERAL unnest(o.part_uuids) WITH ORDINALITY AS p(part_uuid, position)
+GROUP BY o.order_uuid, p.part_uuid
+ORDER BY o.order_uuid, MIN(p.position);
+
+-- удаляем массив деталей, теперь состав заказа хранится в order_items
+ALTER TABLE orders DROP COLUMN part_uuids;
@@ -0,0 +1,7 @@
+-- удаляем индексы для keyset-пагинации списка всех заказов
+DROP INDEX IF EXISTS idx_orders_total_price;
+DROP INDEX IF EXISTS idx_orders_created_at;
+
+-- удаляем индексы для keyset-пагинации списка заказов пользователя
+DROP INDEX IF EXISTS idx_orders_user_uuid_total_price;
+DROP INDEX IF EXISTS idx_orders_user_uuid_created_at;
@@ -0,0 +1,9 @@
+-- +goose Up
+
+-- создаем индексы для keyset-пагинации списка заказов пользователя
+CREATE INDEX IF NOT EXISTS idx_orders_user_uuid_created_at ON orders (user_uuid, created_at DESC, order_uuid DESC);
+CREATE INDEX IF NOT EXISTS idx_orders_user_uuid_total_price ON orders (user_uuid, total_price DESC, order_uuid DESC);
+
+-- создаем индексы для keyset-пагинации списка всех заказов
+CREATE INDEX IF NOT EXISTS idx_orders_created_at ON orders (created_at DESC, order_uuid DESC);
+CREATE INDEX IF NOT EXISTS idx_orders_total_price ON orders (total_price DESC, order_uuid DESC);
@@ -0,0 +1,5 @@
+-- удаляем индекс истории переходов заказа
+DROP INDEX IF EXISTS idx_order_status_transitions_order_uuid;
+
+-- удаляем таблицу истории переходов заказа
+DROP TABLE IF EXISTS order_status_transitions;
@@ -0,0 +1,15 @@
+-- +goose Up
+
+-- создаем таблицу истории переходов заказа между статусами
+CREATE TABLE order_status_transitions (
+    id BIGSERIAL PRIMARY KEY,
+    order_uuid UUID NOT NULL REFERENCES orders (order_uuid) ON DELETE CASCADE,
+    from_status VARCHAR(30),
+    to_status VARCHAR(30) NOT NULL,
+    trigger VARCHAR(30) NOT NULL,
+    reason TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now()
+);
+
+-- создаем индекс для чтения истории заказа в порядке переходов
+CREATE INDEX IF NOT EXISTS idx_order_status_transitions_order_uuid ON order_status_transitions (order_uuid, id);
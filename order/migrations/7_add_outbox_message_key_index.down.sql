@@ -0,0 +1,2 @@
+-- удаляем индекс для поиска более ранних неотправленных событий того же заказа
+DROP INDEX IF EXISTS idx_outbox_pending_message_key;
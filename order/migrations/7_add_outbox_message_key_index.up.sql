@@ -0,0 +1,4 @@
+-- +goose Up
+
+-- создаем индекс для поиска более ранних неотправленных событий того же заказа
+CREATE INDEX IF NOT EXISTS idx_outbox_pending_message_key ON outbox (message_key, id) WHERE status = 'PENDING';
@@ -20,7 +20,7 @@ func (a *API) PayOrder(ctx context.Context, req *paymentV1.PayOrderRequest) (*pa
 		return &paymentV1.PayOrderResponse{}, model.ErrInvalidUserUUID
 	}
 
-	transactionUUID, err := a.paymentService.PayOrder(ctx, orderUUID, userUUID, req.PaymentMethod.String())
+	transactionUUID, err := a.paymentService.PayOrder(ctx, orderUUID, userUUID, req.PaymentMethod.String(), req.Amount)
 	if err != nil {
 		return &paymentV1.PayOrderResponse{}, err
 	}
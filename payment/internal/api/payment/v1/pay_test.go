@@ -30,7 +30,7 @@ func (s *APISuite) TestPayOrder() {
 				PaymentMethod: paymentV1.PaymentMethod_CARD,
 			},
 			setupMock: func() {
-				s.paymentService.On("PayOrder", s.ctx, validOrderUUID, validUserUUID, "CARD").
+				s.paymentService.On("PayOrder", s.ctx, validOrderUUID, validUserUUID, "CARD", float64(0)).
 					Return(validTransactionUUID, nil).Once()
 			},
 			expectedResponse: &paymentV1.PayOrderResponse{
@@ -71,7 +71,7 @@ func (s *APISuite) TestPayOrder() {
 				PaymentMethod: paymentV1.PaymentMethod_CARD,
 			},
 			setupMock: func() {
-				s.paymentService.On("PayOrder", s.ctx, validOrderUUID, validUserUUID, "CARD").
+				s.paymentService.On("PayOrder", s.ctx, validOrderUUID, validUserUUID, "CARD", float64(0)).
 					Return("", context.DeadlineExceeded).Once()
 			},
 			expectedResponse: nil,
@@ -86,7 +86,7 @@ func (s *APISuite) TestPayOrder() {
 				PaymentMethod: paymentV1.PaymentMethod_CARD,
 			},
 			setupMock: func() {
-				s.paymentService.On("PayOrder", s.ctx, validOrderUUID, validUserUUID, "CARD").
+				s.paymentService.On("PayOrder", s.ctx, validOrderUUID, validUserUUID, "CARD", float64(0)).
 					Return("", context.Canceled).Once()
 			},
 			expectedResponse: nil,
@@ -102,7 +102,7 @@ func (s *APISuite) TestPayOrder() {
 			},
 			setupMock: func() {
 				err := errors.New("something went wrong")
-				s.paymentService.On("PayOrder", s.ctx, validOrderUUID, validUserUUID, "CARD").
+				s.paymentService.On("PayOrder", s.ctx, validOrderUUID, validUserUUID, "CARD", float64(0)).
 					Return("", err).Once()
 			},
 			expectedResponse: nil,
@@ -0,0 +1,27 @@
+package v1
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/crafty-ezhik/rocket-factory/payment/internal/model"
+	paymentV1 "github.com/crafty-ezhik/rocket-factory/shared/pkg/proto/payment/v1"
+)
+
+func (a *API) RefundPayment(ctx context.Context, req *paymentV1.RefundPaymentRequest) (*paymentV1.RefundPaymentResponse, error) {
+	transactionUUID, err := uuid.Parse(req.TransactionUuid)
+	if err != nil {
+		return &paymentV1.RefundPaymentResponse{}, model.ErrInvalidTransactionUUID
+	}
+
+	transaction, err := a.paymentService.RefundPayment(ctx, transactionUUID, req.Amount)
+	if err != nil {
+		return &paymentV1.RefundPaymentResponse{}, err
+	}
+
+	return &paymentV1.RefundPaymentResponse{
+		RefundedAmount: transaction.RefundedAmount,
+		FullyRefunded:  transaction.Status == model.TransactionStatusREFUNDED,
+	}, nil
+}
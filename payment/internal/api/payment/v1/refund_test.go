@@ -0,0 +1,98 @@
+package v1
+
+import (
+	"errors"
+
+	"github.com/google/uuid"
+
+	"github.com/crafty-ezhik/rocket-factory/payment/internal/model"
+	paymentV1 "github.com/crafty-ezhik/rocket-factory/shared/pkg/proto/payment/v1"
+)
+
+func (s *APISuite) TestRefundPayment() {
+	transactionUUID := uuid.New()
+	amount := 500.0
+
+	tests := []struct {
+		name             string
+		req              *paymentV1.RefundPaymentRequest
+		setupMock        func()
+		expectedResponse *paymentV1.RefundPaymentResponse
+		expectedErrMsg   string
+	}{
+		{
+			name: "full refund",
+			req: &paymentV1.RefundPaymentRequest{
+				TransactionUuid: transactionUUID.String(),
+			},
+			setupMock: func() {
+				s.paymentService.On("RefundPayment", s.ctx, transactionUUID, (*float64)(nil)).
+					Return(model.Transaction{
+						UUID:           transactionUUID,
+						Status:         model.TransactionStatusREFUNDED,
+						Amount:         1500,
+						RefundedAmount: 1500,
+					}, nil).Once()
+			},
+			expectedResponse: &paymentV1.RefundPaymentResponse{
+				RefundedAmount: 1500,
+				FullyRefunded:  true,
+			},
+		},
+		{
+			name: "partial refund",
+			req: &paymentV1.RefundPaymentRequest{
+				TransactionUuid: transactionUUID.String(),
+				Amount:          &amount,
+			},
+			setupMock: func() {
+				s.paymentService.On("RefundPayment", s.ctx, transactionUUID, &amount).
+					Return(model.Transaction{
+						UUID:           transactionUUID,
+						Status:         model.TransactionStatusCAPTURED,
+						Amount:         1500,
+						RefundedAmount: 500,
+					}, nil).Once()
+			},
+			expectedResponse: &paymentV1.RefundPaymentResponse{
+				RefundedAmount: 500,
+				FullyRefunded:  false,
+			},
+		},
+		{
+			name: "invalid transaction UUID",
+			req: &paymentV1.RefundPaymentRequest{
+				TransactionUuid: "invalid_uuid",
+			},
+			setupMock:      func() {},
+			expectedErrMsg: "invalid transaction UUID",
+		},
+		{
+			name: "service error",
+			req: &paymentV1.RefundPaymentRequest{
+				TransactionUuid: transactionUUID.String(),
+				Amount:          &amount,
+			},
+			setupMock: func() {
+				s.paymentService.On("RefundPayment", s.ctx, transactionUUID, &amount).
+					Return(model.Transaction{}, errors.New("something went wrong")).Once()
+			},
+			expectedErrMsg: "something went wrong",
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			tt.setupMock()
+
+			res, err := s.api.RefundPayment(s.ctx, tt.req)
+
+			if tt.expectedErrMsg != "" {
+				s.Require().ErrorContains(err, tt.expectedErrMsg)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().Equal(tt.expectedResponse, res)
+		})
+	}
+}
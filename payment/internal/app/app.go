@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/jackc/pgx/v5/stdlib"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
@@ -20,6 +21,7 @@ import (
 	"github.com/crafty-ezhik/rocket-factory/platform/pkg/grpc/health"
 	sharedIns "github.com/crafty-ezhik/rocket-factory/platform/pkg/grpc/interceptors"
 	"github.com/crafty-ezhik/rocket-factory/platform/pkg/logger"
+	pgMigrator "github.com/crafty-ezhik/rocket-factory/platform/pkg/migrator/pg"
 	paymentV1 "github.com/crafty-ezhik/rocket-factory/shared/pkg/proto/payment/v1"
 )
 
@@ -66,6 +68,7 @@ func (a *App) initDeps(ctx context.Context) error {
 		a.initDI,
 		a.initLogger,
 		a.initCloser,
+		a.initMigrator,
 		a.initListener,
 		a.initGRPCServer,
 		a.initHTTPGateway,
@@ -97,6 +100,17 @@ func (a *App) initCloser(_ context.Context) error {
 	return nil
 }
 
+func (a *App) initMigrator(ctx context.Context) error {
+	db := stdlib.OpenDBFromPool(a.diContainer.PgConnPool(ctx))
+	defer func() {
+		if err := db.Close(); err != nil {
+			logger.Error(ctx, "❌ failed to close migrator connection", zap.Error(err))
+		}
+	}()
+
+	return pgMigrator.NewPgMigrator(db, config.AppConfig().Postgres.MigrationsDir()).Up()
+}
+
 func (a *App) initListener(_ context.Context) error {
 	listener, err := net.Listen("tcp", config.AppConfig().PaymentGRPC.Address())
 	if err != nil {
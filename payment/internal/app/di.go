@@ -2,16 +2,31 @@ package app
 
 import (
 	"context"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
 
 	paymentV1API "github.com/crafty-ezhik/rocket-factory/payment/internal/api/payment/v1"
+	"github.com/crafty-ezhik/rocket-factory/payment/internal/config"
+	"github.com/crafty-ezhik/rocket-factory/payment/internal/model"
+	"github.com/crafty-ezhik/rocket-factory/payment/internal/provider"
+	"github.com/crafty-ezhik/rocket-factory/payment/internal/provider/fake"
+	"github.com/crafty-ezhik/rocket-factory/payment/internal/repository"
+	transactionRepo "github.com/crafty-ezhik/rocket-factory/payment/internal/repository/transaction"
 	"github.com/crafty-ezhik/rocket-factory/payment/internal/service"
 	paymentService "github.com/crafty-ezhik/rocket-factory/payment/internal/service/payment"
+	"github.com/crafty-ezhik/rocket-factory/platform/pkg/closer"
 	paymentV1 "github.com/crafty-ezhik/rocket-factory/shared/pkg/proto/payment/v1"
 )
 
 type diContainer struct {
-	paymentV1API   paymentV1.PaymentServiceServer
-	paymentService service.PaymentService
+	paymentV1API          paymentV1.PaymentServiceServer
+	paymentService        service.PaymentService
+	transactionRepository repository.TransactionRepository
+	paymentProviders      map[model.PaymentMethod]provider.PaymentProvider
+
+	pgConnPool *pgxpool.Pool
 }
 
 func NewDIContainer() *diContainer {
@@ -25,9 +40,57 @@ func (d *diContainer) PaymentV1API(ctx context.Context) paymentV1.PaymentService
 	return d.paymentV1API
 }
 
-func (d *diContainer) PartService(_ context.Context) service.PaymentService {
+func (d *diContainer) PartService(ctx context.Context) service.PaymentService {
 	if d.paymentService == nil {
-		d.paymentService = paymentService.NewService()
+		d.paymentService = paymentService.NewService(d.TransactionRepository(ctx), d.PaymentProviders())
 	}
 	return d.paymentService
 }
+
+func (d *diContainer) TransactionRepository(ctx context.Context) repository.TransactionRepository {
+	if d.transactionRepository == nil {
+		d.transactionRepository = transactionRepo.NewRepository(d.PgConnPool(ctx))
+	}
+	return d.transactionRepository
+}
+
+// PaymentProviders - Создаются платежные провайдеры для каждого способа оплаты
+func (d *diContainer) PaymentProviders() map[model.PaymentMethod]provider.PaymentProvider {
+	if d.paymentProviders == nil {
+		// Интеграций с реальными провайдерами пока нет, все способы оплаты обслуживает fake-провайдер
+		d.paymentProviders = map[model.PaymentMethod]provider.PaymentProvider{
+			model.PaymentMethodCARD:          fake.NewProvider(),
+			model.PaymentMethodSBP:           fake.NewProvider(),
+			model.PaymentMethodCREDITCARD:    fake.NewProvider(),
+			model.PaymentMethodINVESTORMONEY: fake.NewProvider(),
+		}
+	}
+	return d.paymentProviders
+}
+
+func (d *diContainer) PgConnPool(ctx context.Context) *pgxpool.Pool {
+	if d.pgConnPool == nil {
+		pool, err := pgxpool.New(ctx, config.AppConfig().Postgres.URI())
+		if err != nil {
+			panic(fmt.Sprintf("❌ Ошибка подключения к базе данных: %v\n", err))
+		}
+
+		// Проверка соединения
+		pingCtx, pingCancel := context.WithTimeout(ctx, 5*time.Second)
+		defer pingCancel()
+
+		err = pool.Ping(pingCtx)
+		if err != nil {
+			panic(fmt.Sprintf("failed to ping Postgres: %v\n", err))
+		}
+
+		// Добавляем закрытие пула в closer
+		closer.AddNamed("Postgres connection pool", func(ctx context.Context) error {
+			pool.Close()
+			return nil
+		})
+
+		d.pgConnPool = pool
+	}
+	return d.pgConnPool
+}
@@ -13,6 +13,7 @@ var appConfig *config
 type config struct {
 	PaymentGRPC PaymentGRPCConfig
 	PaymentHTTP PaymentHTTPConfig
+	Postgres    PostgresConfig
 	Logger      LoggerConfig
 }
 
@@ -37,9 +38,15 @@ func Load(path ...string) error {
 		return err
 	}
 
+	postgresConfig, err := env.NewPostgresConfig()
+	if err != nil {
+		return err
+	}
+
 	appConfig = &config{
 		PaymentGRPC: paymentGRPCConfig,
 		PaymentHTTP: paymentHTTPConfig,
+		Postgres:    postgresConfig,
 		Logger:      loggerCfg,
 	}
 	return nil
@@ -12,3 +12,9 @@ type LoggerConfig interface {
 	Level() string
 	AsJSON() bool
 }
+
+type PostgresConfig interface {
+	URI() string
+	DBName() string
+	MigrationsDir() string
+}
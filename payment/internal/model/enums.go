@@ -0,0 +1,28 @@
+package model
+
+type PaymentMethod string
+
+const (
+	PaymentMethodCARD          PaymentMethod = "CARD"
+	PaymentMethodSBP           PaymentMethod = "SBP"
+	PaymentMethodCREDITCARD    PaymentMethod = "CREDIT_CARD"
+	PaymentMethodINVESTORMONEY PaymentMethod = "INVESTOR_MONEY"
+)
+
+func (pm PaymentMethod) String() string {
+	return string(pm)
+}
+
+type TransactionStatus string
+
+const (
+	TransactionStatusPENDING    TransactionStatus = "PENDING"
+	TransactionStatusAUTHORIZED TransactionStatus = "AUTHORIZED"
+	TransactionStatusCAPTURED   TransactionStatus = "CAPTURED"
+	TransactionStatusFAILED     TransactionStatus = "FAILED"
+	TransactionStatusREFUNDED   TransactionStatus = "REFUNDED"
+)
+
+func (s TransactionStatus) String() string {
+	return string(s)
+}
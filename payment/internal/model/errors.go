@@ -7,6 +7,20 @@ import (
 )
 
 var (
-	ErrInvalidUserUUID  = sharedErr.NewBusinessError(sharedErr.BadRequestErrCode, errors.New("invalid user UUID"))
-	ErrInvalidOrderUUID = sharedErr.NewBusinessError(sharedErr.BadRequestErrCode, errors.New("invalid order UUID"))
+	ErrInvalidUserUUID        = sharedErr.NewBusinessError(sharedErr.BadRequestErrCode, errors.New("invalid user UUID"))
+	ErrInvalidOrderUUID       = sharedErr.NewBusinessError(sharedErr.BadRequestErrCode, errors.New("invalid order UUID"))
+	ErrInvalidTransactionUUID = sharedErr.NewBusinessError(sharedErr.BadRequestErrCode, errors.New("invalid transaction UUID"))
+
+	ErrUnsupportedPaymentMethod = sharedErr.NewBusinessError(sharedErr.BadRequestErrCode, errors.New("unsupported payment method"))
+	ErrOrderPaidByAnotherUser   = sharedErr.NewBusinessError(sharedErr.ForbiddenErrCode, errors.New("order payment belongs to another user"))
+	ErrPaymentDeclined          = sharedErr.NewBusinessError(sharedErr.FailedPreconditionErrCode, errors.New("payment declined by provider"))
+	ErrPaymentInProgress        = sharedErr.NewBusinessError(sharedErr.FailedPreconditionErrCode, errors.New("payment is already being processed"))
+	ErrTransactionRefunded      = sharedErr.NewBusinessError(sharedErr.FailedPreconditionErrCode, errors.New("transaction has already been refunded"))
+	ErrTransactionNotFound      = sharedErr.NewBusinessError(sharedErr.NotFoundErrCode, errors.New("transaction not found"))
+	ErrTransactionNotCaptured   = sharedErr.NewBusinessError(sharedErr.FailedPreconditionErrCode, errors.New("transaction has not been captured"))
+	ErrInvalidRefundAmount      = sharedErr.NewBusinessError(sharedErr.BadRequestErrCode, errors.New("refund amount must be positive"))
+	ErrRefundAmountExceeded     = sharedErr.NewBusinessError(sharedErr.FailedPreconditionErrCode, errors.New("refund amount exceeds the remaining amount"))
+	ErrRefundDeclined           = sharedErr.NewBusinessError(sharedErr.FailedPreconditionErrCode, errors.New("refund declined by provider"))
+
+	ErrTransactionStatusChanged = errors.New("transaction status has been changed concurrently")
 )
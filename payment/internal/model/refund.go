@@ -0,0 +1,30 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// RefundStatus - статус возврата. Возврат сначала резервирует сумму в ledger (PENDING),
+// и только потом выполняется у провайдера
+type RefundStatus string
+
+const (
+	RefundStatusPENDING   RefundStatus = "PENDING"
+	RefundStatusCOMPLETED RefundStatus = "COMPLETED"
+	RefundStatusFAILED    RefundStatus = "FAILED"
+)
+
+func (s RefundStatus) String() string {
+	return string(s)
+}
+
+// Refund - возврат по транзакции. UUID возврата служит ключом идемпотентности у провайдера
+type Refund struct {
+	UUID            uuid.UUID
+	TransactionUUID uuid.UUID
+	Amount          float64
+	Status          RefundStatus
+	CreatedAt       time.Time
+}
@@ -0,0 +1,21 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type Transaction struct {
+	UUID          uuid.UUID
+	OrderUUID     uuid.UUID
+	UserUUID      uuid.UUID
+	PaymentMethod PaymentMethod
+	Status        TransactionStatus
+	Amount        float64
+	// RefundedAmount - сумма, уже возвращенная пользователю
+	RefundedAmount float64
+	FailureReason  string
+	CreatedAt      time.Time
+	UpdatedAt      *time.Time
+}
@@ -0,0 +1,47 @@
+package fake
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/crafty-ezhik/rocket-factory/payment/internal/model"
+	def "github.com/crafty-ezhik/rocket-factory/payment/internal/provider"
+)
+
+var _ def.PaymentProvider = (*provider)(nil)
+
+// provider - детерминированный провайдер без внешних вызовов:
+// отклоняет платежи и возвраты перечисленных пользователей и одобряет все остальные
+type provider struct {
+	declinedUsers map[uuid.UUID]struct{}
+}
+
+func NewProvider(declinedUsers ...uuid.UUID) *provider {
+	users := make(map[uuid.UUID]struct{}, len(declinedUsers))
+	for _, userUUID := range declinedUsers {
+		users[userUUID] = struct{}{}
+	}
+
+	return &provider{
+		declinedUsers: users,
+	}
+}
+
+func (p *provider) Authorize(_ context.Context, transaction model.Transaction) error {
+	if _, ok := p.declinedUsers[transaction.UserUUID]; ok {
+		return model.ErrPaymentDeclined
+	}
+	return nil
+}
+
+func (p *provider) Capture(_ context.Context, _ model.Transaction) error {
+	return nil
+}
+
+func (p *provider) Refund(_ context.Context, transaction model.Transaction, _ model.Refund) error {
+	if _, ok := p.declinedUsers[transaction.UserUUID]; ok {
+		return model.ErrRefundDeclined
+	}
+	return nil
+}
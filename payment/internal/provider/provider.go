@@ -0,0 +1,21 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/crafty-ezhik/rocket-factory/payment/internal/model"
+)
+
+// PaymentProvider - платежный провайдер для конкретного способа оплаты.
+//
+//	UUID транзакции служит ключом идемпотентности у провайдера,
+//	поэтому повторный вызов для той же транзакции не приводит к повторному списанию.
+type PaymentProvider interface {
+	// Authorize - резервирует средства, при отказе возвращает model.ErrPaymentDeclined
+	Authorize(ctx context.Context, transaction model.Transaction) error
+	// Capture - списывает ранее зарезервированные средства
+	Capture(ctx context.Context, transaction model.Transaction) error
+	// Refund - возвращает пользователю сумму возврата по проведенной транзакции.
+	// Ключ идемпотентности - UUID возврата, при отказе возвращается model.ErrRefundDeclined
+	Refund(ctx context.Context, transaction model.Transaction, refund model.Refund) error
+}
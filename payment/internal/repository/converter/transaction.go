@@ -0,0 +1,56 @@
+package converter
+
+import (
+	serviceModel "github.com/crafty-ezhik/rocket-factory/payment/internal/model"
+	repoModel "github.com/crafty-ezhik/rocket-factory/payment/internal/repository/model"
+)
+
+func TransactionToServiceModel(transaction repoModel.Transaction) serviceModel.Transaction {
+	var failureReason string
+	if transaction.FailureReason != nil {
+		failureReason = *transaction.FailureReason
+	}
+
+	return serviceModel.Transaction{
+		UUID:           transaction.UUID,
+		OrderUUID:      transaction.OrderUUID,
+		UserUUID:       transaction.UserUUID,
+		PaymentMethod:  transaction.PaymentMethod,
+		Status:         transaction.Status,
+		Amount:         transaction.Amount,
+		RefundedAmount: transaction.RefundedAmount,
+		FailureReason:  failureReason,
+		CreatedAt:      transaction.CreatedAt,
+		UpdatedAt:      transaction.UpdatedAt,
+	}
+}
+
+func TransactionToRepoModel(transaction serviceModel.Transaction) repoModel.Transaction {
+	var failureReason *string
+	if transaction.FailureReason != "" {
+		failureReason = &transaction.FailureReason
+	}
+
+	return repoModel.Transaction{
+		UUID:           transaction.UUID,
+		OrderUUID:      transaction.OrderUUID,
+		UserUUID:       transaction.UserUUID,
+		PaymentMethod:  transaction.PaymentMethod,
+		Status:         transaction.Status,
+		Amount:         transaction.Amount,
+		RefundedAmount: transaction.RefundedAmount,
+		FailureReason:  failureReason,
+		CreatedAt:      transaction.CreatedAt,
+		UpdatedAt:      transaction.UpdatedAt,
+	}
+}
+
+func RefundToServiceModel(refund repoModel.Refund) serviceModel.Refund {
+	return serviceModel.Refund{
+		UUID:            refund.UUID,
+		TransactionUUID: refund.TransactionUUID,
+		Amount:          refund.Amount,
+		Status:          refund.Status,
+		CreatedAt:       refund.CreatedAt,
+	}
+}
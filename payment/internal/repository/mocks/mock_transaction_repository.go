@@ -0,0 +1,445 @@
+// Code generated for crafty-ezhik service
+// © Crafty-ezhik 2025.
+
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/crafty-ezhik/rocket-factory/payment/internal/model"
+	"github.com/google/uuid"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockTransactionRepository creates a new instance of MockTransactionRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockTransactionRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockTransactionRepository {
+	mock := &MockTransactionRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockTransactionRepository is an autogenerated mock type for the TransactionRepository type
+type MockTransactionRepository struct {
+	mock.Mock
+}
+
+type MockTransactionRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockTransactionRepository) EXPECT() *MockTransactionRepository_Expecter {
+	return &MockTransactionRepository_Expecter{mock: &_m.Mock}
+}
+
+// CompleteRefund provides a mock function for the type MockTransactionRepository
+func (_mock *MockTransactionRepository) CompleteRefund(ctx context.Context, refund model.Refund) (model.Transaction, error) {
+	ret := _mock.Called(ctx, refund)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CompleteRefund")
+	}
+
+	var r0 model.Transaction
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, model.Refund) (model.Transaction, error)); ok {
+		return returnFunc(ctx, refund)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, model.Refund) model.Transaction); ok {
+		r0 = returnFunc(ctx, refund)
+	} else {
+		r0 = ret.Get(0).(model.Transaction)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, model.Refund) error); ok {
+		r1 = returnFunc(ctx, refund)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockTransactionRepository_CompleteRefund_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CompleteRefund'
+type MockTransactionRepository_CompleteRefund_Call struct {
+	*mock.Call
+}
+
+// CompleteRefund is a helper method to define mock.On call
+//   - ctx context.Context
+//   - refund model.Refund
+func (_e *MockTransactionRepository_Expecter) CompleteRefund(ctx interface{}, refund interface{}) *MockTransactionRepository_CompleteRefund_Call {
+	return &MockTransactionRepository_CompleteRefund_Call{Call: _e.mock.On("CompleteRefund", ctx, refund)}
+}
+
+func (_c *MockTransactionRepository_CompleteRefund_Call) Run(run func(ctx context.Context, refund model.Refund)) *MockTransactionRepository_CompleteRefund_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 model.Refund
+		if args[1] != nil {
+			arg1 = args[1].(model.Refund)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockTransactionRepository_CompleteRefund_Call) Return(transaction model.Transaction, err error) *MockTransactionRepository_CompleteRefund_Call {
+	_c.Call.Return(transaction, err)
+	return _c
+}
+
+func (_c *MockTransactionRepository_CompleteRefund_Call) RunAndReturn(run func(ctx context.Context, refund model.Refund) (model.Transaction, error)) *MockTransactionRepository_CompleteRefund_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Create provides a mock function for the type MockTransactionRepository
+func (_mock *MockTransactionRepository) Create(ctx context.Context, transaction model.Transaction) (model.Transaction, error) {
+	ret := _mock.Called(ctx, transaction)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 model.Transaction
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, model.Transaction) (model.Transaction, error)); ok {
+		return returnFunc(ctx, transaction)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, model.Transaction) model.Transaction); ok {
+		r0 = returnFunc(ctx, transaction)
+	} else {
+		r0 = ret.Get(0).(model.Transaction)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, model.Transaction) error); ok {
+		r1 = returnFunc(ctx, transaction)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockTransactionRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockTransactionRepository_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - transaction model.Transaction
+func (_e *MockTransactionRepository_Expecter) Create(ctx interface{}, transaction interface{}) *MockTransactionRepository_Create_Call {
+	return &MockTransactionRepository_Create_Call{Call: _e.mock.On("Create", ctx, transaction)}
+}
+
+func (_c *MockTransactionRepository_Create_Call) Run(run func(ctx context.Context, transaction model.Transaction)) *MockTransactionRepository_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 model.Transaction
+		if args[1] != nil {
+			arg1 = args[1].(model.Transaction)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockTransactionRepository_Create_Call) Return(transaction1 model.Transaction, err error) *MockTransactionRepository_Create_Call {
+	_c.Call.Return(transaction1, err)
+	return _c
+}
+
+func (_c *MockTransactionRepository_Create_Call) RunAndReturn(run func(ctx context.Context, transaction model.Transaction) (model.Transaction, error)) *MockTransactionRepository_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FailRefund provides a mock function for the type MockTransactionRepository
+func (_mock *MockTransactionRepository) FailRefund(ctx context.Context, refund model.Refund) error {
+	ret := _mock.Called(ctx, refund)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FailRefund")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, model.Refund) error); ok {
+		r0 = returnFunc(ctx, refund)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockTransactionRepository_FailRefund_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FailRefund'
+type MockTransactionRepository_FailRefund_Call struct {
+	*mock.Call
+}
+
+// FailRefund is a helper method to define mock.On call
+//   - ctx context.Context
+//   - refund model.Refund
+func (_e *MockTransactionRepository_Expecter) FailRefund(ctx interface{}, refund interface{}) *MockTransactionRepository_FailRefund_Call {
+	return &MockTransactionRepository_FailRefund_Call{Call: _e.mock.On("FailRefund", ctx, refund)}
+}
+
+func (_c *MockTransactionRepository_FailRefund_Call) Run(run func(ctx context.Context, refund model.Refund)) *MockTransactionRepository_FailRefund_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 model.Refund
+		if args[1] != nil {
+			arg1 = args[1].(model.Refund)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockTransactionRepository_FailRefund_Call) Return(err error) *MockTransactionRepository_FailRefund_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockTransactionRepository_FailRefund_Call) RunAndReturn(run func(ctx context.Context, refund model.Refund) error) *MockTransactionRepository_FailRefund_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Get provides a mock function for the type MockTransactionRepository
+func (_mock *MockTransactionRepository) Get(ctx context.Context, transactionUUID uuid.UUID) (model.Transaction, error) {
+	ret := _mock.Called(ctx, transactionUUID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 model.Transaction
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (model.Transaction, error)); ok {
+		return returnFunc(ctx, transactionUUID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) model.Transaction); ok {
+		r0 = returnFunc(ctx, transactionUUID)
+	} else {
+		r0 = ret.Get(0).(model.Transaction)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, transactionUUID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockTransactionRepository_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
+type MockTransactionRepository_Get_Call struct {
+	*mock.Call
+}
+
+// Get is a helper method to define mock.On call
+//   - ctx context.Context
+//   - transactionUUID uuid.UUID
+func (_e *MockTransactionRepository_Expecter) Get(ctx interface{}, transactionUUID interface{}) *MockTransactionRepository_Get_Call {
+	return &MockTransactionRepository_Get_Call{Call: _e.mock.On("Get", ctx, transactionUUID)}
+}
+
+func (_c *MockTransactionRepository_Get_Call) Run(run func(ctx context.Context, transactionUUID uuid.UUID)) *MockTransactionRepository_Get_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockTransactionRepository_Get_Call) Return(transaction model.Transaction, err error) *MockTransactionRepository_Get_Call {
+	_c.Call.Return(transaction, err)
+	return _c
+}
+
+func (_c *MockTransactionRepository_Get_Call) RunAndReturn(run func(ctx context.Context, transactionUUID uuid.UUID) (model.Transaction, error)) *MockTransactionRepository_Get_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReserveRefund provides a mock function for the type MockTransactionRepository
+func (_mock *MockTransactionRepository) ReserveRefund(ctx context.Context, transactionUUID uuid.UUID, amount float64) (model.Refund, error) {
+	ret := _mock.Called(ctx, transactionUUID, amount)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReserveRefund")
+	}
+
+	var r0 model.Refund
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, float64) (model.Refund, error)); ok {
+		return returnFunc(ctx, transactionUUID, amount)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, float64) model.Refund); ok {
+		r0 = returnFunc(ctx, transactionUUID, amount)
+	} else {
+		r0 = ret.Get(0).(model.Refund)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, float64) error); ok {
+		r1 = returnFunc(ctx, transactionUUID, amount)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockTransactionRepository_ReserveRefund_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReserveRefund'
+type MockTransactionRepository_ReserveRefund_Call struct {
+	*mock.Call
+}
+
+// ReserveRefund is a helper method to define mock.On call
+//   - ctx context.Context
+//   - transactionUUID uuid.UUID
+//   - amount float64
+func (_e *MockTransactionRepository_Expecter) ReserveRefund(ctx interface{}, transactionUUID interface{}, amount interface{}) *MockTransactionRepository_ReserveRefund_Call {
+	return &MockTransactionRepository_ReserveRefund_Call{Call: _e.mock.On("ReserveRefund", ctx, transactionUUID, amount)}
+}
+
+func (_c *MockTransactionRepository_ReserveRefund_Call) Run(run func(ctx context.Context, transactionUUID uuid.UUID, amount float64)) *MockTransactionRepository_ReserveRefund_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 float64
+		if args[2] != nil {
+			arg2 = args[2].(float64)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockTransactionRepository_ReserveRefund_Call) Return(refund model.Refund, err error) *MockTransactionRepository_ReserveRefund_Call {
+	_c.Call.Return(refund, err)
+	return _c
+}
+
+func (_c *MockTransactionRepository_ReserveRefund_Call) RunAndReturn(run func(ctx context.Context, transactionUUID uuid.UUID, amount float64) (model.Refund, error)) *MockTransactionRepository_ReserveRefund_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateStatus provides a mock function for the type MockTransactionRepository
+func (_mock *MockTransactionRepository) UpdateStatus(ctx context.Context, transactionUUID uuid.UUID, from model.TransactionStatus, to model.TransactionStatus, reason string) error {
+	ret := _mock.Called(ctx, transactionUUID, from, to, reason)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateStatus")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, model.TransactionStatus, model.TransactionStatus, string) error); ok {
+		r0 = returnFunc(ctx, transactionUUID, from, to, reason)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockTransactionRepository_UpdateStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateStatus'
+type MockTransactionRepository_UpdateStatus_Call struct {
+	*mock.Call
+}
+
+// UpdateStatus is a helper method to define mock.On call
+//   - ctx context.Context
+//   - transactionUUID uuid.UUID
+//   - from model.TransactionStatus
+//   - to model.TransactionStatus
+//   - reason string
+func (_e *MockTransactionRepository_Expecter) UpdateStatus(ctx interface{}, transactionUUID interface{}, from interface{}, to interface{}, reason interface{}) *MockTransactionRepository_UpdateStatus_Call {
+	return &MockTransactionRepository_UpdateStatus_Call{Call: _e.mock.On("UpdateStatus", ctx, transactionUUID, from, to, reason)}
+}
+
+func (_c *MockTransactionRepository_UpdateStatus_Call) Run(run func(ctx context.Context, transactionUUID uuid.UUID, from model.TransactionStatus, to model.TransactionStatus, reason string)) *MockTransactionRepository_UpdateStatus_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 model.TransactionStatus
+		if args[2] != nil {
+			arg2 = args[2].(model.TransactionStatus)
+		}
+		var arg3 model.TransactionStatus
+		if args[3] != nil {
+			arg3 = args[3].(model.TransactionStatus)
+		}
+		var arg4 string
+		if args[4] != nil {
+			arg4 = args[4].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+			arg4,
+		)
+	})
+	return _c
+}
+
+func (_c *MockTransactionRepository_UpdateStatus_Call) Return(err error) *MockTransactionRepository_UpdateStatus_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockTransactionRepository_UpdateStatus_Call) RunAndReturn(run func(ctx context.Context, transactionUUID uuid.UUID, from model.TransactionStatus, to model.TransactionStatus, reason string) error) *MockTransactionRepository_UpdateStatus_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -0,0 +1,30 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/crafty-ezhik/rocket-factory/payment/internal/model"
+)
+
+type Transaction struct {
+	UUID           uuid.UUID
+	OrderUUID      uuid.UUID
+	UserUUID       uuid.UUID
+	PaymentMethod  model.PaymentMethod
+	Status         model.TransactionStatus
+	Amount         float64
+	RefundedAmount float64
+	FailureReason  *string
+	CreatedAt      time.Time
+	UpdatedAt      *time.Time
+}
+
+type Refund struct {
+	UUID            uuid.UUID
+	TransactionUUID uuid.UUID
+	Amount          float64
+	Status          model.RefundStatus
+	CreatedAt       time.Time
+}
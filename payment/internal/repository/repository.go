@@ -0,0 +1,25 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	serviceModel "github.com/crafty-ezhik/rocket-factory/payment/internal/model"
+)
+
+type TransactionRepository interface {
+	// Create - создает транзакцию, а если для заказа она уже есть, возвращает существующую
+	Create(ctx context.Context, transaction serviceModel.Transaction) (serviceModel.Transaction, error)
+	// Get - возвращает транзакцию по UUID
+	Get(ctx context.Context, transactionUUID uuid.UUID) (serviceModel.Transaction, error)
+	// UpdateStatus - переводит транзакцию из статуса from в статус to
+	UpdateStatus(ctx context.Context, transactionUUID uuid.UUID, from, to serviceModel.TransactionStatus, reason string) error
+	// ReserveRefund - резервирует сумму возврата по проведенной транзакции и создает возврат в статусе PENDING.
+	// Если транзакция не CAPTURED или сумма превышает остаток, возвращается ErrTransactionStatusChanged
+	ReserveRefund(ctx context.Context, transactionUUID uuid.UUID, amount float64) (serviceModel.Refund, error)
+	// CompleteRefund - отмечает возврат выполненным, при полном возврате транзакция переходит в статус REFUNDED
+	CompleteRefund(ctx context.Context, refund serviceModel.Refund) (serviceModel.Transaction, error)
+	// FailRefund - отмечает возврат неудачным и снимает резерв суммы
+	FailRefund(ctx context.Context, refund serviceModel.Refund) error
+}
@@ -0,0 +1,42 @@
+package transaction
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	sq "github.com/Masterminds/squirrel"
+	"go.uber.org/zap"
+
+	serviceModel "github.com/crafty-ezhik/rocket-factory/payment/internal/model"
+	"github.com/crafty-ezhik/rocket-factory/payment/internal/repository/converter"
+	"github.com/crafty-ezhik/rocket-factory/platform/pkg/logger"
+)
+
+func (r *repository) Create(ctx context.Context, transaction serviceModel.Transaction) (serviceModel.Transaction, error) {
+	repoTransaction := converter.TransactionToRepoModel(transaction)
+
+	// При конфликте по order_uuid обновление ничего не меняет, но позволяет вернуть существующую строку
+	builderInsert := sq.Insert(transactionsTable).
+		PlaceholderFormat(sq.Dollar).
+		Columns(transactionFieldOrderUUID, transactionFieldUserUUID, transactionFieldPaymentMethod, transactionFieldStatus, transactionFieldAmount).
+		Values(repoTransaction.OrderUUID, repoTransaction.UserUUID, repoTransaction.PaymentMethod, repoTransaction.Status, repoTransaction.Amount).
+		Suffix(fmt.Sprintf("ON CONFLICT (%[1]s) DO UPDATE SET %[1]s = EXCLUDED.%[1]s RETURNING %[2]s",
+			transactionFieldOrderUUID,
+			strings.Join(transactionColumns, ", "),
+		))
+
+	query, args, err := builderInsert.ToSql()
+	if err != nil {
+		logger.Error(ctx, "Ошибка при преобразовании запроса к SQL", zap.Error(err))
+		return serviceModel.Transaction{}, err
+	}
+
+	created, err := scanTransaction(r.pool.QueryRow(ctx, query, args...))
+	if err != nil {
+		logger.Error(ctx, "Ошибка при создании транзакции", zap.Error(err))
+		return serviceModel.Transaction{}, err
+	}
+
+	return converter.TransactionToServiceModel(created), nil
+}
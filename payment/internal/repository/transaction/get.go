@@ -0,0 +1,39 @@
+package transaction
+
+import (
+	"context"
+	"errors"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"go.uber.org/zap"
+
+	serviceModel "github.com/crafty-ezhik/rocket-factory/payment/internal/model"
+	"github.com/crafty-ezhik/rocket-factory/payment/internal/repository/converter"
+	"github.com/crafty-ezhik/rocket-factory/platform/pkg/logger"
+)
+
+func (r *repository) Get(ctx context.Context, transactionUUID uuid.UUID) (serviceModel.Transaction, error) {
+	builderSelect := sq.Select(transactionColumns...).
+		From(transactionsTable).
+		Where(sq.Eq{transactionFieldTransactionUUID: transactionUUID}).
+		PlaceholderFormat(sq.Dollar)
+
+	query, args, err := builderSelect.ToSql()
+	if err != nil {
+		logger.Error(ctx, "Ошибка при преобразовании запроса к SQL", zap.Error(err))
+		return serviceModel.Transaction{}, err
+	}
+
+	transaction, err := scanTransaction(r.pool.QueryRow(ctx, query, args...))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return serviceModel.Transaction{}, serviceModel.ErrTransactionNotFound
+		}
+		logger.Error(ctx, "Ошибка при получении транзакции", zap.Error(err))
+		return serviceModel.Transaction{}, err
+	}
+
+	return converter.TransactionToServiceModel(transaction), nil
+}
@@ -0,0 +1,187 @@
+package transaction
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"go.uber.org/zap"
+
+	serviceModel "github.com/crafty-ezhik/rocket-factory/payment/internal/model"
+	"github.com/crafty-ezhik/rocket-factory/payment/internal/repository/converter"
+	repoModel "github.com/crafty-ezhik/rocket-factory/payment/internal/repository/model"
+	"github.com/crafty-ezhik/rocket-factory/platform/pkg/logger"
+)
+
+// ReserveRefund - увеличивает зарезервированную сумму возврата и создает возврат PENDING в одной транзакции.
+// Резерв делается до вызова провайдера, поэтому параллельные возвраты не могут вернуть больше суммы оплаты
+func (r *repository) ReserveRefund(ctx context.Context, transactionUUID uuid.UUID, amount float64) (serviceModel.Refund, error) {
+	// Условия по статусу и остатку защищают от параллельного возврата сверх суммы оплаты
+	updateQuery, updateArgs, err := sq.Update(transactionsTable).
+		PlaceholderFormat(sq.Dollar).
+		Set(transactionFieldRefundedAmount, sq.Expr(transactionFieldRefundedAmount+" + ?", amount)).
+		Set(transactionFieldUpdatedAt, sq.Expr("now()")).
+		Where(sq.Eq{
+			transactionFieldTransactionUUID: transactionUUID,
+			transactionFieldStatus:          serviceModel.TransactionStatusCAPTURED,
+		}).
+		Where(sq.Expr(transactionFieldRefundedAmount+" + ? <= "+transactionFieldAmount, amount)).
+		ToSql()
+	if err != nil {
+		logger.Error(ctx, "Ошибка при преобразовании запроса к SQL", zap.Error(err))
+		return serviceModel.Refund{}, err
+	}
+
+	insertQuery, insertArgs, err := sq.Insert(refundsTable).
+		PlaceholderFormat(sq.Dollar).
+		Columns(refundFieldTransactionUUID, refundFieldAmount, refundFieldStatus).
+		Values(transactionUUID, amount, serviceModel.RefundStatusPENDING).
+		Suffix("RETURNING " + strings.Join([]string{
+			refundFieldRefundUUID,
+			refundFieldTransactionUUID,
+			refundFieldAmount,
+			refundFieldStatus,
+			refundFieldCreatedAt,
+		}, ", ")).
+		ToSql()
+	if err != nil {
+		logger.Error(ctx, "Ошибка при преобразовании запроса к SQL", zap.Error(err))
+		return serviceModel.Refund{}, err
+	}
+
+	var refund repoModel.Refund
+	err = pgx.BeginFunc(ctx, r.pool, func(tx pgx.Tx) error {
+		tag, err := tx.Exec(ctx, updateQuery, updateArgs...)
+		if err != nil {
+			logger.Error(ctx, "Ошибка при резервировании суммы возврата", zap.Error(err))
+			return err
+		}
+		if tag.RowsAffected() == 0 {
+			return serviceModel.ErrTransactionStatusChanged
+		}
+
+		err = tx.QueryRow(ctx, insertQuery, insertArgs...).Scan(
+			&refund.UUID,
+			&refund.TransactionUUID,
+			&refund.Amount,
+			&refund.Status,
+			&refund.CreatedAt,
+		)
+		if err != nil {
+			logger.Error(ctx, "Ошибка при сохранении возврата", zap.Error(err))
+			return err
+		}
+		return nil
+	})
+	if err != nil {
+		return serviceModel.Refund{}, err
+	}
+
+	return converter.RefundToServiceModel(refund), nil
+}
+
+// CompleteRefund - отмечает возврат выполненным. Транзакция становится REFUNDED,
+// когда выполненные и зарезервированные возвраты покрывают всю сумму оплаты
+func (r *repository) CompleteRefund(ctx context.Context, refund serviceModel.Refund) (serviceModel.Transaction, error) {
+	refundQuery, refundArgs, err := buildRefundStatusQuery(refund.UUID, serviceModel.RefundStatusCOMPLETED).ToSql()
+	if err != nil {
+		logger.Error(ctx, "Ошибка при преобразовании запроса к SQL", zap.Error(err))
+		return serviceModel.Transaction{}, err
+	}
+
+	transactionQuery, transactionArgs, err := sq.Update(transactionsTable).
+		PlaceholderFormat(sq.Dollar).
+		Set(transactionFieldStatus, sq.Expr(
+			fmt.Sprintf("CASE WHEN %s >= %s THEN ? ELSE %s END",
+				transactionFieldRefundedAmount, transactionFieldAmount, transactionFieldStatus),
+			serviceModel.TransactionStatusREFUNDED,
+		)).
+		Set(transactionFieldUpdatedAt, sq.Expr("now()")).
+		Where(sq.Eq{transactionFieldTransactionUUID: refund.TransactionUUID}).
+		Suffix("RETURNING " + strings.Join(transactionColumns, ", ")).
+		ToSql()
+	if err != nil {
+		logger.Error(ctx, "Ошибка при преобразовании запроса к SQL", zap.Error(err))
+		return serviceModel.Transaction{}, err
+	}
+
+	var updated repoModel.Transaction
+	err = pgx.BeginFunc(ctx, r.pool, func(tx pgx.Tx) error {
+		tag, err := tx.Exec(ctx, refundQuery, refundArgs...)
+		if err != nil {
+			logger.Error(ctx, "Ошибка при обновлении статуса возврата", zap.Error(err))
+			return err
+		}
+		if tag.RowsAffected() == 0 {
+			return serviceModel.ErrTransactionStatusChanged
+		}
+
+		updated, err = scanTransaction(tx.QueryRow(ctx, transactionQuery, transactionArgs...))
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return serviceModel.ErrTransactionNotFound
+			}
+			logger.Error(ctx, "Ошибка при обновлении статуса транзакции", zap.Error(err))
+			return err
+		}
+		return nil
+	})
+	if err != nil {
+		return serviceModel.Transaction{}, err
+	}
+
+	return converter.TransactionToServiceModel(updated), nil
+}
+
+// FailRefund - отмечает возврат неудачным и возвращает зарезервированную сумму в остаток транзакции
+func (r *repository) FailRefund(ctx context.Context, refund serviceModel.Refund) error {
+	refundQuery, refundArgs, err := buildRefundStatusQuery(refund.UUID, serviceModel.RefundStatusFAILED).ToSql()
+	if err != nil {
+		logger.Error(ctx, "Ошибка при преобразовании запроса к SQL", zap.Error(err))
+		return err
+	}
+
+	transactionQuery, transactionArgs, err := sq.Update(transactionsTable).
+		PlaceholderFormat(sq.Dollar).
+		Set(transactionFieldRefundedAmount, sq.Expr(transactionFieldRefundedAmount+" - ?", refund.Amount)).
+		Set(transactionFieldUpdatedAt, sq.Expr("now()")).
+		Where(sq.Eq{transactionFieldTransactionUUID: refund.TransactionUUID}).
+		ToSql()
+	if err != nil {
+		logger.Error(ctx, "Ошибка при преобразовании запроса к SQL", zap.Error(err))
+		return err
+	}
+
+	return pgx.BeginFunc(ctx, r.pool, func(tx pgx.Tx) error {
+		tag, err := tx.Exec(ctx, refundQuery, refundArgs...)
+		if err != nil {
+			logger.Error(ctx, "Ошибка при обновлении статуса возврата", zap.Error(err))
+			return err
+		}
+		// Резерв снимается только один раз, даже если FailRefund вызван повторно
+		if tag.RowsAffected() == 0 {
+			return serviceModel.ErrTransactionStatusChanged
+		}
+
+		if _, err = tx.Exec(ctx, transactionQuery, transactionArgs...); err != nil {
+			logger.Error(ctx, "Ошибка при снятии резерва возврата", zap.Error(err))
+			return err
+		}
+		return nil
+	})
+}
+
+// buildRefundStatusQuery - завершает возврат, который еще ожидает ответа провайдера
+func buildRefundStatusQuery(refundUUID uuid.UUID, status serviceModel.RefundStatus) sq.UpdateBuilder {
+	return sq.Update(refundsTable).
+		PlaceholderFormat(sq.Dollar).
+		Set(refundFieldStatus, status).
+		Where(sq.Eq{
+			refundFieldRefundUUID: refundUUID,
+			refundFieldStatus:     serviceModel.RefundStatusPENDING,
+		})
+}
@@ -0,0 +1,75 @@
+package transaction
+
+import (
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	def "github.com/crafty-ezhik/rocket-factory/payment/internal/repository"
+	repoModel "github.com/crafty-ezhik/rocket-factory/payment/internal/repository/model"
+)
+
+var _ def.TransactionRepository = (*repository)(nil)
+
+const (
+	transactionsTable = "transactions"
+
+	transactionFieldTransactionUUID = "transaction_uuid"
+	transactionFieldOrderUUID       = "order_uuid"
+	transactionFieldUserUUID        = "user_uuid"
+	transactionFieldPaymentMethod   = "payment_method"
+	transactionFieldStatus          = "status"
+	transactionFieldAmount          = "amount"
+	transactionFieldRefundedAmount  = "refunded_amount"
+	transactionFieldFailureReason   = "failure_reason"
+	transactionFieldCreatedAt       = "created_at"
+	transactionFieldUpdatedAt       = "updated_at"
+
+	refundsTable = "refunds"
+
+	refundFieldRefundUUID      = "refund_uuid"
+	refundFieldTransactionUUID = "transaction_uuid"
+	refundFieldAmount          = "amount"
+	refundFieldStatus          = "status"
+	refundFieldCreatedAt       = "created_at"
+)
+
+var transactionColumns = []string{
+	transactionFieldTransactionUUID,
+	transactionFieldOrderUUID,
+	transactionFieldUserUUID,
+	transactionFieldPaymentMethod,
+	transactionFieldStatus,
+	transactionFieldAmount,
+	transactionFieldRefundedAmount,
+	transactionFieldFailureReason,
+	transactionFieldCreatedAt,
+	transactionFieldUpdatedAt,
+}
+
+type repository struct {
+	pool *pgxpool.Pool
+}
+
+func NewRepository(pool *pgxpool.Pool) *repository {
+	return &repository{
+		pool: pool,
+	}
+}
+
+// scanTransaction - читает строку, выбранную в порядке transactionColumns
+func scanTransaction(row pgx.Row) (repoModel.Transaction, error) {
+	var transaction repoModel.Transaction
+	err := row.Scan(
+		&transaction.UUID,
+		&transaction.OrderUUID,
+		&transaction.UserUUID,
+		&transaction.PaymentMethod,
+		&transaction.Status,
+		&transaction.Amount,
+		&transaction.RefundedAmount,
+		&transaction.FailureReason,
+		&transaction.CreatedAt,
+		&transaction.UpdatedAt,
+	)
+	return transaction, err
+}
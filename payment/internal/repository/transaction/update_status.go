@@ -0,0 +1,48 @@
+package transaction
+
+import (
+	"context"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	serviceModel "github.com/crafty-ezhik/rocket-factory/payment/internal/model"
+	"github.com/crafty-ezhik/rocket-factory/platform/pkg/logger"
+)
+
+func (r *repository) UpdateStatus(ctx context.Context, transactionUUID uuid.UUID, from, to serviceModel.TransactionStatus, reason string) error {
+	var failureReason *string
+	if reason != "" {
+		failureReason = &reason
+	}
+
+	// Условие по текущему статусу защищает от одновременной обработки одной транзакции
+	builderUpdate := sq.Update(transactionsTable).
+		PlaceholderFormat(sq.Dollar).
+		Set(transactionFieldStatus, to).
+		Set(transactionFieldFailureReason, failureReason).
+		Set(transactionFieldUpdatedAt, sq.Expr("now()")).
+		Where(sq.Eq{
+			transactionFieldTransactionUUID: transactionUUID,
+			transactionFieldStatus:          from,
+		})
+
+	query, args, err := builderUpdate.ToSql()
+	if err != nil {
+		logger.Error(ctx, "Ошибка при преобразовании запроса к SQL", zap.Error(err))
+		return err
+	}
+
+	res, err := r.pool.Exec(ctx, query, args...)
+	if err != nil {
+		logger.Error(ctx, "Ошибка при обновлении статуса транзакции", zap.Error(err))
+		return err
+	}
+
+	if res.RowsAffected() == 0 {
+		return serviceModel.ErrTransactionStatusChanged
+	}
+
+	return nil
+}
@@ -10,6 +10,7 @@ package mocks
 import (
 	"context"
 
+	"github.com/crafty-ezhik/rocket-factory/payment/internal/model"
 	"github.com/google/uuid"
 	mock "github.com/stretchr/testify/mock"
 )
@@ -42,8 +43,8 @@ func (_m *MockPaymentService) EXPECT() *MockPaymentService_Expecter {
 }
 
 // PayOrder provides a mock function for the type MockPaymentService
-func (_mock *MockPaymentService) PayOrder(ctx context.Context, orderID uuid.UUID, userID uuid.UUID, paymentMethod string) (string, error) {
-	ret := _mock.Called(ctx, orderID, userID, paymentMethod)
+func (_mock *MockPaymentService) PayOrder(ctx context.Context, orderID uuid.UUID, userID uuid.UUID, paymentMethod string, amount float64) (string, error) {
+	ret := _mock.Called(ctx, orderID, userID, paymentMethod, amount)
 
 	if len(ret) == 0 {
 		panic("no return value specified for PayOrder")
@@ -51,16 +52,16 @@ func (_mock *MockPaymentService) PayOrder(ctx context.Context, orderID uuid.UUID
 
 	var r0 string
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, string) (string, error)); ok {
-		return returnFunc(ctx, orderID, userID, paymentMethod)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, string, float64) (string, error)); ok {
+		return returnFunc(ctx, orderID, userID, paymentMethod, amount)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, string) string); ok {
-		r0 = returnFunc(ctx, orderID, userID, paymentMethod)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, string, float64) string); ok {
+		r0 = returnFunc(ctx, orderID, userID, paymentMethod, amount)
 	} else {
 		r0 = ret.Get(0).(string)
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID, string) error); ok {
-		r1 = returnFunc(ctx, orderID, userID, paymentMethod)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID, string, float64) error); ok {
+		r1 = returnFunc(ctx, orderID, userID, paymentMethod, amount)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -77,11 +78,12 @@ type MockPaymentService_PayOrder_Call struct {
 //   - orderID uuid.UUID
 //   - userID uuid.UUID
 //   - paymentMethod string
-func (_e *MockPaymentService_Expecter) PayOrder(ctx interface{}, orderID interface{}, userID interface{}, paymentMethod interface{}) *MockPaymentService_PayOrder_Call {
-	return &MockPaymentService_PayOrder_Call{Call: _e.mock.On("PayOrder", ctx, orderID, userID, paymentMethod)}
+//   - amount float64
+func (_e *MockPaymentService_Expecter) PayOrder(ctx interface{}, orderID interface{}, userID interface{}, paymentMethod interface{}, amount interface{}) *MockPaymentService_PayOrder_Call {
+	return &MockPaymentService_PayOrder_Call{Call: _e.mock.On("PayOrder", ctx, orderID, userID, paymentMethod, amount)}
 }
 
-func (_c *MockPaymentService_PayOrder_Call) Run(run func(ctx context.Context, orderID uuid.UUID, userID uuid.UUID, paymentMethod string)) *MockPaymentService_PayOrder_Call {
+func (_c *MockPaymentService_PayOrder_Call) Run(run func(ctx context.Context, orderID uuid.UUID, userID uuid.UUID, paymentMethod string, amount float64)) *MockPaymentService_PayOrder_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -99,11 +101,16 @@ func (_c *MockPaymentService_PayOrder_Call) Run(run func(ctx context.Context, or
 		if args[3] != nil {
 			arg3 = args[3].(string)
 		}
+		var arg4 float64
+		if args[4] != nil {
+			arg4 = args[4].(float64)
+		}
 		run(
 			arg0,
 			arg1,
 			arg2,
 			arg3,
+			arg4,
 		)
 	})
 	return _c
@@ -114,7 +121,79 @@ func (_c *MockPaymentService_PayOrder_Call) Return(s string, err error) *MockPay
 	return _c
 }
 
-func (_c *MockPaymentService_PayOrder_Call) RunAndReturn(run func(ctx context.Context, orderID uuid.UUID, userID uuid.UUID, paymentMethod string) (string, error)) *MockPaymentService_PayOrder_Call {
+func (_c *MockPaymentService_PayOrder_Call) RunAndReturn(run func(ctx context.Context, orderID uuid.UUID, userID uuid.UUID, paymentMethod string, amount float64) (string, error)) *MockPaymentService_PayOrder_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RefundPayment provides a mock function for the type MockPaymentService
+func (_mock *MockPaymentService) RefundPayment(ctx context.Context, transactionUUID uuid.UUID, amount *float64) (model.Transaction, error) {
+	ret := _mock.Called(ctx, transactionUUID, amount)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RefundPayment")
+	}
+
+	var r0 model.Transaction
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, *float64) (model.Transaction, error)); ok {
+		return returnFunc(ctx, transactionUUID, amount)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, *float64) model.Transaction); ok {
+		r0 = returnFunc(ctx, transactionUUID, amount)
+	} else {
+		r0 = ret.Get(0).(model.Transaction)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, *float64) error); ok {
+		r1 = returnFunc(ctx, transactionUUID, amount)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPaymentService_RefundPayment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RefundPayment'
+type MockPaymentService_RefundPayment_Call struct {
+	*mock.Call
+}
+
+// RefundPayment is a helper method to define mock.On call
+//   - ctx context.Context
+//   - transactionUUID uuid.UUID
+//   - amount *float64
+func (_e *MockPaymentService_Expecter) RefundPayment(ctx interface{}, transactionUUID interface{}, amount interface{}) *MockPaymentService_RefundPayment_Call {
+	return &MockPaymentService_RefundPayment_Call{Call: _e.mock.On("RefundPayment", ctx, transactionUUID, amount)}
+}
+
+func (_c *MockPaymentService_RefundPayment_Call) Run(run func(ctx context.Context, transactionUUID uuid.UUID, amount *float64)) *MockPaymentService_RefundPayment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 *float64
+		if args[2] != nil {
+			arg2 = args[2].(*float64)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPaymentService_RefundPayment_Call) Return(transaction model.Transaction, err error) *MockPaymentService_RefundPayment_Call {
+	_c.Call.Return(transaction, err)
+	return _c
+}
+
+func (_c *MockPaymentService_RefundPayment_Call) RunAndReturn(run func(ctx context.Context, transactionUUID uuid.UUID, amount *float64) (model.Transaction, error)) *MockPaymentService_RefundPayment_Call {
 	_c.Call.Return(run)
 	return _c
 }
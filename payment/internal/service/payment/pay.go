@@ -2,21 +2,106 @@ package payment
 
 import (
 	"context"
-	"log"
+	"errors"
 
 	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/crafty-ezhik/rocket-factory/payment/internal/model"
+	"github.com/crafty-ezhik/rocket-factory/platform/pkg/logger"
 )
 
-// PayOrder - обрабатывает команду на оплату и возвращает transaction_uuid
-func (s *Service) PayOrder(_ context.Context, orderID, userID uuid.UUID, paymentMethod string) (string, error) {
-	log.Printf(`
-	💳 [Order Paid]
-	• 🆔 Order UUID: %s
-	• 👤 User UUID: %s
-	• 💰 Payment Method: %s
-	`, orderID.String(), userID.String(), paymentMethod,
+// PayOrder - обрабатывает команду на оплату и возвращает transaction_uuid.
+// Повторный вызов для того же заказа возвращает тот же transaction_uuid
+func (s *Service) PayOrder(ctx context.Context, orderID, userID uuid.UUID, paymentMethod string, amount float64) (string, error) {
+	if _, ok := s.providers[model.PaymentMethod(paymentMethod)]; !ok {
+		return "", model.ErrUnsupportedPaymentMethod
+	}
+
+	transaction, err := s.transactionRepo.Create(ctx, model.Transaction{
+		OrderUUID:     orderID,
+		UserUUID:      userID,
+		PaymentMethod: model.PaymentMethod(paymentMethod),
+		Status:        model.TransactionStatusPENDING,
+		Amount:        amount,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	if transaction.UserUUID != userID {
+		return "", model.ErrOrderPaidByAnotherUser
+	}
+
+	switch transaction.Status {
+	case model.TransactionStatusCAPTURED:
+		return transaction.UUID.String(), nil
+	case model.TransactionStatusREFUNDED:
+		return "", model.ErrTransactionRefunded
+	}
+
+	// Повторная попытка проводится тем способом оплаты, с которым транзакция была создана
+	paymentProvider, ok := s.providers[transaction.PaymentMethod]
+	if !ok {
+		return "", model.ErrUnsupportedPaymentMethod
+	}
+
+	if transaction.Status == model.TransactionStatusPENDING || transaction.Status == model.TransactionStatusFAILED {
+		if err = paymentProvider.Authorize(ctx, transaction); err != nil {
+			return "", s.fail(ctx, transaction, err)
+		}
+
+		if err = s.setStatus(ctx, &transaction, model.TransactionStatusAUTHORIZED); err != nil {
+			return "", err
+		}
+	}
+
+	if err = paymentProvider.Capture(ctx, transaction); err != nil {
+		return "", s.fail(ctx, transaction, err)
+	}
+
+	if err = s.setStatus(ctx, &transaction, model.TransactionStatusCAPTURED); err != nil {
+		return "", err
+	}
+
+	logger.Info(ctx, "Заказ оплачен",
+		zap.String("order_uuid", orderID.String()),
+		zap.String("transaction_uuid", transaction.UUID.String()),
+		zap.String("payment_method", paymentMethod),
+	)
+
+	return transaction.UUID.String(), nil
+}
+
+// setStatus - переводит транзакцию в новый статус
+func (s *Service) setStatus(ctx context.Context, transaction *model.Transaction, status model.TransactionStatus) error {
+	err := s.transactionRepo.UpdateStatus(ctx, transaction.UUID, transaction.Status, status, "")
+	if err != nil {
+		if errors.Is(err, model.ErrTransactionStatusChanged) {
+			return model.ErrPaymentInProgress
+		}
+		return err
+	}
+
+	transaction.Status = status
+	return nil
+}
+
+// fail - фиксирует отказ провайдера в ledger и возвращает исходную ошибку
+func (s *Service) fail(ctx context.Context, transaction model.Transaction, reason error) error {
+	err := s.transactionRepo.UpdateStatus(ctx, transaction.UUID, transaction.Status, model.TransactionStatusFAILED, reason.Error())
+	if err != nil {
+		if errors.Is(err, model.ErrTransactionStatusChanged) {
+			return model.ErrPaymentInProgress
+		}
+		return err
+	}
+
+	logger.Error(ctx, "Оплата заказа не прошла",
+		zap.String("order_uuid", transaction.OrderUUID.String()),
+		zap.String("transaction_uuid", transaction.UUID.String()),
+		zap.Error(reason),
 	)
-	transactionUUID := uuid.NewString()
 
-	return transactionUUID, nil
+	return reason
 }
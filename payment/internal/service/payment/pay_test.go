@@ -4,35 +4,190 @@ import (
 	"errors"
 
 	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/crafty-ezhik/rocket-factory/payment/internal/model"
 )
 
 func (s *ServiceSuite) TestPaymentSuccess() {
 	var (
-		orderUUID     = uuid.New()
-		userUUID      = uuid.New()
-		paymentMethod = "CARD"
+		orderUUID       = uuid.MustParse("00000000-0000-0000-0000-000000000001")
+		userUUID        = uuid.MustParse("00000000-0000-0000-0000-000000000002")
+		transactionUUID = uuid.MustParse("00000000-0000-0000-0000-000000000003")
+		paymentMethod   = "CARD"
+	)
+
+	s.repo.On("Create", s.ctx, mock.MatchedBy(func(tx model.Transaction) bool {
+		return tx.OrderUUID == orderUUID && tx.UserUUID == userUUID && tx.Status == model.TransactionStatusPENDING && tx.Amount == 1500
+	})).Return(model.Transaction{
+		UUID:          transactionUUID,
+		OrderUUID:     orderUUID,
+		UserUUID:      userUUID,
+		PaymentMethod: model.PaymentMethodCARD,
+		Status:        model.TransactionStatusPENDING,
+	}, nil).Once()
+	s.repo.On("UpdateStatus", s.ctx, transactionUUID, model.TransactionStatusPENDING, model.TransactionStatusAUTHORIZED, "").
+		Return(nil).Once()
+	s.repo.On("UpdateStatus", s.ctx, transactionUUID, model.TransactionStatusAUTHORIZED, model.TransactionStatusCAPTURED, "").
+		Return(nil).Once()
 
-		expectedUUID = uuid.New().String()
+	result, err := s.service.PayOrder(s.ctx, orderUUID, userUUID, paymentMethod, 1500)
+	s.Require().NoError(err)
+	s.Require().Equal(transactionUUID.String(), result)
+}
+
+func (s *ServiceSuite) TestPaymentIdempotent() {
+	var (
+		orderUUID       = uuid.MustParse("00000000-0000-0000-0000-000000000001")
+		userUUID        = uuid.MustParse("00000000-0000-0000-0000-000000000002")
+		transactionUUID = uuid.MustParse("00000000-0000-0000-0000-000000000003")
 	)
-	s.service.On("PayOrder", s.ctx, orderUUID, userUUID, paymentMethod).Return(expectedUUID, nil)
 
-	transactionUUID, err := s.service.PayOrder(s.ctx, orderUUID, userUUID, paymentMethod)
+	// Транзакция уже проведена — провайдер и смена статуса не вызываются
+	s.repo.On("Create", s.ctx, mock.Anything).Return(model.Transaction{
+		UUID:          transactionUUID,
+		OrderUUID:     orderUUID,
+		UserUUID:      userUUID,
+		PaymentMethod: model.PaymentMethodCARD,
+		Status:        model.TransactionStatusCAPTURED,
+	}, nil).Once()
+
+	result, err := s.service.PayOrder(s.ctx, orderUUID, userUUID, "CARD", 1500)
 	s.Require().NoError(err)
-	s.Require().Equal(expectedUUID, transactionUUID)
+	s.Require().Equal(transactionUUID.String(), result)
+}
+
+func (s *ServiceSuite) TestPaymentRetryAfterFailure() {
+	var (
+		orderUUID       = uuid.MustParse("00000000-0000-0000-0000-000000000001")
+		userUUID        = uuid.MustParse("00000000-0000-0000-0000-000000000002")
+		transactionUUID = uuid.MustParse("00000000-0000-0000-0000-000000000003")
+	)
+
+	// Повторная попытка идет тем же способом оплаты, с которым создана транзакция
+	s.repo.On("Create", s.ctx, mock.Anything).Return(model.Transaction{
+		UUID:          transactionUUID,
+		OrderUUID:     orderUUID,
+		UserUUID:      userUUID,
+		PaymentMethod: model.PaymentMethodSBP,
+		Status:        model.TransactionStatusFAILED,
+		FailureReason: "payment declined by provider",
+	}, nil).Once()
+	s.repo.On("UpdateStatus", s.ctx, transactionUUID, model.TransactionStatusFAILED, model.TransactionStatusAUTHORIZED, "").
+		Return(nil).Once()
+	s.repo.On("UpdateStatus", s.ctx, transactionUUID, model.TransactionStatusAUTHORIZED, model.TransactionStatusCAPTURED, "").
+		Return(nil).Once()
+
+	result, err := s.service.PayOrder(s.ctx, orderUUID, userUUID, "CARD", 1500)
+	s.Require().NoError(err)
+	s.Require().Equal(transactionUUID.String(), result)
 }
 
 func (s *ServiceSuite) TestPaymentFail() {
 	var (
-		orderUUID     = uuid.New()
-		userUUID      = uuid.New()
-		paymentMethod = "CARD"
+		orderUUID       = uuid.MustParse("00000000-0000-0000-0000-000000000001")
+		userUUID        = uuid.MustParse("00000000-0000-0000-0000-000000000002")
+		transactionUUID = uuid.MustParse("00000000-0000-0000-0000-000000000003")
 
-		expectedUUID = ""
-		ErrPaid      = errors.New("payment failed")
+		dbErr = errors.New("db error")
 	)
-	s.service.On("PayOrder", s.ctx, orderUUID, userUUID, paymentMethod).Return("", ErrPaid)
 
-	transactionUUID, err := s.service.PayOrder(s.ctx, orderUUID, userUUID, paymentMethod)
-	s.Require().ErrorIs(err, ErrPaid)
-	s.Require().Equal(expectedUUID, transactionUUID)
+	tests := []struct {
+		name          string
+		userUUID      uuid.UUID
+		paymentMethod string
+		expectedErr   error
+		setupMock     func()
+	}{
+		{
+			name:          "unsupported payment method",
+			userUUID:      userUUID,
+			paymentMethod: "CREDIT_CARD",
+			expectedErr:   model.ErrUnsupportedPaymentMethod,
+			setupMock:     func() {},
+		},
+		{
+			name:          "repository error",
+			userUUID:      userUUID,
+			paymentMethod: "CARD",
+			expectedErr:   dbErr,
+			setupMock: func() {
+				s.repo.On("Create", s.ctx, mock.Anything).Return(model.Transaction{}, dbErr).Once()
+			},
+		},
+		{
+			name:          "order paid by another user",
+			userUUID:      userUUID,
+			paymentMethod: "CARD",
+			expectedErr:   model.ErrOrderPaidByAnotherUser,
+			setupMock: func() {
+				s.repo.On("Create", s.ctx, mock.Anything).Return(model.Transaction{
+					UUID:          transactionUUID,
+					OrderUUID:     orderUUID,
+					UserUUID:      uuid.MustParse("00000000-0000-0000-0000-000000000009"),
+					PaymentMethod: model.PaymentMethodCARD,
+					Status:        model.TransactionStatusPENDING,
+				}, nil).Once()
+			},
+		},
+		{
+			name:          "transaction refunded",
+			userUUID:      userUUID,
+			paymentMethod: "CARD",
+			expectedErr:   model.ErrTransactionRefunded,
+			setupMock: func() {
+				s.repo.On("Create", s.ctx, mock.Anything).Return(model.Transaction{
+					UUID:          transactionUUID,
+					OrderUUID:     orderUUID,
+					UserUUID:      userUUID,
+					PaymentMethod: model.PaymentMethodCARD,
+					Status:        model.TransactionStatusREFUNDED,
+				}, nil).Once()
+			},
+		},
+		{
+			name:          "payment declined",
+			userUUID:      declinedUserUUID,
+			paymentMethod: "CARD",
+			expectedErr:   model.ErrPaymentDeclined,
+			setupMock: func() {
+				s.repo.On("Create", s.ctx, mock.Anything).Return(model.Transaction{
+					UUID:          transactionUUID,
+					OrderUUID:     orderUUID,
+					UserUUID:      declinedUserUUID,
+					PaymentMethod: model.PaymentMethodCARD,
+					Status:        model.TransactionStatusPENDING,
+				}, nil).Once()
+				s.repo.On("UpdateStatus", s.ctx, transactionUUID, model.TransactionStatusPENDING, model.TransactionStatusFAILED, model.ErrPaymentDeclined.Error()).
+					Return(nil).Once()
+			},
+		},
+		{
+			name:          "concurrent processing",
+			userUUID:      userUUID,
+			paymentMethod: "CARD",
+			expectedErr:   model.ErrPaymentInProgress,
+			setupMock: func() {
+				s.repo.On("Create", s.ctx, mock.Anything).Return(model.Transaction{
+					UUID:          transactionUUID,
+					OrderUUID:     orderUUID,
+					UserUUID:      userUUID,
+					PaymentMethod: model.PaymentMethodCARD,
+					Status:        model.TransactionStatusPENDING,
+				}, nil).Once()
+				s.repo.On("UpdateStatus", s.ctx, transactionUUID, model.TransactionStatusPENDING, model.TransactionStatusAUTHORIZED, "").
+					Return(model.ErrTransactionStatusChanged).Once()
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			tt.setupMock()
+
+			result, err := s.service.PayOrder(s.ctx, orderUUID, tt.userUUID, tt.paymentMethod, 1500)
+			s.Require().ErrorIs(err, tt.expectedErr)
+			s.Require().Empty(result)
+		})
+	}
 }
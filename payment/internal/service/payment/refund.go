@@ -0,0 +1,102 @@
+package payment
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/crafty-ezhik/rocket-factory/payment/internal/model"
+	"github.com/crafty-ezhik/rocket-factory/platform/pkg/logger"
+)
+
+// RefundPayment - возвращает средства по транзакции. Если amount не указан, возвращается весь остаток.
+// Повторный полный возврат уже возвращенной транзакции не считается ошибкой.
+//
+//	Сумма сначала резервируется в ledger, и только потом возврат выполняется у провайдера с UUID возврата
+//	в качестве ключа идемпотентности. Отказ провайдера снимает резерв. Если ledger не удалось обновить
+//	после ответа провайдера, возврат остается PENDING и его нужно сверить с провайдером
+func (s *Service) RefundPayment(ctx context.Context, transactionUUID uuid.UUID, amount *float64) (model.Transaction, error) {
+	transaction, err := s.transactionRepo.Get(ctx, transactionUUID)
+	if err != nil {
+		return model.Transaction{}, err
+	}
+
+	switch transaction.Status {
+	case model.TransactionStatusREFUNDED:
+		if amount == nil {
+			return transaction, nil
+		}
+		return model.Transaction{}, model.ErrTransactionRefunded
+	case model.TransactionStatusCAPTURED:
+	default:
+		return model.Transaction{}, model.ErrTransactionNotCaptured
+	}
+
+	remaining := transaction.Amount - transaction.RefundedAmount
+	refundAmount := remaining
+	if amount != nil {
+		refundAmount = *amount
+	}
+
+	if amount == nil && remaining <= 0 {
+		// Остаток полностью зарезервирован возвратом, который еще выполняется у провайдера
+		return model.Transaction{}, model.ErrPaymentInProgress
+	}
+	if refundAmount <= 0 {
+		return model.Transaction{}, model.ErrInvalidRefundAmount
+	}
+	if refundAmount > remaining {
+		return model.Transaction{}, model.ErrRefundAmountExceeded
+	}
+
+	paymentProvider, ok := s.providers[transaction.PaymentMethod]
+	if !ok {
+		return model.Transaction{}, model.ErrUnsupportedPaymentMethod
+	}
+
+	refund, err := s.transactionRepo.ReserveRefund(ctx, transaction.UUID, refundAmount)
+	if err != nil {
+		if errors.Is(err, model.ErrTransactionStatusChanged) {
+			return model.Transaction{}, model.ErrPaymentInProgress
+		}
+		return model.Transaction{}, err
+	}
+
+	if err = paymentProvider.Refund(ctx, transaction, refund); err != nil {
+		logger.Error(ctx, "Провайдер не выполнил возврат",
+			zap.String("transaction_uuid", transaction.UUID.String()),
+			zap.String("refund_uuid", refund.UUID.String()),
+			zap.Error(err),
+		)
+
+		// Резерв снимается и после отмены запроса, иначе сумма останется недоступной для возврата
+		if failErr := s.transactionRepo.FailRefund(context.WithoutCancel(ctx), refund); failErr != nil {
+			logger.Error(ctx, "Не удалось снять резерв возврата",
+				zap.String("refund_uuid", refund.UUID.String()),
+				zap.Error(failErr),
+			)
+		}
+		return model.Transaction{}, err
+	}
+
+	refunded, err := s.transactionRepo.CompleteRefund(context.WithoutCancel(ctx), refund)
+	if err != nil {
+		logger.Error(ctx, "Возврат выполнен провайдером, но не отмечен в ledger",
+			zap.String("refund_uuid", refund.UUID.String()),
+			zap.Error(err),
+		)
+		return model.Transaction{}, err
+	}
+
+	logger.Info(ctx, "Выполнен возврат по транзакции",
+		zap.String("order_uuid", refunded.OrderUUID.String()),
+		zap.String("transaction_uuid", refunded.UUID.String()),
+		zap.String("refund_uuid", refund.UUID.String()),
+		zap.Float64("amount", refundAmount),
+		zap.String("status", refunded.Status.String()),
+	)
+
+	return refunded, nil
+}
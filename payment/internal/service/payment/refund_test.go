@@ -0,0 +1,224 @@
+package payment_test
+
+import (
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/crafty-ezhik/rocket-factory/payment/internal/model"
+)
+
+func (s *ServiceSuite) TestRefundSuccess() {
+	var (
+		orderUUID       = uuid.MustParse("00000000-0000-0000-0000-000000000001")
+		userUUID        = uuid.MustParse("00000000-0000-0000-0000-000000000002")
+		transactionUUID = uuid.MustParse("00000000-0000-0000-0000-000000000003")
+		refundUUID      = uuid.MustParse("00000000-0000-0000-0000-000000000004")
+		partialAmount   = 500.0
+	)
+
+	captured := model.Transaction{
+		UUID:           transactionUUID,
+		OrderUUID:      orderUUID,
+		UserUUID:       userUUID,
+		PaymentMethod:  model.PaymentMethodCARD,
+		Status:         model.TransactionStatusCAPTURED,
+		Amount:         1500,
+		RefundedAmount: 200,
+	}
+
+	tests := []struct {
+		name           string
+		amount         *float64
+		expectedStatus model.TransactionStatus
+		setupMock      func()
+	}{
+		{
+			name:           "full refund of remaining amount",
+			amount:         nil,
+			expectedStatus: model.TransactionStatusREFUNDED,
+			setupMock: func() {
+				refunded := captured
+				refunded.Status = model.TransactionStatusREFUNDED
+				refunded.RefundedAmount = 1500
+
+				refund := model.Refund{UUID: refundUUID, TransactionUUID: transactionUUID, Amount: 1300, Status: model.RefundStatusPENDING}
+
+				// Сумма резервируется в ledger до вызова провайдера
+				s.repo.On("Get", s.ctx, transactionUUID).Return(captured, nil).Once()
+				s.repo.On("ReserveRefund", s.ctx, transactionUUID, 1300.0).Return(refund, nil).Once()
+				s.repo.On("CompleteRefund", mock.Anything, refund).Return(refunded, nil).Once()
+			},
+		},
+		{
+			name:           "partial refund",
+			amount:         &partialAmount,
+			expectedStatus: model.TransactionStatusCAPTURED,
+			setupMock: func() {
+				refunded := captured
+				refunded.RefundedAmount = 700
+
+				refund := model.Refund{UUID: refundUUID, TransactionUUID: transactionUUID, Amount: partialAmount, Status: model.RefundStatusPENDING}
+
+				s.repo.On("Get", s.ctx, transactionUUID).Return(captured, nil).Once()
+				s.repo.On("ReserveRefund", s.ctx, transactionUUID, partialAmount).Return(refund, nil).Once()
+				s.repo.On("CompleteRefund", mock.Anything, refund).Return(refunded, nil).Once()
+			},
+		},
+		{
+			name:           "repeated full refund",
+			amount:         nil,
+			expectedStatus: model.TransactionStatusREFUNDED,
+			setupMock: func() {
+				refunded := captured
+				refunded.Status = model.TransactionStatusREFUNDED
+				refunded.RefundedAmount = 1500
+
+				// Транзакция уже возвращена — провайдер и ledger не вызываются
+				s.repo.On("Get", s.ctx, transactionUUID).Return(refunded, nil).Once()
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			tt.setupMock()
+
+			result, err := s.service.RefundPayment(s.ctx, transactionUUID, tt.amount)
+			s.Require().NoError(err)
+			s.Require().Equal(tt.expectedStatus, result.Status)
+		})
+	}
+}
+
+func (s *ServiceSuite) TestRefundFail() {
+	var (
+		transactionUUID = uuid.MustParse("00000000-0000-0000-0000-000000000003")
+		refundUUID      = uuid.MustParse("00000000-0000-0000-0000-000000000004")
+		zeroAmount      = 0.0
+		bigAmount       = 2000.0
+		partialAmount   = 500.0
+
+		dbErr = errors.New("db error")
+	)
+
+	transaction := func(status model.TransactionStatus) model.Transaction {
+		return model.Transaction{
+			UUID:          transactionUUID,
+			PaymentMethod: model.PaymentMethodCARD,
+			Status:        status,
+			Amount:        1500,
+		}
+	}
+
+	tests := []struct {
+		name        string
+		amount      *float64
+		expectedErr error
+		setupMock   func()
+	}{
+		{
+			name:        "transaction not found",
+			expectedErr: model.ErrTransactionNotFound,
+			setupMock: func() {
+				s.repo.On("Get", s.ctx, transactionUUID).Return(model.Transaction{}, model.ErrTransactionNotFound).Once()
+			},
+		},
+		{
+			name:        "transaction not captured",
+			expectedErr: model.ErrTransactionNotCaptured,
+			setupMock: func() {
+				s.repo.On("Get", s.ctx, transactionUUID).Return(transaction(model.TransactionStatusAUTHORIZED), nil).Once()
+			},
+		},
+		{
+			name:        "partial refund of refunded transaction",
+			amount:      &partialAmount,
+			expectedErr: model.ErrTransactionRefunded,
+			setupMock: func() {
+				s.repo.On("Get", s.ctx, transactionUUID).Return(transaction(model.TransactionStatusREFUNDED), nil).Once()
+			},
+		},
+		{
+			name:        "non-positive amount",
+			amount:      &zeroAmount,
+			expectedErr: model.ErrInvalidRefundAmount,
+			setupMock: func() {
+				s.repo.On("Get", s.ctx, transactionUUID).Return(transaction(model.TransactionStatusCAPTURED), nil).Once()
+			},
+		},
+		{
+			name:        "amount exceeds remaining",
+			amount:      &bigAmount,
+			expectedErr: model.ErrRefundAmountExceeded,
+			setupMock: func() {
+				s.repo.On("Get", s.ctx, transactionUUID).Return(transaction(model.TransactionStatusCAPTURED), nil).Once()
+			},
+		},
+		{
+			name:        "concurrent refund",
+			amount:      &partialAmount,
+			expectedErr: model.ErrPaymentInProgress,
+			setupMock: func() {
+				s.repo.On("Get", s.ctx, transactionUUID).Return(transaction(model.TransactionStatusCAPTURED), nil).Once()
+				s.repo.On("ReserveRefund", s.ctx, transactionUUID, partialAmount).
+					Return(model.Refund{}, model.ErrTransactionStatusChanged).Once()
+			},
+		},
+		{
+			name:        "repository error",
+			amount:      &partialAmount,
+			expectedErr: dbErr,
+			setupMock: func() {
+				s.repo.On("Get", s.ctx, transactionUUID).Return(transaction(model.TransactionStatusCAPTURED), nil).Once()
+				s.repo.On("ReserveRefund", s.ctx, transactionUUID, partialAmount).Return(model.Refund{}, dbErr).Once()
+			},
+		},
+		{
+			name:        "full refund already reserved",
+			expectedErr: model.ErrPaymentInProgress,
+			setupMock: func() {
+				reserved := transaction(model.TransactionStatusCAPTURED)
+				reserved.RefundedAmount = reserved.Amount
+
+				s.repo.On("Get", s.ctx, transactionUUID).Return(reserved, nil).Once()
+			},
+		},
+		{
+			name:        "provider declined releases reservation",
+			amount:      &partialAmount,
+			expectedErr: model.ErrRefundDeclined,
+			setupMock: func() {
+				declined := transaction(model.TransactionStatusCAPTURED)
+				declined.UserUUID = declinedUserUUID
+				refund := model.Refund{UUID: refundUUID, TransactionUUID: transactionUUID, Amount: partialAmount, Status: model.RefundStatusPENDING}
+
+				s.repo.On("Get", s.ctx, transactionUUID).Return(declined, nil).Once()
+				s.repo.On("ReserveRefund", s.ctx, transactionUUID, partialAmount).Return(refund, nil).Once()
+				s.repo.On("FailRefund", mock.Anything, refund).Return(nil).Once()
+			},
+		},
+		{
+			name:        "ledger not updated after provider refund",
+			amount:      &partialAmount,
+			expectedErr: dbErr,
+			setupMock: func() {
+				refund := model.Refund{UUID: refundUUID, TransactionUUID: transactionUUID, Amount: partialAmount, Status: model.RefundStatusPENDING}
+
+				s.repo.On("Get", s.ctx, transactionUUID).Return(transaction(model.TransactionStatusCAPTURED), nil).Once()
+				s.repo.On("ReserveRefund", s.ctx, transactionUUID, partialAmount).Return(refund, nil).Once()
+				s.repo.On("CompleteRefund", mock.Anything, refund).Return(model.Transaction{}, dbErr).Once()
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			tt.setupMock()
+
+			_, err := s.service.RefundPayment(s.ctx, transactionUUID, tt.amount)
+			s.Require().ErrorIs(err, tt.expectedErr)
+		})
+	}
+}
@@ -1,7 +1,22 @@
 package payment
 
-type Service struct{}
+import (
+	"github.com/crafty-ezhik/rocket-factory/payment/internal/model"
+	"github.com/crafty-ezhik/rocket-factory/payment/internal/provider"
+	"github.com/crafty-ezhik/rocket-factory/payment/internal/repository"
+	def "github.com/crafty-ezhik/rocket-factory/payment/internal/service"
+)
 
-func NewService() *Service {
-	return &Service{}
+var _ def.PaymentService = (*Service)(nil)
+
+type Service struct {
+	transactionRepo repository.TransactionRepository
+	providers       map[model.PaymentMethod]provider.PaymentProvider
+}
+
+func NewService(transactionRepo repository.TransactionRepository, providers map[model.PaymentMethod]provider.PaymentProvider) *Service {
+	return &Service{
+		transactionRepo: transactionRepo,
+		providers:       providers,
+	}
 }
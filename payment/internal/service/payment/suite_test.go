@@ -4,23 +4,38 @@ import (
 	"context"
 	"testing"
 
+	"github.com/google/uuid"
 	"github.com/stretchr/testify/suite"
 
-	"github.com/crafty-ezhik/rocket-factory/payment/internal/service/mocks"
+	"github.com/crafty-ezhik/rocket-factory/payment/internal/model"
+	"github.com/crafty-ezhik/rocket-factory/payment/internal/provider"
+	"github.com/crafty-ezhik/rocket-factory/payment/internal/provider/fake"
+	repoMock "github.com/crafty-ezhik/rocket-factory/payment/internal/repository/mocks"
+	"github.com/crafty-ezhik/rocket-factory/payment/internal/service/payment"
+	"github.com/crafty-ezhik/rocket-factory/platform/pkg/logger"
 )
 
+var declinedUserUUID = uuid.MustParse("00000000-0000-0000-0000-0000000000ff")
+
 type ServiceSuite struct {
 	suite.Suite
 
 	ctx context.Context //nolint:containedctx
 
-	service *mocks.MockPaymentService
+	repo    *repoMock.MockTransactionRepository
+	service *payment.Service
 }
 
-func (s *ServiceSuite) SetupSuite() {
+func (s *ServiceSuite) SetupTest() {
 	s.ctx = context.Background()
 
-	s.service = mocks.NewMockPaymentService(s.T())
+	logger.SetNopLogger()
+
+	s.repo = repoMock.NewMockTransactionRepository(s.T())
+	s.service = payment.NewService(s.repo, map[model.PaymentMethod]provider.PaymentProvider{
+		model.PaymentMethodCARD: fake.NewProvider(declinedUserUUID),
+		model.PaymentMethodSBP:  fake.NewProvider(declinedUserUUID),
+	})
 }
 
 func (s *ServiceSuite) TearDownSuite() {}
@@ -4,8 +4,11 @@ import (
 	"context"
 
 	"github.com/google/uuid"
+
+	"github.com/crafty-ezhik/rocket-factory/payment/internal/model"
 )
 
 type PaymentService interface {
-	PayOrder(ctx context.Context, orderID, userID uuid.UUID, paymentMethod string) (string, error)
+	PayOrder(ctx context.Context, orderID, userID uuid.UUID, paymentMethod string, amount float64) (string, error)
+	RefundPayment(ctx context.Context, transactionUUID uuid.UUID, amount *float64) (model.Transaction, error)
 }
@@ -0,0 +1,24 @@
+-- +goose Up
+
+-- создаем таблицу транзакций оплаты (ledger)
+CREATE TABLE transactions (
+    transaction_uuid UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    order_uuid UUID NOT NULL UNIQUE,
+    user_uuid UUID NOT NULL,
+    payment_method VARCHAR(20) NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'PENDING',
+    failure_reason TEXT,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now(),
+    updated_at TIMESTAMP WITH TIME ZONE
+);
+
+-- создаем индекс для поиска транзакций пользователя
+CREATE INDEX IF NOT EXISTS idx_transactions_user_uuid ON transactions (user_uuid);
+
+-- +goose Down
+
+-- удаляем индекс по пользователю
+DROP INDEX IF EXISTS idx_transactions_user_uuid;
+
+-- удаляем таблицу транзакций
+DROP TABLE IF EXISTS transactions;
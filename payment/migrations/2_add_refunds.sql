@@ -0,0 +1,30 @@
+-- +goose Up
+
+-- добавляем сумму оплаты и сумму возвращенных средств
+ALTER TABLE transactions
+    ADD COLUMN amount NUMERIC(12, 2) NOT NULL DEFAULT 0,
+    ADD COLUMN refunded_amount NUMERIC(12, 2) NOT NULL DEFAULT 0;
+
+-- создаем таблицу возвратов по транзакциям
+CREATE TABLE refunds (
+    refund_uuid UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    transaction_uuid UUID NOT NULL REFERENCES transactions (transaction_uuid),
+    amount NUMERIC(12, 2) NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now()
+);
+
+-- создаем индекс для поиска возвратов транзакции
+CREATE INDEX IF NOT EXISTS idx_refunds_transaction_uuid ON refunds (transaction_uuid);
+
+-- +goose Down
+
+-- удаляем индекс по транзакции
+DROP INDEX IF EXISTS idx_refunds_transaction_uuid;
+
+-- удаляем таблицу возвратов
+DROP TABLE IF EXISTS refunds;
+
+-- удаляем суммы из транзакций
+ALTER TABLE transactions
+    DROP COLUMN IF EXISTS refunded_amount,
+    DROP COLUMN IF EXISTS amount;
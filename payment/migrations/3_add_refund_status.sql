@@ -0,0 +1,21 @@
+-- +goose Up
+
+-- добавляем статус возврата: сумма резервируется в ledger до вызова провайдера (PENDING),
+-- после ответа провайдера возврат становится COMPLETED или FAILED со снятием резерва
+ALTER TABLE refunds
+    ADD COLUMN status VARCHAR(20) NOT NULL DEFAULT 'COMPLETED';
+
+ALTER TABLE refunds
+    ALTER COLUMN status SET DEFAULT 'PENDING';
+
+-- создаем индекс для поиска незавершенных возвратов
+CREATE INDEX IF NOT EXISTS idx_refunds_pending ON refunds (created_at) WHERE status = 'PENDING';
+
+-- +goose Down
+
+-- удаляем индекс незавершенных возвратов
+DROP INDEX IF EXISTS idx_refunds_pending;
+
+-- удаляем статус возврата
+ALTER TABLE refunds
+    DROP COLUMN IF EXISTS status;
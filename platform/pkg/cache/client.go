@@ -11,6 +11,7 @@ type RedisClient interface {
 	Get(ctx context.Context, key string) ([]byte, error)
 	HashSet(ctx context.Context, key string, values any) error
 	HGetAll(ctx context.Context, key string) ([]any, error)
+	Incr(ctx context.Context, key string) (int64, error)
 	Del(ctx context.Context, key string) error
 	Exists(ctx context.Context, key string) (bool, error)
 	Expire(ctx context.Context, key string, expiration time.Duration) error
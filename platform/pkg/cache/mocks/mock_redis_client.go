@@ -0,0 +1,942 @@
+// Code generated for crafty-ezhik service
+// © Crafty-ezhik 2025.
+
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"context"
+	"time"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockRedisClient creates a new instance of MockRedisClient. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockRedisClient(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockRedisClient {
+	mock := &MockRedisClient{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockRedisClient is an autogenerated mock type for the RedisClient type
+type MockRedisClient struct {
+	mock.Mock
+}
+
+type MockRedisClient_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockRedisClient) EXPECT() *MockRedisClient_Expecter {
+	return &MockRedisClient_Expecter{mock: &_m.Mock}
+}
+
+// Del provides a mock function for the type MockRedisClient
+func (_mock *MockRedisClient) Del(ctx context.Context, key string) error {
+	ret := _mock.Called(ctx, key)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Del")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = returnFunc(ctx, key)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockRedisClient_Del_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Del'
+type MockRedisClient_Del_Call struct {
+	*mock.Call
+}
+
+// Del is a helper method to define mock.On call
+//   - ctx context.Context
+//   - key string
+func (_e *MockRedisClient_Expecter) Del(ctx interface{}, key interface{}) *MockRedisClient_Del_Call {
+	return &MockRedisClient_Del_Call{Call: _e.mock.On("Del", ctx, key)}
+}
+
+func (_c *MockRedisClient_Del_Call) Run(run func(ctx context.Context, key string)) *MockRedisClient_Del_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRedisClient_Del_Call) Return(err error) *MockRedisClient_Del_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockRedisClient_Del_Call) RunAndReturn(run func(ctx context.Context, key string) error) *MockRedisClient_Del_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Exists provides a mock function for the type MockRedisClient
+func (_mock *MockRedisClient) Exists(ctx context.Context, key string) (bool, error) {
+	ret := _mock.Called(ctx, key)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exists")
+	}
+
+	var r0 bool
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (bool, error)); ok {
+		return returnFunc(ctx, key)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) bool); ok {
+		r0 = returnFunc(ctx, key)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, key)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRedisClient_Exists_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exists'
+type MockRedisClient_Exists_Call struct {
+	*mock.Call
+}
+
+// Exists is a helper method to define mock.On call
+//   - ctx context.Context
+//   - key string
+func (_e *MockRedisClient_Expecter) Exists(ctx interface{}, key interface{}) *MockRedisClient_Exists_Call {
+	return &MockRedisClient_Exists_Call{Call: _e.mock.On("Exists", ctx, key)}
+}
+
+func (_c *MockRedisClient_Exists_Call) Run(run func(ctx context.Context, key string)) *MockRedisClient_Exists_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRedisClient_Exists_Call) Return(b bool, err error) *MockRedisClient_Exists_Call {
+	_c.Call.Return(b, err)
+	return _c
+}
+
+func (_c *MockRedisClient_Exists_Call) RunAndReturn(run func(ctx context.Context, key string) (bool, error)) *MockRedisClient_Exists_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Expire provides a mock function for the type MockRedisClient
+func (_mock *MockRedisClient) Expire(ctx context.Context, key string, expiration time.Duration) error {
+	ret := _mock.Called(ctx, key, expiration)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Expire")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, time.Duration) error); ok {
+		r0 = returnFunc(ctx, key, expiration)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockRedisClient_Expire_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Expire'
+type MockRedisClient_Expire_Call struct {
+	*mock.Call
+}
+
+// Expire is a helper method to define mock.On call
+//   - ctx context.Context
+//   - key string
+//   - expiration time.Duration
+func (_e *MockRedisClient_Expecter) Expire(ctx interface{}, key interface{}, expiration interface{}) *MockRedisClient_Expire_Call {
+	return &MockRedisClient_Expire_Call{Call: _e.mock.On("Expire", ctx, key, expiration)}
+}
+
+func (_c *MockRedisClient_Expire_Call) Run(run func(ctx context.Context, key string, expiration time.Duration)) *MockRedisClient_Expire_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 time.Duration
+		if args[2] != nil {
+			arg2 = args[2].(time.Duration)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRedisClient_Expire_Call) Return(err error) *MockRedisClient_Expire_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockRedisClient_Expire_Call) RunAndReturn(run func(ctx context.Context, key string, expiration time.Duration) error) *MockRedisClient_Expire_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Get provides a mock function for the type MockRedisClient
+func (_mock *MockRedisClient) Get(ctx context.Context, key string) ([]byte, error) {
+	ret := _mock.Called(ctx, key)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 []byte
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) ([]byte, error)); ok {
+		return returnFunc(ctx, key)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) []byte); ok {
+		r0 = returnFunc(ctx, key)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]byte)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, key)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRedisClient_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
+type MockRedisClient_Get_Call struct {
+	*mock.Call
+}
+
+// Get is a helper method to define mock.On call
+//   - ctx context.Context
+//   - key string
+func (_e *MockRedisClient_Expecter) Get(ctx interface{}, key interface{}) *MockRedisClient_Get_Call {
+	return &MockRedisClient_Get_Call{Call: _e.mock.On("Get", ctx, key)}
+}
+
+func (_c *MockRedisClient_Get_Call) Run(run func(ctx context.Context, key string)) *MockRedisClient_Get_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRedisClient_Get_Call) Return(bytes []byte, err error) *MockRedisClient_Get_Call {
+	_c.Call.Return(bytes, err)
+	return _c
+}
+
+func (_c *MockRedisClient_Get_Call) RunAndReturn(run func(ctx context.Context, key string) ([]byte, error)) *MockRedisClient_Get_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// HGetAll provides a mock function for the type MockRedisClient
+func (_mock *MockRedisClient) HGetAll(ctx context.Context, key string) ([]any, error) {
+	ret := _mock.Called(ctx, key)
+
+	if len(ret) == 0 {
+		panic("no return value specified for HGetAll")
+	}
+
+	var r0 []any
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) ([]any, error)); ok {
+		return returnFunc(ctx, key)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) []any); ok {
+		r0 = returnFunc(ctx, key)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]any)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, key)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRedisClient_HGetAll_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'HGetAll'
+type MockRedisClient_HGetAll_Call struct {
+	*mock.Call
+}
+
+// HGetAll is a helper method to define mock.On call
+//   - ctx context.Context
+//   - key string
+func (_e *MockRedisClient_Expecter) HGetAll(ctx interface{}, key interface{}) *MockRedisClient_HGetAll_Call {
+	return &MockRedisClient_HGetAll_Call{Call: _e.mock.On("HGetAll", ctx, key)}
+}
+
+func (_c *MockRedisClient_HGetAll_Call) Run(run func(ctx context.Context, key string)) *MockRedisClient_HGetAll_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRedisClient_HGetAll_Call) Return(vs []any, err error) *MockRedisClient_HGetAll_Call {
+	_c.Call.Return(vs, err)
+	return _c
+}
+
+func (_c *MockRedisClient_HGetAll_Call) RunAndReturn(run func(ctx context.Context, key string) ([]any, error)) *MockRedisClient_HGetAll_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// HashSet provides a mock function for the type MockRedisClient
+func (_mock *MockRedisClient) HashSet(ctx context.Context, key string, values any) error {
+	ret := _mock.Called(ctx, key, values)
+
+	if len(ret) == 0 {
+		panic("no return value specified for HashSet")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, any) error); ok {
+		r0 = returnFunc(ctx, key, values)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockRedisClient_HashSet_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'HashSet'
+type MockRedisClient_HashSet_Call struct {
+	*mock.Call
+}
+
+// HashSet is a helper method to define mock.On call
+//   - ctx context.Context
+//   - key string
+//   - values any
+func (_e *MockRedisClient_Expecter) HashSet(ctx interface{}, key interface{}, values interface{}) *MockRedisClient_HashSet_Call {
+	return &MockRedisClient_HashSet_Call{Call: _e.mock.On("HashSet", ctx, key, values)}
+}
+
+func (_c *MockRedisClient_HashSet_Call) Run(run func(ctx context.Context, key string, values any)) *MockRedisClient_HashSet_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 any
+		if args[2] != nil {
+			arg2 = args[2].(any)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRedisClient_HashSet_Call) Return(err error) *MockRedisClient_HashSet_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockRedisClient_HashSet_Call) RunAndReturn(run func(ctx context.Context, key string, values any) error) *MockRedisClient_HashSet_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Incr provides a mock function for the type MockRedisClient
+func (_mock *MockRedisClient) Incr(ctx context.Context, key string) (int64, error) {
+	ret := _mock.Called(ctx, key)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Incr")
+	}
+
+	var r0 int64
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (int64, error)); ok {
+		return returnFunc(ctx, key)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) int64); ok {
+		r0 = returnFunc(ctx, key)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, key)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRedisClient_Incr_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Incr'
+type MockRedisClient_Incr_Call struct {
+	*mock.Call
+}
+
+// Incr is a helper method to define mock.On call
+//   - ctx context.Context
+//   - key string
+func (_e *MockRedisClient_Expecter) Incr(ctx interface{}, key interface{}) *MockRedisClient_Incr_Call {
+	return &MockRedisClient_Incr_Call{Call: _e.mock.On("Incr", ctx, key)}
+}
+
+func (_c *MockRedisClient_Incr_Call) Run(run func(ctx context.Context, key string)) *MockRedisClient_Incr_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRedisClient_Incr_Call) Return(n int64, err error) *MockRedisClient_Incr_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *MockRedisClient_Incr_Call) RunAndReturn(run func(ctx context.Context, key string) (int64, error)) *MockRedisClient_Incr_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Ping provides a mock function for the type MockRedisClient
+func (_mock *MockRedisClient) Ping(ctx context.Context) error {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Ping")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockRedisClient_Ping_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Ping'
+type MockRedisClient_Ping_Call struct {
+	*mock.Call
+}
+
+// Ping is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockRedisClient_Expecter) Ping(ctx interface{}) *MockRedisClient_Ping_Call {
+	return &MockRedisClient_Ping_Call{Call: _e.mock.On("Ping", ctx)}
+}
+
+func (_c *MockRedisClient_Ping_Call) Run(run func(ctx context.Context)) *MockRedisClient_Ping_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRedisClient_Ping_Call) Return(err error) *MockRedisClient_Ping_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockRedisClient_Ping_Call) RunAndReturn(run func(ctx context.Context) error) *MockRedisClient_Ping_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SAdd provides a mock function for the type MockRedisClient
+func (_mock *MockRedisClient) SAdd(ctx context.Context, key string, value string) error {
+	ret := _mock.Called(ctx, key, value)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SAdd")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = returnFunc(ctx, key, value)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockRedisClient_SAdd_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SAdd'
+type MockRedisClient_SAdd_Call struct {
+	*mock.Call
+}
+
+// SAdd is a helper method to define mock.On call
+//   - ctx context.Context
+//   - key string
+//   - value string
+func (_e *MockRedisClient_Expecter) SAdd(ctx interface{}, key interface{}, value interface{}) *MockRedisClient_SAdd_Call {
+	return &MockRedisClient_SAdd_Call{Call: _e.mock.On("SAdd", ctx, key, value)}
+}
+
+func (_c *MockRedisClient_SAdd_Call) Run(run func(ctx context.Context, key string, value string)) *MockRedisClient_SAdd_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRedisClient_SAdd_Call) Return(err error) *MockRedisClient_SAdd_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockRedisClient_SAdd_Call) RunAndReturn(run func(ctx context.Context, key string, value string) error) *MockRedisClient_SAdd_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SIsMember provides a mock function for the type MockRedisClient
+func (_mock *MockRedisClient) SIsMember(ctx context.Context, key string, value string) (bool, error) {
+	ret := _mock.Called(ctx, key, value)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SIsMember")
+	}
+
+	var r0 bool
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) (bool, error)); ok {
+		return returnFunc(ctx, key, value)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) bool); ok {
+		r0 = returnFunc(ctx, key, value)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = returnFunc(ctx, key, value)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRedisClient_SIsMember_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SIsMember'
+type MockRedisClient_SIsMember_Call struct {
+	*mock.Call
+}
+
+// SIsMember is a helper method to define mock.On call
+//   - ctx context.Context
+//   - key string
+//   - value string
+func (_e *MockRedisClient_Expecter) SIsMember(ctx interface{}, key interface{}, value interface{}) *MockRedisClient_SIsMember_Call {
+	return &MockRedisClient_SIsMember_Call{Call: _e.mock.On("SIsMember", ctx, key, value)}
+}
+
+func (_c *MockRedisClient_SIsMember_Call) Run(run func(ctx context.Context, key string, value string)) *MockRedisClient_SIsMember_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRedisClient_SIsMember_Call) Return(b bool, err error) *MockRedisClient_SIsMember_Call {
+	_c.Call.Return(b, err)
+	return _c
+}
+
+func (_c *MockRedisClient_SIsMember_Call) RunAndReturn(run func(ctx context.Context, key string, value string) (bool, error)) *MockRedisClient_SIsMember_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SMembers provides a mock function for the type MockRedisClient
+func (_mock *MockRedisClient) SMembers(ctx context.Context, key string) ([]string, error) {
+	ret := _mock.Called(ctx, key)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SMembers")
+	}
+
+	var r0 []string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) ([]string, error)); ok {
+		return returnFunc(ctx, key)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) []string); ok {
+		r0 = returnFunc(ctx, key)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, key)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRedisClient_SMembers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SMembers'
+type MockRedisClient_SMembers_Call struct {
+	*mock.Call
+}
+
+// SMembers is a helper method to define mock.On call
+//   - ctx context.Context
+//   - key string
+func (_e *MockRedisClient_Expecter) SMembers(ctx interface{}, key interface{}) *MockRedisClient_SMembers_Call {
+	return &MockRedisClient_SMembers_Call{Call: _e.mock.On("SMembers", ctx, key)}
+}
+
+func (_c *MockRedisClient_SMembers_Call) Run(run func(ctx context.Context, key string)) *MockRedisClient_SMembers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRedisClient_SMembers_Call) Return(strings []string, err error) *MockRedisClient_SMembers_Call {
+	_c.Call.Return(strings, err)
+	return _c
+}
+
+func (_c *MockRedisClient_SMembers_Call) RunAndReturn(run func(ctx context.Context, key string) ([]string, error)) *MockRedisClient_SMembers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SRem provides a mock function for the type MockRedisClient
+func (_mock *MockRedisClient) SRem(ctx context.Context, key string, value string) error {
+	ret := _mock.Called(ctx, key, value)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SRem")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = returnFunc(ctx, key, value)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockRedisClient_SRem_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SRem'
+type MockRedisClient_SRem_Call struct {
+	*mock.Call
+}
+
+// SRem is a helper method to define mock.On call
+//   - ctx context.Context
+//   - key string
+//   - value string
+func (_e *MockRedisClient_Expecter) SRem(ctx interface{}, key interface{}, value interface{}) *MockRedisClient_SRem_Call {
+	return &MockRedisClient_SRem_Call{Call: _e.mock.On("SRem", ctx, key, value)}
+}
+
+func (_c *MockRedisClient_SRem_Call) Run(run func(ctx context.Context, key string, value string)) *MockRedisClient_SRem_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRedisClient_SRem_Call) Return(err error) *MockRedisClient_SRem_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockRedisClient_SRem_Call) RunAndReturn(run func(ctx context.Context, key string, value string) error) *MockRedisClient_SRem_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Set provides a mock function for the type MockRedisClient
+func (_mock *MockRedisClient) Set(ctx context.Context, key string, value any) error {
+	ret := _mock.Called(ctx, key, value)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Set")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, any) error); ok {
+		r0 = returnFunc(ctx, key, value)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockRedisClient_Set_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Set'
+type MockRedisClient_Set_Call struct {
+	*mock.Call
+}
+
+// Set is a helper method to define mock.On call
+//   - ctx context.Context
+//   - key string
+//   - value any
+func (_e *MockRedisClient_Expecter) Set(ctx interface{}, key interface{}, value interface{}) *MockRedisClient_Set_Call {
+	return &MockRedisClient_Set_Call{Call: _e.mock.On("Set", ctx, key, value)}
+}
+
+func (_c *MockRedisClient_Set_Call) Run(run func(ctx context.Context, key string, value any)) *MockRedisClient_Set_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 any
+		if args[2] != nil {
+			arg2 = args[2].(any)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRedisClient_Set_Call) Return(err error) *MockRedisClient_Set_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockRedisClient_Set_Call) RunAndReturn(run func(ctx context.Context, key string, value any) error) *MockRedisClient_Set_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetWithTTL provides a mock function for the type MockRedisClient
+func (_mock *MockRedisClient) SetWithTTL(ctx context.Context, key string, value any, ttl time.Duration) error {
+	ret := _mock.Called(ctx, key, value, ttl)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetWithTTL")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, any, time.Duration) error); ok {
+		r0 = returnFunc(ctx, key, value, ttl)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockRedisClient_SetWithTTL_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetWithTTL'
+type MockRedisClient_SetWithTTL_Call struct {
+	*mock.Call
+}
+
+// SetWithTTL is a helper method to define mock.On call
+//   - ctx context.Context
+//   - key string
+//   - value any
+//   - ttl time.Duration
+func (_e *MockRedisClient_Expecter) SetWithTTL(ctx interface{}, key interface{}, value interface{}, ttl interface{}) *MockRedisClient_SetWithTTL_Call {
+	return &MockRedisClient_SetWithTTL_Call{Call: _e.mock.On("SetWithTTL", ctx, key, value, ttl)}
+}
+
+func (_c *MockRedisClient_SetWithTTL_Call) Run(run func(ctx context.Context, key string, value any, ttl time.Duration)) *MockRedisClient_SetWithTTL_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 any
+		if args[2] != nil {
+			arg2 = args[2].(any)
+		}
+		var arg3 time.Duration
+		if args[3] != nil {
+			arg3 = args[3].(time.Duration)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRedisClient_SetWithTTL_Call) Return(err error) *MockRedisClient_SetWithTTL_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockRedisClient_SetWithTTL_Call) RunAndReturn(run func(ctx context.Context, key string, value any, ttl time.Duration) error) *MockRedisClient_SetWithTTL_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -104,6 +104,21 @@ func (c *client) HGetAll(ctx context.Context, key string) ([]any, error) {
 	return values, err
 }
 
+// Incr - атомарно увеличивает счетчик на 1 и возвращает новое значение. Отсутствующий ключ считается нулем
+func (c *client) Incr(ctx context.Context, key string) (int64, error) {
+	var result int64
+	err := c.withConn(ctx, func(ctx context.Context, conn redigo.Conn) error {
+		val, err := redigo.Int64(conn.Do("INCR", key))
+		if err != nil {
+			return err
+		}
+		result = val
+		return nil
+	})
+
+	return result, err
+}
+
 func (c *client) Del(ctx context.Context, key string) error {
 	return c.withConn(ctx, func(ctx context.Context, conn redigo.Conn) error {
 		_, err := conn.Do("DEL", key)
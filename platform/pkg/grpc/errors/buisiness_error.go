@@ -20,6 +20,7 @@ const (
 	InternalServiceErrCode
 	ServiceUnavailableErrCode
 	CanceledErrCode
+	FailedPreconditionErrCode
 )
 
 // businessError - структура ошибки
@@ -79,6 +80,8 @@ func errCodeToGRPCCode(code ErrorCode) codes.Code {
 		return codes.Unavailable
 	case CanceledErrCode:
 		return codes.Canceled
+	case FailedPreconditionErrCode:
+		return codes.FailedPrecondition
 	default:
 		return codes.Unknown
 	}
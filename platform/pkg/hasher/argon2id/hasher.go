@@ -0,0 +1,135 @@
+package argon2id
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"fmt"
+	"strings"
+
+	"golang.org/x/crypto/argon2"
+
+	"github.com/crafty-ezhik/rocket-factory/platform/pkg/hasher"
+)
+
+var _ hasher.Algorithm = (*Argon2idPasswordHasher)(nil)
+
+// prefix - идентификатор алгоритма в PHC-формате хеша:
+// $argon2id$v=19$m=<память KiB>,t=<итерации>,p=<потоки>$<соль>$<хеш>
+const prefix = "$argon2id$"
+
+// Params - параметры argon2id. Записываются в строку хеша, поэтому их можно менять без потери старых хешей
+type Params struct {
+	// Memory - объем памяти в KiB
+	Memory      uint32
+	Iterations  uint32
+	Parallelism uint8
+	SaltLength  uint32
+	KeyLength   uint32
+}
+
+// DefaultParams - параметры из рекомендаций OWASP для argon2id
+func DefaultParams() Params {
+	return Params{
+		Memory:      64 * 1024,
+		Iterations:  3,
+		Parallelism: 2,
+		SaltLength:  16,
+		KeyLength:   32,
+	}
+}
+
+type Argon2idPasswordHasher struct {
+	params Params
+}
+
+func NewArgon2idPasswordHasher(params Params) *Argon2idPasswordHasher {
+	return &Argon2idPasswordHasher{params: params}
+}
+
+func (h *Argon2idPasswordHasher) Hash(plainText string) (string, error) {
+	salt := make([]byte, h.params.SaltLength)
+	if _, err := rand.Read(salt); err != nil {
+		return "", fmt.Errorf("generating salt: %w", err)
+	}
+
+	key := argon2.IDKey([]byte(plainText), salt, h.params.Iterations, h.params.Memory, h.params.Parallelism, h.params.KeyLength)
+
+	return fmt.Sprintf("%sv=%d$m=%d,t=%d,p=%d$%s$%s",
+		prefix,
+		argon2.Version,
+		h.params.Memory,
+		h.params.Iterations,
+		h.params.Parallelism,
+		base64.RawStdEncoding.EncodeToString(salt),
+		base64.RawStdEncoding.EncodeToString(key),
+	), nil
+}
+
+func (h *Argon2idPasswordHasher) Verify(hashedPassword, plainText string) error {
+	if hashedPassword == "" {
+		return hasher.ErrEmptyHash
+	}
+
+	params, salt, key, err := decode(hashedPassword)
+	if err != nil {
+		return err
+	}
+
+	// Пересчитываем хеш с параметрами из строки хеша, а не текущими
+	actual := argon2.IDKey([]byte(plainText), salt, params.Iterations, params.Memory, params.Parallelism, params.KeyLength)
+	if subtle.ConstantTimeCompare(actual, key) != 1 {
+		return hasher.ErrInvalidPassword
+	}
+	return nil
+}
+
+// NeedsRehash - хеш получен с другими параметрами
+func (h *Argon2idPasswordHasher) NeedsRehash(hashedPassword string) bool {
+	params, salt, _, err := decode(hashedPassword)
+	if err != nil {
+		return true
+	}
+
+	return params.Memory != h.params.Memory ||
+		params.Iterations != h.params.Iterations ||
+		params.Parallelism != h.params.Parallelism ||
+		params.KeyLength != h.params.KeyLength ||
+		uint32(len(salt)) != h.params.SaltLength
+}
+
+func (h *Argon2idPasswordHasher) Matches(hashedPassword string) bool {
+	return strings.HasPrefix(hashedPassword, prefix)
+}
+
+// decode - разбирает строку хеша на параметры, соль и ключ
+func decode(hashedPassword string) (Params, []byte, []byte, error) {
+	parts := strings.Split(hashedPassword, "$")
+	if len(parts) != 6 || parts[1] != "argon2id" {
+		return Params{}, nil, nil, hasher.ErrMalformedHash
+	}
+
+	var version int
+	if _, err := fmt.Sscanf(parts[2], "v=%d", &version); err != nil || version != argon2.Version {
+		return Params{}, nil, nil, hasher.ErrMalformedHash
+	}
+
+	var params Params
+	if _, err := fmt.Sscanf(parts[3], "m=%d,t=%d,p=%d", &params.Memory, &params.Iterations, &params.Parallelism); err != nil {
+		return Params{}, nil, nil, hasher.ErrMalformedHash
+	}
+
+	salt, err := base64.RawStdEncoding.DecodeString(parts[4])
+	if err != nil {
+		return Params{}, nil, nil, hasher.ErrMalformedHash
+	}
+
+	key, err := base64.RawStdEncoding.DecodeString(parts[5])
+	if err != nil || len(key) == 0 {
+		return Params{}, nil, nil, hasher.ErrMalformedHash
+	}
+
+	params.SaltLength = uint32(len(salt))
+	params.KeyLength = uint32(len(key))
+	return params, salt, key, nil
+}
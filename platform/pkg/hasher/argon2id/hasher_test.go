@@ -0,0 +1,113 @@
+package argon2id
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/crafty-ezhik/rocket-factory/platform/pkg/hasher"
+)
+
+const testPassword = "Rocket-Factory1"
+
+// testParams - облегченные параметры, чтобы тесты не тратили 64 MiB на каждый хеш
+func testParams() Params {
+	return Params{
+		Memory:      64,
+		Iterations:  1,
+		Parallelism: 1,
+		SaltLength:  16,
+		KeyLength:   32,
+	}
+}
+
+type HasherSuite struct {
+	suite.Suite
+	hasher *Argon2idPasswordHasher
+}
+
+func (s *HasherSuite) SetupTest() {
+	s.hasher = NewArgon2idPasswordHasher(testParams())
+}
+
+func TestHasher(t *testing.T) {
+	suite.Run(t, new(HasherSuite))
+}
+
+func (s *HasherSuite) TestRoundTrip() {
+	hash, err := s.hasher.Hash(testPassword)
+	s.Require().NoError(err)
+
+	s.True(strings.HasPrefix(hash, "$argon2id$v=19$m=64,t=1,p=1$"))
+	s.True(s.hasher.Matches(hash))
+	s.False(s.hasher.NeedsRehash(hash))
+
+	s.NoError(s.hasher.Verify(hash, testPassword))
+	s.ErrorIs(s.hasher.Verify(hash, testPassword+"!"), hasher.ErrInvalidPassword)
+}
+
+func (s *HasherSuite) TestSaltIsRandom() {
+	first, err := s.hasher.Hash(testPassword)
+	s.Require().NoError(err)
+	second, err := s.hasher.Hash(testPassword)
+	s.Require().NoError(err)
+
+	s.NotEqual(first, second)
+}
+
+func (s *HasherSuite) TestVerifyUsesParamsFromHash() {
+	old := NewArgon2idPasswordHasher(Params{Memory: 32, Iterations: 2, Parallelism: 1, SaltLength: 8, KeyLength: 16})
+	hash, err := old.Hash(testPassword)
+	s.Require().NoError(err)
+
+	// Хеш со старыми параметрами проверяется, но требует пересчета
+	s.NoError(s.hasher.Verify(hash, testPassword))
+	s.True(s.hasher.NeedsRehash(hash))
+}
+
+func (s *HasherSuite) TestNeedsRehashOnParamChange() {
+	hash, err := s.hasher.Hash(testPassword)
+	s.Require().NoError(err)
+
+	for name, params := range map[string]func(*Params){
+		"memory":      func(p *Params) { p.Memory *= 2 },
+		"iterations":  func(p *Params) { p.Iterations++ },
+		"parallelism": func(p *Params) { p.Parallelism++ },
+		"salt":        func(p *Params) { p.SaltLength *= 2 },
+		"key":         func(p *Params) { p.KeyLength *= 2 },
+	} {
+		current := testParams()
+		params(&current)
+		s.True(NewArgon2idPasswordHasher(current).NeedsRehash(hash), name)
+	}
+}
+
+func (s *HasherSuite) TestMalformedHash() {
+	hash, err := s.hasher.Hash(testPassword)
+	s.Require().NoError(err)
+	parts := strings.Split(hash, "$")
+
+	for name, malformed := range map[string]string{
+		"другой алгоритм":      strings.Replace(hash, "argon2id", "argon2i", 1),
+		"не хватает частей":    strings.Join(parts[:5], "$"),
+		"другая версия":        strings.Replace(hash, "v=19", "v=16", 1),
+		"параметры":            strings.Replace(hash, "m=64,t=1,p=1", "m=64;t=1", 1),
+		"соль не base64":       strings.Replace(hash, parts[4], "!!!", 1),
+		"ключ не base64":       strings.Replace(hash, parts[5], "!!!", 1),
+		"пустой ключ":          strings.TrimSuffix(hash, parts[5]),
+		"bcrypt вместо argon2": "$2a$10$abcdefghijklmnopqrstuu",
+	} {
+		s.ErrorIs(s.hasher.Verify(malformed, testPassword), hasher.ErrMalformedHash, name)
+		s.True(s.hasher.NeedsRehash(malformed), name)
+	}
+
+	s.ErrorIs(s.hasher.Verify("", testPassword), hasher.ErrEmptyHash)
+}
+
+func (s *HasherSuite) TestMatches() {
+	s.True(s.hasher.Matches("$argon2id$v=19$m=64,t=1,p=1$c2FsdA$a2V5"))
+	s.False(s.hasher.Matches("$argon2i$v=19$m=64,t=1,p=1$c2FsdA$a2V5"))
+	s.False(s.hasher.Matches("$2a$10$abcdefghijklmnopqrstuu"))
+	s.False(s.hasher.Matches(""))
+}
@@ -2,12 +2,18 @@ package bcrypt
 
 import (
 	"fmt"
+	"strings"
 
 	"golang.org/x/crypto/bcrypt"
 
 	"github.com/crafty-ezhik/rocket-factory/platform/pkg/hasher"
 )
 
+var _ hasher.Algorithm = (*BcryptPasswordHasher)(nil)
+
+// prefixes - версии формата bcrypt ($2a$, $2b$, $2y$)
+var prefixes = []string{"$2a$", "$2b$", "$2y$"}
+
 type BcryptPasswordHasher struct {
 	cost int
 }
@@ -20,10 +26,6 @@ func NewBcryptPasswordHasher(cost int) *BcryptPasswordHasher {
 }
 
 func (h *BcryptPasswordHasher) Hash(plainText string) (string, error) {
-	if err := h.validatePassword(plainText); err != nil {
-		return "", err
-	}
-
 	hash, err := bcrypt.GenerateFromPassword([]byte(plainText), h.cost)
 	if err != nil {
 		return "", fmt.Errorf("hashing password: %w", err)
@@ -41,9 +43,20 @@ func (h *BcryptPasswordHasher) Verify(hashedPassword, plainText string) error {
 	return nil
 }
 
-func (h *BcryptPasswordHasher) validatePassword(password string) error {
-	if len(password) < 8 {
-		return hasher.ErrWeakPassword
+// NeedsRehash - хеш получен с другой стоимостью
+func (h *BcryptPasswordHasher) NeedsRehash(hashedPassword string) bool {
+	cost, err := bcrypt.Cost([]byte(hashedPassword))
+	if err != nil {
+		return true
 	}
-	return nil
+	return cost != h.cost
+}
+
+func (h *BcryptPasswordHasher) Matches(hashedPassword string) bool {
+	for _, prefix := range prefixes {
+		if strings.HasPrefix(hashedPassword, prefix) {
+			return true
+		}
+	}
+	return false
 }
@@ -3,16 +3,47 @@ package hasher
 import "errors"
 
 var (
-	ErrInvalidPassword = errors.New("invalid password")
-	ErrWeakPassword    = errors.New("password must be at least 8 characters")
-	ErrEmptyHash       = errors.New("hash is empty")
+	ErrInvalidPassword   = errors.New("invalid password")
+	ErrWeakPassword      = errors.New("password does not satisfy the password policy")
+	ErrEmptyHash         = errors.New("hash is empty")
+	ErrUnknownHashFormat = errors.New("unknown password hash format")
+	ErrMalformedHash     = errors.New("malformed password hash")
+	ErrPasswordTooShort  = newPolicyError("password is too short")
+	ErrPasswordTooLong   = newPolicyError("password is too long")
+	ErrPasswordCharClass = newPolicyError("password must contain more character classes: lowercase, uppercase, digits, symbols")
+	ErrPasswordTooCommon = newPolicyError("password is too common")
 )
 
 type PasswordHasher interface {
 	// Hash генерирует хеш из plaintext-пароля.
-	// Может валидировать сложность пароля.
+	// Сложность пароля проверяется отдельно, см. PasswordPolicy.
 	Hash(plainText string) (string, error)
 
 	// Verify проверяет, совпадает ли plaintext-пароль с хешем.
 	Verify(hashedPassword, plainText string) error
+
+	// NeedsRehash сообщает, что хеш получен другим алгоритмом или с другими параметрами
+	// и его стоит пересчитать при следующей успешной проверке пароля.
+	NeedsRehash(hashedPassword string) bool
+}
+
+// Algorithm - реализация PasswordHasher для одного формата хеша
+type Algorithm interface {
+	PasswordHasher
+
+	// Matches сообщает, что хеш записан в формате этого алгоритма.
+	Matches(hashedPassword string) bool
+}
+
+// policyError - нарушение политики паролей, errors.Is(err, ErrWeakPassword) для любого нарушения
+type policyError struct {
+	msg string
+}
+
+func newPolicyError(msg string) *policyError {
+	return &policyError{msg: msg}
 }
+
+func (e *policyError) Error() string { return e.msg }
+
+func (e *policyError) Is(target error) bool { return target == ErrWeakPassword }
@@ -0,0 +1,55 @@
+package multi
+
+import (
+	"github.com/crafty-ezhik/rocket-factory/platform/pkg/hasher"
+)
+
+var _ hasher.PasswordHasher = (*PasswordHasher)(nil)
+
+// PasswordHasher хеширует текущим алгоритмом, а проверяет хеши любого из известных алгоритмов.
+// Позволяет сменить алгоритм без сброса паролей: старые хеши пересчитываются при входе
+type PasswordHasher struct {
+	current    hasher.Algorithm
+	algorithms []hasher.Algorithm
+}
+
+// NewPasswordHasher создает PasswordHasher. legacy - алгоритмы, хеши которых еще могут встретиться в хранилище
+func NewPasswordHasher(current hasher.Algorithm, legacy ...hasher.Algorithm) *PasswordHasher {
+	return &PasswordHasher{
+		current:    current,
+		algorithms: append([]hasher.Algorithm{current}, legacy...),
+	}
+}
+
+func (h *PasswordHasher) Hash(plainText string) (string, error) {
+	return h.current.Hash(plainText)
+}
+
+func (h *PasswordHasher) Verify(hashedPassword, plainText string) error {
+	if hashedPassword == "" {
+		return hasher.ErrEmptyHash
+	}
+
+	algorithm, ok := h.algorithm(hashedPassword)
+	if !ok {
+		return hasher.ErrUnknownHashFormat
+	}
+	return algorithm.Verify(hashedPassword, plainText)
+}
+
+// NeedsRehash - хеш получен не текущим алгоритмом или с устаревшими параметрами
+func (h *PasswordHasher) NeedsRehash(hashedPassword string) bool {
+	if !h.current.Matches(hashedPassword) {
+		return true
+	}
+	return h.current.NeedsRehash(hashedPassword)
+}
+
+func (h *PasswordHasher) algorithm(hashedPassword string) (hasher.Algorithm, bool) {
+	for _, algorithm := range h.algorithms {
+		if algorithm.Matches(hashedPassword) {
+			return algorithm, true
+		}
+	}
+	return nil, false
+}
@@ -0,0 +1,99 @@
+package multi
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	cryptoBcrypt "golang.org/x/crypto/bcrypt"
+
+	"github.com/crafty-ezhik/rocket-factory/platform/pkg/hasher"
+	"github.com/crafty-ezhik/rocket-factory/platform/pkg/hasher/argon2id"
+	"github.com/crafty-ezhik/rocket-factory/platform/pkg/hasher/bcrypt"
+)
+
+const testPassword = "Rocket-Factory1"
+
+type HasherSuite struct {
+	suite.Suite
+	argon2id *argon2id.Argon2idPasswordHasher
+	bcrypt   *bcrypt.BcryptPasswordHasher
+	hasher   *PasswordHasher
+}
+
+func (s *HasherSuite) SetupTest() {
+	s.argon2id = argon2id.NewArgon2idPasswordHasher(argon2id.Params{
+		Memory:      64,
+		Iterations:  1,
+		Parallelism: 1,
+		SaltLength:  16,
+		KeyLength:   32,
+	})
+	s.bcrypt = bcrypt.NewBcryptPasswordHasher(cryptoBcrypt.MinCost)
+	s.hasher = NewPasswordHasher(s.argon2id, s.bcrypt)
+}
+
+func TestHasher(t *testing.T) {
+	suite.Run(t, new(HasherSuite))
+}
+
+func (s *HasherSuite) TestHashesWithCurrentAlgorithm() {
+	hash, err := s.hasher.Hash(testPassword)
+	s.Require().NoError(err)
+
+	s.True(s.argon2id.Matches(hash))
+	s.False(s.hasher.NeedsRehash(hash))
+	s.NoError(s.hasher.Verify(hash, testPassword))
+	s.ErrorIs(s.hasher.Verify(hash, "wrong"), hasher.ErrInvalidPassword)
+}
+
+func (s *HasherSuite) TestLegacyBcrypt() {
+	hash, err := s.bcrypt.Hash(testPassword)
+	s.Require().NoError(err)
+
+	// Старый хеш проверяется, но требует пересчета текущим алгоритмом
+	s.NoError(s.hasher.Verify(hash, testPassword))
+	s.ErrorIs(s.hasher.Verify(hash, "wrong"), hasher.ErrInvalidPassword)
+	s.True(s.hasher.NeedsRehash(hash))
+
+	rehashed, err := s.hasher.Hash(testPassword)
+	s.Require().NoError(err)
+	s.False(s.hasher.NeedsRehash(rehashed))
+}
+
+func (s *HasherSuite) TestOutdatedParams() {
+	old := argon2id.NewArgon2idPasswordHasher(argon2id.Params{
+		Memory:      32,
+		Iterations:  1,
+		Parallelism: 1,
+		SaltLength:  16,
+		KeyLength:   32,
+	})
+	hash, err := old.Hash(testPassword)
+	s.Require().NoError(err)
+
+	s.NoError(s.hasher.Verify(hash, testPassword))
+	s.True(s.hasher.NeedsRehash(hash))
+}
+
+func (s *HasherSuite) TestUnknownFormat() {
+	for _, hash := range []string{"plaintext", "$1$md5crypt$hash", "$argon2i$v=19$m=64,t=1,p=1$c2FsdA$a2V5"} {
+		s.ErrorIs(s.hasher.Verify(hash, testPassword), hasher.ErrUnknownHashFormat, hash)
+		s.True(s.hasher.NeedsRehash(hash), hash)
+	}
+}
+
+func (s *HasherSuite) TestEmptyHash() {
+	s.ErrorIs(s.hasher.Verify("", testPassword), hasher.ErrEmptyHash)
+}
+
+func (s *HasherSuite) TestMalformedHash() {
+	s.ErrorIs(s.hasher.Verify("$argon2id$v=19$broken", testPassword), hasher.ErrMalformedHash)
+	s.ErrorIs(s.hasher.Verify("$2a$10$broken", testPassword), hasher.ErrInvalidPassword)
+}
+
+func (s *HasherSuite) TestWithoutLegacy() {
+	hash, err := s.bcrypt.Hash(testPassword)
+	s.Require().NoError(err)
+
+	s.ErrorIs(NewPasswordHasher(s.argon2id).Verify(hash, testPassword), hasher.ErrUnknownHashFormat)
+}
@@ -0,0 +1,104 @@
+package hasher
+
+import (
+	"bufio"
+	_ "embed"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+//go:embed common_passwords.txt
+var commonPasswordsFile string
+
+// commonPasswords - словарь распространенных паролей, встроенный в бинарник
+var commonPasswords = parseCommonPasswords(commonPasswordsFile)
+
+// PasswordPolicy - требования к сложности пароля
+type PasswordPolicy struct {
+	// MinLength и MaxLength - границы длины пароля в символах
+	MinLength int
+	MaxLength int
+	// MaxBytes - граница длины пароля в байтах UTF-8, 0 - без ограничения
+	MaxBytes int
+	// MinCharClasses - сколько классов символов из четырех (строчные, заглавные, цифры, прочие) должно быть в пароле
+	MinCharClasses int
+	// RejectCommon - запрещать пароли из словаря распространенных
+	RejectCommon bool
+}
+
+// DefaultPasswordPolicy - политика паролей по умолчанию.
+// MaxBytes равен 72: bcrypt молча отбрасывает байты сверх этого, а кириллический символ занимает 2 байта
+func DefaultPasswordPolicy() PasswordPolicy {
+	return PasswordPolicy{
+		MinLength:      8,
+		MaxLength:      64,
+		MaxBytes:       72,
+		MinCharClasses: 3,
+		RejectCommon:   true,
+	}
+}
+
+// Validate проверяет пароль. Любая возвращенная ошибка удовлетворяет errors.Is(err, ErrWeakPassword)
+func (p PasswordPolicy) Validate(password string) error {
+	length := utf8.RuneCountInString(password)
+	if length < p.MinLength {
+		return ErrPasswordTooShort
+	}
+	if p.MaxLength > 0 && length > p.MaxLength {
+		return ErrPasswordTooLong
+	}
+	if p.MaxBytes > 0 && len(password) > p.MaxBytes {
+		return ErrPasswordTooLong
+	}
+
+	if charClasses(password) < p.MinCharClasses {
+		return ErrPasswordCharClass
+	}
+
+	if p.RejectCommon {
+		if _, ok := commonPasswords[strings.ToLower(password)]; ok {
+			return ErrPasswordTooCommon
+		}
+	}
+	return nil
+}
+
+// charClasses - количество классов символов, встречающихся в пароле
+func charClasses(password string) int {
+	var lower, upper, digit, other bool
+	for _, r := range password {
+		switch {
+		case unicode.IsLower(r):
+			lower = true
+		case unicode.IsUpper(r):
+			upper = true
+		case unicode.IsDigit(r):
+			digit = true
+		default:
+			other = true
+		}
+	}
+
+	count := 0
+	for _, present := range []bool{lower, upper, digit, other} {
+		if present {
+			count++
+		}
+	}
+	return count
+}
+
+func parseCommonPasswords(data string) map[string]struct{} {
+	passwords := make(map[string]struct{})
+
+	scanner := bufio.NewScanner(strings.NewReader(data))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		passwords[strings.ToLower(line)] = struct{}{}
+	}
+	return passwords
+}
@@ -0,0 +1,67 @@
+package hasher
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPasswordPolicyValidate(t *testing.T) {
+	policy := DefaultPasswordPolicy()
+
+	tests := []struct {
+		name     string
+		password string
+		wantErr  error
+	}{
+		{name: "надежный пароль", password: "Rocket-Factory1"},
+		{name: "кириллица", password: "Ракета-2025"},
+		{name: "слишком короткий", password: "Ab1!", wantErr: ErrPasswordTooShort},
+		{name: "длина в символах", password: "Aa1" + strings.Repeat("x", 62), wantErr: ErrPasswordTooLong},
+		{name: "ровно 72 байта", password: "Aa1" + strings.Repeat("я", 34) + "x"},
+		{name: "больше 72 байт при допустимом числе символов", password: "Aa1" + strings.Repeat("я", 35), wantErr: ErrPasswordTooLong},
+		{name: "мало классов символов", password: "onlylowercase", wantErr: ErrPasswordCharClass},
+		{name: "распространенный пароль", password: "P@ssw0rd", wantErr: ErrPasswordTooCommon},
+		{name: "распространенный пароль в другом регистре", password: "QWERTY123!", wantErr: ErrPasswordTooCommon},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := policy.Validate(tt.password)
+			if tt.wantErr == nil {
+				assert.NoError(t, err)
+				return
+			}
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.ErrorIs(t, err, ErrWeakPassword)
+		})
+	}
+}
+
+func TestPasswordPolicyWithoutLimits(t *testing.T) {
+	policy := PasswordPolicy{MinLength: 1}
+
+	assert.NoError(t, policy.Validate(strings.Repeat("я", 1000)))
+	assert.NoError(t, policy.Validate("123456"))
+}
+
+func TestPolicyErrorIsWeakPassword(t *testing.T) {
+	assert.False(t, errors.Is(ErrInvalidPassword, ErrWeakPassword))
+	assert.True(t, errors.Is(ErrPasswordTooCommon, ErrWeakPassword))
+}
+
+func TestCharClasses(t *testing.T) {
+	assert.Equal(t, 1, charClasses("abc"))
+	assert.Equal(t, 2, charClasses("abcABC"))
+	assert.Equal(t, 3, charClasses("abcABC123"))
+	assert.Equal(t, 4, charClasses("abcABC123!"))
+	assert.Equal(t, 2, charClasses("ракетаРАКЕТА"))
+}
+
+func TestParseCommonPasswords(t *testing.T) {
+	passwords := parseCommonPasswords("# комментарий\n\n  Qwerty \nletmein\n")
+
+	assert.Equal(t, map[string]struct{}{"qwerty": {}, "letmein": {}}, passwords)
+}
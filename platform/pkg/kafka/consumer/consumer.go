@@ -20,24 +20,62 @@ type consumer struct {
 	topics      []string
 	logger      Logger
 	middlewares []Middleware
+	retrier     *retrier
+}
+
+// Option — опция настройки consumer.
+type Option func(c *consumer)
+
+// WithMiddlewares — добавляет middleware в цепочку обработки сообщений.
+func WithMiddlewares(middlewares ...Middleware) Option {
+	return func(c *consumer) {
+		c.middlewares = append(c.middlewares, middlewares...)
+	}
+}
+
+// WithRetry — включает повторную обработку через retry-топики и DLQ.
+//
+//	producer используется для переотправки сообщений, retry-топики читаются той же consumer group.
+//	Если переотправить сообщение не удается, чтение его партиции приостанавливается до восстановления Kafka
+//	или завершения сессии, а сообщение остается непрочитанным.
+func WithRetry(policy RetryPolicy, producer sarama.SyncProducer) Option {
+	return func(c *consumer) {
+		c.retrier = &retrier{
+			policy:           policy,
+			producer:         producer,
+			logger:           c.logger,
+			republishBackoff: defaultRepublishBackoff,
+		}
+	}
 }
 
 // NewConsumer — создаёт новый consumer.
-func NewConsumer(group sarama.ConsumerGroup, topics []string, logger Logger, middlewares ...Middleware) *consumer {
-	return &consumer{
-		group:       group,
-		topics:      topics,
-		logger:      logger,
-		middlewares: middlewares,
+func NewConsumer(group sarama.ConsumerGroup, topics []string, logger Logger, opts ...Option) *consumer {
+	c := &consumer{
+		group:  group,
+		topics: topics,
+		logger: logger,
+	}
+
+	for _, opt := range opts {
+		opt(c)
 	}
+
+	return c
 }
 
 // Consume запускает консьюмер для списка топиков.
 func (c *consumer) Consume(ctx context.Context, handler kafka.MessageHandler) error {
 	newGroupHandler := NewGroupHandler(handler, c.logger, c.middlewares...)
 
+	topics := c.topics
+	if c.retrier != nil {
+		newGroupHandler.retrier = c.retrier
+		topics = append(topics[:len(topics):len(topics)], c.retrier.topics(c.topics)...)
+	}
+
 	for {
-		if err := c.group.Consume(ctx, c.topics, newGroupHandler); err != nil {
+		if err := c.group.Consume(ctx, topics, newGroupHandler); err != nil {
 			if errors.Is(err, sarama.ErrClosedConsumerGroup) {
 				return nil
 			}
@@ -0,0 +1,67 @@
+package consumer
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/crafty-ezhik/rocket-factory/platform/pkg/kafka"
+)
+
+// DedupStore — хранилище ключей уже обработанных сообщений.
+type DedupStore interface {
+	// IsProcessed проверяет, было ли сообщение с ключом обработано в пределах окна хранения.
+	IsProcessed(ctx context.Context, key string) (bool, error)
+	// MarkProcessed запоминает ключ обработанного сообщения на время ttl.
+	MarkProcessed(ctx context.Context, key string, ttl time.Duration) error
+}
+
+// KeyFunc — извлекает из сообщения ключ идемпотентности (например, event_uuid).
+type KeyFunc func(msg kafka.Message) (string, error)
+
+// Deduplicate — middleware, пропускающее сообщения, которые уже были обработаны в пределах retention.
+//
+//	scope отделяет ключи разных consumer group, читающих одно и то же событие,
+//	поэтому обычно в него передается group id.
+//	Ключ запоминается только после успешной обработки, поэтому упавшее сообщение будет обработано повторно.
+func Deduplicate(store DedupStore, scope string, retention time.Duration, keyFunc KeyFunc, logger Logger) Middleware {
+	return func(next kafka.MessageHandler) kafka.MessageHandler {
+		return func(ctx context.Context, msg kafka.Message) error {
+			key, err := keyFunc(msg)
+			if err != nil {
+				// Без ключа дедупликация невозможна, решение о судьбе сообщения принимает обработчик
+				logger.Error(ctx, "Failed to extract deduplication key", zap.String("topic", msg.Topic), zap.Error(err))
+				return next(ctx, msg)
+			}
+			key = scope + ":" + key
+
+			processed, err := store.IsProcessed(ctx, key)
+			if err != nil {
+				logger.Error(ctx, "Failed to check deduplication store", zap.String("key", key), zap.Error(err))
+				return err
+			}
+
+			if processed {
+				logger.Info(ctx, "Kafka message already processed, skipping",
+					zap.String("key", key),
+					zap.String("topic", msg.Topic),
+					zap.Int32("partition", msg.Partition),
+					zap.Int64("offset", msg.Offset),
+				)
+				return nil
+			}
+
+			if err = next(ctx, msg); err != nil {
+				return err
+			}
+
+			if err = store.MarkProcessed(ctx, key, retention); err != nil {
+				// Сообщение уже обработано, поэтому не возвращаем ошибку, чтобы не вызвать повторную обработку
+				logger.Error(ctx, "Failed to save message to deduplication store", zap.String("key", key), zap.Error(err))
+			}
+
+			return nil
+		}
+	}
+}
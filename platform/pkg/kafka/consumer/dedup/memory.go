@@ -0,0 +1,62 @@
+package dedup
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// memoryStore — хранилище обработанных ключей в памяти процесса.
+//
+//	Не переживает перезапуск и не разделяется между экземплярами сервиса,
+//	поэтому защищает только от повторной доставки в тот же процесс.
+type memoryStore struct {
+	mu        sync.Mutex
+	keys      map[string]time.Time
+	lastSweep time.Time
+	now       func() time.Time
+}
+
+func NewMemoryStore() *memoryStore {
+	return &memoryStore{
+		keys: make(map[string]time.Time),
+		now:  time.Now,
+	}
+}
+
+func (s *memoryStore) IsProcessed(_ context.Context, key string) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	expiresAt, ok := s.keys[key]
+	if !ok {
+		return false, nil
+	}
+
+	if !s.now().Before(expiresAt) {
+		delete(s.keys, key)
+		return false, nil
+	}
+
+	return true, nil
+}
+
+func (s *memoryStore) MarkProcessed(_ context.Context, key string, ttl time.Duration) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+	s.keys[key] = now.Add(ttl)
+
+	// Периодически вычищаем истекшие ключи, чтобы карта не росла бесконечно
+	if now.Sub(s.lastSweep) >= ttl {
+		for k, expiresAt := range s.keys {
+			if !now.Before(expiresAt) {
+				delete(s.keys, k)
+			}
+		}
+		s.lastSweep = now
+	}
+
+	return nil
+}
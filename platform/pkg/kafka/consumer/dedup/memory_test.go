@@ -0,0 +1,71 @@
+package dedup
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type MemoryStoreSuite struct {
+	suite.Suite
+	ctx   context.Context
+	now   time.Time
+	store *memoryStore
+}
+
+func (s *MemoryStoreSuite) SetupTest() {
+	s.ctx = context.Background()
+	s.now = time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	s.store = NewMemoryStore()
+	s.store.now = func() time.Time { return s.now }
+}
+
+func TestMemoryStore(t *testing.T) {
+	suite.Run(t, new(MemoryStoreSuite))
+}
+
+func (s *MemoryStoreSuite) TestMarkAndExpire() {
+	processed, err := s.store.IsProcessed(s.ctx, "key")
+	s.Require().NoError(err)
+	s.False(processed)
+
+	s.Require().NoError(s.store.MarkProcessed(s.ctx, "key", time.Minute))
+
+	processed, err = s.store.IsProcessed(s.ctx, "key")
+	s.Require().NoError(err)
+	s.True(processed)
+
+	s.now = s.now.Add(time.Minute)
+	processed, err = s.store.IsProcessed(s.ctx, "key")
+	s.Require().NoError(err)
+	s.False(processed)
+	s.NotContains(s.store.keys, "key")
+}
+
+func (s *MemoryStoreSuite) TestMarkExtendsTTL() {
+	s.Require().NoError(s.store.MarkProcessed(s.ctx, "key", time.Minute))
+	s.now = s.now.Add(30 * time.Second)
+	s.Require().NoError(s.store.MarkProcessed(s.ctx, "key", time.Minute))
+	s.now = s.now.Add(45 * time.Second)
+
+	processed, err := s.store.IsProcessed(s.ctx, "key")
+	s.Require().NoError(err)
+	s.True(processed)
+}
+
+func (s *MemoryStoreSuite) TestSweepRemovesExpiredKeys() {
+	s.Require().NoError(s.store.MarkProcessed(s.ctx, "old", time.Minute))
+
+	// Раньше чем через ttl очистка не выполняется
+	s.now = s.now.Add(30 * time.Second)
+	s.Require().NoError(s.store.MarkProcessed(s.ctx, "mid", time.Minute))
+	s.Len(s.store.keys, 2)
+
+	s.now = s.now.Add(time.Minute)
+	s.Require().NoError(s.store.MarkProcessed(s.ctx, "new", time.Minute))
+
+	s.Len(s.store.keys, 1)
+	s.Contains(s.store.keys, "new")
+}
@@ -0,0 +1,80 @@
+package dedup
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// pgExecutor — часть *pgxpool.Pool, которая нужна хранилищу
+type pgExecutor interface {
+	QueryRow(ctx context.Context, sql string, args ...any) pgx.Row
+	Exec(ctx context.Context, sql string, args ...any) (pgconn.CommandTag, error)
+}
+
+// postgresStore — хранилище обработанных ключей в PostgreSQL.
+//
+//	Ожидает таблицу вида:
+//
+//	CREATE TABLE processed_messages (
+//	    message_key TEXT PRIMARY KEY,
+//	    expires_at  TIMESTAMP WITH TIME ZONE NOT NULL
+//	);
+type postgresStore struct {
+	pool  pgExecutor
+	table string
+
+	mu          sync.Mutex
+	lastCleanup time.Time
+	now         func() time.Time
+}
+
+func NewPostgresStore(pool pgExecutor, table string) *postgresStore {
+	return &postgresStore{
+		pool:  pool,
+		table: table,
+		now:   time.Now,
+	}
+}
+
+func (s *postgresStore) IsProcessed(ctx context.Context, key string) (bool, error) {
+	query := fmt.Sprintf("SELECT EXISTS (SELECT 1 FROM %s WHERE message_key = $1 AND expires_at > now())", s.table)
+
+	var exists bool
+	if err := s.pool.QueryRow(ctx, query, key).Scan(&exists); err != nil {
+		return false, err
+	}
+
+	return exists, nil
+}
+
+func (s *postgresStore) MarkProcessed(ctx context.Context, key string, ttl time.Duration) error {
+	query := fmt.Sprintf(`INSERT INTO %s (message_key, expires_at) VALUES ($1, now() + make_interval(secs => $2))
+ON CONFLICT (message_key) DO UPDATE SET expires_at = EXCLUDED.expires_at`, s.table)
+
+	if _, err := s.pool.Exec(ctx, query, key, ttl.Seconds()); err != nil {
+		return err
+	}
+
+	return s.cleanup(ctx, ttl)
+}
+
+// cleanup - удаляет истекшие ключи не чаще одного раза за ttl
+func (s *postgresStore) cleanup(ctx context.Context, ttl time.Duration) error {
+	s.mu.Lock()
+	now := s.now()
+	if now.Sub(s.lastCleanup) < ttl {
+		s.mu.Unlock()
+		return nil
+	}
+	s.lastCleanup = now
+	s.mu.Unlock()
+
+	query := fmt.Sprintf("DELETE FROM %s WHERE expires_at <= now()", s.table)
+	_, err := s.pool.Exec(ctx, query)
+	return err
+}
@@ -0,0 +1,121 @@
+package dedup
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stretchr/testify/suite"
+)
+
+type fakeRow struct {
+	exists bool
+	err    error
+}
+
+func (r fakeRow) Scan(dest ...any) error {
+	if r.err != nil {
+		return r.err
+	}
+	*dest[0].(*bool) = r.exists
+	return nil
+}
+
+type execCall struct {
+	sql  string
+	args []any
+}
+
+// fakeExecutor - записывает выполненные запросы вместо обращения к базе
+type fakeExecutor struct {
+	row     fakeRow
+	execErr error
+	queries []execCall
+	execs   []execCall
+}
+
+func (e *fakeExecutor) QueryRow(_ context.Context, sql string, args ...any) pgx.Row {
+	e.queries = append(e.queries, execCall{sql: sql, args: args})
+	return e.row
+}
+
+func (e *fakeExecutor) Exec(_ context.Context, sql string, args ...any) (pgconn.CommandTag, error) {
+	e.execs = append(e.execs, execCall{sql: sql, args: args})
+	return pgconn.CommandTag{}, e.execErr
+}
+
+type PostgresStoreSuite struct {
+	suite.Suite
+	ctx   context.Context
+	now   time.Time
+	db    *fakeExecutor
+	store *postgresStore
+}
+
+func (s *PostgresStoreSuite) SetupTest() {
+	s.ctx = context.Background()
+	s.now = time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	s.db = &fakeExecutor{}
+	s.store = NewPostgresStore(s.db, "processed_messages")
+	s.store.now = func() time.Time { return s.now }
+}
+
+func TestPostgresStore(t *testing.T) {
+	suite.Run(t, new(PostgresStoreSuite))
+}
+
+func (s *PostgresStoreSuite) TestIsProcessed() {
+	s.db.row = fakeRow{exists: true}
+
+	processed, err := s.store.IsProcessed(s.ctx, "key")
+	s.Require().NoError(err)
+	s.True(processed)
+
+	s.Require().Len(s.db.queries, 1)
+	s.Contains(s.db.queries[0].sql, "FROM processed_messages")
+	s.Contains(s.db.queries[0].sql, "expires_at > now()")
+	s.Equal([]any{"key"}, s.db.queries[0].args)
+}
+
+func (s *PostgresStoreSuite) TestIsProcessedError() {
+	scanErr := errors.New("connection lost")
+	s.db.row = fakeRow{err: scanErr}
+
+	processed, err := s.store.IsProcessed(s.ctx, "key")
+	s.ErrorIs(err, scanErr)
+	s.False(processed)
+}
+
+func (s *PostgresStoreSuite) TestMarkProcessedUpsertsAndCleansUp() {
+	s.Require().NoError(s.store.MarkProcessed(s.ctx, "key", time.Minute))
+
+	s.Require().Len(s.db.execs, 2)
+	s.Contains(s.db.execs[0].sql, "INSERT INTO processed_messages")
+	s.Contains(s.db.execs[0].sql, "ON CONFLICT (message_key) DO UPDATE")
+	s.Equal([]any{"key", float64(60)}, s.db.execs[0].args)
+	s.Contains(s.db.execs[1].sql, "DELETE FROM processed_messages WHERE expires_at <= now()")
+}
+
+func (s *PostgresStoreSuite) TestCleanupAtMostOncePerTTL() {
+	s.Require().NoError(s.store.MarkProcessed(s.ctx, "first", time.Minute))
+
+	s.now = s.now.Add(30 * time.Second)
+	s.Require().NoError(s.store.MarkProcessed(s.ctx, "second", time.Minute))
+	s.Len(s.db.execs, 3)
+
+	s.now = s.now.Add(30 * time.Second)
+	s.Require().NoError(s.store.MarkProcessed(s.ctx, "third", time.Minute))
+	s.Len(s.db.execs, 5)
+}
+
+func (s *PostgresStoreSuite) TestMarkProcessedError() {
+	execErr := errors.New("connection lost")
+	s.db.execErr = execErr
+
+	s.ErrorIs(s.store.MarkProcessed(s.ctx, "key", time.Minute), execErr)
+	// Очистка не выполняется, если ключ не удалось сохранить
+	s.Len(s.db.execs, 1)
+}
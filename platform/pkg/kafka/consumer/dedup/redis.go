@@ -0,0 +1,27 @@
+package dedup
+
+import (
+	"context"
+	"time"
+
+	"github.com/crafty-ezhik/rocket-factory/platform/pkg/cache"
+)
+
+const redisKeyPrefix = "kafka:dedup:"
+
+// redisStore — хранилище обработанных ключей в Redis, срок хранения задается TTL ключа.
+type redisStore struct {
+	client cache.RedisClient
+}
+
+func NewRedisStore(client cache.RedisClient) *redisStore {
+	return &redisStore{client: client}
+}
+
+func (s *redisStore) IsProcessed(ctx context.Context, key string) (bool, error) {
+	return s.client.Exists(ctx, redisKeyPrefix+key)
+}
+
+func (s *redisStore) MarkProcessed(ctx context.Context, key string, ttl time.Duration) error {
+	return s.client.SetWithTTL(ctx, redisKeyPrefix+key, 1, ttl)
+}
@@ -0,0 +1,58 @@
+package dedup
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/crafty-ezhik/rocket-factory/platform/pkg/cache/mocks"
+)
+
+type RedisStoreSuite struct {
+	suite.Suite
+	ctx    context.Context
+	client *mocks.MockRedisClient
+	store  *redisStore
+}
+
+func (s *RedisStoreSuite) SetupTest() {
+	s.ctx = context.Background()
+	s.client = mocks.NewMockRedisClient(s.T())
+	s.store = NewRedisStore(s.client)
+}
+
+func TestRedisStore(t *testing.T) {
+	suite.Run(t, new(RedisStoreSuite))
+}
+
+func (s *RedisStoreSuite) TestIsProcessed() {
+	s.client.EXPECT().Exists(s.ctx, "kafka:dedup:group:event-1").Return(true, nil).Once()
+
+	processed, err := s.store.IsProcessed(s.ctx, "group:event-1")
+	s.Require().NoError(err)
+	s.True(processed)
+}
+
+func (s *RedisStoreSuite) TestIsProcessedError() {
+	redisErr := errors.New("redis unavailable")
+	s.client.EXPECT().Exists(s.ctx, "kafka:dedup:group:event-1").Return(false, redisErr).Once()
+
+	_, err := s.store.IsProcessed(s.ctx, "group:event-1")
+	s.ErrorIs(err, redisErr)
+}
+
+func (s *RedisStoreSuite) TestMarkProcessedSetsTTL() {
+	s.client.EXPECT().SetWithTTL(s.ctx, "kafka:dedup:group:event-1", 1, time.Hour).Return(nil).Once()
+
+	s.Require().NoError(s.store.MarkProcessed(s.ctx, "group:event-1", time.Hour))
+}
+
+func (s *RedisStoreSuite) TestMarkProcessedError() {
+	redisErr := errors.New("redis unavailable")
+	s.client.EXPECT().SetWithTTL(s.ctx, "kafka:dedup:group:event-1", 1, time.Hour).Return(redisErr).Once()
+
+	s.ErrorIs(s.store.MarkProcessed(s.ctx, "group:event-1", time.Hour), redisErr)
+}
@@ -0,0 +1,151 @@
+package consumer
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/zap"
+
+	"github.com/crafty-ezhik/rocket-factory/platform/pkg/kafka"
+	"github.com/crafty-ezhik/rocket-factory/platform/pkg/kafka/consumer/dedup"
+)
+
+const (
+	testScope     = "test-group"
+	testRetention = time.Hour
+)
+
+type nopLogger struct{}
+
+func (nopLogger) Info(context.Context, string, ...zap.Field)  {}
+func (nopLogger) Error(context.Context, string, ...zap.Field) {}
+
+// failingStore - хранилище, каждый вызов которого завершается ошибкой
+type failingStore struct {
+	err    error
+	marked int
+}
+
+func (s *failingStore) IsProcessed(context.Context, string) (bool, error) {
+	return false, s.err
+}
+
+func (s *failingStore) MarkProcessed(context.Context, string, time.Duration) error {
+	s.marked++
+	return s.err
+}
+
+type DeduplicateSuite struct {
+	suite.Suite
+	ctx   context.Context
+	store DedupStore
+	calls int
+}
+
+func (s *DeduplicateSuite) SetupTest() {
+	s.ctx = context.Background()
+	s.store = dedup.NewMemoryStore()
+	s.calls = 0
+}
+
+func TestDeduplicate(t *testing.T) {
+	suite.Run(t, new(DeduplicateSuite))
+}
+
+func (s *DeduplicateSuite) handler(err error) kafka.MessageHandler {
+	return func(context.Context, kafka.Message) error {
+		s.calls++
+		return err
+	}
+}
+
+func keyFromValue(msg kafka.Message) (string, error) {
+	if len(msg.Value) == 0 {
+		return "", errors.New("empty value")
+	}
+	return string(msg.Value), nil
+}
+
+func (s *DeduplicateSuite) wrap(store DedupStore, next kafka.MessageHandler) kafka.MessageHandler {
+	return Deduplicate(store, testScope, testRetention, keyFromValue, nopLogger{})(next)
+}
+
+func (s *DeduplicateSuite) TestSkipsProcessedMessage() {
+	h := s.wrap(s.store, s.handler(nil))
+	msg := kafka.Message{Value: []byte("event-1")}
+
+	s.Require().NoError(h(s.ctx, msg))
+	s.Require().NoError(h(s.ctx, msg))
+
+	s.Equal(1, s.calls)
+}
+
+func (s *DeduplicateSuite) TestMarksWithScope() {
+	h := s.wrap(s.store, s.handler(nil))
+
+	s.Require().NoError(h(s.ctx, kafka.Message{Value: []byte("event-1")}))
+
+	processed, err := s.store.IsProcessed(s.ctx, testScope+":event-1")
+	s.Require().NoError(err)
+	s.True(processed)
+
+	// Другая consumer group обрабатывает то же событие независимо
+	other := Deduplicate(s.store, "other-group", testRetention, keyFromValue, nopLogger{})(s.handler(nil))
+	s.Require().NoError(other(s.ctx, kafka.Message{Value: []byte("event-1")}))
+	s.Equal(2, s.calls)
+}
+
+func (s *DeduplicateSuite) TestHandlerErrorIsNotMarked() {
+	handlerErr := errors.New("handler failed")
+	h := s.wrap(s.store, s.handler(handlerErr))
+	msg := kafka.Message{Value: []byte("event-1")}
+
+	s.ErrorIs(h(s.ctx, msg), handlerErr)
+	s.ErrorIs(h(s.ctx, msg), handlerErr)
+
+	s.Equal(2, s.calls)
+	processed, err := s.store.IsProcessed(s.ctx, testScope+":event-1")
+	s.Require().NoError(err)
+	s.False(processed)
+}
+
+func (s *DeduplicateSuite) TestKeyErrorPassesThrough() {
+	h := s.wrap(s.store, s.handler(nil))
+
+	s.Require().NoError(h(s.ctx, kafka.Message{}))
+	s.Require().NoError(h(s.ctx, kafka.Message{}))
+
+	s.Equal(2, s.calls)
+}
+
+func (s *DeduplicateSuite) TestStoreCheckError() {
+	storeErr := errors.New("store unavailable")
+	store := &failingStore{err: storeErr}
+	h := s.wrap(store, s.handler(nil))
+
+	s.ErrorIs(h(s.ctx, kafka.Message{Value: []byte("event-1")}), storeErr)
+
+	s.Zero(s.calls)
+	s.Zero(store.marked)
+}
+
+func (s *DeduplicateSuite) TestStoreMarkErrorIsIgnored() {
+	store := &markFailingStore{DedupStore: s.store, err: errors.New("store unavailable")}
+	h := s.wrap(store, s.handler(nil))
+
+	s.Require().NoError(h(s.ctx, kafka.Message{Value: []byte("event-1")}))
+	s.Equal(1, s.calls)
+}
+
+// markFailingStore - хранилище, в котором не удается сохранить ключ
+type markFailingStore struct {
+	DedupStore
+	err error
+}
+
+func (s *markFailingStore) MarkProcessed(context.Context, string, time.Duration) error {
+	return s.err
+}
@@ -1,6 +1,8 @@
 package consumer
 
 import (
+	"context"
+
 	"github.com/IBM/sarama"
 	"go.uber.org/zap"
 
@@ -14,6 +16,7 @@ type Middleware func(next kafka.MessageHandler) kafka.MessageHandler
 type groupHandler struct {
 	handler kafka.MessageHandler
 	logger  Logger
+	retrier *retrier
 }
 
 // NewGroupHandler создаёт новый groupHandler с middleware цепочкой.
@@ -54,9 +57,16 @@ func (g *groupHandler) ConsumeClaim(session sarama.ConsumerGroupSession, claim s
 			}
 
 			// Передаем полученное сообщение в обработчик
-			if err := g.handler(session.Context(), msg); err != nil {
-				g.logger.Info(session.Context(), "Kafka message handler failed", zap.Error(err))
-				continue
+			if err := g.process(session.Context(), msg); err != nil {
+				// Ошибка возможна только при завершении сессии, пока сообщение ждет повтора или переотправки.
+				// Не помечаем его, чтобы перечитать в новой сессии
+				g.logger.Error(session.Context(), "Kafka message processing interrupted, stopping claim",
+					zap.String("topic", msg.Topic),
+					zap.Int32("partition", msg.Partition),
+					zap.Int64("offset", msg.Offset),
+					zap.Error(err),
+				)
+				return err
 			}
 
 			// Помечаем сообщение прочитанным
@@ -69,6 +79,24 @@ func (g *groupHandler) ConsumeClaim(session sarama.ConsumerGroupSession, claim s
 	}
 }
 
+// process — обрабатывает сообщение, ошибка означает, что сообщение нельзя помечать прочитанным
+func (g *groupHandler) process(ctx context.Context, msg kafka.Message) error {
+	if g.retrier != nil {
+		return g.retrier.handle(ctx, msg, g.handler)
+	}
+
+	if err := g.handler(ctx, msg); err != nil {
+		g.logger.Error(ctx, "Kafka message handler failed, message skipped",
+			zap.String("topic", msg.Topic),
+			zap.Int32("partition", msg.Partition),
+			zap.Int64("offset", msg.Offset),
+			zap.Error(err),
+		)
+	}
+
+	return nil
+}
+
 func extractHeaders(headers []*sarama.RecordHeader) map[string][]byte {
 	result := make(map[string][]byte)
 	for _, h := range headers {
@@ -0,0 +1,166 @@
+package consumer
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/IBM/sarama"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/crafty-ezhik/rocket-factory/platform/pkg/kafka"
+)
+
+// fakeSession - сессия consumer group, запоминающая помеченные сообщения
+type fakeSession struct {
+	sarama.ConsumerGroupSession
+	ctx    context.Context //nolint:containedctx
+	marked []int64
+}
+
+func (s *fakeSession) Context() context.Context { return s.ctx }
+
+func (s *fakeSession) MarkMessage(msg *sarama.ConsumerMessage, _ string) {
+	s.marked = append(s.marked, msg.Offset)
+}
+
+type fakeClaim struct {
+	sarama.ConsumerGroupClaim
+	messages chan *sarama.ConsumerMessage
+}
+
+func (c *fakeClaim) Messages() <-chan *sarama.ConsumerMessage { return c.messages }
+
+type GroupHandlerSuite struct {
+	suite.Suite
+	session *fakeSession
+	claim   *fakeClaim
+	cancel  context.CancelFunc
+}
+
+func (s *GroupHandlerSuite) SetupTest() {
+	ctx, cancel := context.WithCancel(context.Background())
+	s.cancel = cancel
+	s.session = &fakeSession{ctx: ctx}
+	s.claim = &fakeClaim{messages: make(chan *sarama.ConsumerMessage, 10)}
+}
+
+func (s *GroupHandlerSuite) TearDownTest() {
+	s.cancel()
+}
+
+func TestGroupHandler(t *testing.T) {
+	suite.Run(t, new(GroupHandlerSuite))
+}
+
+func (s *GroupHandlerSuite) send(offsets ...int64) {
+	for _, offset := range offsets {
+		s.claim.messages <- &sarama.ConsumerMessage{
+			Topic:   testTopic,
+			Offset:  offset,
+			Value:   []byte("payload"),
+			Headers: []*sarama.RecordHeader{{Key: []byte("trace-id"), Value: []byte("abc")}, nil},
+		}
+	}
+	close(s.claim.messages)
+}
+
+func (s *GroupHandlerSuite) TestMarksHandledMessages() {
+	var received []kafka.Message
+	handler := NewGroupHandler(func(_ context.Context, msg kafka.Message) error {
+		received = append(received, msg)
+		return nil
+	}, nopLogger{})
+
+	s.send(1, 2)
+	s.Require().NoError(handler.ConsumeClaim(s.session, s.claim))
+
+	s.Equal([]int64{1, 2}, s.session.marked)
+	s.Require().Len(received, 2)
+	s.Equal(map[string][]byte{"trace-id": []byte("abc")}, received[0].Headers)
+	s.Equal(testTopic, received[0].Topic)
+}
+
+func (s *GroupHandlerSuite) TestMiddlewareOrder() {
+	var order []string
+	middleware := func(name string) Middleware {
+		return func(next kafka.MessageHandler) kafka.MessageHandler {
+			return func(ctx context.Context, msg kafka.Message) error {
+				order = append(order, name)
+				return next(ctx, msg)
+			}
+		}
+	}
+
+	handler := NewGroupHandler(func(context.Context, kafka.Message) error {
+		order = append(order, "handler")
+		return nil
+	}, nopLogger{}, middleware("first"), middleware("second"))
+
+	s.send(1)
+	s.Require().NoError(handler.ConsumeClaim(s.session, s.claim))
+
+	s.Equal([]string{"first", "second", "handler"}, order)
+}
+
+func (s *GroupHandlerSuite) TestErrorWithoutRetrySkipsMessage() {
+	handler := NewGroupHandler(func(context.Context, kafka.Message) error {
+		return errors.New("handler failed")
+	}, nopLogger{})
+
+	s.send(1, 2)
+	s.Require().NoError(handler.ConsumeClaim(s.session, s.claim))
+
+	s.Equal([]int64{1, 2}, s.session.marked)
+}
+
+func (s *GroupHandlerSuite) TestRepublishedMessageIsMarked() {
+	producer := &fakeProducer{err: errors.New("kafka unavailable"), failures: 1}
+	handler := NewGroupHandler(func(context.Context, kafka.Message) error {
+		return errors.New("handler failed")
+	}, nopLogger{})
+	handler.retrier = &retrier{
+		policy:           RetryPolicy{Attempts: 1, Delays: []time.Duration{time.Minute}},
+		producer:         producer,
+		logger:           nopLogger{},
+		republishBackoff: time.Millisecond,
+	}
+
+	s.send(1)
+	s.Require().NoError(handler.ConsumeClaim(s.session, s.claim))
+
+	s.Equal([]int64{1}, s.session.marked)
+	s.Require().Len(producer.sent, 1)
+	s.Equal("order.paid.retry.1", producer.sent[0].Topic)
+}
+
+func (s *GroupHandlerSuite) TestRepublishFailureStopsClaimWithoutMarking() {
+	producer := &fakeProducer{err: errors.New("kafka unavailable"), failures: -1}
+	handler := NewGroupHandler(func(context.Context, kafka.Message) error {
+		return errors.New("handler failed")
+	}, nopLogger{})
+	handler.retrier = &retrier{
+		policy:           RetryPolicy{Attempts: 1, Delays: []time.Duration{time.Minute}},
+		producer:         producer,
+		logger:           nopLogger{},
+		republishBackoff: time.Millisecond,
+	}
+
+	// Пока Kafka недоступна, партиция не читается дальше; сессия завершается ребалансировкой
+	time.AfterFunc(20*time.Millisecond, s.cancel)
+
+	s.send(1, 2)
+	s.ErrorIs(handler.ConsumeClaim(s.session, s.claim), context.Canceled)
+
+	s.Empty(s.session.marked)
+	s.Greater(producer.attempts, 1)
+}
+
+func (s *GroupHandlerSuite) TestSessionClosed() {
+	handler := NewGroupHandler(func(context.Context, kafka.Message) error { return nil }, nopLogger{})
+
+	s.cancel()
+	s.Require().NoError(handler.ConsumeClaim(s.session, s.claim))
+	s.Empty(s.session.marked)
+}
@@ -0,0 +1,224 @@
+package consumer
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/IBM/sarama"
+	"go.uber.org/zap"
+
+	"github.com/crafty-ezhik/rocket-factory/platform/pkg/kafka"
+)
+
+// Заголовки с метаданными неудачной обработки сообщения
+const (
+	HeaderOriginalTopic     = "x-original-topic"
+	HeaderOriginalPartition = "x-original-partition"
+	HeaderOriginalOffset    = "x-original-offset"
+	HeaderRetryAttempt      = "x-retry-attempt"
+	HeaderRetryAt           = "x-retry-at"
+	HeaderError             = "x-error"
+)
+
+// RetryPolicy — политика повторной обработки сообщений.
+//
+//	Сначала сообщение обрабатывается Attempts раз внутри процесса с экспоненциальной паузой,
+//	затем переотправляется в <topic>.retry.N с задержкой Delays[N-1],
+//	а после исчерпания retry-топиков попадает в <topic>.dlq.
+type RetryPolicy struct {
+	// Attempts — количество попыток обработки внутри процесса
+	Attempts int
+	// Backoff — пауза перед второй попыткой, далее удваивается
+	Backoff time.Duration
+	// MaxBackoff — максимальная пауза между попытками
+	MaxBackoff time.Duration
+	// Delays — задержки перед обработкой сообщения из соответствующего retry-топика
+	Delays []time.Duration
+}
+
+// RetryTopic — имя retry-топика уровня attempt для исходного топика.
+func RetryTopic(topic string, attempt int) string {
+	return fmt.Sprintf("%s.retry.%d", topic, attempt)
+}
+
+// DLQTopic — имя топика недоставляемых сообщений для исходного топика.
+func DLQTopic(topic string) string {
+	return topic + ".dlq"
+}
+
+const (
+	// defaultRepublishBackoff и maxRepublishBackoff — пауза между попытками переотправки, пока Kafka недоступна
+	defaultRepublishBackoff = time.Second
+	maxRepublishBackoff     = 30 * time.Second
+)
+
+type retrier struct {
+	policy   RetryPolicy
+	producer sarama.SyncProducer
+	logger   Logger
+
+	republishBackoff time.Duration
+}
+
+// topics — retry-топики, которые consumer должен читать вместе с исходными
+func (r *retrier) topics(topics []string) []string {
+	result := make([]string, 0, len(topics)*len(r.policy.Delays))
+	for _, topic := range topics {
+		for i := range r.policy.Delays {
+			result = append(result, RetryTopic(topic, i+1))
+		}
+	}
+	return result
+}
+
+// handle — обрабатывает сообщение с учетом политики, ошибка означает, что сообщение нельзя помечать прочитанным
+func (r *retrier) handle(ctx context.Context, msg kafka.Message, handler kafka.MessageHandler) error {
+	if retryAt, ok := headerInt(msg.Headers, HeaderRetryAt); ok {
+		if err := sleep(ctx, time.Until(time.UnixMilli(retryAt))); err != nil {
+			return err
+		}
+	}
+
+	attempts := max(r.policy.Attempts, 1)
+	backoff := r.policy.Backoff
+
+	var err error
+	for attempt := 1; attempt <= attempts; attempt++ {
+		if err = handler(ctx, msg); err == nil {
+			return nil
+		}
+
+		r.logger.Error(ctx, "Kafka message handler failed",
+			zap.String("topic", msg.Topic),
+			zap.Int32("partition", msg.Partition),
+			zap.Int64("offset", msg.Offset),
+			zap.Int("attempt", attempt),
+			zap.Error(err),
+		)
+
+		if attempt == attempts {
+			break
+		}
+
+		if sleepErr := sleep(ctx, backoff); sleepErr != nil {
+			return sleepErr
+		}
+
+		backoff *= 2
+		if r.policy.MaxBackoff > 0 && backoff > r.policy.MaxBackoff {
+			backoff = r.policy.MaxBackoff
+		}
+	}
+
+	return r.republishUntilSent(ctx, msg, err)
+}
+
+// republishUntilSent — переотправляет сообщение, пока это не удастся или не завершится сессия.
+//
+//	Пока переотправка не удалась, сообщение нельзя пометить прочитанным, а следующие сообщения
+//	партиции нельзя обработать без нарушения порядка, поэтому чтение партиции приостанавливается.
+//	Если просто вернуть ошибку из ConsumeClaim, sarama не перезапустит чтение партиции до ребалансировки.
+func (r *retrier) republishUntilSent(ctx context.Context, msg kafka.Message, handlerErr error) error {
+	backoff := r.republishBackoff
+	for {
+		err := r.republish(ctx, msg, handlerErr)
+		if err == nil {
+			return nil
+		}
+
+		if sleepErr := sleep(ctx, backoff); sleepErr != nil {
+			return sleepErr
+		}
+
+		backoff = min(backoff*2, maxRepublishBackoff)
+	}
+}
+
+// republish — переотправляет сообщение в следующий retry-топик или в DLQ
+func (r *retrier) republish(ctx context.Context, msg kafka.Message, handlerErr error) error {
+	headers := make(map[string][]byte, len(msg.Headers)+6)
+	for k, v := range msg.Headers {
+		headers[k] = v
+	}
+
+	// Метаданные исходного сообщения сохраняем только при первой переотправке
+	if _, ok := headers[HeaderOriginalTopic]; !ok {
+		headers[HeaderOriginalTopic] = []byte(msg.Topic)
+		headers[HeaderOriginalPartition] = []byte(strconv.FormatInt(int64(msg.Partition), 10))
+		headers[HeaderOriginalOffset] = []byte(strconv.FormatInt(msg.Offset, 10))
+	}
+
+	originalTopic := string(headers[HeaderOriginalTopic])
+	retryAttempt, _ := headerInt(headers, HeaderRetryAttempt)
+	retryAttempt++
+
+	var topic string
+	if int(retryAttempt) <= len(r.policy.Delays) {
+		topic = RetryTopic(originalTopic, int(retryAttempt))
+		retryAt := time.Now().Add(r.policy.Delays[retryAttempt-1])
+		headers[HeaderRetryAt] = []byte(strconv.FormatInt(retryAt.UnixMilli(), 10))
+	} else {
+		topic = DLQTopic(originalTopic)
+		delete(headers, HeaderRetryAt)
+	}
+
+	headers[HeaderRetryAttempt] = []byte(strconv.FormatInt(retryAttempt, 10))
+	headers[HeaderError] = []byte(handlerErr.Error())
+
+	recordHeaders := make([]sarama.RecordHeader, 0, len(headers))
+	for k, v := range headers {
+		recordHeaders = append(recordHeaders, sarama.RecordHeader{Key: []byte(k), Value: v})
+	}
+
+	_, _, err := r.producer.SendMessage(&sarama.ProducerMessage{
+		Topic:   topic,
+		Key:     sarama.ByteEncoder(msg.Key),
+		Value:   sarama.ByteEncoder(msg.Value),
+		Headers: recordHeaders,
+	})
+	if err != nil {
+		r.logger.Error(ctx, "Failed to republish kafka message", zap.String("topic", topic), zap.Error(err))
+		return err
+	}
+
+	r.logger.Info(ctx, "Kafka message republished",
+		zap.String("from", msg.Topic),
+		zap.String("to", topic),
+		zap.Int64("retry_attempt", retryAttempt),
+	)
+
+	return nil
+}
+
+func headerInt(headers map[string][]byte, key string) (int64, bool) {
+	value, ok := headers[key]
+	if !ok {
+		return 0, false
+	}
+
+	n, err := strconv.ParseInt(string(value), 10, 64)
+	if err != nil {
+		return 0, false
+	}
+
+	return n, true
+}
+
+// sleep — пауза, прерываемая завершением контекста
+func sleep(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
@@ -0,0 +1,248 @@
+package consumer
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/IBM/sarama"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/crafty-ezhik/rocket-factory/platform/pkg/kafka"
+)
+
+const testTopic = "order.paid"
+
+// fakeProducer - запоминает переотправленные сообщения, первые failures отправок завершаются ошибкой
+type fakeProducer struct {
+	sarama.SyncProducer
+
+	mu       sync.Mutex
+	failures int
+	err      error
+	sent     []*sarama.ProducerMessage
+	attempts int
+}
+
+func (p *fakeProducer) SendMessage(msg *sarama.ProducerMessage) (int32, int64, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.attempts++
+	if p.failures != 0 {
+		if p.failures > 0 {
+			p.failures--
+		}
+		return 0, 0, p.err
+	}
+
+	p.sent = append(p.sent, msg)
+	return 0, 0, nil
+}
+
+func headersOf(msg *sarama.ProducerMessage) map[string]string {
+	headers := make(map[string]string, len(msg.Headers))
+	for _, h := range msg.Headers {
+		headers[string(h.Key)] = string(h.Value)
+	}
+	return headers
+}
+
+type RetrySuite struct {
+	suite.Suite
+	ctx      context.Context //nolint:containedctx
+	producer *fakeProducer
+	retrier  *retrier
+	calls    int
+	err      error
+}
+
+func (s *RetrySuite) SetupTest() {
+	s.ctx = context.Background()
+	s.producer = &fakeProducer{err: errors.New("kafka unavailable")}
+	s.retrier = &retrier{
+		policy: RetryPolicy{
+			Attempts: 3,
+			Delays:   []time.Duration{time.Minute, time.Hour},
+		},
+		producer:         s.producer,
+		logger:           nopLogger{},
+		republishBackoff: time.Millisecond,
+	}
+	s.calls = 0
+	s.err = errors.New("handler failed")
+}
+
+func TestRetry(t *testing.T) {
+	suite.Run(t, new(RetrySuite))
+}
+
+// handler - обработчик, который первые failures раз завершается ошибкой
+func (s *RetrySuite) handler(failures int) kafka.MessageHandler {
+	return func(context.Context, kafka.Message) error {
+		s.calls++
+		if s.calls <= failures {
+			return s.err
+		}
+		return nil
+	}
+}
+
+func (s *RetrySuite) message() kafka.Message {
+	return kafka.Message{
+		Key:       []byte("order-uuid"),
+		Value:     []byte("payload"),
+		Topic:     testTopic,
+		Partition: 2,
+		Offset:    42,
+		Headers:   map[string][]byte{"trace-id": []byte("abc")},
+	}
+}
+
+func (s *RetrySuite) TestTopics() {
+	s.Equal([]string{
+		"order.paid.retry.1", "order.paid.retry.2",
+		"order.refunded.retry.1", "order.refunded.retry.2",
+	}, s.retrier.topics([]string{"order.paid", "order.refunded"}))
+}
+
+func (s *RetrySuite) TestSuccess() {
+	s.Require().NoError(s.retrier.handle(s.ctx, s.message(), s.handler(0)))
+
+	s.Equal(1, s.calls)
+	s.Empty(s.producer.sent)
+}
+
+func (s *RetrySuite) TestSuccessWithinAttempts() {
+	s.Require().NoError(s.retrier.handle(s.ctx, s.message(), s.handler(2)))
+
+	s.Equal(3, s.calls)
+	s.Empty(s.producer.sent)
+}
+
+func (s *RetrySuite) TestFirstRetryTopic() {
+	startedAt := time.Now()
+
+	s.Require().NoError(s.retrier.handle(s.ctx, s.message(), s.handler(3)))
+
+	s.Equal(3, s.calls)
+	s.Require().Len(s.producer.sent, 1)
+	sent := s.producer.sent[0]
+
+	s.Equal("order.paid.retry.1", sent.Topic)
+	s.Equal(sarama.ByteEncoder("order-uuid"), sent.Key)
+	s.Equal(sarama.ByteEncoder("payload"), sent.Value)
+
+	headers := headersOf(sent)
+	s.Equal("abc", headers["trace-id"])
+	s.Equal(testTopic, headers[HeaderOriginalTopic])
+	s.Equal("2", headers[HeaderOriginalPartition])
+	s.Equal("42", headers[HeaderOriginalOffset])
+	s.Equal("1", headers[HeaderRetryAttempt])
+	s.Equal("handler failed", headers[HeaderError])
+
+	retryAt, err := strconv.ParseInt(headers[HeaderRetryAt], 10, 64)
+	s.Require().NoError(err)
+	s.WithinDuration(startedAt.Add(time.Minute), time.UnixMilli(retryAt), time.Second)
+}
+
+func (s *RetrySuite) TestNextRetryTopic() {
+	msg := s.message()
+	msg.Topic = "order.paid.retry.1"
+	msg.Offset = 7
+	msg.Headers = map[string][]byte{
+		"trace-id":              []byte("abc"),
+		HeaderOriginalTopic:     []byte(testTopic),
+		HeaderOriginalPartition: []byte("2"),
+		HeaderOriginalOffset:    []byte("42"),
+		HeaderRetryAttempt:      []byte("1"),
+		HeaderError:             []byte("previous error"),
+	}
+
+	s.Require().NoError(s.retrier.handle(s.ctx, msg, s.handler(3)))
+
+	s.Require().Len(s.producer.sent, 1)
+	s.Equal("order.paid.retry.2", s.producer.sent[0].Topic)
+
+	// Метаданные исходного сообщения не перезаписываются данными retry-топика
+	headers := headersOf(s.producer.sent[0])
+	s.Equal(testTopic, headers[HeaderOriginalTopic])
+	s.Equal("42", headers[HeaderOriginalOffset])
+	s.Equal("2", headers[HeaderRetryAttempt])
+	s.Equal("handler failed", headers[HeaderError])
+	s.Contains(headers, HeaderRetryAt)
+}
+
+func (s *RetrySuite) TestDLQAfterLastRetry() {
+	msg := s.message()
+	msg.Topic = "order.paid.retry.2"
+	msg.Headers = map[string][]byte{
+		HeaderOriginalTopic: []byte(testTopic),
+		HeaderRetryAttempt:  []byte("2"),
+		HeaderRetryAt:       []byte(strconv.FormatInt(time.Now().UnixMilli(), 10)),
+	}
+
+	s.Require().NoError(s.retrier.handle(s.ctx, msg, s.handler(3)))
+
+	s.Require().Len(s.producer.sent, 1)
+	s.Equal("order.paid.dlq", s.producer.sent[0].Topic)
+
+	headers := headersOf(s.producer.sent[0])
+	s.Equal("3", headers[HeaderRetryAttempt])
+	s.NotContains(headers, HeaderRetryAt)
+}
+
+func (s *RetrySuite) TestDLQWithoutDelays() {
+	s.retrier.policy.Delays = nil
+
+	s.Require().NoError(s.retrier.handle(s.ctx, s.message(), s.handler(3)))
+
+	s.Require().Len(s.producer.sent, 1)
+	s.Equal("order.paid.dlq", s.producer.sent[0].Topic)
+	s.Equal("1", headersOf(s.producer.sent[0])[HeaderRetryAttempt])
+}
+
+func (s *RetrySuite) TestSingleAttempt() {
+	s.retrier.policy.Attempts = 0
+
+	s.Require().NoError(s.retrier.handle(s.ctx, s.message(), s.handler(1)))
+
+	s.Equal(1, s.calls)
+	s.Len(s.producer.sent, 1)
+}
+
+func (s *RetrySuite) TestWaitsForRetryAt() {
+	ctx, cancel := context.WithTimeout(s.ctx, 20*time.Millisecond)
+	defer cancel()
+
+	msg := s.message()
+	msg.Headers[HeaderRetryAt] = []byte(strconv.FormatInt(time.Now().Add(time.Hour).UnixMilli(), 10))
+
+	// Сессия завершилась раньше, чем подошло время повтора: сообщение не обработано
+	s.ErrorIs(s.retrier.handle(ctx, msg, s.handler(0)), context.DeadlineExceeded)
+	s.Zero(s.calls)
+}
+
+func (s *RetrySuite) TestRepublishRetriedUntilSent() {
+	s.producer.failures = 2
+
+	s.Require().NoError(s.retrier.handle(s.ctx, s.message(), s.handler(3)))
+
+	// Обработчик не вызывается повторно, повторяется только переотправка
+	s.Equal(3, s.calls)
+	s.Equal(3, s.producer.attempts)
+	s.Len(s.producer.sent, 1)
+}
+
+func (s *RetrySuite) TestRepublishFailsUntilSessionEnds() {
+	s.producer.failures = -1
+	ctx, cancel := context.WithTimeout(s.ctx, 20*time.Millisecond)
+	defer cancel()
+
+	s.ErrorIs(s.retrier.handle(ctx, s.message(), s.handler(3)), context.DeadlineExceeded)
+	s.Greater(s.producer.attempts, 1)
+	s.Empty(s.producer.sent)
+}
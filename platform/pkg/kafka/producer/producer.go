@@ -42,7 +42,8 @@ func (p *producer) Send(ctx context.Context, key, value []byte) error {
 		zap.Int32("partition", partition),
 		zap.Int64("offset", offset),
 		zap.String("key", string(key)),
-		zap.String("value", string(value)),
+		// Содержимое не логируем: события могут нести секреты, например одноразовые токены
+		zap.Int("value_size", len(value)),
 	)
 
 	return nil
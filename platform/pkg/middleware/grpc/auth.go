@@ -9,6 +9,7 @@ import (
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 
+	"github.com/crafty-ezhik/rocket-factory/platform/pkg/token"
 	authV1 "github.com/crafty-ezhik/rocket-factory/shared/pkg/proto/auth/v1"
 	commonV1 "github.com/crafty-ezhik/rocket-factory/shared/pkg/proto/common/v1"
 )
@@ -30,15 +31,20 @@ const (
 // IAMClient это алиас для сгенерированного gRPC клиента
 type IAMClient = authV1.AuthServiceClient
 
-// AuthInterceptor interceptor для аутентификации gRPC запросов
+// AuthInterceptor interceptor для аутентификации и авторизации gRPC запросов
 type AuthInterceptor struct {
 	iamClient IAMClient
+	verifier  *token.Verifier
+	policy    Policy
 }
 
-// NewAuthInterceptor создает новый interceptor аутентификации
-func NewAuthInterceptor(iamClient IAMClient) *AuthInterceptor {
+// NewAuthInterceptor создает новый interceptor аутентификации с политикой доступа к методам.
+// Токены доступа проверяются локально через verifier, в IAM идут только непрозрачные UUID сессий
+func NewAuthInterceptor(iamClient IAMClient, verifier *token.Verifier, policy Policy) *AuthInterceptor {
 	return &AuthInterceptor{
 		iamClient: iamClient,
+		verifier:  verifier,
+		policy:    policy,
 	}
 }
 
@@ -55,6 +61,10 @@ func (i *AuthInterceptor) Unary() grpc.UnaryServerInterceptor {
 			return nil, err
 		}
 
+		if err = i.authorize(authCtx, info.FullMethod); err != nil {
+			return nil, err
+		}
+
 		return handler(authCtx, req)
 	}
 }
@@ -67,6 +77,15 @@ func (i *AuthInterceptor) authenticate(ctx context.Context) (context.Context, er
 		return nil, status.Error(codes.Unauthenticated, "missing metadata")
 	}
 
+	// Токен доступа проверяем локально, без обращения к IAM
+	if values := md.Get(AuthorizationMetadataKey); len(values) > 0 {
+		accessToken, ok := ParseBearer(values[0])
+		if !ok {
+			return nil, status.Error(codes.Unauthenticated, "malformed authorization metadata")
+		}
+		return i.authenticateToken(ctx, accessToken)
+	}
+
 	// Получаем session UUID из metadata
 	sessionUUIDs := md.Get(SessionUUIDMetadataKey)
 	if len(sessionUUIDs) == 0 {
@@ -92,6 +111,33 @@ func (i *AuthInterceptor) authenticate(ctx context.Context) (context.Context, er
 	return authCtx, nil
 }
 
+// authenticateToken проверяет подпись и срок действия токена и добавляет пользователя из него в контекст
+func (i *AuthInterceptor) authenticateToken(ctx context.Context, accessToken string) (context.Context, error) {
+	claims, err := i.verifier.Verify(ctx, accessToken)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, fmt.Sprintf("invalid access token: %v", err))
+	}
+
+	authCtx := context.WithValue(ctx, userContextKey, UserFromClaims(claims))
+	authCtx = context.WithValue(authCtx, sessionUUIDContextKey, claims.SessionUUID)
+	authCtx = AddAccessTokenToContext(authCtx, accessToken)
+	return authCtx, nil
+}
+
+// authorize проверяет, что пользователю выдано разрешение, которого требует политика для метода
+func (i *AuthInterceptor) authorize(ctx context.Context, fullMethod string) error {
+	permission, ok := i.policy.RequiredPermission(fullMethod)
+	if !ok {
+		return status.Error(codes.PermissionDenied, "method is not allowed by access policy")
+	}
+
+	user, _ := GetUserFromContext(ctx)
+	if permission != AnyAuthenticated && !HasPermission(user, permission) {
+		return status.Error(codes.PermissionDenied, fmt.Sprintf("permission %q is required", permission))
+	}
+	return nil
+}
+
 // GetUserFromContext извлекает пользователя из контекста
 func GetUserFromContext(ctx context.Context) (*commonV1.User, bool) {
 	user, ok := ctx.Value(userContextKey).(*commonV1.User)
@@ -114,8 +160,13 @@ func AddSessionUUIDToContext(ctx context.Context, sessionUUID string) context.Co
 	return context.WithValue(ctx, sessionUUIDContextKey, sessionUUID)
 }
 
-// ForwardSessionUUIDToGRPC добавляет session UUID из контекста в исходящие gRPC metadata
+// ForwardSessionUUIDToGRPC добавляет учетные данные из контекста в исходящие gRPC metadata:
+// токен доступа, если запрос аутентифицирован им, иначе session UUID
 func ForwardSessionUUIDToGRPC(ctx context.Context) context.Context {
+	if accessToken, ok := GetAccessTokenFromContext(ctx); ok && accessToken != "" {
+		return metadata.AppendToOutgoingContext(ctx, AuthorizationMetadataKey, BearerPrefix+accessToken)
+	}
+
 	sessionUUID, ok := GetSessionUUIDFromContext(ctx)
 	if !ok || sessionUUID == "" {
 		return ctx
@@ -0,0 +1,30 @@
+package grpc
+
+import (
+	"slices"
+
+	commonV1 "github.com/crafty-ezhik/rocket-factory/shared/pkg/proto/common/v1"
+)
+
+// AnyAuthenticated - разрешение в политике, с которым метод или маршрут доступен любому аутентифицированному пользователю
+const AnyAuthenticated = ""
+
+// Policy декларативная политика доступа: полное имя gRPC метода -> разрешение, необходимое для вызова.
+// Методы, отсутствующие в политике, запрещены: новый метод не станет доступен всем, пока для него не заведено правило
+type Policy map[string]string
+
+// RequiredPermission возвращает разрешение, необходимое для вызова метода, и false, если метода нет в политике
+func (p Policy) RequiredPermission(fullMethod string) (string, bool) {
+	permission, ok := p[fullMethod]
+	return permission, ok
+}
+
+// HasRole проверяет, что у пользователя есть роль
+func HasRole(user *commonV1.User, role string) bool {
+	return slices.Contains(user.GetRoles(), role)
+}
+
+// HasPermission проверяет, что ролям пользователя выдано разрешение
+func HasPermission(user *commonV1.User, permission string) bool {
+	return slices.Contains(user.GetPermissions(), permission)
+}
@@ -0,0 +1,81 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	commonV1 "github.com/crafty-ezhik/rocket-factory/shared/pkg/proto/common/v1"
+)
+
+const (
+	testMethodRead   = "/test.v1.TestService/Read"
+	testMethodWrite  = "/test.v1.TestService/Write"
+	testMethodPublic = "/test.v1.TestService/Public"
+	testMethodNew    = "/test.v1.TestService/New"
+)
+
+type PolicySuite struct {
+	suite.Suite
+	interceptor *AuthInterceptor
+}
+
+func (s *PolicySuite) SetupTest() {
+	s.interceptor = NewAuthInterceptor(nil, nil, Policy{
+		testMethodRead:   "things:read",
+		testMethodWrite:  "things:write",
+		testMethodPublic: AnyAuthenticated,
+	})
+}
+
+func TestPolicy(t *testing.T) {
+	suite.Run(t, new(PolicySuite))
+}
+
+func (s *PolicySuite) TestAuthorize() {
+	reader := &commonV1.User{Uuid: "reader", Permissions: []string{"things:read"}}
+
+	tests := []struct {
+		name         string
+		user         *commonV1.User
+		method       string
+		expectedCode codes.Code
+	}{
+		{name: "permission granted", user: reader, method: testMethodRead, expectedCode: codes.OK},
+		{name: "permission missing", user: reader, method: testMethodWrite, expectedCode: codes.PermissionDenied},
+		{name: "any authenticated", user: reader, method: testMethodPublic, expectedCode: codes.OK},
+		{name: "method without rule is denied", user: reader, method: testMethodNew, expectedCode: codes.PermissionDenied},
+		{name: "user without permissions", user: &commonV1.User{Uuid: "nobody"}, method: testMethodRead, expectedCode: codes.PermissionDenied},
+		{name: "no user in context", method: testMethodRead, expectedCode: codes.PermissionDenied},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			ctx := context.Background()
+			if tt.user != nil {
+				ctx = context.WithValue(ctx, userContextKey, tt.user)
+			}
+
+			err := s.interceptor.authorize(ctx, tt.method)
+			s.Equal(tt.expectedCode, status.Code(err))
+		})
+	}
+}
+
+func (s *PolicySuite) TestRequiredPermission() {
+	policy := Policy{testMethodRead: "things:read", testMethodPublic: AnyAuthenticated}
+
+	permission, ok := policy.RequiredPermission(testMethodRead)
+	s.True(ok)
+	s.Equal("things:read", permission)
+
+	permission, ok = policy.RequiredPermission(testMethodPublic)
+	s.True(ok)
+	s.Equal(AnyAuthenticated, permission)
+
+	_, ok = policy.RequiredPermission(testMethodNew)
+	s.False(ok)
+}
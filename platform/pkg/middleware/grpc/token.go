@@ -0,0 +1,81 @@
+package grpc
+
+import (
+	"context"
+	"crypto/ed25519"
+	"strings"
+	"time"
+
+	"github.com/crafty-ezhik/rocket-factory/platform/pkg/token"
+	authV1 "github.com/crafty-ezhik/rocket-factory/shared/pkg/proto/auth/v1"
+	commonV1 "github.com/crafty-ezhik/rocket-factory/shared/pkg/proto/common/v1"
+)
+
+const (
+	// AuthorizationMetadataKey ключ для передачи токена доступа в gRPC metadata
+	AuthorizationMetadataKey = "authorization"
+	// BearerPrefix префикс токена доступа в значении authorization
+	BearerPrefix = "Bearer "
+
+	// signingKeysRefreshInterval как часто перезагружать публичные ключи IAM
+	signingKeysRefreshInterval = 5 * time.Minute
+	// signingKeysMinRefreshInterval как часто можно перезагружать ключи при встрече неизвестного kid
+	signingKeysMinRefreshInterval = 10 * time.Second
+)
+
+// accessTokenContextKey ключ для хранения токена доступа в контексте
+const accessTokenContextKey contextKey = "access-token"
+
+// NewTokenVerifier создает проверку токенов доступа по публичным ключам из IAM GetSigningKeys
+func NewTokenVerifier(iamClient IAMClient, issuer string) *token.Verifier {
+	fetch := func(ctx context.Context) (map[string]ed25519.PublicKey, error) {
+		res, err := iamClient.GetSigningKeys(ctx, &authV1.GetSigningKeysRequest{})
+		if err != nil {
+			return nil, err
+		}
+
+		keys := make(map[string]ed25519.PublicKey, len(res.GetKeys()))
+		for _, key := range res.GetKeys() {
+			if key.GetAlgorithm() != token.Algorithm || len(key.GetPublicKey()) != ed25519.PublicKeySize {
+				continue
+			}
+			keys[key.GetKid()] = key.GetPublicKey()
+		}
+		return keys, nil
+	}
+
+	return token.NewVerifier(
+		token.NewCachedKeySet(fetch, signingKeysRefreshInterval, signingKeysMinRefreshInterval),
+		issuer,
+	)
+}
+
+// ParseBearer извлекает токен из значения "Bearer <token>"
+func ParseBearer(value string) (string, bool) {
+	if len(value) < len(BearerPrefix) || !strings.EqualFold(value[:len(BearerPrefix)], BearerPrefix) {
+		return "", false
+	}
+
+	accessToken := strings.TrimSpace(value[len(BearerPrefix):])
+	return accessToken, accessToken != ""
+}
+
+// UserFromClaims собирает пользователя из данных проверенного токена доступа
+func UserFromClaims(claims token.Claims) *commonV1.User {
+	return &commonV1.User{
+		Uuid:        claims.Subject,
+		Roles:       claims.Roles,
+		Permissions: claims.Permissions,
+	}
+}
+
+// GetAccessTokenFromContext извлекает токен доступа из контекста
+func GetAccessTokenFromContext(ctx context.Context) (string, bool) {
+	accessToken, ok := ctx.Value(accessTokenContextKey).(string)
+	return accessToken, ok
+}
+
+// AddAccessTokenToContext добавляет токен доступа в контекст
+func AddAccessTokenToContext(ctx context.Context, accessToken string) context.Context {
+	return context.WithValue(ctx, accessTokenContextKey, accessToken)
+}
@@ -5,24 +5,33 @@ import (
 	"net/http"
 
 	grpcAuth "github.com/crafty-ezhik/rocket-factory/platform/pkg/middleware/grpc"
+	"github.com/crafty-ezhik/rocket-factory/platform/pkg/token"
 	authV1 "github.com/crafty-ezhik/rocket-factory/shared/pkg/proto/auth/v1"
 	commonV1 "github.com/crafty-ezhik/rocket-factory/shared/pkg/proto/common/v1"
 )
 
-const SessionUUIDHeader = "X-Session-Uuid"
+const (
+	SessionUUIDHeader   = "X-Session-Uuid"
+	AuthorizationHeader = "Authorization"
+)
 
 // IAMClient это алиас для сгенерированного gRPC клиента
 type IAMClient = authV1.AuthServiceClient
 
-// AuthMiddleware middleware для аутентификации HTTP запросов
+// AuthMiddleware middleware для аутентификации и авторизации HTTP запросов
 type AuthMiddleware struct {
 	iamClient IAMClient
+	verifier  *token.Verifier
+	policy    RoutePolicy
 }
 
-// NewAuthMiddleware создает новый middleware аутентификации
-func NewAuthMiddleware(iamClient IAMClient) *AuthMiddleware {
+// NewAuthMiddleware создает новый middleware аутентификации с политикой доступа к маршрутам.
+// Токены доступа проверяются локально через verifier, в IAM идут только непрозрачные UUID сессий
+func NewAuthMiddleware(iamClient IAMClient, verifier *token.Verifier, policy RoutePolicy) *AuthMiddleware {
 	return &AuthMiddleware{
 		iamClient: iamClient,
+		verifier:  verifier,
+		policy:    policy,
 	}
 }
 
@@ -30,33 +39,71 @@ func NewAuthMiddleware(iamClient IAMClient) *AuthMiddleware {
 // Handle обрабатывает HTTP запрос с аутентификацией
 func (m *AuthMiddleware) Handle(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		// Извлекаем session UUID из заголовка
-		sessionUUID := r.Header.Get(SessionUUIDHeader)
-		if sessionUUID == "" {
-			writeErrorResponse(w, http.StatusUnauthorized, "MISSING_SESSION", "Authentication required")
+		ctx, user, ok := m.authenticate(w, r)
+		if !ok {
 			return
 		}
 
-		// Валидируем сессию через IAM сервис
-		whoamiRes, err := m.iamClient.Whoami(r.Context(), &authV1.WhoamiRequest{
-			SessionUuid: sessionUUID,
-		})
-		if err != nil {
-			writeErrorResponse(w, http.StatusUnauthorized, "INVALID_SESSION", "Authentication failed")
+		// Проверяем, что пользователю выдано разрешение, которого требует политика для маршрута.
+		// Маршруты без правила запрещены
+		if !m.policy.Allows(user, r.Method, r.URL.Path) {
+			writeErrorResponse(w, http.StatusForbidden, "PERMISSION_DENIED", "Access denied")
 			return
 		}
 
-		// Добавляем пользователя и session UUID в контекст используя функции из grpc middleware
-		ctx := r.Context()
-		ctx = grpcAuth.AddSessionUUIDToContext(ctx, sessionUUID)
-		// Также добавляем пользователя в контекст
-		ctx = context.WithValue(ctx, grpcAuth.GetUserContextKey(), whoamiRes.User)
-
 		// Передаем управление следующему handler
 		next.ServeHTTP(w, r.WithContext(ctx))
 	})
 }
 
+// authenticate проверяет учетные данные запроса и возвращает контекст с пользователем и session UUID.
+// При ошибке ответ уже записан в w
+func (m *AuthMiddleware) authenticate(w http.ResponseWriter, r *http.Request) (context.Context, *commonV1.User, bool) {
+	ctx := r.Context()
+
+	// Токен доступа проверяем локально, без обращения к IAM
+	if authorization := r.Header.Get(AuthorizationHeader); authorization != "" {
+		accessToken, ok := grpcAuth.ParseBearer(authorization)
+		if !ok {
+			writeErrorResponse(w, http.StatusUnauthorized, "INVALID_TOKEN", "Authentication failed")
+			return nil, nil, false
+		}
+
+		claims, err := m.verifier.Verify(ctx, accessToken)
+		if err != nil {
+			writeErrorResponse(w, http.StatusUnauthorized, "INVALID_TOKEN", "Authentication failed")
+			return nil, nil, false
+		}
+
+		user := grpcAuth.UserFromClaims(claims)
+		ctx = grpcAuth.AddSessionUUIDToContext(ctx, claims.SessionUUID)
+		ctx = grpcAuth.AddAccessTokenToContext(ctx, accessToken)
+		ctx = context.WithValue(ctx, grpcAuth.GetUserContextKey(), user)
+		return ctx, user, true
+	}
+
+	// Извлекаем session UUID из заголовка
+	sessionUUID := r.Header.Get(SessionUUIDHeader)
+	if sessionUUID == "" {
+		writeErrorResponse(w, http.StatusUnauthorized, "MISSING_SESSION", "Authentication required")
+		return nil, nil, false
+	}
+
+	// Валидируем сессию через IAM сервис
+	whoamiRes, err := m.iamClient.Whoami(ctx, &authV1.WhoamiRequest{
+		SessionUuid: sessionUUID,
+	})
+	if err != nil {
+		writeErrorResponse(w, http.StatusUnauthorized, "INVALID_SESSION", "Authentication failed")
+		return nil, nil, false
+	}
+
+	// Добавляем пользователя и session UUID в контекст используя функции из grpc middleware
+	ctx = grpcAuth.AddSessionUUIDToContext(ctx, sessionUUID)
+	ctx = context.WithValue(ctx, grpcAuth.GetUserContextKey(), whoamiRes.User)
+	return ctx, whoamiRes.GetUser(), true
+}
+
 // GetUserFromContext извлекает пользователя из контекста
 func GetUserFromContext(ctx context.Context) (*commonV1.User, bool) {
 	return grpcAuth.GetUserFromContext(ctx)
@@ -0,0 +1,74 @@
+package http
+
+import (
+	"strings"
+
+	grpcAuth "github.com/crafty-ezhik/rocket-factory/platform/pkg/middleware/grpc"
+	commonV1 "github.com/crafty-ezhik/rocket-factory/shared/pkg/proto/common/v1"
+)
+
+// RouteRule правило доступа к HTTP маршруту.
+// Сегменты пути вида {param} совпадают с любым значением, пустой Method совпадает с любым методом,
+// пустой Permission (AnyAuthenticated) открывает маршрут любому аутентифицированному пользователю
+type RouteRule struct {
+	Method     string
+	Path       string
+	Permission string
+}
+
+// AnyAuthenticated - разрешение правила, открывающее маршрут любому аутентифицированному пользователю
+const AnyAuthenticated = grpcAuth.AnyAuthenticated
+
+// RoutePolicy декларативная политика доступа к маршрутам. Применяется первое совпавшее правило,
+// маршруты без правил запрещены
+type RoutePolicy []RouteRule
+
+// RequiredPermission возвращает разрешение, необходимое для запроса, и false, если маршрута нет в политике
+func (p RoutePolicy) RequiredPermission(method, path string) (string, bool) {
+	for _, rule := range p {
+		if rule.Method != "" && rule.Method != method {
+			continue
+		}
+		if matchPath(rule.Path, path) {
+			return rule.Permission, true
+		}
+	}
+	return "", false
+}
+
+// Allows проверяет, что политика разрешает пользователю запрос
+func (p RoutePolicy) Allows(user *commonV1.User, method, path string) bool {
+	permission, ok := p.RequiredPermission(method, path)
+	if !ok {
+		return false
+	}
+	return permission == AnyAuthenticated || HasPermission(user, permission)
+}
+
+func matchPath(pattern, path string) bool {
+	patternSegments := strings.Split(strings.Trim(pattern, "/"), "/")
+	pathSegments := strings.Split(strings.Trim(path, "/"), "/")
+	if len(patternSegments) != len(pathSegments) {
+		return false
+	}
+
+	for i, segment := range patternSegments {
+		if strings.HasPrefix(segment, "{") && strings.HasSuffix(segment, "}") {
+			continue
+		}
+		if segment != pathSegments[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// HasRole проверяет, что у пользователя есть роль
+func HasRole(user *commonV1.User, role string) bool {
+	return grpcAuth.HasRole(user, role)
+}
+
+// HasPermission проверяет, что ролям пользователя выдано разрешение
+func HasPermission(user *commonV1.User, permission string) bool {
+	return grpcAuth.HasPermission(user, permission)
+}
@@ -0,0 +1,67 @@
+package http
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	commonV1 "github.com/crafty-ezhik/rocket-factory/shared/pkg/proto/common/v1"
+)
+
+type PolicySuite struct {
+	suite.Suite
+	policy RoutePolicy
+}
+
+func (s *PolicySuite) SetupTest() {
+	s.policy = RoutePolicy{
+		{Method: http.MethodGet, Path: "/api/v1/things", Permission: "things:read"},
+		{Method: http.MethodPost, Path: "/api/v1/things", Permission: "things:write"},
+		{Method: http.MethodGet, Path: "/api/v1/things/{thing_uuid}", Permission: "things:read"},
+		{Path: "/api/v1/me", Permission: AnyAuthenticated},
+	}
+}
+
+func TestPolicy(t *testing.T) {
+	suite.Run(t, new(PolicySuite))
+}
+
+func (s *PolicySuite) TestAllows() {
+	reader := &commonV1.User{Uuid: "reader", Permissions: []string{"things:read"}}
+
+	tests := []struct {
+		name     string
+		user     *commonV1.User
+		method   string
+		path     string
+		expected bool
+	}{
+		{name: "permission granted", user: reader, method: http.MethodGet, path: "/api/v1/things", expected: true},
+		{name: "path parameter", user: reader, method: http.MethodGet, path: "/api/v1/things/42", expected: true},
+		{name: "trailing slash", user: reader, method: http.MethodGet, path: "/api/v1/things/", expected: true},
+		{name: "permission missing", user: reader, method: http.MethodPost, path: "/api/v1/things", expected: false},
+		{name: "any method any authenticated", user: reader, method: http.MethodDelete, path: "/api/v1/me", expected: true},
+		{name: "method without rule is denied", user: reader, method: http.MethodDelete, path: "/api/v1/things/42", expected: false},
+		{name: "route without rule is denied", user: reader, method: http.MethodGet, path: "/api/v1/things/42/secret", expected: false},
+		{name: "unknown route is denied", user: reader, method: http.MethodGet, path: "/debug/pprof", expected: false},
+		{name: "no user", method: http.MethodGet, path: "/api/v1/things", expected: false},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			s.Equal(tt.expected, s.policy.Allows(tt.user, tt.method, tt.path))
+		})
+	}
+}
+
+func (s *PolicySuite) TestFirstMatchingRuleWins() {
+	policy := RoutePolicy{
+		{Method: http.MethodGet, Path: "/api/v1/things/special", Permission: "things:special"},
+		{Method: http.MethodGet, Path: "/api/v1/things/{thing_uuid}", Permission: "things:read"},
+	}
+
+	permission, ok := policy.RequiredPermission(http.MethodGet, "/api/v1/things/special")
+	s.True(ok)
+	s.Equal("things:special", permission)
+}
@@ -0,0 +1,75 @@
+package secretbox
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+)
+
+// KeySize - размер ключа AES-256 в байтах
+const KeySize = 32
+
+var (
+	ErrInvalidKey = errors.New("secretbox: key must be 32 bytes")
+	// ErrDecrypt - шифротекст поврежден, зашифрован другим ключом или с другими associatedData
+	ErrDecrypt = errors.New("secretbox: decryption failed")
+)
+
+// Box шифрует секреты для хранения в БД: AES-256-GCM со случайным nonce перед шифротекстом
+type Box struct {
+	aead cipher.AEAD
+}
+
+// New создает Box с ключом из KeySize байт
+func New(key []byte) (*Box, error) {
+	if len(key) != KeySize {
+		return nil, ErrInvalidKey
+	}
+
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, fmt.Errorf("secretbox: %w", err)
+	}
+
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, fmt.Errorf("secretbox: %w", err)
+	}
+	return &Box{aead: aead}, nil
+}
+
+// NewFromBase64 создает Box с ключом в base64, как он задается в переменных окружения
+func NewFromBase64(encodedKey string) (*Box, error) {
+	key, err := base64.StdEncoding.DecodeString(encodedKey)
+	if err != nil {
+		return nil, ErrInvalidKey
+	}
+	return New(key)
+}
+
+// Seal шифрует plaintext. associatedData не шифруется, но привязывает шифротекст к записи:
+// расшифровать его можно только с теми же associatedData
+func (b *Box) Seal(plaintext, associatedData []byte) ([]byte, error) {
+	nonce := make([]byte, b.aead.NonceSize(), b.aead.NonceSize()+len(plaintext)+b.aead.Overhead())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, fmt.Errorf("secretbox: generate nonce: %w", err)
+	}
+	return b.aead.Seal(nonce, nonce, plaintext, associatedData), nil
+}
+
+// Open расшифровывает результат Seal
+func (b *Box) Open(ciphertext, associatedData []byte) ([]byte, error) {
+	nonceSize := b.aead.NonceSize()
+	if len(ciphertext) < nonceSize {
+		return nil, ErrDecrypt
+	}
+
+	plaintext, err := b.aead.Open(nil, ciphertext[:nonceSize], ciphertext[nonceSize:], associatedData)
+	if err != nil {
+		return nil, ErrDecrypt
+	}
+	return plaintext, nil
+}
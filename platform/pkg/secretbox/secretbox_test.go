@@ -0,0 +1,73 @@
+package secretbox
+
+import (
+	"bytes"
+	"encoding/base64"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func testBox(t *testing.T, fill byte) *Box {
+	t.Helper()
+
+	box, err := New(bytes.Repeat([]byte{fill}, KeySize))
+	require.NoError(t, err)
+	return box
+}
+
+func TestSealOpen(t *testing.T) {
+	box := testBox(t, 1)
+	plaintext := []byte("00000000-0000-0000-0000-000000000001")
+
+	first, err := box.Seal(plaintext, []byte("chat:1"))
+	require.NoError(t, err)
+	second, err := box.Seal(plaintext, []byte("chat:1"))
+	require.NoError(t, err)
+
+	// Случайный nonce: одинаковые секреты не дают одинаковых шифротекстов
+	require.NotEqual(t, first, second)
+	require.NotContains(t, string(first), string(plaintext))
+
+	opened, err := box.Open(first, []byte("chat:1"))
+	require.NoError(t, err)
+	require.Equal(t, plaintext, opened)
+}
+
+func TestOpenFailure(t *testing.T) {
+	box := testBox(t, 1)
+	sealed, err := box.Seal([]byte("secret"), []byte("chat:1"))
+	require.NoError(t, err)
+
+	tampered := bytes.Clone(sealed)
+	tampered[len(tampered)-1] ^= 0xff
+
+	tests := []struct {
+		name           string
+		box            *Box
+		ciphertext     []byte
+		associatedData []byte
+	}{
+		{name: "other key", box: testBox(t, 2), ciphertext: sealed, associatedData: []byte("chat:1")},
+		{name: "other associated data", box: box, ciphertext: sealed, associatedData: []byte("chat:2")},
+		{name: "tampered ciphertext", box: box, ciphertext: tampered, associatedData: []byte("chat:1")},
+		{name: "too short", box: box, ciphertext: sealed[:4], associatedData: []byte("chat:1")},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := tt.box.Open(tt.ciphertext, tt.associatedData)
+			require.ErrorIs(t, err, ErrDecrypt)
+		})
+	}
+}
+
+func TestNewInvalidKey(t *testing.T) {
+	_, err := New([]byte("short"))
+	require.ErrorIs(t, err, ErrInvalidKey)
+
+	_, err = NewFromBase64("not base64!")
+	require.ErrorIs(t, err, ErrInvalidKey)
+
+	_, err = NewFromBase64(base64.StdEncoding.EncodeToString(bytes.Repeat([]byte{1}, KeySize)))
+	require.NoError(t, err)
+}
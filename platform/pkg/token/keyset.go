@@ -0,0 +1,87 @@
+package token
+
+import (
+	"context"
+	"crypto/ed25519"
+	"sync"
+	"time"
+)
+
+// FetchKeysFunc загружает актуальный набор публичных ключей, например через IAM GetSigningKeys
+type FetchKeysFunc func(ctx context.Context) (map[string]ed25519.PublicKey, error)
+
+// CachedKeySet кеширует публичные ключи и перезагружает их раз в refreshInterval.
+// Неизвестный идентификатор ключа (после ротации) вызывает внеочередную загрузку,
+// но не чаще раза в minRefreshInterval
+type CachedKeySet struct {
+	fetch              FetchKeysFunc
+	refreshInterval    time.Duration
+	minRefreshInterval time.Duration
+
+	mu          sync.RWMutex
+	keys        map[string]ed25519.PublicKey
+	fetchedAt   time.Time
+	attemptedAt time.Time
+}
+
+// NewCachedKeySet создает CachedKeySet
+func NewCachedKeySet(fetch FetchKeysFunc, refreshInterval, minRefreshInterval time.Duration) *CachedKeySet {
+	return &CachedKeySet{
+		fetch:              fetch,
+		refreshInterval:    refreshInterval,
+		minRefreshInterval: minRefreshInterval,
+	}
+}
+
+// Key возвращает публичный ключ по его идентификатору
+func (s *CachedKeySet) Key(ctx context.Context, keyID string) (ed25519.PublicKey, error) {
+	s.mu.RLock()
+	key, ok := s.keys[keyID]
+	fetchedAt, attemptedAt := s.fetchedAt, s.attemptedAt
+	s.mu.RUnlock()
+
+	if ok && time.Since(fetchedAt) < s.refreshInterval {
+		return key, nil
+	}
+	if time.Since(attemptedAt) < s.minRefreshInterval {
+		if ok {
+			return key, nil
+		}
+		return nil, ErrUnknownKey
+	}
+
+	if err := s.refresh(ctx); err != nil {
+		// Если IAM недоступен, продолжаем проверять токены ранее загруженными ключами
+		if ok {
+			return key, nil
+		}
+		return nil, err
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	key, ok = s.keys[keyID]
+	if !ok {
+		return nil, ErrUnknownKey
+	}
+	return key, nil
+}
+
+func (s *CachedKeySet) refresh(ctx context.Context) error {
+	s.mu.Lock()
+	s.attemptedAt = time.Now()
+	s.mu.Unlock()
+
+	keys, err := s.fetch(ctx)
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.keys = keys
+	s.fetchedAt = time.Now()
+	return nil
+}
@@ -0,0 +1,113 @@
+package token
+
+import (
+	"context"
+	"crypto/ed25519"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type KeySetSuite struct {
+	suite.Suite
+	ctx     context.Context //nolint:containedctx
+	keys    map[string]ed25519.PublicKey
+	err     error
+	fetches int
+}
+
+func (s *KeySetSuite) SetupTest() {
+	s.ctx = context.Background()
+	s.keys = map[string]ed25519.PublicKey{"key-1": s.newKey()}
+	s.err = nil
+	s.fetches = 0
+}
+
+func TestKeySet(t *testing.T) {
+	suite.Run(t, new(KeySetSuite))
+}
+
+func (s *KeySetSuite) newKey() ed25519.PublicKey {
+	publicKey, _, err := ed25519.GenerateKey(nil)
+	s.Require().NoError(err)
+	return publicKey
+}
+
+func (s *KeySetSuite) fetch(context.Context) (map[string]ed25519.PublicKey, error) {
+	s.fetches++
+	if s.err != nil {
+		return nil, s.err
+	}
+
+	keys := make(map[string]ed25519.PublicKey, len(s.keys))
+	for id, key := range s.keys {
+		keys[id] = key
+	}
+	return keys, nil
+}
+
+func (s *KeySetSuite) TestCachesKeys() {
+	keySet := NewCachedKeySet(s.fetch, time.Hour, time.Hour)
+
+	for range 3 {
+		key, err := keySet.Key(s.ctx, "key-1")
+		s.Require().NoError(err)
+		s.Equal(s.keys["key-1"], key)
+	}
+	s.Equal(1, s.fetches)
+}
+
+func (s *KeySetSuite) TestUnknownKeyTriggersRefresh() {
+	keySet := NewCachedKeySet(s.fetch, time.Hour, 0)
+
+	_, err := keySet.Key(s.ctx, "key-1")
+	s.Require().NoError(err)
+
+	// После ротации в IAM появился новый ключ
+	s.keys["key-2"] = s.newKey()
+
+	key, err := keySet.Key(s.ctx, "key-2")
+	s.Require().NoError(err)
+	s.Equal(s.keys["key-2"], key)
+	s.Equal(2, s.fetches)
+}
+
+func (s *KeySetSuite) TestUnknownKeyRefreshIsRateLimited() {
+	keySet := NewCachedKeySet(s.fetch, time.Hour, time.Hour)
+
+	_, err := keySet.Key(s.ctx, "key-1")
+	s.Require().NoError(err)
+
+	for range 3 {
+		_, err = keySet.Key(s.ctx, "forged")
+		s.ErrorIs(err, ErrUnknownKey)
+	}
+	s.Equal(1, s.fetches)
+}
+
+func (s *KeySetSuite) TestUnknownKeyAfterRefresh() {
+	keySet := NewCachedKeySet(s.fetch, time.Hour, 0)
+
+	_, err := keySet.Key(s.ctx, "forged")
+	s.ErrorIs(err, ErrUnknownKey)
+	s.Equal(1, s.fetches)
+}
+
+func (s *KeySetSuite) TestStaleKeysUsedWhenFetchFails() {
+	keySet := NewCachedKeySet(s.fetch, 0, 0)
+
+	_, err := keySet.Key(s.ctx, "key-1")
+	s.Require().NoError(err)
+
+	// IAM недоступен: продолжаем проверять токены загруженными ранее ключами
+	s.err = errors.New("iam unavailable")
+	key, err := keySet.Key(s.ctx, "key-1")
+	s.Require().NoError(err)
+	s.Equal(s.keys["key-1"], key)
+	s.Equal(2, s.fetches)
+
+	_, err = keySet.Key(s.ctx, "key-2")
+	s.ErrorIs(err, s.err)
+}
@@ -0,0 +1,52 @@
+package token
+
+import (
+	"crypto/ed25519"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+)
+
+// Signer подписывает токены доступа ключом Ed25519
+type Signer struct {
+	keyID      string
+	privateKey ed25519.PrivateKey
+}
+
+// NewSigner создает Signer. keyID попадает в заголовок токена, чтобы проверяющая сторона нашла публичный ключ
+func NewSigner(keyID string, privateKey ed25519.PrivateKey) *Signer {
+	return &Signer{
+		keyID:      keyID,
+		privateKey: privateKey,
+	}
+}
+
+// Sign возвращает подписанный токен в компактном формате JWT
+func (s *Signer) Sign(claims Claims) (string, error) {
+	headerJSON, err := json.Marshal(header{
+		Algorithm: Algorithm,
+		Type:      "JWT",
+		KeyID:     s.keyID,
+	})
+	if err != nil {
+		return "", fmt.Errorf("marshal header: %w", err)
+	}
+
+	claimsJSON, err := json.Marshal(claims)
+	if err != nil {
+		return "", fmt.Errorf("marshal claims: %w", err)
+	}
+
+	signingInput := encode(headerJSON) + "." + encode(claimsJSON)
+	signature := ed25519.Sign(s.privateKey, []byte(signingInput))
+
+	return signingInput + "." + encode(signature), nil
+}
+
+func encode(data []byte) string {
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func decode(data string) ([]byte, error) {
+	return base64.RawURLEncoding.DecodeString(data)
+}
@@ -0,0 +1,48 @@
+package token
+
+import (
+	"errors"
+	"time"
+)
+
+// Algorithm алгоритм подписи токенов доступа
+const Algorithm = "EdDSA"
+
+// clockSkew допустимое расхождение часов IAM и проверяющего сервиса
+const clockSkew = 30 * time.Second
+
+var (
+	ErrInvalidToken     = errors.New("invalid access token")
+	ErrTokenExpired     = errors.New("access token expired")
+	ErrTokenNotYetValid = errors.New("access token is not yet valid")
+	ErrUnknownKey       = errors.New("unknown signing key")
+)
+
+// Claims данные, которые IAM передает в токене доступа
+type Claims struct {
+	Issuer      string   `json:"iss"`
+	Subject     string   `json:"sub"` // UUID пользователя
+	SessionUUID string   `json:"sid"` // UUID сессии, при входе в которую выпущен токен
+	Roles       []string `json:"roles,omitempty"`
+	Permissions []string `json:"permissions,omitempty"`
+	IssuedAt    int64    `json:"iat"`
+	NotBefore   int64    `json:"nbf,omitempty"`
+	ExpiresAt   int64    `json:"exp"`
+}
+
+// Expired истек ли токен к моменту now
+func (c Claims) Expired(now time.Time) bool {
+	return !now.Before(time.Unix(c.ExpiresAt, 0))
+}
+
+// NotYetValid выпущен ли токен или начинает ли он действовать позже now с учетом расхождения часов
+func (c Claims) NotYetValid(now time.Time) bool {
+	validFrom := max(c.IssuedAt, c.NotBefore)
+	return now.Add(clockSkew).Before(time.Unix(validFrom, 0))
+}
+
+type header struct {
+	Algorithm string `json:"alg"`
+	Type      string `json:"typ"`
+	KeyID     string `json:"kid"`
+}
@@ -0,0 +1,87 @@
+package token
+
+import (
+	"context"
+	"crypto/ed25519"
+	"encoding/json"
+	"strings"
+	"time"
+)
+
+// KeySet источник публичных ключей для проверки подписи
+type KeySet interface {
+	// Key возвращает публичный ключ по его идентификатору или ErrUnknownKey
+	Key(ctx context.Context, keyID string) (ed25519.PublicKey, error)
+}
+
+// Verifier проверяет токены доступа локально, без обращения к IAM
+type Verifier struct {
+	keys   KeySet
+	issuer string
+	now    func() time.Time
+}
+
+// NewVerifier создает Verifier. Пустой issuer отключает проверку издателя
+func NewVerifier(keys KeySet, issuer string) *Verifier {
+	return &Verifier{
+		keys:   keys,
+		issuer: issuer,
+		now:    time.Now,
+	}
+}
+
+// Verify проверяет подпись, издателя и срок действия токена и возвращает его данные
+func (v *Verifier) Verify(ctx context.Context, token string) (Claims, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return Claims{}, ErrInvalidToken
+	}
+
+	headerJSON, err := decode(parts[0])
+	if err != nil {
+		return Claims{}, ErrInvalidToken
+	}
+
+	var h header
+	if err = json.Unmarshal(headerJSON, &h); err != nil || h.Algorithm != Algorithm {
+		return Claims{}, ErrInvalidToken
+	}
+
+	publicKey, err := v.keys.Key(ctx, h.KeyID)
+	if err != nil {
+		return Claims{}, err
+	}
+
+	signature, err := decode(parts[2])
+	if err != nil {
+		return Claims{}, ErrInvalidToken
+	}
+
+	if !ed25519.Verify(publicKey, []byte(parts[0]+"."+parts[1]), signature) {
+		return Claims{}, ErrInvalidToken
+	}
+
+	claimsJSON, err := decode(parts[1])
+	if err != nil {
+		return Claims{}, ErrInvalidToken
+	}
+
+	var claims Claims
+	if err = json.Unmarshal(claimsJSON, &claims); err != nil {
+		return Claims{}, ErrInvalidToken
+	}
+
+	if v.issuer != "" && claims.Issuer != v.issuer {
+		return Claims{}, ErrInvalidToken
+	}
+
+	now := v.now()
+	if claims.Expired(now) {
+		return Claims{}, ErrTokenExpired
+	}
+	if claims.NotYetValid(now) {
+		return Claims{}, ErrTokenNotYetValid
+	}
+
+	return claims, nil
+}
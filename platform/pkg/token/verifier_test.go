@@ -0,0 +1,221 @@
+package token
+
+import (
+	"context"
+	"crypto/ed25519"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+const (
+	testIssuer = "iam"
+	testKeyID  = "key-1"
+)
+
+// staticKeySet - набор ключей без обращения к IAM
+type staticKeySet map[string]ed25519.PublicKey
+
+func (s staticKeySet) Key(_ context.Context, keyID string) (ed25519.PublicKey, error) {
+	key, ok := s[keyID]
+	if !ok {
+		return nil, ErrUnknownKey
+	}
+	return key, nil
+}
+
+type VerifierSuite struct {
+	suite.Suite
+	ctx        context.Context //nolint:containedctx
+	now        time.Time
+	privateKey ed25519.PrivateKey
+	verifier   *Verifier
+}
+
+func (s *VerifierSuite) SetupTest() {
+	s.ctx = context.Background()
+	s.now = time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	publicKey, privateKey, err := ed25519.GenerateKey(nil)
+	s.Require().NoError(err)
+	s.privateKey = privateKey
+
+	s.verifier = NewVerifier(staticKeySet{testKeyID: publicKey}, testIssuer)
+	s.verifier.now = func() time.Time { return s.now }
+}
+
+func TestVerifier(t *testing.T) {
+	suite.Run(t, new(VerifierSuite))
+}
+
+func (s *VerifierSuite) claims() Claims {
+	return Claims{
+		Issuer:      testIssuer,
+		Subject:     "user-uuid",
+		SessionUUID: "session-uuid",
+		Roles:       []string{"admin"},
+		Permissions: []string{"orders:read"},
+		IssuedAt:    s.now.Unix(),
+		ExpiresAt:   s.now.Add(time.Minute).Unix(),
+	}
+}
+
+func (s *VerifierSuite) sign(claims Claims) string {
+	value, err := NewSigner(testKeyID, s.privateKey).Sign(claims)
+	s.Require().NoError(err)
+	return value
+}
+
+// withHeader - подменяет заголовок токена, сохраняя остальные части
+func withHeader(value string, h header) string {
+	headerJSON, _ := json.Marshal(h) //nolint:errchkjson
+	parts := strings.Split(value, ".")
+	parts[0] = encode(headerJSON)
+	return strings.Join(parts, ".")
+}
+
+func (s *VerifierSuite) TestRoundTrip() {
+	claims := s.claims()
+
+	got, err := s.verifier.Verify(s.ctx, s.sign(claims))
+	s.Require().NoError(err)
+	s.Equal(claims, got)
+}
+
+func (s *VerifierSuite) TestAlgorithmNone() {
+	value := s.sign(s.claims())
+	parts := strings.Split(withHeader(value, header{Algorithm: "none", Type: "JWT", KeyID: testKeyID}), ".")
+
+	// Токен без подписи
+	_, err := s.verifier.Verify(s.ctx, parts[0]+"."+parts[1]+".")
+	s.ErrorIs(err, ErrInvalidToken)
+}
+
+func (s *VerifierSuite) TestOtherAlgorithm() {
+	value := withHeader(s.sign(s.claims()), header{Algorithm: "HS256", Type: "JWT", KeyID: testKeyID})
+
+	_, err := s.verifier.Verify(s.ctx, value)
+	s.ErrorIs(err, ErrInvalidToken)
+}
+
+func (s *VerifierSuite) TestUnknownKey() {
+	_, otherKey, err := ed25519.GenerateKey(nil)
+	s.Require().NoError(err)
+	value, err := NewSigner("key-2", otherKey).Sign(s.claims())
+	s.Require().NoError(err)
+
+	_, err = s.verifier.Verify(s.ctx, value)
+	s.ErrorIs(err, ErrUnknownKey)
+}
+
+func (s *VerifierSuite) TestTamperedSignature() {
+	value := s.sign(s.claims())
+	parts := strings.Split(value, ".")
+
+	signature, err := decode(parts[2])
+	s.Require().NoError(err)
+	signature[0] ^= 0xff
+	parts[2] = encode(signature)
+
+	_, err = s.verifier.Verify(s.ctx, strings.Join(parts, "."))
+	s.ErrorIs(err, ErrInvalidToken)
+}
+
+func (s *VerifierSuite) TestTamperedClaims() {
+	value := s.sign(s.claims())
+	parts := strings.Split(value, ".")
+
+	escalated := s.claims()
+	escalated.Permissions = append(escalated.Permissions, "users:manage")
+	claimsJSON, err := json.Marshal(escalated)
+	s.Require().NoError(err)
+	parts[1] = encode(claimsJSON)
+
+	_, err = s.verifier.Verify(s.ctx, strings.Join(parts, "."))
+	s.ErrorIs(err, ErrInvalidToken)
+}
+
+func (s *VerifierSuite) TestSignedByOtherKey() {
+	_, otherKey, err := ed25519.GenerateKey(nil)
+	s.Require().NoError(err)
+
+	// Ключ с тем же идентификатором, но не тот, которым подписывает IAM
+	value, err := NewSigner(testKeyID, otherKey).Sign(s.claims())
+	s.Require().NoError(err)
+
+	_, err = s.verifier.Verify(s.ctx, value)
+	s.ErrorIs(err, ErrInvalidToken)
+}
+
+func (s *VerifierSuite) TestMalformed() {
+	for _, value := range []string{
+		"",
+		"a.b",
+		"a.b.c.d",
+		"!!!.e30.sig",
+		encode([]byte("not json")) + ".e30.sig",
+	} {
+		_, err := s.verifier.Verify(s.ctx, value)
+		s.ErrorIs(err, ErrInvalidToken, value)
+	}
+}
+
+func (s *VerifierSuite) TestIssuerMismatch() {
+	claims := s.claims()
+	claims.Issuer = "someone-else"
+
+	_, err := s.verifier.Verify(s.ctx, s.sign(claims))
+	s.ErrorIs(err, ErrInvalidToken)
+}
+
+func (s *VerifierSuite) TestIssuerCheckDisabled() {
+	claims := s.claims()
+	claims.Issuer = "someone-else"
+	s.verifier.issuer = ""
+
+	_, err := s.verifier.Verify(s.ctx, s.sign(claims))
+	s.NoError(err)
+}
+
+func (s *VerifierSuite) TestExpired() {
+	value := s.sign(s.claims())
+
+	s.now = s.now.Add(time.Minute)
+	_, err := s.verifier.Verify(s.ctx, value)
+	s.ErrorIs(err, ErrTokenExpired)
+}
+
+func (s *VerifierSuite) TestIssuedInFuture() {
+	claims := s.claims()
+	claims.IssuedAt = s.now.Add(time.Minute).Unix()
+	claims.ExpiresAt = s.now.Add(2 * time.Minute).Unix()
+
+	_, err := s.verifier.Verify(s.ctx, s.sign(claims))
+	s.ErrorIs(err, ErrTokenNotYetValid)
+}
+
+func (s *VerifierSuite) TestNotBefore() {
+	claims := s.claims()
+	claims.NotBefore = s.now.Add(time.Minute).Unix()
+	claims.ExpiresAt = s.now.Add(2 * time.Minute).Unix()
+	value := s.sign(claims)
+
+	_, err := s.verifier.Verify(s.ctx, value)
+	s.ErrorIs(err, ErrTokenNotYetValid)
+
+	s.now = s.now.Add(time.Minute)
+	_, err = s.verifier.Verify(s.ctx, value)
+	s.NoError(err)
+}
+
+func (s *VerifierSuite) TestClockSkew() {
+	claims := s.claims()
+	claims.IssuedAt = s.now.Add(clockSkew).Unix()
+
+	// Часы IAM немного спешат
+	_, err := s.verifier.Verify(s.ctx, s.sign(claims))
+	s.NoError(err)
+}
@@ -13,6 +13,10 @@ type OrderGetRes interface {
 	orderGetRes()
 }
 
+type OrderListRes interface {
+	orderListRes()
+}
+
 type OrderPayRes interface {
 	orderPayRes()
 }
@@ -9,5 +9,6 @@ const (
 	OrderCancelOperation OperationName = "OrderCancel"
 	OrderCreateOperation OperationName = "OrderCreate"
 	OrderGetOperation    OperationName = "OrderGet"
+	OrderListOperation   OperationName = "OrderList"
 	OrderPayOperation    OperationName = "OrderPay"
 )
@@ -3,8 +3,10 @@
 package order_v1
 
 import (
+	"fmt"
 	"net/http"
 	"net/url"
+	"time"
 
 	"github.com/go-faster/errors"
 	"github.com/google/uuid"
@@ -17,8 +19,9 @@ import (
 
 // OrderCancelParams is parameters of OrderCancel operation.
 type OrderCancelParams struct {
-	// UUID сессии пользователя для аутентификации.
-	XSessionUUID uuid.UUID
+	// UUID сессии пользователя для аутентификации. Не нужен,
+	// если передан токен доступа в заголовке Authorization (Bearer).
+	XSessionUUID OptUUID `json:",omitempty,omitzero"`
 	// Уникальный идентификатор заказа.
 	OrderUUID string
 }
@@ -29,7 +32,9 @@ func unpackOrderCancelParams(packed middleware.Parameters) (params OrderCancelPa
 			Name: "X-Session-Uuid",
 			In:   "header",
 		}
-		params.XSessionUUID = packed[key].(uuid.UUID)
+		if v, ok := packed[key]; ok {
+			params.XSessionUUID = v.(OptUUID)
+		}
 	}
 	{
 		key := middleware.ParameterKey{
@@ -51,23 +56,28 @@ func decodeOrderCancelParams(args [1]string, argsEscaped bool, r *http.Request)
 		}
 		if err := h.HasParam(cfg); err == nil {
 			if err := h.DecodeParam(cfg, func(d uri.Decoder) error {
-				val, err := d.DecodeValue()
-				if err != nil {
-					return err
-				}
+				var paramsDotXSessionUUIDVal uuid.UUID
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
 
-				c, err := conv.ToUUID(val)
-				if err != nil {
+					c, err := conv.ToUUID(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotXSessionUUIDVal = c
+					return nil
+				}(); err != nil {
 					return err
 				}
-
-				params.XSessionUUID = c
+				params.XSessionUUID.SetTo(paramsDotXSessionUUIDVal)
 				return nil
 			}); err != nil {
 				return err
 			}
-		} else {
-			return err
 		}
 		return nil
 	}(); err != nil {
@@ -127,8 +137,9 @@ func decodeOrderCancelParams(args [1]string, argsEscaped bool, r *http.Request)
 
 // OrderCreateParams is parameters of OrderCreate operation.
 type OrderCreateParams struct {
-	// UUID сессии пользователя для аутентификации.
-	XSessionUUID uuid.UUID
+	// UUID сессии пользователя для аутентификации. Не нужен,
+	// если передан токен доступа в заголовке Authorization (Bearer).
+	XSessionUUID OptUUID `json:",omitempty,omitzero"`
 }
 
 func unpackOrderCreateParams(packed middleware.Parameters) (params OrderCreateParams) {
@@ -137,7 +148,9 @@ func unpackOrderCreateParams(packed middleware.Parameters) (params OrderCreatePa
 			Name: "X-Session-Uuid",
 			In:   "header",
 		}
-		params.XSessionUUID = packed[key].(uuid.UUID)
+		if v, ok := packed[key]; ok {
+			params.XSessionUUID = v.(OptUUID)
+		}
 	}
 	return params
 }
@@ -152,23 +165,28 @@ func decodeOrderCreateParams(args [0]string, argsEscaped bool, r *http.Request)
 		}
 		if err := h.HasParam(cfg); err == nil {
 			if err := h.DecodeParam(cfg, func(d uri.Decoder) error {
-				val, err := d.DecodeValue()
-				if err != nil {
-					return err
-				}
+				var paramsDotXSessionUUIDVal uuid.UUID
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
 
-				c, err := conv.ToUUID(val)
-				if err != nil {
+					c, err := conv.ToUUID(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotXSessionUUIDVal = c
+					return nil
+				}(); err != nil {
 					return err
 				}
-
-				params.XSessionUUID = c
+				params.XSessionUUID.SetTo(paramsDotXSessionUUIDVal)
 				return nil
 			}); err != nil {
 				return err
 			}
-		} else {
-			return err
 		}
 		return nil
 	}(); err != nil {
@@ -183,8 +201,9 @@ func decodeOrderCreateParams(args [0]string, argsEscaped bool, r *http.Request)
 
 // OrderGetParams is parameters of OrderGet operation.
 type OrderGetParams struct {
-	// UUID сессии пользователя для аутентификации.
-	XSessionUUID uuid.UUID
+	// UUID сессии пользователя для аутентификации. Не нужен,
+	// если передан токен доступа в заголовке Authorization (Bearer).
+	XSessionUUID OptUUID `json:",omitempty,omitzero"`
 	// Уникальный идентификатор заказа.
 	OrderUUID string
 }
@@ -195,7 +214,9 @@ func unpackOrderGetParams(packed middleware.Parameters) (params OrderGetParams)
 			Name: "X-Session-Uuid",
 			In:   "header",
 		}
-		params.XSessionUUID = packed[key].(uuid.UUID)
+		if v, ok := packed[key]; ok {
+			params.XSessionUUID = v.(OptUUID)
+		}
 	}
 	{
 		key := middleware.ParameterKey{
@@ -217,23 +238,28 @@ func decodeOrderGetParams(args [1]string, argsEscaped bool, r *http.Request) (pa
 		}
 		if err := h.HasParam(cfg); err == nil {
 			if err := h.DecodeParam(cfg, func(d uri.Decoder) error {
-				val, err := d.DecodeValue()
-				if err != nil {
-					return err
-				}
+				var paramsDotXSessionUUIDVal uuid.UUID
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
 
-				c, err := conv.ToUUID(val)
-				if err != nil {
+					c, err := conv.ToUUID(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotXSessionUUIDVal = c
+					return nil
+				}(); err != nil {
 					return err
 				}
-
-				params.XSessionUUID = c
+				params.XSessionUUID.SetTo(paramsDotXSessionUUIDVal)
 				return nil
 			}); err != nil {
 				return err
 			}
-		} else {
-			return err
 		}
 		return nil
 	}(); err != nil {
@@ -291,10 +317,746 @@ func decodeOrderGetParams(args [1]string, argsEscaped bool, r *http.Request) (pa
 	return params, nil
 }
 
+// OrderListParams is parameters of OrderList operation.
+type OrderListParams struct {
+	// Фильтр по статусам заказа, можно передать несколько
+	// значений.
+	Status []OrderStatus `json:",omitempty"`
+	// Фильтр по методам оплаты, можно передать несколько
+	// значений.
+	PaymentMethod []PaymentMethodFilterItem `json:",omitempty"`
+	// Фильтр по пользователю. Доступен только
+	// администратору, для остальных пользователей всегда
+	// используется текущий пользователь.
+	UserUUID OptUUID `json:",omitempty,omitzero"`
+	// Начало периода создания заказа (включительно).
+	CreatedFrom OptDateTime `json:",omitempty,omitzero"`
+	// Конец периода создания заказа (не включительно).
+	CreatedTo OptDateTime `json:",omitempty,omitzero"`
+	// Минимальная сумма заказа (включительно).
+	MinTotal OptFloat64 `json:",omitempty,omitzero"`
+	// Максимальная сумма заказа (включительно).
+	MaxTotal OptFloat64 `json:",omitempty,omitzero"`
+	// Порядок сортировки заказов.
+	Sort OptOrderSort `json:",omitempty,omitzero"`
+	// Количество заказов на странице.
+	Limit OptInt `json:",omitempty,omitzero"`
+	// Курсор следующей страницы из поля next_cursor предыдущего
+	// ответа. Используется с той же сортировкой и фильтрами.
+	Cursor OptString `json:",omitempty,omitzero"`
+	// UUID сессии пользователя для аутентификации. Не нужен,
+	// если передан токен доступа в заголовке Authorization (Bearer).
+	XSessionUUID OptUUID `json:",omitempty,omitzero"`
+}
+
+func unpackOrderListParams(packed middleware.Parameters) (params OrderListParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "status",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Status = v.([]OrderStatus)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "payment_method",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.PaymentMethod = v.([]PaymentMethodFilterItem)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "user_uuid",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.UserUUID = v.(OptUUID)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "created_from",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.CreatedFrom = v.(OptDateTime)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "created_to",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.CreatedTo = v.(OptDateTime)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "min_total",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.MinTotal = v.(OptFloat64)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "max_total",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.MaxTotal = v.(OptFloat64)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "sort",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Sort = v.(OptOrderSort)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "limit",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Limit = v.(OptInt)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "cursor",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Cursor = v.(OptString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "X-Session-Uuid",
+			In:   "header",
+		}
+		if v, ok := packed[key]; ok {
+			params.XSessionUUID = v.(OptUUID)
+		}
+	}
+	return params
+}
+
+func decodeOrderListParams(args [0]string, argsEscaped bool, r *http.Request) (params OrderListParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	h := uri.NewHeaderDecoder(r.Header)
+	// Decode query: status.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "status",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				return d.DecodeArray(func(d uri.Decoder) error {
+					var paramsDotStatusVal OrderStatus
+					if err := func() error {
+						val, err := d.DecodeValue()
+						if err != nil {
+							return err
+						}
+
+						c, err := conv.ToString(val)
+						if err != nil {
+							return err
+						}
+
+						paramsDotStatusVal = OrderStatus(c)
+						return nil
+					}(); err != nil {
+						return err
+					}
+					params.Status = append(params.Status, paramsDotStatusVal)
+					return nil
+				})
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				var failures []validate.FieldError
+				for i, elem := range params.Status {
+					if err := func() error {
+						if err := elem.Validate(); err != nil {
+							return err
+						}
+						return nil
+					}(); err != nil {
+						failures = append(failures, validate.FieldError{
+							Name:  fmt.Sprintf("[%d]", i),
+							Error: err,
+						})
+					}
+				}
+				if len(failures) > 0 {
+					return &validate.Error{Fields: failures}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "status",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: payment_method.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "payment_method",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				return d.DecodeArray(func(d uri.Decoder) error {
+					var paramsDotPaymentMethodVal PaymentMethodFilterItem
+					if err := func() error {
+						val, err := d.DecodeValue()
+						if err != nil {
+							return err
+						}
+
+						c, err := conv.ToString(val)
+						if err != nil {
+							return err
+						}
+
+						paramsDotPaymentMethodVal = PaymentMethodFilterItem(c)
+						return nil
+					}(); err != nil {
+						return err
+					}
+					params.PaymentMethod = append(params.PaymentMethod, paramsDotPaymentMethodVal)
+					return nil
+				})
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				var failures []validate.FieldError
+				for i, elem := range params.PaymentMethod {
+					if err := func() error {
+						if err := elem.Validate(); err != nil {
+							return err
+						}
+						return nil
+					}(); err != nil {
+						failures = append(failures, validate.FieldError{
+							Name:  fmt.Sprintf("[%d]", i),
+							Error: err,
+						})
+					}
+				}
+				if len(failures) > 0 {
+					return &validate.Error{Fields: failures}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "payment_method",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: user_uuid.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "user_uuid",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotUserUUIDVal uuid.UUID
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToUUID(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotUserUUIDVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.UserUUID.SetTo(paramsDotUserUUIDVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "user_uuid",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: created_from.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "created_from",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotCreatedFromVal time.Time
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToDateTime(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotCreatedFromVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.CreatedFrom.SetTo(paramsDotCreatedFromVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "created_from",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: created_to.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "created_to",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotCreatedToVal time.Time
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToDateTime(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotCreatedToVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.CreatedTo.SetTo(paramsDotCreatedToVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "created_to",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: min_total.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "min_total",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotMinTotalVal float64
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToFloat64(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotMinTotalVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.MinTotal.SetTo(paramsDotMinTotalVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.MinTotal.Get(); ok {
+					if err := func() error {
+						if err := (validate.Float{
+							MinSet:        true,
+							Min:           0,
+							MaxSet:        false,
+							Max:           0,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    nil,
+						}).Validate(float64(value)); err != nil {
+							return errors.Wrap(err, "float")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "min_total",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: max_total.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "max_total",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotMaxTotalVal float64
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToFloat64(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotMaxTotalVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.MaxTotal.SetTo(paramsDotMaxTotalVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.MaxTotal.Get(); ok {
+					if err := func() error {
+						if err := (validate.Float{
+							MinSet:        true,
+							Min:           0,
+							MaxSet:        false,
+							Max:           0,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    nil,
+						}).Validate(float64(value)); err != nil {
+							return errors.Wrap(err, "float")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "max_total",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Set default value for query: sort.
+	{
+		val := OrderSort("CREATED_AT_DESC")
+		params.Sort.SetTo(val)
+	}
+	// Decode query: sort.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "sort",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotSortVal OrderSort
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotSortVal = OrderSort(c)
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Sort.SetTo(paramsDotSortVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Sort.Get(); ok {
+					if err := func() error {
+						if err := value.Validate(); err != nil {
+							return err
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "sort",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Set default value for query: limit.
+	{
+		val := int(20)
+		params.Limit.SetTo(val)
+	}
+	// Decode query: limit.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotLimitVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotLimitVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Limit.SetTo(paramsDotLimitVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Limit.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           1,
+							MaxSet:        true,
+							Max:           100,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "limit",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: cursor.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "cursor",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotCursorVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotCursorVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Cursor.SetTo(paramsDotCursorVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "cursor",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode header: X-Session-Uuid.
+	if err := func() error {
+		cfg := uri.HeaderParameterDecodingConfig{
+			Name:    "X-Session-Uuid",
+			Explode: false,
+		}
+		if err := h.HasParam(cfg); err == nil {
+			if err := h.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotXSessionUUIDVal uuid.UUID
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToUUID(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotXSessionUUIDVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.XSessionUUID.SetTo(paramsDotXSessionUUIDVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "X-Session-Uuid",
+			In:   "header",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
 // OrderPayParams is parameters of OrderPay operation.
 type OrderPayParams struct {
-	// UUID сессии пользователя для аутентификации.
-	XSessionUUID uuid.UUID
+	// UUID сессии пользователя для аутентификации. Не нужен,
+	// если передан токен доступа в заголовке Authorization (Bearer).
+	XSessionUUID OptUUID `json:",omitempty,omitzero"`
 	// Уникальный идентификатор заказа.
 	OrderUUID string
 }
@@ -305,7 +1067,9 @@ func unpackOrderPayParams(packed middleware.Parameters) (params OrderPayParams)
 			Name: "X-Session-Uuid",
 			In:   "header",
 		}
-		params.XSessionUUID = packed[key].(uuid.UUID)
+		if v, ok := packed[key]; ok {
+			params.XSessionUUID = v.(OptUUID)
+		}
 	}
 	{
 		key := middleware.ParameterKey{
@@ -327,23 +1091,28 @@ func decodeOrderPayParams(args [1]string, argsEscaped bool, r *http.Request) (pa
 		}
 		if err := h.HasParam(cfg); err == nil {
 			if err := h.DecodeParam(cfg, func(d uri.Decoder) error {
-				val, err := d.DecodeValue()
-				if err != nil {
-					return err
-				}
+				var paramsDotXSessionUUIDVal uuid.UUID
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
 
-				c, err := conv.ToUUID(val)
-				if err != nil {
+					c, err := conv.ToUUID(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotXSessionUUIDVal = c
+					return nil
+				}(); err != nil {
 					return err
 				}
-
-				params.XSessionUUID = c
+				params.XSessionUUID.SetTo(paramsDotXSessionUUIDVal)
 				return nil
 			}); err != nil {
 				return err
 			}
-		} else {
-			return err
 		}
 		return nil
 	}(); err != nil {
@@ -59,10 +59,12 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 
 			if len(elem) == 0 {
 				switch r.Method {
+				case "GET":
+					s.handleOrderListRequest([0]string{}, elemIsEscaped, w, r)
 				case "POST":
 					s.handleOrderCreateRequest([0]string{}, elemIsEscaped, w, r)
 				default:
-					s.notAllowed(w, r, "POST")
+					s.notAllowed(w, r, "GET,POST")
 				}
 
 				return
@@ -250,6 +252,14 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 
 			if len(elem) == 0 {
 				switch method {
+				case "GET":
+					r.name = OrderListOperation
+					r.summary = "Возвращает список заказов с фильтрацией и постраничной навигацией"
+					r.operationID = "OrderList"
+					r.pathPattern = "/api/v1/orders"
+					r.args = args
+					r.count = 0
+					return r, true
 				case "POST":
 					r.name = OrderCreateOperation
 					r.summary = "Создание нового заказа"
@@ -78,6 +78,7 @@ func (s *BadRequestError) SetMessage(val string) {
 func (*BadRequestError) orderCancelRes() {}
 func (*BadRequestError) orderCreateRes() {}
 func (*BadRequestError) orderGetRes()    {}
+func (*BadRequestError) orderListRes()   {}
 func (*BadRequestError) orderPayRes()    {}
 
 // Ref: #/components/schemas/conflict_error
@@ -109,31 +110,51 @@ func (s *ConflictError) SetMessage(val string) {
 }
 
 func (*ConflictError) orderCancelRes() {}
+func (*ConflictError) orderCreateRes() {}
 func (*ConflictError) orderPayRes()    {}
 
 // Ref: #/components/schemas/create_order_request
 type CreateOrderRequest struct {
-	// Уникальный идентификатор пользователя.
-	UserUUID uuid.UUID `json:"user_uuid"`
-	// Список уникальных идентификаторов деталей.
+	// Уникальный идентификатор пользователя. Устарело,
+	// пользователь определяется по сессии.
+	//
+	// Deprecated: schema marks this property as deprecated.
+	UserUUID OptUUID `json:"user_uuid"`
+	// Позиции заказа с количеством деталей. Обязательно,
+	// если не передан part_uuids.
+	Items []OrderItemRequest `json:"items"`
+	// Список уникальных идентификаторов деталей, каждое
+	// вхождение — одна деталь. Устарело, используйте items.
+	//
+	// Deprecated: schema marks this property as deprecated.
 	PartUuids []uuid.UUID `json:"part_uuids"`
 }
 
 // GetUserUUID returns the value of UserUUID.
-func (s *CreateOrderRequest) GetUserUUID() uuid.UUID {
+func (s *CreateOrderRequest) GetUserUUID() OptUUID {
 	return s.UserUUID
 }
 
+// GetItems returns the value of Items.
+func (s *CreateOrderRequest) GetItems() []OrderItemRequest {
+	return s.Items
+}
+
 // GetPartUuids returns the value of PartUuids.
 func (s *CreateOrderRequest) GetPartUuids() []uuid.UUID {
 	return s.PartUuids
 }
 
 // SetUserUUID sets the value of UserUUID.
-func (s *CreateOrderRequest) SetUserUUID(val uuid.UUID) {
+func (s *CreateOrderRequest) SetUserUUID(val OptUUID) {
 	s.UserUUID = val
 }
 
+// SetItems sets the value of Items.
+func (s *CreateOrderRequest) SetItems(val []OrderItemRequest) {
+	s.Items = val
+}
+
 // SetPartUuids sets the value of PartUuids.
 func (s *CreateOrderRequest) SetPartUuids(val []uuid.UUID) {
 	s.PartUuids = val
@@ -198,6 +219,7 @@ func (s *ForbiddenError) SetMessage(val string) {
 }
 
 func (*ForbiddenError) orderCancelRes() {}
+func (*ForbiddenError) orderCreateRes() {}
 func (*ForbiddenError) orderGetRes()    {}
 func (*ForbiddenError) orderPayRes()    {}
 
@@ -286,8 +308,40 @@ func (s *InternalServerError) SetMessage(val string) {
 func (*InternalServerError) orderCancelRes() {}
 func (*InternalServerError) orderCreateRes() {}
 func (*InternalServerError) orderGetRes()    {}
+func (*InternalServerError) orderListRes()   {}
 func (*InternalServerError) orderPayRes()    {}
 
+// Ref: #/components/schemas/list_orders_response
+type ListOrdersResponse struct {
+	// Заказы на текущей странице.
+	Orders []OrderDto `json:"orders"`
+	// Курсор следующей страницы. Отсутствует, если
+	// страница последняя.
+	NextCursor OptString `json:"next_cursor"`
+}
+
+// GetOrders returns the value of Orders.
+func (s *ListOrdersResponse) GetOrders() []OrderDto {
+	return s.Orders
+}
+
+// GetNextCursor returns the value of NextCursor.
+func (s *ListOrdersResponse) GetNextCursor() OptString {
+	return s.NextCursor
+}
+
+// SetOrders sets the value of Orders.
+func (s *ListOrdersResponse) SetOrders(val []OrderDto) {
+	s.Orders = val
+}
+
+// SetNextCursor sets the value of NextCursor.
+func (s *ListOrdersResponse) SetNextCursor(val OptString) {
+	s.NextCursor = val
+}
+
+func (*ListOrdersResponse) orderListRes() {}
+
 // NewNilPaymentMethod returns new NilPaymentMethod with value set to v.
 func NewNilPaymentMethod(v PaymentMethod) NilPaymentMethod {
 	return NilPaymentMethod{
@@ -411,6 +465,98 @@ func (o OptDateTime) Or(d time.Time) time.Time {
 	return d
 }
 
+// NewOptFloat64 returns new OptFloat64 with value set to v.
+func NewOptFloat64(v float64) OptFloat64 {
+	return OptFloat64{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptFloat64 is optional float64.
+type OptFloat64 struct {
+	Value float64
+	Set   bool
+}
+
+// IsSet returns true if OptFloat64 was set.
+func (o OptFloat64) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptFloat64) Reset() {
+	var v float64
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptFloat64) SetTo(v float64) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptFloat64) Get() (v float64, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptFloat64) Or(d float64) float64 {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptInt returns new OptInt with value set to v.
+func NewOptInt(v int) OptInt {
+	return OptInt{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptInt is optional int.
+type OptInt struct {
+	Value int
+	Set   bool
+}
+
+// IsSet returns true if OptInt was set.
+func (o OptInt) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptInt) Reset() {
+	var v int
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptInt) SetTo(v int) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptInt) Get() (v int, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptInt) Or(d int) int {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
 // NewOptNilPaymentMethod returns new OptNilPaymentMethod with value set to v.
 func NewOptNilPaymentMethod(v PaymentMethod) OptNilPaymentMethod {
 	return OptNilPaymentMethod{
@@ -537,6 +683,144 @@ func (o OptNilUUID) Or(d uuid.UUID) uuid.UUID {
 	return d
 }
 
+// NewOptOrderSort returns new OptOrderSort with value set to v.
+func NewOptOrderSort(v OrderSort) OptOrderSort {
+	return OptOrderSort{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptOrderSort is optional OrderSort.
+type OptOrderSort struct {
+	Value OrderSort
+	Set   bool
+}
+
+// IsSet returns true if OptOrderSort was set.
+func (o OptOrderSort) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptOrderSort) Reset() {
+	var v OrderSort
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptOrderSort) SetTo(v OrderSort) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptOrderSort) Get() (v OrderSort, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptOrderSort) Or(d OrderSort) OrderSort {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptString returns new OptString with value set to v.
+func NewOptString(v string) OptString {
+	return OptString{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptString is optional string.
+type OptString struct {
+	Value string
+	Set   bool
+}
+
+// IsSet returns true if OptString was set.
+func (o OptString) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptString) Reset() {
+	var v string
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptString) SetTo(v string) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptString) Get() (v string, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptString) Or(d string) string {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptUUID returns new OptUUID with value set to v.
+func NewOptUUID(v uuid.UUID) OptUUID {
+	return OptUUID{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptUUID is optional uuid.UUID.
+type OptUUID struct {
+	Value uuid.UUID
+	Set   bool
+}
+
+// IsSet returns true if OptUUID was set.
+func (o OptUUID) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptUUID) Reset() {
+	var v uuid.UUID
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptUUID) SetTo(v uuid.UUID) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptUUID) Get() (v uuid.UUID, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptUUID) Or(d uuid.UUID) uuid.UUID {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
 // OrderCancelNoContent is response for OrderCancel operation.
 type OrderCancelNoContent struct{}
 
@@ -548,7 +832,13 @@ type OrderDto struct {
 	OrderUUID uuid.UUID `json:"order_uuid"`
 	// Уникальный идентификатор пользователя.
 	UserUUID uuid.UUID `json:"user_uuid"`
-	// Массив идентификаторов деталей в заказе.
+	// Позиции заказа.
+	Items []OrderItem `json:"items"`
+	// Массив идентификаторов деталей в заказе, каждая
+	// деталь повторяется по количеству. Устарело,
+	// используйте items.
+	//
+	// Deprecated: schema marks this property as deprecated.
 	PartUuids []uuid.UUID `json:"part_uuids"`
 	// Итоговая сумма заказа.
 	TotalPrice float64 `json:"total_price"`
@@ -572,6 +862,11 @@ func (s *OrderDto) GetUserUUID() uuid.UUID {
 	return s.UserUUID
 }
 
+// GetItems returns the value of Items.
+func (s *OrderDto) GetItems() []OrderItem {
+	return s.Items
+}
+
 // GetPartUuids returns the value of PartUuids.
 func (s *OrderDto) GetPartUuids() []uuid.UUID {
 	return s.PartUuids
@@ -617,6 +912,11 @@ func (s *OrderDto) SetUserUUID(val uuid.UUID) {
 	s.UserUUID = val
 }
 
+// SetItems sets the value of Items.
+func (s *OrderDto) SetItems(val []OrderItem) {
+	s.Items = val
+}
+
 // SetPartUuids sets the value of PartUuids.
 func (s *OrderDto) SetPartUuids(val []uuid.UUID) {
 	s.PartUuids = val
@@ -654,6 +954,145 @@ func (s *OrderDto) SetUpdatedAt(val OptDateTime) {
 
 func (*OrderDto) orderGetRes() {}
 
+// Ref: #/components/schemas/order_item
+type OrderItem struct {
+	// Уникальный идентификатор детали.
+	PartUUID uuid.UUID `json:"part_uuid"`
+	// Количество деталей.
+	Quantity int64 `json:"quantity"`
+	// Цена одной детали на момент создания заказа. Для
+	// заказов, созданных до появления позиций, равна 0.
+	UnitPrice float64 `json:"unit_price"`
+	// Название детали на момент создания заказа. Для
+	// заказов, созданных до появления позиций, пустое.
+	Name string `json:"name"`
+}
+
+// GetPartUUID returns the value of PartUUID.
+func (s *OrderItem) GetPartUUID() uuid.UUID {
+	return s.PartUUID
+}
+
+// GetQuantity returns the value of Quantity.
+func (s *OrderItem) GetQuantity() int64 {
+	return s.Quantity
+}
+
+// GetUnitPrice returns the value of UnitPrice.
+func (s *OrderItem) GetUnitPrice() float64 {
+	return s.UnitPrice
+}
+
+// GetName returns the value of Name.
+func (s *OrderItem) GetName() string {
+	return s.Name
+}
+
+// SetPartUUID sets the value of PartUUID.
+func (s *OrderItem) SetPartUUID(val uuid.UUID) {
+	s.PartUUID = val
+}
+
+// SetQuantity sets the value of Quantity.
+func (s *OrderItem) SetQuantity(val int64) {
+	s.Quantity = val
+}
+
+// SetUnitPrice sets the value of UnitPrice.
+func (s *OrderItem) SetUnitPrice(val float64) {
+	s.UnitPrice = val
+}
+
+// SetName sets the value of Name.
+func (s *OrderItem) SetName(val string) {
+	s.Name = val
+}
+
+// Ref: #/components/schemas/order_item_request
+type OrderItemRequest struct {
+	// Уникальный идентификатор детали.
+	PartUUID uuid.UUID `json:"part_uuid"`
+	// Количество деталей.
+	Quantity int64 `json:"quantity"`
+}
+
+// GetPartUUID returns the value of PartUUID.
+func (s *OrderItemRequest) GetPartUUID() uuid.UUID {
+	return s.PartUUID
+}
+
+// GetQuantity returns the value of Quantity.
+func (s *OrderItemRequest) GetQuantity() int64 {
+	return s.Quantity
+}
+
+// SetPartUUID sets the value of PartUUID.
+func (s *OrderItemRequest) SetPartUUID(val uuid.UUID) {
+	s.PartUUID = val
+}
+
+// SetQuantity sets the value of Quantity.
+func (s *OrderItemRequest) SetQuantity(val int64) {
+	s.Quantity = val
+}
+
+// Порядок сортировки заказов.
+// Ref: #/components/schemas/order_sort
+type OrderSort string
+
+const (
+	OrderSortCREATEDATDESC  OrderSort = "CREATED_AT_DESC"
+	OrderSortCREATEDATASC   OrderSort = "CREATED_AT_ASC"
+	OrderSortTOTALPRICEDESC OrderSort = "TOTAL_PRICE_DESC"
+	OrderSortTOTALPRICEASC  OrderSort = "TOTAL_PRICE_ASC"
+)
+
+// AllValues returns all OrderSort values.
+func (OrderSort) AllValues() []OrderSort {
+	return []OrderSort{
+		OrderSortCREATEDATDESC,
+		OrderSortCREATEDATASC,
+		OrderSortTOTALPRICEDESC,
+		OrderSortTOTALPRICEASC,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s OrderSort) MarshalText() ([]byte, error) {
+	switch s {
+	case OrderSortCREATEDATDESC:
+		return []byte(s), nil
+	case OrderSortCREATEDATASC:
+		return []byte(s), nil
+	case OrderSortTOTALPRICEDESC:
+		return []byte(s), nil
+	case OrderSortTOTALPRICEASC:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *OrderSort) UnmarshalText(data []byte) error {
+	switch OrderSort(data) {
+	case OrderSortCREATEDATDESC:
+		*s = OrderSortCREATEDATDESC
+		return nil
+	case OrderSortCREATEDATASC:
+		*s = OrderSortCREATEDATASC
+		return nil
+	case OrderSortTOTALPRICEDESC:
+		*s = OrderSortTOTALPRICEDESC
+		return nil
+	case OrderSortTOTALPRICEASC:
+		*s = OrderSortTOTALPRICEASC
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
 // Статус заказа.
 // Ref: #/components/schemas/order_status
 type OrderStatus string
@@ -663,6 +1102,8 @@ const (
 	OrderStatusPAID           OrderStatus = "PAID"
 	OrderStatusCANCELLED      OrderStatus = "CANCELLED"
 	OrderStatusASSEMBLED      OrderStatus = "ASSEMBLED"
+	OrderStatusREFUNDED       OrderStatus = "REFUNDED"
+	OrderStatusASSEMBLYFAILED OrderStatus = "ASSEMBLY_FAILED"
 )
 
 // AllValues returns all OrderStatus values.
@@ -672,6 +1113,8 @@ func (OrderStatus) AllValues() []OrderStatus {
 		OrderStatusPAID,
 		OrderStatusCANCELLED,
 		OrderStatusASSEMBLED,
+		OrderStatusREFUNDED,
+		OrderStatusASSEMBLYFAILED,
 	}
 }
 
@@ -686,6 +1129,10 @@ func (s OrderStatus) MarshalText() ([]byte, error) {
 		return []byte(s), nil
 	case OrderStatusASSEMBLED:
 		return []byte(s), nil
+	case OrderStatusREFUNDED:
+		return []byte(s), nil
+	case OrderStatusASSEMBLYFAILED:
+		return []byte(s), nil
 	default:
 		return nil, errors.Errorf("invalid value: %q", s)
 	}
@@ -706,6 +1153,12 @@ func (s *OrderStatus) UnmarshalText(data []byte) error {
 	case OrderStatusASSEMBLED:
 		*s = OrderStatusASSEMBLED
 		return nil
+	case OrderStatusREFUNDED:
+		*s = OrderStatusREFUNDED
+		return nil
+	case OrderStatusASSEMBLYFAILED:
+		*s = OrderStatusASSEMBLYFAILED
+		return nil
 	default:
 		return errors.Errorf("invalid value: %q", data)
 	}
@@ -808,6 +1261,68 @@ func (s *PaymentMethod) UnmarshalText(data []byte) error {
 	}
 }
 
+type PaymentMethodFilterItem string
+
+const (
+	PaymentMethodFilterItemUNKNOWN       PaymentMethodFilterItem = "UNKNOWN"
+	PaymentMethodFilterItemCARD          PaymentMethodFilterItem = "CARD"
+	PaymentMethodFilterItemSBP           PaymentMethodFilterItem = "SBP"
+	PaymentMethodFilterItemCREDITCARD    PaymentMethodFilterItem = "CREDIT_CARD"
+	PaymentMethodFilterItemINVESTORMONEY PaymentMethodFilterItem = "INVESTOR_MONEY"
+)
+
+// AllValues returns all PaymentMethodFilterItem values.
+func (PaymentMethodFilterItem) AllValues() []PaymentMethodFilterItem {
+	return []PaymentMethodFilterItem{
+		PaymentMethodFilterItemUNKNOWN,
+		PaymentMethodFilterItemCARD,
+		PaymentMethodFilterItemSBP,
+		PaymentMethodFilterItemCREDITCARD,
+		PaymentMethodFilterItemINVESTORMONEY,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s PaymentMethodFilterItem) MarshalText() ([]byte, error) {
+	switch s {
+	case PaymentMethodFilterItemUNKNOWN:
+		return []byte(s), nil
+	case PaymentMethodFilterItemCARD:
+		return []byte(s), nil
+	case PaymentMethodFilterItemSBP:
+		return []byte(s), nil
+	case PaymentMethodFilterItemCREDITCARD:
+		return []byte(s), nil
+	case PaymentMethodFilterItemINVESTORMONEY:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *PaymentMethodFilterItem) UnmarshalText(data []byte) error {
+	switch PaymentMethodFilterItem(data) {
+	case PaymentMethodFilterItemUNKNOWN:
+		*s = PaymentMethodFilterItemUNKNOWN
+		return nil
+	case PaymentMethodFilterItemCARD:
+		*s = PaymentMethodFilterItemCARD
+		return nil
+	case PaymentMethodFilterItemSBP:
+		*s = PaymentMethodFilterItemSBP
+		return nil
+	case PaymentMethodFilterItemCREDITCARD:
+		*s = PaymentMethodFilterItemCREDITCARD
+		return nil
+	case PaymentMethodFilterItemINVESTORMONEY:
+		*s = PaymentMethodFilterItemINVESTORMONEY
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
 // Ref: #/components/schemas/rate_limit_error
 type RateLimitError struct {
 	// HTTP-код ошибки.
@@ -872,6 +1387,7 @@ func (s *RequestTimeoutError) SetMessage(val string) {
 func (*RequestTimeoutError) orderCancelRes() {}
 func (*RequestTimeoutError) orderCreateRes() {}
 func (*RequestTimeoutError) orderGetRes()    {}
+func (*RequestTimeoutError) orderListRes()   {}
 func (*RequestTimeoutError) orderPayRes()    {}
 
 // Ref: #/components/schemas/service_unavailable_error
@@ -938,4 +1454,5 @@ func (s *UnauthorizedError) SetMessage(val string) {
 func (*UnauthorizedError) orderCancelRes() {}
 func (*UnauthorizedError) orderCreateRes() {}
 func (*UnauthorizedError) orderGetRes()    {}
+func (*UnauthorizedError) orderListRes()   {}
 func (*UnauthorizedError) orderPayRes()    {}
@@ -26,6 +26,14 @@ type Handler interface {
 	//
 	// GET /api/v1/orders/{order_uuid}
 	OrderGet(ctx context.Context, params OrderGetParams) (OrderGetRes, error)
+	// OrderList implements OrderList operation.
+	//
+	// Пользователь видит только свои заказы, администратор
+	// — заказы всех пользователей.
+	// Навигация выполняется курсором из поля next_cursor.
+	//
+	// GET /api/v1/orders
+	OrderList(ctx context.Context, params OrderListParams) (OrderListRes, error)
 	// OrderPay implements OrderPay operation.
 	//
 	// Оплата заказа.
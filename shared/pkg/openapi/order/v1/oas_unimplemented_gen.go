@@ -40,6 +40,17 @@ func (UnimplementedHandler) OrderGet(ctx context.Context, params OrderGetParams)
 	return r, ht.ErrNotImplemented
 }
 
+// OrderList implements OrderList operation.
+//
+// Пользователь видит только свои заказы, администратор
+// — заказы всех пользователей.
+// Навигация выполняется курсором из поля next_cursor.
+//
+// GET /api/v1/orders
+func (UnimplementedHandler) OrderList(ctx context.Context, params OrderListParams) (r OrderListRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // OrderPay implements OrderPay operation.
 //
 // Оплата заказа.
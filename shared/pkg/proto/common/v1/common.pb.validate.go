@@ -332,6 +332,12 @@ func (m *User) validate(all bool) error {
 		}
 	}
 
+	// no validation rules for Roles
+
+	// no validation rules for Permissions
+
+	// no validation rules for EmailVerified
+
 	if len(errors) > 0 {
 		return UserMultiError(errors)
 	}
@@ -469,6 +475,10 @@ func (m *UserInfo) validate(all bool) error {
 
 	}
 
+	// no validation rules for Locale
+
+	// no validation rules for Timezone
+
 	if len(errors) > 0 {
 		return UserInfoMultiError(errors)
 	}
@@ -9,6 +9,7 @@ package events_v1
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -29,6 +30,8 @@ type OrderPaid struct {
 	UserUuid        string                 `protobuf:"bytes,3,opt,name=user_uuid,json=userUuid,proto3" json:"user_uuid,omitempty"`                      // Идентификатор пользователя
 	PaymentMethod   string                 `protobuf:"bytes,4,opt,name=payment_method,json=paymentMethod,proto3" json:"payment_method,omitempty"`       // Способ оплаты (строкой, значение из PaymentMethod)
 	TransactionUuid string                 `protobuf:"bytes,5,opt,name=transaction_uuid,json=transactionUuid,proto3" json:"transaction_uuid,omitempty"` // Идентификатор транзакции, сгенерированный в результате оплаты
+	PaidAt          *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=paid_at,json=paidAt,proto3" json:"paid_at,omitempty"`                            // Время оплаты
+	Parts           []*AssemblyPart        `protobuf:"bytes,7,rep,name=parts,proto3" json:"parts,omitempty"`                                            // Детали заказа, из которых собирается корабль
 	unknownFields   protoimpl.UnknownFields
 	sizeCache       protoimpl.SizeCache
 }
@@ -98,6 +101,344 @@ func (x *OrderPaid) GetTransactionUuid() string {
 	return ""
 }
 
+func (x *OrderPaid) GetPaidAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PaidAt
+	}
+	return nil
+}
+
+func (x *OrderPaid) GetParts() []*AssemblyPart {
+	if x != nil {
+		return x.Parts
+	}
+	return nil
+}
+
+// Деталь заказа для сборки
+type AssemblyPart struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PartUuid      string                 `protobuf:"bytes,1,opt,name=part_uuid,json=partUuid,proto3" json:"part_uuid,omitempty"` // Идентификатор детали
+	Quantity      int64                  `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`                // Количество деталей в заказе
+	Category      string                 `protobuf:"bytes,3,opt,name=category,proto3" json:"category,omitempty"`                 // Категория детали (строкой, значение из inventory.v1.Category)
+	Dimensions    *PartDimensions        `protobuf:"bytes,4,opt,name=dimensions,proto3" json:"dimensions,omitempty"`             // Габариты детали
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AssemblyPart) Reset() {
+	*x = AssemblyPart{}
+	mi := &file_events_v1_order_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AssemblyPart) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssemblyPart) ProtoMessage() {}
+
+func (x *AssemblyPart) ProtoReflect() protoreflect.Message {
+	mi := &file_events_v1_order_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssemblyPart.ProtoReflect.Descriptor instead.
+func (*AssemblyPart) Descriptor() ([]byte, []int) {
+	return file_events_v1_order_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *AssemblyPart) GetPartUuid() string {
+	if x != nil {
+		return x.PartUuid
+	}
+	return ""
+}
+
+func (x *AssemblyPart) GetQuantity() int64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *AssemblyPart) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *AssemblyPart) GetDimensions() *PartDimensions {
+	if x != nil {
+		return x.Dimensions
+	}
+	return nil
+}
+
+// Габариты детали
+type PartDimensions struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Length        float64                `protobuf:"fixed64,1,opt,name=length,proto3" json:"length,omitempty"` // Длина в см
+	Width         float64                `protobuf:"fixed64,2,opt,name=width,proto3" json:"width,omitempty"`   // Ширина в см
+	Height        float64                `protobuf:"fixed64,3,opt,name=height,proto3" json:"height,omitempty"` // Высота в см
+	Weight        float64                `protobuf:"fixed64,4,opt,name=weight,proto3" json:"weight,omitempty"` // Вес в кг
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PartDimensions) Reset() {
+	*x = PartDimensions{}
+	mi := &file_events_v1_order_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PartDimensions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PartDimensions) ProtoMessage() {}
+
+func (x *PartDimensions) ProtoReflect() protoreflect.Message {
+	mi := &file_events_v1_order_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PartDimensions.ProtoReflect.Descriptor instead.
+func (*PartDimensions) Descriptor() ([]byte, []int) {
+	return file_events_v1_order_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *PartDimensions) GetLength() float64 {
+	if x != nil {
+		return x.Length
+	}
+	return 0
+}
+
+func (x *PartDimensions) GetWidth() float64 {
+	if x != nil {
+		return x.Width
+	}
+	return 0
+}
+
+func (x *PartDimensions) GetHeight() float64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *PartDimensions) GetWeight() float64 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+// Сборка корабля начата
+type AssemblyStarted struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	EventUuid             string                 `protobuf:"bytes,1,opt,name=event_uuid,json=eventUuid,proto3" json:"event_uuid,omitempty"`                                          // Уникальный идентификатор события (для идемпотентности)
+	OrderUuid             string                 `protobuf:"bytes,2,opt,name=order_uuid,json=orderUuid,proto3" json:"order_uuid,omitempty"`                                          // Идентификатор собираемого заказа
+	UserUuid              string                 `protobuf:"bytes,3,opt,name=user_uuid,json=userUuid,proto3" json:"user_uuid,omitempty"`                                             // Идентификатор пользователя
+	StagesTotal           int32                  `protobuf:"varint,4,opt,name=stages_total,json=stagesTotal,proto3" json:"stages_total,omitempty"`                                   // Количество этапов сборки
+	EstimatedBuildTimeSec int64                  `protobuf:"varint,5,opt,name=estimated_build_time_sec,json=estimatedBuildTimeSec,proto3" json:"estimated_build_time_sec,omitempty"` // Оценка времени сборки в секундах
+	StartedAt             *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`                                          // Время начала сборки
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *AssemblyStarted) Reset() {
+	*x = AssemblyStarted{}
+	mi := &file_events_v1_order_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AssemblyStarted) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssemblyStarted) ProtoMessage() {}
+
+func (x *AssemblyStarted) ProtoReflect() protoreflect.Message {
+	mi := &file_events_v1_order_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssemblyStarted.ProtoReflect.Descriptor instead.
+func (*AssemblyStarted) Descriptor() ([]byte, []int) {
+	return file_events_v1_order_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *AssemblyStarted) GetEventUuid() string {
+	if x != nil {
+		return x.EventUuid
+	}
+	return ""
+}
+
+func (x *AssemblyStarted) GetOrderUuid() string {
+	if x != nil {
+		return x.OrderUuid
+	}
+	return ""
+}
+
+func (x *AssemblyStarted) GetUserUuid() string {
+	if x != nil {
+		return x.UserUuid
+	}
+	return ""
+}
+
+func (x *AssemblyStarted) GetStagesTotal() int32 {
+	if x != nil {
+		return x.StagesTotal
+	}
+	return 0
+}
+
+func (x *AssemblyStarted) GetEstimatedBuildTimeSec() int64 {
+	if x != nil {
+		return x.EstimatedBuildTimeSec
+	}
+	return 0
+}
+
+func (x *AssemblyStarted) GetStartedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartedAt
+	}
+	return nil
+}
+
+// Этап сборки корабля завершен
+type AssemblyStageCompleted struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventUuid     string                 `protobuf:"bytes,1,opt,name=event_uuid,json=eventUuid,proto3" json:"event_uuid,omitempty"`        // Уникальный идентификатор события (для идемпотентности)
+	OrderUuid     string                 `protobuf:"bytes,2,opt,name=order_uuid,json=orderUuid,proto3" json:"order_uuid,omitempty"`        // Идентификатор собираемого заказа
+	UserUuid      string                 `protobuf:"bytes,3,opt,name=user_uuid,json=userUuid,proto3" json:"user_uuid,omitempty"`           // Идентификатор пользователя
+	Stage         string                 `protobuf:"bytes,4,opt,name=stage,proto3" json:"stage,omitempty"`                                 // Название этапа
+	StageIndex    int32                  `protobuf:"varint,5,opt,name=stage_index,json=stageIndex,proto3" json:"stage_index,omitempty"`    // Порядковый номер этапа, начиная с 1
+	StagesTotal   int32                  `protobuf:"varint,6,opt,name=stages_total,json=stagesTotal,proto3" json:"stages_total,omitempty"` // Количество этапов сборки
+	DurationSec   int64                  `protobuf:"varint,7,opt,name=duration_sec,json=durationSec,proto3" json:"duration_sec,omitempty"` // Время (в секундах), потраченное на этап
+	CompletedAt   *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=completed_at,json=completedAt,proto3" json:"completed_at,omitempty"`  // Время завершения этапа
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AssemblyStageCompleted) Reset() {
+	*x = AssemblyStageCompleted{}
+	mi := &file_events_v1_order_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AssemblyStageCompleted) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssemblyStageCompleted) ProtoMessage() {}
+
+func (x *AssemblyStageCompleted) ProtoReflect() protoreflect.Message {
+	mi := &file_events_v1_order_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssemblyStageCompleted.ProtoReflect.Descriptor instead.
+func (*AssemblyStageCompleted) Descriptor() ([]byte, []int) {
+	return file_events_v1_order_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *AssemblyStageCompleted) GetEventUuid() string {
+	if x != nil {
+		return x.EventUuid
+	}
+	return ""
+}
+
+func (x *AssemblyStageCompleted) GetOrderUuid() string {
+	if x != nil {
+		return x.OrderUuid
+	}
+	return ""
+}
+
+func (x *AssemblyStageCompleted) GetUserUuid() string {
+	if x != nil {
+		return x.UserUuid
+	}
+	return ""
+}
+
+func (x *AssemblyStageCompleted) GetStage() string {
+	if x != nil {
+		return x.Stage
+	}
+	return ""
+}
+
+func (x *AssemblyStageCompleted) GetStageIndex() int32 {
+	if x != nil {
+		return x.StageIndex
+	}
+	return 0
+}
+
+func (x *AssemblyStageCompleted) GetStagesTotal() int32 {
+	if x != nil {
+		return x.StagesTotal
+	}
+	return 0
+}
+
+func (x *AssemblyStageCompleted) GetDurationSec() int64 {
+	if x != nil {
+		return x.DurationSec
+	}
+	return 0
+}
+
+func (x *AssemblyStageCompleted) GetCompletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CompletedAt
+	}
+	return nil
+}
+
 // Корабль собран
 type ShipAssembled struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -105,13 +446,14 @@ type ShipAssembled struct {
 	OrderUuid     string                 `protobuf:"bytes,2,opt,name=order_uuid,json=orderUuid,proto3" json:"order_uuid,omitempty"`             // Идентификатор оплаченного заказа
 	UserUuid      string                 `protobuf:"bytes,3,opt,name=user_uuid,json=userUuid,proto3" json:"user_uuid,omitempty"`                // Идентификатор пользователя
 	BuildTimeSec  int64                  `protobuf:"varint,4,opt,name=build_time_sec,json=buildTimeSec,proto3" json:"build_time_sec,omitempty"` // Время (в секундах), потраченное на сборку корабля
+	AssembledAt   *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=assembled_at,json=assembledAt,proto3" json:"assembled_at,omitempty"`       // Время завершения сборки
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ShipAssembled) Reset() {
 	*x = ShipAssembled{}
-	mi := &file_events_v1_order_proto_msgTypes[1]
+	mi := &file_events_v1_order_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -123,7 +465,7 @@ func (x *ShipAssembled) String() string {
 func (*ShipAssembled) ProtoMessage() {}
 
 func (x *ShipAssembled) ProtoReflect() protoreflect.Message {
-	mi := &file_events_v1_order_proto_msgTypes[1]
+	mi := &file_events_v1_order_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -136,7 +478,7 @@ func (x *ShipAssembled) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ShipAssembled.ProtoReflect.Descriptor instead.
 func (*ShipAssembled) Descriptor() ([]byte, []int) {
-	return file_events_v1_order_proto_rawDescGZIP(), []int{1}
+	return file_events_v1_order_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *ShipAssembled) GetEventUuid() string {
@@ -167,11 +509,273 @@ func (x *ShipAssembled) GetBuildTimeSec() int64 {
 	return 0
 }
 
+func (x *ShipAssembled) GetAssembledAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AssembledAt
+	}
+	return nil
+}
+
+// Сборка корабля завершилась ошибкой
+type ShipAssemblyFailed struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventUuid     string                 `protobuf:"bytes,1,opt,name=event_uuid,json=eventUuid,proto3" json:"event_uuid,omitempty"` // Уникальный идентификатор события (для идемпотентности)
+	OrderUuid     string                 `protobuf:"bytes,2,opt,name=order_uuid,json=orderUuid,proto3" json:"order_uuid,omitempty"` // Идентификатор заказа
+	UserUuid      string                 `protobuf:"bytes,3,opt,name=user_uuid,json=userUuid,proto3" json:"user_uuid,omitempty"`    // Идентификатор пользователя
+	Stage         string                 `protobuf:"bytes,4,opt,name=stage,proto3" json:"stage,omitempty"`                          // Этап, на котором сборка остановилась
+	Reason        string                 `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`                        // Причина ошибки: PART_DEFECT - брак детали, RETRIES_EXHAUSTED - исчерпаны попытки обработки
+	Attempt       int32                  `protobuf:"varint,6,opt,name=attempt,proto3" json:"attempt,omitempty"`                     // Номер попытки сборки заказа, начиная с 1
+	FailedAt      *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=failed_at,json=failedAt,proto3" json:"failed_at,omitempty"`    // Время ошибки
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ShipAssemblyFailed) Reset() {
+	*x = ShipAssemblyFailed{}
+	mi := &file_events_v1_order_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShipAssemblyFailed) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShipAssemblyFailed) ProtoMessage() {}
+
+func (x *ShipAssemblyFailed) ProtoReflect() protoreflect.Message {
+	mi := &file_events_v1_order_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShipAssemblyFailed.ProtoReflect.Descriptor instead.
+func (*ShipAssemblyFailed) Descriptor() ([]byte, []int) {
+	return file_events_v1_order_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ShipAssemblyFailed) GetEventUuid() string {
+	if x != nil {
+		return x.EventUuid
+	}
+	return ""
+}
+
+func (x *ShipAssemblyFailed) GetOrderUuid() string {
+	if x != nil {
+		return x.OrderUuid
+	}
+	return ""
+}
+
+func (x *ShipAssemblyFailed) GetUserUuid() string {
+	if x != nil {
+		return x.UserUuid
+	}
+	return ""
+}
+
+func (x *ShipAssemblyFailed) GetStage() string {
+	if x != nil {
+		return x.Stage
+	}
+	return ""
+}
+
+func (x *ShipAssemblyFailed) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *ShipAssemblyFailed) GetAttempt() int32 {
+	if x != nil {
+		return x.Attempt
+	}
+	return 0
+}
+
+func (x *ShipAssemblyFailed) GetFailedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FailedAt
+	}
+	return nil
+}
+
+// Запрошена повторная сборка заказа после ошибки
+type AssemblyRequested struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventUuid     string                 `protobuf:"bytes,1,opt,name=event_uuid,json=eventUuid,proto3" json:"event_uuid,omitempty"` // Уникальный идентификатор события (для идемпотентности)
+	OrderUuid     string                 `protobuf:"bytes,2,opt,name=order_uuid,json=orderUuid,proto3" json:"order_uuid,omitempty"` // Идентификатор заказа
+	UserUuid      string                 `protobuf:"bytes,3,opt,name=user_uuid,json=userUuid,proto3" json:"user_uuid,omitempty"`    // Идентификатор пользователя
+	Attempt       int32                  `protobuf:"varint,4,opt,name=attempt,proto3" json:"attempt,omitempty"`                     // Номер попытки сборки заказа, больше 1
+	Parts         []*AssemblyPart        `protobuf:"bytes,5,rep,name=parts,proto3" json:"parts,omitempty"`                          // Детали заказа, из которых собирается корабль
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AssemblyRequested) Reset() {
+	*x = AssemblyRequested{}
+	mi := &file_events_v1_order_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AssemblyRequested) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssemblyRequested) ProtoMessage() {}
+
+func (x *AssemblyRequested) ProtoReflect() protoreflect.Message {
+	mi := &file_events_v1_order_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssemblyRequested.ProtoReflect.Descriptor instead.
+func (*AssemblyRequested) Descriptor() ([]byte, []int) {
+	return file_events_v1_order_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *AssemblyRequested) GetEventUuid() string {
+	if x != nil {
+		return x.EventUuid
+	}
+	return ""
+}
+
+func (x *AssemblyRequested) GetOrderUuid() string {
+	if x != nil {
+		return x.OrderUuid
+	}
+	return ""
+}
+
+func (x *AssemblyRequested) GetUserUuid() string {
+	if x != nil {
+		return x.UserUuid
+	}
+	return ""
+}
+
+func (x *AssemblyRequested) GetAttempt() int32 {
+	if x != nil {
+		return x.Attempt
+	}
+	return 0
+}
+
+func (x *AssemblyRequested) GetParts() []*AssemblyPart {
+	if x != nil {
+		return x.Parts
+	}
+	return nil
+}
+
+// Заказ отменен после оплаты, средства возвращены
+type OrderRefunded struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	EventUuid       string                 `protobuf:"bytes,1,opt,name=event_uuid,json=eventUuid,proto3" json:"event_uuid,omitempty"`                   // Уникальный идентификатор события (для идемпотентности)
+	OrderUuid       string                 `protobuf:"bytes,2,opt,name=order_uuid,json=orderUuid,proto3" json:"order_uuid,omitempty"`                   // Идентификатор отмененного заказа
+	UserUuid        string                 `protobuf:"bytes,3,opt,name=user_uuid,json=userUuid,proto3" json:"user_uuid,omitempty"`                      // Идентификатор пользователя
+	TransactionUuid string                 `protobuf:"bytes,4,opt,name=transaction_uuid,json=transactionUuid,proto3" json:"transaction_uuid,omitempty"` // Идентификатор транзакции, по которой выполнен возврат
+	RefundedAmount  float64                `protobuf:"fixed64,5,opt,name=refunded_amount,json=refundedAmount,proto3" json:"refunded_amount,omitempty"`  // Сумма возвращенных средств
+	RefundedAt      *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=refunded_at,json=refundedAt,proto3" json:"refunded_at,omitempty"`                // Время возврата средств
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *OrderRefunded) Reset() {
+	*x = OrderRefunded{}
+	mi := &file_events_v1_order_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrderRefunded) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderRefunded) ProtoMessage() {}
+
+func (x *OrderRefunded) ProtoReflect() protoreflect.Message {
+	mi := &file_events_v1_order_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderRefunded.ProtoReflect.Descriptor instead.
+func (*OrderRefunded) Descriptor() ([]byte, []int) {
+	return file_events_v1_order_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *OrderRefunded) GetEventUuid() string {
+	if x != nil {
+		return x.EventUuid
+	}
+	return ""
+}
+
+func (x *OrderRefunded) GetOrderUuid() string {
+	if x != nil {
+		return x.OrderUuid
+	}
+	return ""
+}
+
+func (x *OrderRefunded) GetUserUuid() string {
+	if x != nil {
+		return x.UserUuid
+	}
+	return ""
+}
+
+func (x *OrderRefunded) GetTransactionUuid() string {
+	if x != nil {
+		return x.TransactionUuid
+	}
+	return ""
+}
+
+func (x *OrderRefunded) GetRefundedAmount() float64 {
+	if x != nil {
+		return x.RefundedAmount
+	}
+	return 0
+}
+
+func (x *OrderRefunded) GetRefundedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RefundedAt
+	}
+	return nil
+}
+
 var File_events_v1_order_proto protoreflect.FileDescriptor
 
 const file_events_v1_order_proto_rawDesc = "" +
 	"\n" +
-	"\x15events/v1/order.proto\x12\tevents.v1\"\xb8\x01\n" +
+	"\x15events/v1/order.proto\x12\tevents.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\x9c\x02\n" +
 	"\tOrderPaid\x12\x1d\n" +
 	"\n" +
 	"event_uuid\x18\x01 \x01(\tR\teventUuid\x12\x1d\n" +
@@ -179,14 +783,79 @@ const file_events_v1_order_proto_rawDesc = "" +
 	"order_uuid\x18\x02 \x01(\tR\torderUuid\x12\x1b\n" +
 	"\tuser_uuid\x18\x03 \x01(\tR\buserUuid\x12%\n" +
 	"\x0epayment_method\x18\x04 \x01(\tR\rpaymentMethod\x12)\n" +
-	"\x10transaction_uuid\x18\x05 \x01(\tR\x0ftransactionUuid\"\x90\x01\n" +
+	"\x10transaction_uuid\x18\x05 \x01(\tR\x0ftransactionUuid\x123\n" +
+	"\apaid_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\x06paidAt\x12-\n" +
+	"\x05parts\x18\a \x03(\v2\x17.events.v1.AssemblyPartR\x05parts\"\x9e\x01\n" +
+	"\fAssemblyPart\x12\x1b\n" +
+	"\tpart_uuid\x18\x01 \x01(\tR\bpartUuid\x12\x1a\n" +
+	"\bquantity\x18\x02 \x01(\x03R\bquantity\x12\x1a\n" +
+	"\bcategory\x18\x03 \x01(\tR\bcategory\x129\n" +
+	"\n" +
+	"dimensions\x18\x04 \x01(\v2\x19.events.v1.PartDimensionsR\n" +
+	"dimensions\"n\n" +
+	"\x0ePartDimensions\x12\x16\n" +
+	"\x06length\x18\x01 \x01(\x01R\x06length\x12\x14\n" +
+	"\x05width\x18\x02 \x01(\x01R\x05width\x12\x16\n" +
+	"\x06height\x18\x03 \x01(\x01R\x06height\x12\x16\n" +
+	"\x06weight\x18\x04 \x01(\x01R\x06weight\"\x83\x02\n" +
+	"\x0fAssemblyStarted\x12\x1d\n" +
+	"\n" +
+	"event_uuid\x18\x01 \x01(\tR\teventUuid\x12\x1d\n" +
+	"\n" +
+	"order_uuid\x18\x02 \x01(\tR\torderUuid\x12\x1b\n" +
+	"\tuser_uuid\x18\x03 \x01(\tR\buserUuid\x12!\n" +
+	"\fstages_total\x18\x04 \x01(\x05R\vstagesTotal\x127\n" +
+	"\x18estimated_build_time_sec\x18\x05 \x01(\x03R\x15estimatedBuildTimeSec\x129\n" +
+	"\n" +
+	"started_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tstartedAt\"\xaf\x02\n" +
+	"\x16AssemblyStageCompleted\x12\x1d\n" +
+	"\n" +
+	"event_uuid\x18\x01 \x01(\tR\teventUuid\x12\x1d\n" +
+	"\n" +
+	"order_uuid\x18\x02 \x01(\tR\torderUuid\x12\x1b\n" +
+	"\tuser_uuid\x18\x03 \x01(\tR\buserUuid\x12\x14\n" +
+	"\x05stage\x18\x04 \x01(\tR\x05stage\x12\x1f\n" +
+	"\vstage_index\x18\x05 \x01(\x05R\n" +
+	"stageIndex\x12!\n" +
+	"\fstages_total\x18\x06 \x01(\x05R\vstagesTotal\x12!\n" +
+	"\fduration_sec\x18\a \x01(\x03R\vdurationSec\x12=\n" +
+	"\fcompleted_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\vcompletedAt\"\xcf\x01\n" +
 	"\rShipAssembled\x12\x1d\n" +
 	"\n" +
 	"event_uuid\x18\x01 \x01(\tR\teventUuid\x12\x1d\n" +
 	"\n" +
 	"order_uuid\x18\x02 \x01(\tR\torderUuid\x12\x1b\n" +
 	"\tuser_uuid\x18\x03 \x01(\tR\buserUuid\x12$\n" +
-	"\x0ebuild_time_sec\x18\x04 \x01(\x03R\fbuildTimeSecBFZDgithub.com/crafty-ezhik/rocket-factory/pkg/proto/events/v1;events_v1b\x06proto3"
+	"\x0ebuild_time_sec\x18\x04 \x01(\x03R\fbuildTimeSec\x12=\n" +
+	"\fassembled_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\vassembledAt\"\xf0\x01\n" +
+	"\x12ShipAssemblyFailed\x12\x1d\n" +
+	"\n" +
+	"event_uuid\x18\x01 \x01(\tR\teventUuid\x12\x1d\n" +
+	"\n" +
+	"order_uuid\x18\x02 \x01(\tR\torderUuid\x12\x1b\n" +
+	"\tuser_uuid\x18\x03 \x01(\tR\buserUuid\x12\x14\n" +
+	"\x05stage\x18\x04 \x01(\tR\x05stage\x12\x16\n" +
+	"\x06reason\x18\x05 \x01(\tR\x06reason\x12\x18\n" +
+	"\aattempt\x18\x06 \x01(\x05R\aattempt\x127\n" +
+	"\tfailed_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\bfailedAt\"\xb7\x01\n" +
+	"\x11AssemblyRequested\x12\x1d\n" +
+	"\n" +
+	"event_uuid\x18\x01 \x01(\tR\teventUuid\x12\x1d\n" +
+	"\n" +
+	"order_uuid\x18\x02 \x01(\tR\torderUuid\x12\x1b\n" +
+	"\tuser_uuid\x18\x03 \x01(\tR\buserUuid\x12\x18\n" +
+	"\aattempt\x18\x04 \x01(\x05R\aattempt\x12-\n" +
+	"\x05parts\x18\x05 \x03(\v2\x17.events.v1.AssemblyPartR\x05parts\"\xfb\x01\n" +
+	"\rOrderRefunded\x12\x1d\n" +
+	"\n" +
+	"event_uuid\x18\x01 \x01(\tR\teventUuid\x12\x1d\n" +
+	"\n" +
+	"order_uuid\x18\x02 \x01(\tR\torderUuid\x12\x1b\n" +
+	"\tuser_uuid\x18\x03 \x01(\tR\buserUuid\x12)\n" +
+	"\x10transaction_uuid\x18\x04 \x01(\tR\x0ftransactionUuid\x12'\n" +
+	"\x0frefunded_amount\x18\x05 \x01(\x01R\x0erefundedAmount\x12;\n" +
+	"\vrefunded_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"refundedAtBFZDgithub.com/crafty-ezhik/rocket-factory/pkg/proto/events/v1;events_v1b\x06proto3"
 
 var (
 	file_events_v1_order_proto_rawDescOnce sync.Once
@@ -200,17 +869,34 @@ func file_events_v1_order_proto_rawDescGZIP() []byte {
 	return file_events_v1_order_proto_rawDescData
 }
 
-var file_events_v1_order_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_events_v1_order_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
 var file_events_v1_order_proto_goTypes = []any{
-	(*OrderPaid)(nil),     // 0: events.v1.OrderPaid
-	(*ShipAssembled)(nil), // 1: events.v1.ShipAssembled
+	(*OrderPaid)(nil),              // 0: events.v1.OrderPaid
+	(*AssemblyPart)(nil),           // 1: events.v1.AssemblyPart
+	(*PartDimensions)(nil),         // 2: events.v1.PartDimensions
+	(*AssemblyStarted)(nil),        // 3: events.v1.AssemblyStarted
+	(*AssemblyStageCompleted)(nil), // 4: events.v1.AssemblyStageCompleted
+	(*ShipAssembled)(nil),          // 5: events.v1.ShipAssembled
+	(*ShipAssemblyFailed)(nil),     // 6: events.v1.ShipAssemblyFailed
+	(*AssemblyRequested)(nil),      // 7: events.v1.AssemblyRequested
+	(*OrderRefunded)(nil),          // 8: events.v1.OrderRefunded
+	(*timestamppb.Timestamp)(nil),  // 9: google.protobuf.Timestamp
 }
 var file_events_v1_order_proto_depIdxs = []int32{
-	0, // [0:0] is the sub-list for method output_type
-	0, // [0:0] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	9, // 0: events.v1.OrderPaid.paid_at:type_name -> google.protobuf.Timestamp
+	1, // 1: events.v1.OrderPaid.parts:type_name -> events.v1.AssemblyPart
+	2, // 2: events.v1.AssemblyPart.dimensions:type_name -> events.v1.PartDimensions
+	9, // 3: events.v1.AssemblyStarted.started_at:type_name -> google.protobuf.Timestamp
+	9, // 4: events.v1.AssemblyStageCompleted.completed_at:type_name -> google.protobuf.Timestamp
+	9, // 5: events.v1.ShipAssembled.assembled_at:type_name -> google.protobuf.Timestamp
+	9, // 6: events.v1.ShipAssemblyFailed.failed_at:type_name -> google.protobuf.Timestamp
+	1, // 7: events.v1.AssemblyRequested.parts:type_name -> events.v1.AssemblyPart
+	9, // 8: events.v1.OrderRefunded.refunded_at:type_name -> google.protobuf.Timestamp
+	9, // [9:9] is the sub-list for method output_type
+	9, // [9:9] is the sub-list for method input_type
+	9, // [9:9] is the sub-list for extension type_name
+	9, // [9:9] is the sub-list for extension extendee
+	0, // [0:9] is the sub-list for field type_name
 }
 
 func init() { file_events_v1_order_proto_init() }
@@ -224,7 +910,7 @@ func file_events_v1_order_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_events_v1_order_proto_rawDesc), len(file_events_v1_order_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   2,
+			NumMessages:   9,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -67,6 +67,69 @@ func (m *OrderPaid) validate(all bool) error {
 
 	// no validation rules for TransactionUuid
 
+	if all {
+		switch v := interface{}(m.GetPaidAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, OrderPaidValidationError{
+					field:  "PaidAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, OrderPaidValidationError{
+					field:  "PaidAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPaidAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return OrderPaidValidationError{
+				field:  "PaidAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetParts() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, OrderPaidValidationError{
+						field:  fmt.Sprintf("Parts[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, OrderPaidValidationError{
+						field:  fmt.Sprintf("Parts[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return OrderPaidValidationError{
+					field:  fmt.Sprintf("Parts[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
 	if len(errors) > 0 {
 		return OrderPaidMultiError(errors)
 	}
@@ -144,6 +207,248 @@ var _ interface {
 	ErrorName() string
 } = OrderPaidValidationError{}
 
+// Validate checks the field values on AssemblyPart with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *AssemblyPart) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AssemblyPart with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in AssemblyPartMultiError, or
+// nil if none found.
+func (m *AssemblyPart) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AssemblyPart) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for PartUuid
+
+	// no validation rules for Quantity
+
+	// no validation rules for Category
+
+	if all {
+		switch v := interface{}(m.GetDimensions()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AssemblyPartValidationError{
+					field:  "Dimensions",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AssemblyPartValidationError{
+					field:  "Dimensions",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDimensions()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AssemblyPartValidationError{
+				field:  "Dimensions",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return AssemblyPartMultiError(errors)
+	}
+
+	return nil
+}
+
+// AssemblyPartMultiError is an error wrapping multiple validation errors
+// returned by AssemblyPart.ValidateAll() if the designated constraints aren't met.
+type AssemblyPartMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AssemblyPartMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AssemblyPartMultiError) AllErrors() []error { return m }
+
+// AssemblyPartValidationError is the validation error returned by
+// AssemblyPart.Validate if the designated constraints aren't met.
+type AssemblyPartValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AssemblyPartValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AssemblyPartValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AssemblyPartValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AssemblyPartValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AssemblyPartValidationError) ErrorName() string { return "AssemblyPartValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AssemblyPartValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAssemblyPart.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AssemblyPartValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AssemblyPartValidationError{}
+
+// Validate checks the field values on PartDimensions with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *PartDimensions) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PartDimensions with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in PartDimensionsMultiError,
+// or nil if none found.
+func (m *PartDimensions) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PartDimensions) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Length
+
+	// no validation rules for Width
+
+	// no validation rules for Height
+
+	// no validation rules for Weight
+
+	if len(errors) > 0 {
+		return PartDimensionsMultiError(errors)
+	}
+
+	return nil
+}
+
+// PartDimensionsMultiError is an error wrapping multiple validation errors
+// returned by PartDimensions.ValidateAll() if the designated constraints
+// aren't met.
+type PartDimensionsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PartDimensionsMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PartDimensionsMultiError) AllErrors() []error { return m }
+
+// PartDimensionsValidationError is the validation error returned by
+// PartDimensions.Validate if the designated constraints aren't met.
+type PartDimensionsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PartDimensionsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PartDimensionsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PartDimensionsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PartDimensionsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PartDimensionsValidationError) ErrorName() string { return "PartDimensionsValidationError" }
+
+// Error satisfies the builtin error interface
+func (e PartDimensionsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPartDimensions.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PartDimensionsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PartDimensionsValidationError{}
+
 // Validate checks the field values on ShipAssembled with the rules defined in
 // the proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
@@ -174,6 +479,35 @@ func (m *ShipAssembled) validate(all bool) error {
 
 	// no validation rules for BuildTimeSec
 
+	if all {
+		switch v := interface{}(m.GetAssembledAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ShipAssembledValidationError{
+					field:  "AssembledAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ShipAssembledValidationError{
+					field:  "AssembledAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAssembledAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ShipAssembledValidationError{
+				field:  "AssembledAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
 		return ShipAssembledMultiError(errors)
 	}
@@ -251,3 +585,713 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = ShipAssembledValidationError{}
+
+// Validate checks the field values on ShipAssemblyFailed with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ShipAssemblyFailed) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ShipAssemblyFailed with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ShipAssemblyFailedMultiError, or nil if none found.
+func (m *ShipAssemblyFailed) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ShipAssemblyFailed) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for EventUuid
+
+	// no validation rules for OrderUuid
+
+	// no validation rules for UserUuid
+
+	// no validation rules for Stage
+
+	// no validation rules for Reason
+
+	// no validation rules for Attempt
+
+	if all {
+		switch v := interface{}(m.GetFailedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ShipAssemblyFailedValidationError{
+					field:  "FailedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ShipAssemblyFailedValidationError{
+					field:  "FailedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFailedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ShipAssemblyFailedValidationError{
+				field:  "FailedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ShipAssemblyFailedMultiError(errors)
+	}
+
+	return nil
+}
+
+// ShipAssemblyFailedMultiError is an error wrapping multiple validation errors
+// returned by ShipAssemblyFailed.ValidateAll() if the designated constraints
+// aren't met.
+type ShipAssemblyFailedMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ShipAssemblyFailedMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ShipAssemblyFailedMultiError) AllErrors() []error { return m }
+
+// ShipAssemblyFailedValidationError is the validation error returned by
+// ShipAssemblyFailed.Validate if the designated constraints aren't met.
+type ShipAssemblyFailedValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ShipAssemblyFailedValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ShipAssemblyFailedValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ShipAssemblyFailedValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ShipAssemblyFailedValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ShipAssemblyFailedValidationError) ErrorName() string {
+	return "ShipAssemblyFailedValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ShipAssemblyFailedValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sShipAssemblyFailed.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ShipAssemblyFailedValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ShipAssemblyFailedValidationError{}
+
+// Validate checks the field values on AssemblyRequested with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *AssemblyRequested) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AssemblyRequested with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AssemblyRequestedMultiError, or nil if none found.
+func (m *AssemblyRequested) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AssemblyRequested) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for EventUuid
+
+	// no validation rules for OrderUuid
+
+	// no validation rules for UserUuid
+
+	// no validation rules for Attempt
+
+	for idx, item := range m.GetParts() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AssemblyRequestedValidationError{
+						field:  fmt.Sprintf("Parts[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AssemblyRequestedValidationError{
+						field:  fmt.Sprintf("Parts[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AssemblyRequestedValidationError{
+					field:  fmt.Sprintf("Parts[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return AssemblyRequestedMultiError(errors)
+	}
+
+	return nil
+}
+
+// AssemblyRequestedMultiError is an error wrapping multiple validation errors
+// returned by AssemblyRequested.ValidateAll() if the designated constraints
+// aren't met.
+type AssemblyRequestedMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AssemblyRequestedMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AssemblyRequestedMultiError) AllErrors() []error { return m }
+
+// AssemblyRequestedValidationError is the validation error returned by
+// AssemblyRequested.Validate if the designated constraints aren't met.
+type AssemblyRequestedValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AssemblyRequestedValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AssemblyRequestedValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AssemblyRequestedValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AssemblyRequestedValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AssemblyRequestedValidationError) ErrorName() string {
+	return "AssemblyRequestedValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AssemblyRequestedValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAssemblyRequested.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AssemblyRequestedValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AssemblyRequestedValidationError{}
+
+// Validate checks the field values on OrderRefunded with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *OrderRefunded) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on OrderRefunded with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in OrderRefundedMultiError, or
+// nil if none found.
+func (m *OrderRefunded) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *OrderRefunded) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for EventUuid
+
+	// no validation rules for OrderUuid
+
+	// no validation rules for UserUuid
+
+	// no validation rules for TransactionUuid
+
+	// no validation rules for RefundedAmount
+
+	if all {
+		switch v := interface{}(m.GetRefundedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, OrderRefundedValidationError{
+					field:  "RefundedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, OrderRefundedValidationError{
+					field:  "RefundedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRefundedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return OrderRefundedValidationError{
+				field:  "RefundedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return OrderRefundedMultiError(errors)
+	}
+
+	return nil
+}
+
+// OrderRefundedMultiError is an error wrapping multiple validation errors
+// returned by OrderRefunded.ValidateAll() if the designated constraints
+// aren't met.
+type OrderRefundedMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m OrderRefundedMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m OrderRefundedMultiError) AllErrors() []error { return m }
+
+// OrderRefundedValidationError is the validation error returned by
+// OrderRefunded.Validate if the designated constraints aren't met.
+type OrderRefundedValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e OrderRefundedValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e OrderRefundedValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e OrderRefundedValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e OrderRefundedValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e OrderRefundedValidationError) ErrorName() string { return "OrderRefundedValidationError" }
+
+// Error satisfies the builtin error interface
+func (e OrderRefundedValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sOrderRefunded.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = OrderRefundedValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = OrderRefundedValidationError{}
+
+// Validate checks the field values on AssemblyStarted with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *AssemblyStarted) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AssemblyStarted with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AssemblyStartedMultiError, or nil if none found.
+func (m *AssemblyStarted) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AssemblyStarted) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for EventUuid
+
+	// no validation rules for OrderUuid
+
+	// no validation rules for UserUuid
+
+	// no validation rules for StagesTotal
+
+	// no validation rules for EstimatedBuildTimeSec
+
+	if all {
+		switch v := interface{}(m.GetStartedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AssemblyStartedValidationError{
+					field:  "StartedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AssemblyStartedValidationError{
+					field:  "StartedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStartedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AssemblyStartedValidationError{
+				field:  "StartedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return AssemblyStartedMultiError(errors)
+	}
+
+	return nil
+}
+
+// AssemblyStartedMultiError is an error wrapping multiple validation errors
+// returned by AssemblyStarted.ValidateAll() if the designated constraints
+// aren't met.
+type AssemblyStartedMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AssemblyStartedMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AssemblyStartedMultiError) AllErrors() []error { return m }
+
+// AssemblyStartedValidationError is the validation error returned by
+// AssemblyStarted.Validate if the designated constraints aren't met.
+type AssemblyStartedValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AssemblyStartedValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AssemblyStartedValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AssemblyStartedValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AssemblyStartedValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AssemblyStartedValidationError) ErrorName() string { return "AssemblyStartedValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AssemblyStartedValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAssemblyStarted.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AssemblyStartedValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AssemblyStartedValidationError{}
+
+// Validate checks the field values on AssemblyStageCompleted with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AssemblyStageCompleted) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AssemblyStageCompleted with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AssemblyStageCompletedMultiError, or nil if none found.
+func (m *AssemblyStageCompleted) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AssemblyStageCompleted) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for EventUuid
+
+	// no validation rules for OrderUuid
+
+	// no validation rules for UserUuid
+
+	// no validation rules for Stage
+
+	// no validation rules for StageIndex
+
+	// no validation rules for StagesTotal
+
+	// no validation rules for DurationSec
+
+	if all {
+		switch v := interface{}(m.GetCompletedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AssemblyStageCompletedValidationError{
+					field:  "CompletedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AssemblyStageCompletedValidationError{
+					field:  "CompletedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCompletedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AssemblyStageCompletedValidationError{
+				field:  "CompletedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return AssemblyStageCompletedMultiError(errors)
+	}
+
+	return nil
+}
+
+// AssemblyStageCompletedMultiError is an error wrapping multiple validation
+// errors returned by AssemblyStageCompleted.ValidateAll() if the designated
+// constraints aren't met.
+type AssemblyStageCompletedMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AssemblyStageCompletedMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AssemblyStageCompletedMultiError) AllErrors() []error { return m }
+
+// AssemblyStageCompletedValidationError is the validation error returned by
+// AssemblyStageCompleted.Validate if the designated constraints aren't met.
+type AssemblyStageCompletedValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AssemblyStageCompletedValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AssemblyStageCompletedValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AssemblyStageCompletedValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AssemblyStageCompletedValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AssemblyStageCompletedValidationError) ErrorName() string {
+	return "AssemblyStageCompletedValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AssemblyStageCompletedValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAssemblyStageCompleted.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AssemblyStageCompletedValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AssemblyStageCompletedValidationError{}
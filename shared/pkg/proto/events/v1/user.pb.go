@@ -0,0 +1,182 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: events/v1/user.proto
+
+package events_v1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Пользователю выпущен одноразовый токен, который нужно доставить на его email
+type UserTokenIssued struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventUuid     string                 `protobuf:"bytes,1,opt,name=event_uuid,json=eventUuid,proto3" json:"event_uuid,omitempty"` // Уникальный идентификатор события (для идемпотентности)
+	UserUuid      string                 `protobuf:"bytes,2,opt,name=user_uuid,json=userUuid,proto3" json:"user_uuid,omitempty"`    // Идентификатор пользователя
+	Login         string                 `protobuf:"bytes,3,opt,name=login,proto3" json:"login,omitempty"`                          // Логин пользователя
+	Email         string                 `protobuf:"bytes,4,opt,name=email,proto3" json:"email,omitempty"`                          // Email, на который нужно отправить токен
+	Purpose       string                 `protobuf:"bytes,5,opt,name=purpose,proto3" json:"purpose,omitempty"`                      // Назначение токена: EMAIL_VERIFICATION, PASSWORD_RESET
+	Token         string                 `protobuf:"bytes,6,opt,name=token,proto3" json:"token,omitempty"`                          // Токен в открытом виде. В IAM хранится только его хеш
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"` // Время истечения токена
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserTokenIssued) Reset() {
+	*x = UserTokenIssued{}
+	mi := &file_events_v1_user_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserTokenIssued) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserTokenIssued) ProtoMessage() {}
+
+func (x *UserTokenIssued) ProtoReflect() protoreflect.Message {
+	mi := &file_events_v1_user_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserTokenIssued.ProtoReflect.Descriptor instead.
+func (*UserTokenIssued) Descriptor() ([]byte, []int) {
+	return file_events_v1_user_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *UserTokenIssued) GetEventUuid() string {
+	if x != nil {
+		return x.EventUuid
+	}
+	return ""
+}
+
+func (x *UserTokenIssued) GetUserUuid() string {
+	if x != nil {
+		return x.UserUuid
+	}
+	return ""
+}
+
+func (x *UserTokenIssued) GetLogin() string {
+	if x != nil {
+		return x.Login
+	}
+	return ""
+}
+
+func (x *UserTokenIssued) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *UserTokenIssued) GetPurpose() string {
+	if x != nil {
+		return x.Purpose
+	}
+	return ""
+}
+
+func (x *UserTokenIssued) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *UserTokenIssued) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+var File_events_v1_user_proto protoreflect.FileDescriptor
+
+const file_events_v1_user_proto_rawDesc = "" +
+	"\n" +
+	"\x14events/v1/user.proto\x12\tevents.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xe4\x01\n" +
+	"\x0fUserTokenIssued\x12\x1d\n" +
+	"\n" +
+	"event_uuid\x18\x01 \x01(\tR\teventUuid\x12\x1b\n" +
+	"\tuser_uuid\x18\x02 \x01(\tR\buserUuid\x12\x14\n" +
+	"\x05login\x18\x03 \x01(\tR\x05login\x12\x14\n" +
+	"\x05email\x18\x04 \x01(\tR\x05email\x12\x18\n" +
+	"\apurpose\x18\x05 \x01(\tR\apurpose\x12\x14\n" +
+	"\x05token\x18\x06 \x01(\tR\x05token\x129\n" +
+	"\n" +
+	"expires_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAtBFZDgithub.com/crafty-ezhik/rocket-factory/pkg/proto/events/v1;events_v1b\x06proto3"
+
+var (
+	file_events_v1_user_proto_rawDescOnce sync.Once
+	file_events_v1_user_proto_rawDescData []byte
+)
+
+func file_events_v1_user_proto_rawDescGZIP() []byte {
+	file_events_v1_user_proto_rawDescOnce.Do(func() {
+		file_events_v1_user_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_events_v1_user_proto_rawDesc), len(file_events_v1_user_proto_rawDesc)))
+	})
+	return file_events_v1_user_proto_rawDescData
+}
+
+var file_events_v1_user_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_events_v1_user_proto_goTypes = []any{
+	(*UserTokenIssued)(nil),       // 0: events.v1.UserTokenIssued
+	(*timestamppb.Timestamp)(nil), // 1: google.protobuf.Timestamp
+}
+var file_events_v1_user_proto_depIdxs = []int32{
+	1, // 0: events.v1.UserTokenIssued.expires_at:type_name -> google.protobuf.Timestamp
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_events_v1_user_proto_init() }
+func file_events_v1_user_proto_init() {
+	if File_events_v1_user_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_events_v1_user_proto_rawDesc), len(file_events_v1_user_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_events_v1_user_proto_goTypes,
+		DependencyIndexes: file_events_v1_user_proto_depIdxs,
+		MessageInfos:      file_events_v1_user_proto_msgTypes,
+	}.Build()
+	File_events_v1_user_proto = out.File
+	file_events_v1_user_proto_goTypes = nil
+	file_events_v1_user_proto_depIdxs = nil
+}